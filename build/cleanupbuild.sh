@@ -24,6 +24,7 @@ if [ -a build/docker-compose.yml ]; then
 	rm -rf odimra/odimra_config/schema.json
         rm -rf odimra/odimra_config/registrystore/*
         rm -rf RFPlugin/plugin_config/*
+        rm -rf SimPlugin/plugin_config/*
 	sudo rm -rf Consul/data/*
 	sudo rm -rf Redis/redis-persistence/*
 	sudo rm -rf /etc/kafka/data/*
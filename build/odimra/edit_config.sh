@@ -21,12 +21,14 @@ hostip=`echo $HOSTIP`
 t=/etc/odimra_config
 c=/etc/odimra_certs
 d=/etc/registrystore
+s=/etc/schemastore
 e=/etc
 ############changes in odimra_json.json #######
 sed -i "s#\"LocalhostFQDN\".*#\"LocalhostFQDN\": \"$fqdn\",#" /etc/odimra_config/odimra_config.json
 sed -i "s#\"MessageQueueConfigFilePath\".*#\"MessageQueueConfigFilePath\": \"$t/platformconfig.toml\",#" /etc/odimra_config/odimra_config.json
 sed -i "s#\"SearchAndFilterSchemaPath\".*#\"SearchAndFilterSchemaPath\": \"$e/schema.json\",#" /etc/odimra_config/odimra_config.json
 sed -i "s#\"RegistryStorePath\".*#\"RegistryStorePath\": \"$d\",#" /etc/odimra_config/odimra_config.json
+sed -i "s#\"SchemaStorePath\".*#\"SchemaStorePath\": \"$s\",#" /etc/odimra_config/odimra_config.json
 sed -i "s#\"RootCACertificatePath\".*#\"RootCACertificatePath\": \"$c/rootCA.crt\",#" /etc/odimra_config/odimra_config.json
 sed -i "s#\"RPCPrivateKeyPath\".*#\"RPCPrivateKeyPath\": \"$c/odimra_server.key\",#" /etc/odimra_config/odimra_config.json
 sed -i "s#\"RPCCertificatePath\".*#\"RPCCertificatePath\": \"$c/odimra_server.crt\",#" /etc/odimra_config/odimra_config.json
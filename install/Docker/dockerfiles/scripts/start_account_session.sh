@@ -42,7 +42,7 @@ start_account_session()
 {
 	registry_address="consul:8500"
 	export CONFIG_FILE_PATH=/etc/odimra_config/odimra_config.json
-	nohup /bin/svc-account-session --registry=consul --registry_address=${registry_address} --server_address=account-session:45101 --client_request_timeout=`expr $(cat $CONFIG_FILE_PATH | grep SouthBoundRequestTimeoutInSecs | cut -d : -f2 | cut -d , -f1 | tr -d " ")`s >> /var/log/odimra_logs/account_session.log 2>&1 &
+	nohup /bin/svc-account-session --registry=consul --registry_address=${registry_address} --server_address=account-session:45101 --metrics_address=:46101 --client_request_timeout=`expr $(cat $CONFIG_FILE_PATH | grep SouthBoundRequestTimeoutInSecs | cut -d : -f2 | cut -d , -f1 | tr -d " ")`s >> /var/log/odimra_logs/account_session.log 2>&1 &
 	PID=$!
 	sleep 3
 
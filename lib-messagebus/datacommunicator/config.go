@@ -34,6 +34,9 @@ import (
 // -----------------------------------------------------------------------------
 
 // Sample Configuration File below
+// [MessageBus]
+// # MQ platform, one of Kafka, RedisStreams or NATS. DEFAULT = Kafka
+// MessageBusType = "Kafka"
 // [KAFKA]
 // # Kafka Server List.
 // KServers   = "yourLocalhostFQDN"
@@ -45,12 +48,26 @@ import (
 // KAFKACertFile       = "path/to/kafka/server.crt"
 // KAFKAKeyFile        = "path/to/kafka/kafka.key"
 // KAFKACAFile         = "path/to/kafka/CA.crt"
+// # Batches of the messages written to KAFKA
+// KBatchSize          = 100
+// KBatchTimeout       = 10
+// # Messages of a topic processed concurrently by a consumer
+// KConsumerWorkers    = 5
 
-// MQF define the configuration File content for KAFKA in Golang
+// MQF define the configuration File content for the MQ platforms in Golang
 // structure format. These configurations are embedded into MQF structure for direct
 // access to the data.
 type MQF struct {
-	KafkaF `toml:"KAFKA"`
+	MessageBusF   `toml:"MessageBus"`
+	KafkaF        `toml:"KAFKA"`
+	RedisStreamsF `toml:"RedisStreams"`
+	NATSF         `toml:"NATS"`
+}
+
+// MessageBusF defines the MQ platform used for the messages. The platform is
+// one of Kafka, RedisStreams or NATS. DEFAULT = Kafka
+type MessageBusF struct {
+	MessageBusType string `toml:"MessageBusType"`
 }
 
 // KafkaF defines the KAFKA Server connection configurations. This structure
@@ -69,6 +86,70 @@ type KafkaF struct {
 	KAFKAKeyFile string `toml:"KAFKAKeyFile"`
 	// KAFKACAFile defines the KAFKA Certification Authority. No DEFAULT
 	KAFKACAFile string `toml:"KAFKACAFile"`
+	// KBatchSize defines the maximum number of messages written to Kafka
+	// in a batch. DEFAULT = 100
+	KBatchSize int `toml:"KBatchSize"`
+	// KBatchTimeout defines the time a batch waits for more messages before
+	// being written to Kafka. DEFAULT = 10 (in milliseconds)
+	KBatchTimeout int `toml:"KBatchTimeout"`
+	// KConsumerWorkers defines the number of partitions of a topic processed
+	// concurrently by a consumer. DEFAULT = 5
+	KConsumerWorkers int `toml:"KConsumerWorkers"`
+}
+
+// RedisStreamsF defines the Redis Server connection configurations used when
+// the messages are carried by Redis Streams. TLS is used only when the TLS
+// files are provided.
+type RedisStreamsF struct {
+	// RedisServerAddress defines the Redis Server URI/Nodename:port. No DEFAULT
+	RedisServerAddress string `toml:"RedisServerAddress"`
+	// RedisStreamMaxLength defines the number of messages kept in each stream,
+	// the oldest messages are trimmed. DEFAULT = 10000
+	RedisStreamMaxLength int `toml:"RedisStreamMaxLength"`
+	// RedisRedeliveryTimeout defines the time after which a message read but not
+	// acknowledged by a consumer is delivered to another consumer of the group.
+	// DEFAULT = 60 (in seconds)
+	RedisRedeliveryTimeout int `toml:"RedisRedeliveryTimeout"`
+	// RedisCertFile defines the TLS Certificate File for Redis. No DEFAULT
+	RedisCertFile string `toml:"RedisCertFile"`
+	// RedisKeyFile defines the TLS Key File for Redis. No DEFAULT
+	RedisKeyFile string `toml:"RedisKeyFile"`
+	// RedisCAFile defines the Redis Certification Authority. No DEFAULT
+	RedisCAFile string `toml:"RedisCAFile"`
+}
+
+// NATSF defines the NATS Server connection configurations used when the
+// messages are carried by NATS JetStream. TLS is used only when the TLS
+// files are provided.
+type NATSF struct {
+	// NATSServersInfo defines the list of NATS Server URLs. No DEFAULT
+	NATSServersInfo []string `toml:"NATSServersInfo"`
+	// NATSTimeout defines the timeout for NATS Server connection.
+	// DEFAULT = 10 (in seconds)
+	NATSTimeout int `toml:"NATSTimeout"`
+	// NATSStreamMaxLength defines the number of messages kept in each stream,
+	// the oldest messages are discarded. DEFAULT = 10000
+	NATSStreamMaxLength int `toml:"NATSStreamMaxLength"`
+	// NATSCertFile defines the TLS Certificate File for NATS. No DEFAULT
+	NATSCertFile string `toml:"NATSCertFile"`
+	// NATSKeyFile defines the TLS Key File for NATS. No DEFAULT
+	NATSKeyFile string `toml:"NATSKeyFile"`
+	// NATSCAFile defines the NATS Certification Authority. No DEFAULT
+	NATSCAFile string `toml:"NATSCAFile"`
+}
+
+// Names of the MQ platforms in the MessageBusType configuration
+const (
+	KafkaMessageBus        = "Kafka"
+	RedisStreamsMessageBus = "RedisStreams"
+	NATSMessageBus         = "NATS"
+)
+
+// brokerTypes maps the MessageBusType configuration to the BrokerType
+var brokerTypes = map[string]int{
+	KafkaMessageBus:        KAFKA,
+	RedisStreamsMessageBus: REDISSTREAMS,
+	NATSMessageBus:         NATS,
 }
 
 // Create both MQF and KafkaPacket Objects. MQF will be used to store
@@ -81,9 +162,27 @@ var mq MQF
 // directly from the user by asking to fill a structure.  THIS DATA DETAILS
 // SHOULD BE DEFINED AS PART OF INTERFACE DEFINITION.
 func SetConfiguration(filePath string) error {
+	mq = MQF{}
 	if _, err := toml.DecodeFile(filePath, &mq); err != nil {
 		return fmt.Errorf("Configuration File - %v Read Error: %v", filePath, err)
 	}
+	switch mq.MessageBusType {
+	case "":
+		log.Warn("no value found for MessageBusType in messagebus config file, using default type " + KafkaMessageBus)
+		mq.MessageBusType = KafkaMessageBus
+		return checkKafkaConf()
+	case KafkaMessageBus:
+		return checkKafkaConf()
+	case RedisStreamsMessageBus:
+		return checkRedisStreamsConf()
+	case NATSMessageBus:
+		return checkNATSConf()
+	default:
+		return fmt.Errorf("invalid value %v found for MessageBusType in messagebus config file", mq.MessageBusType)
+	}
+}
+
+func checkKafkaConf() error {
 	if len(mq.KafkaF.KServersInfo) <= 0 {
 		return fmt.Errorf("no value found for KServersInfo in messagebus config file")
 	}
@@ -100,6 +199,63 @@ func SetConfiguration(filePath string) error {
 	if mq.KafkaF.KAFKACAFile == "" {
 		return fmt.Errorf("no value found for KAFKACAFile in messagebus config file")
 	}
+	if mq.KafkaF.KBatchSize == 0 {
+		log.Warn("no value found for KBatchSize in messagebus config file, using default size 100")
+		mq.KafkaF.KBatchSize = 100
+	}
+	if mq.KafkaF.KBatchTimeout == 0 {
+		log.Warn("no value found for KBatchTimeout in messagebus config file, using default time 10 milliseconds")
+		mq.KafkaF.KBatchTimeout = 10
+	}
+	if mq.KafkaF.KConsumerWorkers == 0 {
+		log.Warn("no value found for KConsumerWorkers in messagebus config file, using default count 5")
+		mq.KafkaF.KConsumerWorkers = 5
+	}
+
+	return nil
+}
+
+func checkRedisStreamsConf() error {
+	if mq.RedisStreamsF.RedisServerAddress == "" {
+		return fmt.Errorf("no value found for RedisServerAddress in messagebus config file")
+	}
+	if mq.RedisStreamsF.RedisStreamMaxLength == 0 {
+		log.Warn("no value found for RedisStreamMaxLength in messagebus config file, using default length 10000")
+		mq.RedisStreamsF.RedisStreamMaxLength = 10000
+	}
+	if mq.RedisStreamsF.RedisRedeliveryTimeout == 0 {
+		log.Warn("no value found for RedisRedeliveryTimeout in messagebus config file, using default time 60 seconds")
+		mq.RedisStreamsF.RedisRedeliveryTimeout = 60
+	}
+	return checkTLSFiles("Redis", mq.RedisCertFile, mq.RedisKeyFile, mq.RedisCAFile)
+}
+
+func checkNATSConf() error {
+	if len(mq.NATSF.NATSServersInfo) <= 0 {
+		return fmt.Errorf("no value found for NATSServersInfo in messagebus config file")
+	}
+	if mq.NATSF.NATSTimeout == 0 {
+		log.Warn("no value found for NATSTimeout in messagebus config file, using default time 10 seconds")
+		mq.NATSF.NATSTimeout = 10
+	}
+	if mq.NATSF.NATSStreamMaxLength == 0 {
+		log.Warn("no value found for NATSStreamMaxLength in messagebus config file, using default length 10000")
+		mq.NATSF.NATSStreamMaxLength = 10000
+	}
+	return checkTLSFiles("NATS", mq.NATSCertFile, mq.NATSKeyFile, mq.NATSCAFile)
+}
 
+// checkTLSFiles checks the TLS files of the MQ platform are either all
+// provided or all omitted
+func checkTLSFiles(platform, certFile, keyFile, caFile string) error {
+	provided := 0
+	for _, file := range []string{certFile, keyFile, caFile} {
+		if file != "" {
+			provided++
+		}
+	}
+	if provided != 0 && provided != 3 {
+		return fmt.Errorf("%[1]vCertFile, %[1]vKeyFile and %[1]vCAFile must be all provided in messagebus config file to enable TLS", platform)
+	}
 	return nil
 }
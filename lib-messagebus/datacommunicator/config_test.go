@@ -68,3 +68,93 @@ func TestSetConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestSetConfigurationMessageBusType(t *testing.T) {
+	sampleConfigFile := filepath.Join(cwdDir, "sample.toml")
+	defer os.Remove(sampleConfigFile)
+
+	tests := []struct {
+		name        string
+		content     string
+		wantErr     bool
+		wantBusType string
+	}{
+		{
+			name:        "default type",
+			content:     sampleFileContent,
+			wantBusType: KafkaMessageBus,
+		},
+		{
+			name:        "kafka missing servers",
+			content:     "[MessageBus]\nMessageBusType = \"Kafka\"\n[RedisStreams]\nRedisServerAddress = \"someServer:0\"",
+			wantErr:     true,
+			wantBusType: KafkaMessageBus,
+		},
+		{
+			name:        "redis streams",
+			content:     "[MessageBus]\nMessageBusType = \"RedisStreams\"\n[RedisStreams]\nRedisServerAddress = \"someServer:0\"",
+			wantBusType: RedisStreamsMessageBus,
+		},
+		{
+			name:        "redis streams missing address",
+			content:     "[MessageBus]\nMessageBusType = \"RedisStreams\"\n" + sampleFileContent,
+			wantErr:     true,
+			wantBusType: RedisStreamsMessageBus,
+		},
+		{
+			name:        "redis streams partial TLS",
+			content:     "[MessageBus]\nMessageBusType = \"RedisStreams\"\n[RedisStreams]\nRedisServerAddress = \"someServer:0\"\nRedisCertFile = \"someCertFile\"",
+			wantErr:     true,
+			wantBusType: RedisStreamsMessageBus,
+		},
+		{
+			name:        "nats",
+			content:     "[MessageBus]\nMessageBusType = \"NATS\"\n[NATS]\nNATSServersInfo = [\"nats://someServer:0\"]",
+			wantBusType: NATSMessageBus,
+		},
+		{
+			name:        "nats missing servers",
+			content:     "[MessageBus]\nMessageBusType = \"NATS\"",
+			wantErr:     true,
+			wantBusType: NATSMessageBus,
+		},
+		{
+			name:        "invalid type",
+			content:     "[MessageBus]\nMessageBusType = \"someType\"\n" + sampleFileContent,
+			wantErr:     true,
+			wantBusType: "someType",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createFile(t, sampleConfigFile, tt.content)
+			if err := SetConfiguration(sampleConfigFile); (err != nil) != tt.wantErr {
+				t.Errorf("SetConfiguration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if mq.MessageBusType != tt.wantBusType {
+				t.Errorf("SetConfiguration() MessageBusType = %v, want %v", mq.MessageBusType, tt.wantBusType)
+			}
+		})
+	}
+}
+
+func TestSetConfigurationDefaults(t *testing.T) {
+	sampleConfigFile := filepath.Join(cwdDir, "sample.toml")
+	defer os.Remove(sampleConfigFile)
+
+	createFile(t, sampleConfigFile, "[MessageBus]\nMessageBusType = \"RedisStreams\"\n[RedisStreams]\nRedisServerAddress = \"someServer:0\"")
+	if err := SetConfiguration(sampleConfigFile); err != nil {
+		t.Fatalf("SetConfiguration() error = %v", err)
+	}
+	if mq.RedisStreamMaxLength != 10000 || mq.RedisRedeliveryTimeout != 60 {
+		t.Errorf("SetConfiguration() RedisStreamsF = %+v, want the default length and timeout", mq.RedisStreamsF)
+	}
+
+	createFile(t, sampleConfigFile, "[MessageBus]\nMessageBusType = \"NATS\"\n[NATS]\nNATSServersInfo = [\"nats://someServer:0\"]")
+	if err := SetConfiguration(sampleConfigFile); err != nil {
+		t.Fatalf("SetConfiguration() error = %v", err)
+	}
+	if mq.NATSTimeout != 10 || mq.NATSStreamMaxLength != 10000 {
+		t.Errorf("SetConfiguration() NATSF = %+v, want the default timeout and length", mq.NATSF)
+	}
+}
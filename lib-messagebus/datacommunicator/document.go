@@ -14,8 +14,9 @@
 /*
 
 Package datacommunicator facilitates communication between different Microservice
-components. It allows user to select the underlying communication mediums. For now,
-Kafka is the only communication middlewares supported by MessageBus in this release.
+components. It allows user to select the underlying communication mediums. Kafka, Redis Streams
+and NATS JetStream are the communication middlewares supported by MessageBus, the
+middleware is selected with MessageBusType in the messagebus config file.
 
 This component provides the basic building block for enabling communication by allowing
 users / clients to send any user defined information over to the remote applications / systems
@@ -38,12 +39,21 @@ KAFKA Producer Client :
 	defer K.Close()
 	K.Distribute("example.topic", P)  // P object of Person{}
 
+Shared Producer, safe for concurrent use :
+	K := dc.GetProducer(dc.KAFKA, messageQueueConfigPath) // shared by the process
+	defer dc.CloseProducers() // on shutdown, flushes the pending messages
+	K.Distribute("example.topic", P)  // P object of Person{}
+
 KAFKA Consumer Client :
 	dc.Enable(Person{})
 	K, _ := dc.Communicator(dc.KAFKA, nil) // KAFKA doesn't support Sync calls
 	N.Accept("example.topic", KAfkaHandler)
 	// Incoming Message would be handled in "KafkaHandler" API in client side
 
+Replaying the messages of a topic :
+	K.Replay("example.topic", time.Now().Add(-time.Hour), KafkaHandler)
+	// messages published in the last hour, the consumer group is not affected
+
 */
 
 package datacommunicator
@@ -19,28 +19,40 @@ package datacommunicator
 // IMPORT Section
 // -----------------------------------------------------------------------------
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	log "github.com/sirupsen/logrus"
+	"time"
 )
 
 // BrokerType defines the underline MQ platform to be selected for the
 // messages. KAFKA is the platform supported as part of odimra phase 1.
+// REDISSTREAMS and NATS are the alternative platforms, the platform can be
+// selected with MessageBusType in the messagebus config file as well.
 const (
-	KAFKA = iota // KAFKA as Messaging Platform, Please use this ID
+	KAFKA        = iota // KAFKA as Messaging Platform, Please use this ID
+	REDISSTREAMS        // Redis Streams as Messaging Platform
+	NATS                // NATS JetStream as Messaging Platform
 )
 
 // MQBus Interface defines the Process interface function (Only function user
 // should call). These functions are implemented as part of Packet struct.
 // Distribute - API to Publish Messages into specified Pipe (Topic / Subject)
+// DistributeWithContext - Distribute propagating the trace context of ctx
 // Accept - Consume the incoming message if subscribed by that component
+// AcceptWithContext - Accept passing the propagated trace context to callback
 // Get - Would initiate blocking call to remote process to get response
+// Replay - Pass again to the callback the messages published since a time
 // Close - Would disconnect the connection with Middleware.
 type MQBus interface {
 	Distribute(pipe string, data interface{}) error
+	DistributeWithContext(ctx context.Context, pipe string, data interface{}) error
 	Accept(pipe string, fn MsgProcess) error
+	AcceptWithContext(pipe string, fn MsgProcessWithContext) error
 	Get(pipe string, d interface{}) interface{}
 	Remove(pipe string) error
+	Replay(pipe string, since time.Time, fn MsgProcessWithContext) error
 	Close()
 }
 
@@ -50,6 +62,10 @@ type MQBus interface {
 // be sent to MessageBus as callback for handling the incoming messages.
 type MsgProcess func(d interface{})
 
+// MsgProcessWithContext is MsgProcess receiving along with the message the
+// context carrying the trace context propagated by the producer, if any.
+type MsgProcessWithContext func(ctx context.Context, d interface{})
+
 // Packet defines all the message related information that Producer or Consumer
 // should know for message transactions. Both Producer and Consumer use this
 // same structure for message transactions.
@@ -62,11 +78,17 @@ type Packet struct {
 
 // Communicator defines the Broker platform Middleware selection and corresponding
 // communication object would be created to send / receive the messages. Broker
-// type would be stored as part of Connection Object "Packet".
+// type would be stored as part of Connection Object "Packet". The platform set
+// with MessageBusType in the messagebus config file takes precedence over bt,
+// so the platform can be changed without changing the callers.
 // TODO: We would be looking into Kafka Synchronous communication API for providing
 // support for Sync Communication Model in MessageBus
 func Communicator(bt int, messageQueueConfigPath string) (MQBus, error) {
 
+	if configured, ok := brokerTypes[mq.MessageBusType]; ok {
+		bt = configured
+	}
+
 	// Defining pointer for KAFKA Connection Objects Based on
 	// BrokerType value, Middleware Connection will be created. Also we would be
 	// storing maintain the connections as a Map (Between Connection and Pipe)
@@ -80,6 +102,20 @@ func Communicator(bt int, messageQueueConfigPath string) (MQBus, error) {
 			return nil, e
 		}
 		return kp, nil
+	case REDISSTREAMS:
+		rp := new(RedisStreamsPacket)
+		rp.BrokerType = bt
+		if e := RedisStreamsConnect(rp, messageQueueConfigPath); e != nil {
+			return nil, e
+		}
+		return rp, nil
+	case NATS:
+		np := new(NATSPacket)
+		np.BrokerType = bt
+		if e := NATSConnect(np, messageQueueConfigPath); e != nil {
+			return nil, e
+		}
+		return np, nil
 	default:
 		return nil, fmt.Errorf("Broker: \"Broker Type\" is not supported - %d", bt)
 	}
@@ -25,9 +25,11 @@ import (
 	"fmt"
 	log "github.com/sirupsen/logrus"
 	"io/ioutil"
+	"sync"
 	"time"
 
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
 )
 
 // KafkaPacket defines the KAFKA Message Object. This one conains all the required
@@ -59,6 +61,18 @@ type KafkaPacket struct {
 	// Writers defines the mapping between KAFKA Writer pointer reference
 	// and the Topic which is handled in that Writer
 	Writers map[string]*kafka.Writer
+	// writersMux guards Writers, so the messages can be distributed
+	// concurrently
+	writersMux sync.Mutex
+
+	// batchSize and batchTimeout define the batches of the Writers, the
+	// messages distributed concurrently are written in the same batch
+	batchSize    int
+	batchTimeout time.Duration
+
+	// consumerWorkers defines the number of messages of a Topic processed
+	// concurrently by the Readers
+	consumerWorkers int
 
 	// DialerConn defines the member which can be used for single connection
 	// towards KAFKA
@@ -128,31 +142,28 @@ func KafkaConnect(kp *KafkaPacket, messageQueueConfigPath string) error {
 	if kp.Writers == nil {
 		kp.Writers = make(map[string]*kafka.Writer)
 	}
+	kp.batchSize = mq.KBatchSize
+	kp.batchTimeout = time.Duration(mq.KBatchTimeout) * time.Millisecond
+	kp.consumerWorkers = mq.KConsumerWorkers
 
 	return nil
 }
 
 // Distribute defines the Producer / Publisher role and functionality. Writer
 // would be created for each Pipe comes-in for communication. If Writer already
-// exists, that connection would be used for this call. Before publishing the
+// exists, that connection would be used for this call. It's safe to call it
+// concurrently, the concurrent messages being batched by the Writer. Before publishing the
 // message in the specified Pipe, it will be converted into Byte stream using
 // "Encode" API. Encryption is enabled for the message via TLS.
 func (kp *KafkaPacket) Distribute(pipe string, d interface{}) error {
+	return kp.DistributeWithContext(context.Background(), pipe, d)
+}
 
-	// Check for existing Writers. If not existing for this specific Pipe,
-	// then we would create this Writer object for sending the message.
-	if _, a := kp.Writers[pipe]; a == false {
-
-		kp.Writers[pipe] = kafka.NewWriter(kafka.WriterConfig{
-			Brokers:       kp.ServersInfo,
-			Topic:         pipe,
-			Balancer:      &kafka.LeastBytes{},
-			BatchSize:     1,
-			QueueCapacity: 1,
-			Async:         true,
-			Dialer:        kp.DialerConn,
-		})
-	}
+// DistributeWithContext is Distribute with the trace context carried by ctx
+// propagated to the consumers in the headers of the message.
+func (kp *KafkaPacket) DistributeWithContext(ctx context.Context, pipe string, d interface{}) error {
+
+	w := kp.writer(pipe)
 
 	// Encode the message before appending into KAFKA Message struct
 	b, e := Encode(d)
@@ -166,9 +177,11 @@ func (kp *KafkaPacket) Distribute(pipe string, d interface{}) error {
 		Key:   []byte(pipe),
 		Value: b,
 	}
+	otel.GetTextMapPropagator().Inject(ctx, &headerCarrier{headers: &km.Headers})
 
-	// Write the messgae in the specified Pipe.
-	if e = kp.Writers[pipe].WriteMessages(context.Background(), km); e != nil {
+	// Write the messgae in the specified Pipe. The call returns once the
+	// batch of the message is acknowledged by the brokers.
+	if e = w.WriteMessages(context.Background(), km); e != nil {
 		log.Error(e.Error())
 		return e
 	}
@@ -176,59 +189,277 @@ func (kp *KafkaPacket) Distribute(pipe string, d interface{}) error {
 	return nil
 }
 
+// writer returns the Writer of the pipe. If not existing for this specific
+// Pipe, then we would create this Writer object for sending the messages.
+func (kp *KafkaPacket) writer(pipe string) *kafka.Writer {
+	kp.writersMux.Lock()
+	defer kp.writersMux.Unlock()
+	if w, a := kp.Writers[pipe]; a {
+		return w
+	}
+	w := kafka.NewWriter(kafka.WriterConfig{
+		Brokers:      kp.ServersInfo,
+		Topic:        pipe,
+		Balancer:     &kafka.LeastBytes{},
+		BatchSize:    kp.batchSize,
+		BatchTimeout: kp.batchTimeout,
+		Dialer:       kp.DialerConn,
+	})
+	kp.Writers[pipe] = w
+	return w
+}
+
 // Accept function defines the Consumer or Subscriber functionality for KAFKA.
 // If Reader object for the specified Pipe is not available, New Reader Object
 // would be created. From this function Goroutine "Read" will be invoked to
 // handle the incoming messages.
 func (kp *KafkaPacket) Accept(pipe string, fn MsgProcess) error {
+	return kp.AcceptWithContext(pipe, func(ctx context.Context, d interface{}) {
+		fn(d)
+	})
+}
+
+// AcceptWithContext is Accept with the callback receiving the trace context
+// propagated in the headers of each message.
+func (kp *KafkaPacket) AcceptWithContext(pipe string, fn MsgProcessWithContext) error {
 
-	// If for the Reader Object for pipe and create one if required.
+	// If for the Reader Object for pipe and create one if required. The
+	// Readers of the Topic share the consumer group of the Topic, so its
+	// partitions are balanced between the consumers. The offsets are
+	// committed explicitly once the messages are processed.
 	if _, a := kp.Readers[pipe]; a == false {
 
 		kp.Readers[pipe] = kafka.NewReader(kafka.ReaderConfig{
-			Brokers:        kp.ServersInfo,
-			GroupID:        pipe,
-			Topic:          pipe,
-			MinBytes:       10e1,
-			MaxBytes:       10e6,
-			CommitInterval: 1 * time.Second,
-			Dialer:         kp.DialerConn,
+			Brokers:  kp.ServersInfo,
+			GroupID:  pipe,
+			Topic:    pipe,
+			MinBytes: 10e1,
+			MaxBytes: 10e6,
+			Dialer:   kp.DialerConn,
 		})
 	}
 
-	kp.Read(pipe, fn)
+	kp.ReadWithContext(pipe, fn)
 	return nil
 }
 
 // Read would access the KAFKA messages in a infinite loop. Callback method
 // access is existing only in "goka" library.  Not available in "kafka-go".
 func (kp *KafkaPacket) Read(p string, fn MsgProcess) error {
+	return kp.ReadWithContext(p, func(ctx context.Context, d interface{}) {
+		fn(d)
+	})
+}
+
+// ReadWithContext is Read with the callback receiving the trace context
+// propagated in the headers of each message. The partitions are spread over
+// the consumer workers, each worker processing the messages of its
+// partitions one after the other, so the messages of a partition are
+// processed in order while the partitions are processed concurrently. The
+// offset of a message is committed once the callback has returned for it,
+// so the messages being processed when the consumer stops are delivered
+// again, to this or another consumer of the group.
+func (kp *KafkaPacket) ReadWithContext(p string, fn MsgProcessWithContext) error {
 
-	// This interface should be defined outside the inner level to make sure
-	// we are making the ToData API to work. Otherwise we would get exception
-	// of having local scope interface pointer into passing to remote one
-	var d interface{}
 	c := context.Background()
+	reader := kp.Readers[p]
+	workers := kp.consumerWorkers
+	if workers <= 0 {
+		workers = 1
+	}
+	offsets := newKafkaOffsets()
+	commit := func(m kafka.Message) error {
+		return reader.CommitMessages(c, m)
+	}
+
+	// each partition is pinned to the worker its ID is hashed to
+	queues := make([]chan *kafkaPendingMessage, workers)
+	for i := range queues {
+		queues[i] = make(chan *kafkaPendingMessage)
+		go func(queue <-chan *kafkaPendingMessage) {
+			for pm := range queue {
+				kp.process(c, pm.msg, fn)
+				if e := offsets.done(pm, commit); e != nil {
+					log.Error("error while committing the offset of " + p + ": " + e.Error())
+				}
+			}
+		}(queues[i])
+	}
+	defer func() {
+		for _, queue := range queues {
+			close(queue)
+		}
+	}()
 
 	// Infinite loop to make sure we are constantly reading the messages
 	// from KAFKA.
 	for {
-		// ReadMessages is also possible.  Here in this case, we are
-		// explicitly committing the messages
-		m, e := kp.Readers[p].ReadMessage(c)
+		m, e := reader.FetchMessage(c)
 		if e != nil {
 			log.Error(e.Error())
 			return e
 		}
+		queues[m.Partition%workers] <- offsets.add(m)
+	}
+}
 
-		// Decode the message before passing it to Callback
-		if e = Decode(m.Value, &d); e != nil {
-			log.Error(e.Error())
+// process decodes the message and passes it to the callback. The messages
+// which can't be decoded are skipped.
+func (kp *KafkaPacket) process(c context.Context, m kafka.Message, fn MsgProcessWithContext) {
+
+	// This interface should be defined outside the inner level to make sure
+	// we are making the ToData API to work. Otherwise we would get exception
+	// of having local scope interface pointer into passing to remote one
+	var d interface{}
+
+	// Decode the message before passing it to Callback
+	if e := Decode(m.Value, &d); e != nil {
+		log.Error(e.Error())
+		return
+	}
+	// Callback Function call.
+	fn(otel.GetTextMapPropagator().Extract(c, &headerCarrier{headers: &m.Headers}), d)
+}
+
+// Replay passes to the callback the messages of the Topic published since the
+// given time, up to the last message at the time of the call. The messages are
+// read by a Reader outside of the consumer group, so the offsets of the group
+// are left as they are.
+func (kp *KafkaPacket) Replay(pipe string, since time.Time, fn MsgProcessWithContext) error {
+
+	conn, e := kp.dial(func(server string) (*kafka.Conn, error) {
+		return kp.DialerConn.Dial("tcp", server)
+	})
+	if e != nil {
+		log.Error(e.Error())
+		return e
+	}
+	partitions, e := conn.ReadPartitions(pipe)
+	conn.Close()
+	if e != nil {
+		log.Error(e.Error())
+		return e
+	}
+
+	for _, partition := range partitions {
+		if e := kp.replayPartition(pipe, partition.ID, since, fn); e != nil {
+			log.Error("error while replaying the partition " + fmt.Sprint(partition.ID) + " of " + pipe + ": " + e.Error())
 			return e
 		}
-		// Callback Function call.
-		fn(d)
 	}
+	return nil
+}
+
+// replayPartition passes to the callback the messages of the partition of the
+// Topic published since the given time
+func (kp *KafkaPacket) replayPartition(pipe string, partition int, since time.Time, fn MsgProcessWithContext) error {
+
+	c := context.Background()
+	conn, e := kp.dial(func(server string) (*kafka.Conn, error) {
+		return kp.DialerConn.DialLeader(c, "tcp", server, pipe, partition)
+	})
+	if e != nil {
+		return e
+	}
+	first, e := conn.ReadOffset(since)
+	if e != nil {
+		conn.Close()
+		return e
+	}
+	last, e := conn.ReadLastOffset()
+	conn.Close()
+	if e != nil {
+		return e
+	}
+	// no message published since the given time
+	if first < 0 || first >= last {
+		return nil
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:   kp.ServersInfo,
+		Topic:     pipe,
+		Partition: partition,
+		MinBytes:  10e1,
+		MaxBytes:  10e6,
+		Dialer:    kp.DialerConn,
+	})
+	defer reader.Close()
+	if e = reader.SetOffset(first); e != nil {
+		return e
+	}
+	for {
+		m, e := reader.ReadMessage(c)
+		if e != nil {
+			return e
+		}
+		kp.process(c, m, fn)
+		if m.Offset >= last-1 {
+			return nil
+		}
+	}
+}
+
+// dial connects to the first available KAFKA server
+func (kp *KafkaPacket) dial(dial func(server string) (*kafka.Conn, error)) (*kafka.Conn, error) {
+	e := fmt.Errorf("no kafka server configured")
+	for _, server := range kp.ServersInfo {
+		var conn *kafka.Conn
+		if conn, e = dial(server); e == nil {
+			return conn, nil
+		}
+	}
+	return nil, e
+}
+
+// kafkaOffsets tracks the messages fetched from the partitions of a Topic
+// until they are processed, so that the offset of a partition is committed
+// only once all the messages before it are processed
+type kafkaOffsets struct {
+	mux     sync.Mutex
+	pending map[int][]*kafkaPendingMessage
+}
+
+// kafkaPendingMessage is a message fetched from a partition
+type kafkaPendingMessage struct {
+	msg  kafka.Message
+	done bool
+}
+
+func newKafkaOffsets() *kafkaOffsets {
+	return &kafkaOffsets{
+		pending: make(map[int][]*kafkaPendingMessage),
+	}
+}
+
+// add tracks the message fetched, the messages must be added in the order
+// they are fetched
+func (o *kafkaOffsets) add(m kafka.Message) *kafkaPendingMessage {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	pm := &kafkaPendingMessage{msg: m}
+	o.pending[m.Partition] = append(o.pending[m.Partition], pm)
+	return pm
+}
+
+// done marks the message processed, and commits the last message of its
+// partition whose previous messages are all processed. The commits are
+// serialized so the committed offsets only increase.
+func (o *kafkaOffsets) done(pm *kafkaPendingMessage, commit func(kafka.Message) error) error {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	pm.done = true
+	queue := o.pending[pm.msg.Partition]
+	processed := 0
+	for processed < len(queue) && queue[processed].done {
+		processed++
+	}
+	if processed == 0 {
+		return nil
+	}
+	last := queue[processed-1].msg
+	o.pending[pm.msg.Partition] = queue[processed:]
+	return commit(last)
 }
 
 // Get - Not supported for now in Kafka from Message Bus side due to limitations
@@ -265,9 +496,12 @@ func (kp *KafkaPacket) Close() {
 		delete(kp.Readers, rp)
 	}
 
-	// Closing all opened Writers Connections
+	// Closing all opened Writers Connections, the buffered messages
+	// are flushed
+	kp.writersMux.Lock()
 	for wp, wc := range kp.Writers {
 		wc.Close()
 		delete(kp.Writers, wp)
 	}
+	kp.writersMux.Unlock()
 }
@@ -238,3 +238,34 @@ func TestKafkaPacket_Close(t *testing.T) {
 		})
 	}
 }
+
+func TestKafkaOffsets(t *testing.T) {
+	offsets := newKafkaOffsets()
+	var committed []kafka.Message
+	commit := func(m kafka.Message) error {
+		committed = append(committed, m)
+		return nil
+	}
+
+	var partition0, partition1 []*kafkaPendingMessage
+	for i := int64(0); i < 3; i++ {
+		partition0 = append(partition0, offsets.add(kafka.Message{Partition: 0, Offset: i}))
+		partition1 = append(partition1, offsets.add(kafka.Message{Partition: 1, Offset: i}))
+	}
+
+	// the offset is not committed while a previous message is processed
+	offsets.done(partition0[1], commit)
+	offsets.done(partition0[2], commit)
+	if len(committed) != 0 {
+		t.Fatalf("done() committed = %v, want no commit before the first message is processed", committed)
+	}
+	offsets.done(partition1[0], commit)
+	offsets.done(partition0[0], commit)
+	want := []kafka.Message{{Partition: 1, Offset: 0}, {Partition: 0, Offset: 2}}
+	if !reflect.DeepEqual(committed, want) {
+		t.Errorf("done() committed = %v, want %v", committed, want)
+	}
+	if len(offsets.pending[0]) != 0 || len(offsets.pending[1]) != 2 {
+		t.Errorf("done() pending = %v, want the processed messages removed", offsets.pending)
+	}
+}
@@ -0,0 +1,309 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package datacommunicator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// natsNameReplacer replaces the characters of the Pipe not allowed in the
+// names of the JetStream streams and consumers
+var natsNameReplacer = strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_")
+
+// NATSPacket defines the NATS JetStream Message Object. Each Pipe is the
+// subject of the stream of the same name, which is consumed by the durable
+// consumer of the same name. The subscribers of a Pipe join the queue group
+// of the same name, so as for the KAFKA consumer groups, each message is
+// processed by only one of them. A message is acknowledged once processed by
+// the callback, the messages not acknowledged are redelivered by the NATS
+// Server, so the messages are delivered at least once.
+type NATSPacket struct {
+
+	// All common base function objects are defined in this object.
+	Packet
+
+	conn *nats.Conn
+	js   nats.JetStreamContext
+
+	// streams is the set of the Pipes whose stream is known to exist
+	streams map[string]bool
+
+	// readers maps the Pipe to the subscriptions of its consumers
+	readers map[string]*natsReader
+	mux     sync.Mutex
+
+	maxLength int64
+}
+
+// natsReader is the subscriptions of the consumers of a Pipe, along with the
+// channel closed when the subscriptions are removed
+type natsReader struct {
+	subs []*nats.Subscription
+	done chan struct{}
+}
+
+// NATSConnect defines the connection procedure for the NATS Servers. TLS is
+// used if the TLS files are configured. The connection is reestablished by
+// the client on failures.
+func NATSConnect(np *NATSPacket, messageQueueConfigPath string) error {
+
+	options := []nats.Option{
+		nats.Timeout(time.Duration(mq.NATSTimeout) * time.Second),
+		nats.MaxReconnects(-1),
+	}
+	if mq.NATSCertFile != "" {
+		tls, e := TLS(mq.NATSCertFile, mq.NATSKeyFile, mq.NATSCAFile)
+		if e != nil {
+			log.Error(e.Error())
+			return e
+		}
+		options = append(options, nats.Secure(tls))
+	}
+
+	conn, e := nats.Connect(strings.Join(mq.NATSServersInfo, ","), options...)
+	if e != nil {
+		log.Error("error while connecting to the nats servers: " + e.Error())
+		return e
+	}
+	js, e := conn.JetStream()
+	if e != nil {
+		log.Error(e.Error())
+		conn.Close()
+		return e
+	}
+
+	np.conn = conn
+	np.js = js
+	np.maxLength = int64(mq.NATSStreamMaxLength)
+	np.streams = make(map[string]bool)
+	np.readers = make(map[string]*natsReader)
+	return nil
+}
+
+// natsName returns the name of the stream and of the consumer of the pipe
+func natsName(pipe string) string {
+	return natsNameReplacer.Replace(pipe)
+}
+
+// addStream creates the stream of the pipe if it doesn't exist. The oldest
+// messages of the stream are discarded beyond the configured length.
+func (np *NATSPacket) addStream(pipe string) error {
+	np.mux.Lock()
+	exists := np.streams[pipe]
+	np.mux.Unlock()
+	if exists {
+		return nil
+	}
+
+	name := natsName(pipe)
+	if _, e := np.js.StreamInfo(name); e != nil {
+		_, e = np.js.AddStream(&nats.StreamConfig{
+			Name:     name,
+			Subjects: []string{pipe},
+			MaxMsgs:  np.maxLength,
+		})
+		if e != nil {
+			return fmt.Errorf("error while creating the stream of %v: %v", pipe, e)
+		}
+	}
+
+	np.mux.Lock()
+	np.streams[pipe] = true
+	np.mux.Unlock()
+	return nil
+}
+
+// Distribute defines the Producer / Publisher role and functionality. The
+// message is encoded using "Encode" API and published in the stream of the
+// Pipe, the stream being created if required.
+func (np *NATSPacket) Distribute(pipe string, d interface{}) error {
+	return np.DistributeWithContext(context.Background(), pipe, d)
+}
+
+// DistributeWithContext is Distribute with the trace context carried by ctx
+// propagated to the consumers in the headers of the message.
+func (np *NATSPacket) DistributeWithContext(ctx context.Context, pipe string, d interface{}) error {
+
+	if e := np.addStream(pipe); e != nil {
+		log.Error(e.Error())
+		return e
+	}
+
+	b, e := Encode(d)
+	if e != nil {
+		log.Error(e.Error())
+		return e
+	}
+
+	m := nats.NewMsg(pipe)
+	m.Data = b
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(http.Header(m.Header)))
+
+	if _, e = np.js.PublishMsg(m); e != nil {
+		log.Error(e.Error())
+		return e
+	}
+	return nil
+}
+
+// Accept function defines the Consumer or Subscriber functionality for NATS.
+// The stream and the durable consumer of the Pipe are created if required,
+// then the messages are processed until the subscription is removed.
+func (np *NATSPacket) Accept(pipe string, fn MsgProcess) error {
+	return np.AcceptWithContext(pipe, func(ctx context.Context, d interface{}) {
+		fn(d)
+	})
+}
+
+// AcceptWithContext is Accept with the callback receiving the trace context
+// propagated in the headers of each message.
+func (np *NATSPacket) AcceptWithContext(pipe string, fn MsgProcessWithContext) error {
+
+	if e := np.addStream(pipe); e != nil {
+		log.Error(e.Error())
+		return e
+	}
+
+	name := natsName(pipe)
+	sub, e := np.js.QueueSubscribe(pipe, name, func(m *nats.Msg) {
+		np.process(pipe, m, fn)
+	}, nats.Durable(name), nats.ManualAck(), nats.AckExplicit(), nats.DeliverAll())
+	if e != nil {
+		log.Error("error while subscribing to " + pipe + ": " + e.Error())
+		return e
+	}
+
+	np.mux.Lock()
+	reader, ok := np.readers[pipe]
+	if !ok {
+		reader = &natsReader{done: make(chan struct{})}
+		np.readers[pipe] = reader
+	}
+	reader.subs = append(reader.subs, sub)
+	np.mux.Unlock()
+
+	<-reader.done
+	return nil
+}
+
+// process passes the message to the callback and acknowledges it. The
+// messages which can't be decoded are acknowledged to not be redelivered.
+func (np *NATSPacket) process(pipe string, m *nats.Msg, fn MsgProcessWithContext) {
+	var d interface{}
+	if e := Decode(m.Data, &d); e == nil {
+		fn(otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(http.Header(m.Header))), d)
+	}
+	if e := m.Ack(); e != nil {
+		log.Error("error while acknowledging the message of " + pipe + ": " + e.Error())
+	}
+}
+
+// Replay passes to the callback the messages of the stream published since
+// the given time, up to the last message at the time of the call. The
+// messages are read by an ephemeral consumer, so the durable consumer of the
+// pipe is left as it is.
+func (np *NATSPacket) Replay(pipe string, since time.Time, fn MsgProcessWithContext) error {
+
+	info, e := np.js.StreamInfo(natsName(pipe))
+	if e != nil {
+		log.Error(e.Error())
+		return e
+	}
+	if info.State.Msgs == 0 || info.State.LastTime.Before(since) {
+		return nil
+	}
+
+	sub, e := np.js.SubscribeSync(pipe, nats.StartTime(since), nats.AckNone())
+	if e != nil {
+		log.Error(e.Error())
+		return e
+	}
+	defer sub.Unsubscribe()
+	timeout := time.Duration(mq.NATSTimeout) * time.Second
+	for {
+		m, e := sub.NextMsg(timeout)
+		if e != nil {
+			log.Error(e.Error())
+			return e
+		}
+		var d interface{}
+		if e := Decode(m.Data, &d); e == nil {
+			fn(otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(http.Header(m.Header))), d)
+		}
+		meta, e := m.Metadata()
+		if e != nil {
+			log.Error(e.Error())
+			return e
+		}
+		if meta.NumPending == 0 || meta.Sequence.Stream >= info.State.LastSeq {
+			return nil
+		}
+	}
+}
+
+// Get - Not supported for now in NATS from Message Bus side, as for KAFKA
+func (np *NATSPacket) Get(pipe string, d interface{}) interface{} {
+
+	return nil
+}
+
+// Remove will just remove the existing subscription. The subscriptions are
+// drained, so the durable consumer of the pipe is kept along with the messages
+// not processed yet.
+func (np *NATSPacket) Remove(pipe string) error {
+
+	np.mux.Lock()
+	defer np.mux.Unlock()
+	reader, ok := np.readers[pipe]
+	if ok == false {
+		e := fmt.Errorf("specified pipe is not subscribed yet. please check the pipe name passed")
+		return e
+	}
+	for _, sub := range reader.subs {
+		if e := sub.Drain(); e != nil {
+			log.Error("error while removing the subscription of " + pipe + ": " + e.Error())
+		}
+	}
+	close(reader.done)
+	delete(np.readers, pipe)
+
+	return nil
+}
+
+// Close will remove all the subscriptions and disconnect the NATS
+// connection once the messages in progress are processed.
+func (np *NATSPacket) Close() {
+
+	np.mux.Lock()
+	for pipe, reader := range np.readers {
+		close(reader.done)
+		delete(np.readers, pipe)
+	}
+	np.mux.Unlock()
+
+	if e := np.conn.Drain(); e != nil {
+		log.Error("error while closing the nats connection: " + e.Error())
+	}
+}
@@ -0,0 +1,65 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package datacommunicator
+
+import (
+	"net"
+	"testing"
+)
+
+func TestNatsName(t *testing.T) {
+	tests := []struct {
+		pipe string
+		want string
+	}{
+		{pipe: "REDFISH-EVENTS-TOPIC", want: "REDFISH-EVENTS-TOPIC"},
+		{pipe: "odim.events", want: "odim_events"},
+		{pipe: "odim.*.events >", want: "odim___events__"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pipe, func(t *testing.T) {
+			if got := natsName(tt.pipe); got != tt.want {
+				t.Errorf("natsName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNATSConnect(t *testing.T) {
+	// the address of a closed listener, where no NATS Server is running
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("error: failed to get a free port for tests:", err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+
+	mq = MQF{
+		MessageBusF: MessageBusF{MessageBusType: NATSMessageBus},
+		NATSF: NATSF{
+			NATSServersInfo:     []string{"nats://" + address},
+			NATSTimeout:         1,
+			NATSStreamMaxLength: 10,
+		},
+	}
+	if err := NATSConnect(new(NATSPacket), ""); err == nil {
+		t.Errorf("NATSConnect() error = nil, want an error for the unavailable server")
+	}
+
+	mq.NATSCertFile, mq.NATSKeyFile, mq.NATSCAFile = "someCertFile", "someKeyFile", "someCAFile"
+	if err := NATSConnect(new(NATSPacket), ""); err == nil {
+		t.Errorf("NATSConnect() error = nil, want an error for the missing TLS files")
+	}
+}
@@ -0,0 +1,274 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package datacommunicator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// producerMaxPending is the maximum number of messages distributed
+// asynchronously by a producer and not yet acknowledged, DistributeAsync
+// blocks beyond it
+const producerMaxPending = 1000
+
+// producerRetryInterval is the first wait before distributing again a message
+// distributed asynchronously which has failed, the wait doubles up to
+// producerMaxRetryInterval for each failure
+const (
+	producerRetryInterval    = 100 * time.Millisecond
+	producerMaxRetryInterval = 30 * time.Second
+)
+
+// errProducerClosed is returned when a message is distributed with a closed
+// producer
+var errProducerClosed = fmt.Errorf("the message bus producer is closed")
+
+// Producer is the long-lived publisher of the messages of a process. As
+// connecting to the MQ platform for each message is costly, mainly for KAFKA
+// whose connections are TLS ones, the publishers share the producers returned
+// by GetProducer. A producer is safe for concurrent use, the messages being
+// distributed over the same connection, and batched by the platform when they
+// are distributed concurrently. When a message can't be distributed, the
+// connection is reestablished and the message is distributed again, so the
+// producer recovers from the failover of the brokers. The messages distributed
+// asynchronously are distributed again until the producer is closed, so they
+// also survive a longer outage of the brokers.
+type Producer struct {
+	bt                     int
+	messageQueueConfigPath string
+
+	mux    sync.Mutex
+	bus    MQBus
+	closed bool
+	// closing is closed when the producer is closed, to stop the retries of
+	// the messages distributed asynchronously
+	closing chan struct{}
+
+	// pending tracks the messages being distributed, so that Close waits for
+	// them to be acknowledged. slots bounds the asynchronous ones.
+	pending sync.WaitGroup
+	slots   chan struct{}
+}
+
+var (
+	producers    = map[string]*Producer{}
+	producersMux sync.Mutex
+
+	// communicator creates the connections of the producers
+	communicator = Communicator
+)
+
+// GetProducer returns the producer of the process for the broker type and
+// the messagebus config file. The producer connects to the MQ platform when
+// the first message is distributed.
+func GetProducer(bt int, messageQueueConfigPath string) *Producer {
+	key := fmt.Sprintf("%d:%s", bt, messageQueueConfigPath)
+	producersMux.Lock()
+	defer producersMux.Unlock()
+	p, ok := producers[key]
+	if !ok {
+		p = &Producer{
+			bt:                     bt,
+			messageQueueConfigPath: messageQueueConfigPath,
+			closing:                make(chan struct{}),
+			slots:                  make(chan struct{}, producerMaxPending),
+		}
+		producers[key] = p
+	}
+	return p
+}
+
+// CloseProducers closes all the producers of the process. It should be called
+// when the process stops, so the messages not yet written are flushed.
+func CloseProducers() {
+	producersMux.Lock()
+	defer producersMux.Unlock()
+	for key, p := range producers {
+		p.Close()
+		delete(producers, key)
+	}
+}
+
+// Distribute publishes the message in the pipe, it returns once the message
+// is acknowledged by the MQ platform.
+func (p *Producer) Distribute(pipe string, d interface{}) error {
+	return p.DistributeWithContext(context.Background(), pipe, d)
+}
+
+// DistributeWithContext is Distribute with the trace context carried by ctx
+// propagated to the consumers.
+func (p *Producer) DistributeWithContext(ctx context.Context, pipe string, d interface{}) error {
+	if !p.begin() {
+		return errProducerClosed
+	}
+	defer p.pending.Done()
+	return p.distribute(ctx, pipe, d)
+}
+
+// DistributeAsync publishes the message in the pipe without waiting for it
+// to be acknowledged. A message which can't be distributed is distributed
+// again with an exponential backoff, until it's acknowledged or the producer
+// is closed or ctx is done. If ack is not nil, it's called with the result
+// once the message is acknowledged or the retries are given up.
+func (p *Producer) DistributeAsync(ctx context.Context, pipe string, d interface{}, ack func(error)) {
+	if !p.begin() {
+		if ack != nil {
+			ack(errProducerClosed)
+		}
+		return
+	}
+	p.slots <- struct{}{}
+	go func() {
+		defer func() {
+			<-p.slots
+			p.pending.Done()
+		}()
+		e := p.distributeWithRetry(ctx, pipe, d)
+		if ack != nil {
+			ack(e)
+		}
+	}()
+}
+
+// Close waits for the messages being distributed to be acknowledged, then
+// closes the connection, flushing the messages not yet written. The messages
+// waiting to be distributed again are given up, their ack being called with
+// the last error.
+func (p *Producer) Close() {
+	p.mux.Lock()
+	if p.closed {
+		p.mux.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.closing)
+	p.mux.Unlock()
+
+	p.pending.Wait()
+
+	p.mux.Lock()
+	bus := p.bus
+	p.bus = nil
+	p.mux.Unlock()
+	if bus != nil {
+		bus.Close()
+	}
+}
+
+// begin registers a message being distributed, it returns false if the
+// producer is closed
+func (p *Producer) begin() bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if p.closed {
+		return false
+	}
+	p.pending.Add(1)
+	return true
+}
+
+// distribute distributes the message, reconnecting once on failure
+func (p *Producer) distribute(ctx context.Context, pipe string, d interface{}) error {
+	bus, e := p.connection()
+	if e != nil {
+		return e
+	}
+	if e = bus.DistributeWithContext(ctx, pipe, d); e == nil {
+		return nil
+	}
+
+	log.Warn("unable to distribute the message to " + pipe + ", reconnecting to the message bus: " + e.Error())
+	if bus, e = p.reconnect(bus); e != nil {
+		return e
+	}
+	return bus.DistributeWithContext(ctx, pipe, d)
+}
+
+// distributeWithRetry distributes the message, distributing it again with an
+// exponential backoff until it's acknowledged or the producer is closed or ctx
+// is done
+func (p *Producer) distributeWithRetry(ctx context.Context, pipe string, d interface{}) error {
+	wait := producerRetryInterval
+	for {
+		e := p.distribute(ctx, pipe, d)
+		if e == nil || e == errProducerClosed {
+			return e
+		}
+		log.Warn("unable to distribute the message to " + pipe + ", retrying in " + wait.String() + ": " + e.Error())
+		select {
+		case <-p.closing:
+			log.Error("unable to distribute the message to " + pipe + " before the producer is closed: " + e.Error())
+			return e
+		case <-ctx.Done():
+			log.Error("unable to distribute the message to " + pipe + ": " + e.Error())
+			return e
+		case <-time.After(wait):
+		}
+		if wait *= 2; wait > producerMaxRetryInterval {
+			wait = producerMaxRetryInterval
+		}
+	}
+}
+
+// connection returns the connection of the producer, connecting if required
+func (p *Producer) connection() (MQBus, error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if p.bus == nil {
+		bus, e := communicator(p.bt, p.messageQueueConfigPath)
+		if e != nil {
+			log.Error("unable to connect to the message bus: " + e.Error())
+			return nil, e
+		}
+		p.bus = bus
+	}
+	return p.bus, nil
+}
+
+// reconnect replaces the failed connection bus, if it's not already replaced, and
+// returns the connection to distribute the message again. The new connection is
+// used by the next messages before the failed one is closed, closing it flushes
+// the messages being distributed over it. No connection is made once the producer
+// is closed.
+func (p *Producer) reconnect(bus MQBus) (MQBus, error) {
+	p.mux.Lock()
+	if p.closed {
+		p.mux.Unlock()
+		return nil, errProducerClosed
+	}
+	if p.bus != bus && p.bus != nil {
+		current := p.bus
+		p.mux.Unlock()
+		return current, nil
+	}
+	newBus, e := communicator(p.bt, p.messageQueueConfigPath)
+	if e != nil {
+		log.Error("unable to connect to the message bus: " + e.Error())
+		p.mux.Unlock()
+		return nil, e
+	}
+	old := p.bus
+	p.bus = newBus
+	p.mux.Unlock()
+	if old != nil {
+		old.Close()
+	}
+	return newBus, nil
+}
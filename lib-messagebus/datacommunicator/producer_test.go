@@ -0,0 +1,274 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package datacommunicator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBus is the MQBus recording the distributed messages, failing the
+// given number of times
+type fakeBus struct {
+	mux      sync.Mutex
+	failures int
+	messages []interface{}
+	closed   bool
+	// onClose is called when the bus is closed
+	onClose func()
+}
+
+func (f *fakeBus) Distribute(pipe string, data interface{}) error {
+	return f.DistributeWithContext(context.Background(), pipe, data)
+}
+
+func (f *fakeBus) DistributeWithContext(ctx context.Context, pipe string, data interface{}) error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	if f.closed {
+		return fmt.Errorf("closed")
+	}
+	if f.failures > 0 {
+		f.failures--
+		return fmt.Errorf("broker not available")
+	}
+	f.messages = append(f.messages, data)
+	return nil
+}
+
+func (f *fakeBus) Accept(pipe string, fn MsgProcess) error                       { return nil }
+func (f *fakeBus) AcceptWithContext(pipe string, fn MsgProcessWithContext) error { return nil }
+func (f *fakeBus) Get(pipe string, d interface{}) interface{}                    { return nil }
+func (f *fakeBus) Remove(pipe string) error                                      { return nil }
+func (f *fakeBus) Replay(pipe string, since time.Time, fn MsgProcessWithContext) error {
+	return nil
+}
+
+func (f *fakeBus) Close() {
+	if f.onClose != nil {
+		f.onClose()
+	}
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.closed = true
+}
+
+// mockCommunicator replaces the connections of the producers with the given
+// buses, the returned function restores them
+func mockCommunicator(buses ...*fakeBus) (*int, func()) {
+	var mux sync.Mutex
+	connections := 0
+	communicator = func(bt int, messageQueueConfigPath string) (MQBus, error) {
+		mux.Lock()
+		defer mux.Unlock()
+		if connections >= len(buses) {
+			return nil, fmt.Errorf("unable to connect")
+		}
+		connections++
+		return buses[connections-1], nil
+	}
+	return &connections, func() {
+		CloseProducers()
+		communicator = Communicator
+	}
+}
+
+func TestGetProducer(t *testing.T) {
+	_, restore := mockCommunicator()
+	defer restore()
+
+	p := GetProducer(KAFKA, "someFile")
+	if got := GetProducer(KAFKA, "someFile"); got != p {
+		t.Errorf("GetProducer() = %p, want the same producer %p", got, p)
+	}
+	if got := GetProducer(NATS, "someFile"); got == p {
+		t.Errorf("GetProducer() = %p, want a producer for the other broker type", got)
+	}
+}
+
+func TestProducerConcurrentDistribute(t *testing.T) {
+	bus := &fakeBus{}
+	connections, restore := mockCommunicator(bus)
+	defer restore()
+
+	p := GetProducer(KAFKA, "someFile")
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := p.Distribute("TEST-TOPIC", i); err != nil {
+				t.Errorf("Distribute() error = %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if *connections != 1 {
+		t.Errorf("Distribute() connections = %v, want 1", *connections)
+	}
+	if len(bus.messages) != 100 {
+		t.Errorf("Distribute() messages = %v, want 100", len(bus.messages))
+	}
+}
+
+func TestProducerReconnect(t *testing.T) {
+	failing := &fakeBus{failures: 1}
+	bus := &fakeBus{}
+	connections, restore := mockCommunicator(failing, bus)
+	defer restore()
+
+	p := GetProducer(KAFKA, "someFile")
+	if err := p.Distribute("TEST-TOPIC", "message 1"); err != nil {
+		t.Fatalf("Distribute() error = %v", err)
+	}
+	if *connections != 2 || !failing.closed {
+		t.Errorf("Distribute() connections = %v, want the failed connection closed and replaced", *connections)
+	}
+	if len(bus.messages) != 1 {
+		t.Errorf("Distribute() messages = %v, want the message distributed on the new connection", bus.messages)
+	}
+
+	// no more connection available
+	if err := p.Distribute("TEST-TOPIC", "message 2"); err != nil {
+		t.Fatalf("Distribute() error = %v", err)
+	}
+	bus.failures = 1
+	if err := p.Distribute("TEST-TOPIC", "message 3"); err == nil {
+		t.Errorf("Distribute() error = nil, want an error when reconnecting fails")
+	}
+}
+
+func TestProducerReconnectReplacesBeforeClosing(t *testing.T) {
+	failing := &fakeBus{failures: 1}
+	bus := &fakeBus{}
+	_, restore := mockCommunicator(failing, bus)
+	defer restore()
+
+	p := GetProducer(KAFKA, "someFile")
+	var current MQBus
+	failing.onClose = func() {
+		current, _ = p.connection()
+	}
+	if err := p.Distribute("TEST-TOPIC", "message 1"); err != nil {
+		t.Fatalf("Distribute() error = %v", err)
+	}
+	if current != bus {
+		t.Errorf("reconnect() connection = %v while closing the failed one, want the new connection", current)
+	}
+}
+
+func TestProducerDistributeAsync(t *testing.T) {
+	failing := &fakeBus{failures: 1}
+	bus := &fakeBus{failures: 1}
+	_, restore := mockCommunicator(failing, bus)
+	defer restore()
+
+	p := GetProducer(KAFKA, "someFile")
+	acks := make(chan error, 1)
+	// the message is distributed again after the failure of the new connection
+	p.DistributeAsync(context.Background(), "TEST-TOPIC", "message 1", func(err error) {
+		acks <- err
+	})
+	if err := <-acks; err != nil {
+		t.Errorf("DistributeAsync() ack = %v, want the message distributed again", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		p.DistributeAsync(context.Background(), "TEST-TOPIC", i, nil)
+	}
+	// Close waits for the pending messages
+	p.Close()
+	if len(bus.messages) != 11 || !bus.closed {
+		t.Errorf("Close() messages = %v, want the pending messages distributed before closing", len(bus.messages))
+	}
+
+	if err := p.Distribute("TEST-TOPIC", "message 2"); err != errProducerClosed {
+		t.Errorf("Distribute() error = %v, want %v", err, errProducerClosed)
+	}
+	p.DistributeAsync(context.Background(), "TEST-TOPIC", "message 3", func(err error) {
+		acks <- err
+	})
+	if err := <-acks; err != errProducerClosed {
+		t.Errorf("DistributeAsync() ack = %v, want %v", err, errProducerClosed)
+	}
+}
+
+func TestProducerDistributeAsyncClose(t *testing.T) {
+	bus := &fakeBus{failures: 1000}
+	_, restore := mockCommunicator(bus)
+	defer restore()
+
+	p := GetProducer(KAFKA, "someFile")
+	acks := make(chan error, 1)
+	p.DistributeAsync(context.Background(), "TEST-TOPIC", "message 1", func(err error) {
+		acks <- err
+	})
+	// the retries are given up when the producer is closed
+	p.Close()
+	select {
+	case err := <-acks:
+		if err == nil {
+			t.Errorf("DistributeAsync() ack = nil, want the error of the broker")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("DistributeAsync() ack not called when the producer is closed")
+	}
+	if len(bus.messages) != 0 {
+		t.Errorf("DistributeAsync() messages = %v, want none", bus.messages)
+	}
+}
+
+func TestProducerReconnectClosed(t *testing.T) {
+	bus := &fakeBus{}
+	connections, restore := mockCommunicator(bus, &fakeBus{})
+	defer restore()
+
+	p := GetProducer(KAFKA, "someFile")
+	if err := p.Distribute("TEST-TOPIC", "message 1"); err != nil {
+		t.Fatalf("Distribute() error = %v", err)
+	}
+	p.Close()
+	// no connection is made once the producer is closed
+	if _, err := p.reconnect(bus); err != errProducerClosed {
+		t.Errorf("reconnect() error = %v, want %v", err, errProducerClosed)
+	}
+	if *connections != 1 {
+		t.Errorf("reconnect() connections = %v, want no new connection", *connections)
+	}
+}
+
+func TestProducerRedisStreams(t *testing.T) {
+	server := setUpRedisStreams(t)
+	defer server.Close()
+	defer CloseProducers()
+
+	p := GetProducer(REDISSTREAMS, "")
+	for i := 0; i < 5; i++ {
+		if err := p.Distribute("TEST-TOPIC", i); err != nil {
+			t.Fatalf("Distribute() error = %v", err)
+		}
+	}
+	stream, err := server.Stream("TEST-TOPIC")
+	if err != nil {
+		t.Fatalf("error: failed to get the stream: %v", err)
+	}
+	if len(stream) != 5 {
+		t.Errorf("Distribute() stream length = %v, want %v", len(stream), 5)
+	}
+}
@@ -0,0 +1,461 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package datacommunicator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	// redisStreamsDataField is the field of the stream entry carrying the
+	// encoded message, the other fields carry the propagated trace context
+	redisStreamsDataField = "data"
+	// redisStreamsReadCount is the maximum number of messages read at once
+	redisStreamsReadCount = 10
+	// redisStreamsBlockTime is the time a read waits for new messages, it
+	// bounds the time taken by Remove or Close to stop a consumer
+	redisStreamsBlockTime = time.Second
+	// redisStreamsRetryInterval is the time waited before reading again
+	// after a failure of the Redis Server
+	redisStreamsRetryInterval = 5 * time.Second
+)
+
+// RedisStreamsPacket defines the Redis Streams Message Object. Each Pipe is
+// mapped to the stream of the same name, which is consumed by the consumer
+// group of the same name. As for the KAFKA consumer groups, the subscribers
+// of a Pipe share its messages, each message being processed by only one of
+// them. A message is acknowledged once processed by the callback, the
+// messages not acknowledged within the redelivery timeout are claimed by the
+// other consumers, so the messages are delivered at least once.
+type RedisStreamsPacket struct {
+
+	// All common base function objects are defined in this object.
+	Packet
+
+	// pool is the pool of connections to the Redis Server
+	pool *redis.Pool
+
+	// readers maps the Pipe to the channel closed to stop its consumers
+	readers map[string]chan struct{}
+	mux     sync.Mutex
+
+	// consumer is the name of the consumer in the consumer groups
+	consumer string
+
+	maxLength         int
+	redeliveryTimeout time.Duration
+}
+
+// redisStreamsMessage is the message read from a stream
+type redisStreamsMessage struct {
+	id     string
+	fields map[string]string
+}
+
+// RedisStreamsConnect defines the connection procedure for the Redis Server
+// carrying the streams. TLS is used if the TLS files are configured. The
+// hostname is used as the consumer name, so that the messages received by the
+// consumer and not acknowledged before a restart are processed by it again.
+func RedisStreamsConnect(rp *RedisStreamsPacket, messageQueueConfigPath string) error {
+
+	options := []redis.DialOption{
+		redis.DialConnectTimeout(10 * time.Second),
+	}
+	if mq.RedisCertFile != "" {
+		tls, e := TLS(mq.RedisCertFile, mq.RedisKeyFile, mq.RedisCAFile)
+		if e != nil {
+			log.Error(e.Error())
+			return e
+		}
+		options = append(options, redis.DialUseTLS(true), redis.DialTLSConfig(tls))
+	}
+	address := mq.RedisServerAddress
+	rp.pool = &redis.Pool{
+		MaxIdle:     redisStreamsReadCount,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", address, options...)
+		},
+	}
+
+	conn := rp.pool.Get()
+	defer conn.Close()
+	if _, e := conn.Do("PING"); e != nil {
+		log.Error("error while connecting to the redis server " + address + ": " + e.Error())
+		rp.pool.Close()
+		return e
+	}
+
+	hostname, e := os.Hostname()
+	if e != nil {
+		log.Error(e.Error())
+		return e
+	}
+	rp.consumer = hostname
+	rp.maxLength = mq.RedisStreamMaxLength
+	rp.redeliveryTimeout = time.Duration(mq.RedisRedeliveryTimeout) * time.Second
+	rp.readers = make(map[string]chan struct{})
+	return nil
+}
+
+// Distribute defines the Producer / Publisher role and functionality. The
+// message is encoded using "Encode" API and appended to the stream of the
+// Pipe, the oldest messages of the stream being trimmed.
+func (rp *RedisStreamsPacket) Distribute(pipe string, d interface{}) error {
+	return rp.DistributeWithContext(context.Background(), pipe, d)
+}
+
+// DistributeWithContext is Distribute with the trace context carried by ctx
+// propagated to the consumers in the fields of the message.
+func (rp *RedisStreamsPacket) DistributeWithContext(ctx context.Context, pipe string, d interface{}) error {
+
+	b, e := Encode(d)
+	if e != nil {
+		log.Error(e.Error())
+		return e
+	}
+
+	args := redis.Args{pipe, "MAXLEN", "~", rp.maxLength, "*", redisStreamsDataField, b}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	for key, value := range carrier {
+		args = args.Add(key, value)
+	}
+
+	conn := rp.pool.Get()
+	defer conn.Close()
+	if _, e = conn.Do("XADD", args...); e != nil {
+		log.Error(e.Error())
+		return e
+	}
+	return nil
+}
+
+// Accept function defines the Consumer or Subscriber functionality for Redis
+// Streams. The consumer group of the Pipe is created if required, then the
+// messages are read until the subscription is removed.
+func (rp *RedisStreamsPacket) Accept(pipe string, fn MsgProcess) error {
+	return rp.AcceptWithContext(pipe, func(ctx context.Context, d interface{}) {
+		fn(d)
+	})
+}
+
+// AcceptWithContext is Accept with the callback receiving the trace context
+// propagated in the fields of each message.
+func (rp *RedisStreamsPacket) AcceptWithContext(pipe string, fn MsgProcessWithContext) error {
+
+	if e := rp.createGroup(pipe); e != nil {
+		log.Error(e.Error())
+		return e
+	}
+
+	rp.mux.Lock()
+	done, ok := rp.readers[pipe]
+	if !ok {
+		done = make(chan struct{})
+		rp.readers[pipe] = done
+	}
+	rp.mux.Unlock()
+
+	rp.read(pipe, done, fn)
+	return nil
+}
+
+// createGroup creates the consumer group of the pipe along with the stream,
+// the group receiving all the messages already in the stream
+func (rp *RedisStreamsPacket) createGroup(pipe string) error {
+	conn := rp.pool.Get()
+	defer conn.Close()
+	_, e := conn.Do("XGROUP", "CREATE", pipe, pipe, "0", "MKSTREAM")
+	if e != nil && !strings.HasPrefix(e.Error(), "BUSYGROUP") {
+		return fmt.Errorf("error while creating the consumer group of %v: %v", pipe, e)
+	}
+	return nil
+}
+
+// read would access the messages of the pipe until done is closed. The
+// messages received before a restart and not acknowledged are processed
+// first, then the new messages. The messages of the other consumers not
+// acknowledged within the redelivery timeout are claimed along the way.
+func (rp *RedisStreamsPacket) read(pipe string, done chan struct{}, fn MsgProcessWithContext) {
+
+	lastID := "0"
+	lastClaim := time.Now()
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+
+		if time.Since(lastClaim) >= rp.redeliveryTimeout {
+			rp.claim(pipe, fn)
+			lastClaim = time.Now()
+		}
+
+		msgs, e := rp.readGroup(pipe, lastID)
+		if e != nil {
+			log.Error("error while reading the messages of " + pipe + ": " + e.Error())
+			select {
+			case <-done:
+				return
+			case <-time.After(redisStreamsRetryInterval):
+			}
+			continue
+		}
+		if lastID != ">" {
+			if len(msgs) == 0 {
+				lastID = ">"
+				continue
+			}
+			lastID = msgs[len(msgs)-1].id
+		}
+		for _, m := range msgs {
+			rp.process(pipe, m, fn)
+		}
+	}
+}
+
+// readGroup reads the messages of the pipe after id for the consumer,
+// waiting for the new messages when id is ">"
+func (rp *RedisStreamsPacket) readGroup(pipe, id string) ([]redisStreamsMessage, error) {
+	conn := rp.pool.Get()
+	defer conn.Close()
+	streams, e := redis.Values(conn.Do("XREADGROUP", "GROUP", pipe, rp.consumer,
+		"COUNT", redisStreamsReadCount, "BLOCK", int64(redisStreamsBlockTime/time.Millisecond),
+		"STREAMS", pipe, id))
+	if e == redis.ErrNil {
+		return nil, nil
+	}
+	if e != nil {
+		return nil, e
+	}
+	for _, stream := range streams {
+		s, e := redis.Values(stream, nil)
+		if e != nil {
+			return nil, e
+		}
+		if len(s) != 2 {
+			return nil, fmt.Errorf("unexpected reply of XREADGROUP %v", s)
+		}
+		return parseRedisStreamsEntries(s[1])
+	}
+	return nil, nil
+}
+
+// claim takes over the messages of the pipe not acknowledged within the
+// redelivery timeout, and processes them
+func (rp *RedisStreamsPacket) claim(pipe string, fn MsgProcessWithContext) {
+	conn := rp.pool.Get()
+	defer conn.Close()
+	pending, e := redis.Values(conn.Do("XPENDING", pipe, pipe, "-", "+", redisStreamsReadCount))
+	if e != nil {
+		log.Error("error while getting the pending messages of " + pipe + ": " + e.Error())
+		return
+	}
+
+	minIdle := int64(rp.redeliveryTimeout / time.Millisecond)
+	args := redis.Args{pipe, pipe, rp.consumer, minIdle}
+	claimed := 0
+	for _, p := range pending {
+		var id, consumer string
+		var idle, deliveries int64
+		v, e := redis.Values(p, nil)
+		if e == nil {
+			_, e = redis.Scan(v, &id, &consumer, &idle, &deliveries)
+		}
+		if e != nil {
+			log.Error("error while getting the pending messages of " + pipe + ": " + e.Error())
+			return
+		}
+		if idle >= minIdle {
+			args = args.Add(id)
+			claimed++
+		}
+	}
+	if claimed == 0 {
+		return
+	}
+
+	entries, e := conn.Do("XCLAIM", args...)
+	if e != nil {
+		log.Error("error while claiming the pending messages of " + pipe + ": " + e.Error())
+		return
+	}
+	msgs, e := parseRedisStreamsEntries(entries)
+	if e != nil {
+		log.Error("error while claiming the pending messages of " + pipe + ": " + e.Error())
+		return
+	}
+	for _, m := range msgs {
+		rp.process(pipe, m, fn)
+	}
+}
+
+// process passes the message to the callback and acknowledges it. The
+// messages which can't be decoded are acknowledged to not be redelivered.
+func (rp *RedisStreamsPacket) process(pipe string, m redisStreamsMessage, fn MsgProcessWithContext) {
+	var d interface{}
+	data, ok := m.fields[redisStreamsDataField]
+	if !ok {
+		log.Error("message " + m.id + " of " + pipe + " is not found, it may have been trimmed")
+	} else if e := Decode([]byte(data), &d); e == nil {
+		fn(otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(m.fields)), d)
+	}
+
+	conn := rp.pool.Get()
+	defer conn.Close()
+	if _, e := conn.Do("XACK", pipe, pipe, m.id); e != nil {
+		log.Error("error while acknowledging the message " + m.id + " of " + pipe + ": " + e.Error())
+	}
+}
+
+// parseRedisStreamsEntries parses the stream entries replied by XREADGROUP
+// and XCLAIM. The fields of the entries trimmed from the stream are empty.
+func parseRedisStreamsEntries(reply interface{}) ([]redisStreamsMessage, error) {
+	entries, e := redis.Values(reply, nil)
+	if e != nil {
+		return nil, e
+	}
+	msgs := make([]redisStreamsMessage, 0, len(entries))
+	for _, entry := range entries {
+		v, e := redis.Values(entry, nil)
+		if e != nil {
+			return nil, e
+		}
+		if len(v) != 2 {
+			return nil, fmt.Errorf("unexpected stream entry %v", v)
+		}
+		id, e := redis.String(v[0], nil)
+		if e != nil {
+			return nil, e
+		}
+		fields := map[string]string{}
+		if v[1] != nil {
+			if fields, e = redis.StringMap(v[1], nil); e != nil {
+				return nil, e
+			}
+		}
+		msgs = append(msgs, redisStreamsMessage{id: id, fields: fields})
+	}
+	return msgs, nil
+}
+
+// Replay passes to the callback the messages of the stream added since the
+// given time, up to the last message at the time of the call. The messages
+// are read outside of the consumer group, so they are not acknowledged.
+func (rp *RedisStreamsPacket) Replay(pipe string, since time.Time, fn MsgProcessWithContext) error {
+
+	conn := rp.pool.Get()
+	defer conn.Close()
+	last, e := redis.Values(conn.Do("XREVRANGE", pipe, "+", "-", "COUNT", 1))
+	if e != nil {
+		log.Error(e.Error())
+		return e
+	}
+	if len(last) == 0 {
+		return nil
+	}
+	msgs, e := parseRedisStreamsEntries(last)
+	if e != nil {
+		log.Error(e.Error())
+		return e
+	}
+	lastID := msgs[0].id
+
+	// the IDs of the messages are made of the time they are added
+	start := fmt.Sprintf("%d-0", since.UnixNano()/int64(time.Millisecond))
+	for {
+		entries, e := conn.Do("XRANGE", pipe, start, lastID, "COUNT", redisStreamsReadCount)
+		if e != nil {
+			log.Error(e.Error())
+			return e
+		}
+		msgs, e := parseRedisStreamsEntries(entries)
+		if e != nil {
+			log.Error(e.Error())
+			return e
+		}
+		if len(msgs) == 0 {
+			return nil
+		}
+		for _, m := range msgs {
+			var d interface{}
+			if e := Decode([]byte(m.fields[redisStreamsDataField]), &d); e == nil {
+				fn(otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(m.fields)), d)
+			}
+		}
+		if start, e = nextRedisStreamsID(msgs[len(msgs)-1].id); e != nil {
+			log.Error(e.Error())
+			return e
+		}
+	}
+}
+
+// nextRedisStreamsID returns the ID following the given stream ID
+func nextRedisStreamsID(id string) (string, error) {
+	var ms, seq uint64
+	if _, e := fmt.Sscanf(id, "%d-%d", &ms, &seq); e != nil {
+		return "", fmt.Errorf("invalid stream ID %v: %v", id, e)
+	}
+	return fmt.Sprintf("%d-%d", ms, seq+1), nil
+}
+
+// Get - Not supported for now in Redis Streams from Message Bus side, as for KAFKA
+func (rp *RedisStreamsPacket) Get(pipe string, d interface{}) interface{} {
+
+	return nil
+}
+
+// Remove will just remove the existing subscription. The consumers of the
+// pipe stop after the read in progress.
+func (rp *RedisStreamsPacket) Remove(pipe string) error {
+
+	rp.mux.Lock()
+	defer rp.mux.Unlock()
+	done, ok := rp.readers[pipe]
+	if ok == false {
+		e := fmt.Errorf("specified pipe is not subscribed yet. please check the pipe name passed")
+		return e
+	}
+	close(done)
+	delete(rp.readers, pipe)
+
+	return nil
+}
+
+// Close will stop all the consumers and disconnect the Redis Server
+// connections.
+func (rp *RedisStreamsPacket) Close() {
+
+	rp.mux.Lock()
+	for pipe, done := range rp.readers {
+		close(done)
+		delete(rp.readers, pipe)
+	}
+	rp.mux.Unlock()
+
+	rp.pool.Close()
+}
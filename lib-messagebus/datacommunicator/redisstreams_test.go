@@ -0,0 +1,331 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package datacommunicator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setUpRedisStreams(t *testing.T) *miniredis.Miniredis {
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatal("error: failed to start the redis server for tests:", err)
+	}
+	mq = MQF{
+		MessageBusF: MessageBusF{MessageBusType: RedisStreamsMessageBus},
+		RedisStreamsF: RedisStreamsF{
+			RedisServerAddress:     server.Addr(),
+			RedisStreamMaxLength:   10,
+			RedisRedeliveryTimeout: 60,
+		},
+	}
+	return server
+}
+
+func connectRedisStreams(t *testing.T) *RedisStreamsPacket {
+	rp := new(RedisStreamsPacket)
+	if err := RedisStreamsConnect(rp, ""); err != nil {
+		t.Fatal("error: failed to connect to the redis server for tests:", err)
+	}
+	return rp
+}
+
+// acceptRedisStreams subscribes to the pipe, the messages being sent to the
+// returned channel. The returned function removes the subscription.
+func acceptRedisStreams(t *testing.T, rp *RedisStreamsPacket, pipe string) (chan interface{}, func()) {
+	received := make(chan interface{}, 100)
+	stopped := make(chan error)
+	go func() {
+		stopped <- rp.Accept(pipe, func(d interface{}) {
+			received <- d
+		})
+	}()
+	return received, func() {
+		for {
+			if err := rp.Remove(pipe); err == nil {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if err := <-stopped; err != nil {
+			t.Errorf("Accept() error = %v", err)
+		}
+	}
+}
+
+func receive(t *testing.T, received chan interface{}) interface{} {
+	select {
+	case d := <-received:
+		return d
+	case <-time.After(5 * time.Second):
+		t.Fatal("error: no message received")
+	}
+	return nil
+}
+
+func pendingCount(t *testing.T, server *miniredis.Miniredis, pipe string) int {
+	conn, err := redis.Dial("tcp", server.Addr())
+	if err != nil {
+		t.Fatal("error: failed to connect to the redis server for tests:", err)
+	}
+	defer conn.Close()
+	pending, err := redis.Values(conn.Do("XPENDING", pipe, pipe))
+	if err != nil {
+		t.Fatal("error: failed to get the pending messages:", err)
+	}
+	count, _ := redis.Int(pending[0], nil)
+	return count
+}
+
+func TestRedisStreamsConnect(t *testing.T) {
+	server := setUpRedisStreams(t)
+	rp := connectRedisStreams(t)
+	rp.Close()
+
+	server.Close()
+	if err := RedisStreamsConnect(new(RedisStreamsPacket), ""); err == nil {
+		t.Errorf("RedisStreamsConnect() error = nil, want an error for the stopped server")
+	}
+}
+
+func TestCommunicatorConfiguredType(t *testing.T) {
+	server := setUpRedisStreams(t)
+	defer server.Close()
+
+	bus, err := Communicator(KAFKA, "")
+	if err != nil {
+		t.Fatalf("Communicator() error = %v", err)
+	}
+	defer bus.Close()
+	rp, ok := bus.(*RedisStreamsPacket)
+	if !ok {
+		t.Fatalf("Communicator() = %T, want *RedisStreamsPacket", bus)
+	}
+	if rp.BrokerType != REDISSTREAMS {
+		t.Errorf("Communicator() BrokerType = %v, want %v", rp.BrokerType, REDISSTREAMS)
+	}
+}
+
+func TestRedisStreamsDistributeAccept(t *testing.T) {
+	server := setUpRedisStreams(t)
+	defer server.Close()
+	rp := connectRedisStreams(t)
+	defer rp.Close()
+
+	// messages distributed before the subscription are received as well
+	if err := rp.Distribute("TEST-TOPIC", "message 1"); err != nil {
+		t.Fatalf("Distribute() error = %v", err)
+	}
+	received, remove := acceptRedisStreams(t, rp, "TEST-TOPIC")
+	if err := rp.Distribute("TEST-TOPIC", map[string]interface{}{"Message": "message 2"}); err != nil {
+		t.Fatalf("Distribute() error = %v", err)
+	}
+
+	if got := receive(t, received); got != "message 1" {
+		t.Errorf("Accept() message = %v, want %v", got, "message 1")
+	}
+	got, _ := receive(t, received).(map[string]interface{})
+	if got["Message"] != "message 2" {
+		t.Errorf("Accept() message = %v, want %v", got, "message 2")
+	}
+	remove()
+
+	if count := pendingCount(t, server, "TEST-TOPIC"); count != 0 {
+		t.Errorf("Accept() pending messages = %v, want the messages acknowledged", count)
+	}
+	if err := rp.Remove("TEST-TOPIC"); err == nil {
+		t.Errorf("Remove() error = nil, want an error for the removed subscription")
+	}
+}
+
+func TestRedisStreamsConsumerGroup(t *testing.T) {
+	server := setUpRedisStreams(t)
+	defer server.Close()
+	rp1 := connectRedisStreams(t)
+	defer rp1.Close()
+	rp2 := connectRedisStreams(t)
+	defer rp2.Close()
+	rp2.consumer = "otherHost"
+
+	received1, remove1 := acceptRedisStreams(t, rp1, "TEST-TOPIC")
+	received2, remove2 := acceptRedisStreams(t, rp2, "TEST-TOPIC")
+	for i := 0; i < 5; i++ {
+		if err := rp1.Distribute("TEST-TOPIC", i); err != nil {
+			t.Fatalf("Distribute() error = %v", err)
+		}
+	}
+
+	got := map[interface{}]int{}
+	for len(got) < 5 {
+		select {
+		case d := <-received1:
+			got[d]++
+		case d := <-received2:
+			got[d]++
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Accept() messages = %v, want 5 messages", got)
+		}
+	}
+	remove1()
+	remove2()
+	for d, count := range got {
+		if count != 1 {
+			t.Errorf("Accept() message %v received %v times, want once", d, count)
+		}
+	}
+}
+
+func TestRedisStreamsPendingMessages(t *testing.T) {
+	server := setUpRedisStreams(t)
+	defer server.Close()
+	rp := connectRedisStreams(t)
+	defer rp.Close()
+
+	// the message is read but not acknowledged, as if the consumer stopped
+	// while processing it
+	if err := rp.createGroup("TEST-TOPIC"); err != nil {
+		t.Fatalf("createGroup() error = %v", err)
+	}
+	if err := rp.Distribute("TEST-TOPIC", "message 1"); err != nil {
+		t.Fatalf("Distribute() error = %v", err)
+	}
+	if _, err := rp.readGroup("TEST-TOPIC", ">"); err != nil {
+		t.Fatalf("readGroup() error = %v", err)
+	}
+
+	received, remove := acceptRedisStreams(t, rp, "TEST-TOPIC")
+	if got := receive(t, received); got != "message 1" {
+		t.Errorf("Accept() message = %v, want %v", got, "message 1")
+	}
+	remove()
+	if count := pendingCount(t, server, "TEST-TOPIC"); count != 0 {
+		t.Errorf("Accept() pending messages = %v, want the messages acknowledged", count)
+	}
+}
+
+func TestRedisStreamsMaxLength(t *testing.T) {
+	server := setUpRedisStreams(t)
+	defer server.Close()
+	rp := connectRedisStreams(t)
+	defer rp.Close()
+
+	for i := 0; i < 20; i++ {
+		if err := rp.Distribute("TEST-TOPIC", i); err != nil {
+			t.Fatalf("Distribute() error = %v", err)
+		}
+	}
+	stream, err := server.Stream("TEST-TOPIC")
+	if err != nil {
+		t.Fatalf("error: failed to get the stream: %v", err)
+	}
+	if len(stream) > 10 {
+		t.Errorf("Distribute() stream length = %v, want at most %v", len(stream), 10)
+	}
+}
+
+func TestRedisStreamsTraceContext(t *testing.T) {
+	server := setUpRedisStreams(t)
+	defer server.Close()
+	rp := connectRedisStreams(t)
+
+	defaultPropagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(defaultPropagator)
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	if err := rp.DistributeWithContext(ctx, "TEST-TOPIC", "message 1"); err != nil {
+		t.Fatalf("DistributeWithContext() error = %v", err)
+	}
+
+	received := make(chan trace.SpanContext, 1)
+	stopped := make(chan error)
+	go func() {
+		stopped <- rp.AcceptWithContext("TEST-TOPIC", func(ctx context.Context, d interface{}) {
+			received <- trace.SpanContextFromContext(ctx)
+		})
+	}()
+	select {
+	case got := <-received:
+		if got.TraceID() != traceID || got.SpanID() != spanID || !got.IsRemote() {
+			t.Errorf("AcceptWithContext() span context = %v, want trace %v and span %v", got, traceID, spanID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("error: no message received")
+	}
+	rp.Close()
+	if err := <-stopped; err != nil {
+		t.Errorf("AcceptWithContext() error = %v", err)
+	}
+}
+
+func TestRedisStreamsReplay(t *testing.T) {
+	server := setUpRedisStreams(t)
+	defer server.Close()
+	rp := connectRedisStreams(t)
+	defer rp.Close()
+
+	var replayed []interface{}
+	replay := func(ctx context.Context, d interface{}) {
+		replayed = append(replayed, d)
+	}
+	if err := rp.Replay("TEST-TOPIC", time.Time{}, replay); err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+	if len(replayed) != 0 {
+		t.Errorf("Replay() messages = %v, want none for an empty stream", replayed)
+	}
+
+	for _, id := range []string{"1000-0", "2000-0", "2000-1", "3000-0"} {
+		server.XAdd("TEST-TOPIC", id, []string{redisStreamsDataField, `"message ` + id + `"`})
+	}
+	// the messages are replayed even if already processed by the group
+	received, remove := acceptRedisStreams(t, rp, "TEST-TOPIC")
+	for i := 0; i < 4; i++ {
+		receive(t, received)
+	}
+	remove()
+
+	if err := rp.Replay("TEST-TOPIC", time.Unix(2, 0), replay); err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+	want := []interface{}{"message 2000-0", "message 2000-1", "message 3000-0"}
+	if !reflect.DeepEqual(replayed, want) {
+		t.Errorf("Replay() messages = %v, want %v", replayed, want)
+	}
+}
+
+func TestNextRedisStreamsID(t *testing.T) {
+	if got, err := nextRedisStreamsID("1000-1"); err != nil || got != "1000-2" {
+		t.Errorf("nextRedisStreamsID() = %v, %v, want %v", got, err, "1000-2")
+	}
+	if _, err := nextRedisStreamsID("invalid"); err == nil {
+		t.Errorf("nextRedisStreamsID() error = nil, want an error for an invalid ID")
+	}
+}
@@ -0,0 +1,49 @@
+// (C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+package datacommunicator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHeaderCarrier(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	km := kafka.Message{
+		Headers: []kafka.Header{{Key: "traceparent", Value: []byte("stale")}},
+	}
+	propagator := propagation.TraceContext{}
+	propagator.Inject(ctx, &headerCarrier{headers: &km.Headers})
+	if len(km.Headers) != 1 {
+		t.Fatalf("headerCarrier.Set() headers = %v, want the traceparent header only", km.Headers)
+	}
+	want := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	if got := string(km.Headers[0].Value); got != want {
+		t.Errorf("headerCarrier.Set() traceparent = %v, want %v", got, want)
+	}
+	got := trace.SpanContextFromContext(propagator.Extract(context.Background(), &headerCarrier{headers: &km.Headers}))
+	if got.TraceID() != traceID || got.SpanID() != spanID || !got.IsRemote() {
+		t.Errorf("headerCarrier.Get() span context = %v, want trace %v and span %v", got, traceID, spanID)
+	}
+}
@@ -4,10 +4,13 @@ go 1.13
 
 require (
 	github.com/BurntSushi/toml v0.3.1
+	github.com/alicebob/miniredis/v2 v2.17.0
+	github.com/gomodule/redigo v2.0.0+incompatible
+	github.com/nats-io/nats.go v1.11.0
 	github.com/segmentio/kafka-go v0.3.5
 	github.com/sirupsen/logrus v1.4.2
-	golang.org/x/crypto v0.0.0-20190701094942-4def268fd1a4 // indirect
-	golang.org/x/sys v0.0.0-20191026070338-33540a1f6037 // indirect
+	go.opentelemetry.io/otel v1.7.0
+	go.opentelemetry.io/otel/trace v1.7.0
 )
 
 replace (
@@ -0,0 +1,238 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package persistencemgr
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/gomodule/redigo/redis"
+)
+
+// restoreStagingPrefix prefixes the keys written by a restore before they replace the content of the DB
+const restoreStagingPrefix = "odimra-restore"
+
+// BackupEntry is a key of the DB along with its type and content. The content is the value of a string,
+// the members of a list or a set, the fields of a hash each followed by its value, and the members
+// of a zset each followed by its score.
+type BackupEntry struct {
+	Key    string   `json:"Key"`
+	Type   string   `json:"Type"`
+	Values []string `json:"Values"`
+}
+
+// backupReadCommands are the commands reading the whole content of a key for each of the supported types
+var backupReadCommands = map[string][]interface{}{
+	"string": {"GET"},
+	"list":   {"LRANGE", 0, -1},
+	"set":    {"SMEMBERS"},
+	"hash":   {"HGETALL"},
+	"zset":   {"ZRANGE", 0, -1, "WITHSCORES"},
+}
+
+// backupScript reads all the keys of the DB along with their type and content, as a list of
+// [key, type, values] entries. The script runs atomically, so the result is a point-in-time
+// snapshot of the DB: the other clients wait until all the keys are read.
+var backupScript = redis.NewScript(0, `
+local backup = {}
+for _, key in ipairs(redis.call("KEYS", "*")) do
+	local keyType = redis.call("TYPE", key)["ok"]
+	local values
+	if keyType == "string" then
+		values = {redis.call("GET", key)}
+	elseif keyType == "list" then
+		values = redis.call("LRANGE", key, 0, -1)
+	elseif keyType == "set" then
+		values = redis.call("SMEMBERS", key)
+	elseif keyType == "hash" then
+		values = redis.call("HGETALL", key)
+	elseif keyType == "zset" then
+		values = redis.call("ZRANGE", key, 0, -1, "WITHSCORES")
+	end
+	if values ~= nil and #values > 0 then
+		table.insert(backup, {key, keyType, values})
+	end
+end
+return backup`)
+
+// restoreSwapScript replaces the content of the DB with the keys staged under the prefix ARGV[1]:
+// the other keys are deleted and the staged keys are renamed without the prefix. The script runs
+// atomically, so the other clients see either the DB before or after the restore.
+var restoreSwapScript = redis.NewScript(0, `
+local prefix = ARGV[1]
+for _, key in ipairs(redis.call("KEYS", "*")) do
+	if string.sub(key, 1, #prefix) ~= prefix then
+		redis.call("DEL", key)
+	end
+end
+for _, key in ipairs(redis.call("KEYS", prefix .. "*")) do
+	redis.call("RENAME", key, string.sub(key, #prefix + 1))
+end
+return 0`)
+
+// restoreCleanUpScript deletes the keys staged under the prefix ARGV[1] by a failed restore
+var restoreCleanUpScript = redis.NewScript(0, `
+for _, key in ipairs(redis.call("KEYS", ARGV[1] .. "*")) do
+	redis.call("DEL", key)
+end
+return 0`)
+
+// Backup returns all the keys of the DB along with their content. The keys are read by a
+// single script, so the backup is a point-in-time snapshot of the DB and the writes made
+// while the backup is taken wait until it's complete.
+func (p *RedisConnPool) Backup() ([]BackupEntry, *errors.Error) {
+	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
+	if writePool == nil {
+		return nil, errors.PackError(errors.UndefinedErrorType, "error while trying to backup the DB: WritePool is nil")
+	}
+	conn := writePool.Get()
+	defer conn.Close()
+
+	replies, err := redis.Values(backupScript.Do(conn))
+	if err != nil {
+		return nil, p.backupError(err)
+	}
+	var backup = make([]BackupEntry, 0, len(replies))
+	for _, reply := range replies {
+		fields, err := redis.Values(reply, nil)
+		if err != nil || len(fields) != 3 {
+			return nil, errors.PackError(errors.UndefinedErrorType, "error while trying to backup the DB: unexpected entry ", fmt.Sprint(reply))
+		}
+		var entry BackupEntry
+		entry.Key, _ = redis.String(fields[0], nil)
+		entry.Type, _ = redis.String(fields[1], nil)
+		if entry.Values, err = redis.Strings(fields[2], nil); err != nil {
+			return nil, p.backupError(err)
+		}
+		backup = append(backup, entry)
+	}
+	return backup, nil
+}
+
+// Restore replaces the content of the DB with the entries of a backup. The entries are
+// validated and written in chunks of transactions under a staging prefix, the live keys are
+// left untouched until all the entries are written. The staged keys then replace the content
+// of the DB atomically, so a failed restore leaves the DB as it was before the restore.
+func (p *RedisConnPool) Restore(backup []BackupEntry) *errors.Error {
+	for _, entry := range backup {
+		if err := validateBackupEntry(entry); err != nil {
+			return errors.PackError(errors.UndefinedErrorType, "error while trying to restore the DB: ", err.Error())
+		}
+	}
+	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
+	if writePool == nil {
+		return errors.PackError(errors.UndefinedErrorType, "error while trying to restore the DB: WritePool is nil")
+	}
+	conn := writePool.Get()
+	defer conn.Close()
+
+	prefix := fmt.Sprintf("%s:%d:", restoreStagingPrefix, time.Now().UnixNano())
+	for start := 0; start < len(backup); start += count {
+		end := start + count
+		if end > len(backup) {
+			end = len(backup)
+		}
+		if err := writeBackupEntries(conn, prefix, backup[start:end]); err != nil {
+			restoreCleanUpScript.Do(conn, prefix)
+			return p.restoreError(err)
+		}
+	}
+	if _, err := restoreSwapScript.Do(conn, prefix); err != nil {
+		restoreCleanUpScript.Do(conn, prefix)
+		return p.restoreError(err)
+	}
+	return nil
+}
+
+// writeBackupEntries writes the entries of a backup under the prefix within a single transaction
+func writeBackupEntries(conn redis.Conn, prefix string, entries []BackupEntry) error {
+	conn.Send("MULTI")
+	for _, entry := range entries {
+		key := prefix + entry.Key
+		args := []interface{}{key}
+		switch entry.Type {
+		case "string":
+			conn.Send("SET", key, entry.Values[0])
+			continue
+		case "zset":
+			// ZADD expects the score before the member
+			for i := 0; i < len(entry.Values); i += 2 {
+				args = append(args, entry.Values[i+1], entry.Values[i])
+			}
+			conn.Send("ZADD", args...)
+			continue
+		}
+		for _, value := range entry.Values {
+			args = append(args, value)
+		}
+		switch entry.Type {
+		case "list":
+			conn.Send("RPUSH", args...)
+		case "set":
+			conn.Send("SADD", args...)
+		case "hash":
+			conn.Send("HSET", args...)
+		}
+	}
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return err
+	}
+	// the commands failing within the transaction don't fail the EXEC
+	for _, reply := range replies {
+		if err, ok := reply.(redis.Error); ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateBackupEntry checks the type of the entry and the number of its values
+func validateBackupEntry(entry BackupEntry) error {
+	if _, ok := backupReadCommands[entry.Type]; !ok {
+		return fmt.Errorf("unsupported type %v of the key %v", entry.Type, entry.Key)
+	}
+	if entry.Key == "" || len(entry.Values) == 0 {
+		return fmt.Errorf("missing key or values in the entry of the key %v", entry.Key)
+	}
+	if entry.Type == "string" && len(entry.Values) != 1 {
+		return fmt.Errorf("the key %v of type string has %v values", entry.Key, len(entry.Values))
+	}
+	if (entry.Type == "hash" || entry.Type == "zset") && len(entry.Values)%2 != 0 {
+		return fmt.Errorf("the key %v of type %v has an odd number of values", entry.Key, entry.Type)
+	}
+	return nil
+}
+
+// backupError packs the error raised while reading the DB for the backup
+func (p *RedisConnPool) backupError(err error) *errors.Error {
+	if errs, aye := isDbConnectError(err); aye {
+		atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool)), nil)
+		return errs
+	}
+	return errors.PackError(errors.UndefinedErrorType, "error while trying to backup the DB: ", err.Error())
+}
+
+// restoreError packs the error raised while writing the DB for the restore
+func (p *RedisConnPool) restoreError(err error) *errors.Error {
+	if errs, aye := isDbConnectError(err); aye {
+		atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool)), nil)
+		return errs
+	}
+	return errors.PackError(errors.UndefinedErrorType, "error while trying to restore the DB: ", err.Error())
+}
@@ -0,0 +1,158 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package persistencemgr
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/gomodule/redigo/redis"
+)
+
+// mockRedisConnection returns a connection to the redis DB of the mock config, the backup
+// of the types other than string and zset is only supported by RedisConnPool
+func mockRedisConnection() (*RedisConnPool, *errors.Error) {
+	config, err := GetMockDBConfig()
+	if err != nil {
+		return nil, err
+	}
+	return config.Connection()
+}
+
+func TestBackupRestore(t *testing.T) {
+	c, err := mockRedisConnection()
+	if err != nil {
+		t.Fatal("Error while making redis DB connection:", err)
+	}
+	if errs := c.CleanUpDB(); errs != nil {
+		t.Fatalf("error while trying to flush db: %v", errs.Error())
+	}
+	defer c.CleanUpDB()
+
+	if errs := c.Create("table", "key1", sample{Data1: "Value1"}); errs != nil {
+		t.Fatalf("Error while creating data: %v", errs.Error())
+	}
+	conn := c.WritePool.Get()
+	defer conn.Close()
+	conn.Do("ZADD", "index", 0, "member1", 2.5, "member2")
+	conn.Do("SADD", "set", "member1", "member2")
+	conn.Do("HSET", "hash", "field1", "value1")
+	conn.Do("RPUSH", "list", "item1", "item2")
+
+	backup, errs := c.Backup()
+	if errs != nil {
+		t.Fatalf("Backup() unexpected error = %v", errs.Error())
+	}
+	sort.Slice(backup, func(i, j int) bool { return backup[i].Key < backup[j].Key })
+	for _, entry := range backup {
+		if entry.Type == "set" {
+			sort.Strings(entry.Values)
+		}
+	}
+	want := []BackupEntry{
+		{Key: "hash", Type: "hash", Values: []string{"field1", "value1"}},
+		{Key: "index", Type: "zset", Values: []string{"member1", "0", "member2", "2.5"}},
+		{Key: "list", Type: "list", Values: []string{"item1", "item2"}},
+		{Key: "set", Type: "set", Values: []string{"member1", "member2"}},
+		{Key: "table:key1", Type: "string", Values: []string{`{"Data1":"Value1","Data2":"","Data3":""}`}},
+	}
+	if !reflect.DeepEqual(backup, want) {
+		t.Fatalf("Backup() = %v, want %v", backup, want)
+	}
+
+	if errs := c.Create("table", "key2", sample{Data1: "Value2"}); errs != nil {
+		t.Fatalf("Error while creating data: %v", errs.Error())
+	}
+	if errs := c.Restore(backup); errs != nil {
+		t.Fatalf("Restore() unexpected error = %v", errs.Error())
+	}
+	if _, errs := c.Read("table", "key2"); errs == nil {
+		t.Error("Restore() expected the keys created after the backup to be removed")
+	}
+	restored, errs := c.Backup()
+	if errs != nil {
+		t.Fatalf("Backup() unexpected error = %v", errs.Error())
+	}
+	sort.Slice(restored, func(i, j int) bool { return restored[i].Key < restored[j].Key })
+	for _, entry := range restored {
+		if entry.Type == "set" {
+			sort.Strings(entry.Values)
+		}
+	}
+	if !reflect.DeepEqual(restored, want) {
+		t.Errorf("Restore() restored %v, want %v", restored, want)
+	}
+}
+
+func TestBackupRestore_batches(t *testing.T) {
+	c, err := mockRedisConnection()
+	if err != nil {
+		t.Fatal("Error while making redis DB connection:", err)
+	}
+	if errs := c.CleanUpDB(); errs != nil {
+		t.Fatalf("error while trying to flush db: %v", errs.Error())
+	}
+	defer c.CleanUpDB()
+
+	conn := c.WritePool.Get()
+	defer conn.Close()
+	for i := 0; i <= count; i++ {
+		conn.Do("SET", "key"+strconv.Itoa(i), i)
+	}
+
+	backup, errs := c.Backup()
+	if errs != nil {
+		t.Fatalf("Backup() unexpected error = %v", errs.Error())
+	}
+	if len(backup) != count+1 {
+		t.Fatalf("Backup() got %v keys, want %v", len(backup), count+1)
+	}
+	conn.Do("SET", "extra", "value")
+	if errs := c.Restore(backup); errs != nil {
+		t.Fatalf("Restore() unexpected error = %v", errs.Error())
+	}
+	if size, _ := redis.Int(conn.Do("DBSIZE")); size != count+1 {
+		t.Errorf("Restore() restored %v keys, want %v", size, count+1)
+	}
+}
+
+func TestRestore_invalidBackup(t *testing.T) {
+	c, err := MockDBConnection()
+	if err != nil {
+		t.Fatal("Error while making mock DB connection:", err)
+	}
+	if errs := c.Create("table", "key1", sample{Data1: "Value1"}); errs != nil {
+		t.Fatalf("Error while creating data: %v", errs.Error())
+	}
+	defer c.Delete("table", "key1")
+
+	invalid := [][]BackupEntry{
+		{{Key: "key", Type: "stream", Values: []string{"value"}}},
+		{{Key: "key", Type: "string", Values: []string{"value1", "value2"}}},
+		{{Key: "key", Type: "zset", Values: []string{"member1"}}},
+		{{Key: "", Type: "set", Values: []string{"member1"}}},
+	}
+	for _, backup := range invalid {
+		if errs := c.Restore(backup); errs == nil {
+			t.Errorf("Restore(%v) expected an error", backup)
+		}
+	}
+	if _, errs := c.Read("table", "key1"); errs != nil {
+		t.Error("Restore() of an invalid backup should not change the DB")
+	}
+}
@@ -35,8 +35,8 @@ type Config struct {
 	MasterSet    string
 }
 
-// ConnPool is the established connection
-type ConnPool struct {
+// RedisConnPool is the established connection to a redis DB, it implements ConnPool
+type RedisConnPool struct {
 	ReadPool        *redis.Pool
 	WritePool       *redis.Pool
 	MasterIP        string
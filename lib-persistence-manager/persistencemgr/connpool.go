@@ -0,0 +1,82 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package persistencemgr
+
+import (
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+)
+
+// ConnPool is the connection to a DB used by the services. The data is stored as JSON under
+// the key "table:key", and the indexes are sorted sets of members scored by their value.
+// RedisConnPool stores the data in the configured redis servers and InProcessConnPool, used
+// in the unit tests, in the memory of the service.
+type ConnPool interface {
+	// Create, read, update and delete of the data
+	Create(table, key string, data interface{}) *errors.Error
+	CreateWithExpiry(table, key string, data interface{}, expiry time.Duration) *errors.Error
+	Read(table, key string) (string, *errors.Error)
+	ReadMultiple(table string, keys []string) (map[string]string, *errors.Error)
+	Take(table, key string) (string, *errors.Error)
+	FindOrNull(table, key string) (string, error)
+	Update(table, key string, data interface{}) (string, *errors.Error)
+	UpdateIfMatch(table, key string, data interface{}, ifMatch string) (string, *errors.Error)
+	AddResourceData(table, key string, data interface{}) *errors.Error
+	Delete(table, key string) *errors.Error
+	DeleteIfMatch(table, key, ifMatch string) *errors.Error
+	DeleteServer(key string) *errors.Error
+	CleanUpDB() *errors.Error
+
+	// Scans of the keys
+	GetAllDetails(table string) ([]string, *errors.Error)
+	GetAllMatchingDetails(table, pattern string) ([]string, *errors.Error)
+	GetResourceDetails(key string) (string, *errors.Error)
+	Scan(table, pattern string) ([]string, *errors.Error)
+
+	// Indexes
+	CreateIndex(form map[string]interface{}, uuid string) error
+	UpdateResourceIndex(form map[string]interface{}, uuid string) error
+	CreateTaskIndex(index string, value int64, key string) error
+	GetString(index string, cursor float64, match string, regexFlag bool) ([]string, error)
+	GetStorageList(index string, cursor, match float64, condition string, regexFlag bool) ([]string, error)
+	GetRange(index string, min, max int, regexFlag bool) ([]string, error)
+	GetRangeByScore(index, min, max string) ([]string, error)
+	GetTaskList(index string, min, max int) ([]string, error)
+	Del(index string, k string) error
+	CreateEvtSubscriptionIndex(index string, key interface{}) error
+	GetEvtSubscriptions(index, searchKey string) ([]string, error)
+	DeleteEvtSubscriptions(index, removeKey string) error
+	UpdateEvtSubscriptions(index, subscritionID string, key interface{}) error
+	CreateDeviceSubscriptionIndex(index, hostIP, location string, originResources []string) error
+	GetDeviceSubscription(index string, match string) ([]string, error)
+	DeleteDeviceSubscription(index, hostIP string) error
+	UpdateDeviceSubscription(index, hostIP, location string, originResources []string) error
+
+	// Transactions
+	Transaction(key string, cb func(string) error) *errors.Error
+
+	// Locks shared by the instances of the services
+	TryLock(name string, expiry time.Duration) (string, *errors.Error)
+	Unlock(name, token string)
+
+	// Maintenance of the DB
+	Ping() error
+	Backup() ([]BackupEntry, *errors.Error)
+	Restore(backup []BackupEntry) *errors.Error
+	GetSchemaVersion(table string) (SchemaVersion, *errors.Error)
+	GetMigrationStatus(migrations []Migration) ([]MigrationStatus, *errors.Error)
+	RunMigrations(migrations []Migration) ([]MigrationResult, *errors.Error)
+}
@@ -0,0 +1,820 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package persistencemgr
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+)
+
+// inProcessDBConnPools are the in-process DBs used in place of the InMemory and OnDisk redis DBs
+var inProcessDBConnPools = map[DbType]*InProcessConnPool{
+	InMemory: NewInProcessConnPool(),
+	OnDisk:   NewInProcessConnPool(),
+}
+
+// InProcessConnPool is a DB kept in the memory of the service, it implements ConnPool.
+// It follows the behavior of RedisConnPool, so that it can replace it in the unit tests.
+// The data is lost when the service stops and it isn't shared with the other services,
+// so it can't be selected in the odimra configuration.
+type InProcessConnPool struct {
+	mux sync.RWMutex
+	// data holds the data stored under the key "table:key"
+	data map[string]string
+	// expires holds the expiry of the data created with CreateWithExpiry
+	expires map[string]time.Time
+	// indexes holds the score of each member of the indexes
+	indexes map[string]map[string]float64
+	// migrationMux is the lock taken while running the migrations
+	migrationMux sync.Mutex
+	// locks holds the locks taken with TryLock, keyed by name
+	locks map[string]inProcessLock
+}
+
+// indexMemberScore is a member of an index along with its score
+type indexMemberScore struct {
+	member string
+	score  float64
+}
+
+// NewInProcessConnPool returns an empty in-process DB
+func NewInProcessConnPool() *InProcessConnPool {
+	return &InProcessConnPool{
+		data:    make(map[string]string),
+		expires: make(map[string]time.Time),
+		indexes: make(map[string]map[string]float64),
+		locks:   make(map[string]inProcessLock),
+	}
+}
+
+// getInProcessDBConnection returns the in-process DB used in place of the InMemory or OnDisk DB
+func getInProcessDBConnection(dbFlag DbType) (ConnPool, *errors.Error) {
+	connPool, ok := inProcessDBConnPools[dbFlag]
+	if !ok {
+		return nil, errors.PackError(errors.UndefinedErrorType, "error invalid db type selection")
+	}
+	return connPool, nil
+}
+
+// Create will make an entry into the database with the given values, it fails if the key already exists
+func (p *InProcessConnPool) Create(table, key string, data interface{}) *errors.Error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.create(table, key, data, time.Time{})
+}
+
+// CreateWithExpiry makes an entry into the database like Create, the entry is removed once the expiry elapsed
+func (p *InProcessConnPool) CreateWithExpiry(table, key string, data interface{}, expiry time.Duration) *errors.Error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.create(table, key, data, time.Now().Add(expiry))
+}
+
+// create stores the data of a new key, the data expires at expires unless it is zero.
+// The caller must hold the write lock.
+func (p *InProcessConnPool) create(table, key string, data interface{}, expires time.Time) *errors.Error {
+	saveID := table + ":" + key
+	if _, ok := p.get(saveID); ok {
+		return errors.PackError(errors.DBKeyAlreadyExist, "error: data with key ", key, " already exists")
+	}
+	jsondata, err := json.Marshal(data)
+	if err != nil {
+		return errors.PackError(errors.UndefinedErrorType, "Write to DB in json form failed: "+err.Error())
+	}
+	p.set(saveID, string(jsondata))
+	if !expires.IsZero() {
+		p.expires[saveID] = expires
+	}
+	return nil
+}
+
+// Read is for getting singular data
+func (p *InProcessConnPool) Read(table, key string) (string, *errors.Error) {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	value, ok := p.get(table + ":" + key)
+	if !ok {
+		return "", errors.PackError(errors.DBKeyNotFound, "no data with the with key ", key, " found")
+	}
+	return value, nil
+}
+
+// ReadMultiple reads the data of the keys of the table, the keys which don't exist are left out
+func (p *InProcessConnPool) ReadMultiple(table string, keys []string) (map[string]string, *errors.Error) {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	result := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if value, ok := p.get(table + ":" + key); ok {
+			result[key] = value
+		}
+	}
+	return result, nil
+}
+
+// Take returns the data of the key and deletes it, so that the data is returned to only
+// one of the concurrent callers
+func (p *InProcessConnPool) Take(table, key string) (string, *errors.Error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	saveID := table + ":" + key
+	value, ok := p.get(saveID)
+	if !ok {
+		return "", errors.PackError(errors.DBKeyNotFound, "no data with the with key ", key, " found")
+	}
+	p.remove(saveID)
+	return value, nil
+}
+
+// get returns the data of the key, the expired data is not found. The caller must hold the lock.
+func (p *InProcessConnPool) get(saveID string) (string, bool) {
+	value, ok := p.data[saveID]
+	if !ok {
+		return "", false
+	}
+	if expires, ok := p.expires[saveID]; ok && !time.Now().Before(expires) {
+		return "", false
+	}
+	return value, true
+}
+
+// set stores the data of the key without expiry, the caller must hold the write lock
+func (p *InProcessConnPool) set(saveID, value string) {
+	p.data[saveID] = value
+	delete(p.expires, saveID)
+}
+
+// remove deletes the data of the key, the caller must hold the write lock
+func (p *InProcessConnPool) remove(saveID string) {
+	delete(p.data, saveID)
+	delete(p.expires, saveID)
+}
+
+// FindOrNull is a wrapper for Read function. If requested asset doesn't exist errors.DBKeyNotFound error returned by Read is converted to nil
+func (p *InProcessConnPool) FindOrNull(table, key string) (string, error) {
+	r, e := p.Read(table, key)
+	if e != nil {
+		switch e.ErrNo() {
+		case errors.DBKeyNotFound:
+			return "", nil
+		default:
+			return "", e
+		}
+	}
+	return r, nil
+}
+
+// Update replaces the data of an existing key
+func (p *InProcessConnPool) Update(table, key string, data interface{}) (string, *errors.Error) {
+	return p.UpdateIfMatch(table, key, data, "")
+}
+
+// UpdateIfMatch updates the data only if the ETag of the stored data matches ifMatch.
+// An empty ifMatch behaves exactly like Update.
+func (p *InProcessConnPool) UpdateIfMatch(table, key string, data interface{}, ifMatch string) (string, *errors.Error) {
+	jsondata, err := json.Marshal(data)
+	if err != nil {
+		return "", errors.PackError(errors.UndefinedErrorType, "Write to DB in json form failed: "+err.Error())
+	}
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	saveID := table + ":" + key
+	if err := p.checkIfMatch(saveID, key, ifMatch); err != nil {
+		return "", err
+	}
+	p.set(saveID, string(jsondata))
+	return saveID, nil
+}
+
+// AddResourceData will make an entry into the database with the given values, the existing data is replaced
+func (p *InProcessConnPool) AddResourceData(table, key string, data interface{}) *errors.Error {
+	jsondata, err := json.Marshal(data)
+	if err != nil {
+		return errors.PackError(errors.UndefinedErrorType, "Write to DB in json form failed: "+err.Error())
+	}
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.set(table+":"+key, string(jsondata))
+	return nil
+}
+
+// Delete removes the data of an existing key
+func (p *InProcessConnPool) Delete(table, key string) *errors.Error {
+	return p.DeleteIfMatch(table, key, "")
+}
+
+// DeleteIfMatch deletes the data only if the ETag of the stored data matches ifMatch.
+// An empty ifMatch behaves exactly like Delete.
+func (p *InProcessConnPool) DeleteIfMatch(table, key, ifMatch string) *errors.Error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	saveID := table + ":" + key
+	if err := p.checkIfMatch(saveID, key, ifMatch); err != nil {
+		return err
+	}
+	p.remove(saveID)
+	return nil
+}
+
+// checkIfMatch checks that the key exists and that the ETag of its data matches ifMatch,
+// the caller must hold the write lock
+func (p *InProcessConnPool) checkIfMatch(saveID, key, ifMatch string) *errors.Error {
+	value, ok := p.get(saveID)
+	if !ok {
+		return errors.PackError(errors.DBKeyNotFound, "error: data with key ", key, " does not exist")
+	}
+	if !MatchETag(ifMatch, ETag(value)) {
+		return errors.PackError(errors.PreconditionFailed, "error: ETag of the data with key ", saveID, " does not match ", ifMatch)
+	}
+	return nil
+}
+
+// DeleteServer deletes all the keys, data and indexes, matching the glob style pattern
+func (p *InProcessConnPool) DeleteServer(key string) *errors.Error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	for saveID := range p.data {
+		if globMatch(key, saveID) {
+			p.remove(saveID)
+		}
+	}
+	for index := range p.indexes {
+		if globMatch(key, index) {
+			delete(p.indexes, index)
+		}
+	}
+	return nil
+}
+
+// CleanUpDB will delete all database entries
+func (p *InProcessConnPool) CleanUpDB() *errors.Error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.data = make(map[string]string)
+	p.expires = make(map[string]time.Time)
+	p.indexes = make(map[string]map[string]float64)
+	return nil
+}
+
+// GetAllDetails will fetch all the keys of the table
+func (p *InProcessConnPool) GetAllDetails(table string) ([]string, *errors.Error) {
+	return p.matchingIDs(table, "*"), nil
+}
+
+// GetAllMatchingDetails will fetch all the keys of the table which contain the pattern
+func (p *InProcessConnPool) GetAllMatchingDetails(table, pattern string) ([]string, *errors.Error) {
+	return p.matchingIDs(table, "*"+pattern+"*"), nil
+}
+
+// matchingIDs returns the keys of the table matching the glob style pattern, without the table prefix
+func (p *InProcessConnPool) matchingIDs(table, pattern string) []string {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	var IDs []string
+	for _, saveID := range p.matchingKeys(table + ":" + pattern) {
+		IDs = append(IDs, strings.TrimPrefix(saveID, table+":"))
+	}
+	return IDs
+}
+
+// matchingKeys returns the sorted keys of the data matching the glob style pattern,
+// the caller must hold the lock
+func (p *InProcessConnPool) matchingKeys(pattern string) []string {
+	var keys []string
+	for saveID := range p.data {
+		if _, ok := p.get(saveID); ok && globMatch(pattern, saveID) {
+			keys = append(keys, saveID)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// GetResourceDetails will fetch the data of the key ending with the given key
+func (p *InProcessConnPool) GetResourceDetails(key string) (string, *errors.Error) {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	keys := p.matchingKeys("*" + key)
+	if len(keys) == 0 {
+		return "", errors.PackError(errors.DBKeyNotFound, "no data with the with key ", key, " found")
+	}
+	return p.data[keys[len(keys)-1]], nil
+}
+
+// Scan will fetch the data of all the keys of the table which match the glob style pattern
+func (p *InProcessConnPool) Scan(table, pattern string) ([]string, *errors.Error) {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	values := []string{}
+	for _, saveID := range p.matchingKeys(table + ":" + pattern) {
+		values = append(values, p.data[saveID])
+	}
+	return values, nil
+}
+
+// CreateIndex is used to create and save secondary index
+func (p *InProcessConnPool) CreateIndex(form map[string]interface{}, uuid string) error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	for index, value := range form {
+		member, score, err := indexMember(value, uuid)
+		if err != nil {
+			return err
+		}
+		switch s := score.(type) {
+		case int:
+			p.addIndexMember(index, member, float64(s))
+		case float64:
+			p.addIndexMember(index, member, s)
+		}
+	}
+	return nil
+}
+
+// UpdateResourceIndex is used to update the resource inforamtion which is indexed
+func (p *InProcessConnPool) UpdateResourceIndex(form map[string]interface{}, uuid string) error {
+	for index := range form {
+		err := p.Del(index, uuid)
+		if (err != nil) && (err.Error() != "no data with ID found") {
+			return fmt.Errorf("Error while updating index: %v", err)
+		}
+	}
+	err := p.CreateIndex(form, uuid)
+	if err != nil {
+		return fmt.Errorf("Error while updating index: %v", err)
+	}
+	return nil
+}
+
+// CreateTaskIndex is used to create secondary indexing for task service
+func (p *InProcessConnPool) CreateTaskIndex(index string, value int64, key string) error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.addIndexMember(index, key, float64(value))
+	return nil
+}
+
+// GetString is used to retrive index values of type string
+func (p *InProcessConnPool) GetString(index string, cursor float64, match string, regexFlag bool) ([]string, error) {
+	var getList []string
+	for _, member := range p.indexMembers(index, strings.ToLower(match)) {
+		if regexFlag {
+			getList = append(getList, member.member)
+		} else if values := strings.SplitN(member.member, "::", 2); len(values) > 1 {
+			getList = append(getList, values[1])
+		}
+	}
+	return getList, nil
+}
+
+// GetStorageList is used to storage list of capacity
+func (p *InProcessConnPool) GetStorageList(index string, cursor, match float64, condition string, regexFlag bool) ([]string, error) {
+	var getList []string
+	for _, member := range p.indexMembers(index, "*") {
+		getList = append(getList, member.member)
+	}
+	if regexFlag {
+		return getList, nil
+	}
+	return filterStorageList(getList, match, condition)
+}
+
+// GetRange is used to range over float type values
+func (p *InProcessConnPool) GetRange(index string, min, max int, regexFlag bool) ([]string, error) {
+	var getList = []string{}
+	for _, member := range p.indexMembers(index, "*") {
+		if member.score < float64(min) || member.score > float64(max) {
+			continue
+		}
+		if regexFlag {
+			getList = append(getList, member.member)
+		} else if values := strings.SplitN(member.member, "::", 2); len(values) > 1 {
+			getList = append(getList, values[1])
+		}
+	}
+	return getList, nil
+}
+
+// GetRangeByScore is used to range over float type values using the score range syntax of redis
+func (p *InProcessConnPool) GetRangeByScore(index, min, max string) ([]string, error) {
+	minScore, minExclusive, err := parseScoreBound(min)
+	if err != nil {
+		return nil, fmt.Errorf("error while trying to get data: " + err.Error())
+	}
+	maxScore, maxExclusive, err := parseScoreBound(max)
+	if err != nil {
+		return nil, fmt.Errorf("error while trying to get data: " + err.Error())
+	}
+	var getList = []string{}
+	for _, member := range p.indexMembers(index, "*") {
+		if member.score < minScore || (minExclusive && member.score == minScore) {
+			continue
+		}
+		if member.score > maxScore || (maxExclusive && member.score == maxScore) {
+			continue
+		}
+		if values := strings.SplitN(member.member, "::", 2); len(values) > 1 {
+			getList = append(getList, values[1])
+		}
+	}
+	return getList, nil
+}
+
+// GetTaskList returns the members of the index between the min and max ranks, in the increasing order of
+// the scores. Negative ranks are counted from the end of the index, as in the ZRANGE command of redis.
+func (p *InProcessConnPool) GetTaskList(index string, min, max int) ([]string, error) {
+	members := p.indexMembers(index, "*")
+	if min < 0 {
+		min += len(members)
+	}
+	if max < 0 {
+		max += len(members)
+	}
+	if min < 0 {
+		min = 0
+	}
+	if max >= len(members) {
+		max = len(members) - 1
+	}
+	data := []string{}
+	for i := min; i <= max; i++ {
+		data = append(data, members[i].member)
+	}
+	return data, nil
+}
+
+// Del is used to delete the members of the index ending with the key
+func (p *InProcessConnPool) Del(index string, k string) error {
+	members := p.indexMembers(index, "*"+k)
+	if len(members) < 1 {
+		return fmt.Errorf("no data with ID found")
+	}
+	p.removeIndexMembers(index, members)
+	return nil
+}
+
+// CreateEvtSubscriptionIndex is used to create and save secondary index
+func (p *InProcessConnPool) CreateEvtSubscriptionIndex(index string, key interface{}) error {
+	matchKey := strings.Replace(key.(string), "[", "\\[", -1)
+	matchKey = strings.Replace(matchKey, "]", "\\]", -1)
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if len(p.matchingIndexMembers(index, matchKey)) > 0 {
+		return fmt.Errorf("Data Already Exist for the index: %v", index)
+	}
+	p.addIndexMember(index, key.(string), 0)
+	return nil
+}
+
+// GetEvtSubscriptions is for to get subscription details
+func (p *InProcessConnPool) GetEvtSubscriptions(index, searchKey string) ([]string, error) {
+	var getList []string
+	for _, member := range p.indexMembers(index, searchKey) {
+		getList = append(getList, member.member)
+	}
+	return getList, nil
+}
+
+// DeleteEvtSubscriptions is for to Delete subscription details
+func (p *InProcessConnPool) DeleteEvtSubscriptions(index, removeKey string) error {
+	members := p.indexMembers(index, removeKey)
+	if len(members) < 1 {
+		return fmt.Errorf("No data found for the key: %v", removeKey)
+	}
+	p.removeIndexMembers(index, members)
+	return nil
+}
+
+// UpdateEvtSubscriptions is for to Update subscription details
+func (p *InProcessConnPool) UpdateEvtSubscriptions(index, subscritionID string, key interface{}) error {
+	err := p.DeleteEvtSubscriptions(index, subscritionID)
+	if err != nil {
+		return err
+	}
+	err = p.CreateEvtSubscriptionIndex(index, key)
+	if err != nil {
+		return fmt.Errorf("Error while updating subscriptions")
+	}
+	return nil
+}
+
+// CreateDeviceSubscriptionIndex is used to create and save secondary index
+func (p *InProcessConnPool) CreateDeviceSubscriptionIndex(index, hostIP, location string, originResources []string) error {
+	originResourceStr := "[" + strings.Join(originResources, " ") + "]"
+	key := hostIP + "::" + location + "::" + originResourceStr
+	// escape the square brackets before matching
+	searchKey := strings.Replace(key, "[", "\\[", -1)
+	searchKey = strings.Replace(searchKey, "]", "\\]", -1)
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if len(p.matchingIndexMembers(index, searchKey)) > 0 {
+		return fmt.Errorf("Data Already Exist for the index: %v", index)
+	}
+	p.addIndexMember(index, key, 0)
+	return nil
+}
+
+// GetDeviceSubscription is used to retrive index values of type string
+func (p *InProcessConnPool) GetDeviceSubscription(index string, match string) ([]string, error) {
+	data := []string{}
+	for _, member := range p.indexMembers(index, match) {
+		data = append(data, member.member)
+	}
+	if len(data) < 1 {
+		return data, fmt.Errorf("No data found for the key: %v", match)
+	}
+	return data, nil
+}
+
+// DeleteDeviceSubscription is for to Delete subscription details of Device
+func (p *InProcessConnPool) DeleteDeviceSubscription(index, hostIP string) error {
+	members := p.indexMembers(index, hostIP+"*")
+	if len(members) < 1 {
+		return fmt.Errorf("No data found for the key: %v", hostIP+"*")
+	}
+	p.removeIndexMembers(index, members)
+	return nil
+}
+
+// UpdateDeviceSubscription is for to Update subscription details
+func (p *InProcessConnPool) UpdateDeviceSubscription(index, hostIP, location string, originResources []string) error {
+	_, err := p.GetDeviceSubscription(index, hostIP+"[^0-9]*")
+	if err != nil {
+		return err
+	}
+	// host ip will be unique on each index in subscription of device
+	// so there will be only one data
+	err = p.DeleteDeviceSubscription(index, hostIP+"[^0-9]")
+	if err != nil {
+		return err
+	}
+	err = p.CreateDeviceSubscriptionIndex(index, hostIP, location, originResources)
+	if err != nil {
+		return fmt.Errorf("Error while updating subscriptions")
+	}
+	return nil
+}
+
+// addIndexMember adds the member to the index or updates its score, the caller must hold the write lock
+func (p *InProcessConnPool) addIndexMember(index, member string, score float64) {
+	members, ok := p.indexes[index]
+	if !ok {
+		members = make(map[string]float64)
+		p.indexes[index] = members
+	}
+	members[member] = score
+}
+
+// removeIndexMembers removes the members from the index, the index is removed along with its last member
+func (p *InProcessConnPool) removeIndexMembers(index string, members []indexMemberScore) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	for _, member := range members {
+		delete(p.indexes[index], member.member)
+	}
+	if len(p.indexes[index]) == 0 {
+		delete(p.indexes, index)
+	}
+}
+
+// indexMembers returns the members of the index matching the glob style pattern
+func (p *InProcessConnPool) indexMembers(index, pattern string) []indexMemberScore {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	return p.matchingIndexMembers(index, pattern)
+}
+
+// matchingIndexMembers returns the members of the index matching the glob style pattern, in the
+// increasing order of the scores and then of the members, the caller must hold the lock
+func (p *InProcessConnPool) matchingIndexMembers(index, pattern string) []indexMemberScore {
+	var members []indexMemberScore
+	for member, score := range p.indexes[index] {
+		if globMatch(pattern, member) {
+			members = append(members, indexMemberScore{member: member, score: score})
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].score != members[j].score {
+			return members[i].score < members[j].score
+		}
+		return members[i].member < members[j].member
+	})
+	return members
+}
+
+// Transaction calls cb with the key, as the RedisConnPool does within its optimistic lock
+func (p *InProcessConnPool) Transaction(key string, cb func(string) error) *errors.Error {
+	if err := cb(key); err != nil {
+		return errors.PackError(errors.UndefinedErrorType, err)
+	}
+	return nil
+}
+
+// Ping will check the DB connection health, the in-process DB is always reachable
+func (p *InProcessConnPool) Ping() error {
+	return nil
+}
+
+// Backup returns all the keys of the DB along with their content, the data are of the string type
+// and the indexes of the zset type as in the backups of the redis DB
+func (p *InProcessConnPool) Backup() ([]BackupEntry, *errors.Error) {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	backup := []BackupEntry{}
+	for key := range p.data {
+		value, ok := p.get(key)
+		if !ok {
+			continue
+		}
+		backup = append(backup, BackupEntry{Key: key, Type: "string", Values: []string{value}})
+	}
+	for index, members := range p.indexes {
+		entry := BackupEntry{Key: index, Type: "zset"}
+		for member, score := range members {
+			entry.Values = append(entry.Values, member, strconv.FormatFloat(score, 'f', -1, 64))
+		}
+		backup = append(backup, entry)
+	}
+	sort.Slice(backup, func(i, j int) bool {
+		return backup[i].Key < backup[j].Key
+	})
+	return backup, nil
+}
+
+// Restore replaces the content of the DB with the entries of a backup, only the string
+// and zset types are supported
+func (p *InProcessConnPool) Restore(backup []BackupEntry) *errors.Error {
+	data := make(map[string]string)
+	indexes := make(map[string]map[string]float64)
+	for _, entry := range backup {
+		if err := validateBackupEntry(entry); err != nil {
+			return errors.PackError(errors.UndefinedErrorType, "error while trying to restore the DB: ", err.Error())
+		}
+		switch entry.Type {
+		case "string":
+			data[entry.Key] = entry.Values[0]
+		case "zset":
+			members := make(map[string]float64)
+			for i := 0; i < len(entry.Values); i += 2 {
+				score, err := strconv.ParseFloat(entry.Values[i+1], 64)
+				if err != nil {
+					return errors.PackError(errors.UndefinedErrorType, "error while trying to restore the DB: invalid score of the key ", entry.Key, ": ", err.Error())
+				}
+				members[entry.Values[i]] = score
+			}
+			indexes[entry.Key] = members
+		default:
+			return errors.PackError(errors.UndefinedErrorType, "error while trying to restore the DB: the type ", entry.Type, " of the key ", entry.Key, " is not supported by the in-process DB")
+		}
+	}
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.data = data
+	p.expires = make(map[string]time.Time)
+	p.indexes = indexes
+	return nil
+}
+
+// GetSchemaVersion returns the schema version recorded for a table, the version is 0 when
+// no migration of the table is done yet
+func (p *InProcessConnPool) GetSchemaVersion(table string) (SchemaVersion, *errors.Error) {
+	return getSchemaVersion(p, table)
+}
+
+// GetMigrationStatus returns the status of each table of the migrations, in the order of the tables
+// in the migrations
+func (p *InProcessConnPool) GetMigrationStatus(migrations []Migration) ([]MigrationStatus, *errors.Error) {
+	return getMigrationStatus(p, migrations)
+}
+
+// RunMigrations runs the migrations newer than the schema version of their table, the concurrent
+// calls wait until the running migrations are done
+func (p *InProcessConnPool) RunMigrations(migrations []Migration) ([]MigrationResult, *errors.Error) {
+	return runMigrations(p, p, migrations)
+}
+
+func (p *InProcessConnPool) acquireMigrationLock() (string, *errors.Error) {
+	p.migrationMux.Lock()
+	return "", nil
+}
+
+func (p *InProcessConnPool) refreshMigrationLock(token string) *errors.Error {
+	return nil
+}
+
+func (p *InProcessConnPool) releaseMigrationLock(token string) {
+	p.migrationMux.Unlock()
+}
+
+// parseScoreBound parses a min or max score of an index range as in the ZRANGEBYSCORE command of redis,
+// the score is exclusive when it is prefixed by "(" and it can be -inf or +inf
+func parseScoreBound(bound string) (float64, bool, error) {
+	exclusive := strings.HasPrefix(bound, "(")
+	bound = strings.TrimPrefix(bound, "(")
+	switch bound {
+	case "-inf":
+		return math.Inf(-1), exclusive, nil
+	case "+inf", "inf":
+		return math.Inf(1), exclusive, nil
+	}
+	score, err := strconv.ParseFloat(bound, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("min or max is not a float")
+	}
+	return score, exclusive, nil
+}
+
+// globMatch reports whether the string matches the glob style pattern as in the MATCH option
+// of the SCAN commands of redis: * and ? match any characters and any character, [...] matches
+// a character of the set, which can contain ranges and be negated with ^, and \ escapes a character.
+func globMatch(pattern, str string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 1 && pattern[1] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(str); i++ {
+				if globMatch(pattern[1:], str[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(str) == 0 {
+				return false
+			}
+			str = str[1:]
+		case '[':
+			if len(str) == 0 {
+				return false
+			}
+			pattern = pattern[1:]
+			not := len(pattern) > 0 && pattern[0] == '^'
+			if not {
+				pattern = pattern[1:]
+			}
+			match := false
+			for len(pattern) > 0 && pattern[0] != ']' {
+				switch {
+				case pattern[0] == '\\' && len(pattern) > 1:
+					pattern = pattern[1:]
+					match = match || pattern[0] == str[0]
+				case len(pattern) > 2 && pattern[1] == '-':
+					start, end := pattern[0], pattern[2]
+					if start > end {
+						start, end = end, start
+					}
+					match = match || (str[0] >= start && str[0] <= end)
+					pattern = pattern[2:]
+				default:
+					match = match || pattern[0] == str[0]
+				}
+				pattern = pattern[1:]
+			}
+			if match == not {
+				return false
+			}
+			str = str[1:]
+			if len(pattern) == 0 {
+				// the set isn't closed, it ends the pattern
+				return len(str) == 0
+			}
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(str) == 0 || pattern[0] != str[0] {
+				return false
+			}
+			str = str[1:]
+		}
+		pattern = pattern[1:]
+	}
+	return len(str) == 0
+}
@@ -0,0 +1,421 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package persistencemgr
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+)
+
+func TestInProcessConnPool_CRUD(t *testing.T) {
+	c := NewInProcessConnPool()
+	if err := c.Create("table", "key", sample{Data1: "Value1"}); err != nil {
+		t.Fatalf("Create() unexpected error = %v", err.Error())
+	}
+	if err := c.Create("table", "key", sample{Data1: "Value1"}); err == nil || err.ErrNo() != errors.DBKeyAlreadyExist {
+		t.Errorf("Create() of an existing key error = %v, want DBKeyAlreadyExist", err)
+	}
+	data, err := c.Read("table", "key")
+	if err != nil {
+		t.Fatalf("Read() unexpected error = %v", err.Error())
+	}
+	if want := `{"Data1":"Value1","Data2":"","Data3":""}`; data != want {
+		t.Errorf("Read() = %v, want %v", data, want)
+	}
+	if _, err := c.Update("table", "key", sample{Data1: "Value2"}); err != nil {
+		t.Errorf("Update() unexpected error = %v", err.Error())
+	}
+	if _, err := c.Update("table", "missing", sample{}); err == nil || err.ErrNo() != errors.DBKeyNotFound {
+		t.Errorf("Update() of a missing key error = %v, want DBKeyNotFound", err)
+	}
+	if _, err := c.UpdateIfMatch("table", "key", sample{Data1: "Value3"}, ETag(data)); err == nil || err.ErrNo() != errors.PreconditionFailed {
+		t.Errorf("UpdateIfMatch() with an outdated ETag error = %v, want PreconditionFailed", err)
+	}
+	data, _ = c.Read("table", "key")
+	if _, err := c.UpdateIfMatch("table", "key", sample{Data1: "Value3"}, ETag(data)); err != nil {
+		t.Errorf("UpdateIfMatch() unexpected error = %v", err.Error())
+	}
+	values, err := c.ReadMultiple("table", []string{"key", "missing"})
+	if err != nil {
+		t.Errorf("ReadMultiple() unexpected error = %v", err.Error())
+	}
+	if want := map[string]string{"key": `{"Data1":"Value3","Data2":"","Data3":""}`}; !reflect.DeepEqual(values, want) {
+		t.Errorf("ReadMultiple() = %v, want %v", values, want)
+	}
+	if err := c.Delete("table", "key"); err != nil {
+		t.Errorf("Delete() unexpected error = %v", err.Error())
+	}
+	if _, err := c.Read("table", "key"); err == nil || err.ErrNo() != errors.DBKeyNotFound {
+		t.Errorf("Read() of a deleted key error = %v, want DBKeyNotFound", err)
+	}
+	if value, err := c.FindOrNull("table", "key"); value != "" || err != nil {
+		t.Errorf("FindOrNull() = %v, %v, want no data and no error", value, err)
+	}
+	if err := c.Delete("table", "key"); err == nil || err.ErrNo() != errors.DBKeyNotFound {
+		t.Errorf("Delete() of a missing key error = %v, want DBKeyNotFound", err)
+	}
+}
+
+func TestInProcessConnPool_TakeAndExpiry(t *testing.T) {
+	c := NewInProcessConnPool()
+	if err := c.CreateWithExpiry("table", "key", "value", time.Hour); err != nil {
+		t.Fatalf("CreateWithExpiry() unexpected error = %v", err.Error())
+	}
+	if err := c.CreateWithExpiry("table", "key", "value", time.Hour); err == nil || err.ErrNo() != errors.DBKeyAlreadyExist {
+		t.Errorf("CreateWithExpiry() of an existing key error = %v, want DBKeyAlreadyExist", err)
+	}
+	var wg sync.WaitGroup
+	var mux sync.Mutex
+	taken := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if value, err := c.Take("table", "key"); err == nil && value == `"value"` {
+				mux.Lock()
+				taken++
+				mux.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if taken != 1 {
+		t.Errorf("Take() returned the data %v times, want once", taken)
+	}
+
+	c.CreateWithExpiry("table", "expired", "value", -time.Second)
+	if _, err := c.Read("table", "expired"); err == nil || err.ErrNo() != errors.DBKeyNotFound {
+		t.Errorf("Read() of an expired key error = %v, want DBKeyNotFound", err)
+	}
+	if ids, _ := c.GetAllDetails("table"); len(ids) != 0 {
+		t.Errorf("GetAllDetails() = %v, want no expired keys", ids)
+	}
+	if err := c.Create("table", "expired", "value"); err != nil {
+		t.Errorf("Create() of an expired key unexpected error = %v", err.Error())
+	}
+	if _, err := c.Read("table", "expired"); err != nil {
+		t.Errorf("Read() of a key created again without expiry unexpected error = %v", err.Error())
+	}
+}
+
+func TestInProcessConnPool_Scans(t *testing.T) {
+	c := NewInProcessConnPool()
+	c.AddResourceData("System", "/redfish/v1/Systems/uuid:1", "system1")
+	c.AddResourceData("System", "/redfish/v1/Systems/uuid:2", "system2")
+	c.AddResourceData("Plugin", "GRF", "plugin")
+
+	ids, _ := c.GetAllDetails("System")
+	if want := []string{"/redfish/v1/Systems/uuid:1", "/redfish/v1/Systems/uuid:2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("GetAllDetails() = %v, want %v", ids, want)
+	}
+	ids, _ = c.GetAllMatchingDetails("System", "uuid:2")
+	if want := []string{"/redfish/v1/Systems/uuid:2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("GetAllMatchingDetails() = %v, want %v", ids, want)
+	}
+	values, _ := c.Scan("System", "*:1")
+	if want := []string{`"system1"`}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Scan() = %v, want %v", values, want)
+	}
+	value, err := c.GetResourceDetails("GRF")
+	if err != nil || value != `"plugin"` {
+		t.Errorf("GetResourceDetails() = %v, %v, want the data of the plugin", value, err)
+	}
+	if err := c.DeleteServer("System:*"); err != nil {
+		t.Errorf("DeleteServer() unexpected error = %v", err.Error())
+	}
+	if ids, _ := c.GetAllDetails("System"); len(ids) != 0 {
+		t.Errorf("GetAllDetails() after DeleteServer() = %v, want no keys", ids)
+	}
+}
+
+func TestInProcessConnPool_Indexes(t *testing.T) {
+	c := NewInProcessConnPool()
+	form := map[string]interface{}{
+		"ProcessorSummary/Model": "Intel Xeon",
+		"ProcessorSummary/Count": 4,
+		"MemorySummary/Total":    1.5,
+		"Storage/Capacity":       []float64{2.5, 4},
+	}
+	if err := c.CreateIndex(form, "abc-123"); err != nil {
+		t.Fatalf("CreateIndex() unexpected error = %v", err.Error())
+	}
+	if err := c.CreateIndex(map[string]interface{}{"invalid": true}, "abc-123"); err == nil {
+		t.Errorf("CreateIndex() of an unsupported value should fail")
+	}
+	if got, _ := c.GetString("ProcessorSummary/Model", 0, "*XEON*", false); !reflect.DeepEqual(got, []string{"abc-123"}) {
+		t.Errorf("GetString() = %v, want [abc-123]", got)
+	}
+	if got, _ := c.GetRange("ProcessorSummary/Count", 0, 5, false); !reflect.DeepEqual(got, []string{"abc-123"}) {
+		t.Errorf("GetRange() = %v, want [abc-123]", got)
+	}
+	if got, _ := c.GetRangeByScore("MemorySummary/Total", "1.5", "+inf"); !reflect.DeepEqual(got, []string{"abc-123"}) {
+		t.Errorf("GetRangeByScore() = %v, want [abc-123]", got)
+	}
+	if got, _ := c.GetRangeByScore("MemorySummary/Total", "(1.5", "+inf"); len(got) != 0 {
+		t.Errorf("GetRangeByScore() with an exclusive min = %v, want empty", got)
+	}
+	if got, _ := c.GetStorageList("Storage/Capacity", 0, 3, "ge", false); !reflect.DeepEqual(got, []string{"abc-123"}) {
+		t.Errorf("GetStorageList() = %v, want [abc-123]", got)
+	}
+	if got, _ := c.GetStorageList("Storage/Capacity", 0, 4, "gt", false); len(got) != 0 {
+		t.Errorf("GetStorageList() = %v, want empty", got)
+	}
+
+	if err := c.UpdateResourceIndex(map[string]interface{}{"ProcessorSummary/Count": 8}, "abc-123"); err != nil {
+		t.Errorf("UpdateResourceIndex() unexpected error = %v", err.Error())
+	}
+	if got, _ := c.GetRange("ProcessorSummary/Count", 0, 5, false); len(got) != 0 {
+		t.Errorf("GetRange() after UpdateResourceIndex() = %v, want empty", got)
+	}
+	if err := c.Del("ProcessorSummary/Model", "abc-123"); err != nil {
+		t.Errorf("Del() unexpected error = %v", err.Error())
+	}
+	if err := c.Del("ProcessorSummary/Model", "abc-123"); err == nil || err.Error() != "no data with ID found" {
+		t.Errorf("Del() of a missing member error = %v, want no data with ID found", err)
+	}
+}
+
+func TestInProcessConnPool_GetTaskList(t *testing.T) {
+	c := NewInProcessConnPool()
+	for i, task := range []string{"task3", "task1", "task2"} {
+		c.CreateTaskIndex("Tasks", int64(3-i), task)
+	}
+	tests := []struct {
+		min, max int
+		want     []string
+	}{
+		{0, -1, []string{"task2", "task1", "task3"}},
+		{1, 1, []string{"task1"}},
+		{-2, 10, []string{"task1", "task3"}},
+		{2, 1, []string{}},
+	}
+	for _, tt := range tests {
+		if got, _ := c.GetTaskList("Tasks", tt.min, tt.max); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GetTaskList(%v, %v) = %v, want %v", tt.min, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestInProcessConnPool_Subscriptions(t *testing.T) {
+	c := NewInProcessConnPool()
+	subscription := `{"SubscriptionID":"1","OriginResources":["/redfish/v1/Systems"]}`
+	if err := c.CreateEvtSubscriptionIndex("Subscription", subscription); err != nil {
+		t.Fatalf("CreateEvtSubscriptionIndex() unexpected error = %v", err.Error())
+	}
+	if err := c.CreateEvtSubscriptionIndex("Subscription", subscription); err == nil {
+		t.Errorf("CreateEvtSubscriptionIndex() of an existing subscription should fail")
+	}
+	if got, _ := c.GetEvtSubscriptions("Subscription", "*1*"); !reflect.DeepEqual(got, []string{subscription}) {
+		t.Errorf("GetEvtSubscriptions() = %v, want %v", got, []string{subscription})
+	}
+	updated := `{"SubscriptionID":"1","OriginResources":[]}`
+	if err := c.UpdateEvtSubscriptions("Subscription", "*1*", updated); err != nil {
+		t.Errorf("UpdateEvtSubscriptions() unexpected error = %v", err.Error())
+	}
+	if err := c.DeleteEvtSubscriptions("Subscription", "*1*"); err != nil {
+		t.Errorf("DeleteEvtSubscriptions() unexpected error = %v", err.Error())
+	}
+	if got, _ := c.GetEvtSubscriptions("Subscription", "*"); len(got) != 0 {
+		t.Errorf("GetEvtSubscriptions() after DeleteEvtSubscriptions() = %v, want empty", got)
+	}
+
+	originResources := []string{"/redfish/v1/Systems/uuid:1"}
+	if err := c.CreateDeviceSubscriptionIndex("DeviceSubscription", "10.24.0.1", "https://10.24.0.1/1", originResources); err != nil {
+		t.Fatalf("CreateDeviceSubscriptionIndex() unexpected error = %v", err.Error())
+	}
+	if err := c.CreateDeviceSubscriptionIndex("DeviceSubscription", "10.24.0.10", "https://10.24.0.10/1", originResources); err != nil {
+		t.Fatalf("CreateDeviceSubscriptionIndex() unexpected error = %v", err.Error())
+	}
+	if err := c.UpdateDeviceSubscription("DeviceSubscription", "10.24.0.1", "https://10.24.0.1/2", originResources); err != nil {
+		t.Errorf("UpdateDeviceSubscription() unexpected error = %v", err.Error())
+	}
+	got, _ := c.GetDeviceSubscription("DeviceSubscription", "10.24.0.1[^0-9]*")
+	if want := []string{"10.24.0.1::https://10.24.0.1/2::[/redfish/v1/Systems/uuid:1]"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetDeviceSubscription() = %v, want %v", got, want)
+	}
+	if _, err := c.GetDeviceSubscription("DeviceSubscription", "10.10.10.100*"); err == nil {
+		t.Errorf("GetDeviceSubscription() of a missing host should fail")
+	}
+	if err := c.DeleteDeviceSubscription("DeviceSubscription", "10.24.0.10"); err != nil {
+		t.Errorf("DeleteDeviceSubscription() unexpected error = %v", err.Error())
+	}
+	if got, _ := c.GetDeviceSubscription("DeviceSubscription", "*"); len(got) != 1 {
+		t.Errorf("GetDeviceSubscription() after DeleteDeviceSubscription() = %v, want one subscription", got)
+	}
+}
+
+func TestInProcessConnPool_BackupRestore(t *testing.T) {
+	c := NewInProcessConnPool()
+	c.AddResourceData("table", "key", "data")
+	c.CreateIndex(map[string]interface{}{"count": 4}, "abc-123")
+	backup, err := c.Backup()
+	if err != nil {
+		t.Fatalf("Backup() unexpected error = %v", err.Error())
+	}
+	want := []BackupEntry{
+		{Key: "count", Type: "zset", Values: []string{"4::abc-123", "4"}},
+		{Key: "table:key", Type: "string", Values: []string{`"data"`}},
+	}
+	if !reflect.DeepEqual(backup, want) {
+		t.Errorf("Backup() = %v, want %v", backup, want)
+	}
+
+	restored := NewInProcessConnPool()
+	restored.AddResourceData("table", "other", "data")
+	if err := restored.Restore(backup); err != nil {
+		t.Fatalf("Restore() unexpected error = %v", err.Error())
+	}
+	if got, _ := restored.Backup(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Backup() of the restored DB = %v, want %v", got, want)
+	}
+	if err := restored.Restore([]BackupEntry{{Key: "set", Type: "set", Values: []string{"member"}}}); err == nil {
+		t.Errorf("Restore() of an unsupported type should fail")
+	}
+}
+
+func TestInProcessConnPool_RunMigrations(t *testing.T) {
+	c := NewInProcessConnPool()
+	var runs int
+	var mux sync.Mutex
+	migrations := []Migration{
+		{Table: "table1", Version: 1},
+		{Table: "table1", Version: 2, Migrate: func(conn ConnPool) error {
+			mux.Lock()
+			defer mux.Unlock()
+			runs++
+			if err := conn.AddResourceData("table1", "key", "migrated"); err != nil {
+				return err
+			}
+			return nil
+		}},
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.RunMigrations(migrations); err != nil {
+				t.Errorf("RunMigrations() unexpected error = %v", err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+	if runs != 1 {
+		t.Errorf("migration ran %v times, want once", runs)
+	}
+	statuses, err := c.GetMigrationStatus(migrations)
+	if err != nil {
+		t.Fatalf("GetMigrationStatus() unexpected error = %v", err.Error())
+	}
+	if want := []MigrationStatus{{Table: "table1", CurrentVersion: 2, LatestVersion: 2}}; !reflect.DeepEqual(statuses, want) {
+		t.Errorf("GetMigrationStatus() = %v, want %v", statuses, want)
+	}
+
+	failing := []Migration{{Table: "table2", Version: 1, Migrate: func(ConnPool) error { return fmt.Errorf("failed") }}}
+	results, err := c.RunMigrations(failing)
+	if err == nil {
+		t.Errorf("RunMigrations() of a failing migration should fail")
+	}
+	if len(results) != 1 || results[0].Table != "table2" || results[0].Error == "" {
+		t.Errorf("RunMigrations() results = %v, want the failed migration", results)
+	}
+	if version, _ := c.GetSchemaVersion("table2"); version.Version != 0 {
+		t.Errorf("GetSchemaVersion() after a failed migration = %v, want 0", version.Version)
+	}
+}
+
+func TestInProcessConnPool_TryLock(t *testing.T) {
+	c := NewInProcessConnPool()
+	token, err := c.TryLock("lock", time.Minute)
+	if err != nil || token == "" {
+		t.Fatalf("TryLock() = %v, %v, want the lock", token, err)
+	}
+	if other, _ := c.TryLock("lock", time.Minute); other != "" {
+		t.Errorf("TryLock() took the lock held by another caller")
+	}
+	c.Unlock("lock", "other token")
+	if other, _ := c.TryLock("lock", time.Minute); other != "" {
+		t.Errorf("Unlock() released the lock with another token")
+	}
+	c.Unlock("lock", token)
+	if token, _ = c.TryLock("lock", -time.Second); token == "" {
+		t.Errorf("TryLock() didn't take the released lock")
+	}
+	if token, _ = c.TryLock("lock", time.Minute); token == "" {
+		t.Errorf("TryLock() didn't take the expired lock")
+	}
+}
+
+func TestGetDBConnection_InProcess(t *testing.T) {
+	GetMockDBConfig()
+	config.Data.DBConf.Backend = config.InProcessDBBackend
+	defer func() {
+		config.Data.DBConf.Backend = config.RedisDBBackend
+	}()
+	inMem, err := GetDBConnection(InMemory)
+	if err != nil {
+		t.Fatalf("GetDBConnection() unexpected error = %v", err.Error())
+	}
+	onDisk, err := GetDBConnection(OnDisk)
+	if err != nil {
+		t.Fatalf("GetDBConnection() unexpected error = %v", err.Error())
+	}
+	if _, ok := inMem.(*InProcessConnPool); !ok {
+		t.Errorf("GetDBConnection() = %T, want *InProcessConnPool", inMem)
+	}
+	if inMem == onDisk {
+		t.Errorf("GetDBConnection() should return distinct DBs for InMemory and OnDisk")
+	}
+	if again, _ := GetDBConnection(InMemory); again != inMem {
+		t.Errorf("GetDBConnection() should return the same InMemory DB")
+	}
+	if _, err := GetDBConnection(3); err == nil {
+		t.Errorf("GetDBConnection() of an invalid db type should fail")
+	}
+}
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		str     string
+		want    bool
+	}{
+		{"*", "", true},
+		{"System:*", "System:/redfish/v1/Systems/uuid:1", true},
+		{"*uuid:1", "System:/redfish/v1/Systems/uuid:1", true},
+		{"*uuid:1", "System:/redfish/v1/Systems/uuid:10", false},
+		{"h?llo", "hello", true},
+		{"h?llo", "hllo", false},
+		{"h[ae]llo", "hallo", true},
+		{"h[^e]llo", "hello", false},
+		{"h[a-c]llo", "hbllo", true},
+		{"10.24.0.1[^0-9]*", "10.24.0.1::location", true},
+		{"10.24.0.1[^0-9]*", "10.24.0.10::location", false},
+		{`\[a\]*`, "[a]b", true},
+		{`*\[a\]`, "x[a]", true},
+		{`\[a\]`, "a", false},
+	}
+	for _, tt := range tests {
+		if got := globMatch(tt.pattern, tt.str); got != tt.want {
+			t.Errorf("globMatch(%q, %q) = %v, want %v", tt.pattern, tt.str, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package persistencemgr
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/gomodule/redigo/redis"
+	log "github.com/sirupsen/logrus"
+)
+
+// lockKeyPrefix is the prefix of the keys of the locks
+const lockKeyPrefix = "Lock:"
+
+// inProcessLock is a lock of the in-process DB
+type inProcessLock struct {
+	token   string
+	expires time.Time
+}
+
+// newLockToken returns a random token identifying the holder of a lock
+func newLockToken() (string, *errors.Error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", errors.PackError(errors.UndefinedErrorType, "error while trying to create the lock token: ", err)
+	}
+	return hex.EncodeToString(random), nil
+}
+
+// TryLock takes the lock of the given name if it is free, the returned token identifies the holder
+// of the lock and is empty when the lock is held by another instance. The lock is released after
+// the expiry, in case the instance holding it stops before calling Unlock.
+func (p *RedisConnPool) TryLock(name string, expiry time.Duration) (string, *errors.Error) {
+	token, err := newLockToken()
+	if err != nil {
+		return "", err
+	}
+	acquired, err := p.doLockCommand(func(conn redis.Conn) (interface{}, error) {
+		return conn.Do("SET", lockKeyPrefix+name, token, "NX", "PX", int64(expiry/time.Millisecond))
+	})
+	if err != nil || acquired == nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Unlock releases the lock of the given name if it is still held with the token
+func (p *RedisConnPool) Unlock(name, token string) {
+	_, err := p.doLockCommand(func(conn redis.Conn) (interface{}, error) {
+		return releaseLockScript.Do(conn, lockKeyPrefix+name, token)
+	})
+	if err != nil {
+		log.Error("error while trying to release the lock " + name + ": " + err.Error())
+	}
+}
+
+// TryLock takes the lock of the given name if it is free, the returned token is empty when
+// the lock is held by another caller
+func (p *InProcessConnPool) TryLock(name string, expiry time.Duration) (string, *errors.Error) {
+	token, err := newLockToken()
+	if err != nil {
+		return "", err
+	}
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if lock, held := p.locks[name]; held && time.Now().Before(lock.expires) {
+		return "", nil
+	}
+	p.locks[name] = inProcessLock{token: token, expires: time.Now().Add(expiry)}
+	return token, nil
+}
+
+// Unlock releases the lock of the given name if it is still held with the token
+func (p *InProcessConnPool) Unlock(name, token string) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if p.locks[name].token == token {
+		delete(p.locks, name)
+	}
+}
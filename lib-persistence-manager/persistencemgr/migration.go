@@ -0,0 +1,308 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package persistencemgr
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/gomodule/redigo/redis"
+)
+
+// SchemaVersionTable is the table recording the schema version of the records of each table
+const SchemaVersionTable = "SchemaVersion"
+
+const (
+	// migrationLock is the lock held by the service running the migrations, so that
+	// the instances of the services starting at the same time run them only once
+	migrationLock = "Lock:SchemaMigration"
+	// migrationLockExpiry releases the lock if the service holding it stops while migrating,
+	// the lock is refreshed before each migration
+	migrationLockExpiry = 10 * time.Minute
+	// migrationLockRetryInterval is the interval between two attempts to take the lock
+	migrationLockRetryInterval = time.Second
+)
+
+// releaseLockScript deletes the lock only when it is still held with the given token
+var releaseLockScript = redis.NewScript(1, `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) end return 0`)
+
+// refreshLockScript extends the expiry of the lock only when it is still held with the given token
+var refreshLockScript = redis.NewScript(1, `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("PEXPIRE", KEYS[1], ARGV[2]) end return 0`)
+
+// Migration is a change of the schema of the records of a table. Version is the schema version of
+// the records once Migrate is done: the versions of a table start at 1 and increase with each of its
+// migrations. Migrate must be idempotent, since it is run again when the service stops before the
+// new version is recorded. A nil Migrate only records the version, which is used for the first
+// version of a table whose records are already in the expected shape.
+type Migration struct {
+	Table       string
+	Version     int
+	Description string
+	Migrate     func(ConnPool) error
+}
+
+// SchemaVersion is the schema version recorded for a table
+type SchemaVersion struct {
+	Version     int    `json:"Version"`
+	Description string `json:"Description"`
+	MigratedAt  string `json:"MigratedAt"`
+}
+
+// MigrationStatus is the schema version of a table compared to the latest version of its migrations
+type MigrationStatus struct {
+	Table          string `json:"Table"`
+	CurrentVersion int    `json:"CurrentVersion"`
+	LatestVersion  int    `json:"LatestVersion"`
+}
+
+// MigrationResult is the result of a migration run on the DB, Error is empty when the migration succeeded
+type MigrationResult struct {
+	Table       string `json:"Table"`
+	Version     int    `json:"Version"`
+	Description string `json:"Description"`
+	RunAt       string `json:"RunAt"`
+	Error       string `json:"Error,omitempty"`
+}
+
+// UpToDate tells whether all the migrations of the table are done
+func (s MigrationStatus) UpToDate() bool {
+	return s.CurrentVersion >= s.LatestVersion
+}
+
+// GetSchemaVersion returns the schema version recorded for a table, the version is 0 when
+// no migration of the table is done yet
+func (p *RedisConnPool) GetSchemaVersion(table string) (SchemaVersion, *errors.Error) {
+	return getSchemaVersion(p, table)
+}
+
+// GetMigrationStatus returns the status of each table of the migrations, in the order of the tables
+// in the migrations
+func (p *RedisConnPool) GetMigrationStatus(migrations []Migration) ([]MigrationStatus, *errors.Error) {
+	return getMigrationStatus(p, migrations)
+}
+
+// RunMigrations runs the migrations newer than the schema version of their table, in the increasing
+// order of the versions, and records the version of the table after each of them. The migrations are
+// run while holding a lock in the DB, the other services calling RunMigrations wait for the lock and
+// then skip the migrations already done. The first failing migration stops the run. The results of the
+// migrations run are returned, the failed migration being the last one.
+func (p *RedisConnPool) RunMigrations(migrations []Migration) ([]MigrationResult, *errors.Error) {
+	return runMigrations(p, p, migrations)
+}
+
+// migrationLocker is the lock taken while running the migrations
+type migrationLocker interface {
+	acquireMigrationLock() (string, *errors.Error)
+	refreshMigrationLock(token string) *errors.Error
+	releaseMigrationLock(token string)
+}
+
+// getSchemaVersion reads the schema version of the table from the DB
+func getSchemaVersion(p ConnPool, table string) (SchemaVersion, *errors.Error) {
+	var version SchemaVersion
+	data, err := p.Read(SchemaVersionTable, table)
+	if err != nil {
+		if err.ErrNo() == errors.DBKeyNotFound {
+			return version, nil
+		}
+		return version, err
+	}
+	if jerr := json.Unmarshal([]byte(data), &version); jerr != nil {
+		return version, errors.PackError(errors.JSONUnmarshalFailed, "error while trying to read the schema version of ", table, ": ", jerr)
+	}
+	return version, nil
+}
+
+// getMigrationStatus compares the schema version of each table in the DB with its migrations
+func getMigrationStatus(p ConnPool, migrations []Migration) ([]MigrationStatus, *errors.Error) {
+	var statuses []MigrationStatus
+	index := make(map[string]int)
+	for _, migration := range migrations {
+		i, ok := index[migration.Table]
+		if !ok {
+			version, err := getSchemaVersion(p, migration.Table)
+			if err != nil {
+				return nil, err
+			}
+			i = len(statuses)
+			index[migration.Table] = i
+			statuses = append(statuses, MigrationStatus{Table: migration.Table, CurrentVersion: version.Version})
+		}
+		if migration.Version > statuses[i].LatestVersion {
+			statuses[i].LatestVersion = migration.Version
+		}
+	}
+	return statuses, nil
+}
+
+// runMigrations runs the migrations on the DB while holding the lock of the locker
+func runMigrations(p ConnPool, locker migrationLocker, migrations []Migration) ([]MigrationResult, *errors.Error) {
+	if err := validateMigrations(migrations); err != nil {
+		return nil, errors.PackError(errors.UndefinedErrorType, "invalid migrations: ", err)
+	}
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	token, err := locker.acquireMigrationLock()
+	if err != nil {
+		return nil, err
+	}
+	defer locker.releaseMigrationLock(token)
+
+	var results []MigrationResult
+	versions := make(map[string]int)
+	for _, migration := range sorted {
+		current, ok := versions[migration.Table]
+		if !ok {
+			version, err := getSchemaVersion(p, migration.Table)
+			if err != nil {
+				return results, err
+			}
+			current = version.Version
+			versions[migration.Table] = current
+		}
+		if migration.Version <= current {
+			continue
+		}
+		if err := locker.refreshMigrationLock(token); err != nil {
+			return results, err
+		}
+		log.Info("migrating the table " + migration.Table + " to the schema version " + strconv.Itoa(migration.Version) + ": " + migration.Description)
+		result := MigrationResult{
+			Table:       migration.Table,
+			Version:     migration.Version,
+			Description: migration.Description,
+			RunAt:       time.Now().UTC().Format(time.RFC3339),
+		}
+		if migration.Migrate != nil {
+			if merr := migration.Migrate(p); merr != nil {
+				err := errors.PackError(errors.UndefinedErrorType, "migration of the table ", migration.Table, " to the schema version ", migration.Version, " failed: ", merr)
+				result.Error = err.Error()
+				return append(results, result), err
+			}
+		}
+		version := SchemaVersion{
+			Version:     migration.Version,
+			Description: migration.Description,
+			MigratedAt:  time.Now().UTC().Format(time.RFC3339),
+		}
+		if err := p.AddResourceData(SchemaVersionTable, migration.Table, version); err != nil {
+			result.Error = err.Error()
+			return append(results, result), err
+		}
+		results = append(results, result)
+		versions[migration.Table] = migration.Version
+	}
+	return results, nil
+}
+
+// validateMigrations checks that each migration has a table and that the versions of a table are
+// positive and unique
+func validateMigrations(migrations []Migration) error {
+	versions := make(map[string]bool)
+	for _, migration := range migrations {
+		if migration.Table == "" {
+			return fmt.Errorf("table of the migration %q is empty", migration.Description)
+		}
+		if migration.Version <= 0 {
+			return fmt.Errorf("version %d of the table %s is not positive", migration.Version, migration.Table)
+		}
+		key := migration.Table + ":" + strconv.Itoa(migration.Version)
+		if versions[key] {
+			return fmt.Errorf("version %d of the table %s is defined more than once", migration.Version, migration.Table)
+		}
+		versions[key] = true
+	}
+	return nil
+}
+
+// acquireMigrationLock waits until the migration lock is free and takes it, the returned
+// token identifies the holder of the lock
+func (p *RedisConnPool) acquireMigrationLock() (string, *errors.Error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", errors.PackError(errors.UndefinedErrorType, "error while trying to create the migration lock token: ", err)
+	}
+	token := hex.EncodeToString(random)
+	for {
+		acquired, err := p.doLockCommand(func(conn redis.Conn) (interface{}, error) {
+			return conn.Do("SET", migrationLock, token, "NX", "PX", int64(migrationLockExpiry/time.Millisecond))
+		})
+		if err != nil {
+			return "", err
+		}
+		if acquired != nil {
+			return token, nil
+		}
+		log.Info("waiting for the migrations run by another service")
+		time.Sleep(migrationLockRetryInterval)
+	}
+}
+
+// refreshMigrationLock extends the expiry of the migration lock, it fails when the lock is not
+// held with the token anymore
+func (p *RedisConnPool) refreshMigrationLock(token string) *errors.Error {
+	refreshed, err := p.doLockCommand(func(conn redis.Conn) (interface{}, error) {
+		return refreshLockScript.Do(conn, migrationLock, token, int64(migrationLockExpiry/time.Millisecond))
+	})
+	if err != nil {
+		return err
+	}
+	if n, _ := redis.Int(refreshed, nil); n != 1 {
+		return errors.PackError(errors.UndefinedErrorType, "migration lock expired")
+	}
+	return nil
+}
+
+// releaseMigrationLock frees the migration lock if it is still held with the token
+func (p *RedisConnPool) releaseMigrationLock(token string) {
+	_, err := p.doLockCommand(func(conn redis.Conn) (interface{}, error) {
+		return releaseLockScript.Do(conn, migrationLock, token)
+	})
+	if err != nil {
+		log.Error("error while trying to release the migration lock: " + err.Error())
+	}
+}
+
+// doLockCommand runs a command on a lock with a connection of the WritePool
+func (p *RedisConnPool) doLockCommand(cmd func(redis.Conn) (interface{}, error)) (interface{}, *errors.Error) {
+	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
+	if writePool == nil {
+		return nil, errors.PackError(errors.UndefinedErrorType, "error while trying to use the lock: WritePool is nil")
+	}
+	conn := writePool.Get()
+	defer conn.Close()
+	reply, err := cmd(conn)
+	if err != nil {
+		if errs, aye := isDbConnectError(err); aye {
+			atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool)), nil)
+			return nil, errs
+		}
+		return nil, errors.PackError(errors.UndefinedErrorType, "error while trying to use the lock: ", err)
+	}
+	return reply, nil
+}
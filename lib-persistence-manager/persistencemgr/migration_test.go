@@ -0,0 +1,168 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package persistencemgr
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestRunMigrations(t *testing.T) {
+	c, err := MockDBConnection()
+	if err != nil {
+		t.Fatal("Error while making mock DB connection:", err)
+	}
+	if errs := c.CleanUpDB(); errs != nil {
+		t.Fatalf("error while trying to flush db: %v", errs.Error())
+	}
+	defer c.CleanUpDB()
+
+	var runs []string
+	migrate := func(name string) func(ConnPool) error {
+		return func(ConnPool) error {
+			runs = append(runs, name)
+			return nil
+		}
+	}
+	migrations := []Migration{
+		{Table: "table1", Version: 2, Description: "second", Migrate: migrate("table1 v2")},
+		{Table: "table1", Version: 1, Description: "first", Migrate: migrate("table1 v1")},
+		{Table: "table2", Version: 1, Description: "baseline"},
+	}
+	results, errs := c.RunMigrations(migrations)
+	if errs != nil {
+		t.Fatalf("RunMigrations() unexpected error = %v", errs.Error())
+	}
+	if want := []string{"table1 v1", "table1 v2"}; !reflect.DeepEqual(runs, want) {
+		t.Errorf("RunMigrations() runs = %v, want %v", runs, want)
+	}
+	if len(results) != 3 || results[0].Table != "table1" || results[0].Version != 1 || results[2].Version != 2 || results[2].RunAt == "" || results[2].Error != "" {
+		t.Errorf("RunMigrations() results = %v, want the 3 migrations", results)
+	}
+	version, errs := c.GetSchemaVersion("table1")
+	if errs != nil || version.Version != 2 || version.Description != "second" || version.MigratedAt == "" {
+		t.Errorf("GetSchemaVersion() = %v, %v, want version 2", version, errs)
+	}
+
+	// the migrations already done are skipped
+	runs = nil
+	migrations = append(migrations, Migration{Table: "table1", Version: 3, Description: "third", Migrate: migrate("table1 v3")})
+	if _, errs := c.RunMigrations(migrations); errs != nil {
+		t.Fatalf("RunMigrations() unexpected error = %v", errs.Error())
+	}
+	if want := []string{"table1 v3"}; !reflect.DeepEqual(runs, want) {
+		t.Errorf("RunMigrations() runs = %v, want %v", runs, want)
+	}
+
+	status, errs := c.GetMigrationStatus(migrations)
+	if errs != nil {
+		t.Fatalf("GetMigrationStatus() unexpected error = %v", errs.Error())
+	}
+	want := []MigrationStatus{
+		{Table: "table1", CurrentVersion: 3, LatestVersion: 3},
+		{Table: "table2", CurrentVersion: 1, LatestVersion: 1},
+	}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("GetMigrationStatus() = %v, want %v", status, want)
+	}
+}
+
+func TestRunMigrations_failure(t *testing.T) {
+	c, err := MockDBConnection()
+	if err != nil {
+		t.Fatal("Error while making mock DB connection:", err)
+	}
+	if errs := c.CleanUpDB(); errs != nil {
+		t.Fatalf("error while trying to flush db: %v", errs.Error())
+	}
+	defer c.CleanUpDB()
+
+	var runs int
+	migrations := []Migration{
+		{Table: "table1", Version: 1, Migrate: func(ConnPool) error { return fmt.Errorf("failed") }},
+		{Table: "table1", Version: 2, Migrate: func(ConnPool) error { runs++; return nil }},
+	}
+	results, errs := c.RunMigrations(migrations)
+	if errs == nil {
+		t.Fatal("RunMigrations() expected an error")
+	}
+	if len(results) != 1 || results[0].Version != 1 || results[0].Error == "" {
+		t.Errorf("RunMigrations() results = %v, want the failed migration", results)
+	}
+	if runs != 0 {
+		t.Error("RunMigrations() ran the migrations after a failure")
+	}
+	status, _ := c.GetMigrationStatus(migrations)
+	if len(status) != 1 || status[0].UpToDate() || status[0].CurrentVersion != 0 {
+		t.Errorf("GetMigrationStatus() = %v, want version 0", status)
+	}
+	// the lock is released after a failure
+	migrations[0].Migrate = nil
+	if _, errs := c.RunMigrations(migrations); errs != nil {
+		t.Fatalf("RunMigrations() unexpected error = %v", errs.Error())
+	}
+	if runs != 1 {
+		t.Errorf("RunMigrations() runs = %v, want 1", runs)
+	}
+
+	invalid := [][]Migration{
+		{{Table: "", Version: 1}},
+		{{Table: "table1", Version: 0}},
+		{{Table: "table1", Version: 1}, {Table: "table1", Version: 1}},
+	}
+	for _, migrations := range invalid {
+		if _, errs := c.RunMigrations(migrations); errs == nil {
+			t.Errorf("RunMigrations(%v) expected an error", migrations)
+		}
+	}
+}
+
+func TestRunMigrations_concurrent(t *testing.T) {
+	c, err := MockDBConnection()
+	if err != nil {
+		t.Fatal("Error while making mock DB connection:", err)
+	}
+	if errs := c.CleanUpDB(); errs != nil {
+		t.Fatalf("error while trying to flush db: %v", errs.Error())
+	}
+	defer c.CleanUpDB()
+
+	var lock sync.Mutex
+	var runs int
+	migrations := []Migration{
+		{Table: "table1", Version: 1, Migrate: func(ConnPool) error {
+			lock.Lock()
+			runs++
+			lock.Unlock()
+			return nil
+		}},
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, errs := c.RunMigrations(migrations); errs != nil {
+				t.Errorf("RunMigrations() unexpected error = %v", errs.Error())
+			}
+		}()
+	}
+	wg.Wait()
+	if runs != 1 {
+		t.Errorf("RunMigrations() runs = %v, want 1", runs)
+	}
+}
@@ -16,6 +16,8 @@
 package persistencemgr
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	log "github.com/sirupsen/logrus"
@@ -32,8 +34,8 @@ import (
 	"github.com/gomodule/redigo/redis"
 )
 
-var inMemDBConnPool *ConnPool
-var onDiskDBConnPool *ConnPool
+var inMemDBConnPool *RedisConnPool
+var onDiskDBConnPool *RedisConnPool
 
 const (
 	errorCollectingData string = "error while trying to collect data: "
@@ -140,7 +142,7 @@ func resetDBWriteConection(dbFlag DbType) {
 	}
 }
 
-func (p *ConnPool) setWritePool(config *Config) error {
+func (p *RedisConnPool) setWritePool(config *Config) error {
 	currentMasterIP, currentMasterPort := retryForMasterIP(p, config)
 	if currentMasterIP == "" {
 		return fmt.Errorf("unable to retrieve master ip from sentinel master election")
@@ -157,7 +159,7 @@ func (p *ConnPool) setWritePool(config *Config) error {
 	return nil
 }
 
-func retryForMasterIP(pool *ConnPool, config *Config) (currentMasterIP, currentMasterPort string) {
+func retryForMasterIP(pool *RedisConnPool, config *Config) (currentMasterIP, currentMasterPort string) {
 	for i := 0; i < 120; i++ {
 		currentMasterIP, currentMasterPort = GetCurrentMasterHostPort(config)
 		if currentMasterIP != "" && pool.MasterIP != currentMasterIP {
@@ -189,7 +191,10 @@ func getOnDiskDBConfig() *Config {
 }
 
 //GetDBConnection is used to get the new Connection Pool for Inmemory/OnDisk DB
-func GetDBConnection(dbFlag DbType) (*ConnPool, *errors.Error) {
+func GetDBConnection(dbFlag DbType) (ConnPool, *errors.Error) {
+	if config.Data.DBConf.Backend == config.InProcessDBBackend {
+		return getInProcessDBConnection(dbFlag)
+	}
 	var err *errors.Error
 	switch dbFlag {
 	case InMemory:
@@ -230,6 +235,40 @@ func GetDBConnection(dbFlag DbType) (*ConnPool, *errors.Error) {
 	}
 }
 
+// PoolStats holds the number of connections in the read and write pools of a DB.
+// The active count includes the idle connections and the connections in use.
+type PoolStats struct {
+	ReadActive  int
+	ReadIdle    int
+	WriteActive int
+	WriteIdle   int
+}
+
+// GetPoolStats returns the number of connections in the pools of the DB.
+// Unlike GetDBConnection it doesn't establish the pools, the second return value
+// is false if the pools of the DB are not yet established.
+func GetPoolStats(dbFlag DbType) (PoolStats, bool) {
+	var connPool *RedisConnPool
+	switch dbFlag {
+	case InMemory:
+		connPool = inMemDBConnPool
+	case OnDisk:
+		connPool = onDiskDBConnPool
+	}
+	if connPool == nil || connPool.ReadPool == nil {
+		return PoolStats{}, false
+	}
+	stats := PoolStats{
+		ReadActive: connPool.ReadPool.ActiveCount(),
+		ReadIdle:   connPool.ReadPool.IdleCount(),
+	}
+	if writePool := connPool.WritePool; writePool != nil {
+		stats.WriteActive = writePool.ActiveCount()
+		stats.WriteIdle = writePool.IdleCount()
+	}
+	return stats, true
+}
+
 //getPool is used is utility function to get the Connection Pool from DB.
 func getPool(host, port string) (*redis.Pool, error) {
 	protocol := config.Data.DBConf.Protocol
@@ -264,11 +303,11 @@ func getPool(host, port string) (*redis.Pool, error) {
 
 // Connection returns connection pool
 // Connection does not take any input and returns a connection object used to interact with the DB
-func (c *Config) Connection() (*ConnPool, *errors.Error) {
+func (c *Config) Connection() (*RedisConnPool, *errors.Error) {
 	var err error
 	var masterIP string
 	var masterPort string
-	connPools := &ConnPool{}
+	connPools := &RedisConnPool{}
 	masterIP = c.Host
 	masterPort = c.Port
 	if config.Data.DBConf.RedisHAEnabled {
@@ -304,7 +343,7 @@ func (c *Config) Connection() (*ConnPool, *errors.Error) {
 2."data" is of type interface and is the userdata sent to be stored in DB.
 3."key" is a string which acts as a unique ID to the data entry.
 */
-func (p *ConnPool) Create(table, key string, data interface{}) *errors.Error {
+func (p *RedisConnPool) Create(table, key string, data interface{}) *errors.Error {
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 	if writePool == nil {
 		log.Info("Create : WritePool nil")
@@ -335,12 +374,71 @@ func (p *ConnPool) Create(table, key string, data interface{}) *errors.Error {
 	return nil
 }
 
+// takeScript returns the data of the key and deletes it, the reply is nil when the key doesn't exist
+var takeScript = redis.NewScript(1, `local value = redis.call("GET", KEYS[1]) if value then redis.call("DEL", KEYS[1]) end return value`)
+
+// CreateWithExpiry makes an entry into the database like Create, the entry is removed by
+// the DB once the expiry elapsed
+func (p *RedisConnPool) CreateWithExpiry(table, key string, data interface{}, expiry time.Duration) *errors.Error {
+	jsondata, err := json.Marshal(data)
+	if err != nil {
+		return errors.PackError(errors.UndefinedErrorType, "Write to DB in json form failed: "+err.Error())
+	}
+	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
+	if writePool == nil {
+		log.Info("CreateWithExpiry : WritePool nil")
+		return errors.PackError(errors.UndefinedErrorType, "CreateWithExpiry : WritePool is nil ")
+	}
+	writeConn := writePool.Get()
+	defer writeConn.Close()
+	created, createErr := writeConn.Do("SET", table+":"+key, jsondata, "NX", "PX", int64(expiry/time.Millisecond))
+	if createErr != nil {
+		if errs, aye := isDbConnectError(createErr); aye {
+			atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool)), nil)
+			return errs
+		}
+		return errors.PackError(errors.UndefinedErrorType, "Write to DB failed : "+createErr.Error())
+	}
+	if created == nil {
+		return errors.PackError(errors.DBKeyAlreadyExist, "error: data with key ", key, " already exists")
+	}
+	return nil
+}
+
+// Take returns the data of the key and deletes it in a single operation, so that
+// the data is returned to only one of the concurrent callers
+func (p *RedisConnPool) Take(table, key string) (string, *errors.Error) {
+	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
+	if writePool == nil {
+		log.Info("Take : WritePool nil")
+		return "", errors.PackError(errors.UndefinedErrorType, "Take : WritePool is nil ")
+	}
+	writeConn := writePool.Get()
+	defer writeConn.Close()
+	value, err := takeScript.Do(writeConn, table+":"+key)
+	if err != nil {
+		if errs, aye := isDbConnectError(err); aye {
+			atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool)), nil)
+			return "", errs
+		}
+		return "", errors.PackError(errors.DBKeyFetchFailed, errorCollectingData, err)
+	}
+	if value == nil {
+		return "", errors.PackError(errors.DBKeyNotFound, "no data with the with key ", key, " found")
+	}
+	data, err := redis.String(value, nil)
+	if err != nil {
+		return "", errors.PackError(errors.UndefinedErrorType, "error while trying to convert the data into string: ", err)
+	}
+	return data, nil
+}
+
 //Update data
 /* Update take the following leys as input:
 1."uid" is a string which acts as a unique ID to fetch the data from the DB
 2."data" is userdata which is of type interface sent by the user to update/patch the already existing data
 */
-func (p *ConnPool) Update(table, key string, data interface{}) (string, *errors.Error) {
+func (p *RedisConnPool) Update(table, key string, data interface{}) (string, *errors.Error) {
 
 	if _, readErr := p.Read(table, key); readErr != nil {
 		if errors.DBKeyNotFound == readErr.ErrNo() {
@@ -374,7 +472,7 @@ func (p *ConnPool) Update(table, key string, data interface{}) (string, *errors.
 
 //Read is for getting singular data
 // Read takes "key" sting as input which acts as a unique ID to fetch specific data from DB
-func (p *ConnPool) Read(table, key string) (string, *errors.Error) {
+func (p *RedisConnPool) Read(table, key string) (string, *errors.Error) {
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	var (
@@ -405,8 +503,41 @@ func (p *ConnPool) Read(table, key string) (string, *errors.Error) {
 	return string(data), nil
 }
 
+// ReadMultiple reads the data of the keys of the table in a single request to the DB,
+// the data is returned by key and the keys which don't exist are left out
+func (p *RedisConnPool) ReadMultiple(table string, keys []string) (map[string]string, *errors.Error) {
+	result := make(map[string]string, len(keys))
+	if len(keys) == 0 {
+		return result, nil
+	}
+	saveIDs := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		saveIDs = append(saveIDs, table+":"+key)
+	}
+	readConn := p.ReadPool.Get()
+	defer readConn.Close()
+	values, err := redis.Values(readConn.Do("MGET", saveIDs...))
+	if err != nil {
+		if errs, aye := isDbConnectError(err); aye {
+			return nil, errs
+		}
+		return nil, errors.PackError(errors.DBKeyFetchFailed, errorCollectingData, err)
+	}
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		data, err := redis.String(value, nil)
+		if err != nil {
+			return nil, errors.PackError(errors.UndefinedErrorType, "error while trying to convert the data into string: ", err)
+		}
+		result[keys[i]] = data
+	}
+	return result, nil
+}
+
 // FindOrNull is a wrapper for Read function. If requested asset doesn't exist errors.DBKeyNotFound error returned by Read is converted to nil
-func (p *ConnPool) FindOrNull(table, key string) (string, error) {
+func (p *RedisConnPool) FindOrNull(table, key string) (string, error) {
 	r, e := p.Read(table, key)
 	if e != nil {
 		switch e.ErrNo() {
@@ -420,7 +551,7 @@ func (p *ConnPool) FindOrNull(table, key string) (string, error) {
 }
 
 //GetAllDetails will fetch all the keys present in the database
-func (p *ConnPool) GetAllDetails(table string) ([]string, *errors.Error) {
+func (p *RedisConnPool) GetAllDetails(table string) ([]string, *errors.Error) {
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	keys, err := readConn.Do("KEYS", table+":*")
@@ -441,7 +572,7 @@ func (p *ConnPool) GetAllDetails(table string) ([]string, *errors.Error) {
 
 //Delete data entry
 // Read takes "key" sting as input which acts as a unique ID to delete specific data from DB
-func (p *ConnPool) Delete(table, key string) *errors.Error {
+func (p *RedisConnPool) Delete(table, key string) *errors.Error {
 
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 	if writePool == nil {
@@ -471,7 +602,7 @@ func (p *ConnPool) Delete(table, key string) *errors.Error {
 
 //CleanUpDB will delete all database entries
 //The flush command will be executed without warnings please be cautious in using this
-func (p *ConnPool) CleanUpDB() *errors.Error {
+func (p *RedisConnPool) CleanUpDB() *errors.Error {
 	writeConn := p.WritePool.Get()
 	defer writeConn.Close()
 	_, err := writeConn.Do("FLUSHALL")
@@ -487,7 +618,7 @@ func (p *ConnPool) CleanUpDB() *errors.Error {
 
 /*
 //FilterSearch to search resource with given filter
-func (p *ConnPool) FilterSearch(table, key, path string) (interface{}, *errors.Error) {
+func (p *RedisConnPool) FilterSearch(table, key, path string) (interface{}, *errors.Error) {
     c := p.pool.Get()
     defer c.Close()
     rh := rejson.NewReJSONHandler()
@@ -507,7 +638,7 @@ func (p *ConnPool) FilterSearch(table, key, path string) (interface{}, *errors.E
 
 //DeleteServer data entry without table
 // Read takes "key" sting as input which acts as a unique ID to delete specific data from DB
-func (p *ConnPool) DeleteServer(key string) *errors.Error {
+func (p *RedisConnPool) DeleteServer(key string) *errors.Error {
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	keys, err := readConn.Do("KEYS", key)
@@ -542,7 +673,7 @@ func (p *ConnPool) DeleteServer(key string) *errors.Error {
 }
 
 //GetAllMatchingDetails will fetch all the keys which matches pattern present in the database
-func (p *ConnPool) GetAllMatchingDetails(table, pattern string) ([]string, *errors.Error) {
+func (p *RedisConnPool) GetAllMatchingDetails(table, pattern string) ([]string, *errors.Error) {
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	keys, err := readConn.Do("KEYS", table+":*"+pattern+"*")
@@ -561,8 +692,56 @@ func (p *ConnPool) GetAllMatchingDetails(table, pattern string) ([]string, *erro
 	return IDs, nil
 }
 
+// Scan will fetch the data of all the keys of the table which match the glob style pattern.
+// Unlike KEYS, the keys are scanned incrementally so that the DB isn't blocked on large tables.
+func (p *RedisConnPool) Scan(table, pattern string) ([]string, *errors.Error) {
+	readConn := p.ReadPool.Get()
+	defer readConn.Close()
+	var (
+		keys   []interface{}
+		cursor int64
+	)
+	for {
+		reply, err := redis.Values(readConn.Do("SCAN", cursor, "MATCH", table+":"+pattern, "COUNT", count))
+		if err != nil {
+			if errs, aye := isDbConnectError(err); aye {
+				return nil, errs
+			}
+			return nil, errors.PackError(errors.UndefinedErrorType, errorCollectingData, err)
+		}
+		var batch []interface{}
+		if _, err := redis.Scan(reply, &cursor, &batch); err != nil {
+			return nil, errors.PackError(errors.UndefinedErrorType, errorCollectingData, err)
+		}
+		keys = append(keys, batch...)
+		if cursor == 0 {
+			break
+		}
+	}
+	values := []string{}
+	if len(keys) == 0 {
+		return values, nil
+	}
+	data, err := redis.Values(readConn.Do("MGET", keys...))
+	if err != nil {
+		if errs, aye := isDbConnectError(err); aye {
+			return nil, errs
+		}
+		return nil, errors.PackError(errors.UndefinedErrorType, errorCollectingData, err)
+	}
+	for _, value := range data {
+		// the key was removed after the scan
+		if value == nil {
+			continue
+		}
+		v, _ := redis.String(value, nil)
+		values = append(values, v)
+	}
+	return values, nil
+}
+
 //Transaction is to do a atomic operation using optimistic lock
-func (p *ConnPool) Transaction(key string, cb func(string) error) *errors.Error {
+func (p *RedisConnPool) Transaction(key string, cb func(string) error) *errors.Error {
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 	if writePool == nil {
 		return errors.PackError(errors.UndefinedErrorType, "error while trying to Write Transaction data: WritePool is nil")
@@ -592,6 +771,101 @@ func (p *ConnPool) Transaction(key string, cb func(string) error) *errors.Error
 	return nil
 }
 
+// ETag computes the strong entity tag of the given stored data.
+// The tag is the quoted hex encoded SHA-256 digest of the data, so two reads
+// of an unchanged entry always produce the same tag.
+func ETag(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return "\"" + hex.EncodeToString(sum[:]) + "\""
+}
+
+// MatchETag reports whether the If-Match style header value matches the given etag.
+// Value can be a comma separated list of tags, "*" matches any existing entry
+// and an empty value is treated as no precondition.
+func MatchETag(ifMatch, etag string) bool {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+	for _, tag := range strings.Split(ifMatch, ",") {
+		if strings.TrimSpace(tag) == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateIfMatch updates the data only if the ETag of the stored data matches ifMatch.
+// The comparison and the write are done atomically using WATCH/MULTI/EXEC, so a
+// concurrent write between the read and the update fails with PreconditionFailed.
+// An empty ifMatch behaves exactly like Update.
+func (p *RedisConnPool) UpdateIfMatch(table, key string, data interface{}, ifMatch string) (string, *errors.Error) {
+	if ifMatch == "" {
+		return p.Update(table, key, data)
+	}
+	saveID := table + ":" + key
+	jsondata, err := json.Marshal(data)
+	if err != nil {
+		log.Error("UpdateIfMatch : error in masrshalling json data", err.Error())
+		return "", errors.PackError(errors.UndefinedErrorType, "Write to DB in json form failed: "+err.Error())
+	}
+	if writeErr := p.conditionalWrite(saveID, ifMatch, "SET", saveID, jsondata); writeErr != nil {
+		return "", writeErr
+	}
+	return saveID, nil
+}
+
+// DeleteIfMatch deletes the data only if the ETag of the stored data matches ifMatch.
+// An empty ifMatch behaves exactly like Delete.
+func (p *RedisConnPool) DeleteIfMatch(table, key, ifMatch string) *errors.Error {
+	if ifMatch == "" {
+		return p.Delete(table, key)
+	}
+	saveID := table + ":" + key
+	return p.conditionalWrite(saveID, ifMatch, "DEL", saveID)
+}
+
+// conditionalWrite watches saveID, verifies the ETag of the current value against
+// ifMatch and then executes the given command inside a MULTI/EXEC block
+func (p *RedisConnPool) conditionalWrite(saveID, ifMatch, cmd string, args ...interface{}) *errors.Error {
+	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
+	if writePool == nil {
+		return errors.PackError(errors.UndefinedErrorType, "error while trying to write data: WritePool is nil")
+	}
+	writeConn := writePool.Get()
+	defer writeConn.Close()
+	if _, err := writeConn.Do("WATCH", saveID); err != nil {
+		if errs, aye := isDbConnectError(err); aye {
+			atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool)), nil)
+			return errs
+		}
+		return errors.PackError(errors.UndefinedErrorType, err)
+	}
+	value, err := redis.String(writeConn.Do("GET", saveID))
+	if err != nil {
+		writeConn.Do("UNWATCH")
+		if err == redis.ErrNil {
+			return errors.PackError(errors.DBKeyNotFound, "error: data with key ", saveID, " does not exist")
+		}
+		return errors.PackError(errors.DBKeyFetchFailed, errorCollectingData, err)
+	}
+	if !MatchETag(ifMatch, ETag(value)) {
+		writeConn.Do("UNWATCH")
+		return errors.PackError(errors.PreconditionFailed, "error: ETag of the data with key ", saveID, " does not match ", ifMatch)
+	}
+	writeConn.Send("MULTI")
+	writeConn.Send(cmd, args...)
+	reply, err := writeConn.Do("EXEC")
+	if err != nil {
+		return errors.PackError(errors.UndefinedErrorType, "Write to DB failed : "+err.Error())
+	}
+	// a nil reply means the watched key was modified by someone else after the ETag check
+	if reply == nil {
+		return errors.PackError(errors.PreconditionFailed, "error: data with key ", saveID, " was modified concurrently")
+	}
+	return nil
+}
+
 // isDbConnectError is for checking if error is dial connection error
 func isDbConnectError(err error) (*errors.Error, bool) {
 	if strings.HasSuffix(err.Error(), "connect: connection refused") || err.Error() == "EOF" {
@@ -601,7 +875,7 @@ func isDbConnectError(err error) (*errors.Error, bool) {
 }
 
 //GetResourceDetails will fetch the key and also fetch the data
-func (p *ConnPool) GetResourceDetails(key string) (string, *errors.Error) {
+func (p *RedisConnPool) GetResourceDetails(key string) (string, *errors.Error) {
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	keys, err := readConn.Do("KEYS", "*"+key)
@@ -631,7 +905,7 @@ func (p *ConnPool) GetResourceDetails(key string) (string, *errors.Error) {
 2."data" is of type interface and is the userdata sent to be stored in DB.
 3."key" is a string which acts as a unique ID to the data entry.
 */
-func (p *ConnPool) AddResourceData(table, key string, data interface{}) *errors.Error {
+func (p *RedisConnPool) AddResourceData(table, key string, data interface{}) *errors.Error {
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 	if writePool == nil {
 		return errors.PackError(errors.UndefinedErrorType, "WritePool is nil")
@@ -655,7 +929,7 @@ func (p *ConnPool) AddResourceData(table, key string, data interface{}) *errors.
 }
 
 // Ping will check the DB connection health
-func (p *ConnPool) Ping() error {
+func (p *RedisConnPool) Ping() error {
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	if _, err := readConn.Do("PING"); err != nil {
@@ -682,7 +956,7 @@ func (p *ConnPool) Ping() error {
 1. form is a map of the index to be created and the data along with it
 2. uuid is the resource id with witch the value is stored
 */
-func (p *ConnPool) CreateIndex(form map[string]interface{}, uuid string) error {
+func (p *RedisConnPool) CreateIndex(form map[string]interface{}, uuid string) error {
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 	log.Info("CreateIndex : WritePool value : ", writePool)
 	if writePool == nil {
@@ -693,37 +967,9 @@ func (p *ConnPool) CreateIndex(form map[string]interface{}, uuid string) error {
 	writeConn := writePool.Get()
 	defer writeConn.Close()
 	for index, value := range form {
-		var key string
-		var val interface{}
-		switch v := value.(type) {
-		case int:
-			key = strconv.Itoa(value.(int)) + "::" + uuid
-			val = value
-		case float64:
-			key = strconv.FormatFloat(value.(float64), 'f', -1, 64) + "::" + uuid
-			val = value
-		case string:
-			val = 0
-			value = strings.ToLower(value.(string))
-			key = value.(string) + "::" + uuid
-		case []string:
-			val = 0
-			sliceString := strings.Join(value.([]string), " ")
-			sliceString = "[" + sliceString + "]"
-			sliceString = strings.ToLower(sliceString)
-			key = sliceString + "::" + uuid
-		case []float64:
-			val = 0
-			var floatString []string
-			for _, v := range value.([]float64) {
-				vs := strconv.FormatFloat(v, 'f', -1, 64)
-				floatString = append(floatString, vs)
-			}
-			sliceString := strings.Join(floatString, " ")
-			sliceString = "[" + sliceString + "]"
-			key = sliceString + "::" + uuid
-		default:
-			return fmt.Errorf("error while saving index, unsupported value type %v", v)
+		key, val, err := indexMember(value, uuid)
+		if err != nil {
+			return err
 		}
 		createErr := writeConn.Send("ZADD", index, val, key)
 		if createErr != nil {
@@ -734,13 +980,51 @@ func (p *ConnPool) CreateIndex(form map[string]interface{}, uuid string) error {
 	return nil
 }
 
+// indexMember returns the member and the score of the resource uuid in an index for the indexed value.
+// The numbers are used as the score, the other values are stored in lower case in the member with a 0 score.
+func indexMember(value interface{}, uuid string) (string, interface{}, error) {
+	var key string
+	var val interface{}
+	switch v := value.(type) {
+	case int:
+		key = strconv.Itoa(value.(int)) + "::" + uuid
+		val = value
+	case float64:
+		key = strconv.FormatFloat(value.(float64), 'f', -1, 64) + "::" + uuid
+		val = value
+	case string:
+		val = 0
+		value = strings.ToLower(value.(string))
+		key = value.(string) + "::" + uuid
+	case []string:
+		val = 0
+		sliceString := strings.Join(value.([]string), " ")
+		sliceString = "[" + sliceString + "]"
+		sliceString = strings.ToLower(sliceString)
+		key = sliceString + "::" + uuid
+	case []float64:
+		val = 0
+		var floatString []string
+		for _, v := range value.([]float64) {
+			vs := strconv.FormatFloat(v, 'f', -1, 64)
+			floatString = append(floatString, vs)
+		}
+		sliceString := strings.Join(floatString, " ")
+		sliceString = "[" + sliceString + "]"
+		key = sliceString + "::" + uuid
+	default:
+		return "", nil, fmt.Errorf("error while saving index, unsupported value type %v", v)
+	}
+	return key, val, nil
+}
+
 //CreateTaskIndex is used to create secondary indexing for task service
 /*Following are the input parameters for creating task index:
 1. index name
 2. value takes the Endtime for sorting with range
 3. key if of the format `UserName::Endtime::TaskID`
 */
-func (p *ConnPool) CreateTaskIndex(index string, value int64, key string) error {
+func (p *RedisConnPool) CreateTaskIndex(index string, value int64, key string) error {
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 
 	if writePool == nil {
@@ -763,7 +1047,7 @@ func (p *ConnPool) CreateTaskIndex(index string, value int64, key string) error
 2. cursor is the redis db cursor value
 3. match is the value to match with
 */
-func (p *ConnPool) GetString(index string, cursor float64, match string, regexFlag bool) ([]string, error) {
+func (p *RedisConnPool) GetString(index string, cursor float64, match string, regexFlag bool) ([]string, error) {
 	var getList []string
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
@@ -820,8 +1104,8 @@ func getUniqueSlice(inputSlice []string) []string {
 3. match is the search for list float type
 4. condition is the value for condition operation
 */
-func (p *ConnPool) GetStorageList(index string, cursor, match float64, condition string, regexFlag bool) ([]string, error) {
-	var getList, storeList []string
+func (p *RedisConnPool) GetStorageList(index string, cursor, match float64, condition string, regexFlag bool) ([]string, error) {
+	var getList []string
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	currentCursor := cursor
@@ -855,6 +1139,13 @@ func (p *ConnPool) GetStorageList(index string, cursor, match float64, condition
 		return getList, nil
 	}
 
+	return filterStorageList(getList, match, condition)
+}
+
+// filterStorageList returns the IDs of the members of the storage list index having a value
+// which satisfies the condition against match
+func filterStorageList(getList []string, match float64, condition string) ([]string, error) {
+	var storeList []string
 	for _, k := range getList {
 		values := strings.Split(k, "::")[0]
 		id := strings.Split(k, "::")[1]
@@ -900,7 +1191,7 @@ func (p *ConnPool) GetStorageList(index string, cursor, match float64, condition
 2. min is the minimum value for the search
 3. max is the maximum value for the search
 */
-func (p *ConnPool) GetRange(index string, min, max int, regexFlag bool) ([]string, error) {
+func (p *RedisConnPool) GetRange(index string, min, max int, regexFlag bool) ([]string, error) {
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	data, getErr := redis.Strings(readConn.Do("ZRANGEBYSCORE", index, min, max))
@@ -918,13 +1209,35 @@ func (p *ConnPool) GetRange(index string, min, max int, regexFlag bool) ([]strin
 	return getList, nil
 }
 
+// GetRangeByScore is used to range over float type values using the score range syntax of redis
+/*
+1. index is the name of the index to search under
+2. min is the minimum score for the search, it can be exclusive as in (1.5 or -inf
+3. max is the maximum score for the search, it can be exclusive as in (1.5 or +inf
+*/
+func (p *RedisConnPool) GetRangeByScore(index, min, max string) ([]string, error) {
+	readConn := p.ReadPool.Get()
+	defer readConn.Close()
+	data, getErr := redis.Strings(readConn.Do("ZRANGEBYSCORE", index, min, max))
+	if getErr != nil {
+		return nil, fmt.Errorf("error while trying to get data: " + getErr.Error())
+	}
+	var getList = []string{}
+	for i := 0; i < len(data); i++ {
+		if values := strings.SplitN(data[i], "::", 2); len(values) > 1 {
+			getList = append(getList, values[1])
+		}
+	}
+	return getList, nil
+}
+
 // GetTaskList is used to range over float type values
 /*
 1. index is the name of the index to search under
 2. min is the minimum value for the search
 3. max is the maximum value for the search
 */
-func (p *ConnPool) GetTaskList(index string, min, max int) ([]string, error) {
+func (p *RedisConnPool) GetTaskList(index string, min, max int) ([]string, error) {
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	data, getErr := redis.Strings(readConn.Do("ZRANGE", index, min, max))
@@ -939,7 +1252,7 @@ func (p *ConnPool) GetTaskList(index string, min, max int) ([]string, error) {
 1. index is the name of the index under which the key needs to be deleted
 2. key is the id of the resource to be deleted under an index
 */
-func (p *ConnPool) Del(index string, k string) error {
+func (p *RedisConnPool) Del(index string, k string) error {
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
 	currentCursor := 0
@@ -995,7 +1308,7 @@ func (p *ConnPool) Del(index string, k string) error {
 1. index is the name of the index to be created
 2. key and value are the key value pair for the index
 */
-func (p *ConnPool) CreateEvtSubscriptionIndex(index string, key interface{}) error {
+func (p *RedisConnPool) CreateEvtSubscriptionIndex(index string, key interface{}) error {
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 	if writePool == nil {
 		return fmt.Errorf("WritePool is nil")
@@ -1022,7 +1335,7 @@ func (p *ConnPool) CreateEvtSubscriptionIndex(index string, key interface{}) err
 // 1. index is the name of the index to be created
 // 2. searchKey is for search
 // TODO: Add support for cursors and multiple data
-func (p *ConnPool) GetEvtSubscriptions(index, searchKey string) ([]string, error) {
+func (p *RedisConnPool) GetEvtSubscriptions(index, searchKey string) ([]string, error) {
 	var getList []string
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
@@ -1060,7 +1373,7 @@ func (p *ConnPool) GetEvtSubscriptions(index, searchKey string) ([]string, error
 // DeleteEvtSubscriptions is for to Delete subscription details
 // 1. index is the name of the index to be created
 // 2. removeKey is string parameter for remove
-func (p *ConnPool) DeleteEvtSubscriptions(index, removeKey string) error {
+func (p *RedisConnPool) DeleteEvtSubscriptions(index, removeKey string) error {
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 	if writePool == nil {
 		return fmt.Errorf("WritePool is nil")
@@ -1090,7 +1403,7 @@ func (p *ConnPool) DeleteEvtSubscriptions(index, removeKey string) error {
 // UpdateEvtSubscriptions is for to Update subscription details
 // 1. index is the name of the index to be created
 // 2. key and value are the key value pair for the index
-func (p *ConnPool) UpdateEvtSubscriptions(index, subscritionID string, key interface{}) error {
+func (p *RedisConnPool) UpdateEvtSubscriptions(index, subscritionID string, key interface{}) error {
 
 	err := p.DeleteEvtSubscriptions(index, subscritionID)
 	if err != nil {
@@ -1108,7 +1421,7 @@ func (p *ConnPool) UpdateEvtSubscriptions(index, subscritionID string, key inter
 1. index is the name of the index to be created
 2. key is for the index
 */
-func (p *ConnPool) CreateDeviceSubscriptionIndex(index, hostIP, location string, originResources []string) error {
+func (p *RedisConnPool) CreateDeviceSubscriptionIndex(index, hostIP, location string, originResources []string) error {
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 	if writePool == nil {
 		return fmt.Errorf("WritePool is nil")
@@ -1138,7 +1451,7 @@ func (p *ConnPool) CreateDeviceSubscriptionIndex(index, hostIP, location string,
 2. match is the value to match with
 */
 // TODO : Handle cursor
-func (p *ConnPool) GetDeviceSubscription(index string, match string) ([]string, error) {
+func (p *RedisConnPool) GetDeviceSubscription(index string, match string) ([]string, error) {
 	var data []string
 	readConn := p.ReadPool.Get()
 	defer readConn.Close()
@@ -1176,7 +1489,7 @@ func (p *ConnPool) GetDeviceSubscription(index string, match string) ([]string,
 // DeleteDeviceSubscription is for to Delete subscription details of Device
 // 1. index is the name of the index to be created
 // 2. removeKey is string parameter for remove
-func (p *ConnPool) DeleteDeviceSubscription(index, hostIP string) error {
+func (p *RedisConnPool) DeleteDeviceSubscription(index, hostIP string) error {
 	writePool := (*redis.Pool)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(&p.WritePool))))
 	if writePool == nil {
 		return fmt.Errorf("WritePool is nil")
@@ -1207,7 +1520,7 @@ func (p *ConnPool) DeleteDeviceSubscription(index, hostIP string) error {
 // UpdateDeviceSubscription is for to Update subscription details
 // 1. index is the name of the index to be created
 // 2. key and value are the key value pair for the index
-func (p *ConnPool) UpdateDeviceSubscription(index, hostIP, location string, originResources []string) error {
+func (p *RedisConnPool) UpdateDeviceSubscription(index, hostIP, location string, originResources []string) error {
 	_, err := p.GetDeviceSubscription(index, hostIP+"[^0-9]*")
 	if err != nil {
 		return err
@@ -1227,7 +1540,7 @@ func (p *ConnPool) UpdateDeviceSubscription(index, hostIP, location string, orig
 
 //UpdateResourceIndex is used to update the resource inforamtion which is indexed
 // form contains index name and value:key for the index
-func (p *ConnPool) UpdateResourceIndex(form map[string]interface{}, uuid string) error {
+func (p *RedisConnPool) UpdateResourceIndex(form map[string]interface{}, uuid string) error {
 	for index := range form {
 		err := p.Del(index, uuid)
 		if (err != nil) && (err.Error() != "no data with ID found") {
@@ -142,6 +142,26 @@ func TestRead(t *testing.T) {
 
 }
 
+func TestReadMultiple(t *testing.T) {
+	c, err := MockDBConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		c.Delete("table", "key1")
+		c.Delete("table", "key2")
+	}()
+	c.Create("table", "key1", "sample1")
+	c.Create("table", "key2", "sample2")
+	data, rerr := c.ReadMultiple("table", []string{"key1", "missing", "key2"})
+	if rerr != nil {
+		t.Fatalf("Error while reading data: %v\n", rerr.Error())
+	}
+	if want := map[string]string{"key1": `"sample1"`, "key2": `"sample2"`}; !reflect.DeepEqual(data, want) {
+		t.Errorf("ReadMultiple() = %v, want %v", data, want)
+	}
+}
+
 func TestRead_nonExistingData(t *testing.T) {
 
 	c, err := MockDBConnection()
@@ -233,6 +253,59 @@ func TestUpdate_nonExistingData(t *testing.T) {
 	}
 }
 
+func TestUpdateIfMatch(t *testing.T) {
+	c, err := MockDBConnection()
+	if err != nil {
+		t.Fatal("Error while making mock DB connection:", err)
+	}
+	data := sample{Data1: "Value1", Data2: "Value2", Data3: "Value3"}
+	if cerr := c.Create("table", "etagKey", data); cerr != nil {
+		t.Errorf("Error: %v\n", cerr.Error())
+	}
+	defer c.Delete("table", "etagKey")
+	stored, rerr := c.Read("table", "etagKey")
+	if rerr != nil {
+		t.Fatalf("Error while read data: %v\n", rerr.Error())
+	}
+	etag := ETag(stored)
+
+	data.Data3 = "Value4"
+	if _, uerr := c.UpdateIfMatch("table", "etagKey", data, etag); uerr != nil {
+		t.Errorf("Error while updating data with matching ETag: %v\n", uerr.Error())
+	}
+	// the ETag is now stale, so the second update must be rejected
+	data.Data3 = "Value5"
+	_, uerr := c.UpdateIfMatch("table", "etagKey", data, etag)
+	if uerr == nil || uerr.ErrNo() != errors.PreconditionFailed {
+		t.Errorf("Expected PreconditionFailed for stale ETag, got: %v\n", uerr)
+	}
+	if derr := c.DeleteIfMatch("table", "etagKey", etag); derr == nil || derr.ErrNo() != errors.PreconditionFailed {
+		t.Errorf("Expected PreconditionFailed for stale ETag on delete, got: %v\n", derr)
+	}
+}
+
+func TestMatchETag(t *testing.T) {
+	etag := ETag("data")
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    bool
+	}{
+		{name: "empty precondition", ifMatch: "", want: true},
+		{name: "wildcard", ifMatch: "*", want: true},
+		{name: "exact match", ifMatch: etag, want: true},
+		{name: "list match", ifMatch: ETag("other") + ", " + etag, want: true},
+		{name: "mismatch", ifMatch: ETag("other"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchETag(tt.ifMatch, etag); got != tt.want {
+				t.Errorf("MatchETag() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetall(t *testing.T) {
 
 	c, err := MockDBConnection()
@@ -324,6 +397,29 @@ func TestDelete_nonExistingKey(t *testing.T) {
 	}
 }
 
+func TestTake(t *testing.T) {
+	c, err := MockDBConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cerr := c.CreateWithExpiry("table", "key", "sample", time.Minute); cerr != nil {
+		t.Fatalf("Error while making data entry: %v\n", cerr.Error())
+	}
+	if cerr := c.CreateWithExpiry("table", "key", "sample", time.Minute); cerr == nil || cerr.ErrNo() != errors.DBKeyAlreadyExist {
+		t.Errorf("CreateWithExpiry() of an existing key error = %v, want DBKeyAlreadyExist", cerr)
+	}
+	data, terr := c.Take("table", "key")
+	if terr != nil {
+		t.Fatalf("Error while taking data: %v\n", terr.Error())
+	}
+	if data != `"sample"` {
+		t.Errorf("Take() = %v, want %v", data, `"sample"`)
+	}
+	if _, terr := c.Take("table", "key"); terr == nil || terr.ErrNo() != errors.DBKeyNotFound {
+		t.Errorf("Take() of a taken key error = %v, want DBKeyNotFound", terr)
+	}
+}
+
 func TestCleanUpDB(t *testing.T) {
 	c, err := MockDBConnection()
 	if err != nil {
@@ -418,6 +514,35 @@ func TestGetAllMatchingDetails(t *testing.T) {
 	}()
 }
 
+func TestScan(t *testing.T) {
+	c, err := MockDBConnection()
+	if err != nil {
+		t.Fatal("Error while making mock DB connection:", err)
+	}
+	data := sample{Data1: "Value1", Data2: "Value2", Data3: "Value3"}
+	if cerr := c.Create("scantable", "key1", data); cerr != nil {
+		t.Errorf("Error while creating data: %v\n", cerr.Error())
+	}
+	if cerr := c.Create("scantable", "other", data); cerr != nil {
+		t.Errorf("Error while creating data: %v\n", cerr.Error())
+	}
+	defer func() {
+		c.Delete("scantable", "key1")
+		c.Delete("scantable", "other")
+	}()
+	values, serr := c.Scan("scantable", "key*")
+	if serr != nil {
+		t.Fatalf("Error while scanning data: %v\n", serr.Error())
+	}
+	if want := []string{`{"Data1":"Value1","Data2":"Value2","Data3":"Value3"}`}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Scan() = %v, want %v", values, want)
+	}
+	values, serr = c.Scan("scantable", "missing*")
+	if serr != nil || len(values) != 0 {
+		t.Errorf("Scan() = %v, %v, want no data", values, serr)
+	}
+}
+
 func TestGetAllMatchingDetails_nonExistingtable(t *testing.T) {
 	c, err := MockDBConnection()
 	if err != nil {
@@ -722,6 +847,36 @@ func TestGetRange(t *testing.T) {
 	}()
 
 }
+
+func TestGetRangeByScore(t *testing.T) {
+	c, err := MockDBConnection()
+	if err != nil {
+		t.Fatal("Error while making mock DB connection:", err)
+	}
+	if cerr := c.CreateIndex(map[string]interface{}{"memory": 1.5}, "abc-123"); cerr != nil {
+		t.Errorf("Error: %v\n", cerr.Error())
+	}
+	defer func() {
+		if derr := c.Del("memory", "abc-123"); derr != nil {
+			t.Errorf("Error while deleting Data: %v\n", derr.Error())
+		}
+	}()
+	got, rerr := c.GetRangeByScore("memory", "1.5", "+inf")
+	if rerr != nil {
+		t.Errorf("Error while reading data: %v\n", rerr.Error())
+	}
+	if len(got) != 1 || got[0] != "abc-123" {
+		t.Errorf("GetRangeByScore() = %v, want [abc-123]", got)
+	}
+	got, rerr = c.GetRangeByScore("memory", "(1.5", "+inf")
+	if rerr != nil {
+		t.Errorf("Error while reading data: %v\n", rerr.Error())
+	}
+	if len(got) != 0 {
+		t.Errorf("GetRangeByScore() = %v, want empty", got)
+	}
+}
+
 func TestGetStorageList(t *testing.T) {
 	c, err := MockDBConnection()
 	if err != nil {
@@ -799,7 +954,9 @@ func TestCreateEvtSubscriptions_existingData(t *testing.T) {
 	}
 	js := `{"Name":"Subscriptions", "hosts":["10.10.10.10"]}`
 	defer func() {
-		if derr := c.DeleteEvtSubscriptions("subscriptions", js); derr != nil {
+		// the key is matched as a glob pattern, the brackets of the hosts are escaped
+		matchKey := strings.NewReplacer("[", "\\[", "]", "\\]").Replace(js)
+		if derr := c.DeleteEvtSubscriptions("subscriptions", matchKey); derr != nil {
 			t.Errorf("Error while deleting Data: %v\n", derr.Error())
 		}
 	}()
@@ -1166,7 +1323,7 @@ func TestGetDBConnection(t *testing.T) {
 	tests := []struct {
 		name  string
 		args  args
-		want  *ConnPool
+		want  *RedisConnPool
 		want1 *errors.Error
 	}{
 		{
@@ -1174,7 +1331,7 @@ func TestGetDBConnection(t *testing.T) {
 			args: args{
 				dbFlag: InMemory,
 			},
-			want:  &ConnPool{},
+			want:  &RedisConnPool{},
 			want1: nil,
 		},
 		{
@@ -1182,7 +1339,7 @@ func TestGetDBConnection(t *testing.T) {
 			args: args{
 				dbFlag: OnDisk,
 			},
-			want:  &ConnPool{},
+			want:  &RedisConnPool{},
 			want1: nil,
 		},
 		{
@@ -1211,13 +1368,13 @@ func TestGetDBConnection_HAEnabled(t *testing.T) {
 	// Enableing HA
 	config.Data.DBConf.RedisHAEnabled = true
 
-	inMemDBConnPool = &ConnPool{
+	inMemDBConnPool = &RedisConnPool{
 		ReadPool:        &redis.Pool{},
 		WritePool:       nil,
 		MasterIP:        "NotValid",
 		PoolUpdatedTime: time.Now(),
 	}
-	onDiskDBConnPool = &ConnPool{
+	onDiskDBConnPool = &RedisConnPool{
 		ReadPool:        &redis.Pool{},
 		WritePool:       nil,
 		MasterIP:        "NotValid",
@@ -1230,7 +1387,7 @@ func TestGetDBConnection_HAEnabled(t *testing.T) {
 	tests := []struct {
 		name  string
 		args  args
-		want  *ConnPool
+		want  *RedisConnPool
 		want1 *errors.Error
 	}{
 		{
@@ -1238,7 +1395,7 @@ func TestGetDBConnection_HAEnabled(t *testing.T) {
 			args: args{
 				dbFlag: InMemory,
 			},
-			want:  &ConnPool{},
+			want:  &RedisConnPool{},
 			want1: nil,
 		},
 		{
@@ -1246,7 +1403,7 @@ func TestGetDBConnection_HAEnabled(t *testing.T) {
 			args: args{
 				dbFlag: OnDisk,
 			},
-			want:  &ConnPool{},
+			want:  &RedisConnPool{},
 			want1: nil,
 		},
 		{
@@ -1270,3 +1427,21 @@ func TestGetDBConnection_HAEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPoolStats(t *testing.T) {
+	inMem, onDisk := inMemDBConnPool, onDiskDBConnPool
+	defer func() {
+		inMemDBConnPool, onDiskDBConnPool = inMem, onDisk
+	}()
+	inMemDBConnPool = &RedisConnPool{ReadPool: &redis.Pool{}, WritePool: &redis.Pool{}}
+	onDiskDBConnPool = nil
+	if stats, ok := GetPoolStats(InMemory); !ok || stats != (PoolStats{}) {
+		t.Errorf("GetPoolStats() = %v, %v, want established empty pools", stats, ok)
+	}
+	if _, ok := GetPoolStats(OnDisk); ok {
+		t.Errorf("GetPoolStats() should not report the pools which are not established")
+	}
+	if onDiskDBConnPool != nil {
+		t.Errorf("GetPoolStats() should not establish the pools")
+	}
+}
@@ -20,13 +20,9 @@ import (
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
 )
 
-// MockDBConnection provides a mock db for unit testing
-func MockDBConnection() (*ConnPool, *errors.Error) {
-	config, err := GetMockDBConfig()
-	if err != nil {
-		return nil, errors.PackError(errors.UndefinedErrorType, "error while trying to initiate mock db: ", err)
-	}
-	return config.Connection()
+// MockDBConnection provides a mock db for unit testing, the data is kept in the memory of the test
+func MockDBConnection() (ConnPool, *errors.Error) {
+	return NewInProcessConnPool(), nil
 }
 
 // GetMockDBConfig will initiate mock db and will provide the config file
@@ -0,0 +1,113 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package pluginclient
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// circuitBreaker stops sending the requests to a plugin which couldn't be reached for
+// CircuitBreakerThreshold consecutive requests. Once CircuitBreakerResetInSecs elapsed,
+// a single request is sent to the plugin, which closes the circuit when it reaches the plugin.
+type circuitBreaker struct {
+	lock      sync.Mutex
+	pluginID  string
+	failures  int
+	openUntil time.Time
+}
+
+// breakerStore holds the circuit breakers of the plugins, keyed by plugin ID
+type breakerStore struct {
+	lock     sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+var breakers = &breakerStore{
+	breakers: make(map[string]*circuitBreaker),
+}
+
+func (s *breakerStore) get(plugin Plugin) *circuitBreaker {
+	key := plugin.ID
+	if key == "" {
+		key = plugin.IP + ":" + plugin.Port
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	breaker, exists := s.breakers[key]
+	if !exists {
+		breaker = &circuitBreaker{pluginID: key}
+		s.breakers[key] = breaker
+	}
+	return breaker
+}
+
+// allow reports if a request can be sent to the plugin
+func (b *circuitBreaker) allow() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.failures < breakerThreshold() {
+		return true
+	}
+	now := time.Now()
+	if now.Before(b.openUntil) {
+		return false
+	}
+	// a single request is sent to check if the plugin can be reached again
+	b.openUntil = now.Add(breakerReset())
+	return true
+}
+
+// record records if a request couldn't reach the plugin
+func (b *circuitBreaker) record(unreachable bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if !unreachable {
+		if b.failures >= breakerThreshold() {
+			log.Info("the plugin " + b.pluginID + " is reachable again")
+		}
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures == breakerThreshold() {
+		log.Error("the plugin " + b.pluginID + " couldn't be reached for consecutive requests, the requests to the plugin will fail fast")
+		b.openUntil = time.Now().Add(breakerReset())
+	}
+}
+
+// retryAt returns the time after which a request is sent again to the plugin
+func (b *circuitBreaker) retryAt() time.Time {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.openUntil
+}
+
+func breakerThreshold() int {
+	if config.Data.PluginClientConf == nil {
+		return config.DefaultCircuitBreakerThreshold
+	}
+	return config.Data.PluginClientConf.CircuitBreakerThreshold
+}
+
+func breakerReset() time.Duration {
+	if config.Data.PluginClientConf == nil {
+		return config.DefaultCircuitBreakerResetInSecs * time.Second
+	}
+	return time.Duration(config.Data.PluginClientConf.CircuitBreakerResetInSecs) * time.Second
+}
@@ -0,0 +1,293 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package pluginclient sends the requests of the services to the plugins. It owns the
+// session tokens of the plugins, the retries of the requests which couldn't reach a plugin,
+// the circuit breaking of the unreachable plugins and the mapping of the plugin errors
+// to Redfish error responses.
+package pluginclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-rest-client/pmbhandle"
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// XAuthToken is the authentication type of the plugins authenticating the requests with a session token
+	XAuthToken = "XAuthToken"
+	// BasicAuth is the authentication type of the plugins authenticating the requests with the basic authentication
+	BasicAuth = "BasicAuth"
+	// SessionsURI is the URI of the plugin sessions
+	SessionsURI = "/ODIM/v1/Sessions"
+)
+
+// ContactClient is the function sending a request to a plugin, pmbhandle.ContactPlugin or the
+// function returned by pmbhandle.ContactClient for the requests which are part of a trace
+type ContactClient func(url, method, token string, odataID string, body interface{}, basicAuth map[string]string) (*http.Response, error)
+
+// Plugin holds the details of the plugin a request is sent to
+type Plugin struct {
+	ID                string
+	IP                string
+	Port              string
+	Username          string
+	Password          []byte
+	PreferredAuthType string
+}
+
+// Request holds a request to a plugin
+type Request struct {
+	Plugin Plugin
+	Method string
+	// URI is the northbound URI of the request, translated to the southbound URI of the plugin
+	URI  string
+	Body interface{}
+	// ContactClient sends the request, pmbhandle.ContactPlugin is used when it's not set
+	ContactClient ContactClient
+	// StatusCheck is called when the plugin couldn't be reached after the retries, the request
+	// being sent once more when it reports the plugin is up
+	StatusCheck func() bool
+}
+
+// Response holds the response of a plugin
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	// Body is the body of the response, translated to the northbound URIs
+	Body []byte
+}
+
+// Error is the error of a request to a plugin, with the Redfish error it's mapped to
+type Error struct {
+	StatusCode    int32
+	StatusMessage string
+	MsgArgs       []interface{}
+	// Body is the body of the response of the plugin, when the plugin responded
+	Body []byte
+	err  error
+}
+
+// Error returns the error message
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+// Response returns the Redfish error response of the error. The error response of the
+// plugin is returned as it is, when the plugin responded with a Redfish error.
+func (e *Error) Response() response.RPC {
+	var pluginError struct {
+		Error interface{} `json:"error"`
+	}
+	if err := json.Unmarshal(e.Body, &pluginError); err == nil && pluginError.Error != nil {
+		resp := response.RPC{
+			StatusCode:    e.StatusCode,
+			StatusMessage: e.StatusMessage,
+			Header: map[string]string{
+				"Content-type": "application/json; charset=utf-8",
+			},
+		}
+		json.Unmarshal(e.Body, &resp.Body)
+		return resp
+	}
+	return common.GeneralError(e.StatusCode, e.StatusMessage, e.Error(), e.MsgArgs, nil)
+}
+
+// ResponseBody returns the body of the Redfish error response of the error
+func (e *Error) ResponseBody() []byte {
+	body, _ := json.Marshal(e.Response().Body)
+	return body
+}
+
+// Do sends a request to a plugin and returns its response. The request is authenticated with the
+// session token of the plugin, which is created on the first request and created again when the
+// plugin rejects it, or with the basic authentication. The request is sent again when the plugin
+// couldn't be reached, and fails fast once the plugin couldn't be reached for consecutive requests.
+// An Error is returned when the plugin couldn't be reached or didn't respond with a success.
+func Do(req Request) (*Response, *Error) {
+	if req.ContactClient == nil {
+		req.ContactClient = pmbhandle.ContactPlugin
+	}
+	uri := translateURI(req.URI, config.Data.URLTranslation.SouthBoundURL)
+	resp, err := send(req, uri, false)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && isXAuthToken(req.Plugin) {
+		// the session of the plugin expired, a new one is created
+		log.Info("session token of the plugin " + req.Plugin.ID + " rejected, creating a new session")
+		if resp, err = send(req, uri, true); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, responseError(req, uri, resp)
+	}
+	resp.Body = []byte(translateURI(string(resp.Body), config.Data.URLTranslation.NorthBoundURL))
+	return resp, nil
+}
+
+// send sends a request to a plugin with its authentication
+func send(req Request, uri string, newToken bool) (*Response, *Error) {
+	var token string
+	var basicAuth map[string]string
+	if isXAuthToken(req.Plugin) {
+		if newToken {
+			tokens.invalidate(req.Plugin)
+		}
+		var err *Error
+		if token, err = tokens.get(req.Plugin, req.ContactClient); err != nil {
+			return nil, err
+		}
+	} else {
+		basicAuth = map[string]string{
+			"UserName": req.Plugin.Username,
+			"Password": string(req.Plugin.Password),
+		}
+	}
+	return contact(req, uri, token, basicAuth)
+}
+
+// contact sends a request to a plugin, retrying it while the plugin couldn't be reached
+func contact(req Request, uri, token string, basicAuth map[string]string) (*Response, *Error) {
+	reqURL := "https://" + req.Plugin.IP + ":" + req.Plugin.Port + uri
+	breaker := breakers.get(req.Plugin)
+	if !breaker.allow() {
+		return nil, &Error{
+			StatusCode:    http.StatusServiceUnavailable,
+			StatusMessage: response.CouldNotEstablishConnection,
+			MsgArgs:       []interface{}{reqURL},
+			err:           fmt.Errorf("error: the plugin %s is unreachable, the requests are not sent until %v", req.Plugin.ID, breaker.retryAt()),
+		}
+	}
+	resp, err := req.ContactClient(reqURL, req.Method, token, uri, req.Body, basicAuth)
+	metrics.ObservePluginResponse(req.Plugin.ID, resp, err)
+	interval := retryInterval()
+	for attempt := 0; attempt < maxRetryAttempts() && isRetryable(req.Method, err); attempt++ {
+		log.Warn(fmt.Sprintf("unable to reach the plugin %s, retrying in %v: %v", req.Plugin.ID, interval, err))
+		time.Sleep(interval)
+		interval *= 2
+		resp, err = req.ContactClient(reqURL, req.Method, token, uri, req.Body, basicAuth)
+		metrics.ObservePluginResponse(req.Plugin.ID, resp, err)
+	}
+	if isRetryable(req.Method, err) && req.StatusCheck != nil && req.StatusCheck() {
+		resp, err = req.ContactClient(reqURL, req.Method, token, uri, req.Body, basicAuth)
+		metrics.ObservePluginResponse(req.Plugin.ID, resp, err)
+	}
+	breaker.record(isUnreachable(err))
+	if err != nil {
+		log.Error("error while contacting the plugin " + req.Plugin.ID + ": " + err.Error())
+		return nil, &Error{
+			StatusCode:    http.StatusServiceUnavailable,
+			StatusMessage: response.CouldNotEstablishConnection,
+			MsgArgs:       []interface{}{reqURL},
+			err:           err,
+		}
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &Error{
+			StatusCode:    http.StatusInternalServerError,
+			StatusMessage: response.InternalError,
+			err:           fmt.Errorf("error while trying to read the response body of the plugin: %v", err),
+		}
+	}
+	return &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	}, nil
+}
+
+// responseError maps the error response of a plugin to a Redfish error
+func responseError(req Request, uri string, resp *Response) *Error {
+	reqURL := "https://" + req.Plugin.IP + ":" + req.Plugin.Port + uri
+	pluginErr := &Error{
+		StatusCode:    int32(resp.StatusCode),
+		StatusMessage: response.InternalError,
+		Body:          resp.Body,
+		err:           fmt.Errorf("error: got %d from the plugin %s for %s %s: %s", resp.StatusCode, req.Plugin.ID, req.Method, uri, resp.Body),
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		pluginErr.StatusMessage = response.ResourceAtURIUnauthorized
+		pluginErr.MsgArgs = []interface{}{reqURL}
+	}
+	return pluginErr
+}
+
+// isRetryable reports if a request is sent again after an error. The requests which couldn't
+// reach the plugin are retried, except the requests which are not idempotent, which are retried
+// only when the connection to the plugin couldn't be opened, since they may have been processed
+// by the plugin otherwise.
+func isRetryable(method string, err error) bool {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isUnreachable reports if the error is a failure to reach the plugin, as opposed to a failure to
+// build the request
+func isUnreachable(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+func isXAuthToken(plugin Plugin) bool {
+	return strings.EqualFold(plugin.PreferredAuthType, XAuthToken)
+}
+
+// translateURI replaces the URI prefixes of the URL translation in a URI or in a response body
+func translateURI(data string, translation map[string]string) string {
+	common.MuxLock.Lock()
+	defer common.MuxLock.Unlock()
+	for key, value := range translation {
+		data = strings.Replace(data, key, value, -1)
+	}
+	return data
+}
+
+func maxRetryAttempts() int {
+	if config.Data.PluginClientConf == nil {
+		return config.DefaultPluginMaxRetryAttempts
+	}
+	return config.Data.PluginClientConf.MaxRetryAttempts
+}
+
+func retryInterval() time.Duration {
+	if config.Data.PluginClientConf == nil {
+		return config.DefaultPluginRetryIntervalInMillisecs * time.Millisecond
+	}
+	return time.Duration(config.Data.PluginClientConf.RetryIntervalInMillisecs) * time.Millisecond
+}
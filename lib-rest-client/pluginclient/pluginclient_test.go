@@ -0,0 +1,254 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package pluginclient
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+)
+
+// mockPlugin records the requests sent to a plugin and responds to them
+type mockPlugin struct {
+	sessions    int
+	requests    int
+	tokens      []string
+	basicAuth   []map[string]string
+	validToken  string
+	unreachable int
+	status      int
+	body        string
+}
+
+func (p *mockPlugin) contact(reqURL, method, token string, odataID string, body interface{}, basicAuth map[string]string) (*http.Response, error) {
+	if p.unreachable > 0 {
+		p.unreachable--
+		return nil, &url.Error{Op: method, URL: reqURL, Err: fmt.Errorf("connection refused")}
+	}
+	if odataID == SessionsURI {
+		p.sessions++
+		p.validToken = fmt.Sprintf("token%d", p.sessions)
+		return newResponse(http.StatusCreated, "{}", http.Header{"X-Auth-Token": []string{p.validToken}}), nil
+	}
+	p.requests++
+	p.tokens = append(p.tokens, token)
+	p.basicAuth = append(p.basicAuth, basicAuth)
+	if basicAuth == nil && token != p.validToken {
+		return newResponse(http.StatusUnauthorized, "", nil), nil
+	}
+	return newResponse(p.status, p.body, nil), nil
+}
+
+func newResponse(status int, body string, header http.Header) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func newRequest(id, authType string, plugin *mockPlugin) Request {
+	return Request{
+		Plugin: Plugin{
+			ID:                id,
+			IP:                "localhost",
+			Port:              "45000",
+			Username:          "admin",
+			Password:          []byte("password"),
+			PreferredAuthType: authType,
+		},
+		Method:        http.MethodGet,
+		URI:           "/redfish/v1/Systems/1",
+		ContactClient: plugin.contact,
+	}
+}
+
+func TestDoWithSessionToken(t *testing.T) {
+	config.SetUpMockConfig(t)
+	plugin := &mockPlugin{status: http.StatusOK, body: `{"@odata.id":"/ODIM/v1/Systems/1"}`}
+	req := newRequest("TokenPlugin", XAuthToken, plugin)
+	for i := 0; i < 3; i++ {
+		resp, err := Do(req)
+		if err != nil {
+			t.Fatalf("Do() unexpected error = %v", err)
+		}
+		if string(resp.Body) != `{"@odata.id":"/redfish/v1/Systems/1"}` {
+			t.Errorf("Do() body = %s, the URIs are not translated", resp.Body)
+		}
+	}
+	if plugin.sessions != 1 || plugin.requests != 3 {
+		t.Errorf("got %v sessions and %v requests, want 1 and 3", plugin.sessions, plugin.requests)
+	}
+
+	// the plugin deleted the session
+	plugin.validToken = "expired"
+	if _, err := Do(req); err != nil {
+		t.Fatalf("Do() unexpected error = %v", err)
+	}
+	if plugin.sessions != 2 || plugin.tokens[len(plugin.tokens)-1] != "token2" {
+		t.Errorf("Do() didn't create a new session once the token was rejected")
+	}
+
+	// the token is not reused with other credentials
+	req.Plugin.Password = []byte("other")
+	Do(req)
+	if plugin.sessions != 3 {
+		t.Errorf("got %v sessions, want 3", plugin.sessions)
+	}
+}
+
+func TestDoWithBasicAuth(t *testing.T) {
+	config.SetUpMockConfig(t)
+	plugin := &mockPlugin{status: http.StatusOK, body: "{}"}
+	if _, err := Do(newRequest("BasicAuthPlugin", BasicAuth, plugin)); err != nil {
+		t.Fatalf("Do() unexpected error = %v", err)
+	}
+	if plugin.sessions != 0 || plugin.basicAuth[0]["UserName"] != "admin" || plugin.basicAuth[0]["Password"] != "password" {
+		t.Errorf("Do() didn't send the request with the basic authentication")
+	}
+}
+
+func TestDoErrors(t *testing.T) {
+	config.SetUpMockConfig(t)
+	tests := []struct {
+		name        string
+		plugin      *mockPlugin
+		wantStatus  int32
+		wantMessage string
+		wantBody    bool
+	}{
+		{
+			name:        "Redfish error of the plugin",
+			plugin:      &mockPlugin{status: http.StatusBadRequest, body: `{"error":{"code":"Base.1.6.1.GeneralError"}}`},
+			wantStatus:  http.StatusBadRequest,
+			wantMessage: response.InternalError,
+			wantBody:    true,
+		},
+		{
+			name:        "plugin unreachable",
+			plugin:      &mockPlugin{unreachable: 10},
+			wantStatus:  http.StatusServiceUnavailable,
+			wantMessage: response.CouldNotEstablishConnection,
+		},
+		{
+			name:        "session rejected",
+			plugin:      &mockPlugin{status: http.StatusUnauthorized},
+			wantStatus:  http.StatusUnauthorized,
+			wantMessage: response.ResourceAtURIUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Do(newRequest(tt.name, BasicAuth, tt.plugin))
+			if err == nil {
+				t.Fatal("Do() expected an error")
+			}
+			if err.StatusCode != tt.wantStatus || err.StatusMessage != tt.wantMessage {
+				t.Errorf("Do() error = %v %v, want %v %v", err.StatusCode, err.StatusMessage, tt.wantStatus, tt.wantMessage)
+			}
+			resp := err.Response()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Response() status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if _, isPluginBody := resp.Body.(map[string]interface{}); isPluginBody != tt.wantBody {
+				t.Errorf("Response() body = %v", resp.Body)
+			}
+		})
+	}
+}
+
+func TestDoRetries(t *testing.T) {
+	config.SetUpMockConfig(t)
+	config.Data.PluginClientConf.MaxRetryAttempts = 2
+	plugin := &mockPlugin{unreachable: 2, status: http.StatusOK, body: "{}"}
+	if _, err := Do(newRequest("RetryPlugin", BasicAuth, plugin)); err != nil {
+		t.Fatalf("Do() unexpected error = %v", err)
+	}
+
+	// the status check of the plugin allows a last attempt
+	plugin.unreachable = 3
+	statusChecked := false
+	req := newRequest("RetryPlugin", BasicAuth, plugin)
+	req.StatusCheck = func() bool {
+		statusChecked = true
+		return true
+	}
+	if _, err := Do(req); err != nil || !statusChecked {
+		t.Fatalf("Do() error = %v, status checked = %v", err, statusChecked)
+	}
+
+	// the errors which are not connection failures are not retried
+	attempts := 0
+	req.ContactClient = func(string, string, string, string, interface{}, map[string]string) (*http.Response, error) {
+		attempts++
+		return nil, fmt.Errorf("json: unsupported type")
+	}
+	if _, err := Do(req); err == nil || attempts != 1 {
+		t.Errorf("Do() error = %v after %v attempts, want an error after 1 attempt", err, attempts)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	dialErr := &url.Error{Op: "Post", URL: "https://localhost:45000", Err: &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")}}
+	readErr := &url.Error{Op: "Post", URL: "https://localhost:45000", Err: &net.OpError{Op: "read", Net: "tcp", Err: fmt.Errorf("connection reset by peer")}}
+	tests := []struct {
+		method string
+		err    error
+		want   bool
+	}{
+		{http.MethodGet, dialErr, true},
+		{http.MethodGet, readErr, true},
+		{http.MethodPost, dialErr, true},
+		{http.MethodPost, readErr, false},
+		{http.MethodPatch, &url.Error{Op: "Patch", URL: "https://localhost:45000", Err: io.ErrUnexpectedEOF}, false},
+		{http.MethodGet, fmt.Errorf("json: unsupported type"), false},
+	}
+	for _, tt := range tests {
+		if got := isRetryable(tt.method, tt.err); got != tt.want {
+			t.Errorf("isRetryable(%v, %v) = %v, want %v", tt.method, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestDoCircuitBreaker(t *testing.T) {
+	config.SetUpMockConfig(t)
+	config.Data.PluginClientConf.MaxRetryAttempts = 0
+	config.Data.PluginClientConf.CircuitBreakerThreshold = 2
+	plugin := &mockPlugin{unreachable: 2, status: http.StatusOK, body: "{}"}
+	req := newRequest("UnreachablePlugin", BasicAuth, plugin)
+	Do(req)
+	Do(req)
+	if _, err := Do(req); err == nil || plugin.requests != 0 {
+		t.Fatalf("Do() sent the request to an unreachable plugin")
+	}
+
+	// a request is sent again once the reset duration elapsed
+	breakers.get(req.Plugin).openUntil = time.Now()
+	if _, err := Do(req); err != nil {
+		t.Fatalf("Do() unexpected error = %v", err)
+	}
+	if _, err := Do(req); err != nil || plugin.requests != 2 {
+		t.Errorf("Do() didn't close the circuit once the plugin was reached")
+	}
+}
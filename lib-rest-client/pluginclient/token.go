@@ -0,0 +1,140 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package pluginclient
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-rest-client/pmbhandle"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+)
+
+// pluginToken is the session token of a plugin
+type pluginToken struct {
+	// lock serializes the creation of the session, so that a single session is created
+	lock     sync.Mutex
+	token    string
+	lastUsed time.Time
+}
+
+// tokenCache holds the session tokens of the plugins, keyed by plugin and credentials
+type tokenCache struct {
+	lock   sync.Mutex
+	tokens map[string]*pluginToken
+}
+
+var tokens = &tokenCache{
+	tokens: make(map[string]*pluginToken),
+}
+
+// Token returns the session token of a plugin, creating a session when there is no token
+// of the plugin or when the token was not used for TokenExpiryInMins
+func Token(plugin Plugin, contactClient ContactClient) (string, *Error) {
+	if contactClient == nil {
+		contactClient = pmbhandle.ContactPlugin
+	}
+	return tokens.get(plugin, contactClient)
+}
+
+// InvalidateToken discards the session token of a plugin, so that a new session is created
+// by the next request. It's used when the plugin or its credentials are updated or removed.
+func InvalidateToken(plugin Plugin) {
+	tokens.invalidate(plugin)
+}
+
+// entry returns the token entry of a plugin. The address and the credentials of the plugin are
+// part of the key, so that a token is never reused once the plugin is updated.
+func (c *tokenCache) entry(plugin Plugin) *pluginToken {
+	hash := sha256.Sum256([]byte(plugin.ID + "\x00" + plugin.IP + ":" + plugin.Port + "\x00" + plugin.Username + "\x00" + string(plugin.Password)))
+	key := hex.EncodeToString(hash[:])
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, exists := c.tokens[key]
+	if !exists {
+		entry = &pluginToken{}
+		c.tokens[key] = entry
+	}
+	return entry
+}
+
+func (c *tokenCache) get(plugin Plugin, contactClient ContactClient) (string, *Error) {
+	entry := c.entry(plugin)
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+	if entry.token != "" && time.Since(entry.lastUsed) < tokenExpiry() {
+		entry.lastUsed = time.Now()
+		return entry.token, nil
+	}
+	entry.token = ""
+	token, err := createSession(plugin, contactClient)
+	if err != nil {
+		return "", err
+	}
+	entry.token = token
+	entry.lastUsed = time.Now()
+	return token, nil
+}
+
+func (c *tokenCache) invalidate(plugin Plugin) {
+	entry := c.entry(plugin)
+	entry.lock.Lock()
+	entry.token = ""
+	entry.lock.Unlock()
+}
+
+// createSession creates a session with the plugin and returns its token
+func createSession(plugin Plugin, contactClient ContactClient) (string, *Error) {
+	req := Request{
+		Plugin:        plugin,
+		Method:        http.MethodPost,
+		URI:           SessionsURI,
+		ContactClient: contactClient,
+		Body: map[string]interface{}{
+			"Username": plugin.Username,
+			"Password": string(plugin.Password),
+		},
+	}
+	resp, err := contact(req, SessionsURI, "", nil)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		sessionErr := responseError(req, SessionsURI, resp)
+		sessionErr.err = fmt.Errorf("error: unable to create a session with the plugin %s: %v", plugin.ID, sessionErr.err)
+		return "", sessionErr
+	}
+	token := resp.Header.Get("X-Auth-Token")
+	if token == "" {
+		return "", &Error{
+			StatusCode:    http.StatusUnauthorized,
+			StatusMessage: response.NoValidSession,
+			err:           fmt.Errorf("error: no session token returned by the plugin %s", plugin.ID),
+		}
+	}
+	return token, nil
+}
+
+func tokenExpiry() time.Duration {
+	if config.Data.PluginClientConf == nil {
+		return config.DefaultPluginTokenExpiryInMins * time.Minute
+	}
+	return time.Duration(config.Data.PluginClientConf.TokenExpiryInMins * float64(time.Minute))
+}
@@ -17,15 +17,34 @@ package pmbhandle
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	log "github.com/sirupsen/logrus"
 	"net/http"
+	"time"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
 )
 
 //ContactPlugin is used to send a request to plugin to add a resource
 func ContactPlugin(url, method, token string, odataID string, body interface{}, basicAuth map[string]string) (*http.Response, error) {
+	return ContactPluginWithContext(context.Background(), url, method, token, odataID, body, basicAuth)
+}
+
+//ContactClient returns the ContactPlugin function which sends the requests to
+//the plugin as part of the trace carried by ctx, they are not cancelled with ctx
+func ContactClient(ctx context.Context) func(string, string, string, string, interface{}, map[string]string) (*http.Response, error) {
+	ctx = tracing.Detach(ctx)
+	return func(url, method, token string, odataID string, body interface{}, basicAuth map[string]string) (*http.Response, error) {
+		return ContactPluginWithContext(ctx, url, method, token, odataID, body, basicAuth)
+	}
+}
+
+//ContactPluginWithContext is ContactPlugin propagating the trace context carried
+//by ctx to the plugin in the request headers
+func ContactPluginWithContext(ctx context.Context, url, method, token string, odataID string, body interface{}, basicAuth map[string]string) (*http.Response, error) {
 	jsonStr, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
@@ -35,6 +54,7 @@ func ContactPlugin(url, method, token string, odataID string, body interface{},
 		log.Error(err.Error())
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	// indicate to close the request created
 	req.Close = true
@@ -57,12 +77,18 @@ func ContactPlugin(url, method, token string, odataID string, body interface{},
 	if err != nil {
 		return nil, err
 	}
+	httpClient = tracing.NewClient(httpClient)
+	if config.Data.SouthBoundRequestTimeoutInSecs > 0 {
+		httpClient.Timeout = time.Duration(config.Data.SouthBoundRequestTimeoutInSecs) * time.Second
+	}
 	config.TLSConfMutex.RLock()
 	resp, err := httpClient.Do(req)
 	config.TLSConfMutex.RUnlock()
 	if err != nil {
 		return nil, err
 	}
+	// the certificate chain of the plugin is recorded for monitoring its expiry
+	common.RecordEndpointCertificate(common.PluginEndpoint, req.URL.Host, resp.TLS)
 
 	if resp.StatusCode >= 300 {
 		log.Warn("got " + resp.Status + " while fetching " + url + " with method " + method)
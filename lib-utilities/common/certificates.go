@@ -0,0 +1,183 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// ODIMRAEndpoint is the type of the endpoints of odimra
+	ODIMRAEndpoint = "ODIMRA"
+	// PluginEndpoint is the type of the endpoints of the plugins
+	PluginEndpoint = "Plugin"
+	// BMCEndpoint is the type of the endpoints of the devices
+	BMCEndpoint = "BMC"
+	// EndpointCertificateTable is the table of the certificates presented by the endpoints
+	EndpointCertificateTable = "EndpointCertificate"
+	// endpointCertificateSaveInterval is the interval after which a certificate chain which
+	// didn't change is saved again, to refresh the time it was last seen at
+	endpointCertificateSaveInterval = time.Hour
+)
+
+// CertificateInfo holds the details of a certificate of a chain
+type CertificateInfo struct {
+	Subject        string    `json:"Subject"`
+	Issuer         string    `json:"Issuer"`
+	SerialNumber   string    `json:"SerialNumber"`
+	Fingerprint    string    `json:"Fingerprint"`
+	ValidNotBefore time.Time `json:"ValidNotBefore"`
+	ValidNotAfter  time.Time `json:"ValidNotAfter"`
+}
+
+// EndpointCertificate holds the certificate chain presented by an endpoint
+// on the last connection to it
+type EndpointCertificate struct {
+	Endpoint     string            `json:"Endpoint"`
+	EndpointType string            `json:"EndpointType"`
+	Chain        []CertificateInfo `json:"Chain"`
+	// ValidNotAfter is the earliest expiry of the certificates of the chain
+	ValidNotAfter time.Time `json:"ValidNotAfter"`
+	LastSeen      time.Time `json:"LastSeen"`
+}
+
+// savedCertificate is the certificate chain of an endpoint last saved by the service
+type savedCertificate struct {
+	fingerprint string
+	savedAt     time.Time
+}
+
+var savedCertificates = struct {
+	lock         sync.Mutex
+	certificates map[string]savedCertificate
+}{
+	certificates: make(map[string]savedCertificate),
+}
+
+// NewEndpointCertificate returns the details of the certificate chain presented by an endpoint
+func NewEndpointCertificate(endpointType, endpoint string, chain []*x509.Certificate) EndpointCertificate {
+	certificate := EndpointCertificate{
+		Endpoint:     endpoint,
+		EndpointType: endpointType,
+		LastSeen:     time.Now().UTC(),
+	}
+	for _, cert := range chain {
+		fingerprint := sha256.Sum256(cert.Raw)
+		certificate.Chain = append(certificate.Chain, CertificateInfo{
+			Subject:        cert.Subject.CommonName,
+			Issuer:         cert.Issuer.CommonName,
+			SerialNumber:   cert.SerialNumber.Text(16),
+			Fingerprint:    hex.EncodeToString(fingerprint[:]),
+			ValidNotBefore: cert.NotBefore.UTC(),
+			ValidNotAfter:  cert.NotAfter.UTC(),
+		})
+		if certificate.ValidNotAfter.IsZero() || cert.NotAfter.Before(certificate.ValidNotAfter) {
+			certificate.ValidNotAfter = cert.NotAfter.UTC()
+		}
+	}
+	return certificate
+}
+
+// RecordEndpointCertificate saves the certificate chain presented by an endpoint on a TLS connection.
+// The chain is saved when it changed or when it was saved more than an hour ago, so that the DB is
+// not written on every request to the endpoint.
+func RecordEndpointCertificate(endpointType, endpoint string, state *tls.ConnectionState) {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return
+	}
+	certificate := NewEndpointCertificate(endpointType, endpoint, state.PeerCertificates)
+	fingerprint := certificate.Chain[0].Fingerprint
+	savedCertificates.lock.Lock()
+	saved, ok := savedCertificates.certificates[endpoint]
+	if ok && saved.fingerprint == fingerprint && time.Since(saved.savedAt) < endpointCertificateSaveInterval {
+		savedCertificates.lock.Unlock()
+		return
+	}
+	savedCertificates.certificates[endpoint] = savedCertificate{fingerprint: fingerprint, savedAt: time.Now()}
+	savedCertificates.lock.Unlock()
+	if err := SaveEndpointCertificate(certificate); err != nil {
+		log.Error("unable to save the certificate of the endpoint " + endpoint + ": " + err.Error())
+		savedCertificates.lock.Lock()
+		delete(savedCertificates.certificates, endpoint)
+		savedCertificates.lock.Unlock()
+	}
+}
+
+// SaveEndpointCertificate saves the certificate chain of an endpoint in the DB,
+// replacing the chain already saved for the endpoint
+func SaveEndpointCertificate(certificate EndpointCertificate) error {
+	conn, err := GetDBConnection(InMemory)
+	if err != nil {
+		return fmt.Errorf("unable to connect DB: %v", err.Error())
+	}
+	if err := conn.AddResourceData(EndpointCertificateTable, certificate.Endpoint, certificate); err != nil {
+		return fmt.Errorf("unable to save the certificate: %v", err.Error())
+	}
+	return nil
+}
+
+// GetEndpointCertificates returns the certificate chains saved for all the endpoints, sorted by endpoint
+func GetEndpointCertificates() ([]EndpointCertificate, error) {
+	conn, err := GetDBConnection(InMemory)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect DB: %v", err.Error())
+	}
+	keys, err := conn.GetAllDetails(EndpointCertificateTable)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the endpoints: %v", err.Error())
+	}
+	var certificates []EndpointCertificate
+	for _, key := range keys {
+		data, err := conn.Read(EndpointCertificateTable, key)
+		if err != nil {
+			// the certificate was deleted after the keys were read
+			continue
+		}
+		var certificate EndpointCertificate
+		if jerr := json.Unmarshal([]byte(data), &certificate); jerr != nil {
+			return nil, fmt.Errorf("unable to unmarshal the certificate of %s: %v", key, jerr)
+		}
+		certificates = append(certificates, certificate)
+	}
+	sort.Slice(certificates, func(i, j int) bool {
+		return certificates[i].Endpoint < certificates[j].Endpoint
+	})
+	return certificates, nil
+}
+
+// DeleteEndpointCertificate deletes the certificate chain saved for an endpoint
+func DeleteEndpointCertificate(endpoint string) error {
+	conn, err := GetDBConnection(InMemory)
+	if err != nil {
+		return fmt.Errorf("unable to connect DB: %v", err.Error())
+	}
+	if err := conn.Delete(EndpointCertificateTable, endpoint); err != nil {
+		return fmt.Errorf("unable to delete the certificate of %s: %v", endpoint, err.Error())
+	}
+	savedCertificates.lock.Lock()
+	delete(savedCertificates.certificates, endpoint)
+	savedCertificates.lock.Unlock()
+	return nil
+}
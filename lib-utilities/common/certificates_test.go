@@ -0,0 +1,77 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func createTestCertificate(t *testing.T, commonName string, notAfter time.Time) *x509.Certificate {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error while creating the certificate: %v", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("error while parsing the certificate: %v", err)
+	}
+	return certificate
+}
+
+func TestNewEndpointCertificate(t *testing.T) {
+	leafExpiry := time.Now().Add(90 * 24 * time.Hour)
+	intermediateExpiry := time.Now().Add(10 * 24 * time.Hour)
+	chain := []*x509.Certificate{
+		createTestCertificate(t, "bmc", leafExpiry),
+		createTestCertificate(t, "intermediate CA", intermediateExpiry),
+	}
+
+	certificate := NewEndpointCertificate(BMCEndpoint, "10.0.0.1:443", chain)
+	if certificate.Endpoint != "10.0.0.1:443" || certificate.EndpointType != BMCEndpoint {
+		t.Errorf("NewEndpointCertificate() returned the endpoint %s of type %s", certificate.Endpoint, certificate.EndpointType)
+	}
+	if len(certificate.Chain) != 2 || certificate.Chain[0].Subject != "bmc" || certificate.Chain[0].Fingerprint == "" {
+		t.Errorf("NewEndpointCertificate() returned the chain %+v", certificate.Chain)
+	}
+	if !certificate.ValidNotAfter.Equal(chain[1].NotAfter) {
+		t.Errorf("NewEndpointCertificate() ValidNotAfter = %v, want the expiry of the intermediate CA %v", certificate.ValidNotAfter, chain[1].NotAfter)
+	}
+}
+
+func TestRecordEndpointCertificateWithoutCertificates(t *testing.T) {
+	// nothing is saved for the plain connections and the connections without a peer certificate
+	RecordEndpointCertificate(PluginEndpoint, "10.0.0.1:45001", nil)
+	RecordEndpointCertificate(PluginEndpoint, "10.0.0.1:45001", &tls.ConnectionState{})
+	savedCertificates.lock.Lock()
+	defer savedCertificates.lock.Unlock()
+	if _, ok := savedCertificates.certificates["10.0.0.1:45001"]; ok {
+		t.Errorf("RecordEndpointCertificate() recorded a connection without certificate")
+	}
+}
@@ -156,6 +156,7 @@ var ManagersResource = map[string]string{
 var ResourceTypes = map[string]string{
 	"AccelerationFunction":   "AccelerationFunction",
 	"AddressPool":            "AddressPool",
+	"Aggregate":              "Aggregates",
 	"Assembly":               "Assembly",
 	"Bios":                   "Bios",
 	"BootOption":             "BootOptions",
@@ -220,6 +221,9 @@ var ResourceTypes = map[string]string{
 type Events struct {
 	IP      string `json:"ip"`
 	Request []byte `json:"request"`
+	// TraceContext holds the trace context propagated with the events
+	// while they are processed, it is not part of the message itself
+	TraceContext map[string]string `json:"-"`
 }
 
 // MessageData contains information of Events and message details including arguments
@@ -16,11 +16,29 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
+
 	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	log "github.com/sirupsen/logrus"
 )
 
+// MigrationReportTable is the table of the on-disk DB holding the migration report of each service
+const MigrationReportTable = "MigrationReport"
+
+// MigrationReport is the result of the migrations run by a service when it starts. Applied holds all
+// the migrations applied by the service, Failed the migration which failed when it last started, if any,
+// and Tables the schema version of the tables of the service after that start.
+type MigrationReport struct {
+	Service   string                           `json:"Service"`
+	UpdatedAt string                           `json:"UpdatedAt"`
+	Applied   []persistencemgr.MigrationResult `json:"Applied"`
+	Failed    []persistencemgr.MigrationResult `json:"Failed"`
+	Tables    []persistencemgr.MigrationStatus `json:"Tables"`
+}
+
 // DbType is a alias name for int32
 type DbType int32
 
@@ -36,7 +54,7 @@ const (
 // dbFlag:
 //	InMemory:	returns In-Memory DB connection pool
 //	OnDsik:  	returns On-Disk DB connection pool
-func GetDBConnection(dbFlag DbType) (*persistencemgr.ConnPool, *errors.Error) {
+func GetDBConnection(dbFlag DbType) (persistencemgr.ConnPool, *errors.Error) {
 	switch dbFlag {
 	case InMemory:
 		pool, err := persistencemgr.GetDBConnection(persistencemgr.InMemory)
@@ -87,3 +105,76 @@ func CheckDBConnection() error {
 
 	return nil
 }
+
+// RunMigrations brings the records of the DB to the schema expected by the service.
+// The migrations newer than the recorded schema version of their table are run, and
+// their results are saved in the migration report of the service, even when one fails.
+func RunMigrations(service string, dbFlag DbType, migrations []persistencemgr.Migration) error {
+	conn, err := GetDBConnection(dbFlag)
+	if err != nil {
+		return fmt.Errorf("unable to create DB connection: %v", err)
+	}
+	results, migrationErr := conn.RunMigrations(migrations)
+	statuses, err := conn.GetMigrationStatus(migrations)
+	if err != nil {
+		return fmt.Errorf("unable to get the migration status: %v", err)
+	}
+	if err := saveMigrationReport(service, results, statuses); err != nil {
+		return fmt.Errorf("unable to save the migration report: %v", err)
+	}
+	if migrationErr != nil {
+		return fmt.Errorf("unable to migrate the DB: %v", migrationErr)
+	}
+	for _, status := range statuses {
+		log.Info(fmt.Sprintf("schema version of the table %s is %d", status.Table, status.CurrentVersion))
+	}
+	return nil
+}
+
+// saveMigrationReport adds the results of the migrations run by the service to its report
+// in the on-disk DB
+func saveMigrationReport(service string, results []persistencemgr.MigrationResult, statuses []persistencemgr.MigrationStatus) *errors.Error {
+	report, err := GetMigrationReport(service)
+	if err != nil {
+		if err.ErrNo() != errors.DBKeyNotFound {
+			return err
+		}
+		report = MigrationReport{}
+	}
+	report.Service = service
+	report.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	report.Failed = []persistencemgr.MigrationResult{}
+	for _, result := range results {
+		if result.Error != "" {
+			report.Failed = append(report.Failed, result)
+			continue
+		}
+		report.Applied = append(report.Applied, result)
+	}
+	if report.Applied == nil {
+		report.Applied = []persistencemgr.MigrationResult{}
+	}
+	report.Tables = statuses
+	conn, err := GetDBConnection(OnDisk)
+	if err != nil {
+		return err
+	}
+	return conn.AddResourceData(MigrationReportTable, service, report)
+}
+
+// GetMigrationReport reads the migration report of the service from the on-disk DB
+func GetMigrationReport(service string) (MigrationReport, *errors.Error) {
+	var report MigrationReport
+	conn, err := GetDBConnection(OnDisk)
+	if err != nil {
+		return report, err
+	}
+	data, err := conn.Read(MigrationReportTable, service)
+	if err != nil {
+		return report, err
+	}
+	if jerr := json.Unmarshal([]byte(data), &report); jerr != nil {
+		return report, errors.PackError(errors.JSONUnmarshalFailed, "error while trying to read the migration report of ", service, ": ", jerr)
+	}
+	return report, nil
+}
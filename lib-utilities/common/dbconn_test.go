@@ -18,6 +18,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 )
 
@@ -218,3 +219,50 @@ func TestCheckDBConnection(t *testing.T) {
 		reportError(t, undefinedErr, fmt.Sprintf("expected err to be nil while using CheckDBConnection but got: %v", err))
 	}
 }
+
+func TestRunMigrations(t *testing.T) {
+	config.SetUpMockConfig(t)
+	defer TruncateDB(OnDisk)
+	migrations := []persistencemgr.Migration{
+		{Table: "table1", Version: 1, Description: "initial schema"},
+	}
+	if err := RunMigrations("svc-test", OnDisk, migrations); err != nil {
+		reportError(t, undefinedErr, fmt.Sprintf("expected err to be nil while using RunMigrations but got: %v", err))
+	}
+	conn, _ := GetDBConnection(OnDisk)
+	if version, err := conn.GetSchemaVersion("table1"); err != nil || version.Version != 1 {
+		reportError(t, undefinedErr, fmt.Sprintf("expected schema version 1 but got: %v, %v", version, err))
+	}
+	if err := RunMigrations("svc-test", math.MaxInt32, migrations); err == nil {
+		reportError(t, undefinedErr, "expected err to be non nil while using RunMigrations with an invalid DB")
+	}
+
+	// the failed migration is reported along with the ones applied before
+	migrations = append(migrations, persistencemgr.Migration{
+		Table:       "table1",
+		Version:     2,
+		Description: "failing migration",
+		Migrate:     func(persistencemgr.ConnPool) error { return fmt.Errorf("failed") },
+	})
+	if err := RunMigrations("svc-test", OnDisk, migrations); err == nil {
+		reportError(t, undefinedErr, "expected err to be non nil while using RunMigrations with a failing migration")
+	}
+	report, err := GetMigrationReport("svc-test")
+	if err != nil {
+		reportError(t, redisDataReadErr, err)
+	}
+	if len(report.Applied) != 1 || report.Applied[0].Version != 1 || len(report.Failed) != 1 || report.Failed[0].Version != 2 ||
+		len(report.Tables) != 1 || report.Tables[0].CurrentVersion != 1 || report.Tables[0].LatestVersion != 2 {
+		reportError(t, undefinedErr, fmt.Sprintf("unexpected migration report: %+v", report))
+	}
+
+	// the failure is cleared once the migration succeeds
+	migrations[1].Migrate = nil
+	if err := RunMigrations("svc-test", OnDisk, migrations); err != nil {
+		reportError(t, undefinedErr, fmt.Sprintf("expected err to be nil while using RunMigrations but got: %v", err))
+	}
+	report, _ = GetMigrationReport("svc-test")
+	if len(report.Applied) != 2 || len(report.Failed) != 0 || report.Tables[0].CurrentVersion != 2 {
+		reportError(t, undefinedErr, fmt.Sprintf("unexpected migration report: %+v", report))
+	}
+}
@@ -0,0 +1,500 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	log "github.com/sirupsen/logrus"
+)
+
+// comparison operators supported in $filter
+var filterOperators = map[string]bool{
+	"eq": true,
+	"ne": true,
+	"gt": true,
+	"ge": true,
+	"lt": true,
+	"le": true,
+}
+
+// FilterIndexFunc looks up the members matching a comparison of the $filter using a secondary index.
+// indexed is false when there is no index for the property, the comparison is then evaluated
+// against the stored JSON of each member.
+// A FilterError is returned when the comparison is invalid, other errors are taken as DB errors.
+type FilterIndexFunc func(property, operator, value string) (members []string, indexed bool, err error)
+
+// FilterError is the error of a FilterIndexFunc for a comparison which can't be evaluated,
+// Response is the error response to be returned for the request
+type FilterError struct {
+	Response response.RPC
+	Err      error
+}
+
+func (e *FilterError) Error() string {
+	return e.Err.Error()
+}
+
+// Filter is a parsed $filter expression
+type Filter struct {
+	root filterNode
+}
+
+type filterNode interface {
+	// match evaluates the expression against a single resource
+	match(resource map[string]interface{}) bool
+	// members evaluates the expression against all the members of a collection
+	members(ctx *filterContext) (map[string]bool, error)
+}
+
+type filterContext struct {
+	members     []string
+	getMembers  GetMembersFunc
+	lookupIndex FilterIndexFunc
+	// resources holds the members read with getMembers, it is nil until they are read
+	resources map[string]map[string]interface{}
+}
+
+type filterLogical struct {
+	operator    string
+	left, right filterNode
+}
+
+type filterNot struct {
+	expression filterNode
+}
+
+type filterComparison struct {
+	property string
+	operator string
+	value    string
+	quoted   bool
+}
+
+// ParseFilter parses a $filter expression. The expression is made of comparisons
+// of the form Property op Value, where op is one of eq, ne, gt, ge, lt and le,
+// combined with and, or, not and parentheses. Nested properties are given in the
+// form Status/Health, and string values can optionally be enclosed in single quotes.
+func ParseFilter(expression string) (*Filter, error) {
+	p := &filterParser{input: expression}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.input[p.pos:], p.pos)
+	}
+	return &Filter{root: root}, nil
+}
+
+// Match returns true if the resource satisfies the filter
+func (f *Filter) Match(resource map[string]interface{}) bool {
+	return f.root.match(resource)
+}
+
+// Members returns the members of a collection satisfying the filter, in the same order as they are given.
+// Comparisons are answered with lookupIndex when the property is indexed, others are evaluated
+// on the members read with getMembers. lookupIndex can be nil when the collection has no index.
+func (f *Filter) Members(members []string, getMembers GetMembersFunc, lookupIndex FilterIndexFunc) ([]string, error) {
+	ctx := &filterContext{
+		members:     members,
+		getMembers:  getMembers,
+		lookupIndex: lookupIndex,
+	}
+	matched, err := f.root.members(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []string{}
+	for _, member := range members {
+		if matched[member] {
+			result = append(result, member)
+		}
+	}
+	return result, nil
+}
+
+// readResources reads all the members at once, the first time a comparison is evaluated on them,
+// and keeps them for the other comparisons of the expression
+func (ctx *filterContext) readResources() error {
+	if ctx.resources != nil {
+		return nil
+	}
+	data, err := ctx.getMembers(ctx.members)
+	if err != nil {
+		return err
+	}
+	ctx.resources = make(map[string]map[string]interface{}, len(data))
+	for member, value := range data {
+		var resource map[string]interface{}
+		if jerr := json.Unmarshal([]byte(value), &resource); jerr != nil {
+			log.Error("error while reading " + member + " for $filter: " + jerr.Error())
+			continue
+		}
+		ctx.resources[member] = resource
+	}
+	return nil
+}
+
+func (l *filterLogical) match(resource map[string]interface{}) bool {
+	if l.operator == "and" {
+		return l.left.match(resource) && l.right.match(resource)
+	}
+	return l.left.match(resource) || l.right.match(resource)
+}
+
+func (l *filterLogical) members(ctx *filterContext) (map[string]bool, error) {
+	left, err := l.left.members(ctx)
+	if err != nil {
+		return nil, err
+	}
+	right, err := l.right.members(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool)
+	for member := range left {
+		if l.operator == "or" || right[member] {
+			result[member] = true
+		}
+	}
+	if l.operator == "or" {
+		for member := range right {
+			result[member] = true
+		}
+	}
+	return result, nil
+}
+
+func (n *filterNot) match(resource map[string]interface{}) bool {
+	return !n.expression.match(resource)
+}
+
+func (n *filterNot) members(ctx *filterContext) (map[string]bool, error) {
+	excluded, err := n.expression.members(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool)
+	for _, member := range ctx.members {
+		if !excluded[member] {
+			result[member] = true
+		}
+	}
+	return result, nil
+}
+
+func (c *filterComparison) members(ctx *filterContext) (map[string]bool, error) {
+	result := make(map[string]bool)
+	if ctx.lookupIndex != nil {
+		list, indexed, err := ctx.lookupIndex(c.property, c.operator, c.value)
+		if err != nil {
+			return nil, err
+		}
+		if indexed {
+			for _, member := range list {
+				result[member] = true
+			}
+			return result, nil
+		}
+	}
+	if err := ctx.readResources(); err != nil {
+		return nil, err
+	}
+	for _, member := range ctx.members {
+		if resource := ctx.resources[member]; resource != nil && c.match(resource) {
+			result[member] = true
+		}
+	}
+	return result, nil
+}
+
+// match compares the value of the property in the resource with the value of the comparison.
+// When the property is inside an array, the comparison is satisfied if any of the elements satisfies it.
+func (c *filterComparison) match(resource map[string]interface{}) bool {
+	values := propertyValues(resource, strings.Split(c.property, "/"))
+	if len(values) == 0 {
+		values = []interface{}{nil}
+	}
+	for _, value := range values {
+		if c.compare(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// compare compares the value with the value of the comparison. Strings are compared
+// case insensitively, the same way as the comparisons answered by the string indexes.
+func (c *filterComparison) compare(value interface{}) bool {
+	if !c.quoted && c.value == "null" {
+		switch c.operator {
+		case "eq":
+			return value == nil
+		case "ne":
+			return value != nil
+		}
+		return false
+	}
+	var result int
+	switch v := value.(type) {
+	case string:
+		result = strings.Compare(strings.ToLower(v), strings.ToLower(c.value))
+	case float64:
+		n, err := strconv.ParseFloat(c.value, 64)
+		if err != nil || c.quoted {
+			return c.operator == "ne"
+		}
+		switch {
+		case v < n:
+			result = -1
+		case v > n:
+			result = 1
+		}
+	case bool:
+		b, err := strconv.ParseBool(c.value)
+		if err != nil || c.quoted {
+			return c.operator == "ne"
+		}
+		switch c.operator {
+		case "eq":
+			return v == b
+		case "ne":
+			return v != b
+		}
+		return false
+	default:
+		// null, objects and arrays are only different from the value
+		return c.operator == "ne"
+	}
+	switch c.operator {
+	case "eq":
+		return result == 0
+	case "ne":
+		return result != 0
+	case "gt":
+		return result > 0
+	case "ge":
+		return result >= 0
+	case "lt":
+		return result < 0
+	case "le":
+		return result <= 0
+	}
+	return false
+}
+
+// propertyValues returns the values of the property path in the resource,
+// arrays found in the path are flattened
+func propertyValues(resource interface{}, path []string) []interface{} {
+	switch r := resource.(type) {
+	case []interface{}:
+		var values []interface{}
+		for _, element := range r {
+			values = append(values, propertyValues(element, path)...)
+		}
+		return values
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return []interface{}{r}
+		}
+		value, ok := r[path[0]]
+		if !ok {
+			return nil
+		}
+		return propertyValues(value, path[1:])
+	}
+	if len(path) > 0 {
+		return nil
+	}
+	return []interface{}{resource}
+}
+
+// filterParser is a recursive descent parser for the $filter grammar:
+//
+//	or         = and { "or" and }
+//	and        = unary { "and" unary }
+//	unary      = "not" unary | "(" or ")" | comparison
+//	comparison = property operator value
+type filterParser struct {
+	input string
+	pos   int
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &filterLogical{operator: "or", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("and") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &filterLogical{operator: "and", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return nil, fmt.Errorf("unexpected end of the expression")
+	}
+	if p.input[p.pos] == '(' {
+		p.pos++
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.pos >= len(p.input) || p.input[p.pos] != ')' {
+			return nil, fmt.Errorf("missing closing parenthesis at position %d", p.pos)
+		}
+		p.pos++
+		return expression, nil
+	}
+	if p.acceptKeyword("not") {
+		expression, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &filterNot{expression: expression}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (filterNode, error) {
+	start := p.pos
+	property := p.readWord()
+	if property == "" {
+		return nil, fmt.Errorf("missing property at position %d", start)
+	}
+	p.skipSpaces()
+	operator := p.readWord()
+	if !filterOperators[operator] {
+		return nil, fmt.Errorf("invalid operator %q for %v", operator, property)
+	}
+	comparison := &filterComparison{property: property, operator: operator}
+	p.skipSpaces()
+	if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+		value, err := p.readQuoted()
+		if err != nil {
+			return nil, err
+		}
+		comparison.value = value
+		comparison.quoted = true
+		return comparison, nil
+	}
+	comparison.value = p.readValue()
+	if comparison.value == "" {
+		return nil, fmt.Errorf("missing value for %v %v", property, operator)
+	}
+	return comparison, nil
+}
+
+// readWord reads till the next space or parenthesis
+func (p *filterParser) readWord() string {
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune(" ()", rune(p.input[p.pos])) {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// readQuoted reads a value enclosed in single quotes, a quote inside the value is given as two quotes
+func (p *filterParser) readQuoted() (string, error) {
+	var value strings.Builder
+	for p.pos++; p.pos < len(p.input); p.pos++ {
+		if p.input[p.pos] == '\'' {
+			if p.pos+1 < len(p.input) && p.input[p.pos+1] == '\'' {
+				value.WriteByte('\'')
+				p.pos++
+				continue
+			}
+			p.pos++
+			return value.String(), nil
+		}
+		value.WriteByte(p.input[p.pos])
+	}
+	return "", fmt.Errorf("missing closing quote for %v", value.String())
+}
+
+// readValue reads an unquoted value, which can have spaces and balanced parentheses in it
+// as in Intel(R) Xeon(R) Gold 6152 CPU. The value ends before and, or or an unmatched closing parenthesis.
+func (p *filterParser) readValue() string {
+	start := p.pos
+	depth := 0
+	for ; p.pos < len(p.input); p.pos++ {
+		switch p.input[p.pos] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return strings.TrimSpace(p.input[start:p.pos])
+			}
+			depth--
+		case ' ':
+			if depth == 0 && (p.isKeywordAt(p.pos+1, "and") || p.isKeywordAt(p.pos+1, "or")) {
+				return strings.TrimSpace(p.input[start:p.pos])
+			}
+		}
+	}
+	return strings.TrimSpace(p.input[start:])
+}
+
+// acceptKeyword consumes the keyword if it is the next word in the expression
+func (p *filterParser) acceptKeyword(keyword string) bool {
+	p.skipSpaces()
+	if !p.isKeywordAt(p.pos, keyword) {
+		return false
+	}
+	p.pos += len(keyword)
+	return true
+}
+
+func (p *filterParser) isKeywordAt(pos int, keyword string) bool {
+	end := pos + len(keyword)
+	if end > len(p.input) || p.input[pos:end] != keyword {
+		return false
+	}
+	return end == len(p.input) || p.input[end] == ' ' || p.input[end] == '('
+}
+
+func (p *filterParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
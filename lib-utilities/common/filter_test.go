@@ -0,0 +1,190 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+)
+
+var mockFilterResources = map[string]string{
+	"/redfish/v1/Chassis/1": `{"@odata.id":"/redfish/v1/Chassis/1","ChassisType":"RackMount","PowerState":"On","Status":{"Health":"OK"},"Oem":{"Slots":[{"Count":2},{"Count":4}]},"Enabled":true}`,
+	"/redfish/v1/Chassis/2": `{"@odata.id":"/redfish/v1/Chassis/2","ChassisType":"Enclosure","PowerState":"Off","Status":{"Health":"Critical"},"Model":"Intel(R) Xeon(R) Gold 6152 CPU","Enabled":false}`,
+	"/redfish/v1/Chassis/3": `{"@odata.id":"/redfish/v1/Chassis/3","ChassisType":"RackMount","PowerState":"Off","Status":{"Health":"Warning"},"Oem":{"Slots":[{"Count":8}]}}`,
+}
+
+var mockFilterMembers = []string{"/redfish/v1/Chassis/1", "/redfish/v1/Chassis/2", "/redfish/v1/Chassis/3"}
+
+func mockFilterGetMember(odataID string) (string, *errors.Error) {
+	if data, ok := mockFilterResources[odataID]; ok {
+		return data, nil
+	}
+	return "", errors.PackError(errors.DBKeyNotFound, "no data with the key ", odataID, " found")
+}
+
+func TestParseFilter(t *testing.T) {
+	invalid := []string{
+		"",
+		"Name",
+		"Name eq",
+		"Name has abc",
+		"(Name eq abc",
+		"Name eq abc)",
+		"Name eq 'abc",
+		"Name eq abc and",
+		"not",
+	}
+	for _, expression := range invalid {
+		if _, err := ParseFilter(expression); err == nil {
+			t.Errorf("ParseFilter(%q) expected an error", expression)
+		}
+	}
+	f, err := ParseFilter("Model eq Intel(R) Xeon(R) Gold 6152 CPU and PowerState eq Off")
+	if err != nil {
+		t.Fatalf("ParseFilter() unexpected error = %v", err)
+	}
+	want := &filterLogical{
+		operator: "and",
+		left:     &filterComparison{property: "Model", operator: "eq", value: "Intel(R) Xeon(R) Gold 6152 CPU"},
+		right:    &filterComparison{property: "PowerState", operator: "eq", value: "Off"},
+	}
+	if !reflect.DeepEqual(f.root, want) {
+		t.Errorf("ParseFilter() = %+v, want %+v", f.root, want)
+	}
+	f, err = ParseFilter("Name eq 'it''s (not) and'")
+	if err != nil {
+		t.Fatalf("ParseFilter() unexpected error = %v", err)
+	}
+	if c := f.root.(*filterComparison); c.value != "it's (not) and" || !c.quoted {
+		t.Errorf("ParseFilter() = %+v", c)
+	}
+}
+
+func TestFilterMembers(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       []string
+	}{
+		{"ChassisType eq RackMount", []string{"/redfish/v1/Chassis/1", "/redfish/v1/Chassis/3"}},
+		{"ChassisType eq rackmount", []string{"/redfish/v1/Chassis/1", "/redfish/v1/Chassis/3"}},
+		{"Status/Health ne OK", []string{"/redfish/v1/Chassis/2", "/redfish/v1/Chassis/3"}},
+		{"ChassisType eq RackMount and PowerState eq Off", []string{"/redfish/v1/Chassis/3"}},
+		{"PowerState eq On or Status/Health eq 'Critical'", []string{"/redfish/v1/Chassis/1", "/redfish/v1/Chassis/2"}},
+		{"not (PowerState eq On or Status/Health eq Critical)", []string{"/redfish/v1/Chassis/3"}},
+		{"not PowerState eq On and not Status/Health eq Critical", []string{"/redfish/v1/Chassis/3"}},
+		{"Oem/Slots/Count gt 3", []string{"/redfish/v1/Chassis/1", "/redfish/v1/Chassis/3"}},
+		{"Oem/Slots/Count le 2", []string{"/redfish/v1/Chassis/1"}},
+		{"Oem eq null", []string{"/redfish/v1/Chassis/2"}},
+		{"Enabled eq true", []string{"/redfish/v1/Chassis/1"}},
+		{"Model eq Intel(R) Xeon(R) Gold 6152 CPU", []string{"/redfish/v1/Chassis/2"}},
+		{"Status/Health ge OK", []string{"/redfish/v1/Chassis/1", "/redfish/v1/Chassis/3"}},
+		{"Unknown eq abc", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			f, err := ParseFilter(tt.expression)
+			if err != nil {
+				t.Fatalf("ParseFilter() unexpected error = %v", err)
+			}
+			got, err := f.Members(mockFilterMembers, EachMember(mockFilterGetMember), nil)
+			if err != nil {
+				t.Fatalf("Members() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Members() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterMembersWithIndex(t *testing.T) {
+	var lookups int
+	lookupIndex := func(property, operator, value string) ([]string, bool, error) {
+		switch property {
+		case "ChassisType":
+			lookups++
+			return []string{"/redfish/v1/Chassis/1", "/redfish/v1/Chassis/3", "/redfish/v1/Chassis/4"}, true, nil
+		case "Broken":
+			return nil, false, fmt.Errorf("connection refused")
+		}
+		return nil, false, nil
+	}
+	f, _ := ParseFilter("ChassisType eq RackMount and PowerState eq Off")
+	got, err := f.Members(mockFilterMembers, EachMember(mockFilterGetMember), lookupIndex)
+	if err != nil {
+		t.Fatalf("Members() unexpected error = %v", err)
+	}
+	if want := []string{"/redfish/v1/Chassis/3"}; !reflect.DeepEqual(got, want) || lookups != 1 {
+		t.Errorf("Members() = %v, want %v", got, want)
+	}
+	f, _ = ParseFilter("Broken eq abc")
+	if _, err := f.Members(mockFilterMembers, EachMember(mockFilterGetMember), lookupIndex); err == nil {
+		t.Errorf("Members() expected an error from the index")
+	}
+}
+
+func TestFilterIndexForm(t *testing.T) {
+	resource := map[string]interface{}{
+		"ChassisType": "RackMount",
+		"Status":      map[string]interface{}{"Health": "OK"},
+		"Enabled":     true,
+		"Power":       float64(200),
+		"Slots":       []interface{}{float64(1), float64(2)},
+	}
+	indexes := map[string]string{
+		"ChassisType":   "string",
+		"Status/Health": "string",
+		"Enabled":       "string",
+		"Power":         "float64",
+		"Slots":         "float64",
+		"Missing":       "string",
+	}
+	want := map[string]interface{}{
+		"FilterIndex:Chassis:ChassisType":   "RackMount",
+		"FilterIndex:Chassis:Status/Health": "OK",
+		"FilterIndex:Chassis:Enabled":       "true",
+		"FilterIndex:Chassis:Power":         float64(200),
+	}
+	if got := filterIndexForm("Chassis", resource, indexes); !reflect.DeepEqual(got, want) {
+		t.Errorf("filterIndexForm() = %v, want %v", got, want)
+	}
+}
+
+func TestSetFilterIndexes(t *testing.T) {
+	schema := []byte(`{"searchKeys":[],"filterIndexes":{"Chassis":[{"Status/Health":{"type":"string"}},{"Power":{"type":"float64"}}]}}`)
+	if err := SetFilterIndexes(schema); err != nil {
+		t.Fatalf("SetFilterIndexes() unexpected error = %v", err)
+	}
+	defer SetFilterIndexes([]byte(`{}`))
+	want := map[string]string{"Status/Health": "string", "Power": "float64"}
+	if got := getFilterIndexes("Chassis"); !reflect.DeepEqual(got, want) {
+		t.Errorf("getFilterIndexes() = %v, want %v", got, want)
+	}
+	if got, indexed, err := FilterIndexLookup("Managers")("Status/Health", "eq", "OK"); indexed || err != nil || got != nil {
+		t.Errorf("FilterIndexLookup() should not answer for a table without indexes")
+	}
+	if err := SetFilterIndexes([]byte(`{`)); err == nil {
+		t.Errorf("SetFilterIndexes() expected an error for invalid schema")
+	}
+}
+
+func TestEscapeGlob(t *testing.T) {
+	if got := escapeGlob(`a*b?[c]\`); got != `a\*b\?\[c\]\\` {
+		t.Errorf("escapeGlob() = %v", got)
+	}
+}
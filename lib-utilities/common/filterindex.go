@@ -0,0 +1,199 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+)
+
+// filterIndexPrefix is prefixed to the name of the secondary indexes used by $filter
+const filterIndexPrefix = "FilterIndex:"
+
+// filterIndexSchema is the filterIndexes section of the search/filter schema file.
+// It holds the properties indexed for each of the resource tables along with their type,
+// in the same form as the searchKeys of ComputerSystem, for e.g.
+//
+//	"filterIndexes": {"Chassis": [{"Status/Health": {"type": "string"}}]}
+type filterIndexSchema struct {
+	FilterIndexes map[string][]map[string]map[string]string `json:"filterIndexes"`
+}
+
+var (
+	filterIndexes     = make(map[string]map[string]string)
+	filterIndexesLock sync.RWMutex
+)
+
+// LoadFilterIndexes reads the per table index definitions from the search/filter schema file.
+// It is expected to be called at the service startup and whenever the configuration changes.
+func LoadFilterIndexes() error {
+	config.TLSConfMutex.RLock()
+	schemaPath := config.Data.SearchAndFilterSchemaPath
+	config.TLSConfMutex.RUnlock()
+	data, err := ioutil.ReadFile(schemaPath)
+	if err != nil {
+		return fmt.Errorf("error while trying to read search/filter schema json: %v", err)
+	}
+	return SetFilterIndexes(data)
+}
+
+// SetFilterIndexes sets the per table index definitions from the content of the search/filter schema file
+func SetFilterIndexes(schema []byte) error {
+	var sf filterIndexSchema
+	if err := json.Unmarshal(schema, &sf); err != nil {
+		return fmt.Errorf("error while trying to fetch search/filter schema json: %v", err)
+	}
+	indexes := make(map[string]map[string]string)
+	for table, properties := range sf.FilterIndexes {
+		indexes[table] = make(map[string]string)
+		for _, property := range properties {
+			for name, attributes := range property {
+				indexes[table][name] = attributes["type"]
+			}
+		}
+	}
+	filterIndexesLock.Lock()
+	filterIndexes = indexes
+	filterIndexesLock.Unlock()
+	return nil
+}
+
+// getFilterIndexes returns the indexed properties of the table along with their type
+func getFilterIndexes(table string) map[string]string {
+	filterIndexesLock.RLock()
+	defer filterIndexesLock.RUnlock()
+	return filterIndexes[table]
+}
+
+// filterIndexName returns the name of the secondary index of a property in a table
+func filterIndexName(table, property string) string {
+	return filterIndexPrefix + table + ":" + property
+}
+
+// UpdateFilterIndex updates the secondary indexes of the resource for the properties
+// configured for the table. It does nothing if there is no index configured for the table.
+func UpdateFilterIndex(table, key string, data []byte) error {
+	indexes := getFilterIndexes(table)
+	if len(indexes) == 0 {
+		return nil
+	}
+	var resource map[string]interface{}
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return fmt.Errorf("error while trying to unmarshal %v for indexing: %v", key, err)
+	}
+	form := filterIndexForm(table, resource, indexes)
+	if len(form) == 0 {
+		return nil
+	}
+	conn, dbErr := GetDBConnection(InMemory)
+	if dbErr != nil {
+		return fmt.Errorf("error while trying to connecting to DB: %v", dbErr.Error())
+	}
+	return conn.UpdateResourceIndex(form, key)
+}
+
+// filterIndexForm builds the index values of the resource. Only the properties having a single
+// value of the configured type are indexed, the others are left to be evaluated on the stored JSON.
+func filterIndexForm(table string, resource map[string]interface{}, indexes map[string]string) map[string]interface{} {
+	form := make(map[string]interface{})
+	for property, indexType := range indexes {
+		values := propertyValues(resource, strings.Split(property, "/"))
+		if len(values) != 1 {
+			continue
+		}
+		switch v := values[0].(type) {
+		case string:
+			if indexType == "string" {
+				form[filterIndexName(table, property)] = v
+			}
+		case bool:
+			if indexType == "string" {
+				form[filterIndexName(table, property)] = strconv.FormatBool(v)
+			}
+		case float64:
+			if indexType == "float64" {
+				form[filterIndexName(table, property)] = v
+			}
+		}
+	}
+	return form
+}
+
+// FilterIndexLookup returns the FilterIndexFunc which answers the comparisons on the properties
+// indexed for the table. String properties are looked up with eq, in which case the comparison
+// is case insensitive, and float64 properties with all the operators except ne.
+func FilterIndexLookup(table string) FilterIndexFunc {
+	return func(property, operator, value string) ([]string, bool, error) {
+		indexType, ok := getFilterIndexes(table)[property]
+		if !ok {
+			return nil, false, nil
+		}
+		conn, dbErr := GetDBConnection(InMemory)
+		if dbErr != nil {
+			return nil, false, fmt.Errorf("error while trying to connecting to DB: %v", dbErr.Error())
+		}
+		index := filterIndexName(table, property)
+		switch indexType {
+		case "string":
+			if operator != "eq" {
+				// ne is answered by the stored JSON, since the resources without
+				// the property are not present in the index
+				return nil, false, nil
+			}
+			list, err := conn.GetString(index, 0, escapeGlob(value)+"::*", false)
+			return list, err == nil, err
+		case "float64":
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil || operator == "ne" {
+				return nil, false, nil
+			}
+			score := strconv.FormatFloat(n, 'f', -1, 64)
+			min, max := "-inf", "+inf"
+			switch operator {
+			case "eq":
+				min, max = score, score
+			case "gt":
+				min = "(" + score
+			case "ge":
+				min = score
+			case "lt":
+				max = "(" + score
+			case "le":
+				max = score
+			}
+			list, err := conn.GetRangeByScore(index, min, max)
+			return list, err == nil, err
+		}
+		return nil, false, nil
+	}
+}
+
+// escapeGlob escapes the special characters of the redis glob style pattern
+func escapeGlob(value string) string {
+	var escaped strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(`*?[]\`, r) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
@@ -0,0 +1,185 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// CertificatesTable is the on-disk DB table holding the key pairs of odimra replaced with
+	// the certificate service, keyed by the name of the key pair, so that every service serves them
+	CertificatesTable = "ODIMRACertificates"
+	// APIGatewayKeyPair is the name of the key pair of the API gateway in CertificatesTable
+	APIGatewayKeyPair = "APIGateway"
+	// RPCKeyPair is the name of the key pair of the RPCs between the services in CertificatesTable
+	RPCKeyPair = "RPC"
+
+	// keyPairPollInterval is the interval at which the services check if a key pair was replaced
+	keyPairPollInterval = 30 * time.Second
+)
+
+// KeyPair is a key pair of odimra saved in the DB. The private key is sealed with a random
+// AES key, which is encrypted with the RSA public key of odimra like the device passwords.
+type KeyPair struct {
+	Certificate []byte `json:"Certificate"`
+	PrivateKey  []byte `json:"PrivateKey"`
+	DataKey     []byte `json:"DataKey"`
+}
+
+// SaveKeyPair saves the key pair in the DB, the certificate and the private key are written
+// together, so that the services never load a certificate with the private key of another one
+func SaveKeyPair(name string, certificatePEM, privateKeyPEM []byte) error {
+	keyPair, err := sealKeyPair(certificatePEM, privateKeyPEM)
+	if err != nil {
+		return err
+	}
+	conn, gerr := GetDBConnection(OnDisk)
+	if gerr != nil {
+		return gerr
+	}
+	if gerr := conn.AddResourceData(CertificatesTable, name, keyPair); gerr != nil {
+		return gerr
+	}
+	return nil
+}
+
+// GetKeyPair reads the key pair from the DB, the error is of type DBKeyNotFound
+// when the key pair was never replaced
+func GetKeyPair(name string) ([]byte, []byte, *errors.Error) {
+	conn, err := GetDBConnection(OnDisk)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := conn.Read(CertificatesTable, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return openKeyPair(data)
+}
+
+// DeleteKeyPair deletes the key pair from the DB
+func DeleteKeyPair(name string) error {
+	conn, err := GetDBConnection(OnDisk)
+	if err != nil {
+		return err
+	}
+	if err := conn.Delete(CertificatesTable, name); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WatchKeyPair loads the key pair saved in the DB into the certificate store, and loads it again
+// whenever it is replaced, so that a certificate replaced by svc-managers is served by all the services
+func WatchKeyPair(name string, store *config.CertificateStore) {
+	var loaded [sha256.Size]byte
+	for ; ; time.Sleep(keyPairPollInterval) {
+		conn, err := GetDBConnection(OnDisk)
+		if err != nil {
+			log.Error("unable to check the " + name + " key pair: " + err.Error())
+			continue
+		}
+		data, err := conn.Read(CertificatesTable, name)
+		if err != nil {
+			if err.ErrNo() != errors.DBKeyNotFound {
+				log.Error("unable to check the " + name + " key pair: " + err.Error())
+			}
+			continue
+		}
+		digest := sha256.Sum256([]byte(data))
+		if digest == loaded {
+			continue
+		}
+		certificatePEM, privateKeyPEM, err := openKeyPair(data)
+		if err != nil {
+			log.Error("unable to read the " + name + " key pair: " + err.Error())
+			continue
+		}
+		if err := store.Set(certificatePEM, privateKeyPEM); err != nil {
+			log.Error("unable to load the " + name + " key pair: " + err.Error())
+			continue
+		}
+		loaded = digest
+		log.Info("the " + name + " key pair saved in the DB is loaded")
+	}
+}
+
+func sealKeyPair(certificatePEM, privateKeyPEM []byte) (*KeyPair, error) {
+	dataKey := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	encryptedDataKey, err := EncryptWithPublicKey(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{
+		Certificate: certificatePEM,
+		PrivateKey:  aead.Seal(nonce, nonce, privateKeyPEM, certificatePEM),
+		DataKey:     encryptedDataKey,
+	}, nil
+}
+
+func openKeyPair(data string) ([]byte, []byte, *errors.Error) {
+	var keyPair KeyPair
+	if err := json.Unmarshal([]byte(data), &keyPair); err != nil {
+		return nil, nil, errors.PackError(errors.UndefinedErrorType, "error while trying to unmarshal the key pair: ", err)
+	}
+	dataKey, err := DecryptWithPrivateKey(keyPair.DataKey)
+	if err != nil {
+		return nil, nil, errors.PackError(errors.UndefinedErrorType, err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, nil, errors.PackError(errors.UndefinedErrorType, err)
+	}
+	if len(keyPair.PrivateKey) < aead.NonceSize() {
+		return nil, nil, errors.PackError(errors.UndefinedErrorType, "error: the private key of the key pair is truncated")
+	}
+	nonce, sealed := keyPair.PrivateKey[:aead.NonceSize()], keyPair.PrivateKey[aead.NonceSize():]
+	privateKeyPEM, err := aead.Open(nil, nonce, sealed, keyPair.Certificate)
+	if err != nil {
+		return nil, nil, errors.PackError(errors.UndefinedErrorType, fmt.Sprintf("error while trying to decrypt the private key: %v", err))
+	}
+	return keyPair.Certificate, privateKeyPEM, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
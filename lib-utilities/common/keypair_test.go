@@ -0,0 +1,52 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+)
+
+func TestSealKeyPair(t *testing.T) {
+	config.Data.KeyCertConf = &config.KeyCertConf{
+		RSAPublicKey:  []byte(publicKey),
+		RSAPrivateKey: []byte(privateKey),
+	}
+
+	keyPair, err := sealKeyPair([]byte("certificate"), []byte("private key"))
+	if err != nil {
+		t.Fatalf("sealKeyPair failed with %v", err)
+	}
+	data, _ := json.Marshal(keyPair)
+	if string(keyPair.PrivateKey) == "private key" {
+		t.Errorf("sealKeyPair saved the private key in clear")
+	}
+	certificatePEM, privateKeyPEM, gerr := openKeyPair(string(data))
+	if gerr != nil {
+		t.Fatalf("openKeyPair failed with %v", gerr)
+	}
+	if string(certificatePEM) != "certificate" || string(privateKeyPEM) != "private key" {
+		t.Errorf("openKeyPair = %s, %s, want certificate, private key", certificatePEM, privateKeyPEM)
+	}
+
+	// the private key is bound to its certificate
+	keyPair.Certificate = []byte("other certificate")
+	data, _ = json.Marshal(keyPair)
+	if _, _, gerr := openKeyPair(string(data)); gerr == nil {
+		t.Errorf("openKeyPair expected an error for the private key of another certificate")
+	}
+}
@@ -93,6 +93,9 @@ func SetUpMockConfig() error {
 		MaxResetPriority:    10,
 		MaxResetDelayInSecs: 36000,
 	}
+	config.Data.SchemaValidationConf = &config.SchemaValidationConf{
+		Mode: config.SchemaValidationOff,
+	}
 	config.Data.PluginStatusPolling = &config.PluginStatusPolling{
 		MaxRetryAttempt:         1,
 		RetryIntervalInMins:     1,
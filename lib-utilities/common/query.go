@@ -0,0 +1,441 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	log "github.com/sirupsen/logrus"
+)
+
+// Query parameters supported on the collections
+const (
+	ExpandQuery = "$expand"
+	SelectQuery = "$select"
+	TopQuery    = "$top"
+	SkipQuery   = "$skip"
+	FilterQuery = "$filter"
+	// MaxExpandLevels is the maximum $levels supported with $expand
+	MaxExpandLevels = 1
+)
+
+// GetMemberFunc reads a member of a collection from the DB using its @odata.id
+type GetMemberFunc func(odataID string) (string, *errors.Error)
+
+// GetMembersFunc reads the members of a collection using their @odata.id, in a single request to the DB.
+// The members are returned by @odata.id and the members which are not found are left out.
+type GetMembersFunc func(odataIDs []string) (map[string]string, *errors.Error)
+
+// GetMembersFromDB returns the GetMembersFunc reading the members saved as resources, that is
+// with the JSON of the resource saved as a string, in the table of the DB
+func GetMembersFromDB(table string, dbFlag DbType) GetMembersFunc {
+	return func(odataIDs []string) (map[string]string, *errors.Error) {
+		conn, err := GetDBConnection(dbFlag)
+		if err != nil {
+			return nil, err
+		}
+		values, err := conn.ReadMultiple(table, odataIDs)
+		if err != nil {
+			return nil, errors.PackError(err.ErrNo(), "error while trying to get the members of ", table, ": ", err.Error())
+		}
+		members := make(map[string]string, len(values))
+		for odataID, value := range values {
+			var member string
+			if jerr := json.Unmarshal([]byte(value), &member); jerr != nil {
+				log.Error("error while reading the member " + odataID + ": " + jerr.Error())
+				continue
+			}
+			members[odataID] = member
+		}
+		return members, nil
+	}
+}
+
+// EachMember returns the GetMembersFunc reading the members one by one with getMember,
+// for the collections whose members are not read from the DB
+func EachMember(getMember GetMemberFunc) GetMembersFunc {
+	return func(odataIDs []string) (map[string]string, *errors.Error) {
+		members := make(map[string]string, len(odataIDs))
+		for _, odataID := range odataIDs {
+			data, err := getMember(odataID)
+			if err != nil {
+				log.Error("error while reading the member " + odataID + ": " + err.Error())
+				continue
+			}
+			members[odataID] = data
+		}
+		return members, nil
+	}
+}
+
+// QueryOptions holds the $filter, $expand, $select, $top and $skip query parameters of a collection request
+type QueryOptions struct {
+	Expand string
+	Levels int
+	Select []string
+	Top    int
+	Skip   int
+	Filter string
+	// filter is the parsed $filter expression
+	filter *Filter
+	// path and rawParams hold the request path and the query parameters other than $skip,
+	// used for building Members@odata.nextLink
+	path      string
+	rawParams []string
+	// topParam is the $top query parameter as it is present in the request
+	topParam string
+}
+
+// IsEmpty returns true when none of $filter, $expand, $select, $top and $skip are requested
+func (q *QueryOptions) IsEmpty() bool {
+	return q.Filter == "" && q.Expand == "" && len(q.Select) == 0 && q.Top < 0 && q.Skip == 0
+}
+
+// ParseQueryOptions parses the query parameters present in the request uri.
+// In case of an invalid value the error response is returned along with the error.
+func ParseQueryOptions(uri string) (*QueryOptions, response.RPC, error) {
+	options := &QueryOptions{Top: -1, Levels: MaxExpandLevels}
+	paramStr := strings.SplitN(uri, "?", 2)
+	options.path = paramStr[0]
+	if len(paramStr) < 2 {
+		return options, response.RPC{}, nil
+	}
+	for _, param := range strings.Split(paramStr[1], "&") {
+		if param == "" {
+			continue
+		}
+		keyValue := strings.SplitN(param, "=", 2)
+		key, err := url.QueryUnescape(keyValue[0])
+		if err != nil {
+			key = keyValue[0]
+		}
+		var value string
+		if len(keyValue) > 1 {
+			if value, err = url.QueryUnescape(keyValue[1]); err != nil {
+				value = keyValue[1]
+			}
+		}
+		if key != SkipQuery {
+			options.rawParams = append(options.rawParams, param)
+		}
+		switch key {
+		case ExpandQuery:
+			if resp, err := options.parseExpand(value); err != nil {
+				return nil, resp, err
+			}
+		case SelectQuery:
+			for _, property := range strings.Split(value, ",") {
+				if property = strings.TrimSpace(property); property != "" {
+					options.Select = append(options.Select, property)
+				}
+			}
+			if len(options.Select) == 0 {
+				resp, err := queryValueTypeError(value, key)
+				return nil, resp, err
+			}
+		case TopQuery:
+			if options.Top, err = parseNonNegative(value); err != nil {
+				resp, err := queryValueError(value, key, err)
+				return nil, resp, err
+			}
+			options.topParam = param
+		case SkipQuery:
+			if options.Skip, err = parseNonNegative(value); err != nil {
+				resp, err := queryValueError(value, key, err)
+				return nil, resp, err
+			}
+		case FilterQuery:
+			if options.filter, err = ParseFilter(value); err != nil {
+				errorMessage := "error: invalid value " + value + " for " + key + ": " + err.Error()
+				log.Error(errorMessage)
+				return nil, GeneralError(http.StatusBadRequest, response.QueryParameterValueTypeError, errorMessage, []interface{}{value, key}, nil), fmt.Errorf(errorMessage)
+			}
+			options.Filter = value
+		default:
+			if strings.HasPrefix(key, "$") {
+				errorMessage := "error: query parameter " + key + " is not supported"
+				log.Error(errorMessage)
+				return nil, GeneralError(http.StatusBadRequest, response.QueryNotSupported, errorMessage, nil, nil), fmt.Errorf(errorMessage)
+			}
+		}
+	}
+	return options, response.RPC{}, nil
+}
+
+// parseExpand validates the $expand value, which can be * or . with an optional ($levels=n).
+// ~ is rejected with 501 Not Implemented since the expansion of the Links is not supported.
+func (q *QueryOptions) parseExpand(value string) (response.RPC, error) {
+	expand := value
+	if i := strings.Index(value, "("); i > 0 && strings.HasSuffix(value, ")") {
+		expand = value[:i]
+		levels := strings.SplitN(strings.TrimSuffix(value[i+1:], ")"), "=", 2)
+		if len(levels) != 2 || levels[0] != "$levels" {
+			return queryValueTypeError(value, ExpandQuery)
+		}
+		n, err := strconv.Atoi(levels[1])
+		if err != nil {
+			return queryValueTypeError(value, ExpandQuery)
+		}
+		if n < 1 || n > MaxExpandLevels {
+			errorMessage := "error: $levels " + levels[1] + " is not supported"
+			log.Error(errorMessage)
+			return GeneralError(http.StatusBadRequest, response.QueryParameterOutOfRange, errorMessage, []interface{}{value, ExpandQuery, "1-" + strconv.Itoa(MaxExpandLevels)}, nil), fmt.Errorf(errorMessage)
+		}
+		q.Levels = n
+	}
+	switch expand {
+	case "*", ".":
+		q.Expand = expand
+	case "~":
+		errorMessage := "error: " + ExpandQuery + "=" + value + " is not supported"
+		log.Error(errorMessage)
+		return GeneralError(http.StatusNotImplemented, response.QueryNotSupported, errorMessage, nil, nil), fmt.Errorf(errorMessage)
+	default:
+		return queryValueTypeError(value, ExpandQuery)
+	}
+	return response.RPC{}, nil
+}
+
+// GetFilterQuery returns the $filter parameter of the uri as it is present in the request,
+// empty string is returned if $filter is not present
+func GetFilterQuery(uri string) string {
+	paramStr := strings.SplitN(uri, "?", 2)
+	if len(paramStr) < 2 {
+		return ""
+	}
+	for _, param := range strings.Split(paramStr[1], "&") {
+		if strings.HasPrefix(param, FilterQuery+"=") || strings.HasPrefix(param, url.QueryEscape(FilterQuery)+"=") {
+			return param
+		}
+	}
+	return ""
+}
+
+// ApplyQueryOptions applies the $filter, $skip, $top, $expand and $select query parameters of the uri on the
+// collection present in the response body. Members are filtered and expanded by reading them with getMembers,
+// and Members@odata.nextLink is added when more members are available after the page returned.
+// The response is returned as it is when it is not a successful one or when no query parameter is requested.
+func ApplyQueryOptions(resp response.RPC, uri string, getMembers GetMembersFunc) response.RPC {
+	return ApplyQueryOptionsWithIndex(resp, uri, getMembers, nil)
+}
+
+// ApplyQueryOptionsWithIndex is same as ApplyQueryOptions, except that the comparisons of $filter
+// on the indexed properties are answered by lookupIndex instead of reading all the members.
+func ApplyQueryOptionsWithIndex(resp response.RPC, uri string, getMembers GetMembersFunc, lookupIndex FilterIndexFunc) response.RPC {
+	if resp.StatusCode != http.StatusOK {
+		return resp
+	}
+	options, errResp, err := ParseQueryOptions(uri)
+	if err != nil {
+		return errResp
+	}
+	if options.IsEmpty() {
+		return resp
+	}
+	collection, err := toMap(resp.Body)
+	if err != nil {
+		errorMessage := "error while applying query parameters on the collection: " + err.Error()
+		log.Error(errorMessage)
+		return GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	var members []interface{}
+	if m, ok := collection["Members"].([]interface{}); ok {
+		members = m
+	}
+	if options.filter != nil {
+		var ids []string
+		for _, member := range members {
+			ids = append(ids, getOdataID(member))
+		}
+		matched, err := options.filter.Members(ids, getMembers, lookupIndex)
+		if filterErr, ok := err.(*FilterError); ok {
+			log.Error("error while evaluating " + FilterQuery + " on the collection: " + err.Error())
+			return filterErr.Response
+		}
+		if err != nil {
+			errorMessage := "error while evaluating " + FilterQuery + " on the collection: " + err.Error()
+			log.Error(errorMessage)
+			return GeneralError(http.StatusServiceUnavailable, response.CouldNotEstablishConnection, errorMessage, []interface{}{config.Data.DBConf.InMemoryHost + ":" + config.Data.DBConf.InMemoryPort}, nil)
+		}
+		members = make([]interface{}, 0, len(matched))
+		for _, id := range matched {
+			members = append(members, map[string]interface{}{"@odata.id": id})
+		}
+		collection["Members@odata.count"] = len(members)
+	}
+	if options.Top >= 0 || options.Skip > 0 {
+		sort.SliceStable(members, func(i, j int) bool {
+			return getOdataID(members[i]) < getOdataID(members[j])
+		})
+		total := len(members)
+		start := options.Skip
+		if start > total {
+			start = total
+		}
+		end := total
+		if options.Top >= 0 && start+options.Top < total {
+			end = start + options.Top
+		}
+		members = members[start:end]
+		if end < total {
+			collection["Members@odata.nextLink"] = options.nextLink(end)
+		}
+	}
+	if (options.Expand == "*" || options.Expand == ".") && len(members) > 0 {
+		odataIDs := make([]string, 0, len(members))
+		for _, member := range members {
+			odataIDs = append(odataIDs, getOdataID(member))
+		}
+		memberData, err := getMembers(odataIDs)
+		if err != nil {
+			errorMessage := "error while expanding the members of the collection: " + err.Error()
+			log.Error(errorMessage)
+			return GeneralError(http.StatusServiceUnavailable, response.CouldNotEstablishConnection, errorMessage, []interface{}{config.Data.DBConf.InMemoryHost + ":" + config.Data.DBConf.InMemoryPort}, nil)
+		}
+		for i, odataID := range odataIDs {
+			data, ok := memberData[odataID]
+			if !ok {
+				log.Error("error while expanding the member " + odataID + ": member not found")
+				continue
+			}
+			var expanded map[string]interface{}
+			if err := json.Unmarshal([]byte(data), &expanded); err != nil {
+				log.Error("error while expanding the member " + odataID + ": " + err.Error())
+				continue
+			}
+			if len(options.Select) > 0 {
+				expanded = selectProperties(expanded, options.Select)
+			}
+			members[i] = expanded
+		}
+	}
+	if members == nil {
+		members = []interface{}{}
+	}
+	collection["Members"] = members
+	if len(options.Select) > 0 {
+		properties := append([]string{"Members", "Members@odata.count", "Members@odata.nextLink"}, options.Select...)
+		collection = selectProperties(collection, properties)
+	}
+	resp.Body = collection
+	return resp
+}
+
+// nextLink builds the uri of the next page of the collection. With $top=0 no member is returned,
+// so $top is left out of the next page which returns the remaining members.
+func (q *QueryOptions) nextLink(skip int) string {
+	var params []string
+	for _, param := range q.rawParams {
+		if q.Top == 0 && param == q.topParam {
+			continue
+		}
+		params = append(params, param)
+	}
+	params = append(params, SkipQuery+"="+strconv.Itoa(skip))
+	return q.path + "?" + strings.Join(params, "&")
+}
+
+// selectProperties returns the annotations and the properties requested with $select,
+// nested properties can be requested in the form Status/Health
+func selectProperties(resource map[string]interface{}, properties []string) map[string]interface{} {
+	selected := make(map[string]interface{})
+	for key, value := range resource {
+		if strings.HasPrefix(key, "@odata.") {
+			selected[key] = value
+		}
+	}
+	for _, property := range properties {
+		path := strings.SplitN(property, "/", 2)
+		value, ok := resource[path[0]]
+		if !ok {
+			continue
+		}
+		if len(path) == 1 {
+			selected[path[0]] = value
+			continue
+		}
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		subset := selectProperties(nested, []string{path[1]})
+		if existing, ok := selected[path[0]].(map[string]interface{}); ok {
+			for k, v := range subset {
+				existing[k] = v
+			}
+		} else {
+			selected[path[0]] = subset
+		}
+	}
+	return selected
+}
+
+func getOdataID(resource interface{}) string {
+	if r, ok := resource.(map[string]interface{}); ok {
+		if id, ok := r["@odata.id"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+func toMap(body interface{}) (map[string]interface{}, error) {
+	if m, ok := body.(map[string]interface{}); ok {
+		return m, nil
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	err = json.Unmarshal(data, &m)
+	return m, err
+}
+
+func parseNonNegative(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("value %d is less than 0", n)
+	}
+	return n, nil
+}
+
+func queryValueError(value, param string, err error) (response.RPC, error) {
+	if _, convErr := strconv.Atoi(value); convErr == nil {
+		errorMessage := "error: invalid value " + value + " for " + param + ": " + err.Error()
+		log.Error(errorMessage)
+		return GeneralError(http.StatusBadRequest, response.QueryParameterOutOfRange, errorMessage, []interface{}{value, param, "0-" + strconv.Itoa(math.MaxInt32)}, nil), fmt.Errorf(errorMessage)
+	}
+	return queryValueTypeError(value, param)
+}
+
+func queryValueTypeError(value, param string) (response.RPC, error) {
+	errorMessage := "error: invalid value " + value + " for " + param
+	log.Error(errorMessage)
+	return GeneralError(http.StatusBadRequest, response.QueryParameterValueTypeError, errorMessage, []interface{}{value, param}, nil), fmt.Errorf(errorMessage)
+}
@@ -0,0 +1,248 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+)
+
+type mockLink struct {
+	Oid string `json:"@odata.id"`
+}
+
+type mockCollection struct {
+	OdataID      string     `json:"@odata.id"`
+	OdataType    string     `json:"@odata.type"`
+	Name         string     `json:"Name"`
+	Description  string     `json:"Description"`
+	Members      []mockLink `json:"Members"`
+	MembersCount int        `json:"Members@odata.count"`
+}
+
+func mockGetMember(odataID string) (string, *errors.Error) {
+	if odataID == "/redfish/v1/Systems/3" {
+		return "", errors.PackError(errors.DBKeyNotFound, "no data with the key ", odataID, " found")
+	}
+	return `{"@odata.id":"` + odataID + `","Id":"` + odataID[len(odataID)-1:] + `","Name":"System","Status":{"State":"Enabled","Health":"OK"}}`, nil
+}
+
+// mockGetMembers reads the members with mockGetMember and records the batches of members read
+type mockGetMembers struct {
+	batches [][]string
+}
+
+func (m *mockGetMembers) getMembers(odataIDs []string) (map[string]string, *errors.Error) {
+	m.batches = append(m.batches, odataIDs)
+	return EachMember(mockGetMember)(odataIDs)
+}
+
+func mockCollectionResponse() response.RPC {
+	return response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Body: mockCollection{
+			OdataID:      "/redfish/v1/Systems",
+			OdataType:    "#ComputerSystemCollection.ComputerSystemCollection",
+			Name:         "Computer Systems",
+			Description:  "Computer Systems view",
+			Members:      []mockLink{{Oid: "/redfish/v1/Systems/2"}, {Oid: "/redfish/v1/Systems/1"}, {Oid: "/redfish/v1/Systems/3"}},
+			MembersCount: 3,
+		},
+	}
+}
+
+func TestParseQueryOptions(t *testing.T) {
+	tests := []struct {
+		name       string
+		uri        string
+		want       *QueryOptions
+		wantStatus string
+		wantCode   int32
+	}{
+		{
+			name: "no query",
+			uri:  "/redfish/v1/Systems",
+			want: &QueryOptions{Top: -1, Levels: 1, path: "/redfish/v1/Systems"},
+		},
+		{
+			name: "all options",
+			uri:  "/redfish/v1/Systems?$expand=.($levels=1)&$select=Name,Status/Health&$top=2&$skip=1&$filter=Name%20eq%20abc",
+			want: &QueryOptions{Expand: ".", Levels: 1, Select: []string{"Name", "Status/Health"}, Top: 2, Skip: 1, Filter: "Name eq abc",
+				filter: &Filter{root: &filterComparison{property: "Name", operator: "eq", value: "abc"}},
+				path:   "/redfish/v1/Systems", rawParams: []string{"$expand=.($levels=1)", "$select=Name,Status/Health", "$top=2", "$filter=Name%20eq%20abc"}, topParam: "$top=2"},
+		},
+		{
+			name:       "invalid $top",
+			uri:        "/redfish/v1/Systems?$top=abc",
+			wantStatus: response.QueryParameterValueTypeError,
+		},
+		{
+			name:       "negative $skip",
+			uri:        "/redfish/v1/Systems?$skip=-1",
+			wantStatus: response.QueryParameterOutOfRange,
+		},
+		{
+			name:       "invalid $expand",
+			uri:        "/redfish/v1/Systems?$expand=all",
+			wantStatus: response.QueryParameterValueTypeError,
+		},
+		{
+			name:       "unsupported $expand of the links",
+			uri:        "/redfish/v1/Systems?$expand=~",
+			wantStatus: response.QueryNotSupported,
+			wantCode:   http.StatusNotImplemented,
+		},
+		{
+			name:       "unsupported $levels",
+			uri:        "/redfish/v1/Systems?$expand=*($levels=3)",
+			wantStatus: response.QueryParameterOutOfRange,
+		},
+		{
+			name:       "invalid $filter",
+			uri:        "/redfish/v1/Systems?$filter=Name%20has%20abc",
+			wantStatus: response.QueryParameterValueTypeError,
+		},
+		{
+			name:       "unsupported query",
+			uri:        "/redfish/v1/Systems?$orderby=Name",
+			wantStatus: response.QueryNotSupported,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, resp, err := ParseQueryOptions(tt.uri)
+			if tt.wantStatus != "" {
+				if tt.wantCode == 0 {
+					tt.wantCode = http.StatusBadRequest
+				}
+				if err == nil || resp.StatusCode != tt.wantCode || resp.StatusMessage != tt.wantStatus {
+					t.Errorf("ParseQueryOptions() got = %v, %v, want %v", resp.StatusMessage, err, tt.wantStatus)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ParseQueryOptions() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQueryOptions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyQueryOptions(t *testing.T) {
+	resp := ApplyQueryOptions(mockCollectionResponse(), "/redfish/v1/Systems", EachMember(mockGetMember))
+	if _, ok := resp.Body.(mockCollection); !ok {
+		t.Errorf("ApplyQueryOptions() should not modify the response without query parameters")
+	}
+
+	resp = ApplyQueryOptions(mockCollectionResponse(), "/redfish/v1/Systems?$top=1&$skip=1", EachMember(mockGetMember))
+	body := resp.Body.(map[string]interface{})
+	wantMembers := []interface{}{map[string]interface{}{"@odata.id": "/redfish/v1/Systems/2"}}
+	if !reflect.DeepEqual(body["Members"], wantMembers) {
+		t.Errorf("ApplyQueryOptions() Members = %v, want %v", body["Members"], wantMembers)
+	}
+	if body["Members@odata.nextLink"] != "/redfish/v1/Systems?$top=1&$skip=2" {
+		t.Errorf("ApplyQueryOptions() Members@odata.nextLink = %v", body["Members@odata.nextLink"])
+	}
+	if body["Members@odata.count"] != float64(3) {
+		t.Errorf("ApplyQueryOptions() Members@odata.count = %v, want 3", body["Members@odata.count"])
+	}
+
+	reads := &mockGetMembers{}
+	resp = ApplyQueryOptions(mockCollectionResponse(), "/redfish/v1/Systems?$expand=*&$select=Status/Health", reads.getMembers)
+	body = resp.Body.(map[string]interface{})
+	if _, ok := body["Name"]; ok {
+		t.Errorf("ApplyQueryOptions() Name should not be present with $select")
+	}
+	if _, ok := body["Members@odata.nextLink"]; ok {
+		t.Errorf("ApplyQueryOptions() Members@odata.nextLink should not be present without $top")
+	}
+	wantMembers = []interface{}{
+		map[string]interface{}{"@odata.id": "/redfish/v1/Systems/2", "Status": map[string]interface{}{"Health": "OK"}},
+		map[string]interface{}{"@odata.id": "/redfish/v1/Systems/1", "Status": map[string]interface{}{"Health": "OK"}},
+		map[string]interface{}{"@odata.id": "/redfish/v1/Systems/3"},
+	}
+	if !reflect.DeepEqual(body["Members"], wantMembers) {
+		t.Errorf("ApplyQueryOptions() Members = %v, want %v", body["Members"], wantMembers)
+	}
+	if len(reads.batches) != 1 {
+		t.Errorf("ApplyQueryOptions() read the members in %v batches, want 1", len(reads.batches))
+	}
+
+	resp = ApplyQueryOptions(mockCollectionResponse(), "/redfish/v1/Systems?$top=0", EachMember(mockGetMember))
+	body = resp.Body.(map[string]interface{})
+	if !reflect.DeepEqual(body["Members"], []interface{}{}) {
+		t.Errorf("ApplyQueryOptions() Members = %v, want no member with $top=0", body["Members"])
+	}
+	if body["Members@odata.nextLink"] != "/redfish/v1/Systems?$skip=0" {
+		t.Errorf("ApplyQueryOptions() Members@odata.nextLink = %v, want /redfish/v1/Systems?$skip=0", body["Members@odata.nextLink"])
+	}
+	resp = ApplyQueryOptions(mockCollectionResponse(), "/redfish/v1/Systems?$top=0&$skip=3", EachMember(mockGetMember))
+	if _, ok := resp.Body.(map[string]interface{})["Members@odata.nextLink"]; ok {
+		t.Errorf("ApplyQueryOptions() Members@odata.nextLink should not be present when no member is left")
+	}
+
+	resp = ApplyQueryOptions(mockCollectionResponse(), "/redfish/v1/Systems?$filter=Id%20ne%20'2'&$top=1", EachMember(mockGetMember))
+	body = resp.Body.(map[string]interface{})
+	wantMembers = []interface{}{map[string]interface{}{"@odata.id": "/redfish/v1/Systems/1"}}
+	if !reflect.DeepEqual(body["Members"], wantMembers) {
+		t.Errorf("ApplyQueryOptions() Members = %v, want %v", body["Members"], wantMembers)
+	}
+	if body["Members@odata.count"] != 1 {
+		t.Errorf("ApplyQueryOptions() Members@odata.count = %v, want 1", body["Members@odata.count"])
+	}
+	if _, ok := body["Members@odata.nextLink"]; ok {
+		t.Errorf("ApplyQueryOptions() Members@odata.nextLink should not be present for the last page")
+	}
+
+	resp = ApplyQueryOptions(mockCollectionResponse(), "/redfish/v1/Systems?$top=-2", EachMember(mockGetMember))
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("ApplyQueryOptions() StatusCode = %v, want %v", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestApplyQueryOptionsWithIndex(t *testing.T) {
+	invalidFilter := GeneralError(http.StatusBadRequest, response.QueryNotSupported, "invalid value", nil, nil)
+	lookupIndex := func(property, operator, value string) ([]string, bool, error) {
+		if property != "Id" {
+			return nil, false, nil
+		}
+		return nil, true, &FilterError{Response: invalidFilter, Err: fmt.Errorf("invalid value")}
+	}
+	resp := ApplyQueryOptionsWithIndex(mockCollectionResponse(), "/redfish/v1/Systems?$filter=Id%20eq%20abc", EachMember(mockGetMember), lookupIndex)
+	if !reflect.DeepEqual(resp, invalidFilter) {
+		t.Errorf("ApplyQueryOptionsWithIndex() = %v, want %v", resp, invalidFilter)
+	}
+	resp = ApplyQueryOptionsWithIndex(mockCollectionResponse(), "/redfish/v1/Systems?$filter=Name%20eq%20system", EachMember(mockGetMember), lookupIndex)
+	if body := resp.Body.(map[string]interface{}); body["Members@odata.count"] != 2 {
+		t.Errorf("ApplyQueryOptionsWithIndex() Members@odata.count = %v, want 2", body["Members@odata.count"])
+	}
+}
+
+func TestGetFilterQuery(t *testing.T) {
+	if got := GetFilterQuery("/redfish/v1/Systems?$top=1&$filter=MemorySummary/TotalSystemMemoryGiB%20eq%20384"); got != "$filter=MemorySummary/TotalSystemMemoryGiB%20eq%20384" {
+		t.Errorf("GetFilterQuery() = %v", got)
+	}
+	if got := GetFilterQuery("/redfish/v1/Systems?$top=1"); got != "" {
+		t.Errorf("GetFilterQuery() = %v, want empty", got)
+	}
+}
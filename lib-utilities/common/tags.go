@@ -0,0 +1,155 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+)
+
+// ResourceTagsTable is the table of the tags set on the aggregated devices, keyed by the device UUID
+const ResourceTagsTable = "ResourceTags"
+
+// resourceTagsTables are the tables of the resources which expose the tags of their device
+var resourceTagsTables = map[string]bool{
+	"ComputerSystem": true,
+	"Chassis":        true,
+}
+
+// ResourceTags are the free-form tags and custom properties set by the users on an aggregated device.
+// They are kept apart from the inventory of the device so that they survive its rediscovery, and
+// are exposed under the Oem/ODIM section of the computer systems and chassis of the device.
+type ResourceTags struct {
+	Tags             []string          `json:"Tags"`
+	CustomProperties map[string]string `json:"CustomProperties"`
+}
+
+// IsEmpty tells whether neither tags nor custom properties are set
+func (t ResourceTags) IsEmpty() bool {
+	return len(t.Tags) == 0 && len(t.CustomProperties) == 0
+}
+
+// GetResourceTags returns the tags of the device, an empty ResourceTags is returned if none were set
+func GetResourceTags(deviceUUID string) (ResourceTags, *errors.Error) {
+	var tags ResourceTags
+	conn, err := GetDBConnection(OnDisk)
+	if err != nil {
+		return tags, err
+	}
+	data, err := conn.Read(ResourceTagsTable, deviceUUID)
+	if err != nil {
+		if err.ErrNo() == errors.DBKeyNotFound {
+			return tags, nil
+		}
+		return tags, err
+	}
+	if jerr := json.Unmarshal([]byte(data), &tags); jerr != nil {
+		return tags, errors.PackError(errors.UndefinedErrorType, "error while trying to unmarshal the tags of ", deviceUUID, ": ", jerr.Error())
+	}
+	return tags, nil
+}
+
+// SaveResourceTags sets the tags of the device, the tags are removed when they are empty
+func SaveResourceTags(deviceUUID string, tags ResourceTags) *errors.Error {
+	if tags.IsEmpty() {
+		return DeleteResourceTags(deviceUUID)
+	}
+	conn, err := GetDBConnection(OnDisk)
+	if err != nil {
+		return err
+	}
+	return conn.AddResourceData(ResourceTagsTable, deviceUUID, tags)
+}
+
+// DeleteResourceTags removes the tags of the device, it does nothing if no tags were set
+func DeleteResourceTags(deviceUUID string) *errors.Error {
+	conn, err := GetDBConnection(OnDisk)
+	if err != nil {
+		return err
+	}
+	if err = conn.Delete(ResourceTagsTable, deviceUUID); err != nil && err.ErrNo() != errors.DBKeyNotFound {
+		return err
+	}
+	return nil
+}
+
+// ResourceTagsDeviceUUID returns the UUID of the device owning the resource, for e.g.
+// the device UUID of /redfish/v1/Systems/{deviceUUID}:{id}
+func ResourceTagsDeviceUUID(resourceURI string) string {
+	id := resourceURI[strings.LastIndex(strings.TrimSuffix(resourceURI, "/"), "/")+1:]
+	index := strings.Index(id, ":")
+	if index <= 0 {
+		return ""
+	}
+	return id[:index]
+}
+
+// SetResourceTags sets the Oem/ODIM section of the resource to the tags. The section is removed
+// when the tags are empty. The values are set in the form they have once unmarshalled from JSON
+// so that the resource can be evaluated with a Filter.
+func SetResourceTags(resource map[string]interface{}, tags ResourceTags) {
+	oem, _ := resource["Oem"].(map[string]interface{})
+	if tags.IsEmpty() {
+		if oem == nil {
+			return
+		}
+		delete(oem, "ODIM")
+		if len(oem) == 0 {
+			delete(resource, "Oem")
+		}
+		return
+	}
+	tagList := make([]interface{}, 0, len(tags.Tags))
+	for _, tag := range tags.Tags {
+		tagList = append(tagList, tag)
+	}
+	customProperties := make(map[string]interface{}, len(tags.CustomProperties))
+	for name, value := range tags.CustomProperties {
+		customProperties[name] = value
+	}
+	if oem == nil {
+		oem = make(map[string]interface{})
+		resource["Oem"] = oem
+	}
+	oem["ODIM"] = map[string]interface{}{
+		"Tags":             tagList,
+		"CustomProperties": customProperties,
+	}
+}
+
+// ApplyResourceTags returns the data of the computer system or chassis with its Oem/ODIM section set
+// to the tags of its device. The data of the resources of the other tables is returned unchanged.
+func ApplyResourceTags(table, key string, data []byte) ([]byte, error) {
+	deviceUUID := ResourceTagsDeviceUUID(key)
+	if !resourceTagsTables[table] || deviceUUID == "" {
+		return data, nil
+	}
+	tags, err := GetResourceTags(deviceUUID)
+	if err != nil {
+		return nil, fmt.Errorf("error while trying to get the tags of %v: %v", deviceUUID, err.Error())
+	}
+	var resource map[string]interface{}
+	if jerr := json.Unmarshal(data, &resource); jerr != nil {
+		return nil, fmt.Errorf("error while trying to unmarshal %v: %v", key, jerr)
+	}
+	if _, ok := resource["Oem"]; !ok && tags.IsEmpty() {
+		return data, nil
+	}
+	SetResourceTags(resource, tags)
+	return json.Marshal(resource)
+}
@@ -0,0 +1,74 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceTagsDeviceUUID(t *testing.T) {
+	tests := map[string]string{
+		"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1":  "6d4a0a66-7efa-578e-83cf-44dc68d2874e",
+		"/redfish/v1/Chassis/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1/": "6d4a0a66-7efa-578e-83cf-44dc68d2874e",
+		"/redfish/v1/Chassis/6d4a0a66-7efa-578e-83cf-44dc68d2874e":    "",
+		"/redfish/v1/Systems/:1":                                      "",
+	}
+	for uri, want := range tests {
+		if got := ResourceTagsDeviceUUID(uri); got != want {
+			t.Errorf("ResourceTagsDeviceUUID(%q) = %q, want %q", uri, got, want)
+		}
+	}
+}
+
+func TestSetResourceTags(t *testing.T) {
+	resource := map[string]interface{}{"Id": "1", "Oem": map[string]interface{}{"Vendor": "data"}}
+	tags := ResourceTags{
+		Tags:             []string{"rack-1", "prod"},
+		CustomProperties: map[string]string{"Owner": "team-a"},
+	}
+	SetResourceTags(resource, tags)
+	want := map[string]interface{}{
+		"Id": "1",
+		"Oem": map[string]interface{}{
+			"Vendor": "data",
+			"ODIM": map[string]interface{}{
+				"Tags":             []interface{}{"rack-1", "prod"},
+				"CustomProperties": map[string]interface{}{"Owner": "team-a"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(resource, want) {
+		t.Errorf("SetResourceTags() = %v, want %v", resource, want)
+	}
+	f, err := ParseFilter("Oem/ODIM/Tags eq prod and Oem/ODIM/CustomProperties/Owner eq 'team-a'")
+	if err != nil {
+		t.Fatalf("ParseFilter() unexpected error = %v", err)
+	}
+	if !f.Match(resource) {
+		t.Errorf("Filter.Match() expected the tagged resource to match")
+	}
+
+	SetResourceTags(resource, ResourceTags{})
+	want = map[string]interface{}{"Id": "1", "Oem": map[string]interface{}{"Vendor": "data"}}
+	if !reflect.DeepEqual(resource, want) {
+		t.Errorf("SetResourceTags() with empty tags = %v, want %v", resource, want)
+	}
+	resource = map[string]interface{}{"Id": "1", "Oem": map[string]interface{}{"ODIM": map[string]interface{}{}}}
+	SetResourceTags(resource, ResourceTags{})
+	if _, ok := resource["Oem"]; ok {
+		t.Errorf("SetResourceTags() with empty tags expected the empty Oem section to be removed")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 )
 
 // TrackConfigFileChanges monitors the config changes using fsnotfiy
@@ -41,10 +42,13 @@ func TrackConfigFileChanges(configFilePath string, eventChan chan<- interface{})
 				}
 				if fileEvent.Op&fsnotify.Write == fsnotify.Write || fileEvent.Op&fsnotify.Remove == fsnotify.Remove {
 					log.Info("modified file:" + fileEvent.Name)
-					// update the odim config
+					// update the odim config, and the log level which can be
+					// changed without restarting the service
 					config.TLSConfMutex.Lock()
 					if err := config.SetConfiguration(); err != nil {
 						log.Error("error while trying to set configuration: " + err.Error())
+					} else if err := logs.SetLevel(config.Data.LogConf.Level); err != nil {
+						log.Error("error while trying to set the log level: " + err.Error())
 					}
 					config.TLSConfMutex.Unlock()
 					eventChan <- "config file modified"
@@ -0,0 +1,186 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/fsnotify/fsnotify"
+	log "github.com/sirupsen/logrus"
+)
+
+// CertificateStore holds the key pair served by a TLS server or client, and the root CA
+// certificate verifying the peers, so that they can be replaced while the service is running,
+// without a restart. They are replaced and read under TLSConfMutex, as the rest of the TLS configuration.
+type CertificateStore struct {
+	certificate     *tls.Certificate
+	rootCAs         *x509.CertPool
+	certificatePath string
+	privateKeyPath  string
+	rootCAPath      string
+}
+
+var (
+	// APIGatewayCertificates is the key pair of the API gateway, read from the paths of APIGatewayConf
+	APIGatewayCertificates = &CertificateStore{}
+	// RPCCertificates is the key pair of the RPC servers and clients, read from the paths of KeyCertConf
+	RPCCertificates = &CertificateStore{}
+)
+
+// Load reads the key pair and the root CA certificate from the given files, they are read again by Reload
+func (s *CertificateStore) Load(certificatePath, privateKeyPath, rootCAPath string) error {
+	s.certificatePath = certificatePath
+	s.privateKeyPath = privateKeyPath
+	s.rootCAPath = rootCAPath
+	return s.Reload()
+}
+
+// Reload reads the key pair and the root CA certificate again from their files and replaces
+// the ones in use, which are kept on failure
+func (s *CertificateStore) Reload() error {
+	certificatePEM, err := ioutil.ReadFile(s.certificatePath)
+	if err != nil {
+		return fmt.Errorf("error: failed to read the certificate %s: %v", s.certificatePath, err)
+	}
+	privateKeyPEM, err := ioutil.ReadFile(s.privateKeyPath)
+	if err != nil {
+		return fmt.Errorf("error: failed to read the private key %s: %v", s.privateKeyPath, err)
+	}
+	certificate, err := tls.X509KeyPair(certificatePEM, privateKeyPEM)
+	if err != nil {
+		return fmt.Errorf("error: failed to load key pair: %v", err)
+	}
+	rootCAPEM, err := ioutil.ReadFile(s.rootCAPath)
+	if err != nil {
+		return fmt.Errorf("error: failed to read the root CA certificate %s: %v", s.rootCAPath, err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(rootCAPEM) {
+		return fmt.Errorf("error: failed to load the root CA certificate %s", s.rootCAPath)
+	}
+	TLSConfMutex.Lock()
+	s.certificate = &certificate
+	s.rootCAs = rootCAs
+	TLSConfMutex.Unlock()
+	return nil
+}
+
+// Set replaces the key pair served with the given PEM encoded key pair, the key pair in
+// use is kept on failure. The handshakes which already started use the previous key pair.
+func (s *CertificateStore) Set(certificatePEM, privateKeyPEM []byte) error {
+	certificate, err := tls.X509KeyPair(certificatePEM, privateKeyPEM)
+	if err != nil {
+		return fmt.Errorf("error: failed to load key pair: %v", err)
+	}
+	TLSConfMutex.Lock()
+	s.certificate = &certificate
+	TLSConfMutex.Unlock()
+	return nil
+}
+
+// GetCertificate returns the key pair served, it is set in the tls.Config of the servers
+func (s *CertificateStore) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	TLSConfMutex.RLock()
+	defer TLSConfMutex.RUnlock()
+	if s.certificate == nil {
+		return nil, fmt.Errorf("error: no certificate loaded")
+	}
+	return s.certificate, nil
+}
+
+// GetClientCertificate returns the key pair served, it is set in the tls.Config of the clients
+func (s *CertificateStore) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return s.GetCertificate(nil)
+}
+
+// VerifyPeerCertificate verifies the certificate chain presented by the server against the root CA
+// certificate in use, for serverName. It is set in the tls.Config of the clients along with
+// InsecureSkipVerify, as the RootCAs of a tls.Config can't be replaced once it is in use.
+func (s *CertificateStore) VerifyPeerCertificate(serverName string) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		TLSConfMutex.RLock()
+		rootCAs, verify := s.rootCAs, verifyPeer
+		TLSConfMutex.RUnlock()
+		if !verify {
+			return nil
+		}
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("error: no certificate presented by %s", serverName)
+		}
+		certificates := make([]*x509.Certificate, 0, len(rawCerts))
+		for _, rawCert := range rawCerts {
+			certificate, err := x509.ParseCertificate(rawCert)
+			if err != nil {
+				return fmt.Errorf("error: failed to parse the certificate of %s: %v", serverName, err)
+			}
+			certificates = append(certificates, certificate)
+		}
+		intermediates := x509.NewCertPool()
+		for _, certificate := range certificates[1:] {
+			intermediates.AddCert(certificate)
+		}
+		_, err := certificates[0].Verify(x509.VerifyOptions{
+			DNSName:       serverName,
+			Roots:         rootCAs,
+			Intermediates: intermediates,
+		})
+		return err
+	}
+}
+
+// WatchFiles reloads the key pair and the root CA certificate whenever their files are written, e.g. when the secret
+// mounted in the pod is updated. The certificates replaced with the CertificateService
+// are saved in the DB instead, and loaded by each of the services with common.WatchKeyPair.
+func (s *CertificateStore) WatchFiles() {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Error("error while trying to watch the certificate files: " + err.Error())
+		return
+	}
+	defer watcher.Close()
+	for _, path := range []string{s.certificatePath, s.privateKeyPath, s.rootCAPath} {
+		if err := watcher.Add(path); err != nil {
+			log.Error("error while trying to watch " + path + ": " + err.Error())
+		}
+	}
+	for {
+		select {
+		case fileEvent, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if fileEvent.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove) == 0 {
+				continue
+			}
+			// the files replaced by a rename are watched again
+			watcher.Add(fileEvent.Name)
+			// the reload fails while only one of the files of the key pair is written,
+			// the key pair is then reloaded on the write of the other one
+			if err := s.Reload(); err != nil {
+				log.Warn("certificate not reloaded after the change of " + fileEvent.Name + ": " + err.Error())
+				continue
+			}
+			log.Info("certificate reloaded after the change of " + fileEvent.Name)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Error("error while watching the certificate files: " + err.Error())
+		}
+	}
+}
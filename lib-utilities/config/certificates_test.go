@@ -0,0 +1,178 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// generateKeyPair returns a self-signed certificate and its private key
+func generateKeyPair(t *testing.T, commonName string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error while generating the key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error while creating the certificate: %v", err)
+	}
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeKeyPair(t *testing.T, dir string, certificate, privateKey []byte) {
+	if err := ioutil.WriteFile(filepath.Join(dir, "server.key"), privateKey, 0600); err != nil {
+		t.Fatalf("error while writing the private key: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "server.crt"), certificate, 0600); err != nil {
+		t.Fatalf("error while writing the certificate: %v", err)
+	}
+}
+
+func writeRootCA(t *testing.T, dir string, certificate []byte) {
+	if err := ioutil.WriteFile(filepath.Join(dir, "rootCA.crt"), certificate, 0600); err != nil {
+		t.Fatalf("error while writing the root CA certificate: %v", err)
+	}
+}
+
+func servedCertificate(t *testing.T, store *CertificateStore) []byte {
+	certificate, err := store.GetCertificate(&tls.ClientHelloInfo{})
+	if err != nil {
+		t.Fatalf("error while getting the certificate: %v", err)
+	}
+	return certificate.Certificate[0]
+}
+
+func TestCertificateStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certificates")
+	if err != nil {
+		t.Fatalf("error while creating the directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := &CertificateStore{}
+	if _, err := store.GetCertificate(&tls.ClientHelloInfo{}); err == nil {
+		t.Error("no certificate should be served before the key pair is loaded")
+	}
+
+	firstCertificate, firstKey := generateKeyPair(t, "first")
+	writeKeyPair(t, dir, firstCertificate, firstKey)
+	writeRootCA(t, dir, firstCertificate)
+	if err := store.Load(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "rootCA.crt")); err != nil {
+		t.Fatalf("error while loading the key pair: %v", err)
+	}
+	firstDER, _ := pem.Decode(firstCertificate)
+	if !bytes.Equal(servedCertificate(t, store), firstDER.Bytes) {
+		t.Error("loaded certificate should be served")
+	}
+
+	// the key pair in use is kept when the files don't hold a valid key pair
+	secondCertificate, secondKey := generateKeyPair(t, "second")
+	writeKeyPair(t, dir, secondCertificate, firstKey)
+	if err := store.Reload(); err == nil {
+		t.Error("reload of a certificate not matching the private key should fail")
+	}
+	if !bytes.Equal(servedCertificate(t, store), firstDER.Bytes) {
+		t.Error("certificate in use should be kept")
+	}
+
+	go store.WatchFiles()
+	// let the watcher start before the files are written
+	time.Sleep(100 * time.Millisecond)
+	writeKeyPair(t, dir, secondCertificate, secondKey)
+	secondDER, _ := pem.Decode(secondCertificate)
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(50 * time.Millisecond) {
+		if bytes.Equal(servedCertificate(t, store), secondDER.Bytes) {
+			return
+		}
+	}
+	t.Error("replaced certificate should be served without a restart")
+}
+
+func TestCertificateStoreVerifyPeerCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certificates")
+	if err != nil {
+		t.Fatalf("error while creating the directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	SetVerifyPeer(true)
+
+	firstCertificate, firstKey := generateKeyPair(t, "first")
+	secondCertificate, _ := generateKeyPair(t, "second")
+	firstDER, _ := pem.Decode(firstCertificate)
+	secondDER, _ := pem.Decode(secondCertificate)
+	writeKeyPair(t, dir, firstCertificate, firstKey)
+	writeRootCA(t, dir, firstCertificate)
+	store := &CertificateStore{}
+	if err := store.Load(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "rootCA.crt")); err != nil {
+		t.Fatalf("error while loading the key pair: %v", err)
+	}
+	if err := store.VerifyPeerCertificate("first")([][]byte{firstDER.Bytes}, nil); err != nil {
+		t.Errorf("certificate issued by the root CA should be verified: %v", err)
+	}
+	if err := store.VerifyPeerCertificate("other")([][]byte{firstDER.Bytes}, nil); err == nil {
+		t.Error("certificate of another server name should not be verified")
+	}
+
+	// the servers are verified against the root CA certificate replaced in its file
+	writeRootCA(t, dir, secondCertificate)
+	if err := store.Reload(); err != nil {
+		t.Fatalf("error while reloading the certificates: %v", err)
+	}
+	if err := store.VerifyPeerCertificate("first")([][]byte{firstDER.Bytes}, nil); err == nil {
+		t.Error("certificate not issued by the replaced root CA should not be verified")
+	}
+	if err := store.VerifyPeerCertificate("second")([][]byte{secondDER.Bytes}, nil); err != nil {
+		t.Errorf("certificate issued by the replaced root CA should be verified: %v", err)
+	}
+}
+
+func TestGetHTTPServerObjWithCertificateStore(t *testing.T) {
+	if err := SetUpMockConfig(t); err != nil {
+		t.Fatal("error: SetUpMockConfig failed with", err)
+	}
+	httpConf := &HTTPConfig{
+		CACertificate:    &Data.KeyCertConf.RootCACertificate,
+		CertificateStore: &CertificateStore{},
+	}
+	server, err := httpConf.GetHTTPServerObj()
+	if err != nil {
+		t.Fatalf("error while creating the server: %v", err)
+	}
+	if server.TLSConfig.GetCertificate == nil || len(server.TLSConfig.Certificates) != 0 {
+		t.Error("certificate of the server should be served from the certificate store")
+	}
+}
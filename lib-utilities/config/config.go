@@ -21,8 +21,12 @@ import (
 	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"time"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/google/uuid"
 )
 
@@ -38,6 +42,7 @@ type configModel struct {
 	MessageQueueConfigFilePath     string                   `json:"MessageQueueConfigFilePath"`
 	SearchAndFilterSchemaPath      string                   `json:"SearchAndFilterSchemaPath"`
 	RegistryStorePath              string                   `json:"RegistryStorePath"`
+	SchemaStorePath                string                   `json:"SchemaStorePath"`
 	LocalhostFQDN                  string                   `json:"LocalhostFQDN"`
 	EnabledServices                []string                 `json:"EnabledServices"`
 	DBConf                         *DBConf                  `json:"DBConf"`
@@ -47,6 +52,11 @@ type configModel struct {
 	AddComputeSkipResources        *AddComputeSkipResources `json:"AddComputeSkipResources"`
 	URLTranslation                 *URLTranslation          `json:"URLTranslation"`
 	PluginStatusPolling            *PluginStatusPolling     `json:"PluginStatusPolling"`
+	PluginClientConf               *PluginClientConf        `json:"PluginClientConf"`
+	CertificateExpiryConf          *CertificateExpiryConf   `json:"CertificateExpiryConf"`
+	SchemaValidationConf           *SchemaValidationConf    `json:"SchemaValidationConf"`
+	PassThroughConf                *PassThroughConf         `json:"PassThroughConf"`
+	LogConf                        *LogConf                 `json:"LogConf"`
 	ExecPriorityDelayConf          *ExecPriorityDelayConf   `json:"ExecPriorityDelayConf"`
 	TLSConf                        *TLSConf                 `json:"TLSConf"`
 	SupportedPluginTypes           []string                 `json:"SupportedPluginTypes"`
@@ -55,6 +65,7 @@ type configModel struct {
 
 // DBConf holds all DB related configurations
 type DBConf struct {
+	Backend              string `json:"Backend"`
 	Protocol             string `json:"Protocol"`
 	InMemoryHost         string `json:"InMemoryHost"`
 	InMemoryPort         string `json:"InMemoryPort"`
@@ -129,6 +140,89 @@ type PluginStatusPolling struct {
 	StartUpResouceBatchSize int `json:"StartUpResouceBatchSize"`
 }
 
+// PluginClientConf holds the configuration of the requests sent to the plugins
+type PluginClientConf struct {
+	MaxRetryAttempts          int     `json:"MaxRetryAttempts"`          // holds the number of retries of a request which couldn't reach the plugin
+	RetryIntervalInMillisecs  int     `json:"RetryIntervalInMillisecs"`  // holds the interval before the first retry, doubled after each retry
+	CircuitBreakerThreshold   int     `json:"CircuitBreakerThreshold"`   // holds the number of consecutive unreachable requests after which the requests to the plugin fail fast
+	CircuitBreakerResetInSecs int     `json:"CircuitBreakerResetInSecs"` // holds the duration after which a request is sent again to an unreachable plugin
+	TokenExpiryInMins         float64 `json:"TokenExpiryInMins"`         // holds the idle time after which a plugin session token is not reused
+}
+
+// CertificateExpiryConf holds the configuration of the monitoring of the certificate expiry
+// of odimra, of the plugins and of the devices
+type CertificateExpiryConf struct {
+	CheckIntervalInMins   int   `json:"CheckIntervalInMins"`   // holds the interval between two checks of the certificates
+	AlertThresholdsInDays []int `json:"AlertThresholdsInDays"` // holds the numbers of days before the expiry at which an alert is raised
+}
+
+// SchemaValidationConf holds the configuration of the validation of the plugin responses
+// against the Redfish JSON schemas of the schema store
+type SchemaValidationConf struct {
+	Mode string `json:"Mode"` // holds the validation mode, one of Off, Report or Strict
+}
+
+// PassThroughConf holds the configuration of the forwarding of the requests on the
+// Systems, Chassis and Managers resources which are not modelled by ODIMRA to the plugins
+type PassThroughConf struct {
+	Enabled   bool     `json:"Enabled"`   // enables the forwarding of the requests to the plugins
+	AllowList []string `json:"AllowList"` // holds the patterns of the URIs which can be forwarded, all the URIs can be forwarded when empty
+	DenyList  []string `json:"DenyList"`  // holds the patterns of the URIs which are never forwarded
+	allowList []*regexp.Regexp
+	denyList  []*regexp.Regexp
+}
+
+// Compile compiles the patterns of the allow-list and deny-list, it's called when the
+// configuration is loaded so that the patterns are not compiled for each request
+func (c *PassThroughConf) Compile() error {
+	allowList, err := compilePatterns(c.AllowList)
+	if err != nil {
+		return err
+	}
+	denyList, err := compilePatterns(c.DenyList)
+	if err != nil {
+		return err
+	}
+	c.allowList, c.denyList = allowList, denyList
+	return nil
+}
+
+// Allows reports if the requests on the URI can be forwarded to the plugins. The URI is allowed
+// when the forwarding is enabled, it matches none of the deny-list patterns and either the
+// allow-list is empty or the URI matches one of its patterns. Nothing is allowed when the
+// patterns were not compiled.
+func (c *PassThroughConf) Allows(uri string) bool {
+	if !c.Enabled || len(c.allowList) != len(c.AllowList) || len(c.denyList) != len(c.DenyList) {
+		return false
+	}
+	for _, pattern := range c.denyList {
+		if pattern.MatchString(uri) {
+			return false
+		}
+	}
+	if len(c.allowList) == 0 {
+		return true
+	}
+	for _, pattern := range c.allowList {
+		if pattern.MatchString(uri) {
+			return true
+		}
+	}
+	return false
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error: invalid pattern %s in PassThroughConf: %v", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
 // ExecPriorityDelayConf holds priority and delay configurations for exec actions
 type ExecPriorityDelayConf struct {
 	MinResetPriority    int `json:"MinResetPriority"`
@@ -136,6 +230,12 @@ type ExecPriorityDelayConf struct {
 	MaxResetDelayInSecs int `json:"MaxResetDelayInSecs"`
 }
 
+// LogConf holds the configuration of the logging of the services
+type LogConf struct {
+	Level  string `json:"Level"`  // holds the level of the entries written, the level is changed when the config is reloaded
+	Format string `json:"Format"` // holds the format of the entries, one of JSON or Syslog
+}
+
 // TLSConf holds TLS confifurations used in https queries
 type TLSConf struct {
 	VerifyPeer            bool     `json:"VerifyPeer"`
@@ -196,7 +296,18 @@ func ValidateConfiguration() error {
 	checkAddComputeSkipResources()
 	checkURLTranslation()
 	checkPluginStatusPolling()
+	checkPluginClientConf()
+	checkCertificateExpiryConf()
 	checkExecPriorityDelayConf()
+	if err = checkSchemaValidationConf(); err != nil {
+		return err
+	}
+	if err = checkPassThroughConf(); err != nil {
+		return err
+	}
+	if err = checkLogConf(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -224,6 +335,11 @@ func checkMiscellaneousConf() error {
 	if _, err := os.Stat(Data.RegistryStorePath); err != nil {
 		return fmt.Errorf("error: value check failed for RegistryStorePath:%s with %v", Data.RegistryStorePath, err)
 	}
+	if Data.SchemaStorePath == "" {
+		log.Warn("No value set for SchemaStorePath, JSON schemas and $metadata will not be served")
+	} else if _, err := os.Stat(Data.SchemaStorePath); err != nil {
+		return fmt.Errorf("error: value check failed for SchemaStorePath:%s with %v", Data.SchemaStorePath, err)
+	}
 	if len(Data.EnabledServices) == 0 {
 		return fmt.Errorf("error: no value set for EnabledServices")
 	}
@@ -237,6 +353,17 @@ func checkDBConf() error {
 	if Data.DBConf == nil {
 		return fmt.Errorf("error: DBConf is not provided")
 	}
+	switch Data.DBConf.Backend {
+	case "":
+		log.Warn("No value configured for DB Backend, setting default value")
+		Data.DBConf.Backend = RedisDBBackend
+	case RedisDBBackend:
+	case InProcessDBBackend:
+		// the data would be lost when the service stops and not shared between the services
+		return fmt.Errorf("error: DB Backend %v is only supported in the unit tests", Data.DBConf.Backend)
+	default:
+		return fmt.Errorf("error: invalid value configured for DB Backend: %v", Data.DBConf.Backend)
+	}
 	if Data.DBConf.Protocol != DefaultDBProtocol {
 		log.Warn("Incorrect value configured for DB Protocol, setting default value")
 		Data.DBConf.Protocol = DefaultDBProtocol
@@ -462,6 +589,70 @@ func checkPluginStatusPolling() {
 	}
 }
 
+func checkPluginClientConf() {
+	if Data.PluginClientConf == nil {
+		log.Warn("PluginClientConf not provided, setting default value")
+		Data.PluginClientConf = &PluginClientConf{
+			MaxRetryAttempts:          DefaultPluginMaxRetryAttempts,
+			RetryIntervalInMillisecs:  DefaultPluginRetryIntervalInMillisecs,
+			CircuitBreakerThreshold:   DefaultCircuitBreakerThreshold,
+			CircuitBreakerResetInSecs: DefaultCircuitBreakerResetInSecs,
+			TokenExpiryInMins:         DefaultPluginTokenExpiryInMins,
+		}
+		return
+	}
+	if Data.PluginClientConf.MaxRetryAttempts < 0 {
+		log.Warn("Invalid value found for MaxRetryAttempts, setting default value")
+		Data.PluginClientConf.MaxRetryAttempts = DefaultPluginMaxRetryAttempts
+	}
+	if Data.PluginClientConf.RetryIntervalInMillisecs <= 0 {
+		log.Warn("No value found for RetryIntervalInMillisecs, setting default value")
+		Data.PluginClientConf.RetryIntervalInMillisecs = DefaultPluginRetryIntervalInMillisecs
+	}
+	if Data.PluginClientConf.CircuitBreakerThreshold <= 0 {
+		log.Warn("No value found for CircuitBreakerThreshold, setting default value")
+		Data.PluginClientConf.CircuitBreakerThreshold = DefaultCircuitBreakerThreshold
+	}
+	if Data.PluginClientConf.CircuitBreakerResetInSecs <= 0 {
+		log.Warn("No value found for CircuitBreakerResetInSecs, setting default value")
+		Data.PluginClientConf.CircuitBreakerResetInSecs = DefaultCircuitBreakerResetInSecs
+	}
+	if Data.PluginClientConf.TokenExpiryInMins <= 0 {
+		log.Warn("No value found for TokenExpiryInMins, setting default value")
+		Data.PluginClientConf.TokenExpiryInMins = DefaultPluginTokenExpiryInMins
+	}
+}
+
+func checkCertificateExpiryConf() {
+	if Data.CertificateExpiryConf == nil {
+		log.Warn("CertificateExpiryConf not provided, setting default value")
+		Data.CertificateExpiryConf = &CertificateExpiryConf{
+			CheckIntervalInMins:   DefaultCertificateCheckIntervalInMins,
+			AlertThresholdsInDays: append([]int{}, DefaultCertificateAlertThresholdsInDays...),
+		}
+		return
+	}
+	if Data.CertificateExpiryConf.CheckIntervalInMins <= 0 {
+		log.Warn("No value found for CheckIntervalInMins, setting default value")
+		Data.CertificateExpiryConf.CheckIntervalInMins = DefaultCertificateCheckIntervalInMins
+	}
+	var thresholds []int
+	for _, threshold := range Data.CertificateExpiryConf.AlertThresholdsInDays {
+		if threshold <= 0 {
+			log.Warn("Invalid value " + strconv.Itoa(threshold) + " found in AlertThresholdsInDays, ignoring it")
+			continue
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	if len(thresholds) == 0 {
+		log.Warn("No value found for AlertThresholdsInDays, setting default value")
+		thresholds = append(thresholds, DefaultCertificateAlertThresholdsInDays...)
+	}
+	// the thresholds are checked from the farthest to the nearest to the expiry
+	sort.Sort(sort.Reverse(sort.IntSlice(thresholds)))
+	Data.CertificateExpiryConf.AlertThresholdsInDays = thresholds
+}
+
 func checkExecPriorityDelayConf() {
 	if Data.ExecPriorityDelayConf == nil {
 		log.Warn("ExecPriorityDelayConf not provided, setting default value")
@@ -487,6 +678,55 @@ func checkExecPriorityDelayConf() {
 	}
 }
 
+func checkSchemaValidationConf() error {
+	if Data.SchemaValidationConf == nil || Data.SchemaValidationConf.Mode == "" {
+		log.Warn("SchemaValidationConf not provided, setting default value")
+		Data.SchemaValidationConf = &SchemaValidationConf{
+			Mode: SchemaValidationOff,
+		}
+		return nil
+	}
+	switch Data.SchemaValidationConf.Mode {
+	case SchemaValidationOff:
+		return nil
+	case SchemaValidationReport, SchemaValidationStrict:
+		if Data.SchemaStorePath == "" {
+			return fmt.Errorf("error: SchemaStorePath is required for the %s schema validation mode", Data.SchemaValidationConf.Mode)
+		}
+		return nil
+	}
+	return fmt.Errorf("error: invalid value %s for SchemaValidationConf Mode, supported values are %s, %s and %s",
+		Data.SchemaValidationConf.Mode, SchemaValidationOff, SchemaValidationReport, SchemaValidationStrict)
+}
+
+func checkPassThroughConf() error {
+	if Data.PassThroughConf == nil {
+		log.Warn("PassThroughConf not provided, setting default value")
+		Data.PassThroughConf = &PassThroughConf{}
+	}
+	return Data.PassThroughConf.Compile()
+}
+
+func checkLogConf() error {
+	if Data.LogConf == nil {
+		log.Warn("LogConf not provided, setting default value")
+		Data.LogConf = &LogConf{}
+	}
+	if Data.LogConf.Level == "" {
+		Data.LogConf.Level = logs.DefaultLevel
+	}
+	if Data.LogConf.Format == "" {
+		Data.LogConf.Format = logs.JSONFormat
+	}
+	if _, err := log.ParseLevel(Data.LogConf.Level); err != nil {
+		return fmt.Errorf("error: invalid value %s for LogConf Level: %v", Data.LogConf.Level, err)
+	}
+	if err := logs.ValidateFormat(Data.LogConf.Format); err != nil {
+		return fmt.Errorf("error: invalid value %s for LogConf Format: %v", Data.LogConf.Format, err)
+	}
+	return nil
+}
+
 func checkTLSConf() error {
 	if Data.TLSConf == nil {
 		log.Warn("TLSConf not provided, setting default value")
@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -470,3 +471,287 @@ func TestValidateConfigurationGroup3(t *testing.T) {
 	}
 	os.Remove(sampleFileForTest)
 }
+
+func TestCheckSchemaValidationConf(t *testing.T) {
+	tests := []struct {
+		name      string
+		conf      *SchemaValidationConf
+		storePath string
+		wantMode  string
+		wantErr   bool
+	}{
+		{
+			name:     "SchemaValidationConf not provided",
+			wantMode: SchemaValidationOff,
+		},
+		{
+			name:     "Off mode without schema store",
+			conf:     &SchemaValidationConf{Mode: SchemaValidationOff},
+			wantMode: SchemaValidationOff,
+		},
+		{
+			name:      "Strict mode with schema store",
+			conf:      &SchemaValidationConf{Mode: SchemaValidationStrict},
+			storePath: cwdDir,
+			wantMode:  SchemaValidationStrict,
+		},
+		{
+			name:    "Report mode without schema store",
+			conf:    &SchemaValidationConf{Mode: SchemaValidationReport},
+			wantErr: true,
+		},
+		{
+			name:      "Invalid mode",
+			conf:      &SchemaValidationConf{Mode: "Repair"},
+			storePath: cwdDir,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Data.SchemaValidationConf = tt.conf
+			Data.SchemaStorePath = tt.storePath
+			err := checkSchemaValidationConf()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkSchemaValidationConf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && Data.SchemaValidationConf.Mode != tt.wantMode {
+				t.Errorf("checkSchemaValidationConf() Mode = %v, want %v", Data.SchemaValidationConf.Mode, tt.wantMode)
+			}
+		})
+	}
+	Data.SchemaStorePath = ""
+}
+
+func TestCheckPluginClientConf(t *testing.T) {
+	tests := []struct {
+		name string
+		conf *PluginClientConf
+		want PluginClientConf
+	}{
+		{
+			name: "PluginClientConf not provided",
+			want: PluginClientConf{
+				MaxRetryAttempts:          DefaultPluginMaxRetryAttempts,
+				RetryIntervalInMillisecs:  DefaultPluginRetryIntervalInMillisecs,
+				CircuitBreakerThreshold:   DefaultCircuitBreakerThreshold,
+				CircuitBreakerResetInSecs: DefaultCircuitBreakerResetInSecs,
+				TokenExpiryInMins:         DefaultPluginTokenExpiryInMins,
+			},
+		},
+		{
+			name: "Retries disabled",
+			conf: &PluginClientConf{RetryIntervalInMillisecs: 100, CircuitBreakerThreshold: 3},
+			want: PluginClientConf{
+				MaxRetryAttempts:          0,
+				RetryIntervalInMillisecs:  100,
+				CircuitBreakerThreshold:   3,
+				CircuitBreakerResetInSecs: DefaultCircuitBreakerResetInSecs,
+				TokenExpiryInMins:         DefaultPluginTokenExpiryInMins,
+			},
+		},
+		{
+			name: "Invalid values",
+			conf: &PluginClientConf{MaxRetryAttempts: -1, CircuitBreakerResetInSecs: -5, TokenExpiryInMins: -1},
+			want: PluginClientConf{
+				MaxRetryAttempts:          DefaultPluginMaxRetryAttempts,
+				RetryIntervalInMillisecs:  DefaultPluginRetryIntervalInMillisecs,
+				CircuitBreakerThreshold:   DefaultCircuitBreakerThreshold,
+				CircuitBreakerResetInSecs: DefaultCircuitBreakerResetInSecs,
+				TokenExpiryInMins:         DefaultPluginTokenExpiryInMins,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Data.PluginClientConf = tt.conf
+			checkPluginClientConf()
+			if *Data.PluginClientConf != tt.want {
+				t.Errorf("checkPluginClientConf() = %+v, want %+v", *Data.PluginClientConf, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckCertificateExpiryConf(t *testing.T) {
+	tests := []struct {
+		name string
+		conf *CertificateExpiryConf
+		want CertificateExpiryConf
+	}{
+		{
+			name: "CertificateExpiryConf not provided",
+			want: CertificateExpiryConf{
+				CheckIntervalInMins:   DefaultCertificateCheckIntervalInMins,
+				AlertThresholdsInDays: DefaultCertificateAlertThresholdsInDays,
+			},
+		},
+		{
+			name: "Unsorted thresholds",
+			conf: &CertificateExpiryConf{CheckIntervalInMins: 10, AlertThresholdsInDays: []int{1, 14, 60}},
+			want: CertificateExpiryConf{
+				CheckIntervalInMins:   10,
+				AlertThresholdsInDays: []int{60, 14, 1},
+			},
+		},
+		{
+			name: "Invalid values",
+			conf: &CertificateExpiryConf{CheckIntervalInMins: -1, AlertThresholdsInDays: []int{0, -7}},
+			want: CertificateExpiryConf{
+				CheckIntervalInMins:   DefaultCertificateCheckIntervalInMins,
+				AlertThresholdsInDays: DefaultCertificateAlertThresholdsInDays,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Data.CertificateExpiryConf = tt.conf
+			checkCertificateExpiryConf()
+			if !reflect.DeepEqual(*Data.CertificateExpiryConf, tt.want) {
+				t.Errorf("checkCertificateExpiryConf() = %+v, want %+v", *Data.CertificateExpiryConf, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPassThroughConf(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    *PassThroughConf
+		wantErr bool
+	}{
+		{
+			name: "PassThroughConf not provided",
+		},
+		{
+			name: "Valid patterns",
+			conf: &PassThroughConf{Enabled: true, AllowList: []string{"/Controls(/.*)?$"}, DenyList: []string{"/Actions/.*Reset$"}},
+		},
+		{
+			name:    "Invalid pattern",
+			conf:    &PassThroughConf{Enabled: true, DenyList: []string{"/Actions/(.*"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Data.PassThroughConf = tt.conf
+			err := checkPassThroughConf()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkPassThroughConf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if Data.PassThroughConf == nil {
+				t.Errorf("checkPassThroughConf() didn't set the default value")
+			}
+		})
+	}
+}
+
+func TestPassThroughConfAllows(t *testing.T) {
+	conf := &PassThroughConf{Enabled: true, AllowList: []string{"/Controls(/.*)?$"}, DenyList: []string{"/Controls/Fan$"}}
+	if conf.Allows("/redfish/v1/Chassis/uuid:1/Controls") {
+		t.Errorf("Allows() allowed a URI before the patterns were compiled")
+	}
+	if err := conf.Compile(); err != nil {
+		t.Fatalf("Compile() unexpected error = %v", err)
+	}
+	tests := map[string]bool{
+		"/redfish/v1/Chassis/uuid:1/Controls":     true,
+		"/redfish/v1/Chassis/uuid:1/Controls/CPU": true,
+		"/redfish/v1/Chassis/uuid:1/Controls/Fan": false,
+		"/redfish/v1/Chassis/uuid:1/Certificates": false,
+	}
+	for uri, want := range tests {
+		if got := conf.Allows(uri); got != want {
+			t.Errorf("Allows(%v) = %v, want %v", uri, got, want)
+		}
+	}
+	conf.Enabled = false
+	if conf.Allows("/redfish/v1/Chassis/uuid:1/Controls") {
+		t.Errorf("Allows() allowed a URI with the forwarding disabled")
+	}
+}
+
+func TestCheckLogConf(t *testing.T) {
+	tests := []struct {
+		name       string
+		conf       *LogConf
+		wantLevel  string
+		wantFormat string
+		wantErr    bool
+	}{
+		{
+			name:       "LogConf not provided",
+			wantLevel:  "info",
+			wantFormat: "JSON",
+		},
+		{
+			name:       "Valid syslog configuration",
+			conf:       &LogConf{Level: "debug", Format: "Syslog"},
+			wantLevel:  "debug",
+			wantFormat: "Syslog",
+		},
+		{
+			name:    "Invalid level",
+			conf:    &LogConf{Level: "verbose"},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid format",
+			conf:    &LogConf{Format: "text"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Data.LogConf = tt.conf
+			err := checkLogConf()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkLogConf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if Data.LogConf.Level != tt.wantLevel || Data.LogConf.Format != tt.wantFormat {
+				t.Errorf("checkLogConf() = %v, want level %v and format %v", Data.LogConf, tt.wantLevel, tt.wantFormat)
+			}
+		})
+	}
+}
+
+func TestCheckDBConfBackend(t *testing.T) {
+	tests := []struct {
+		name        string
+		conf        *DBConf
+		wantBackend string
+		wantErr     bool
+	}{
+		{
+			name:        "Backend not provided",
+			conf:        &DBConf{InMemoryHost: "localhost", InMemoryPort: "6379", OnDiskHost: "localhost", OnDiskPort: "6380"},
+			wantBackend: RedisDBBackend,
+		},
+		{
+			name:    "In-process backend",
+			conf:    &DBConf{Backend: InProcessDBBackend},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid backend",
+			conf:    &DBConf{Backend: "Memcached"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Data.DBConf = tt.conf
+			err := checkDBConf()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkDBConf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && Data.DBConf.Backend != tt.wantBackend {
+				t.Errorf("checkDBConf() Backend = %v, want %v", Data.DBConf.Backend, tt.wantBackend)
+			}
+		})
+	}
+}
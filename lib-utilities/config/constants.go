@@ -38,6 +38,10 @@ const (
 	DefaultExpiredSessionCleanUpTimeInMins = 15
 	// DefaultDBProtocol - default Protocol value
 	DefaultDBProtocol = "tcp"
+	// RedisDBBackend - DB backend storing the data in the configured redis servers
+	RedisDBBackend = "Redis"
+	// InProcessDBBackend - DB backend storing the data in the memory of the service, only for the unit tests
+	InProcessDBBackend = "InProcess"
 	// DefaultDBMaxActiveConns - default MaxActiveConns value
 	DefaultDBMaxActiveConns = 120
 	// DefaultDBMaxIdleConns - default MaxIdleConns value
@@ -66,6 +70,18 @@ const (
 	DefaultResponseTimeoutInSecs = 3
 	// DefaultStartUpResouceBatchSize - default StartUpResouceBatchSize value
 	DefaultStartUpResouceBatchSize = 10
+	// DefaultPluginMaxRetryAttempts - default MaxRetryAttempts value of PluginClientConf
+	DefaultPluginMaxRetryAttempts = 2
+	// DefaultPluginRetryIntervalInMillisecs - default RetryIntervalInMillisecs value
+	DefaultPluginRetryIntervalInMillisecs = 500
+	// DefaultCircuitBreakerThreshold - default CircuitBreakerThreshold value
+	DefaultCircuitBreakerThreshold = 5
+	// DefaultCircuitBreakerResetInSecs - default CircuitBreakerResetInSecs value
+	DefaultCircuitBreakerResetInSecs = 30
+	// DefaultPluginTokenExpiryInMins - default TokenExpiryInMins value
+	DefaultPluginTokenExpiryInMins = 30
+	// DefaultCertificateCheckIntervalInMins - default CheckIntervalInMins value of CertificateExpiryConf
+	DefaultCertificateCheckIntervalInMins = 60
 	// DefaultMinResetPriority - default MinResetPriority value
 	DefaultMinResetPriority = 1
 	// DefaultMaxResetDelay - maximum delay in seconds a reset action can wait
@@ -90,6 +106,12 @@ const (
 	DefaultTLSMaxVersion = tls.VersionTLS12
 	// DefaultTLSServerVerify - indicator for performing server validation
 	DefaultTLSServerVerify = true
+	// SchemaValidationOff - the plugin responses are not validated
+	SchemaValidationOff = "Off"
+	// SchemaValidationReport - the schema violations of the plugin responses are recorded
+	SchemaValidationReport = "Report"
+	// SchemaValidationStrict - the schema violations are recorded and the invalid properties are repaired or removed
+	SchemaValidationStrict = "Strict"
 )
 
 var (
@@ -99,6 +121,8 @@ var (
 	DefaultChassisCollection = []string{"Managers", "Systems", "Devices"}
 	// DefaultOtherCollection - default OtherCollection value
 	DefaultOtherCollection = []string{"Power", "Thermal", "SmartStorage"}
+	// DefaultCertificateAlertThresholdsInDays - default AlertThresholdsInDays value of CertificateExpiryConf
+	DefaultCertificateAlertThresholdsInDays = []int{30, 7, 1}
 	// DefaultCipherSuiteList - default cipher suite list
 	DefaultCipherSuiteList = []uint16{
 		tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
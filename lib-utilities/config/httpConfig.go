@@ -38,6 +38,9 @@ type HTTPConfig struct {
 	ServerAddress string
 	// ServerPort contains the port of the server
 	ServerPort string
+	// CertificateStore serves the key pair of the server in place of Certificate and PrivateKey,
+	// so that the key pair can be replaced while the server is running
+	CertificateStore *CertificateStore
 	// loadCertificates is for marking to load CA cert only or not
 	loadCertificates bool
 }
@@ -103,7 +106,9 @@ func (config *HTTPConfig) GetHTTPServerObj() (*http.Server, error) {
 func (config *HTTPConfig) LoadCertificates(tlsConfig *tls.Config) error {
 	// for client mode interaction certificates will not be required and
 	// just CA certificate needs to be loaded for server validation
-	if config.loadCertificates {
+	if config.loadCertificates && config.CertificateStore != nil {
+		tlsConfig.GetCertificate = config.CertificateStore.GetCertificate
+	} else if config.loadCertificates {
 		cert, err := tls.X509KeyPair(*config.Certificate, *config.PrivateKey)
 		if err != nil {
 			return fmt.Errorf("error: failed to load key pair: %v", err)
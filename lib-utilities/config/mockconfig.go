@@ -172,6 +172,7 @@ func SetUpMockConfig(t *testing.T) error {
 	Data.LocalhostFQDN = "odim.test.com"
 	Data.EnabledServices = []string{"SessionService", "AccountService", "EventService"}
 	Data.DBConf = &DBConf{
+		Backend:        "Redis",
 		Protocol:       "tcp",
 		InMemoryHost:   localhost,
 		InMemoryPort:   "6379",
@@ -234,11 +235,30 @@ func SetUpMockConfig(t *testing.T) error {
 		StartUpResouceBatchSize: 1,
 		PollingFrequencyInMins:  1,
 	}
+	Data.PluginClientConf = &PluginClientConf{
+		MaxRetryAttempts:          1,
+		RetryIntervalInMillisecs:  1,
+		CircuitBreakerThreshold:   5,
+		CircuitBreakerResetInSecs: 1,
+		TokenExpiryInMins:         30,
+	}
+	Data.CertificateExpiryConf = &CertificateExpiryConf{
+		CheckIntervalInMins:   60,
+		AlertThresholdsInDays: []int{30, 7, 1},
+	}
 	Data.ExecPriorityDelayConf = &ExecPriorityDelayConf{
 		MinResetPriority:    1,
 		MaxResetPriority:    10,
 		MaxResetDelayInSecs: 36000,
 	}
+	Data.SchemaValidationConf = &SchemaValidationConf{
+		Mode: SchemaValidationOff,
+	}
+	Data.PassThroughConf = &PassThroughConf{}
+	Data.LogConf = &LogConf{
+		Level:  "info",
+		Format: "JSON",
+	}
 	Data.TLSConf = &TLSConf{
 		VerifyPeer: true,
 		MinVersion: "TLS_1.2",
@@ -66,6 +66,8 @@ const (
 	JSONUnmarshalFailed
 	// DecryptionFailed indicates decryption of data failed
 	DecryptionFailed
+	// PreconditionFailed indicates the ETag supplied with a conditional write did not match the stored data
+	PreconditionFailed
 )
 
 // constants defined for matching partial strings in error returned
@@ -6,10 +6,19 @@ require (
 	github.com/ODIM-Project/ODIM/lib-persistence-manager v0.0.0-20201201072448-9772421f1b55
 	github.com/fsnotify/fsnotify v1.4.7
 	github.com/golang/protobuf v1.4.2
-	github.com/google/uuid v1.1.2-0.20200519141726-cb32006e483f
+	github.com/google/uuid v1.1.2
 	github.com/kataras/iris/v12 v12.1.9-0.20200616210209-a85c83b70ad0
+	github.com/micro/cli v0.2.0
 	github.com/micro/go-micro v1.13.2
+	github.com/prometheus/client_golang v1.1.0
 	github.com/sirupsen/logrus v1.4.2
+	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415
+	github.com/xeipuuv/gojsonschema v1.2.0
+	go.opentelemetry.io/otel v1.7.0
+	go.opentelemetry.io/otel/sdk v1.7.0
+	go.opentelemetry.io/otel/trace v1.7.0
+	golang.org/x/sys v0.7.0 // indirect
+	google.golang.org/protobuf v1.23.0
 )
 
 replace (
@@ -19,3 +28,5 @@ replace (
 	github.com/ODIM-Project/ODIM/lib-rest-client => ../lib-rest-client
 	github.com/ODIM-Project/ODIM/lib-utilities => ../lib-utilities
 )
+
+
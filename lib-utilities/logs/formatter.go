@@ -0,0 +1,125 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// syslogFacility is the user-level messages facility of RFC 5424
+	syslogFacility = 1
+	// syslogTimeFormat is the RFC 5424 timestamp with microseconds
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+	// syslogNilValue is the RFC 5424 value of the header fields which are not known
+	syslogNilValue = "-"
+)
+
+// syslogSeverities maps the logrus levels to the RFC 5424 severities
+var syslogSeverities = map[log.Level]int{
+	log.PanicLevel: 1, // alert
+	log.FatalLevel: 2, // critical
+	log.ErrorLevel: 3, // error
+	log.WarnLevel:  4, // warning
+	log.InfoLevel:  6, // informational
+	log.DebugLevel: 7, // debug
+	log.TraceLevel: 7, // debug
+}
+
+// formatter writes the entries as JSON objects with the service name,
+// framed as RFC 5424 syslog messages if syslog is set
+type formatter struct {
+	service  string
+	json     log.JSONFormatter
+	syslog   bool
+	hostname string
+	pid      int
+}
+
+func newFormatter(serviceName, format string) (*formatter, error) {
+	if err := ValidateFormat(format); err != nil {
+		return nil, err
+	}
+	f := &formatter{
+		service:  serviceName,
+		json:     log.JSONFormatter{TimestampFormat: time.RFC3339Nano},
+		syslog:   format == SyslogFormat,
+		hostname: syslogNilValue,
+		pid:      os.Getpid(),
+	}
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		f.hostname = syslogHeaderValue(hostname, 255)
+	}
+	return f, nil
+}
+
+// Format implements log.Formatter
+func (f *formatter) Format(entry *log.Entry) ([]byte, error) {
+	if _, ok := entry.Data[ServiceField]; !ok {
+		data := make(log.Fields, len(entry.Data)+1)
+		for k, v := range entry.Data {
+			data[k] = v
+		}
+		data[ServiceField] = f.service
+		e := *entry
+		e.Data = data
+		entry = &e
+	}
+	message, err := f.json.Format(entry)
+	if err != nil || !f.syslog {
+		return message, err
+	}
+
+	severity, ok := syslogSeverities[entry.Level]
+	if !ok {
+		severity = syslogSeverities[log.InfoLevel]
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "<%d>1 %s %s %s %d %s %s ",
+		syslogFacility*8+severity,
+		entry.Time.Format(syslogTimeFormat),
+		f.hostname,
+		syslogHeaderValue(f.service, 48),
+		f.pid,
+		syslogNilValue, // MSGID
+		syslogNilValue, // STRUCTURED-DATA, the fields are in the JSON message
+	)
+	b.Write(message)
+	return b.Bytes(), nil
+}
+
+// syslogHeaderValue returns the value of a RFC 5424 header field, made of
+// printable US-ASCII characters and limited to the given length
+func syslogHeaderValue(value string, length int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, value)
+	if value == "" {
+		return syslogNilValue
+	}
+	if len(value) > length {
+		value = value[:length]
+	}
+	return value
+}
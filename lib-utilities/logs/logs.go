@@ -0,0 +1,127 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package logs sets up the structured logging of the ODIMRA services and plugins
+// and holds the helpers to log the fields correlating the entries of a request
+package logs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// JSONFormat writes each entry as a JSON object
+	JSONFormat = "JSON"
+	// SyslogFormat writes each entry as a RFC 5424 syslog message with the JSON object as message
+	SyslogFormat = "Syslog"
+
+	// ServiceField is the name of the service or plugin writing the entry
+	ServiceField = "service"
+	// TransactionIDField is the ID of the request the entry is written for, the ID of its trace
+	TransactionIDField = "transactionid"
+	// SessionUserField is the user of the session the request is sent with
+	SessionUserField = "sessionuser"
+	// SystemIDField is the UUID of the system the request is for
+	SystemIDField = "systemid"
+	// PluginIDField is the ID of the plugin the request is for
+	PluginIDField = "pluginid"
+
+	// DefaultLevel is the level of the entries written when no level is configured
+	DefaultLevel = "info"
+)
+
+// levelMutex serializes the changes of the level, on a config reload for e.g.
+var levelMutex sync.Mutex
+
+// Init sets the format and the level of the standard logger, so all the entries
+// of the service are written to stdout as JSON objects or RFC 5424 syslog messages,
+// with the service name. The format defaults to JSON and the level to info.
+func Init(serviceName, format, level string) error {
+	formatter, err := newFormatter(serviceName, format)
+	if err != nil {
+		return err
+	}
+	log.SetOutput(os.Stdout)
+	log.SetFormatter(formatter)
+	return SetLevel(level)
+}
+
+// SetLevel sets the level of the standard logger, for e.g. when the config is
+// reloaded. The level defaults to info.
+func SetLevel(level string) error {
+	if level == "" {
+		level = DefaultLevel
+	}
+	l, err := log.ParseLevel(level)
+	if err != nil {
+		return fmt.Errorf("invalid log level %v: %v", level, err)
+	}
+	levelMutex.Lock()
+	defer levelMutex.Unlock()
+	if log.GetLevel() != l {
+		log.SetLevel(l)
+		log.Info("Log level set to " + l.String())
+	}
+	return nil
+}
+
+// ValidateFormat returns an error if format is not a supported format, empty being JSON
+func ValidateFormat(format string) error {
+	switch format {
+	case "", JSONFormat, SyslogFormat:
+		return nil
+	}
+	return fmt.Errorf("invalid log format %v, supported formats are %v and %v", format, JSONFormat, SyslogFormat)
+}
+
+// fieldsKey is the key of the correlation fields in a context
+type fieldsKey struct{}
+
+// With returns a copy of ctx carrying the correlation field, along with the fields
+// of ctx, the fields are added to the entries written with Log(ctx). A nil ctx is
+// handled as an empty one.
+func With(ctx context.Context, field, value string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	parent, _ := ctx.Value(fieldsKey{}).(log.Fields)
+	fields := make(log.Fields, len(parent)+1)
+	for k, v := range parent {
+		fields[k] = v
+	}
+	fields[field] = value
+	return context.WithValue(ctx, fieldsKey{}, fields)
+}
+
+// Log returns the entry of the standard logger with the correlation fields carried
+// by ctx, the transaction ID being the ID of the trace carried by ctx
+func Log(ctx context.Context) *log.Entry {
+	entry := log.NewEntry(log.StandardLogger())
+	if ctx == nil {
+		return entry
+	}
+	if fields, ok := ctx.Value(fieldsKey{}).(log.Fields); ok {
+		entry = entry.WithFields(fields)
+	}
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		entry = entry.WithField(TransactionIDField, traceID)
+	}
+	return entry
+}
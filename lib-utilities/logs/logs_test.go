@@ -0,0 +1,184 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
+	log "github.com/sirupsen/logrus"
+)
+
+const testTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+func TestMain(m *testing.M) {
+	if err := tracing.Init("test"); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// capture initializes the standard logger and returns the buffer the entries are written to
+func capture(t *testing.T, format, level string) *bytes.Buffer {
+	if err := Init("test-service", format, level); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	var b bytes.Buffer
+	log.SetOutput(&b)
+	return &b
+}
+
+func decode(t *testing.T, b []byte) map[string]interface{} {
+	var entry map[string]interface{}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		t.Fatalf("error while decoding the entry %s: %v", b, err)
+	}
+	return entry
+}
+
+func TestInit(t *testing.T) {
+	defer log.SetOutput(os.Stderr)
+	b := capture(t, "", "")
+	log.Info("message")
+	entry := decode(t, b.Bytes())
+	want := map[string]interface{}{"level": "info", "msg": "message", ServiceField: "test-service"}
+	for k, v := range want {
+		if entry[k] != v {
+			t.Errorf("entry %v = %v, want %v", k, entry[k], v)
+		}
+	}
+
+	if err := Init("test-service", "text", ""); err == nil {
+		t.Errorf("Init() error = nil, want an error for an invalid format")
+	}
+	if err := Init("test-service", JSONFormat, "verbose"); err == nil {
+		t.Errorf("Init() error = nil, want an error for an invalid level")
+	}
+}
+
+func TestSetLevel(t *testing.T) {
+	defer log.SetOutput(os.Stderr)
+	b := capture(t, JSONFormat, "warn")
+	log.Info("message")
+	if b.Len() != 0 {
+		t.Errorf("entry %s written, want no entry below the warning level", b.Bytes())
+	}
+
+	if err := SetLevel("debug"); err != nil {
+		t.Fatalf("SetLevel() error = %v", err)
+	}
+	b.Reset()
+	log.Debug("message")
+	if b.Len() == 0 {
+		t.Errorf("no entry written, want the debug entry once the level is changed")
+	}
+
+	if err := SetLevel("verbose"); err == nil {
+		t.Errorf("SetLevel() error = nil, want an error for an invalid level")
+	}
+	if log.GetLevel() != log.DebugLevel {
+		t.Errorf("level = %v, want %v kept for an invalid level", log.GetLevel(), log.DebugLevel)
+	}
+}
+
+func TestLog(t *testing.T) {
+	defer log.SetOutput(os.Stderr)
+	b := capture(t, JSONFormat, "")
+	header := http.Header{}
+	header.Set("traceparent", "00-"+testTraceID+"-00f067aa0ba902b7-01")
+	ctx := tracing.ExtractHeaders(context.Background(), header)
+	ctx = With(ctx, SessionUserField, "admin")
+	ctx = With(ctx, SystemIDField, "6d4a0a66-7efa-578e-83cf-44dc68d2874e:1")
+	parent := With(ctx, PluginIDField, "GRF")
+
+	Log(parent).Error("message")
+	entry := decode(t, b.Bytes())
+	want := map[string]interface{}{
+		"level":            "error",
+		"msg":              "message",
+		ServiceField:       "test-service",
+		TransactionIDField: testTraceID,
+		SessionUserField:   "admin",
+		SystemIDField:      "6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
+		PluginIDField:      "GRF",
+	}
+	for k, v := range want {
+		if entry[k] != v {
+			t.Errorf("entry %v = %v, want %v", k, entry[k], v)
+		}
+	}
+
+	b.Reset()
+	Log(ctx).Info("message")
+	if entry := decode(t, b.Bytes()); entry[PluginIDField] != nil {
+		t.Errorf("entry %v = %v, want the fields of the parent context only", PluginIDField, entry[PluginIDField])
+	}
+
+	b.Reset()
+	Log(context.Background()).Info("message")
+	if entry := decode(t, b.Bytes()); entry[TransactionIDField] != nil {
+		t.Errorf("entry %v = %v, want no transaction ID without trace", TransactionIDField, entry[TransactionIDField])
+	}
+
+	b.Reset()
+	Log(With(nil, SessionUserField, "admin")).Info("message")
+	if entry := decode(t, b.Bytes()); entry[SessionUserField] != "admin" {
+		t.Errorf("entry %v = %v, want the field set on a nil context", SessionUserField, entry[SessionUserField])
+	}
+}
+
+func TestSyslogFormat(t *testing.T) {
+	defer log.SetOutput(os.Stderr)
+	b := capture(t, SyslogFormat, "")
+	log.WithField(PluginIDField, "GRF").Warn("message")
+
+	hostname, _ := os.Hostname()
+	header := regexp.MustCompile(fmt.Sprintf(
+		`^<12>1 \d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{6}(Z|[+-]\d{2}:\d{2}) %s test-service %d - - `,
+		regexp.QuoteMeta(hostname), os.Getpid()))
+	loc := header.FindIndex(b.Bytes())
+	if loc == nil {
+		t.Fatalf("entry %s, want a RFC 5424 header", b.Bytes())
+	}
+	entry := decode(t, b.Bytes()[loc[1]:])
+	if entry["msg"] != "message" || entry[PluginIDField] != "GRF" || entry[ServiceField] != "test-service" {
+		t.Errorf("entry message = %v, want the JSON entry", entry)
+	}
+}
+
+func TestSyslogHeaderValue(t *testing.T) {
+	tests := []struct {
+		value  string
+		length int
+		want   string
+	}{
+		{"svc-events", 48, "svc-events"},
+		{"plugin name", 48, "plugin_name"},
+		{"", 48, "-"},
+		{"abcdef", 3, "abc"},
+	}
+	for _, tt := range tests {
+		if got := syslogHeaderValue(tt.value, tt.length); got != tt.want {
+			t.Errorf("syslogHeaderValue(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package metrics holds the Prometheus metrics exposed by the ODIMRA services and plugins
+// along with the helpers to record them and to serve them on the /metrics endpoint
+package metrics
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+	iris "github.com/kataras/iris/v12"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+)
+
+const namespace = "odimra"
+
+// Outcomes of the requests sent to the plugins
+const (
+	// PluginSuccess is the outcome of the plugin requests answered with a 2xx status
+	PluginSuccess = "success"
+	// PluginFailure is the outcome of the plugin requests answered with any other status
+	PluginFailure = "failure"
+	// PluginUnreachable is the outcome of the plugin requests for which no response was received
+	PluginUnreachable = "unreachable"
+)
+
+// UnmatchedRoute is the route label of the HTTP requests which matched none of the routes,
+// the path of these requests isn't recorded so that the number of series is bounded
+const UnmatchedRoute = "unmatched"
+
+// Results of the events delivered to the subscribers
+const (
+	// DeliverySuccess is the result of the events accepted by the destination
+	DeliverySuccess = "success"
+	// DeliveryFailure is the result of the events not accepted by the destination
+	DeliveryFailure = "failure"
+)
+
+var (
+	httpRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests served, by route, method and status code.",
+		},
+		[]string{"route", "method", "code"},
+	)
+	httpRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of the HTTP requests served, by route and method.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"route", "method"},
+	)
+	rpcRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rpc_requests_total",
+			Help:      "Number of RPC requests served, by method and status code.",
+		},
+		[]string{"method", "code"},
+	)
+	rpcRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "rpc_request_duration_seconds",
+			Help:      "Latency of the RPC requests served, by method.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+	pluginRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "plugin_requests_total",
+			Help:      "Number of requests sent to the plugins, by plugin ID and outcome.",
+		},
+		[]string{"plugin", "outcome"},
+	)
+	eventDeliveries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "event_deliveries_total",
+			Help:      "Number of events delivered to the subscribers, by destination host and result.",
+		},
+		[]string{"destination", "result"},
+	)
+	taskStates = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "task_state_transitions_total",
+			Help:      "Number of tasks which entered each of the task states, by state.",
+		},
+		[]string{"state"},
+	)
+	schemaViolations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "schema_violations_total",
+			Help:      "Number of schema violations found in the plugin responses, by plugin ID.",
+		},
+		[]string{"plugin"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		httpRequests,
+		httpRequestDuration,
+		rpcRequests,
+		rpcRequestDuration,
+		pluginRequests,
+		eventDeliveries,
+		taskStates,
+		schemaViolations,
+		newDBPoolCollector(),
+	)
+}
+
+// Handler returns the http.Handler which serves the metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ListenAndServe serves the metrics on the /metrics endpoint of the address.
+// It is expected to be called as a goroutine and it does nothing if the address is empty.
+func ListenAndServe(address string) {
+	if address == "" {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	log.Info("Serving metrics on " + address + "/metrics")
+	if err := http.ListenAndServe(address, mux); err != nil {
+		log.Error("error while serving metrics on " + address + ": " + err.Error())
+	}
+}
+
+// ObserveHTTPRequest records a served HTTP request. The route is the registered path
+// of the route, for e.g. /redfish/v1/Systems/{id}, so that the number of series is bounded.
+func ObserveHTTPRequest(route, method string, code int, duration time.Duration) {
+	httpRequests.WithLabelValues(route, method, strconv.Itoa(code)).Inc()
+	httpRequestDuration.WithLabelValues(route, method).Observe(duration.Seconds())
+}
+
+// ObserveRPCRequest records a served RPC request, code is the status code of the response
+func ObserveRPCRequest(method, code string, duration time.Duration) {
+	rpcRequests.WithLabelValues(method, code).Inc()
+	rpcRequestDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// ObservePluginResponse records the outcome of a request sent to a plugin from the
+// response received and the error returned by the client
+func ObservePluginResponse(pluginID string, resp *http.Response, err error) {
+	outcome := PluginSuccess
+	switch {
+	case err != nil || resp == nil:
+		outcome = PluginUnreachable
+	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		outcome = PluginFailure
+	}
+	pluginRequests.WithLabelValues(pluginID, outcome).Inc()
+}
+
+// ObserveEventDelivery records the result of an event delivered to the destination of a subscriber.
+// Only the host of the destination is recorded, its path may hold identifiers or credentials.
+func ObserveEventDelivery(destination string, delivered bool) {
+	result := DeliverySuccess
+	if !delivered {
+		result = DeliveryFailure
+	}
+	eventDeliveries.WithLabelValues(destinationHost(destination), result).Inc()
+}
+
+// destinationHost returns the host of the destination URL, or an empty string if it isn't a valid URL
+func destinationHost(destination string) string {
+	u, err := url.Parse(destination)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+// ObserveTaskState records a task entering the state
+func ObserveTaskState(state string) {
+	taskStates.WithLabelValues(state).Inc()
+}
+
+// ObserveSchemaViolations records the schema violations found in a response of the plugin
+func ObserveSchemaViolations(pluginID string, count int) {
+	schemaViolations.WithLabelValues(pluginID).Add(float64(count))
+}
+
+// IrisMiddleware is the iris handler which records the requests served by the routes.
+// It is to be registered with UseGlobal so that it runs first on all the routes.
+func IrisMiddleware(ctx iris.Context) {
+	start := time.Now()
+	ctx.Next()
+	route := UnmatchedRoute
+	// the requests matching no route are served by the route of the error code
+	if r := ctx.GetCurrentRoute(); r != nil && r.StatusErrorCode() == 0 {
+		route = r.Path()
+	}
+	ObserveHTTPRequest(route, ctx.Method(), ctx.GetStatusCode(), time.Since(start))
+}
+
+// dbPoolCollector collects the number of connections in the DB pools at each scrape
+type dbPoolCollector struct {
+	connections *prometheus.Desc
+}
+
+func newDBPoolCollector() *dbPoolCollector {
+	return &dbPoolCollector{
+		connections: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "db_pool", "connections"),
+			"Number of connections in the DB pools, by DB, pool and state.",
+			[]string{"db", "pool", "state"}, nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *dbPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.connections
+}
+
+// Collect implements prometheus.Collector. Only the pools already established
+// by the service are reported.
+func (c *dbPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	dbs := []struct {
+		name   string
+		dbFlag persistencemgr.DbType
+	}{
+		{"InMemory", persistencemgr.InMemory},
+		{"OnDisk", persistencemgr.OnDisk},
+	}
+	for _, db := range dbs {
+		stats, ok := persistencemgr.GetPoolStats(db.dbFlag)
+		if !ok {
+			continue
+		}
+		c.collect(ch, db.name, "read", stats.ReadActive, stats.ReadIdle)
+		c.collect(ch, db.name, "write", stats.WriteActive, stats.WriteIdle)
+	}
+}
+
+func (c *dbPoolCollector) collect(ch chan<- prometheus.Metric, db, pool string, active, idle int) {
+	ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(active-idle), db, pool, "inuse")
+	ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(idle), db, pool, "idle")
+}
@@ -220,6 +220,7 @@ type UpdateAccountRequest struct {
 	SessionToken         string   `protobuf:"bytes,1,opt,name=SessionToken,proto3" json:"SessionToken,omitempty"`
 	AccountID            string   `protobuf:"bytes,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
 	RequestBody          []byte   `protobuf:"bytes,3,opt,name=RequestBody,proto3" json:"RequestBody,omitempty"`
+	IfMatch              string   `protobuf:"bytes,4,opt,name=IfMatch,proto3" json:"IfMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -271,9 +272,17 @@ func (m *UpdateAccountRequest) GetRequestBody() []byte {
 	return nil
 }
 
+func (m *UpdateAccountRequest) GetIfMatch() string {
+	if m != nil {
+		return m.IfMatch
+	}
+	return ""
+}
+
 type DeleteAccountRequest struct {
 	SessionToken         string   `protobuf:"bytes,1,opt,name=SessionToken,proto3" json:"SessionToken,omitempty"`
 	AccountID            string   `protobuf:"bytes,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	IfMatch              string   `protobuf:"bytes,3,opt,name=IfMatch,proto3" json:"IfMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -318,6 +327,13 @@ func (m *DeleteAccountRequest) GetAccountID() string {
 	return ""
 }
 
+func (m *DeleteAccountRequest) GetIfMatch() string {
+	if m != nil {
+		return m.IfMatch
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AccountResponse)(nil), "AccountResponse")
 	proto.RegisterMapType((map[string]string)(nil), "AccountResponse.HeaderEntry")
@@ -331,29 +347,30 @@ func init() {
 func init() { proto.RegisterFile("account.proto", fileDescriptor_8e28828dcb8d24f0) }
 
 var fileDescriptor_8e28828dcb8d24f0 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4d, 0x6f, 0xda, 0x40,
-	0x10, 0xad, 0x6d, 0x70, 0xc5, 0xf0, 0xd9, 0x91, 0x91, 0x2c, 0x84, 0x2a, 0xcb, 0xea, 0xc1, 0xa7,
-	0x3d, 0x00, 0x95, 0x4a, 0x6f, 0x2d, 0x44, 0x49, 0x0e, 0xb9, 0x98, 0x20, 0xe5, 0x90, 0x8b, 0xc1,
-	0xa3, 0x04, 0x61, 0x79, 0x89, 0x77, 0x8d, 0x44, 0x7e, 0x65, 0x7e, 0x40, 0x7e, 0x4c, 0x84, 0xed,
-	0x84, 0x2f, 0x27, 0x22, 0x28, 0xb7, 0x9d, 0xb7, 0xf3, 0x66, 0xde, 0xbe, 0x67, 0x43, 0xd5, 0x9b,
-	0x4e, 0x79, 0x1c, 0x4a, 0xb6, 0x88, 0xb8, 0xe4, 0xf6, 0xb3, 0x02, 0xf5, 0x7f, 0x29, 0xe2, 0x92,
-	0x58, 0xf0, 0x50, 0x10, 0xfe, 0x04, 0x10, 0xd2, 0x93, 0xb1, 0x18, 0x70, 0x9f, 0x4c, 0xc5, 0x52,
-	0x9c, 0xa2, 0xbb, 0x85, 0xe0, 0x2f, 0xa8, 0xa6, 0xd5, 0x15, 0x09, 0xe1, 0xdd, 0x91, 0xa9, 0x5a,
-	0x8a, 0x53, 0x72, 0x77, 0x41, 0xec, 0x81, 0x7e, 0x4f, 0x9e, 0x4f, 0x91, 0xa9, 0x59, 0x9a, 0x53,
-	0xee, 0xb4, 0xd9, 0xde, 0x1e, 0x76, 0x91, 0x5c, 0x9f, 0x85, 0x32, 0x5a, 0xb9, 0x59, 0x2f, 0x22,
-	0x14, 0x26, 0xdc, 0x5f, 0x99, 0x05, 0x4b, 0x71, 0x2a, 0x6e, 0x72, 0x6e, 0xf5, 0xa1, 0xbc, 0xd5,
-	0x8a, 0x0d, 0xd0, 0xe6, 0xb4, 0x4a, 0x74, 0x95, 0xdc, 0xf5, 0x11, 0x0d, 0x28, 0x2e, 0xbd, 0x20,
-	0x7e, 0x15, 0x92, 0x16, 0x7f, 0xd5, 0x3f, 0x8a, 0x7d, 0x0b, 0xc6, 0x20, 0x22, 0x4f, 0xd2, 0xdb,
-	0xee, 0x87, 0x98, 0x84, 0x44, 0x1b, 0x2a, 0x23, 0x12, 0x62, 0xc6, 0xc3, 0x6b, 0x3e, 0xa7, 0x30,
-	0x1b, 0xb6, 0x83, 0xa1, 0x05, 0xe5, 0xac, 0xfd, 0xff, 0x5a, 0x91, 0x9a, 0x28, 0xda, 0x86, 0xec,
-	0x1e, 0xd4, 0x3e, 0x3f, 0xd7, 0x1e, 0xc3, 0x8f, 0x73, 0x92, 0x27, 0x08, 0x6a, 0x43, 0x29, 0x63,
-	0x5d, 0x0e, 0xb3, 0xa7, 0x6e, 0x00, 0xfb, 0x11, 0x8c, 0xf1, 0xc2, 0x3f, 0xed, 0xa9, 0x1f, 0x4e,
-	0xde, 0x37, 0x42, 0x3b, 0x34, 0xe2, 0x06, 0x8c, 0x21, 0x05, 0xf4, 0xf5, 0xbb, 0x3b, 0x4f, 0x2a,
-	0x7c, 0xcf, 0x2a, 0xec, 0x82, 0x9e, 0x86, 0x89, 0x4d, 0x96, 0x97, 0x6a, 0xab, 0xb1, 0xff, 0x89,
-	0xd9, 0xdf, 0xf0, 0x37, 0xd4, 0xd6, 0x6e, 0x07, 0x41, 0x76, 0x25, 0xb0, 0xce, 0x8e, 0xa0, 0xf5,
-	0x00, 0x36, 0x21, 0x21, 0xb2, 0x83, 0xc4, 0x72, 0x59, 0x7d, 0xc0, 0x4d, 0xe3, 0x88, 0xa2, 0xe5,
-	0x6c, 0x4a, 0x47, 0x2e, 0xec, 0x82, 0x9e, 0xc6, 0x87, 0x4d, 0x96, 0x97, 0xe3, 0x7b, 0xa4, 0xd4,
-	0x77, 0x6c, 0xb2, 0xbc, 0x00, 0xf2, 0x48, 0x13, 0x3d, 0xf9, 0xf3, 0xbb, 0x2f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0xb3, 0x27, 0x8e, 0x0a, 0x04, 0x00, 0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0xce, 0xd2, 0x40,
+	0x14, 0xb5, 0xed, 0xf7, 0xf5, 0x4b, 0x2f, 0xdf, 0x0f, 0xde, 0x94, 0xa4, 0x21, 0xc4, 0x34, 0x13,
+	0x17, 0x5d, 0xcd, 0x02, 0x30, 0x11, 0x77, 0x0a, 0x46, 0x59, 0xb0, 0x29, 0xb2, 0x73, 0x53, 0xda,
+	0xab, 0x10, 0x9a, 0x0e, 0x76, 0xa6, 0x24, 0xbc, 0x87, 0x0f, 0xe6, 0x03, 0xf8, 0x30, 0x86, 0xb6,
+	0x48, 0x81, 0x6a, 0x90, 0xc4, 0x5d, 0xef, 0x99, 0x7b, 0xee, 0x39, 0x33, 0xe7, 0xa6, 0xf0, 0x10,
+	0x84, 0xa1, 0xc8, 0x12, 0xc5, 0xd7, 0xa9, 0x50, 0x82, 0xfd, 0xd4, 0xe0, 0xe9, 0x6d, 0x81, 0xf8,
+	0x24, 0xd7, 0x22, 0x91, 0x84, 0x2f, 0x00, 0xa4, 0x0a, 0x54, 0x26, 0x87, 0x22, 0x22, 0x47, 0x73,
+	0x35, 0xef, 0xd6, 0xaf, 0x20, 0xf8, 0x12, 0x1e, 0x8a, 0x6a, 0x42, 0x52, 0x06, 0x5f, 0xc9, 0xd1,
+	0x5d, 0xcd, 0xb3, 0xfc, 0x63, 0x10, 0xfb, 0x60, 0x2e, 0x28, 0x88, 0x28, 0x75, 0x0c, 0xd7, 0xf0,
+	0x1a, 0xdd, 0x0e, 0x3f, 0xd1, 0xe1, 0x1f, 0xf3, 0xe3, 0xf7, 0x89, 0x4a, 0xb7, 0x7e, 0xd9, 0x8b,
+	0x08, 0x37, 0x73, 0x11, 0x6d, 0x9d, 0x1b, 0x57, 0xf3, 0xee, 0xfd, 0xfc, 0xbb, 0x3d, 0x80, 0x46,
+	0xa5, 0x15, 0x9b, 0x60, 0xac, 0x68, 0x9b, 0xfb, 0xb2, 0xfc, 0xdd, 0x27, 0xda, 0x70, 0xbb, 0x09,
+	0xe2, 0x6c, 0x6f, 0xa4, 0x28, 0xde, 0xe8, 0xaf, 0x35, 0xf6, 0x19, 0xec, 0x61, 0x4a, 0x81, 0xa2,
+	0xdf, 0xda, 0xdf, 0x32, 0x92, 0x0a, 0x19, 0xdc, 0x4f, 0x49, 0xca, 0xa5, 0x48, 0x3e, 0x89, 0x15,
+	0x25, 0xe5, 0xb0, 0x23, 0x0c, 0x5d, 0x68, 0x94, 0xed, 0xef, 0x76, 0x8e, 0xf4, 0xdc, 0x51, 0x15,
+	0x62, 0x7d, 0x78, 0xfc, 0xf7, 0xb9, 0x6c, 0x06, 0xcf, 0x3f, 0x90, 0xba, 0xc2, 0x50, 0x07, 0xac,
+	0x92, 0x35, 0x1e, 0x95, 0x57, 0x3d, 0x00, 0xec, 0xbb, 0x06, 0xf6, 0x6c, 0x1d, 0x5d, 0x77, 0xd7,
+	0xbf, 0x8e, 0x3e, 0x7d, 0x09, 0xe3, 0xec, 0x25, 0xd0, 0x81, 0xbb, 0xf1, 0x97, 0x49, 0xa0, 0xc2,
+	0x45, 0x9e, 0x9c, 0xe5, 0xef, 0x4b, 0x96, 0x82, 0x3d, 0xa2, 0x98, 0xfe, 0x83, 0xab, 0x8a, 0xa6,
+	0x71, 0xa4, 0xd9, 0xfd, 0xa1, 0xc3, 0x5d, 0xd9, 0x87, 0x3d, 0x30, 0x8b, 0x0d, 0xc0, 0x16, 0xaf,
+	0x5b, 0x85, 0x76, 0xf3, 0x74, 0x2f, 0xd9, 0x33, 0x7c, 0x05, 0x8f, 0xbb, 0x88, 0xe2, 0xb8, 0x3c,
+	0x92, 0xf8, 0xc4, 0x2f, 0xa0, 0xf5, 0x01, 0x0e, 0xc9, 0x22, 0xf2, 0xb3, 0x98, 0x6b, 0x59, 0x03,
+	0xc0, 0x43, 0xe3, 0x94, 0xd2, 0xcd, 0x32, 0xa4, 0x0b, 0x05, 0x7b, 0x60, 0x16, 0x91, 0x63, 0x8b,
+	0xd7, 0x65, 0xff, 0x27, 0x52, 0x91, 0x08, 0xb6, 0x78, 0x5d, 0x34, 0x75, 0xa4, 0xb9, 0x99, 0xff,
+	0x2e, 0x7a, 0xbf, 0x06, 0x00, 0xdf, 0xae, 0x1e, 0x09, 0x3f, 0x04, 0x00, 0x00,
 }
@@ -39,6 +39,9 @@ type AggregatorService interface {
 	SetDefaultBootOrder(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
 	RediscoverSystemInventory(ctx context.Context, in *RediscoverSystemInventoryRequest, opts ...client.CallOption) (*RediscoverSystemInventoryResponse, error)
 	UpdateSystemState(ctx context.Context, in *UpdateSystemStateRequest, opts ...client.CallOption) (*UpdateSystemStateResponse, error)
+	UpdateDynamicAggregates(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
+	Backup(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
+	Restore(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
 	AddAggregationSource(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
 	GetAllAggregationSource(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
 	GetAggregationSource(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
@@ -52,6 +55,7 @@ type AggregatorService interface {
 	RemoveElementsFromAggregate(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
 	ResetElementsOfAggregate(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
 	SetDefaultBootOrderElementsOfAggregate(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
+	BulkRequestElementsOfAggregate(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
 	GetAllConnectionMethods(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
 	GetConnectionMethod(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error)
 }
@@ -124,6 +128,36 @@ func (c *aggregatorService) UpdateSystemState(ctx context.Context, in *UpdateSys
 	return out, nil
 }
 
+func (c *aggregatorService) UpdateDynamicAggregates(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error) {
+	req := c.c.NewRequest(c.name, "Aggregator.UpdateDynamicAggregates", in)
+	out := new(AggregatorResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorService) Backup(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error) {
+	req := c.c.NewRequest(c.name, "Aggregator.Backup", in)
+	out := new(AggregatorResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorService) Restore(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error) {
+	req := c.c.NewRequest(c.name, "Aggregator.Restore", in)
+	out := new(AggregatorResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aggregatorService) AddAggregationSource(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error) {
 	req := c.c.NewRequest(c.name, "Aggregator.AddAggregationSource", in)
 	out := new(AggregatorResponse)
@@ -254,6 +288,16 @@ func (c *aggregatorService) SetDefaultBootOrderElementsOfAggregate(ctx context.C
 	return out, nil
 }
 
+func (c *aggregatorService) BulkRequestElementsOfAggregate(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error) {
+	req := c.c.NewRequest(c.name, "Aggregator.BulkRequestElementsOfAggregate", in)
+	out := new(AggregatorResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aggregatorService) GetAllConnectionMethods(ctx context.Context, in *AggregatorRequest, opts ...client.CallOption) (*AggregatorResponse, error) {
 	req := c.c.NewRequest(c.name, "Aggregator.GetAllConnectionMethods", in)
 	out := new(AggregatorResponse)
@@ -282,6 +326,9 @@ type AggregatorHandler interface {
 	SetDefaultBootOrder(context.Context, *AggregatorRequest, *AggregatorResponse) error
 	RediscoverSystemInventory(context.Context, *RediscoverSystemInventoryRequest, *RediscoverSystemInventoryResponse) error
 	UpdateSystemState(context.Context, *UpdateSystemStateRequest, *UpdateSystemStateResponse) error
+	UpdateDynamicAggregates(context.Context, *AggregatorRequest, *AggregatorResponse) error
+	Backup(context.Context, *AggregatorRequest, *AggregatorResponse) error
+	Restore(context.Context, *AggregatorRequest, *AggregatorResponse) error
 	AddAggregationSource(context.Context, *AggregatorRequest, *AggregatorResponse) error
 	GetAllAggregationSource(context.Context, *AggregatorRequest, *AggregatorResponse) error
 	GetAggregationSource(context.Context, *AggregatorRequest, *AggregatorResponse) error
@@ -295,6 +342,7 @@ type AggregatorHandler interface {
 	RemoveElementsFromAggregate(context.Context, *AggregatorRequest, *AggregatorResponse) error
 	ResetElementsOfAggregate(context.Context, *AggregatorRequest, *AggregatorResponse) error
 	SetDefaultBootOrderElementsOfAggregate(context.Context, *AggregatorRequest, *AggregatorResponse) error
+	BulkRequestElementsOfAggregate(context.Context, *AggregatorRequest, *AggregatorResponse) error
 	GetAllConnectionMethods(context.Context, *AggregatorRequest, *AggregatorResponse) error
 	GetConnectionMethod(context.Context, *AggregatorRequest, *AggregatorResponse) error
 }
@@ -306,6 +354,9 @@ func RegisterAggregatorHandler(s server.Server, hdlr AggregatorHandler, opts ...
 		SetDefaultBootOrder(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
 		RediscoverSystemInventory(ctx context.Context, in *RediscoverSystemInventoryRequest, out *RediscoverSystemInventoryResponse) error
 		UpdateSystemState(ctx context.Context, in *UpdateSystemStateRequest, out *UpdateSystemStateResponse) error
+		UpdateDynamicAggregates(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
+		Backup(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
+		Restore(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
 		AddAggregationSource(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
 		GetAllAggregationSource(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
 		GetAggregationSource(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
@@ -319,6 +370,7 @@ func RegisterAggregatorHandler(s server.Server, hdlr AggregatorHandler, opts ...
 		RemoveElementsFromAggregate(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
 		ResetElementsOfAggregate(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
 		SetDefaultBootOrderElementsOfAggregate(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
+		BulkRequestElementsOfAggregate(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
 		GetAllConnectionMethods(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
 		GetConnectionMethod(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error
 	}
@@ -353,6 +405,18 @@ func (h *aggregatorHandler) UpdateSystemState(ctx context.Context, in *UpdateSys
 	return h.AggregatorHandler.UpdateSystemState(ctx, in, out)
 }
 
+func (h *aggregatorHandler) UpdateDynamicAggregates(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error {
+	return h.AggregatorHandler.UpdateDynamicAggregates(ctx, in, out)
+}
+
+func (h *aggregatorHandler) Backup(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error {
+	return h.AggregatorHandler.Backup(ctx, in, out)
+}
+
+func (h *aggregatorHandler) Restore(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error {
+	return h.AggregatorHandler.Restore(ctx, in, out)
+}
+
 func (h *aggregatorHandler) AddAggregationSource(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error {
 	return h.AggregatorHandler.AddAggregationSource(ctx, in, out)
 }
@@ -405,6 +469,10 @@ func (h *aggregatorHandler) SetDefaultBootOrderElementsOfAggregate(ctx context.C
 	return h.AggregatorHandler.SetDefaultBootOrderElementsOfAggregate(ctx, in, out)
 }
 
+func (h *aggregatorHandler) BulkRequestElementsOfAggregate(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error {
+	return h.AggregatorHandler.BulkRequestElementsOfAggregate(ctx, in, out)
+}
+
 func (h *aggregatorHandler) GetAllConnectionMethods(ctx context.Context, in *AggregatorRequest, out *AggregatorResponse) error {
 	return h.AggregatorHandler.GetAllConnectionMethods(ctx, in, out)
 }
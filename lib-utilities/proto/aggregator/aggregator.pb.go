@@ -24,6 +24,7 @@ type AggregatorRequest struct {
 	SessionToken         string   `protobuf:"bytes,1,opt,name=SessionToken,proto3" json:"SessionToken,omitempty"`
 	RequestBody          []byte   `protobuf:"bytes,2,opt,name=RequestBody,proto3" json:"RequestBody,omitempty"`
 	URL                  string   `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	IfMatch              string   `protobuf:"bytes,4,opt,name=IfMatch,proto3" json:"IfMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -75,6 +76,13 @@ func (m *AggregatorRequest) GetURL() string {
 	return ""
 }
 
+func (m *AggregatorRequest) GetIfMatch() string {
+	if m != nil {
+		return m.IfMatch
+	}
+	return ""
+}
+
 type AggregatorResponse struct {
 	StatusCode           int32             `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
 	StatusMessage        string            `protobuf:"bytes,2,opt,name=statusMessage,proto3" json:"statusMessage,omitempty"`
@@ -347,44 +355,48 @@ func init() {
 func init() { proto.RegisterFile("aggregator.proto", fileDescriptor_60785b04c84bec7e) }
 
 var fileDescriptor_60785b04c84bec7e = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xda, 0x4c,
-	0x10, 0x8d, 0x21, 0xe4, 0xfb, 0x18, 0xa8, 0x4a, 0x36, 0xb4, 0x35, 0x6e, 0x95, 0x12, 0xab, 0xaa,
-	0xb8, 0xf2, 0x05, 0x55, 0xd5, 0xa6, 0x6a, 0xa4, 0xf2, 0xd7, 0x24, 0x6a, 0xa2, 0x48, 0x36, 0xe4,
-	0xaa, 0x37, 0x1b, 0x3c, 0x21, 0x08, 0xe3, 0xa5, 0xbb, 0x0b, 0x12, 0x6f, 0xd5, 0x67, 0xea, 0x6b,
-	0xf4, 0xa6, 0xf2, 0x1f, 0x98, 0x00, 0xa5, 0x86, 0xbb, 0xdd, 0xb3, 0x3b, 0x67, 0xce, 0x9c, 0x19,
-	0xaf, 0x0c, 0x05, 0xda, 0xeb, 0x71, 0xec, 0x51, 0xc9, 0xb8, 0x31, 0xe2, 0x4c, 0x32, 0x7d, 0x00,
-	0x87, 0xb5, 0x19, 0x66, 0xe2, 0x8f, 0x31, 0x0a, 0x49, 0x74, 0xc8, 0x5b, 0x28, 0x44, 0x9f, 0xb9,
-	0x6d, 0x36, 0x40, 0x57, 0x55, 0xca, 0x4a, 0x25, 0x6b, 0x2e, 0x60, 0xa4, 0x0c, 0xb9, 0xf0, 0x7a,
-	0x9d, 0xd9, 0x53, 0x35, 0x55, 0x56, 0x2a, 0x79, 0x33, 0x0e, 0x91, 0x02, 0xa4, 0x3b, 0xe6, 0x95,
-	0x9a, 0xf6, 0x83, 0xbd, 0xa5, 0xfe, 0x4b, 0x01, 0x12, 0xcf, 0x26, 0x46, 0xcc, 0x15, 0x48, 0x8e,
-	0x01, 0x84, 0xa4, 0x72, 0x2c, 0x1a, 0xcc, 0x46, 0x3f, 0x59, 0xc6, 0x8c, 0x21, 0xe4, 0x0d, 0x3c,
-	0x09, 0x76, 0xd7, 0x28, 0x04, 0xed, 0xa1, 0x9f, 0x2c, 0x6b, 0x2e, 0x82, 0xe4, 0x03, 0x1c, 0x3c,
-	0x20, 0xb5, 0x91, 0xab, 0xe9, 0x72, 0xba, 0x92, 0xab, 0xbe, 0x36, 0x96, 0x53, 0x19, 0x17, 0xfe,
-	0x8d, 0x96, 0x2b, 0xf9, 0xd4, 0x0c, 0xaf, 0x13, 0x02, 0xfb, 0x77, 0x5e, 0x09, 0xfb, 0x7e, 0x09,
-	0xfe, 0x5a, 0x3b, 0x85, 0x5c, 0xec, 0xaa, 0x57, 0xca, 0x00, 0xa7, 0xa1, 0x0f, 0xde, 0x92, 0x14,
-	0x21, 0x33, 0xa1, 0xce, 0x38, 0xd2, 0x12, 0x6c, 0x3e, 0xa5, 0x3e, 0x2a, 0xfa, 0x77, 0x28, 0x9b,
-	0x68, 0xf7, 0x45, 0x97, 0x4d, 0x90, 0x5b, 0x53, 0x21, 0x71, 0x78, 0xe9, 0x4e, 0xd0, 0x95, 0x8c,
-	0x4f, 0x23, 0x83, 0x35, 0xf8, 0x3f, 0x3c, 0x69, 0x86, 0xa4, 0xb3, 0x3d, 0x79, 0x05, 0xd9, 0x60,
-	0xed, 0x99, 0x17, 0xb0, 0xcf, 0x01, 0xfd, 0x0c, 0x4e, 0xfe, 0xc2, 0x1e, 0x1a, 0xaa, 0xc2, 0x7f,
-	0x6d, 0x2a, 0x06, 0x1e, 0x41, 0xc0, 0x1e, 0x6d, 0xf5, 0x9f, 0x0a, 0xa8, 0x9d, 0x91, 0x4d, 0x25,
-	0x06, 0xb1, 0x96, 0xa4, 0x12, 0x23, 0x55, 0xc7, 0x00, 0x61, 0xa2, 0xce, 0x4c, 0x57, 0x0c, 0x59,
-	0x50, 0x9d, 0x5a, 0xaf, 0xfa, 0x32, 0x6c, 0xf9, 0x1c, 0xf0, 0x4e, 0x83, 0xac, 0xdf, 0x30, 0xf0,
-	0x39, 0x6b, 0xce, 0x81, 0xf9, 0xe9, 0x2d, 0x75, 0xd4, 0x4c, 0xfc, 0xf4, 0x96, 0x3a, 0xfa, 0x7b,
-	0x28, 0xad, 0x50, 0xbc, 0xa9, 0xd2, 0xea, 0x6f, 0x00, 0x98, 0x0f, 0x00, 0xa9, 0xc3, 0xb3, 0x73,
-	0x94, 0x11, 0xd0, 0x67, 0xae, 0x85, 0x7c, 0xd2, 0xef, 0x22, 0x21, 0xc6, 0xd2, 0xfc, 0x6b, 0x47,
-	0x2b, 0x46, 0x47, 0xdf, 0x23, 0x55, 0xc8, 0x98, 0x28, 0x50, 0x26, 0x89, 0xf9, 0x02, 0x47, 0x16,
-	0xca, 0x26, 0xde, 0xd3, 0xb1, 0x23, 0xeb, 0x8c, 0xc9, 0x1b, 0xee, 0xcf, 0xdc, 0xbf, 0x33, 0xd8,
-	0x50, 0x5a, 0xdb, 0x71, 0x72, 0x62, 0x6c, 0x9a, 0x35, 0x4d, 0x37, 0x36, 0x0e, 0x8c, 0xbe, 0x47,
-	0xae, 0xe0, 0x70, 0xc9, 0x65, 0x52, 0x32, 0xd6, 0xcd, 0x8a, 0xa6, 0x19, 0x6b, 0x9b, 0xa2, 0xef,
-	0x91, 0x1a, 0x14, 0x6b, 0xb6, 0x1d, 0x77, 0x9b, 0x8d, 0x79, 0x32, 0xb3, 0x9b, 0xf0, 0xc2, 0x6b,
-	0x98, 0xe3, 0xec, 0xc4, 0x52, 0x83, 0xe2, 0xa3, 0xb6, 0x6f, 0x23, 0x24, 0x28, 0x75, 0x57, 0x96,
-	0x26, 0x3a, 0xb8, 0x23, 0xcb, 0x67, 0x78, 0xda, 0xe0, 0x18, 0xd3, 0x92, 0x28, 0xfa, 0x0c, 0x0a,
-	0x8b, 0x96, 0xa2, 0x48, 0x12, 0x7e, 0x0a, 0xf9, 0x98, 0x97, 0x49, 0x75, 0x2f, 0x56, 0x9f, 0x28,
-	0xba, 0x01, 0xcf, 0x6b, 0xb6, 0xdd, 0x72, 0x70, 0x88, 0xae, 0x14, 0x6d, 0xb6, 0x15, 0xc9, 0x05,
-	0xbc, 0x34, 0x71, 0xc8, 0x26, 0x18, 0xf1, 0x7c, 0xe5, 0x6c, 0xb8, 0x15, 0x53, 0x0b, 0x54, 0xff,
-	0x19, 0x88, 0x88, 0x6e, 0xee, 0xb7, 0xa2, 0xb1, 0xe0, 0xed, 0x8a, 0x97, 0x61, 0x47, 0xd2, 0xd9,
-	0x57, 0xd3, 0x60, 0xae, 0x8b, 0x5d, 0x6f, 0xca, 0xae, 0x51, 0x3e, 0x30, 0x5b, 0x24, 0x7c, 0xb4,
-	0xce, 0x51, 0x3e, 0xa6, 0x48, 0xc0, 0x70, 0x77, 0xe0, 0xff, 0x5d, 0xbc, 0xfb, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x78, 0x2b, 0x7e, 0x3d, 0x71, 0x08, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x4e, 0xdb, 0x4c,
+	0x10, 0xc5, 0x84, 0xc0, 0xc7, 0x90, 0x4f, 0x85, 0x85, 0x16, 0xe3, 0x56, 0x34, 0x58, 0x55, 0xc5,
+	0x95, 0x2f, 0xa0, 0x55, 0x4b, 0x55, 0xa4, 0x26, 0x31, 0x05, 0x04, 0x08, 0xc9, 0x06, 0xae, 0x7a,
+	0xb3, 0xd8, 0x43, 0x88, 0xe2, 0x78, 0xd3, 0xdd, 0x75, 0x24, 0x3f, 0x40, 0xdf, 0xa7, 0xcf, 0xd4,
+	0xb7, 0xe8, 0x5d, 0xe5, 0xbf, 0xc4, 0x21, 0x49, 0x53, 0x27, 0x77, 0xbb, 0x67, 0x66, 0xce, 0x9e,
+	0x99, 0x3d, 0x5e, 0x19, 0xd6, 0x69, 0xb3, 0xc9, 0xb1, 0x49, 0x25, 0xe3, 0x46, 0x97, 0x33, 0xc9,
+	0xf4, 0x1f, 0x0a, 0x6c, 0xd4, 0xfa, 0xa0, 0x85, 0xdf, 0x03, 0x14, 0x92, 0xe8, 0x50, 0xb1, 0x51,
+	0x88, 0x16, 0xf3, 0x6f, 0x58, 0x1b, 0x7d, 0x55, 0xa9, 0x2a, 0xfb, 0xab, 0xd6, 0x10, 0x46, 0xaa,
+	0xb0, 0x96, 0xa6, 0xd7, 0x99, 0x1b, 0xaa, 0x8b, 0x55, 0x65, 0xbf, 0x62, 0xe5, 0x21, 0xb2, 0x0e,
+	0xa5, 0x5b, 0xeb, 0x52, 0x2d, 0xc5, 0xc5, 0xd1, 0x92, 0xa8, 0xb0, 0x72, 0xfe, 0x70, 0x45, 0xa5,
+	0xf3, 0xa8, 0x2e, 0xc5, 0x68, 0xb6, 0xd5, 0x7f, 0x29, 0x40, 0xf2, 0x3a, 0x44, 0x97, 0xf9, 0x02,
+	0xc9, 0x2e, 0x80, 0x90, 0x54, 0x06, 0xa2, 0xc1, 0x5c, 0x8c, 0x65, 0x94, 0xad, 0x1c, 0x42, 0xde,
+	0xc0, 0xff, 0xc9, 0xee, 0x0a, 0x85, 0xa0, 0x4d, 0x8c, 0x65, 0xac, 0x5a, 0xc3, 0x20, 0xf9, 0x00,
+	0xcb, 0x8f, 0x48, 0x5d, 0xe4, 0x6a, 0xa9, 0x5a, 0xda, 0x5f, 0x3b, 0x78, 0x6d, 0x8c, 0x1e, 0x65,
+	0x9c, 0xc5, 0x19, 0x27, 0xbe, 0xe4, 0xa1, 0x95, 0xa6, 0x13, 0x02, 0x4b, 0xf7, 0x51, 0x73, 0x4b,
+	0x71, 0x73, 0xf1, 0x5a, 0x3b, 0x82, 0xb5, 0x5c, 0x6a, 0xd4, 0x64, 0x1b, 0xc3, 0x74, 0x42, 0xd1,
+	0x92, 0x6c, 0x41, 0xb9, 0x47, 0xbd, 0x20, 0xd3, 0x92, 0x6c, 0x3e, 0x2d, 0x7e, 0x54, 0xf4, 0x6f,
+	0x50, 0xb5, 0xd0, 0x6d, 0x09, 0x87, 0xf5, 0x90, 0xdb, 0xa1, 0x90, 0xd8, 0x39, 0xf7, 0x7b, 0xe8,
+	0x4b, 0xc6, 0xc3, 0x6c, 0xf4, 0x1a, 0xfc, 0x97, 0x46, 0xcc, 0x94, 0xb4, 0xbf, 0x27, 0xaf, 0x60,
+	0x35, 0x59, 0x47, 0x63, 0x4d, 0xd8, 0x07, 0x80, 0x7e, 0x0c, 0x7b, 0x7f, 0x61, 0x4f, 0x07, 0xaa,
+	0xc2, 0xca, 0x0d, 0x15, 0xed, 0x88, 0x20, 0x61, 0xcf, 0xb6, 0xfa, 0x4f, 0x05, 0xd4, 0xdb, 0xae,
+	0x4b, 0x25, 0x26, 0xb5, 0xb6, 0xa4, 0x12, 0x33, 0x55, 0xbb, 0x00, 0xe9, 0x41, 0xb7, 0x7d, 0x5d,
+	0x39, 0x64, 0x48, 0xf5, 0xe2, 0x64, 0xd5, 0xe7, 0xa9, 0x19, 0x06, 0x40, 0x14, 0x4d, 0x4e, 0xbd,
+	0xc0, 0x30, 0x35, 0xc5, 0x00, 0x18, 0x44, 0xef, 0xa8, 0xa7, 0x96, 0xf3, 0xd1, 0x3b, 0xea, 0xe9,
+	0xef, 0x61, 0x67, 0x8c, 0xe2, 0x69, 0x9d, 0x1e, 0xfc, 0xae, 0x00, 0x0c, 0x0c, 0x40, 0xea, 0xf0,
+	0xfc, 0x14, 0x65, 0x06, 0xb4, 0x98, 0x6f, 0x23, 0xef, 0xb5, 0x1c, 0x24, 0xc4, 0x18, 0xf9, 0x32,
+	0xb4, 0xcd, 0x31, 0xd6, 0xd1, 0x17, 0xc8, 0x01, 0x94, 0x2d, 0x14, 0x28, 0x8b, 0xd4, 0x7c, 0x81,
+	0x4d, 0x1b, 0xa5, 0x89, 0x0f, 0x34, 0xf0, 0x64, 0x9d, 0x31, 0x79, 0xcd, 0x63, 0xcf, 0xfd, 0x3b,
+	0x83, 0x0b, 0x3b, 0x13, 0x6f, 0x9c, 0xec, 0x19, 0xd3, 0xbc, 0xa6, 0xe9, 0xc6, 0x54, 0xc3, 0xe8,
+	0x0b, 0xe4, 0x12, 0x36, 0x46, 0xa6, 0x4c, 0x76, 0x8c, 0x49, 0x5e, 0xd1, 0x34, 0x63, 0xe2, 0xa5,
+	0xe8, 0x0b, 0xc4, 0x84, 0xed, 0x24, 0x6c, 0x86, 0x3e, 0xed, 0xb4, 0x9c, 0xac, 0x31, 0x14, 0x45,
+	0x3a, 0x3f, 0x84, 0xe5, 0x3a, 0x75, 0xda, 0x41, 0xb7, 0x48, 0xd1, 0x3b, 0x58, 0xb1, 0x50, 0x48,
+	0xc6, 0x0b, 0x5d, 0x6d, 0x0d, 0xb6, 0x6a, 0xae, 0x9b, 0xb7, 0x07, 0x0b, 0x78, 0x31, 0x77, 0x98,
+	0xb0, 0x1d, 0x39, 0xcc, 0xf3, 0xe6, 0x62, 0xa9, 0xc1, 0xd6, 0x13, 0x9f, 0xce, 0x22, 0x24, 0x19,
+	0xfe, 0xbc, 0x2c, 0x26, 0x7a, 0x38, 0x27, 0xcb, 0x67, 0x78, 0xd6, 0xe0, 0x98, 0xd3, 0x52, 0xa8,
+	0xfa, 0x18, 0xd6, 0x87, 0x47, 0x5a, 0xcc, 0x3f, 0x47, 0x50, 0xc9, 0xcd, 0xb2, 0xa8, 0xee, 0xe1,
+	0xee, 0x0b, 0x55, 0x37, 0xe0, 0x45, 0xcd, 0x75, 0x4f, 0x3c, 0xec, 0xa0, 0x2f, 0xc5, 0x0d, 0x9b,
+	0x89, 0xe4, 0x0c, 0x5e, 0x5a, 0xd8, 0x61, 0x3d, 0xcc, 0x78, 0xbe, 0x72, 0xd6, 0x99, 0x89, 0xe9,
+	0x04, 0xd4, 0xf8, 0xdd, 0xca, 0x88, 0xae, 0x1f, 0x66, 0xa2, 0xb1, 0xe1, 0xed, 0x98, 0xa7, 0x6c,
+	0x4e, 0xd2, 0x0b, 0xd8, 0xad, 0x07, 0x5e, 0x3b, 0xcd, 0x9a, 0x93, 0xac, 0xff, 0x09, 0x36, 0x98,
+	0xef, 0xa3, 0x13, 0x59, 0xf6, 0x0a, 0xe5, 0x23, 0x73, 0x45, 0xc1, 0x27, 0xfb, 0x14, 0xe5, 0x53,
+	0x8a, 0x02, 0x0c, 0xf7, 0xcb, 0xf1, 0x6f, 0xd7, 0xe1, 0x9f, 0x01, 0x00, 0x6a, 0xe5, 0xd7, 0xd0,
+	0x8a, 0x09, 0x00, 0x00,
 }
@@ -42,6 +42,7 @@ type EventsService interface {
 	CreateDefaultEventSubscription(ctx context.Context, in *DefaultEventSubRequest, opts ...client.CallOption) (*DefaultEventSubResponse, error)
 	GetEventSubscriptionsCollection(ctx context.Context, in *EventRequest, opts ...client.CallOption) (*EventSubResponse, error)
 	SubsribeEMB(ctx context.Context, in *SubscribeEMBRequest, opts ...client.CallOption) (*SubscribeEMBResponse, error)
+	ReplayEvents(ctx context.Context, in *EventSubRequest, opts ...client.CallOption) (*EventSubResponse, error)
 }
 
 type eventsService struct {
@@ -142,6 +143,16 @@ func (c *eventsService) SubsribeEMB(ctx context.Context, in *SubscribeEMBRequest
 	return out, nil
 }
 
+func (c *eventsService) ReplayEvents(ctx context.Context, in *EventSubRequest, opts ...client.CallOption) (*EventSubResponse, error) {
+	req := c.c.NewRequest(c.name, "Events.ReplayEvents", in)
+	out := new(EventSubResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Events service
 
 type EventsHandler interface {
@@ -153,6 +164,7 @@ type EventsHandler interface {
 	CreateDefaultEventSubscription(context.Context, *DefaultEventSubRequest, *DefaultEventSubResponse) error
 	GetEventSubscriptionsCollection(context.Context, *EventRequest, *EventSubResponse) error
 	SubsribeEMB(context.Context, *SubscribeEMBRequest, *SubscribeEMBResponse) error
+	ReplayEvents(context.Context, *EventSubRequest, *EventSubResponse) error
 }
 
 func RegisterEventsHandler(s server.Server, hdlr EventsHandler, opts ...server.HandlerOption) error {
@@ -165,6 +177,7 @@ func RegisterEventsHandler(s server.Server, hdlr EventsHandler, opts ...server.H
 		CreateDefaultEventSubscription(ctx context.Context, in *DefaultEventSubRequest, out *DefaultEventSubResponse) error
 		GetEventSubscriptionsCollection(ctx context.Context, in *EventRequest, out *EventSubResponse) error
 		SubsribeEMB(ctx context.Context, in *SubscribeEMBRequest, out *SubscribeEMBResponse) error
+		ReplayEvents(ctx context.Context, in *EventSubRequest, out *EventSubResponse) error
 	}
 	type Events struct {
 		events
@@ -208,3 +221,7 @@ func (h *eventsHandler) GetEventSubscriptionsCollection(ctx context.Context, in
 func (h *eventsHandler) SubsribeEMB(ctx context.Context, in *SubscribeEMBRequest, out *SubscribeEMBResponse) error {
 	return h.EventsHandler.SubsribeEMB(ctx, in, out)
 }
+
+func (h *eventsHandler) ReplayEvents(ctx context.Context, in *EventSubRequest, out *EventSubResponse) error {
+	return h.EventsHandler.ReplayEvents(ctx, in, out)
+}
@@ -142,6 +142,7 @@ type EventRequest struct {
 	SessionToken         string   `protobuf:"bytes,1,opt,name=SessionToken,proto3" json:"SessionToken,omitempty"`
 	EventSubscriptionID  string   `protobuf:"bytes,2,opt,name=EventSubscriptionID,proto3" json:"EventSubscriptionID,omitempty"`
 	UUID                 string   `protobuf:"bytes,3,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	URL                  string   `protobuf:"bytes,4,opt,name=URL,proto3" json:"URL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -193,6 +194,13 @@ func (m *EventRequest) GetUUID() string {
 	return ""
 }
 
+func (m *EventRequest) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
 type DefaultEventSubRequest struct {
 	SystemID             []string `protobuf:"bytes,1,rep,name=SystemID,proto3" json:"SystemID,omitempty"`
 	EventTypes           []string `protobuf:"bytes,2,rep,name=EventTypes,proto3" json:"EventTypes,omitempty"`
@@ -411,42 +419,43 @@ func init() {
 func init() { proto.RegisterFile("events.proto", fileDescriptor_8f22242cb04491f9) }
 
 var fileDescriptor_8f22242cb04491f9 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x6d, 0x8f, 0xd2, 0x40,
-	0x10, 0xa6, 0xbc, 0xc9, 0x0d, 0x5c, 0x0e, 0xf7, 0xf0, 0x68, 0x9a, 0x78, 0x92, 0xc6, 0x0f, 0x7c,
-	0x6a, 0xcc, 0x5d, 0x4c, 0xce, 0x8b, 0x26, 0x06, 0x68, 0x94, 0x44, 0xcc, 0x5d, 0x81, 0x1f, 0x50,
-	0xca, 0x78, 0x36, 0x94, 0x2e, 0x76, 0xb7, 0x44, 0x7e, 0x88, 0x7f, 0xc5, 0x1f, 0xe7, 0x27, 0xb3,
-	0xdb, 0x2d, 0x14, 0xa8, 0x09, 0x7e, 0xeb, 0x33, 0xb3, 0xf3, 0xcc, 0x33, 0x33, 0x4f, 0x0a, 0x0d,
-	0x5c, 0x63, 0xc8, 0x99, 0xb5, 0x8a, 0x28, 0xa7, 0xe6, 0x23, 0x5c, 0xd8, 0x02, 0x8f, 0xe3, 0x99,
-	0x83, 0x3f, 0x62, 0x64, 0x9c, 0x98, 0xd0, 0x18, 0x23, 0x63, 0x3e, 0x0d, 0x27, 0x74, 0x81, 0xa1,
-	0xae, 0x75, 0xb4, 0xee, 0x99, 0xb3, 0x17, 0x23, 0x06, 0xd4, 0x1e, 0x28, 0xe3, 0x3d, 0x3a, 0xdf,
-	0xe8, 0xc5, 0x8e, 0xd6, 0x6d, 0x38, 0x5b, 0x6c, 0xfe, 0xd1, 0xa0, 0xb9, 0xe3, 0x64, 0x2b, 0x1a,
-	0x32, 0x24, 0xd7, 0x00, 0x8c, 0xbb, 0x3c, 0x66, 0x7d, 0x3a, 0x47, 0x49, 0x59, 0x71, 0x32, 0x11,
-	0xf2, 0x1a, 0xce, 0x13, 0x34, 0x42, 0xc6, 0xdc, 0x27, 0x94, 0xac, 0x67, 0xce, 0x7e, 0x50, 0xb4,
-	0x0d, 0xa8, 0xe7, 0x72, 0x9f, 0x86, 0x7a, 0x49, 0x3e, 0xd8, 0x62, 0x42, 0xa0, 0x3c, 0x13, 0x72,
-	0xca, 0x52, 0x8e, 0xfc, 0x26, 0x6f, 0xa1, 0xfa, 0x1d, 0xdd, 0x39, 0x46, 0x7a, 0xa5, 0x53, 0xea,
-	0xd6, 0x6f, 0x5e, 0x5a, 0x87, 0xc2, 0xac, 0xcf, 0x32, 0x6f, 0x87, 0x3c, 0xda, 0x38, 0xea, 0xb1,
-	0xf1, 0x0e, 0xea, 0x99, 0x30, 0x69, 0x42, 0x69, 0x81, 0x1b, 0xb5, 0x07, 0xf1, 0x49, 0x5a, 0x50,
-	0x59, 0xbb, 0x41, 0x9c, 0xaa, 0x4c, 0xc0, 0x7d, 0xf1, 0x4e, 0x33, 0x7f, 0x42, 0x43, 0xb6, 0xf8,
-	0x9f, 0x65, 0xbe, 0x81, 0xcb, 0x54, 0x16, 0xf3, 0x22, 0x7f, 0x25, 0xc6, 0x19, 0x0e, 0x14, 0x77,
-	0x5e, 0x4a, 0xcc, 0x3a, 0x9d, 0x0e, 0x07, 0x6a, 0x07, 0xf2, 0xdb, 0xfc, 0xad, 0xc1, 0xd5, 0x00,
-	0xbf, 0xb9, 0x71, 0xc0, 0x0f, 0x2f, 0x6a, 0x40, 0x6d, 0xbc, 0x61, 0x1c, 0x97, 0xc3, 0x81, 0xae,
-	0x75, 0x4a, 0x62, 0x6d, 0x29, 0x16, 0x87, 0x91, 0xcf, 0x27, 0x9b, 0x15, 0x32, 0xbd, 0x28, 0xb3,
-	0x99, 0x88, 0xc8, 0xab, 0xed, 0x0f, 0x07, 0x4c, 0x2f, 0x25, 0xf9, 0x5d, 0x44, 0x1c, 0xce, 0x41,
-	0x46, 0xe3, 0xc8, 0xc3, 0x84, 0xa2, 0x2c, 0x9f, 0xec, 0x07, 0xa5, 0x5f, 0x84, 0xdf, 0x3c, 0x1a,
-	0xe8, 0x95, 0xe4, 0x70, 0x29, 0x36, 0x6f, 0xa1, 0x7d, 0xa4, 0x5b, 0xb9, 0x46, 0x87, 0x67, 0x13,
-	0x97, 0x2d, 0xa6, 0xce, 0x17, 0xb5, 0xb8, 0x14, 0x9a, 0x14, 0x2e, 0xd5, 0x4e, 0x66, 0x68, 0x8f,
-	0x7a, 0x99, 0x49, 0x1f, 0x82, 0xf8, 0xc9, 0x0f, 0xe5, 0xa4, 0x49, 0x1f, 0x85, 0x05, 0x99, 0x3d,
-	0xea, 0x09, 0x3d, 0x6a, 0xb5, 0x29, 0x14, 0x47, 0xb2, 0x47, 0xbd, 0xc7, 0x18, 0x63, 0xfc, 0xea,
-	0x2e, 0x51, 0x4d, 0xb9, 0x17, 0x33, 0x2d, 0x68, 0xed, 0x37, 0x54, 0x12, 0xaf, 0xa0, 0x3a, 0x96,
-	0x1e, 0x95, 0xfd, 0x6a, 0x8e, 0x42, 0x37, 0xbf, 0xca, 0x50, 0x95, 0xf3, 0x30, 0x72, 0x07, 0x17,
-	0x9f, 0x50, 0x0d, 0x87, 0xd1, 0xda, 0xf7, 0x90, 0x34, 0xad, 0x83, 0x1b, 0x19, 0xcf, 0x8f, 0xac,
-	0x69, 0x16, 0x44, 0xe5, 0x38, 0x9e, 0x2d, 0x7d, 0x3e, 0x41, 0x96, 0x10, 0x9c, 0x5a, 0xf9, 0x11,
-	0xda, 0xfd, 0x08, 0x5d, 0x8e, 0x47, 0xf6, 0x39, 0x95, 0xe1, 0x1e, 0x5a, 0x5b, 0xd5, 0xd9, 0xf2,
-	0x73, 0x2b, 0x6b, 0xf0, 0xfc, 0xda, 0x0f, 0xe2, 0xa4, 0x01, 0xe6, 0x75, 0x3f, 0xa5, 0x7c, 0x0a,
-	0xd7, 0x89, 0xf8, 0x03, 0x5f, 0xec, 0x58, 0xda, 0x56, 0xbe, 0xd5, 0x0d, 0xdd, 0xfa, 0x87, 0x97,
-	0xcc, 0x02, 0xb1, 0xe1, 0x55, 0xde, 0x44, 0xac, 0x4f, 0x83, 0x00, 0xbd, 0x93, 0xd5, 0xbd, 0x87,
-	0xba, 0x28, 0x57, 0x46, 0x20, 0x2d, 0x2b, 0xc7, 0x88, 0xc6, 0x0b, 0x2b, 0xcf, 0x2d, 0x66, 0x61,
-	0x56, 0x95, 0xff, 0xdd, 0xdb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x33, 0x1c, 0x3c, 0x08, 0x87,
-	0x05, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x5d, 0x6e, 0xd3, 0x40,
+	0x10, 0xae, 0x9b, 0x1f, 0xda, 0x69, 0xaa, 0x96, 0x6d, 0x68, 0x2c, 0x4b, 0x94, 0xc8, 0xe2, 0x21,
+	0x4f, 0x16, 0x6a, 0x55, 0xa9, 0x54, 0x20, 0xa1, 0xc4, 0x16, 0x44, 0x22, 0xa8, 0x75, 0x92, 0x03,
+	0x38, 0xce, 0x50, 0xac, 0x38, 0xde, 0xe0, 0x5d, 0x47, 0xf2, 0x15, 0x38, 0x10, 0xa7, 0xe0, 0x44,
+	0x3c, 0xa1, 0x5d, 0xaf, 0x13, 0x27, 0x31, 0x52, 0xe0, 0x6d, 0xbf, 0x99, 0x9d, 0xd9, 0x6f, 0xe6,
+	0xfb, 0xb4, 0xd0, 0xc0, 0x25, 0x46, 0x9c, 0x59, 0x8b, 0x98, 0x72, 0x6a, 0x3e, 0xc2, 0x99, 0x23,
+	0xf0, 0x30, 0x99, 0xb8, 0xf8, 0x3d, 0x41, 0xc6, 0x89, 0x09, 0x8d, 0x21, 0x32, 0x16, 0xd0, 0x68,
+	0x44, 0x67, 0x18, 0xe9, 0x5a, 0x5b, 0xeb, 0x1c, 0xbb, 0x1b, 0x31, 0x62, 0xc0, 0xd1, 0x03, 0x65,
+	0xbc, 0x4b, 0xa7, 0xa9, 0x7e, 0xd8, 0xd6, 0x3a, 0x0d, 0x77, 0x85, 0xcd, 0xdf, 0x1a, 0x9c, 0xaf,
+	0x7b, 0xb2, 0x05, 0x8d, 0x18, 0x92, 0x2b, 0x00, 0xc6, 0x3d, 0x9e, 0xb0, 0x1e, 0x9d, 0xa2, 0x6c,
+	0x59, 0x73, 0x0b, 0x11, 0xf2, 0x1a, 0x4e, 0x33, 0x34, 0x40, 0xc6, 0xbc, 0x27, 0x94, 0x5d, 0x8f,
+	0xdd, 0xcd, 0xa0, 0x78, 0x36, 0xa4, 0xbe, 0xc7, 0x03, 0x1a, 0xe9, 0x15, 0x79, 0x61, 0x85, 0x09,
+	0x81, 0xea, 0x44, 0xd0, 0xa9, 0x4a, 0x3a, 0xf2, 0x4c, 0x6e, 0xa1, 0xfe, 0x0d, 0xbd, 0x29, 0xc6,
+	0x7a, 0xad, 0x5d, 0xe9, 0x9c, 0x5c, 0xbf, 0xb4, 0xb6, 0x89, 0x59, 0x9f, 0x64, 0xde, 0x89, 0x78,
+	0x9c, 0xba, 0xea, 0xb2, 0xf1, 0x16, 0x4e, 0x0a, 0x61, 0x72, 0x0e, 0x95, 0x19, 0xa6, 0x6a, 0x0f,
+	0xe2, 0x48, 0x9a, 0x50, 0x5b, 0x7a, 0x61, 0x92, 0xb3, 0xcc, 0xc0, 0xfd, 0xe1, 0x9d, 0x66, 0xfe,
+	0xd0, 0xa0, 0x21, 0xdf, 0xf8, 0x97, 0x6d, 0xbe, 0x81, 0x8b, 0x9c, 0x17, 0xf3, 0xe3, 0x60, 0x21,
+	0xe6, 0xe9, 0xdb, 0xaa, 0x79, 0x59, 0x4a, 0x0c, 0x3b, 0x1e, 0xf7, 0x6d, 0xb5, 0x04, 0x79, 0x16,
+	0x34, 0xc7, 0xee, 0x67, 0x39, 0xff, 0xb1, 0x2b, 0x8e, 0xe6, 0x4f, 0x0d, 0x2e, 0x6d, 0xfc, 0xea,
+	0x25, 0x21, 0xdf, 0x16, 0xd9, 0x80, 0xa3, 0x61, 0xca, 0x38, 0xce, 0xfb, 0xb6, 0xae, 0xb5, 0x2b,
+	0x62, 0x93, 0x39, 0x16, 0x5a, 0xc9, 0xeb, 0xa3, 0x74, 0x81, 0x4c, 0x3f, 0x94, 0xd9, 0x42, 0x44,
+	0xe4, 0x95, 0x20, 0x7d, 0x9b, 0xe9, 0x95, 0x2c, 0xbf, 0x8e, 0x08, 0x2d, 0x5d, 0x64, 0x34, 0x89,
+	0x7d, 0xcc, 0x5a, 0x54, 0xe5, 0x95, 0xcd, 0xa0, 0xb4, 0x90, 0xb0, 0xa0, 0x4f, 0x43, 0xbd, 0x96,
+	0x69, 0x99, 0x63, 0xf3, 0x06, 0x5a, 0x3b, 0xbc, 0x95, 0x91, 0x74, 0x78, 0x36, 0xf2, 0xd8, 0x4c,
+	0x4c, 0x9a, 0xad, 0x32, 0x87, 0x26, 0x85, 0x0b, 0xb5, 0xa5, 0x09, 0x3a, 0x83, 0x6e, 0x61, 0xd2,
+	0x87, 0x30, 0x79, 0x0a, 0xa2, 0xbe, 0xad, 0x2a, 0x56, 0x58, 0x34, 0x73, 0x06, 0x5d, 0xc1, 0x47,
+	0x2d, 0x3b, 0x87, 0x42, 0x36, 0x67, 0xd0, 0x7d, 0x4c, 0x30, 0xc1, 0x2f, 0xde, 0x1c, 0xd5, 0x94,
+	0x1b, 0x31, 0xd3, 0x82, 0xe6, 0xe6, 0x83, 0x8a, 0xe2, 0x25, 0xd4, 0x87, 0xd2, 0xb6, 0xf2, 0xbd,
+	0x23, 0x57, 0xa1, 0xeb, 0x5f, 0x55, 0xa8, 0xcb, 0x79, 0x18, 0xb9, 0x83, 0xb3, 0x8f, 0xa8, 0x86,
+	0xc3, 0x78, 0x19, 0xf8, 0x48, 0xce, 0xad, 0x2d, 0x8d, 0x8c, 0xe7, 0x3b, 0x6e, 0x35, 0x0f, 0x44,
+	0xe5, 0x30, 0x99, 0xcc, 0x03, 0x3e, 0x42, 0x96, 0x35, 0xd8, 0xb7, 0xf2, 0x03, 0xb4, 0x7a, 0x31,
+	0x7a, 0x1c, 0x77, 0x0c, 0xb5, 0x6f, 0x87, 0x7b, 0x68, 0xae, 0x58, 0x17, 0xcb, 0x4f, 0xad, 0xa2,
+	0xe5, 0xcb, 0x6b, 0xdf, 0x0b, 0x49, 0x43, 0xe4, 0xf8, 0x7f, 0xe5, 0x63, 0xb8, 0xca, 0xc8, 0x6f,
+	0xf9, 0x62, 0xdd, 0xa5, 0x65, 0x95, 0x5b, 0xdd, 0xd0, 0xad, 0xbf, 0x78, 0xc9, 0x3c, 0x20, 0x0e,
+	0xbc, 0x2a, 0x9b, 0x88, 0xf5, 0x68, 0x18, 0xa2, 0xbf, 0x37, 0xbb, 0x77, 0x70, 0x22, 0xca, 0x95,
+	0x11, 0x48, 0xd3, 0x2a, 0x31, 0xa2, 0xf1, 0xc2, 0x2a, 0x73, 0x8b, 0x79, 0x40, 0x6e, 0xa1, 0xe1,
+	0xe2, 0x22, 0xf4, 0x52, 0x65, 0x8e, 0xfd, 0xd4, 0x98, 0xd4, 0xe5, 0x0f, 0x7e, 0xf3, 0x67, 0x00,
+	0x62, 0x56, 0x6f, 0xd9, 0xd1, 0x05, 0x00, 0x00,
 }
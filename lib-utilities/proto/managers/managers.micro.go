@@ -37,6 +37,18 @@ type ManagersService interface {
 	GetManagersCollection(ctx context.Context, in *ManagerRequest, opts ...client.CallOption) (*ManagerResponse, error)
 	GetManager(ctx context.Context, in *ManagerRequest, opts ...client.CallOption) (*ManagerResponse, error)
 	GetManagersResource(ctx context.Context, in *ManagerRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	ResetManager(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	ResetManagerToDefaults(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	InsertVirtualMedia(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	EjectVirtualMedia(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	UpdateVirtualMedia(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	CreateConsoleSession(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	ConnectConsoleSession(ctx context.Context, in *ConsoleSessionRequest, opts ...client.CallOption) (*ConsoleSessionResponse, error)
+	GetCertificateService(ctx context.Context, in *ManagerRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	GetCertificateLocations(ctx context.Context, in *ManagerRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	GenerateCSR(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	ReplaceCertificate(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error)
+	GetEndpointCertificates(ctx context.Context, in *ManagerRequest, opts ...client.CallOption) (*ManagerResponse, error)
 }
 
 type managersService struct {
@@ -87,12 +99,144 @@ func (c *managersService) GetManagersResource(ctx context.Context, in *ManagerRe
 	return out, nil
 }
 
+func (c *managersService) ResetManager(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.ResetManager", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) ResetManagerToDefaults(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.ResetManagerToDefaults", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) InsertVirtualMedia(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.InsertVirtualMedia", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) EjectVirtualMedia(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.EjectVirtualMedia", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) UpdateVirtualMedia(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.UpdateVirtualMedia", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) CreateConsoleSession(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.CreateConsoleSession", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) ConnectConsoleSession(ctx context.Context, in *ConsoleSessionRequest, opts ...client.CallOption) (*ConsoleSessionResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.ConnectConsoleSession", in)
+	out := new(ConsoleSessionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) GetCertificateService(ctx context.Context, in *ManagerRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.GetCertificateService", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) GetCertificateLocations(ctx context.Context, in *ManagerRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.GetCertificateLocations", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) GenerateCSR(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.GenerateCSR", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) ReplaceCertificate(ctx context.Context, in *ManagerActionRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.ReplaceCertificate", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managersService) GetEndpointCertificates(ctx context.Context, in *ManagerRequest, opts ...client.CallOption) (*ManagerResponse, error) {
+	req := c.c.NewRequest(c.name, "Managers.GetEndpointCertificates", in)
+	out := new(ManagerResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Managers service
 
 type ManagersHandler interface {
 	GetManagersCollection(context.Context, *ManagerRequest, *ManagerResponse) error
 	GetManager(context.Context, *ManagerRequest, *ManagerResponse) error
 	GetManagersResource(context.Context, *ManagerRequest, *ManagerResponse) error
+	ResetManager(context.Context, *ManagerActionRequest, *ManagerResponse) error
+	ResetManagerToDefaults(context.Context, *ManagerActionRequest, *ManagerResponse) error
+	InsertVirtualMedia(context.Context, *ManagerActionRequest, *ManagerResponse) error
+	EjectVirtualMedia(context.Context, *ManagerActionRequest, *ManagerResponse) error
+	UpdateVirtualMedia(context.Context, *ManagerActionRequest, *ManagerResponse) error
+	CreateConsoleSession(context.Context, *ManagerActionRequest, *ManagerResponse) error
+	ConnectConsoleSession(context.Context, *ConsoleSessionRequest, *ConsoleSessionResponse) error
+	GetCertificateService(context.Context, *ManagerRequest, *ManagerResponse) error
+	GetCertificateLocations(context.Context, *ManagerRequest, *ManagerResponse) error
+	GenerateCSR(context.Context, *ManagerActionRequest, *ManagerResponse) error
+	ReplaceCertificate(context.Context, *ManagerActionRequest, *ManagerResponse) error
+	GetEndpointCertificates(context.Context, *ManagerRequest, *ManagerResponse) error
 }
 
 func RegisterManagersHandler(s server.Server, hdlr ManagersHandler, opts ...server.HandlerOption) error {
@@ -100,6 +244,18 @@ func RegisterManagersHandler(s server.Server, hdlr ManagersHandler, opts ...serv
 		GetManagersCollection(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error
 		GetManager(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error
 		GetManagersResource(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error
+		ResetManager(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error
+		ResetManagerToDefaults(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error
+		InsertVirtualMedia(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error
+		EjectVirtualMedia(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error
+		UpdateVirtualMedia(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error
+		CreateConsoleSession(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error
+		ConnectConsoleSession(ctx context.Context, in *ConsoleSessionRequest, out *ConsoleSessionResponse) error
+		GetCertificateService(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error
+		GetCertificateLocations(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error
+		GenerateCSR(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error
+		ReplaceCertificate(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error
+		GetEndpointCertificates(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error
 	}
 	type Managers struct {
 		managers
@@ -123,3 +279,51 @@ func (h *managersHandler) GetManager(ctx context.Context, in *ManagerRequest, ou
 func (h *managersHandler) GetManagersResource(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error {
 	return h.ManagersHandler.GetManagersResource(ctx, in, out)
 }
+
+func (h *managersHandler) ResetManager(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.ResetManager(ctx, in, out)
+}
+
+func (h *managersHandler) ResetManagerToDefaults(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.ResetManagerToDefaults(ctx, in, out)
+}
+
+func (h *managersHandler) InsertVirtualMedia(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.InsertVirtualMedia(ctx, in, out)
+}
+
+func (h *managersHandler) EjectVirtualMedia(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.EjectVirtualMedia(ctx, in, out)
+}
+
+func (h *managersHandler) UpdateVirtualMedia(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.UpdateVirtualMedia(ctx, in, out)
+}
+
+func (h *managersHandler) CreateConsoleSession(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.CreateConsoleSession(ctx, in, out)
+}
+
+func (h *managersHandler) ConnectConsoleSession(ctx context.Context, in *ConsoleSessionRequest, out *ConsoleSessionResponse) error {
+	return h.ManagersHandler.ConnectConsoleSession(ctx, in, out)
+}
+
+func (h *managersHandler) GetCertificateService(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.GetCertificateService(ctx, in, out)
+}
+
+func (h *managersHandler) GetCertificateLocations(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.GetCertificateLocations(ctx, in, out)
+}
+
+func (h *managersHandler) GenerateCSR(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.GenerateCSR(ctx, in, out)
+}
+
+func (h *managersHandler) ReplaceCertificate(ctx context.Context, in *ManagerActionRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.ReplaceCertificate(ctx, in, out)
+}
+
+func (h *managersHandler) GetEndpointCertificates(ctx context.Context, in *ManagerRequest, out *ManagerResponse) error {
+	return h.ManagersHandler.GetEndpointCertificates(ctx, in, out)
+}
@@ -83,6 +83,211 @@ func (m *ManagerRequest) GetResourceID() string {
 	return ""
 }
 
+type ManagerActionRequest struct {
+	SessionToken         string   `protobuf:"bytes,1,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	ManagerID            string   `protobuf:"bytes,2,opt,name=managerID,proto3" json:"managerID,omitempty"`
+	URL                  string   `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	ResourceID           string   `protobuf:"bytes,4,opt,name=resourceID,proto3" json:"resourceID,omitempty"`
+	RequestBody          []byte   `protobuf:"bytes,5,opt,name=RequestBody,proto3" json:"RequestBody,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManagerActionRequest) Reset()         { *m = ManagerActionRequest{} }
+func (m *ManagerActionRequest) String() string { return proto.CompactTextString(m) }
+func (*ManagerActionRequest) ProtoMessage()    {}
+func (*ManagerActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49f5910ae72958ed, []int{1}
+}
+
+func (m *ManagerActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManagerActionRequest.Unmarshal(m, b)
+}
+func (m *ManagerActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManagerActionRequest.Marshal(b, m, deterministic)
+}
+func (m *ManagerActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagerActionRequest.Merge(m, src)
+}
+func (m *ManagerActionRequest) XXX_Size() int {
+	return xxx_messageInfo_ManagerActionRequest.Size(m)
+}
+func (m *ManagerActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagerActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagerActionRequest proto.InternalMessageInfo
+
+func (m *ManagerActionRequest) GetSessionToken() string {
+	if m != nil {
+		return m.SessionToken
+	}
+	return ""
+}
+
+func (m *ManagerActionRequest) GetManagerID() string {
+	if m != nil {
+		return m.ManagerID
+	}
+	return ""
+}
+
+func (m *ManagerActionRequest) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *ManagerActionRequest) GetResourceID() string {
+	if m != nil {
+		return m.ResourceID
+	}
+	return ""
+}
+
+func (m *ManagerActionRequest) GetRequestBody() []byte {
+	if m != nil {
+		return m.RequestBody
+	}
+	return nil
+}
+
+type ConsoleSessionRequest struct {
+	ManagerID            string   `protobuf:"bytes,1,opt,name=managerID,proto3" json:"managerID,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsoleSessionRequest) Reset()         { *m = ConsoleSessionRequest{} }
+func (m *ConsoleSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsoleSessionRequest) ProtoMessage()    {}
+func (*ConsoleSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49f5910ae72958ed, []int{2}
+}
+
+func (m *ConsoleSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsoleSessionRequest.Unmarshal(m, b)
+}
+func (m *ConsoleSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsoleSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *ConsoleSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsoleSessionRequest.Merge(m, src)
+}
+func (m *ConsoleSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_ConsoleSessionRequest.Size(m)
+}
+func (m *ConsoleSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsoleSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsoleSessionRequest proto.InternalMessageInfo
+
+func (m *ConsoleSessionRequest) GetManagerID() string {
+	if m != nil {
+		return m.ManagerID
+	}
+	return ""
+}
+
+func (m *ConsoleSessionRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type ConsoleSessionResponse struct {
+	StatusCode           int32    `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	StatusMessage        string   `protobuf:"bytes,2,opt,name=statusMessage,proto3" json:"statusMessage,omitempty"`
+	Body                 []byte   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	ConsoleURL           string   `protobuf:"bytes,4,opt,name=consoleURL,proto3" json:"consoleURL,omitempty"`
+	UserName             string   `protobuf:"bytes,5,opt,name=userName,proto3" json:"userName,omitempty"`
+	ConsoleType          string   `protobuf:"bytes,6,opt,name=consoleType,proto3" json:"consoleType,omitempty"`
+	SessionToken         string   `protobuf:"bytes,7,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsoleSessionResponse) Reset()         { *m = ConsoleSessionResponse{} }
+func (m *ConsoleSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsoleSessionResponse) ProtoMessage()    {}
+func (*ConsoleSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49f5910ae72958ed, []int{3}
+}
+
+func (m *ConsoleSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsoleSessionResponse.Unmarshal(m, b)
+}
+func (m *ConsoleSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsoleSessionResponse.Marshal(b, m, deterministic)
+}
+func (m *ConsoleSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsoleSessionResponse.Merge(m, src)
+}
+func (m *ConsoleSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_ConsoleSessionResponse.Size(m)
+}
+func (m *ConsoleSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsoleSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsoleSessionResponse proto.InternalMessageInfo
+
+func (m *ConsoleSessionResponse) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *ConsoleSessionResponse) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
+	}
+	return ""
+}
+
+func (m *ConsoleSessionResponse) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *ConsoleSessionResponse) GetConsoleURL() string {
+	if m != nil {
+		return m.ConsoleURL
+	}
+	return ""
+}
+
+func (m *ConsoleSessionResponse) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *ConsoleSessionResponse) GetConsoleType() string {
+	if m != nil {
+		return m.ConsoleType
+	}
+	return ""
+}
+
+func (m *ConsoleSessionResponse) GetSessionToken() string {
+	if m != nil {
+		return m.SessionToken
+	}
+	return ""
+}
+
 type ManagerResponse struct {
 	StatusCode           int32             `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
 	StatusMessage        string            `protobuf:"bytes,2,opt,name=statusMessage,proto3" json:"statusMessage,omitempty"`
@@ -97,7 +302,7 @@ func (m *ManagerResponse) Reset()         { *m = ManagerResponse{} }
 func (m *ManagerResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagerResponse) ProtoMessage()    {}
 func (*ManagerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49f5910ae72958ed, []int{1}
+	return fileDescriptor_49f5910ae72958ed, []int{4}
 }
 
 func (m *ManagerResponse) XXX_Unmarshal(b []byte) error {
@@ -148,6 +353,9 @@ func (m *ManagerResponse) GetHeader() map[string]string {
 
 func init() {
 	proto.RegisterType((*ManagerRequest)(nil), "ManagerRequest")
+	proto.RegisterType((*ManagerActionRequest)(nil), "ManagerActionRequest")
+	proto.RegisterType((*ConsoleSessionRequest)(nil), "ConsoleSessionRequest")
+	proto.RegisterType((*ConsoleSessionResponse)(nil), "ConsoleSessionResponse")
 	proto.RegisterType((*ManagerResponse)(nil), "ManagerResponse")
 	proto.RegisterMapType((map[string]string)(nil), "ManagerResponse.HeaderEntry")
 }
@@ -155,25 +363,42 @@ func init() {
 func init() { proto.RegisterFile("managers.proto", fileDescriptor_49f5910ae72958ed) }
 
 var fileDescriptor_49f5910ae72958ed = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4f, 0xf2, 0x40,
-	0x10, 0xc6, 0xdf, 0xf2, 0x2f, 0x2f, 0x03, 0x02, 0x19, 0x35, 0x69, 0x08, 0x31, 0xa4, 0xf1, 0xc0,
-	0xa9, 0x89, 0xe8, 0x41, 0x89, 0x37, 0x30, 0x4a, 0x22, 0x97, 0x8d, 0x7e, 0x80, 0x05, 0x26, 0x48,
-	0xc0, 0x5d, 0xdc, 0xd9, 0x9a, 0x70, 0xf7, 0x3b, 0xf9, 0x65, 0xfc, 0x30, 0xa6, 0xcb, 0x22, 0x85,
-	0x13, 0xb7, 0x99, 0xdf, 0xcc, 0xd3, 0xe7, 0xe9, 0xb4, 0x50, 0x7b, 0x97, 0x4a, 0xce, 0xc8, 0x70,
-	0xbc, 0x32, 0xda, 0xea, 0xe8, 0x2b, 0x80, 0xda, 0x68, 0x83, 0x04, 0x7d, 0x24, 0xc4, 0x16, 0x23,
-	0xa8, 0x32, 0x31, 0xcf, 0xb5, 0x7a, 0xd1, 0x0b, 0x52, 0x61, 0xd0, 0x0e, 0x3a, 0x65, 0xb1, 0xc7,
-	0xb0, 0x05, 0x65, 0xff, 0xa0, 0xe1, 0x20, 0xcc, 0xb9, 0x85, 0x1d, 0xc0, 0x06, 0xe4, 0x5f, 0xc5,
-	0x73, 0x98, 0x77, 0x3c, 0x2d, 0xf1, 0x02, 0xc0, 0x10, 0xeb, 0xc4, 0x4c, 0x68, 0x38, 0x08, 0x0b,
-	0x6e, 0x90, 0x21, 0xd1, 0x4f, 0x00, 0xf5, 0xbf, 0x18, 0xbc, 0xd2, 0x8a, 0x29, 0xd5, 0xb0, 0x95,
-	0x36, 0xe1, 0xbe, 0x9e, 0x92, 0x4b, 0x51, 0x14, 0x19, 0x82, 0x97, 0x70, 0xb2, 0xe9, 0x46, 0xc4,
-	0x2c, 0x67, 0xe4, 0x73, 0xec, 0x43, 0x44, 0x28, 0x8c, 0xf5, 0x74, 0xed, 0x3c, 0xab, 0xc2, 0xd5,
-	0x78, 0x03, 0xa5, 0x37, 0x92, 0x53, 0x32, 0x61, 0xb1, 0x9d, 0xef, 0x54, 0xba, 0xad, 0xf8, 0xc0,
-	0x3b, 0x7e, 0x72, 0xe3, 0x07, 0x65, 0xcd, 0x5a, 0xf8, 0xdd, 0xe6, 0x1d, 0x54, 0x32, 0x38, 0x7d,
-	0xc9, 0x05, 0xad, 0xfd, 0x75, 0xd2, 0x12, 0xcf, 0xa0, 0xf8, 0x29, 0x97, 0xc9, 0x36, 0xc8, 0xa6,
-	0xe9, 0xe5, 0x6e, 0x83, 0xee, 0x77, 0x00, 0xff, 0xbd, 0x05, 0xe3, 0x3d, 0x9c, 0x3f, 0x92, 0xdd,
-	0xb6, 0x7d, 0xbd, 0x5c, 0xd2, 0xc4, 0xce, 0xb5, 0xc2, 0x7a, 0xbc, 0xff, 0x25, 0x9a, 0x8d, 0xc3,
-	0x5c, 0xd1, 0x3f, 0xbc, 0x02, 0xd8, 0xa9, 0x8f, 0x93, 0xf4, 0xe0, 0x34, 0x63, 0x28, 0xfc, 0xd5,
-	0x8f, 0xd2, 0x8e, 0x4b, 0xee, 0x37, 0xb9, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xe1, 0x96,
-	0xea, 0x38, 0x02, 0x00, 0x00,
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xdd, 0x4e, 0xdb, 0x30,
+	0x14, 0x26, 0x94, 0xf2, 0x73, 0xca, 0x80, 0x79, 0xb4, 0x44, 0x15, 0x9a, 0xaa, 0x68, 0x17, 0xbd,
+	0x8a, 0x34, 0xb6, 0x0b, 0x40, 0x13, 0x88, 0xa5, 0x15, 0xeb, 0x46, 0x77, 0x91, 0x96, 0xdd, 0x9b,
+	0xe4, 0xc0, 0x32, 0x82, 0x9d, 0xd9, 0x0e, 0x52, 0xef, 0xf7, 0x38, 0x7b, 0x89, 0xbd, 0xc7, 0x1e,
+	0x63, 0x0f, 0x30, 0xd9, 0x49, 0x69, 0x52, 0x7a, 0xd1, 0x22, 0x4d, 0xbb, 0xf3, 0xf9, 0xec, 0xf3,
+	0x9d, 0xef, 0xfc, 0xd8, 0x86, 0xad, 0x3b, 0xca, 0xe8, 0x0d, 0x0a, 0xe9, 0x26, 0x82, 0x2b, 0xee,
+	0xfc, 0xb0, 0x60, 0xab, 0x9f, 0x41, 0x3e, 0x7e, 0x4f, 0x51, 0x2a, 0xe2, 0xc0, 0xa6, 0x44, 0x29,
+	0x23, 0xce, 0x86, 0xfc, 0x16, 0x99, 0x6d, 0xb5, 0xac, 0xf6, 0x86, 0x5f, 0xc2, 0xc8, 0x3e, 0x6c,
+	0xe4, 0x44, 0xbd, 0x8e, 0xbd, 0x6c, 0x0e, 0x4c, 0x00, 0xb2, 0x03, 0x95, 0x4b, 0xff, 0xc2, 0xae,
+	0x18, 0x5c, 0x2f, 0xc9, 0x4b, 0x00, 0x81, 0x92, 0xa7, 0x22, 0xc0, 0x5e, 0xc7, 0x5e, 0x31, 0x1b,
+	0x05, 0xc4, 0xf9, 0x69, 0xc1, 0x6e, 0x2e, 0xe3, 0x2c, 0x50, 0x11, 0x67, 0xff, 0x51, 0x0c, 0x69,
+	0x41, 0x2d, 0x0f, 0xff, 0x9e, 0x87, 0x23, 0xbb, 0xda, 0xb2, 0xda, 0x9b, 0x7e, 0x11, 0x72, 0x3e,
+	0x41, 0xdd, 0xe3, 0x4c, 0xf2, 0x18, 0x07, 0x99, 0x90, 0xb1, 0xdc, 0x92, 0x14, 0x6b, 0x5a, 0xca,
+	0x2e, 0x54, 0x95, 0xc9, 0x22, 0x13, 0x99, 0x19, 0xce, 0x1f, 0x0b, 0x1a, 0xd3, 0x6c, 0x32, 0xe1,
+	0x4c, 0xa2, 0x56, 0x2a, 0x15, 0x55, 0xa9, 0xf4, 0x78, 0x88, 0x86, 0xaf, 0xea, 0x17, 0x10, 0xf2,
+	0x0a, 0x9e, 0x65, 0x56, 0x1f, 0xa5, 0xa4, 0x37, 0x98, 0x13, 0x97, 0x41, 0x42, 0x60, 0xe5, 0x4a,
+	0x27, 0x52, 0x31, 0x89, 0x98, 0xb5, 0x66, 0x0e, 0xb2, 0x98, 0xba, 0x38, 0x79, 0x0d, 0x26, 0x08,
+	0x69, 0xc2, 0x7a, 0x2a, 0x51, 0x7c, 0xa6, 0x77, 0x68, 0x0a, 0xb0, 0xe1, 0x3f, 0xd8, 0xba, 0x3e,
+	0xf9, 0xc9, 0xe1, 0x28, 0x41, 0x7b, 0xd5, 0x6c, 0x17, 0xa1, 0x47, 0x5d, 0x5b, 0x7b, 0xdc, 0x35,
+	0xe7, 0xb7, 0x05, 0xdb, 0x0f, 0x93, 0xf7, 0x4f, 0xf2, 0x5d, 0x29, 0xe4, 0xfb, 0x16, 0x56, 0xbf,
+	0x22, 0x0d, 0x51, 0xd8, 0xd5, 0x56, 0xa5, 0x5d, 0x3b, 0xd8, 0x77, 0xa7, 0x62, 0xbb, 0x1f, 0xcc,
+	0x76, 0x97, 0x29, 0x31, 0xf2, 0xf3, 0xb3, 0xcd, 0x23, 0xa8, 0x15, 0x60, 0x3d, 0x4a, 0xb7, 0x38,
+	0xca, 0xfb, 0xaa, 0x97, 0xba, 0xa3, 0xf7, 0x34, 0x4e, 0xc7, 0x42, 0x32, 0xe3, 0x78, 0xf9, 0xd0,
+	0x3a, 0xf8, 0xb5, 0x06, 0xeb, 0x79, 0x08, 0x49, 0xde, 0x41, 0xfd, 0x1c, 0xd5, 0xd8, 0xf4, 0x78,
+	0x1c, 0xa3, 0x99, 0x72, 0xb2, 0xed, 0x96, 0x2f, 0x5f, 0x73, 0x67, 0x5a, 0x97, 0xb3, 0x44, 0x5e,
+	0x03, 0x4c, 0xbc, 0xe7, 0x73, 0x39, 0x86, 0x17, 0x85, 0x80, 0x7e, 0x3e, 0xdb, 0xf3, 0xf9, 0x1e,
+	0xc1, 0xa6, 0x8f, 0x72, 0x12, 0xb0, 0xee, 0xce, 0xba, 0x99, 0x33, 0x5d, 0x3d, 0x68, 0x14, 0x5d,
+	0x87, 0xbc, 0x83, 0xd7, 0x34, 0x8d, 0x95, 0x5c, 0x84, 0xe4, 0x14, 0x48, 0x8f, 0x49, 0x14, 0xea,
+	0x4b, 0x24, 0x54, 0x4a, 0xe3, 0x3e, 0x86, 0x11, 0x5d, 0x84, 0xe0, 0x04, 0x9e, 0x77, 0xbf, 0x61,
+	0xf0, 0x64, 0xff, 0x53, 0x20, 0x97, 0x49, 0x48, 0x15, 0x3e, 0x95, 0xe0, 0x0c, 0x76, 0x3d, 0x81,
+	0x54, 0x61, 0xf9, 0x5a, 0x2f, 0x42, 0xf1, 0xd1, 0xbc, 0x30, 0x0c, 0x03, 0x35, 0xc5, 0xd1, 0x70,
+	0x67, 0xbe, 0x3c, 0xcd, 0x3d, 0x77, 0xf6, 0x1b, 0xe2, 0x2c, 0xe5, 0xd3, 0xe7, 0xa1, 0x50, 0xd1,
+	0x75, 0x14, 0x50, 0x85, 0x03, 0x14, 0xf7, 0xd1, 0xbc, 0xe3, 0x70, 0x02, 0x7b, 0x65, 0xef, 0x0b,
+	0x1e, 0x50, 0x2d, 0x5f, 0xce, 0xe7, 0x7f, 0x08, 0xb5, 0x73, 0x64, 0x28, 0x74, 0x39, 0x06, 0xfe,
+	0x82, 0x7d, 0xf0, 0x31, 0x89, 0x69, 0x80, 0x85, 0xe8, 0x8b, 0x0d, 0x82, 0x96, 0xde, 0x65, 0x61,
+	0xc2, 0x23, 0x56, 0x4c, 0x61, 0x3e, 0xe9, 0x57, 0xab, 0xe6, 0x8f, 0x7c, 0xf3, 0x77, 0x00, 0xdf,
+	0xc6, 0xff, 0x4d, 0x35, 0x07, 0x00, 0x00,
 }
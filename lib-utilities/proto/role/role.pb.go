@@ -184,6 +184,7 @@ func (m *RoleResponse) GetBody() []byte {
 type DeleteRoleRequest struct {
 	SessionToken         string   `protobuf:"bytes,1,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
 	ID                   string   `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	IfMatch              string   `protobuf:"bytes,3,opt,name=IfMatch,proto3" json:"IfMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -228,11 +229,19 @@ func (m *DeleteRoleRequest) GetID() string {
 	return ""
 }
 
+func (m *DeleteRoleRequest) GetIfMatch() string {
+	if m != nil {
+		return m.IfMatch
+	}
+	return ""
+}
+
 // Message for Update Role request
 type UpdateRoleRequest struct {
 	SessionToken         string   `protobuf:"bytes,1,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
 	UpdateRequest        []byte   `protobuf:"bytes,3,opt,name=updateRequest,proto3" json:"updateRequest,omitempty"`
+	IfMatch              string   `protobuf:"bytes,4,opt,name=IfMatch,proto3" json:"IfMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -284,6 +293,13 @@ func (m *UpdateRoleRequest) GetUpdateRequest() []byte {
 	return nil
 }
 
+func (m *UpdateRoleRequest) GetIfMatch() string {
+	if m != nil {
+		return m.IfMatch
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RoleRequest)(nil), "RoleRequest")
 	proto.RegisterType((*GetRoleRequest)(nil), "GetRoleRequest")
@@ -296,28 +312,29 @@ func init() {
 func init() { proto.RegisterFile("role.proto", fileDescriptor_48a3ff9f7c9032f8) }
 
 var fileDescriptor_48a3ff9f7c9032f8 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x4e, 0xbb, 0x40,
-	0x10, 0xc6, 0xff, 0x40, 0xdb, 0x7f, 0x3a, 0xd0, 0x6a, 0x27, 0x1e, 0xb0, 0x07, 0x43, 0x88, 0x87,
-	0x26, 0x4d, 0xd6, 0xb4, 0x5e, 0xd4, 0x9b, 0x16, 0x53, 0x7b, 0xf0, 0xb2, 0xea, 0x03, 0xd0, 0x30,
-	0x51, 0x53, 0x64, 0x2b, 0xbb, 0x98, 0xf4, 0x45, 0x7d, 0x16, 0x8f, 0x86, 0x05, 0x2d, 0x04, 0x13,
-	0x63, 0x6f, 0xbb, 0x1f, 0x33, 0xdf, 0xc7, 0xfc, 0x32, 0x0b, 0x90, 0x8a, 0x98, 0xd8, 0x3a, 0x15,
-	0x4a, 0xf8, 0x77, 0x60, 0x73, 0x11, 0x13, 0xa7, 0xd7, 0x8c, 0xa4, 0x42, 0x1f, 0x1c, 0x49, 0x52,
-	0x3e, 0x8b, 0xe4, 0x5e, 0xac, 0x28, 0x71, 0x0d, 0xcf, 0x18, 0x75, 0x79, 0x4d, 0x43, 0x0f, 0xec,
-	0xb2, 0xfc, 0x4a, 0x44, 0x1b, 0xd7, 0xf4, 0x8c, 0x91, 0xc3, 0xab, 0x92, 0x1f, 0x40, 0x7f, 0x4e,
-	0xea, 0xaf, 0xbe, 0x7d, 0x30, 0x17, 0x91, 0xb6, 0xeb, 0x72, 0x73, 0x11, 0xf9, 0xef, 0x06, 0x38,
-	0x85, 0x87, 0x5c, 0x8b, 0x44, 0x12, 0x1e, 0x01, 0x48, 0x15, 0xaa, 0x4c, 0xce, 0x44, 0x44, 0xda,
-	0xa2, 0xcd, 0x2b, 0x0a, 0x1e, 0x43, 0xaf, 0xb8, 0xdd, 0x92, 0x94, 0xe1, 0x23, 0x95, 0x5e, 0x75,
-	0x11, 0x27, 0xd0, 0x79, 0xa2, 0x30, 0xa2, 0xd4, 0xb5, 0x3c, 0x6b, 0x64, 0x4f, 0x0f, 0x59, 0x35,
-	0x84, 0xdd, 0xe8, 0x6f, 0xd7, 0x89, 0x4a, 0x37, 0xbc, 0x2c, 0x44, 0x84, 0xd6, 0x32, 0x1f, 0xb5,
-	0xa5, 0x47, 0xd5, 0xe7, 0xe1, 0x39, 0xd8, 0x95, 0x52, 0xdc, 0x07, 0x6b, 0x45, 0x9b, 0x72, 0xae,
-	0xfc, 0x88, 0x07, 0xd0, 0x7e, 0x0b, 0xe3, 0xec, 0xeb, 0x2f, 0x8a, 0xcb, 0x85, 0x79, 0x66, 0xf8,
-	0x73, 0x18, 0x04, 0x14, 0x93, 0xa2, 0x5d, 0x08, 0x05, 0xdf, 0x84, 0x02, 0xff, 0x05, 0x06, 0x0f,
-	0xeb, 0x28, 0xdc, 0xcd, 0xa8, 0x82, 0x3a, 0x27, 0x97, 0x15, 0x46, 0x85, 0x89, 0x6b, 0xe9, 0x49,
-	0xeb, 0xe2, 0xf4, 0xc3, 0x80, 0x76, 0x9e, 0x24, 0x71, 0x0c, 0x30, 0x4b, 0xa9, 0x0c, 0x46, 0x87,
-	0x55, 0xf2, 0x87, 0xbd, 0x1a, 0x4f, 0xff, 0x1f, 0x8e, 0xe1, 0x7f, 0xb9, 0x0d, 0xb8, 0xc7, 0xea,
-	0x7b, 0xd1, 0x2c, 0x3e, 0x01, 0x7b, 0x4e, 0xea, 0x32, 0x8e, 0x8b, 0xa0, 0xdf, 0x1b, 0x26, 0x00,
-	0x5b, 0x98, 0x88, 0xac, 0x41, 0xf6, 0xc7, 0x96, 0x2d, 0x36, 0x44, 0xd6, 0x60, 0xd8, 0x68, 0x59,
-	0x76, 0xf4, 0x6b, 0x39, 0xfd, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x8f, 0x5d, 0xc2, 0x3b, 0x03,
-	0x00, 0x00,
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x4e, 0xab, 0x50,
+	0x10, 0xbe, 0x40, 0x7f, 0xd2, 0x81, 0xf6, 0xde, 0x4e, 0xee, 0x82, 0xdb, 0xc5, 0x0d, 0x21, 0x2e,
+	0x9a, 0x34, 0xc1, 0xb4, 0x6e, 0xd4, 0x9d, 0x16, 0x53, 0xbb, 0xe8, 0xe6, 0xa8, 0x0f, 0x40, 0x65,
+	0xb4, 0xa6, 0x84, 0x53, 0x39, 0x07, 0x93, 0x3e, 0x81, 0x6f, 0xe8, 0xb3, 0xb8, 0x34, 0x1c, 0xa8,
+	0x85, 0x60, 0x62, 0xd4, 0xdd, 0x99, 0x8f, 0x99, 0x6f, 0xbe, 0xf9, 0x66, 0x00, 0x48, 0x78, 0x44,
+	0xde, 0x26, 0xe1, 0x92, 0xbb, 0x57, 0x60, 0x32, 0x1e, 0x11, 0xa3, 0xc7, 0x94, 0x84, 0x44, 0x17,
+	0x2c, 0x41, 0x42, 0x3c, 0xf0, 0xf8, 0x9a, 0xaf, 0x29, 0xb6, 0x35, 0x47, 0x1b, 0x76, 0x58, 0x05,
+	0x43, 0x07, 0xcc, 0x22, 0xfd, 0x9c, 0x87, 0x5b, 0x5b, 0x77, 0xb4, 0xa1, 0xc5, 0xca, 0x90, 0xeb,
+	0x43, 0x6f, 0x46, 0xf2, 0xab, 0xbc, 0x3d, 0xd0, 0xe7, 0xa1, 0xa2, 0xeb, 0x30, 0x7d, 0x1e, 0xba,
+	0x2f, 0x1a, 0x58, 0x39, 0x87, 0xd8, 0xf0, 0x58, 0x10, 0xfe, 0x07, 0x10, 0x32, 0x90, 0xa9, 0x98,
+	0xf2, 0x90, 0x14, 0x45, 0x93, 0x95, 0x10, 0x3c, 0x80, 0x6e, 0x1e, 0x2d, 0x48, 0x88, 0xe0, 0x9e,
+	0x0a, 0xae, 0x2a, 0x88, 0x63, 0x68, 0xad, 0x28, 0x08, 0x29, 0xb1, 0x0d, 0xc7, 0x18, 0x9a, 0x93,
+	0x7f, 0x5e, 0xb9, 0x89, 0x77, 0xa9, 0xbe, 0x5d, 0xc4, 0x32, 0xd9, 0xb2, 0x22, 0x11, 0x11, 0x1a,
+	0xcb, 0x6c, 0xd4, 0x86, 0x1a, 0x55, 0xbd, 0x07, 0x27, 0x60, 0x96, 0x52, 0xf1, 0x0f, 0x18, 0x6b,
+	0xda, 0x16, 0x73, 0x65, 0x4f, 0xfc, 0x0b, 0xcd, 0xa7, 0x20, 0x4a, 0x77, 0x2a, 0xf2, 0xe0, 0x54,
+	0x3f, 0xd6, 0xdc, 0x00, 0xfa, 0x3e, 0x45, 0x24, 0xe9, 0x3b, 0x0e, 0xf9, 0xef, 0x0e, 0xf9, 0x68,
+	0x43, 0x7b, 0x7e, 0xb7, 0x08, 0xe4, 0xed, 0xca, 0x36, 0x14, 0xb8, 0x0b, 0xdd, 0x67, 0x0d, 0xfa,
+	0x37, 0x9b, 0x30, 0x90, 0xf4, 0xc3, 0x2d, 0x64, 0xa6, 0xa6, 0x39, 0x51, 0x4e, 0xa2, 0x3a, 0x59,
+	0xac, 0x0a, 0x96, 0x95, 0x34, 0x2a, 0x4a, 0x26, 0xaf, 0x1a, 0x34, 0x33, 0x0d, 0x02, 0x47, 0x00,
+	0xd3, 0x84, 0x0a, 0x49, 0x68, 0x79, 0x25, 0x65, 0x83, 0x6e, 0x65, 0x09, 0xee, 0x2f, 0x1c, 0x41,
+	0xbb, 0x38, 0x21, 0xfc, 0xed, 0x55, 0x8f, 0xa9, 0x9e, 0x7c, 0x08, 0xe6, 0x8c, 0xe4, 0x59, 0x14,
+	0xe5, 0x8d, 0x3e, 0x2f, 0x18, 0x03, 0xec, 0x37, 0x80, 0xe8, 0xd5, 0xd6, 0xf1, 0x61, 0xc9, 0xde,
+	0x50, 0x44, 0xaf, 0xe6, 0x6e, 0xad, 0x64, 0xd9, 0x52, 0xbf, 0xd8, 0xd1, 0xdb, 0x00, 0x9f, 0xc9,
+	0x1d, 0x2b, 0x70, 0x03, 0x00, 0x00,
 }
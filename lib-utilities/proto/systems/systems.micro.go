@@ -43,6 +43,7 @@ type SystemsService interface {
 	ChangeBootOrderSettings(ctx context.Context, in *BootOrderSettingsRequest, opts ...client.CallOption) (*SystemsResponse, error)
 	CreateVolume(ctx context.Context, in *VolumeRequest, opts ...client.CallOption) (*SystemsResponse, error)
 	DeleteVolume(ctx context.Context, in *VolumeRequest, opts ...client.CallOption) (*SystemsResponse, error)
+	PassThrough(ctx context.Context, in *PassThroughRequest, opts ...client.CallOption) (*SystemsResponse, error)
 }
 
 type systemsService struct {
@@ -153,6 +154,16 @@ func (c *systemsService) DeleteVolume(ctx context.Context, in *VolumeRequest, op
 	return out, nil
 }
 
+func (c *systemsService) PassThrough(ctx context.Context, in *PassThroughRequest, opts ...client.CallOption) (*SystemsResponse, error) {
+	req := c.c.NewRequest(c.name, "Systems.PassThrough", in)
+	out := new(SystemsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Systems service
 
 type SystemsHandler interface {
@@ -165,6 +176,7 @@ type SystemsHandler interface {
 	ChangeBootOrderSettings(context.Context, *BootOrderSettingsRequest, *SystemsResponse) error
 	CreateVolume(context.Context, *VolumeRequest, *SystemsResponse) error
 	DeleteVolume(context.Context, *VolumeRequest, *SystemsResponse) error
+	PassThrough(context.Context, *PassThroughRequest, *SystemsResponse) error
 }
 
 func RegisterSystemsHandler(s server.Server, hdlr SystemsHandler, opts ...server.HandlerOption) error {
@@ -178,6 +190,7 @@ func RegisterSystemsHandler(s server.Server, hdlr SystemsHandler, opts ...server
 		ChangeBootOrderSettings(ctx context.Context, in *BootOrderSettingsRequest, out *SystemsResponse) error
 		CreateVolume(ctx context.Context, in *VolumeRequest, out *SystemsResponse) error
 		DeleteVolume(ctx context.Context, in *VolumeRequest, out *SystemsResponse) error
+		PassThrough(ctx context.Context, in *PassThroughRequest, out *SystemsResponse) error
 	}
 	type Systems struct {
 		systems
@@ -225,3 +238,7 @@ func (h *systemsHandler) CreateVolume(ctx context.Context, in *VolumeRequest, ou
 func (h *systemsHandler) DeleteVolume(ctx context.Context, in *VolumeRequest, out *SystemsResponse) error {
 	return h.SystemsHandler.DeleteVolume(ctx, in, out)
 }
+
+func (h *systemsHandler) PassThrough(ctx context.Context, in *PassThroughRequest, out *SystemsResponse) error {
+	return h.SystemsHandler.PassThrough(ctx, in, out)
+}
@@ -252,6 +252,7 @@ type BiosSettingsRequest struct {
 	SessionToken         string   `protobuf:"bytes,1,opt,name=SessionToken,proto3" json:"SessionToken,omitempty"`
 	SystemID             string   `protobuf:"bytes,2,opt,name=SystemID,proto3" json:"SystemID,omitempty"`
 	RequestBody          []byte   `protobuf:"bytes,3,opt,name=RequestBody,proto3" json:"RequestBody,omitempty"`
+	IfMatch              string   `protobuf:"bytes,4,opt,name=IfMatch,proto3" json:"IfMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -303,10 +304,18 @@ func (m *BiosSettingsRequest) GetRequestBody() []byte {
 	return nil
 }
 
+func (m *BiosSettingsRequest) GetIfMatch() string {
+	if m != nil {
+		return m.IfMatch
+	}
+	return ""
+}
+
 type BootOrderSettingsRequest struct {
 	SessionToken         string   `protobuf:"bytes,1,opt,name=SessionToken,proto3" json:"SessionToken,omitempty"`
 	SystemID             string   `protobuf:"bytes,2,opt,name=SystemID,proto3" json:"SystemID,omitempty"`
 	RequestBody          []byte   `protobuf:"bytes,3,opt,name=RequestBody,proto3" json:"RequestBody,omitempty"`
+	IfMatch              string   `protobuf:"bytes,4,opt,name=IfMatch,proto3" json:"IfMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -358,6 +367,13 @@ func (m *BootOrderSettingsRequest) GetRequestBody() []byte {
 	return nil
 }
 
+func (m *BootOrderSettingsRequest) GetIfMatch() string {
+	if m != nil {
+		return m.IfMatch
+	}
+	return ""
+}
+
 type VolumeRequest struct {
 	SessionToken         string   `protobuf:"bytes,1,opt,name=SessionToken,proto3" json:"SessionToken,omitempty"`
 	SystemID             string   `protobuf:"bytes,2,opt,name=SystemID,proto3" json:"SystemID,omitempty"`
@@ -429,6 +445,69 @@ func (m *VolumeRequest) GetRequestBody() []byte {
 	return nil
 }
 
+type PassThroughRequest struct {
+	SessionToken         string   `protobuf:"bytes,1,opt,name=SessionToken,proto3" json:"SessionToken,omitempty"`
+	URL                  string   `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Method               string   `protobuf:"bytes,3,opt,name=Method,proto3" json:"Method,omitempty"`
+	RequestBody          []byte   `protobuf:"bytes,4,opt,name=RequestBody,proto3" json:"RequestBody,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PassThroughRequest) Reset()         { *m = PassThroughRequest{} }
+func (m *PassThroughRequest) String() string { return proto.CompactTextString(m) }
+func (*PassThroughRequest) ProtoMessage()    {}
+func (*PassThroughRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec938d4cda008df6, []int{7}
+}
+
+func (m *PassThroughRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PassThroughRequest.Unmarshal(m, b)
+}
+func (m *PassThroughRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PassThroughRequest.Marshal(b, m, deterministic)
+}
+func (m *PassThroughRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PassThroughRequest.Merge(m, src)
+}
+func (m *PassThroughRequest) XXX_Size() int {
+	return xxx_messageInfo_PassThroughRequest.Size(m)
+}
+func (m *PassThroughRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PassThroughRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PassThroughRequest proto.InternalMessageInfo
+
+func (m *PassThroughRequest) GetSessionToken() string {
+	if m != nil {
+		return m.SessionToken
+	}
+	return ""
+}
+
+func (m *PassThroughRequest) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *PassThroughRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *PassThroughRequest) GetRequestBody() []byte {
+	if m != nil {
+		return m.RequestBody
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetSystemsRequest)(nil), "GetSystemsRequest")
 	proto.RegisterType((*SystemsResponse)(nil), "SystemsResponse")
@@ -438,43 +517,48 @@ func init() {
 	proto.RegisterType((*BiosSettingsRequest)(nil), "BiosSettingsRequest")
 	proto.RegisterType((*BootOrderSettingsRequest)(nil), "BootOrderSettingsRequest")
 	proto.RegisterType((*VolumeRequest)(nil), "VolumeRequest")
+	proto.RegisterType((*PassThroughRequest)(nil), "PassThroughRequest")
 }
 
 func init() { proto.RegisterFile("systems.proto", fileDescriptor_ec938d4cda008df6) }
 
 var fileDescriptor_ec938d4cda008df6 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x75, 0x52, 0x60, 0x92, 0xd0, 0x32, 0x89, 0x54, 0x13, 0x10, 0x8a, 0x2c, 0x0e, 0x39,
-	0xf9, 0x10, 0x7a, 0xe0, 0x43, 0xea, 0x21, 0x0e, 0xd0, 0x48, 0x20, 0x90, 0x0d, 0x48, 0x1c, 0xb7,
-	0xc9, 0x90, 0x46, 0x75, 0xbc, 0x61, 0x77, 0x0c, 0x8a, 0x84, 0xf8, 0x07, 0xfc, 0x1e, 0x0e, 0xfc,
-	0x15, 0x7e, 0x0c, 0xf2, 0x07, 0xae, 0xe3, 0x3a, 0x52, 0x23, 0x45, 0xdc, 0x76, 0xdf, 0xce, 0x7b,
-	0xf3, 0xd6, 0x9a, 0xb7, 0x86, 0x96, 0x5e, 0x69, 0xa6, 0x85, 0x76, 0x96, 0x4a, 0xb2, 0xb4, 0x7f,
-	0x1a, 0x70, 0xf7, 0x15, 0xb1, 0x9f, 0x82, 0x1e, 0x7d, 0x89, 0x48, 0x33, 0xda, 0xd0, 0xd4, 0xa4,
-	0xf5, 0x5c, 0x86, 0xef, 0xe5, 0x05, 0x85, 0x96, 0xd1, 0x33, 0xfa, 0xb7, 0xbd, 0x35, 0x2c, 0xae,
-	0x51, 0x69, 0xf9, 0x3b, 0xa1, 0xc4, 0xc2, 0xda, 0x4b, 0x6b, 0x8a, 0x18, 0x1e, 0x82, 0xf9, 0xc1,
-	0x7b, 0x6d, 0x99, 0xc9, 0x51, 0xbc, 0xc4, 0x87, 0x00, 0x8a, 0xb4, 0x8c, 0xd4, 0x84, 0xc6, 0x23,
-	0xab, 0x96, 0x1c, 0x14, 0x10, 0xfb, 0x8f, 0x01, 0x07, 0xb9, 0x19, 0xbd, 0x94, 0xa1, 0xa6, 0x98,
-	0xa3, 0x59, 0x70, 0xa4, 0x5d, 0x39, 0xa5, 0xc4, 0x4b, 0xdd, 0x2b, 0x20, 0xf8, 0x08, 0x5a, 0xe9,
-	0xee, 0x0d, 0x69, 0x2d, 0x66, 0x94, 0x59, 0x59, 0x07, 0xf1, 0x18, 0xf6, 0xcf, 0x49, 0x4c, 0x49,
-	0x59, 0x66, 0xcf, 0xec, 0x37, 0x06, 0x0f, 0x9c, 0x52, 0x1f, 0xe7, 0x34, 0x39, 0x7e, 0x11, 0xb2,
-	0x5a, 0x79, 0x59, 0x2d, 0x22, 0xd4, 0xce, 0xe4, 0x74, 0x95, 0x38, 0x6d, 0x7a, 0xc9, 0xba, 0xfb,
-	0x14, 0x1a, 0x85, 0xd2, 0xf8, 0x92, 0x17, 0xb4, 0xca, 0xbe, 0x51, 0xbc, 0xc4, 0x0e, 0xd4, 0xbf,
-	0x8a, 0x20, 0xfa, 0x67, 0x24, 0xdd, 0x3c, 0xdb, 0x7b, 0x62, 0xd8, 0x3f, 0xa0, 0xeb, 0xca, 0xc5,
-	0x32, 0x62, 0x52, 0x69, 0x77, 0x8f, 0x34, 0xf1, 0x36, 0x9f, 0xbd, 0x0b, 0xb7, 0x52, 0xe6, 0x78,
-	0x94, 0xc9, 0xe7, 0x7b, 0xec, 0x41, 0x23, 0x93, 0x1a, 0xc6, 0x9e, 0xcd, 0xc4, 0x73, 0x11, 0xb2,
-	0x3f, 0xc1, 0xd1, 0x88, 0x3e, 0x8b, 0x28, 0xe0, 0xa1, 0x94, 0xfc, 0x56, 0x4d, 0x49, 0xed, 0xa8,
-	0xb9, 0xfd, 0x0d, 0xda, 0xc3, 0xb9, 0xd4, 0x3e, 0x31, 0xcf, 0xc3, 0x59, 0x71, 0x94, 0xfc, 0x0a,
-	0x59, 0x7f, 0x77, 0x77, 0xfa, 0x0e, 0x56, 0x7e, 0x99, 0xff, 0xdf, 0xfd, 0x97, 0x01, 0xad, 0x8f,
-	0x32, 0x88, 0x16, 0xb4, 0xab, 0x9e, 0x7d, 0x38, 0xf0, 0x59, 0x2a, 0x31, 0xa3, 0x71, 0xa8, 0x59,
-	0x84, 0x13, 0xca, 0x02, 0x54, 0x86, 0x63, 0x95, 0xb4, 0x75, 0x1e, 0xa5, 0x7c, 0x5f, 0x76, 0x5e,
-	0xbf, 0xe2, 0x7c, 0xf0, 0xbb, 0x06, 0x37, 0xb3, 0x08, 0xe0, 0x09, 0x74, 0x2e, 0x5f, 0x01, 0x57,
-	0x06, 0x01, 0x4d, 0x78, 0x2e, 0x43, 0x44, 0xe7, 0xca, 0xe3, 0xd0, 0x3d, 0x2c, 0x07, 0xc7, 0xbe,
-	0x81, 0xcf, 0x0b, 0xaf, 0x88, 0x97, 0xa5, 0xf9, 0xda, 0xe4, 0x63, 0x80, 0xcb, 0xc2, 0x6b, 0xb3,
-	0x5e, 0x42, 0xbb, 0x22, 0x4a, 0x78, 0xdf, 0xd9, 0x1c, 0xb0, 0x4a, 0x1d, 0x17, 0xda, 0x3e, 0x71,
-	0x39, 0x15, 0x68, 0x39, 0x1b, 0x82, 0x52, 0x29, 0x72, 0x02, 0xe8, 0x9e, 0x8b, 0x70, 0x46, 0xc5,
-	0x08, 0x60, 0xc7, 0xa9, 0x48, 0x44, 0x25, 0xff, 0x14, 0x8e, 0x32, 0x7e, 0x79, 0x92, 0xf1, 0x9e,
-	0xb3, 0x69, 0xba, 0x2b, 0x95, 0x06, 0xd0, 0x74, 0x15, 0x09, 0xa6, 0x74, 0x12, 0xf0, 0x8e, 0xb3,
-	0x36, 0x9d, 0x9b, 0x38, 0x23, 0x0a, 0x68, 0x1b, 0xce, 0xd9, 0x7e, 0xf2, 0xff, 0x78, 0xfc, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x1b, 0x27, 0x26, 0x4c, 0x50, 0x06, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xfe, 0xb9, 0x49, 0xdb, 0x1f, 0x93, 0x86, 0x96, 0x49, 0x44, 0x4d, 0x40, 0x28, 0xb2, 0x38,
+	0xe4, 0xe4, 0x43, 0xa8, 0x10, 0x7f, 0xa4, 0x1e, 0xe2, 0x00, 0x8d, 0x44, 0x44, 0x65, 0x17, 0x24,
+	0x8e, 0xdb, 0x64, 0x1a, 0x47, 0x75, 0xbc, 0x61, 0x77, 0x8d, 0x94, 0x0b, 0x27, 0xae, 0x1c, 0xb8,
+	0xf0, 0x2a, 0xbc, 0x0c, 0x6f, 0xc1, 0x0b, 0x20, 0xdb, 0xdb, 0xc4, 0x71, 0x1c, 0xa9, 0x91, 0x72,
+	0xe0, 0xb6, 0x33, 0x3b, 0xdf, 0xec, 0x37, 0xd6, 0x7c, 0x9f, 0xa1, 0x2a, 0x67, 0x52, 0xd1, 0x44,
+	0xda, 0x53, 0xc1, 0x15, 0xb7, 0xbe, 0x1b, 0x70, 0xef, 0x2d, 0x29, 0x2f, 0x4d, 0xba, 0xf4, 0x39,
+	0x22, 0xa9, 0xd0, 0x82, 0x03, 0x49, 0x52, 0x8e, 0x79, 0x78, 0xc1, 0xaf, 0x29, 0x34, 0x8d, 0xa6,
+	0xd1, 0xba, 0xe3, 0x2e, 0xe5, 0xe2, 0x1a, 0x91, 0x96, 0x9f, 0x33, 0xc1, 0x26, 0xe6, 0x4e, 0x5a,
+	0x93, 0xcd, 0xe1, 0x11, 0x94, 0x3e, 0xb8, 0xef, 0xcc, 0x52, 0x72, 0x15, 0x1f, 0xf1, 0x31, 0x80,
+	0x20, 0xc9, 0x23, 0x31, 0xa0, 0x5e, 0xd7, 0x2c, 0x27, 0x17, 0x99, 0x8c, 0xf5, 0xdb, 0x80, 0xc3,
+	0x39, 0x19, 0x39, 0xe5, 0xa1, 0xa4, 0x18, 0x23, 0x15, 0x53, 0x91, 0x74, 0xf8, 0x90, 0x12, 0x2e,
+	0xbb, 0x6e, 0x26, 0x83, 0x4f, 0xa0, 0x9a, 0x46, 0x7d, 0x92, 0x92, 0x8d, 0x48, 0x53, 0x59, 0x4e,
+	0xe2, 0x09, 0xec, 0xf9, 0xc4, 0x86, 0x24, 0xcc, 0x52, 0xb3, 0xd4, 0xaa, 0xb4, 0x1f, 0xd9, 0xb9,
+	0x77, 0xec, 0xb3, 0xe4, 0xfa, 0x75, 0xa8, 0xc4, 0xcc, 0xd5, 0xb5, 0x88, 0x50, 0xbe, 0xe4, 0xc3,
+	0x59, 0xc2, 0xf4, 0xc0, 0x4d, 0xce, 0x8d, 0x17, 0x50, 0xc9, 0x94, 0xc6, 0x43, 0x5e, 0xd3, 0x4c,
+	0x7f, 0xa3, 0xf8, 0x88, 0x75, 0xd8, 0xfd, 0xc2, 0x82, 0xe8, 0x86, 0x48, 0x1a, 0xbc, 0xdc, 0x79,
+	0x6e, 0x58, 0x5f, 0xa1, 0xe1, 0xf0, 0xc9, 0x34, 0x52, 0x24, 0xd2, 0xd7, 0x5d, 0x92, 0xa4, 0x36,
+	0xf9, 0xec, 0x0d, 0xf8, 0x3f, 0x45, 0xf6, 0xba, 0xba, 0xfd, 0x3c, 0xc6, 0x26, 0x54, 0x74, 0xab,
+	0x4e, 0xcc, 0xb9, 0x94, 0x70, 0xce, 0xa6, 0xac, 0x4f, 0x70, 0xdc, 0xa5, 0x2b, 0x16, 0x05, 0xaa,
+	0xc3, 0xb9, 0x7a, 0x2f, 0x86, 0x24, 0xb6, 0xf4, 0xb8, 0xf5, 0xc3, 0x80, 0x5a, 0x67, 0xcc, 0xa5,
+	0x47, 0x4a, 0x8d, 0xc3, 0x51, 0x76, 0x97, 0xbc, 0x82, 0xbe, 0xde, 0xd6, 0x86, 0x42, 0x13, 0xf6,
+	0x7b, 0x57, 0x7d, 0xa6, 0x06, 0xbe, 0x5e, 0xa8, 0x9b, 0xd0, 0xfa, 0x69, 0x80, 0x39, 0x1f, 0xf4,
+	0x5f, 0x22, 0xf6, 0xcb, 0x80, 0xea, 0x47, 0x1e, 0x44, 0x13, 0xda, 0x16, 0x9b, 0x16, 0x1c, 0x7a,
+	0x8a, 0x0b, 0x36, 0xa2, 0x5e, 0x28, 0x15, 0x0b, 0x07, 0xa4, 0x65, 0x97, 0x4f, 0xc7, 0x5d, 0xd2,
+	0xa7, 0xe7, 0x02, 0x9c, 0xc7, 0xf9, 0x99, 0x76, 0x57, 0x37, 0xe8, 0x9b, 0x01, 0x78, 0xce, 0xa4,
+	0xbc, 0xf0, 0x05, 0x8f, 0x46, 0xfe, 0x26, 0xf4, 0xb5, 0x1b, 0xec, 0x2c, 0xdc, 0xe0, 0x3e, 0xec,
+	0xf5, 0x49, 0xf9, 0x7c, 0xa8, 0xb9, 0xea, 0x28, 0x4f, 0xa3, 0xbc, 0x42, 0xa3, 0xfd, 0xa7, 0x0c,
+	0xfb, 0x5a, 0xbf, 0x78, 0x0a, 0xf5, 0x85, 0x85, 0x39, 0x3c, 0x08, 0x68, 0xa0, 0xc6, 0x3c, 0x44,
+	0xb4, 0x57, 0x9c, 0xad, 0x71, 0x94, 0x57, 0xbd, 0xf5, 0x1f, 0xbe, 0xca, 0x58, 0xa0, 0xab, 0xad,
+	0xe8, 0xd6, 0xe0, 0x13, 0x80, 0x45, 0xe1, 0xad, 0x51, 0x6f, 0xa0, 0x56, 0xe0, 0x03, 0xf8, 0xd0,
+	0x5e, 0xef, 0x0e, 0x85, 0x7d, 0x1c, 0xa8, 0x79, 0xa4, 0xf2, 0x92, 0x46, 0xd3, 0x5e, 0xa3, 0xf2,
+	0xc2, 0x26, 0xa7, 0x80, 0x8e, 0xcf, 0xc2, 0x11, 0x65, 0xe5, 0x8b, 0x75, 0xbb, 0x40, 0xcd, 0x85,
+	0xf8, 0x33, 0x38, 0xd6, 0xf8, 0xbc, 0xd4, 0xf0, 0x81, 0xbd, 0x4e, 0x7e, 0x85, 0x9d, 0xda, 0x70,
+	0xe0, 0x08, 0x62, 0x8a, 0xd2, 0x85, 0xc4, 0xbb, 0xf6, 0x92, 0x48, 0xd6, 0x61, 0xba, 0x14, 0xd0,
+	0x46, 0x98, 0x67, 0x50, 0xc9, 0xec, 0x30, 0xd6, 0xec, 0xd5, 0x8d, 0x2e, 0xc2, 0x5d, 0xee, 0x25,
+	0x3f, 0xcd, 0xa7, 0x7f, 0x07, 0x00, 0xae, 0x03, 0x2a, 0x19, 0x45, 0x07, 0x00, 0x00,
 }
@@ -104,6 +104,7 @@ type GetTaskRequest struct {
 	TaskID               string   `protobuf:"bytes,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
 	SubTaskID            string   `protobuf:"bytes,2,opt,name=subTaskID,proto3" json:"subTaskID,omitempty"`
 	SessionToken         string   `protobuf:"bytes,3,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	URL                  string   `protobuf:"bytes,4,opt,name=URL,proto3" json:"URL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -155,6 +156,13 @@ func (m *GetTaskRequest) GetSessionToken() string {
 	return ""
 }
 
+func (m *GetTaskRequest) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
 type TaskResponse struct {
 	StatusCode           int32             `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
 	StatusMessage        string            `protobuf:"bytes,2,opt,name=statusMessage,proto3" json:"statusMessage,omitempty"`
@@ -439,46 +447,47 @@ func init() {
 }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5d, 0x6e, 0xd3, 0x40,
-	0x10, 0xae, 0x9b, 0x34, 0x49, 0xc7, 0xfd, 0xdd, 0x22, 0x64, 0x2c, 0x04, 0x91, 0xc5, 0x43, 0x1e,
-	0xd0, 0x56, 0x04, 0xa4, 0x42, 0x41, 0x3c, 0x90, 0xa2, 0xb6, 0x88, 0xfe, 0xc8, 0x71, 0x0f, 0xb0,
-	0xa9, 0x87, 0xd4, 0xc4, 0xf1, 0x1a, 0xef, 0xba, 0x52, 0xce, 0xc2, 0x0d, 0x38, 0x10, 0xb7, 0xe0,
-	0x0e, 0x68, 0xbd, 0x76, 0x6d, 0x27, 0x08, 0x25, 0x6f, 0x3b, 0xdf, 0x7e, 0x33, 0xde, 0xf9, 0xe6,
-	0x1b, 0x03, 0x48, 0x26, 0x26, 0x34, 0x4e, 0xb8, 0xe4, 0xf6, 0xf3, 0x31, 0xe7, 0xe3, 0x10, 0x0f,
-	0xb3, 0x68, 0x94, 0x7e, 0x3b, 0x94, 0xc1, 0x14, 0x85, 0x64, 0xd3, 0x58, 0x13, 0x9c, 0x5f, 0xeb,
-	0xd0, 0xbe, 0x66, 0xb3, 0x90, 0x33, 0x9f, 0xbc, 0x07, 0xf3, 0xcc, 0xf3, 0xae, 0xcf, 0x90, 0xf9,
-	0x98, 0x08, 0xcb, 0xe8, 0x36, 0x7a, 0x66, 0xff, 0x09, 0xcd, 0xaf, 0x69, 0xe5, 0xee, 0x73, 0x24,
-	0x93, 0x99, 0x5b, 0x65, 0x93, 0x17, 0xb0, 0xad, 0xc2, 0xab, 0x18, 0x13, 0x26, 0x03, 0x1e, 0x59,
-	0xeb, 0x5d, 0xa3, 0xb7, 0xe9, 0xd6, 0x41, 0x62, 0x43, 0xe7, 0xcb, 0xf0, 0xea, 0xf2, 0x13, 0xf7,
-	0x67, 0x56, 0x23, 0x23, 0x3c, 0xc4, 0xe4, 0x19, 0xc0, 0x50, 0x32, 0x99, 0x8a, 0x01, 0xf7, 0xd1,
-	0x6a, 0x76, 0x8d, 0xde, 0x86, 0x5b, 0x41, 0xc8, 0x53, 0xd8, 0xf4, 0x58, 0x32, 0x46, 0x79, 0xe3,
-	0x9e, 0x5b, 0x1b, 0x59, 0x72, 0x09, 0x10, 0x07, 0xb6, 0x5c, 0x14, 0x31, 0x8f, 0x04, 0x66, 0xd5,
-	0x5b, 0x5d, 0xa3, 0xb7, 0xe5, 0xd6, 0x30, 0xfb, 0x23, 0xec, 0xcd, 0x37, 0x41, 0xf6, 0xa0, 0x31,
-	0xc1, 0x99, 0x65, 0x64, 0xf5, 0xd4, 0x91, 0x3c, 0x82, 0x8d, 0x7b, 0x16, 0xa6, 0x98, 0x77, 0xa0,
-	0x83, 0xe3, 0xf5, 0xb7, 0x86, 0xf3, 0x1d, 0x76, 0x4e, 0x51, 0x7a, 0x4c, 0x4c, 0x5c, 0xfc, 0x91,
-	0xa2, 0x90, 0xe4, 0x31, 0xb4, 0x94, 0xda, 0xe7, 0x27, 0x79, 0x81, 0x3c, 0x52, 0x6f, 0x15, 0xe9,
-	0xc8, 0xd3, 0x57, 0xba, 0x4e, 0x09, 0xa8, 0xb7, 0x0a, 0x14, 0x22, 0xe0, 0x91, 0xc7, 0x27, 0x18,
-	0xe5, 0x4a, 0xd4, 0x30, 0xe7, 0xb7, 0x01, 0x5b, 0xfa, 0x4b, 0xba, 0x01, 0x25, 0x8f, 0x28, 0xe5,
-	0x31, 0xb4, 0x3c, 0x25, 0xa2, 0x06, 0xa0, 0xa3, 0x0b, 0x14, 0x82, 0x8d, 0x8b, 0xe7, 0xd7, 0x41,
-	0xf2, 0x0a, 0x5a, 0x77, 0x59, 0xfb, 0x56, 0x23, 0x1f, 0x6f, 0xf5, 0x23, 0x54, 0x4b, 0xa3, 0xc7,
-	0x9b, 0x13, 0x09, 0x81, 0xe6, 0x48, 0x29, 0xda, 0xcc, 0x14, 0xcd, 0xce, 0xf6, 0x3b, 0x30, 0x2b,
-	0xd4, 0x95, 0x44, 0x1c, 0xc2, 0xfe, 0x20, 0x41, 0x26, 0xb1, 0xaa, 0xa3, 0x0d, 0x9d, 0x54, 0x60,
-	0x72, 0xc9, 0xa6, 0x98, 0x57, 0x79, 0x88, 0x95, 0x5a, 0x31, 0x4b, 0x30, 0x92, 0x35, 0x39, 0x6b,
-	0x98, 0x43, 0x81, 0x54, 0x8b, 0xe6, 0x92, 0x59, 0xd0, 0x56, 0xf3, 0x50, 0x7e, 0xd1, 0x45, 0x8b,
-	0xd0, 0xf9, 0x63, 0xc0, 0xfe, 0x4d, 0xec, 0xcf, 0xbd, 0xe2, 0x3f, 0xd3, 0x54, 0x27, 0xe5, 0xc5,
-	0xa2, 0xa1, 0x12, 0x50, 0x83, 0x29, 0x82, 0x54, 0xe4, 0xb3, 0xac, 0x20, 0xa4, 0x07, 0xbb, 0x31,
-	0x26, 0xb7, 0x18, 0xc9, 0x01, 0x9f, 0xc6, 0x21, 0xca, 0xc2, 0xdc, 0xf3, 0x30, 0x71, 0xa0, 0x1d,
-	0xb3, 0xd9, 0x57, 0xce, 0xfc, 0xcc, 0xdf, 0x66, 0xbf, 0x53, 0x2c, 0x9f, 0x5b, 0x5c, 0x90, 0x37,
-	0xd0, 0xc6, 0xc8, 0xf7, 0x82, 0x29, 0x66, 0x16, 0x37, 0xfb, 0x36, 0xd5, 0x3b, 0x4e, 0x8b, 0x1d,
-	0xa7, 0x5e, 0xb1, 0xe3, 0x6e, 0x41, 0x75, 0x8e, 0x81, 0x54, 0xdb, 0xcd, 0xf5, 0x59, 0xb0, 0x8c,
-	0xf1, 0x0f, 0xcb, 0xf4, 0x7f, 0x36, 0x1f, 0x6c, 0x3f, 0xc4, 0xe4, 0x3e, 0xb8, 0x45, 0x42, 0x01,
-	0x4e, 0x50, 0x3d, 0x59, 0x81, 0x64, 0x97, 0xd6, 0xb7, 0xc2, 0xde, 0xae, 0x99, 0xca, 0x59, 0x23,
-	0x2f, 0xa1, 0x93, 0x53, 0xc4, 0x12, 0xec, 0x43, 0x30, 0x4f, 0x51, 0x0e, 0xf5, 0xba, 0x2c, 0x93,
-	0x40, 0x01, 0xca, 0x84, 0x25, 0xf8, 0x7d, 0xd8, 0x51, 0xc8, 0x80, 0x87, 0x21, 0xde, 0x66, 0xff,
-	0xa5, 0xa5, 0x72, 0xe6, 0x44, 0x58, 0x25, 0xe7, 0x82, 0x47, 0x81, 0xe4, 0xc9, 0x12, 0x39, 0x47,
-	0x00, 0xa5, 0x93, 0x09, 0xa1, 0x0b, 0xbb, 0x62, 0x1f, 0xd0, 0x45, 0xab, 0x3b, 0x6b, 0xe4, 0x03,
-	0xec, 0x6a, 0x7c, 0x70, 0x17, 0x84, 0xfe, 0xaa, 0xd9, 0x47, 0x00, 0xa5, 0x41, 0x08, 0xa1, 0x0b,
-	0xcb, 0x61, 0x1f, 0xd0, 0x45, 0x07, 0x39, 0x6b, 0xa3, 0x56, 0x66, 0xbb, 0xd7, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xa8, 0x1e, 0x93, 0x64, 0x76, 0x06, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5d, 0x6e, 0xdb, 0x38,
+	0x10, 0x8e, 0x62, 0xc7, 0x76, 0xc6, 0xf9, 0x65, 0x16, 0x0b, 0xad, 0xb0, 0xd8, 0x35, 0x84, 0x3e,
+	0xf8, 0xa1, 0x60, 0x50, 0xb7, 0x40, 0xda, 0xb4, 0xe8, 0x43, 0x9d, 0x22, 0x49, 0x91, 0x3f, 0xc8,
+	0xca, 0x01, 0xe8, 0x68, 0xea, 0x08, 0x96, 0x45, 0x55, 0xa4, 0x02, 0x18, 0xe8, 0x4d, 0x7a, 0x83,
+	0x1e, 0xa8, 0xb7, 0xe8, 0x1d, 0x0a, 0x92, 0x52, 0x24, 0xd9, 0x45, 0xe1, 0xbc, 0x71, 0x3e, 0x7e,
+	0x33, 0xe2, 0x7c, 0xf3, 0x8d, 0x00, 0x24, 0x13, 0x53, 0x9a, 0xa4, 0x5c, 0x72, 0xe7, 0xff, 0x09,
+	0xe7, 0x93, 0x08, 0x0f, 0x75, 0x34, 0xce, 0x3e, 0x1f, 0xca, 0x70, 0x86, 0x42, 0xb2, 0x59, 0x62,
+	0x08, 0xee, 0xf7, 0x75, 0x68, 0xdf, 0xb0, 0x79, 0xc4, 0x59, 0x40, 0xde, 0x42, 0xf7, 0xcc, 0xf7,
+	0x6f, 0xce, 0x90, 0x05, 0x98, 0x0a, 0xdb, 0xea, 0x35, 0xfa, 0xdd, 0xc1, 0x3f, 0x34, 0xbf, 0xa6,
+	0x95, 0xbb, 0x8f, 0xb1, 0x4c, 0xe7, 0x5e, 0x95, 0x4d, 0x9e, 0xc1, 0xb6, 0x0a, 0xaf, 0x13, 0x4c,
+	0x99, 0x0c, 0x79, 0x6c, 0xaf, 0xf7, 0xac, 0xfe, 0xa6, 0x57, 0x07, 0x89, 0x03, 0x9d, 0x4f, 0xa3,
+	0xeb, 0xab, 0x0f, 0x3c, 0x98, 0xdb, 0x0d, 0x4d, 0x78, 0x8c, 0xc9, 0x7f, 0x00, 0x23, 0xc9, 0x64,
+	0x26, 0x86, 0x3c, 0x40, 0xbb, 0xd9, 0xb3, 0xfa, 0x1b, 0x5e, 0x05, 0x21, 0xff, 0xc2, 0xa6, 0xcf,
+	0xd2, 0x09, 0xca, 0x5b, 0xef, 0xdc, 0xde, 0xd0, 0xc9, 0x25, 0x40, 0x5c, 0xd8, 0xf2, 0x50, 0x24,
+	0x3c, 0x16, 0xa8, 0xab, 0xb7, 0x7a, 0x56, 0x7f, 0xcb, 0xab, 0x61, 0xce, 0x7b, 0xd8, 0x5b, 0x6c,
+	0x82, 0xec, 0x41, 0x63, 0x8a, 0x73, 0xdb, 0xd2, 0xf5, 0xd4, 0x91, 0xfc, 0x05, 0x1b, 0x0f, 0x2c,
+	0xca, 0x30, 0xef, 0xc0, 0x04, 0xc7, 0xeb, 0xaf, 0x2d, 0xf7, 0x2b, 0xec, 0x9c, 0xa2, 0xf4, 0x99,
+	0x98, 0x7a, 0xf8, 0x25, 0x43, 0x21, 0xc9, 0xdf, 0xd0, 0x52, 0x6a, 0x9f, 0x9f, 0xe4, 0x05, 0xf2,
+	0x48, 0xbd, 0x55, 0x64, 0x63, 0xdf, 0x5c, 0x99, 0x3a, 0x25, 0xa0, 0xde, 0x2a, 0x50, 0x88, 0x90,
+	0xc7, 0x3e, 0x9f, 0x62, 0x9c, 0x2b, 0x51, 0xc3, 0xd4, 0xbb, 0x6e, 0xbd, 0x0b, 0x2d, 0xc3, 0xa6,
+	0xa7, 0x8e, 0xee, 0x0f, 0x0b, 0xb6, 0xcc, 0xb7, 0x4d, 0x4b, 0x4a, 0x30, 0x51, 0x0a, 0x66, 0x19,
+	0xc1, 0x4a, 0x44, 0x8d, 0xc4, 0x44, 0x97, 0x28, 0x04, 0x9b, 0x14, 0x0d, 0xd5, 0x41, 0xf2, 0x02,
+	0x5a, 0xf7, 0x5a, 0x10, 0xbb, 0x91, 0x0f, 0xbc, 0xfa, 0x11, 0x6a, 0xc4, 0x32, 0x03, 0xcf, 0x89,
+	0x84, 0x40, 0x73, 0xac, 0x34, 0x6e, 0x6a, 0x8d, 0xf5, 0xd9, 0x79, 0x03, 0xdd, 0x0a, 0xf5, 0x49,
+	0xb2, 0x8e, 0x60, 0x7f, 0x98, 0x22, 0x93, 0x58, 0x55, 0xd6, 0x81, 0x4e, 0x26, 0x30, 0xbd, 0x62,
+	0x33, 0xcc, 0xab, 0x3c, 0xc6, 0x4a, 0xbf, 0x84, 0xa5, 0x18, 0xcb, 0x9a, 0xc0, 0x35, 0xcc, 0xa5,
+	0x40, 0xaa, 0x45, 0x73, 0xc9, 0x6c, 0x68, 0xab, 0x09, 0x29, 0x07, 0x99, 0xa2, 0x45, 0xe8, 0xfe,
+	0xb4, 0x60, 0xff, 0x36, 0x09, 0x16, 0x5e, 0xf1, 0x87, 0xf9, 0xaa, 0x93, 0x72, 0x67, 0xd1, 0x50,
+	0x09, 0xa8, 0xc1, 0x14, 0x41, 0x26, 0xf2, 0xe9, 0x56, 0x10, 0xd2, 0x87, 0xdd, 0x04, 0xd3, 0x3b,
+	0x8c, 0xe5, 0x90, 0xcf, 0x92, 0x08, 0x65, 0x61, 0xf7, 0x45, 0x98, 0xb8, 0xd0, 0x4e, 0xd8, 0xfc,
+	0x82, 0xb3, 0x40, 0x3b, 0xbe, 0x3b, 0xe8, 0x14, 0xeb, 0xe8, 0x15, 0x17, 0xe4, 0x15, 0xb4, 0x31,
+	0x0e, 0xfc, 0x70, 0x86, 0xda, 0xf4, 0xdd, 0x81, 0x43, 0xcd, 0xd6, 0xd3, 0x62, 0xeb, 0xa9, 0x5f,
+	0x6c, 0xbd, 0x57, 0x50, 0xdd, 0x63, 0x20, 0xd5, 0x76, 0x73, 0x7d, 0x96, 0x2c, 0x63, 0xfd, 0xc6,
+	0x32, 0x83, 0x6f, 0xcd, 0xc7, 0x45, 0x18, 0x61, 0xfa, 0x10, 0xde, 0x21, 0xa1, 0x00, 0x27, 0xa8,
+	0x9e, 0xac, 0x40, 0xb2, 0x4b, 0xeb, 0x7b, 0xe2, 0x6c, 0xd7, 0x4c, 0xe5, 0xae, 0x91, 0xe7, 0xd0,
+	0xc9, 0x29, 0x62, 0x05, 0xf6, 0x21, 0x74, 0x4f, 0x51, 0x8e, 0xb2, 0xf1, 0xaa, 0x09, 0x14, 0xa0,
+	0x4c, 0x58, 0x81, 0x3f, 0x80, 0x1d, 0x85, 0x0c, 0x79, 0x14, 0xe1, 0x9d, 0xfe, 0x53, 0xad, 0x94,
+	0xb3, 0x20, 0xc2, 0x53, 0x72, 0x2e, 0x79, 0x1c, 0x4a, 0x9e, 0xae, 0x90, 0x73, 0x04, 0x50, 0x3a,
+	0x99, 0x10, 0xba, 0xb4, 0x2b, 0xce, 0x01, 0x5d, 0xb6, 0xba, 0xbb, 0x46, 0xde, 0xc1, 0xae, 0xc1,
+	0x87, 0xf7, 0x61, 0x14, 0x3c, 0x35, 0xfb, 0x08, 0xa0, 0x34, 0x08, 0x21, 0x74, 0x69, 0x39, 0x9c,
+	0x03, 0xba, 0xec, 0x20, 0x77, 0x6d, 0xdc, 0xd2, 0xb6, 0x7b, 0xf9, 0x6b, 0x00, 0x8b, 0xb1, 0x87,
+	0x56, 0x88, 0x06, 0x00, 0x00,
 }
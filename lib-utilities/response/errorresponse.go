@@ -24,19 +24,21 @@ const (
 	//ErrorHelperMessage holds helper error message sent in error response
 	ErrorHelperMessage = "An error has occurred. See ExtendedInfo for more information."
 	//ErrorMessageOdataType holds message registry version
-	ErrorMessageOdataType               = "#Message.v1_0_8.Message"
-	propertyMissingArgCount             = 1
-	propertyValueNotInListArgCount      = 2
-	propertyValueTypeErrorArgCount      = 2
-	resourceNotFoundArgCount            = 2
-	propertyValueFormatErrorArgCount    = 2
-	resourceAtURIUnauthorizedArgCount   = 1
-	couldNotEstablishConnectionArgCount = 1
-	actionNotSupportedArgCount          = 1
-	resourceAlreadyExistsArgCount       = 3
-	actionParameterNotSupportedArgCount = 2
-	propertyUnknownArgCount             = 1
-	propertyValueConflictArgCount       = 2
+	ErrorMessageOdataType                = "#Message.v1_0_8.Message"
+	propertyMissingArgCount              = 1
+	propertyValueNotInListArgCount       = 2
+	propertyValueTypeErrorArgCount       = 2
+	resourceNotFoundArgCount             = 2
+	propertyValueFormatErrorArgCount     = 2
+	resourceAtURIUnauthorizedArgCount    = 1
+	couldNotEstablishConnectionArgCount  = 1
+	actionNotSupportedArgCount           = 1
+	resourceAlreadyExistsArgCount        = 3
+	actionParameterNotSupportedArgCount  = 2
+	propertyUnknownArgCount              = 1
+	propertyValueConflictArgCount        = 2
+	queryParameterValueTypeErrorArgCount = 2
+	queryParameterOutOfRangeArgCount     = 3
 )
 
 // validateParamTypes will compare string slices and returns bool
@@ -303,6 +305,37 @@ func (a *Args) CreateGenericErrorResponse() CommonError {
 					MessageArgs: errArg.MessageArgs,
 					Resolution:  "No resolution is required.",
 				})
+		case PreconditionFailed:
+			e.Error.MessageExtendedInfo = append(e.Error.MessageExtendedInfo,
+				Msg{
+					OdataType:  ErrorMessageOdataType,
+					MessageID:  errArg.StatusMessage,
+					Message:    "The ETag supplied did not match the ETag required to change this resource." + errArg.ErrorMessage,
+					Severity:   "Critical",
+					Resolution: "Try the operation again using the appropriate ETag.",
+				})
+		case QueryParameterValueTypeError:
+			validateMessageArgs(errArg.MessageArgs, []string{"string", "string"}, queryParameterValueTypeErrorArgCount)
+			e.Error.MessageExtendedInfo = append(e.Error.MessageExtendedInfo,
+				Msg{
+					OdataType:   ErrorMessageOdataType,
+					MessageID:   errArg.StatusMessage,
+					Message:     fmt.Sprintf("The value %v for the query parameter %v is of a different type than the parameter can accept. %v", errArg.MessageArgs[0], errArg.MessageArgs[1], errArg.ErrorMessage),
+					Severity:    "Warning",
+					MessageArgs: errArg.MessageArgs,
+					Resolution:  "Correct the value for the query parameter in the request and resubmit the request if the operation failed.",
+				})
+		case QueryParameterOutOfRange:
+			validateMessageArgs(errArg.MessageArgs, []string{"string", "string", "string"}, queryParameterOutOfRangeArgCount)
+			e.Error.MessageExtendedInfo = append(e.Error.MessageExtendedInfo,
+				Msg{
+					OdataType:   ErrorMessageOdataType,
+					MessageID:   errArg.StatusMessage,
+					Message:     fmt.Sprintf("The value %v for the query parameter %v is out of range %v. %v", errArg.MessageArgs[0], errArg.MessageArgs[1], errArg.MessageArgs[2], errArg.ErrorMessage),
+					Severity:    "Warning",
+					MessageArgs: errArg.MessageArgs,
+					Resolution:  "Reduce the value for the query parameter to a value that is within range, such as a start or count value that is within bounds of the number of resources in a collection or a page that is within the range of valid pages.",
+				})
 		}
 	}
 	return e
@@ -550,6 +550,95 @@ func TestCreateErrorResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: PreconditionFailed,
+			args: Args{
+				Code:    GeneralError,
+				Message: "",
+				ErrorArgs: []ErrArgs{
+					ErrArgs{
+						StatusMessage: PreconditionFailed,
+						ErrorMessage:  errMsg,
+						MessageArgs:   []interface{}{},
+					},
+				},
+			},
+			want: CommonError{
+				Error: ErrorClass{
+					Code:    GeneralError,
+					Message: ErrorHelperMessage,
+					MessageExtendedInfo: []Msg{
+						Msg{
+							OdataType:  ErrorMessageOdataType,
+							MessageID:  PreconditionFailed,
+							Message:    "The ETag supplied did not match the ETag required to change this resource." + errMsg,
+							Severity:   "Critical",
+							Resolution: "Try the operation again using the appropriate ETag.",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: QueryParameterValueTypeError,
+			args: Args{
+				Code:    GeneralError,
+				Message: "",
+				ErrorArgs: []ErrArgs{
+					ErrArgs{
+						StatusMessage: QueryParameterValueTypeError,
+						ErrorMessage:  errMsg,
+						MessageArgs:   []interface{}{"abc", "$top"},
+					},
+				},
+			},
+			want: CommonError{
+				Error: ErrorClass{
+					Code:    GeneralError,
+					Message: ErrorHelperMessage,
+					MessageExtendedInfo: []Msg{
+						Msg{
+							OdataType:   ErrorMessageOdataType,
+							MessageID:   QueryParameterValueTypeError,
+							Message:     "The value abc for the query parameter $top is of a different type than the parameter can accept. " + errMsg,
+							Severity:    "Warning",
+							MessageArgs: []interface{}{"abc", "$top"},
+							Resolution:  "Correct the value for the query parameter in the request and resubmit the request if the operation failed.",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: QueryParameterOutOfRange,
+			args: Args{
+				Code:    GeneralError,
+				Message: "",
+				ErrorArgs: []ErrArgs{
+					ErrArgs{
+						StatusMessage: QueryParameterOutOfRange,
+						ErrorMessage:  errMsg,
+						MessageArgs:   []interface{}{"-1", "$skip", "0-2147483647"},
+					},
+				},
+			},
+			want: CommonError{
+				Error: ErrorClass{
+					Code:    GeneralError,
+					Message: ErrorHelperMessage,
+					MessageExtendedInfo: []Msg{
+						Msg{
+							OdataType:   ErrorMessageOdataType,
+							MessageID:   QueryParameterOutOfRange,
+							Message:     "The value -1 for the query parameter $skip is out of range 0-2147483647. " + errMsg,
+							Severity:    "Warning",
+							MessageArgs: []interface{}{"-1", "$skip", "0-2147483647"},
+							Resolution:  "Reduce the value for the query parameter to a value that is within range, such as a start or count value that is within bounds of the number of resources in a collection or a page that is within the range of valid pages.",
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -71,6 +71,12 @@ const (
 	ResourceCannotBeDeleted = "Base.1.6.1.ResourceCannotBeDeleted"
 	// PropertyValueConflict indicates that the requested write of a property value could not be completed, because of a conflict with another property value.
 	PropertyValueConflict = "Base.1.6.1.PropertyValueConflict"
+	// PreconditionFailed indicates that the ETag supplied did not match the ETag required to change this resource
+	PreconditionFailed = "Base.1.6.1.PreconditionFailed"
+	// QueryParameterValueTypeError indicates that the value of a query parameter is of a different type than the parameter can accept
+	QueryParameterValueTypeError = "Base.1.6.1.QueryParameterValueTypeError"
+	// QueryParameterOutOfRange indicates that the value of a query parameter is out of the supported range
+	QueryParameterOutOfRange = "Base.1.6.1.QueryParameterOutOfRange"
 )
 
 // Response holds the generic response from odimra
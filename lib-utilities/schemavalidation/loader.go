@@ -0,0 +1,104 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package schemavalidation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/xeipuuv/gojsonreference"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// storeBaseURI is the location under which the DMTF publishes the Redfish JSON schemas
+const storeBaseURI = "http://redfish.dmtf.org/schemas/v1/"
+
+// storeLoader loads a JSON schema from the schema store. The schemas reference each other
+// with the absolute URI of their publication, which is mapped to a file of the store, so that
+// the DMTF, Swordfish and OEM schemas are all read from the store without network access.
+type storeLoader struct {
+	source string
+}
+
+// storeLoaderFactory creates the loaders of the schemas referenced by a schema of the store
+type storeLoaderFactory struct{}
+
+// JsonSource returns the reference of the schema
+func (l storeLoader) JsonSource() interface{} {
+	return l.source
+}
+
+// LoadJSON reads and decodes the schema file of the reference from the schema store
+func (l storeLoader) LoadJSON() (interface{}, error) {
+	reference, err := gojsonreference.NewJsonReference(l.source)
+	if err != nil {
+		return nil, err
+	}
+	filePath, err := getStorePath(reference)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var document interface{}
+	decoder := json.NewDecoder(file)
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// getStorePath returns the file of the schema store holding the schema of a reference.
+// The DMTF schemas published under storeBaseURI are at the root of the store, for e.g.
+// http://redfish.dmtf.org/schemas/v1/Resource.json is Resource.json, and the other schemas
+// are under the host and path of their publication, for e.g.
+// http://redfish.dmtf.org/schemas/swordfish/v1/Volume.json is redfish.dmtf.org/schemas/swordfish/v1/Volume.json
+func getStorePath(reference gojsonreference.JsonReference) (string, error) {
+	u := reference.GetUrl()
+	relativePath := path.Clean(u.Host + "/" + strings.TrimPrefix(u.Path, "/"))
+	base, _ := url.Parse(storeBaseURI)
+	if strings.EqualFold(u.Host, base.Host) && strings.HasPrefix(u.Path, base.Path) {
+		relativePath = path.Clean(strings.TrimPrefix(u.Path, base.Path))
+	}
+	if u.Host == "" || relativePath == "." || relativePath == ".." || strings.HasPrefix(relativePath, "../") {
+		return "", fmt.Errorf("schema reference %v is outside of the schema store", u)
+	}
+	return filepath.Join(config.Data.SchemaStorePath, filepath.FromSlash(relativePath)), nil
+}
+
+// JsonReference returns the parsed reference of the schema
+func (l storeLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference(l.source)
+}
+
+// LoaderFactory returns the factory used to load the schemas referenced by the schema
+func (l storeLoader) LoaderFactory() gojsonschema.JSONLoaderFactory {
+	return storeLoaderFactory{}
+}
+
+// New returns the loader of a referenced schema
+func (storeLoaderFactory) New(source string) gojsonschema.JSONLoader {
+	return storeLoader{source: source}
+}
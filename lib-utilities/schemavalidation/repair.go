@@ -0,0 +1,297 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package schemavalidation
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	// contextDelimiter separates the properties of the context of a validation error
+	contextDelimiter = "\x00"
+	// maxRemovalPasses is the maximum number of times the resource is validated again
+	// to remove the properties which couldn't be repaired
+	maxRemovalPasses = 3
+)
+
+// protectedProperties are the properties of the resources the aggregator relies on,
+// which are never removed from a resource even when they are invalid
+var protectedProperties = map[string]bool{
+	"@odata.id":      true,
+	"@odata.type":    true,
+	"@odata.context": true,
+	"Id":             true,
+	"Name":           true,
+	"UUID":           true,
+	"Members":        true,
+}
+
+// repair fixes the violations of a resource in place, and returns the action taken
+// on each repaired or removed property, keyed by the JSON pointer of the property.
+// The values of the wrong type or with a wrong enum case are converted first, and the
+// properties not allowed by the schema are removed. The resource is then validated again,
+// and the properties still invalid are removed, the innermost ones first.
+func repair(schema *gojsonschema.Schema, resource map[string]interface{}, resultErrors []gojsonschema.ResultError) map[string]string {
+	actions := make(map[string]string)
+	for _, resultErr := range resultErrors {
+		path := getPath(resultErr)
+		switch resultErr.Type() {
+		case "additional_property_not_allowed":
+			property, _ := resultErr.Details()["property"].(string)
+			propertyPath := append(append([]string{}, path...), property)
+			if removeProperty(resource, propertyPath) {
+				actions[toPointer(propertyPath)] = ActionRemoved
+			}
+		case "invalid_type":
+			expected, _ := resultErr.Details()["expected"].(string)
+			if value, ok := convertType(resultErr.Value(), expected); ok && setProperty(resource, path, value) {
+				actions[toPointer(path)] = ActionRepaired
+			}
+		case "enum":
+			allowed, _ := resultErr.Details()["allowed"].(string)
+			if value, ok := matchEnum(resultErr.Value(), allowed); ok && setProperty(resource, path, value) {
+				actions[toPointer(path)] = ActionRepaired
+			}
+		}
+	}
+	for pass := 0; pass < maxRemovalPasses; pass++ {
+		result, err := schema.Validate(gojsonschema.NewGoLoader(resource))
+		if err != nil || result.Valid() {
+			break
+		}
+		removed := false
+		for _, path := range getRemovablePaths(result.Errors()) {
+			if removeProperty(resource, path) {
+				actions[toPointer(path)] = ActionRemoved
+				removed = true
+			}
+		}
+		if !removed {
+			break
+		}
+	}
+	return actions
+}
+
+// getRemovablePaths returns the paths of the invalid properties to remove. Missing required
+// properties can't be fixed by removing a property, and the properties containing other invalid
+// properties are kept so that they are validated again once their invalid properties are removed.
+// The paths are sorted in descending order, so that the array items are removed from the last one.
+func getRemovablePaths(resultErrors []gojsonschema.ResultError) [][]string {
+	var paths [][]string
+	for _, resultErr := range resultErrors {
+		path := getPath(resultErr)
+		if resultErr.Type() == "required" || len(path) == 0 || (len(path) == 1 && protectedProperties[path[0]]) {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	var removable [][]string
+	for i, path := range paths {
+		isParent, isDuplicate := false, false
+		for j, other := range paths {
+			if len(other) > len(path) && hasPrefix(other, path) {
+				isParent = true
+				break
+			}
+			if j < i && len(other) == len(path) && hasPrefix(other, path) {
+				isDuplicate = true
+				break
+			}
+		}
+		if !isParent && !isDuplicate {
+			removable = append(removable, path)
+		}
+	}
+	sort.Slice(removable, func(i, j int) bool {
+		return comparePaths(removable[i], removable[j]) > 0
+	})
+	return removable
+}
+
+// convertType converts a value to the type expected by the schema. Only the numbers and
+// booleans sent as strings, and the numbers and booleans expected as strings, are converted.
+func convertType(value interface{}, expected string) (interface{}, bool) {
+	expectedTypes := make(map[string]bool)
+	for _, expectedType := range strings.Split(strings.Trim(expected, "[]"), ",") {
+		expectedTypes[strings.TrimSpace(expectedType)] = true
+	}
+	switch v := value.(type) {
+	case string:
+		v = strings.TrimSpace(v)
+		if expectedTypes["integer"] {
+			if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return json.Number(v), true
+			}
+		}
+		if expectedTypes["number"] {
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				return json.Number(v), true
+			}
+		}
+		if expectedTypes["boolean"] {
+			if b, err := strconv.ParseBool(strings.ToLower(v)); err == nil {
+				return b, true
+			}
+		}
+	case json.Number:
+		if expectedTypes["string"] {
+			return v.String(), true
+		}
+	case bool:
+		if expectedTypes["string"] {
+			return strconv.FormatBool(v), true
+		}
+	}
+	return nil, false
+}
+
+// matchEnum returns the allowed value of an enum differing from the value by its case only
+func matchEnum(value interface{}, allowed string) (interface{}, bool) {
+	str, ok := value.(string)
+	if !ok {
+		return nil, false
+	}
+	var allowedValues []interface{}
+	if err := json.Unmarshal([]byte("["+allowed+"]"), &allowedValues); err != nil {
+		return nil, false
+	}
+	for _, allowedValue := range allowedValues {
+		if allowedStr, ok := allowedValue.(string); ok && strings.EqualFold(allowedStr, str) {
+			return allowedStr, true
+		}
+	}
+	return nil, false
+}
+
+// setProperty sets the value of the property at the path, and reports if the property exists
+func setProperty(node interface{}, path []string, value interface{}) bool {
+	if len(path) == 0 {
+		return false
+	}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		child, exists := n[path[0]]
+		if !exists {
+			return false
+		}
+		if len(path) == 1 {
+			n[path[0]] = value
+			return true
+		}
+		return setProperty(child, path[1:], value)
+	case []interface{}:
+		index, err := strconv.Atoi(path[0])
+		if err != nil || index < 0 || index >= len(n) {
+			return false
+		}
+		if len(path) == 1 {
+			n[index] = value
+			return true
+		}
+		return setProperty(n[index], path[1:], value)
+	}
+	return false
+}
+
+// removeProperty removes the property at the path, and reports if the property existed.
+// When an item of a collection is removed, the count of the collection is updated.
+func removeProperty(resource map[string]interface{}, path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+	if len(path) == 1 {
+		if _, exists := resource[path[0]]; !exists {
+			return false
+		}
+		delete(resource, path[0])
+		return true
+	}
+	switch child := resource[path[0]].(type) {
+	case map[string]interface{}:
+		return removeProperty(child, path[1:])
+	case []interface{}:
+		index, err := strconv.Atoi(path[1])
+		if err != nil || index < 0 || index >= len(child) {
+			return false
+		}
+		if len(path) > 2 {
+			item, ok := child[index].(map[string]interface{})
+			return ok && removeProperty(item, path[2:])
+		}
+		resource[path[0]] = append(child[:index:index], child[index+1:]...)
+		if _, exists := resource[path[0]+"@odata.count"]; exists {
+			resource[path[0]+"@odata.count"] = len(child) - 1
+		}
+		return true
+	}
+	return false
+}
+
+// getPath returns the path of the property of a validation error, the root being an empty path
+func getPath(resultErr gojsonschema.ResultError) []string {
+	path := strings.Split(resultErr.Context().String(contextDelimiter), contextDelimiter)
+	return path[1:]
+}
+
+// toPointer returns the JSON pointer of a path
+func toPointer(path []string) string {
+	var pointer strings.Builder
+	for _, property := range path {
+		property = strings.Replace(property, "~", "~0", -1)
+		pointer.WriteString("/" + strings.Replace(property, "/", "~1", -1))
+	}
+	return pointer.String()
+}
+
+// hasPrefix reports if the path starts with the prefix path
+func hasPrefix(path, prefix []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// comparePaths compares two paths property by property, the array indexes being compared as numbers
+func comparePaths(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] == b[i] {
+			continue
+		}
+		indexA, errA := strconv.Atoi(a[i])
+		indexB, errB := strconv.Atoi(b[i])
+		if errA == nil && errB == nil {
+			if indexA < indexB {
+				return -1
+			}
+			return 1
+		}
+		if a[i] < b[i] {
+			return -1
+		}
+		return 1
+	}
+	return len(a) - len(b)
+}
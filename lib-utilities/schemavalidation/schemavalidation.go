@@ -0,0 +1,349 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package schemavalidation validates the resources returned by the plugins against the
+// Redfish JSON schemas of the schema store. Depending on the configured mode the violations
+// are only reported, or the invalid properties are repaired or removed from the resource.
+package schemavalidation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
+	log "github.com/sirupsen/logrus"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	// Table is the in-memory DB table holding the schema violation reports, keyed by resource URI
+	Table = "SchemaViolations"
+	// ActionRepaired is the action of a violation fixed by converting the value of the property
+	ActionRepaired = "Repaired"
+	// ActionRemoved is the action of a violation fixed by removing the property from the resource
+	ActionRemoved = "Removed"
+)
+
+// Violation is a property of a resource which doesn't conform to the schema of the resource
+type Violation struct {
+	Property string `json:"Property"`
+	Type     string `json:"Type"`
+	Message  string `json:"Message"`
+	Action   string `json:"Action,omitempty"`
+}
+
+// Report holds the schema violations found in a resource returned by a plugin
+type Report struct {
+	Resource    string      `json:"Resource"`
+	PluginID    string      `json:"PluginID"`
+	OdataType   string      `json:"OdataType"`
+	Schema      string      `json:"Schema"`
+	Mode        string      `json:"Mode"`
+	Violations  []Violation `json:"Violations"`
+	ValidatedAt string      `json:"ValidatedAt"`
+}
+
+// schemaCache holds the schemas compiled from the schema store, along with the
+// errors of the schemas which couldn't be compiled, so that they are compiled once
+type schemaCache struct {
+	lock    sync.Mutex
+	loader  *gojsonschema.SchemaLoader
+	schemas map[string]*gojsonschema.Schema
+	errs    map[string]error
+}
+
+var cache = &schemaCache{}
+
+// maxCachedResults bounds the number of validation results kept in memory
+const maxCachedResults = 10000
+
+// validationResult is the outcome of the last validation of a resource. It spares the validation,
+// and the DB access, when a resource is returned again unchanged, and the deletion of
+// the report of a resource which was already found valid.
+type validationResult struct {
+	digest   [sha256.Size]byte
+	mode     string
+	body     []byte
+	reported bool
+}
+
+// resultCache holds the last validation result of each resource, keyed by resource URI
+type resultCache struct {
+	lock    sync.Mutex
+	results map[string]validationResult
+}
+
+var results = &resultCache{}
+
+// combinedSchemaErrors are the types of the errors of the anyOf, oneOf and allOf schemas
+var combinedSchemaErrors = map[string]bool{
+	"number_any_of": true,
+	"number_one_of": true,
+	"number_all_of": true,
+}
+
+// saveReport and deleteReport are overridden in the unit tests
+var (
+	saveReport   = saveReportInDB
+	deleteReport = deleteReportFromDB
+)
+
+// Validate validates the resource returned by a plugin against the schema of its @odata.type.
+// resourceURI is the URI under which the resource is exposed on the northbound.
+// The violations found are recorded in the schema violation report of the resource, and the
+// body is returned unchanged unless the mode is Strict, in which case the invalid properties
+// are repaired when possible and removed otherwise. The result is cached, so that a resource
+// returned again unchanged is neither validated nor looked up in the DB.
+// Resources without @odata.type and resources whose schema is missing from the schema store
+// are not validated.
+func Validate(pluginID, resourceURI string, body []byte) []byte {
+	mode := getMode()
+	if mode == config.SchemaValidationOff {
+		return body
+	}
+	digest := sha256.Sum256(body)
+	previous, cached := results.get(resourceURI)
+	if cached && previous.digest == digest && previous.mode == mode {
+		return previous.body
+	}
+	resource, err := decodeResource(body)
+	if err != nil {
+		return body
+	}
+	odataType, _ := resource["@odata.type"].(string)
+	schemaFile, schemaRef := getSchemaReference(odataType)
+	if schemaRef == "" {
+		return body
+	}
+	if _, err := os.Stat(filepath.Join(config.Data.SchemaStorePath, schemaFile)); err != nil {
+		log.Debug("schema " + schemaFile + " of " + resourceURI + " not found in the schema store")
+		return body
+	}
+	schema, err := cache.get(schemaRef)
+	if err != nil {
+		log.Warn("unable to compile the schema " + schemaRef + ": " + err.Error())
+		return body
+	}
+	result, err := schema.Validate(gojsonschema.NewGoLoader(resource))
+	if err != nil {
+		log.Warn("unable to validate " + resourceURI + ": " + err.Error())
+		return body
+	}
+	if result.Valid() {
+		// the report is deleted unless the resource was already found valid
+		if !cached || previous.reported {
+			if err := deleteReport(resourceURI); err != nil {
+				log.Error("unable to delete the schema violation report of " + resourceURI + ": " + err.Error())
+				return body
+			}
+		}
+		results.set(resourceURI, validationResult{digest: digest, mode: mode, body: body})
+		return body
+	}
+	violations := getViolations(result.Errors())
+	metrics.ObserveSchemaViolations(pluginID, len(violations))
+	if mode == config.SchemaValidationStrict {
+		actions := repair(schema, resource, result.Errors())
+		setActions(violations, actions)
+		if data, err := json.Marshal(resource); err == nil {
+			body = data
+		} else {
+			log.Error("unable to marshal the repaired resource " + resourceURI + ": " + err.Error())
+		}
+	}
+	log.Warn(fmt.Sprintf("%d schema violations found in %s returned by the plugin %s", len(violations), resourceURI, pluginID))
+	report := Report{
+		Resource:    resourceURI,
+		PluginID:    pluginID,
+		OdataType:   odataType,
+		Schema:      strings.TrimPrefix(schemaRef, storeBaseURI),
+		Mode:        mode,
+		Violations:  violations,
+		ValidatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := saveReport(report); err != nil {
+		log.Error("unable to save the schema violation report of " + resourceURI + ": " + err.Error())
+		return body
+	}
+	results.set(resourceURI, validationResult{digest: digest, mode: mode, body: body, reported: true})
+	return body
+}
+
+// getMode returns the configured schema validation mode. The validation is disabled
+// when the schema store is not configured.
+func getMode() string {
+	if config.Data.SchemaValidationConf == nil || config.Data.SchemaStorePath == "" ||
+		config.Data.SchemaValidationConf.Mode == "" {
+		return config.SchemaValidationOff
+	}
+	return config.Data.SchemaValidationConf.Mode
+}
+
+// decodeResource decodes a resource keeping the numbers as they are, so that the resource is
+// not altered when it's encoded again after being repaired
+func decodeResource(body []byte) (map[string]interface{}, error) {
+	var resource map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// getSchemaReference returns the schema file and the reference of the definition of an @odata.type.
+// For instance #ComputerSystem.v1_13_0.ComputerSystem is defined in ComputerSystem.v1_13_0.json
+// under #/definitions/ComputerSystem.
+func getSchemaReference(odataType string) (string, string) {
+	odataType = strings.TrimPrefix(odataType, "#")
+	index := strings.LastIndex(odataType, ".")
+	if index <= 0 || index == len(odataType)-1 {
+		return "", ""
+	}
+	schemaFile := odataType[:index] + ".json"
+	return schemaFile, storeBaseURI + schemaFile + "#/definitions/" + odataType[index+1:]
+}
+
+// get returns the compiled schema of a reference, compiling it on the first use
+func (c *schemaCache) get(schemaRef string) (*gojsonschema.Schema, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if schema, exists := c.schemas[schemaRef]; exists {
+		return schema, nil
+	}
+	if err, exists := c.errs[schemaRef]; exists {
+		return nil, err
+	}
+	if c.loader == nil {
+		c.loader = gojsonschema.NewSchemaLoader()
+		c.schemas = make(map[string]*gojsonschema.Schema)
+		c.errs = make(map[string]error)
+	}
+	schema, err := c.loader.Compile(storeLoader{source: schemaRef})
+	if err != nil {
+		c.errs[schemaRef] = err
+		return nil, err
+	}
+	c.schemas[schemaRef] = schema
+	return schema, nil
+}
+
+// get returns the last validation result of a resource
+func (c *resultCache) get(resourceURI string) (validationResult, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	r, exists := c.results[resourceURI]
+	return r, exists
+}
+
+// set records the validation result of a resource. The cache is emptied when it's full,
+// so that the results of the resources which are gone are not kept forever.
+func (c *resultCache) set(resourceURI string, r validationResult) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.results == nil || len(c.results) >= maxCachedResults {
+		c.results = make(map[string]validationResult)
+	}
+	c.results[resourceURI] = r
+}
+
+// getViolations converts the validation errors into violations. The errors of the anyOf,
+// oneOf and allOf schemas are skipped when the errors of their closest subschema are reported.
+func getViolations(resultErrors []gojsonschema.ResultError) []Violation {
+	violations := make([]Violation, 0, len(resultErrors))
+	for i, resultErr := range resultErrors {
+		if combinedSchemaErrors[resultErr.Type()] && hasInnerError(resultErrors, i) {
+			continue
+		}
+		path := getPath(resultErr)
+		if resultErr.Type() == "additional_property_not_allowed" {
+			property, _ := resultErr.Details()["property"].(string)
+			path = append(path, property)
+		}
+		violations = append(violations, Violation{
+			Property: toPointer(path),
+			Type:     resultErr.Type(),
+			Message:  resultErr.Description(),
+		})
+	}
+	return violations
+}
+
+// hasInnerError reports if an error of another type is reported for the property
+// of the error at the index, or for any property it contains
+func hasInnerError(resultErrors []gojsonschema.ResultError, index int) bool {
+	path := getPath(resultErrors[index])
+	for i, resultErr := range resultErrors {
+		if i != index && !combinedSchemaErrors[resultErr.Type()] && hasPrefix(getPath(resultErr), path) {
+			return true
+		}
+	}
+	return false
+}
+
+// setActions sets the action taken on each violation. A violation on a property
+// contained in a removed property is considered removed as well.
+func setActions(violations []Violation, actions map[string]string) {
+	for i, violation := range violations {
+		if action, exists := actions[violation.Property]; exists {
+			violations[i].Action = action
+			continue
+		}
+		for property, action := range actions {
+			if action == ActionRemoved && strings.HasPrefix(violation.Property, property+"/") {
+				violations[i].Action = ActionRemoved
+				break
+			}
+		}
+	}
+}
+
+func saveReportInDB(report Report) error {
+	conn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return err
+	}
+	if err := conn.AddResourceData(Table, report.Resource, report); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deleteReportFromDB(resourceURI string) error {
+	conn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return err
+	}
+	// the report is read first, since deleting a missing key is logged as an error
+	if _, err := conn.Read(Table, resourceURI); err != nil {
+		if err.ErrNo() == errors.DBKeyNotFound {
+			return nil
+		}
+		return err
+	}
+	if err := conn.Delete(Table, resourceURI); err != nil {
+		return err
+	}
+	return nil
+}
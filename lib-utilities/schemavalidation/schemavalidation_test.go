@@ -0,0 +1,282 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package schemavalidation
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/xeipuuv/gojsonreference"
+)
+
+var mockSchemas = map[string]string{
+	"Widget.v1_0_0.json": `{
+		"$id": "http://redfish.dmtf.org/schemas/v1/Widget.v1_0_0.json",
+		"$schema": "http://redfish.dmtf.org/schemas/v1/redfish-schema-v1.json",
+		"definitions": {
+			"Widget": {
+				"type": "object",
+				"additionalProperties": false,
+				"patternProperties": {
+					"^([a-zA-Z_][a-zA-Z0-9_]*)?@(odata|Redfish|Message)\\.[a-zA-Z_][a-zA-Z0-9_]*$": {}
+				},
+				"properties": {
+					"@odata.id": {"$ref": "http://redfish.dmtf.org/schemas/v1/odata-v4.json#/definitions/id"},
+					"@odata.type": {"type": "string"},
+					"Id": {"type": "string"},
+					"Name": {"type": "string"},
+					"Count": {"type": ["integer", "null"]},
+					"Enabled": {"type": "boolean"},
+					"Status": {"anyOf": [{"$ref": "http://redfish.dmtf.org/schemas/v1/Resource.json#/definitions/Status"}, {"type": "null"}]},
+					"Parts": {"type": "array", "items": {"type": "string"}}
+				},
+				"required": ["@odata.id", "@odata.type", "Id", "Name"]
+			}
+		}
+	}`,
+	"Resource.json": `{
+		"$id": "http://redfish.dmtf.org/schemas/v1/Resource.json",
+		"definitions": {
+			"Health": {"type": "string", "enum": ["OK", "Warning", "Critical"]},
+			"Status": {
+				"type": "object",
+				"additionalProperties": false,
+				"properties": {
+					"Health": {"anyOf": [{"$ref": "#/definitions/Health"}, {"type": "null"}]},
+					"State": {"type": "string"}
+				}
+			}
+		}
+	}`,
+	"odata-v4.json": `{
+		"$id": "http://redfish.dmtf.org/schemas/v1/odata-v4.json",
+		"definitions": {
+			"id": {"type": "string", "format": "uri-reference"}
+		}
+	}`,
+}
+
+// setUpMockSchemaStore creates a schema store with the mock schemas, sets the schema
+// validation mode and records the reports saved and deleted instead of using the DB
+func setUpMockSchemaStore(t *testing.T, mode string) (map[string]Report, map[string]bool) {
+	storePath, err := ioutil.TempDir("", "schemastore")
+	if err != nil {
+		t.Fatal("error while creating the schema store:", err)
+	}
+	for name, content := range mockSchemas {
+		if err := ioutil.WriteFile(filepath.Join(storePath, name), []byte(content), 0644); err != nil {
+			t.Fatal("error while writing the schema", name, ":", err)
+		}
+	}
+	config.Data.SchemaStorePath = storePath
+	config.Data.SchemaValidationConf = &config.SchemaValidationConf{Mode: mode}
+	cache = &schemaCache{}
+	results = &resultCache{}
+	saved := make(map[string]Report)
+	deleted := make(map[string]bool)
+	saveReport = func(report Report) error {
+		saved[report.Resource] = report
+		return nil
+	}
+	deleteReport = func(resourceURI string) error {
+		deleted[resourceURI] = true
+		return nil
+	}
+	return saved, deleted
+}
+
+// tearDownMockSchemaStore removes the mock schema store and restores the reports recording
+func tearDownMockSchemaStore() {
+	os.RemoveAll(config.Data.SchemaStorePath)
+	config.Data.SchemaStorePath = ""
+	config.Data.SchemaValidationConf = nil
+	saveReport = saveReportInDB
+	deleteReport = deleteReportFromDB
+}
+
+const invalidWidget = `{"@odata.id":"/redfish/v1/Widgets/1","@odata.type":"#Widget.v1_0_0.Widget","Id":"1","Name":"Widget 1",` +
+	`"Count":"12","Enabled":"TRUE","Status":{"Health":"ok","State":"Enabled"},"Color":"Blue","Parts":["a",2,"b",null]}`
+
+func TestValidateOffMode(t *testing.T) {
+	saved, deleted := setUpMockSchemaStore(t, config.SchemaValidationOff)
+	defer tearDownMockSchemaStore()
+	if got := Validate("GRF", "/redfish/v1/Widgets/1", []byte(invalidWidget)); string(got) != invalidWidget {
+		t.Errorf("Validate() altered the resource in Off mode: %s", got)
+	}
+	if len(saved) != 0 || len(deleted) != 0 {
+		t.Errorf("Validate() accessed the reports in Off mode")
+	}
+}
+
+func TestValidateValidResource(t *testing.T) {
+	saved, deleted := setUpMockSchemaStore(t, config.SchemaValidationStrict)
+	defer tearDownMockSchemaStore()
+	body := `{"@odata.id":"/redfish/v1/Widgets/1","@odata.type":"#Widget.v1_0_0.Widget","Id":"1","Name":"Widget 1",` +
+		`"Count":null,"Status":{"Health":"OK"},"Parts@odata.count":1}`
+	if got := Validate("GRF", "/redfish/v1/Widgets/1", []byte(body)); string(got) != body {
+		t.Errorf("Validate() altered a valid resource: %s", got)
+	}
+	if len(saved) != 0 || !deleted["/redfish/v1/Widgets/1"] {
+		t.Errorf("Validate() should only delete the report of a valid resource")
+	}
+}
+
+func TestValidateCachedResult(t *testing.T) {
+	saved, deleted := setUpMockSchemaStore(t, config.SchemaValidationStrict)
+	defer tearDownMockSchemaStore()
+	valid := `{"@odata.id":"/redfish/v1/Widgets/1","@odata.type":"#Widget.v1_0_0.Widget","Id":"1","Name":"Widget 1"}`
+	Validate("GRF", "/redfish/v1/Widgets/1", []byte(valid))
+	delete(deleted, "/redfish/v1/Widgets/1")
+	Validate("GRF", "/redfish/v1/Widgets/1", []byte(valid))
+	Validate("GRF", "/redfish/v1/Widgets/1", []byte(strings.Replace(valid, "Widget 1", "Widget one", 1)))
+	if len(deleted) != 0 {
+		t.Errorf("Validate() deleted the report of a resource already found valid")
+	}
+
+	repaired := Validate("GRF", "/redfish/v1/Widgets/1", []byte(invalidWidget))
+	delete(saved, "/redfish/v1/Widgets/1")
+	if got := Validate("GRF", "/redfish/v1/Widgets/1", []byte(invalidWidget)); string(got) != string(repaired) || len(saved) != 0 {
+		t.Errorf("Validate() = %s, want the cached result %s", got, repaired)
+	}
+	Validate("GRF", "/redfish/v1/Widgets/1", []byte(valid))
+	if !deleted["/redfish/v1/Widgets/1"] {
+		t.Errorf("Validate() didn't delete the report of a resource found valid again")
+	}
+}
+
+func TestGetStorePath(t *testing.T) {
+	config.Data.SchemaStorePath = "/etc/schemastore"
+	defer func() { config.Data.SchemaStorePath = "" }()
+	tests := map[string]string{
+		"http://redfish.dmtf.org/schemas/v1/Resource.json#/definitions/Status": "/etc/schemastore/Resource.json",
+		"http://redfish.dmtf.org/schemas/swordfish/v1/Volume.json":             "/etc/schemastore/redfish.dmtf.org/schemas/swordfish/v1/Volume.json",
+		"https://oem.example.com/schemas/Widget.json":                          "/etc/schemastore/oem.example.com/schemas/Widget.json",
+		"http://redfish.dmtf.org/schemas/v1/../../../etc/passwd":               "",
+		"http://oem.example.com/../../etc/passwd":                              "",
+		"Resource.json": "",
+	}
+	for source, want := range tests {
+		reference, _ := gojsonreference.NewJsonReference(source)
+		got, err := getStorePath(reference)
+		if got != want || (err == nil) != (want != "") {
+			t.Errorf("getStorePath(%v) = %v, %v, want %v", source, got, err, want)
+		}
+	}
+}
+
+func TestValidateUnknownSchema(t *testing.T) {
+	saved, _ := setUpMockSchemaStore(t, config.SchemaValidationStrict)
+	defer tearDownMockSchemaStore()
+	for _, body := range []string{
+		`{"@odata.id":"/redfish/v1/Gadgets/1","@odata.type":"#Gadget.v1_0_0.Gadget","Id":1}`,
+		`{"@odata.id":"/redfish/v1/Gadgets/1","Id":1}`,
+		`not a resource`,
+	} {
+		if got := Validate("GRF", "/redfish/v1/Gadgets/1", []byte(body)); string(got) != body {
+			t.Errorf("Validate() altered a resource without schema: %s", got)
+		}
+	}
+	if len(saved) != 0 {
+		t.Errorf("Validate() saved a report for a resource without schema")
+	}
+}
+
+func TestValidateReportMode(t *testing.T) {
+	saved, _ := setUpMockSchemaStore(t, config.SchemaValidationReport)
+	defer tearDownMockSchemaStore()
+	if got := Validate("GRF", "/redfish/v1/Widgets/1", []byte(invalidWidget)); string(got) != invalidWidget {
+		t.Errorf("Validate() altered the resource in Report mode: %s", got)
+	}
+	report, exists := saved["/redfish/v1/Widgets/1"]
+	if !exists {
+		t.Fatal("Validate() didn't save the report of the resource")
+	}
+	if report.PluginID != "GRF" || report.Schema != "Widget.v1_0_0.json#/definitions/Widget" || report.Mode != config.SchemaValidationReport {
+		t.Errorf("Validate() saved an unexpected report: %+v", report)
+	}
+	properties := make(map[string]string)
+	for _, violation := range report.Violations {
+		if violation.Action != "" {
+			t.Errorf("Validate() reported the action %s in Report mode", violation.Action)
+		}
+		properties[violation.Property] = violation.Type
+	}
+	want := map[string]string{
+		"/Color":         "additional_property_not_allowed",
+		"/Count":         "invalid_type",
+		"/Enabled":       "invalid_type",
+		"/Status/Health": "enum",
+		"/Parts/1":       "invalid_type",
+		"/Parts/3":       "invalid_type",
+	}
+	if !reflect.DeepEqual(properties, want) {
+		t.Errorf("Validate() reported %v, want %v", properties, want)
+	}
+}
+
+func TestValidateStrictMode(t *testing.T) {
+	saved, _ := setUpMockSchemaStore(t, config.SchemaValidationStrict)
+	defer tearDownMockSchemaStore()
+	got := Validate("GRF", "/redfish/v1/Widgets/1", []byte(invalidWidget))
+	var resource map[string]interface{}
+	if err := json.Unmarshal(got, &resource); err != nil {
+		t.Fatal("Validate() returned an invalid resource:", err)
+	}
+	want := map[string]interface{}{
+		"@odata.id":   "/redfish/v1/Widgets/1",
+		"@odata.type": "#Widget.v1_0_0.Widget",
+		"Id":          "1",
+		"Name":        "Widget 1",
+		"Count":       float64(12),
+		"Enabled":     true,
+		"Status":      map[string]interface{}{"Health": "OK", "State": "Enabled"},
+		"Parts":       []interface{}{"a", "2", "b"},
+	}
+	if !reflect.DeepEqual(resource, want) {
+		t.Errorf("Validate() = %v, want %v", resource, want)
+	}
+	actions := make(map[string]string)
+	for _, violation := range saved["/redfish/v1/Widgets/1"].Violations {
+		actions[violation.Property] = violation.Action
+	}
+	wantActions := map[string]string{
+		"/Color":         ActionRemoved,
+		"/Count":         ActionRepaired,
+		"/Enabled":       ActionRepaired,
+		"/Status/Health": ActionRepaired,
+		"/Parts/1":       ActionRepaired,
+		"/Parts/3":       ActionRemoved,
+	}
+	if !reflect.DeepEqual(actions, wantActions) {
+		t.Errorf("Validate() reported the actions %v, want %v", actions, wantActions)
+	}
+}
+
+func TestValidateStrictModeProtectedProperties(t *testing.T) {
+	setUpMockSchemaStore(t, config.SchemaValidationStrict)
+	defer tearDownMockSchemaStore()
+	body := `{"@odata.id":"/redfish/v1/Widgets/1","@odata.type":"#Widget.v1_0_0.Widget","Id":1,"Name":"Widget 1","Status":"OK"}`
+	got := Validate("GRF", "/redfish/v1/Widgets/1", []byte(body))
+	want := `{"@odata.id":"/redfish/v1/Widgets/1","@odata.type":"#Widget.v1_0_0.Widget","Id":"1","Name":"Widget 1"}`
+	if string(got) != want {
+		t.Errorf("Validate() = %s, want %s", got, want)
+	}
+}
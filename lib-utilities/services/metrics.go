@@ -0,0 +1,55 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
+	"github.com/micro/cli"
+	"github.com/micro/go-micro/server"
+)
+
+// metricsAddressFlag is the command line flag with the address on which the service serves
+// its metrics, for e.g. --metrics_address=:9104. The metrics are not served if it is not set.
+var metricsAddressFlag = cli.StringFlag{
+	Name:   "metrics_address",
+	EnvVar: "METRICS_ADDRESS",
+	Usage:  "Address on which the Prometheus metrics are served",
+}
+
+// statusCoder is implemented by the RPC responses carrying a HTTP status code
+type statusCoder interface {
+	GetStatusCode() int32
+}
+
+// metricsWrapper records the count and the latency of the RPC requests served.
+// The code recorded is the status code of the response when it has one.
+func metricsWrapper(fn server.HandlerFunc) server.HandlerFunc {
+	return func(ctx context.Context, req server.Request, rsp interface{}) error {
+		start := time.Now()
+		err := fn(ctx, req, rsp)
+		code := "OK"
+		if err != nil {
+			code = "Error"
+		} else if resp, ok := rsp.(statusCoder); ok {
+			code = strconv.Itoa(int(resp.GetStatusCode()))
+		}
+		metrics.ObserveRPCRequest(req.Endpoint(), code, time.Since(start))
+		return err
+	}
+}
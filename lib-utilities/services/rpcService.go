@@ -16,11 +16,14 @@ package services
 
 import (
 	"crypto/tls"
-	"crypto/x509"
-	"encoding/pem"
 	"fmt"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
+	"github.com/micro/cli"
 	micro "github.com/micro/go-micro"
 	"github.com/micro/go-micro/transport"
 )
@@ -31,39 +34,42 @@ var Service micro.Service
 // InitializeService will initialize a new micro.Service.
 // Service will be initialized here itself, so the Server() and Client()
 // called easily.
+// The service serves its metrics on the address given with the
+// --metrics_address flag, if any, and traces the RPC requests it sends
+// and serves as part of the trace propagated in the request metadata.
+// The entries of the service are logged as configured in LogConf.
 func InitializeService(serviceName string) error {
-
-	cer, err := tls.X509KeyPair(
-		config.Data.KeyCertConf.RPCCertificate,
-		config.Data.KeyCertConf.RPCPrivateKey,
-	)
-	if err != nil {
-		return fmt.Errorf("error while trying to load x509 key pair: %v", err)
-	}
-
-	certPool := x509.NewCertPool()
-
-	block, _ := pem.Decode(config.Data.KeyCertConf.RootCACertificate)
-	if block == nil {
-		return fmt.Errorf("error while decoding ca file")
+	if err := logs.Init(serviceName, config.Data.LogConf.Format, config.Data.LogConf.Level); err != nil {
+		return fmt.Errorf("error while initializing logging: %v", err)
 	}
-	if block.Type != "CERTIFICATE" || len(block.Headers) != 0 {
-		return fmt.Errorf("error while decoding ca file")
+	if err := tracing.Init(serviceName); err != nil {
+		return fmt.Errorf("error while initializing tracing: %v", err)
 	}
 
-	certificate, err := x509.ParseCertificate(block.Bytes)
+	// the key pair is served and the servers are verified from the certificate store,
+	// so that a certificate replaced with the CertificateService, which is saved in the DB,
+	// or a root CA certificate replaced in its file, is taken into account without restarting the service
+	err := config.RPCCertificates.Load(
+		config.Data.KeyCertConf.RPCCertificatePath,
+		config.Data.KeyCertConf.RPCPrivateKeyPath,
+		config.Data.KeyCertConf.RootCACertificatePath,
+	)
 	if err != nil {
-		return fmt.Errorf("error while ParseCertificate ca block file: %v", err)
+		return fmt.Errorf("error while trying to load x509 key pair: %v", err)
 	}
-
-	certPool.AddCert(certificate)
+	go config.RPCCertificates.WatchFiles()
+	go common.WatchKeyPair(common.RPCKeyPair, config.RPCCertificates)
 
 	tlsConfig := &tls.Config{
-		Certificates: []tls.Certificate{cer},
-		RootCAs:      certPool,
-		ServerName:   config.Data.LocalhostFQDN,
+		GetCertificate:       config.RPCCertificates.GetCertificate,
+		GetClientCertificate: config.RPCCertificates.GetClientCertificate,
+		ServerName:           config.Data.LocalhostFQDN,
 	}
 	config.Server.SetTLSConfig(tlsConfig)
+	// the chain of the servers is verified against the root CA certificate in use by
+	// VerifyPeerCertificate in place of the RootCAs, which can't be replaced
+	tlsConfig.InsecureSkipVerify = true
+	tlsConfig.VerifyPeerCertificate = config.RPCCertificates.VerifyPeerCertificate(config.Data.LocalhostFQDN)
 
 	Service = micro.NewService(
 		micro.Name(serviceName),
@@ -73,6 +79,12 @@ func InitializeService(serviceName string) error {
 				transport.TLSConfig(tlsConfig),
 			),
 		),
+		micro.WrapClient(tracingClientWrapper),
+		micro.WrapHandler(tracingWrapper, metricsWrapper),
+		micro.Flags(metricsAddressFlag),
+		micro.Action(func(c *cli.Context) {
+			go metrics.ListenAndServe(c.String(metricsAddressFlag.Name))
+		}),
 	)
 	Service.Init()
 	return nil
@@ -0,0 +1,75 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/metadata"
+	"github.com/micro/go-micro/server"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingClient starts a client span for each RPC request sent and
+// propagates its trace context to the service in the request metadata
+type tracingClient struct {
+	client.Client
+}
+
+// tracingClientWrapper wraps the client of the service with the tracingClient
+func tracingClientWrapper(c client.Client) client.Client {
+	return &tracingClient{c}
+}
+
+// Call implements client.Client
+func (c *tracingClient) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	ctx, span := tracing.Tracer().Start(ctx, req.Endpoint(), trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+	md := make(metadata.Metadata)
+	if existing, ok := metadata.FromContext(ctx); ok {
+		md = metadata.Copy(existing)
+	}
+	tracing.InjectMap(ctx, md)
+	err := c.Client.Call(metadata.NewContext(ctx, md), req, rsp, opts...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// tracingWrapper starts the server span of the RPC requests served,
+// continuing the trace propagated in the request metadata if any, so
+// the entries logged while serving them carry its transaction ID
+func tracingWrapper(fn server.HandlerFunc) server.HandlerFunc {
+	return func(ctx context.Context, req server.Request, rsp interface{}) error {
+		if md, ok := metadata.FromContext(ctx); ok {
+			ctx = tracing.ExtractMap(ctx, md)
+		}
+		ctx, span := tracing.Tracer().Start(ctx, req.Endpoint(), trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+		logs.Log(ctx).Debug("serving the RPC request " + req.Endpoint())
+		err := fn(ctx, req, rsp)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	}
+}
@@ -0,0 +1,80 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	otlppb "github.com/ODIM-Project/ODIM/lib-utilities/proto/otlp"
+	"github.com/golang/protobuf/proto"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// exporter implements sdktrace.SpanExporter. It sends the spans to an OTLP/HTTP collector,
+// for e.g. the OpenTelemetry collector, using the protobuf encoding.
+// The upstream OTLP exporters are not used since they pull gRPC and protobuf versions
+// which are not compatible with go-micro and the ODIMRA protobuf files.
+type exporter struct {
+	url    string
+	client *http.Client
+}
+
+// newExporter returns the exporter of the spans to the collector listening on endpoint
+func newExporter(endpoint string) *exporter {
+	return &exporter{
+		url:    strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// ExportSpans implements sdktrace.SpanExporter
+func (e *exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	body, err := proto.Marshal(&otlppb.ExportTraceServiceRequest{ResourceSpans: resourceSpans(spans)})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error while exporting the spans to %v: %v", e.url, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error while exporting the spans to %v: %v", e.url, resp.Status)
+	}
+	return nil
+}
+
+// Shutdown implements sdktrace.SpanExporter
+func (e *exporter) Shutdown(ctx context.Context) error {
+	e.client.CloseIdleConnections()
+	return nil
+}
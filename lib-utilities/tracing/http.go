@@ -0,0 +1,102 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package tracing
+
+import (
+	"net/http"
+
+	iris "github.com/kataras/iris/v12"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// IrisMiddleware is the iris handler which starts the server span of the requests served.
+// The trace context of the request headers is continued when present, otherwise a new
+// trace is started. The trace ID is returned in the X-Trace-Id response header and the
+// span is carried by the context of the request for the handlers.
+// It is to be registered with UseGlobal so that it runs first on all the routes.
+func IrisMiddleware(ctx iris.Context) {
+	req := ctx.Request()
+	spanCtx, span := Tracer().Start(
+		ExtractHeaders(req.Context(), req.Header),
+		req.Method+" "+req.URL.Path,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest("", "", req)...),
+	)
+	defer span.End()
+	ctx.ResetRequest(req.WithContext(spanCtx))
+	ctx.Header(TraceIDHeader, TraceID(spanCtx))
+
+	ctx.Next()
+
+	if route := ctx.GetCurrentRoute(); route != nil {
+		span.SetName(req.Method + " " + route.Path())
+		span.SetAttributes(semconv.HTTPRouteKey.String(route.Path()))
+	}
+	code := ctx.GetStatusCode()
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(code)...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(code, trace.SpanKindServer))
+}
+
+// NewTransport returns the http.RoundTripper which sends the requests through base as
+// part of the trace carried by their context. A client span is started for each request
+// and its trace context is set on the request headers. base is returned as is when it
+// already traces the requests.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if _, traced := base.(*transport); traced {
+		return base
+	}
+	return &transport{base: base}
+}
+
+// NewClient returns a copy of client whose requests are sent through NewTransport.
+// The client is copied since the clients of GetHTTPClientObj are shared.
+func NewClient(client *http.Client) *http.Client {
+	traced := *client
+	traced.Transport = NewTransport(client.Transport)
+	return &traced
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := Tracer().Start(
+		req.Context(),
+		"HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPClientAttributesFromHTTPRequest(req)...),
+	)
+	defer span.End()
+
+	// the request is not to be modified by the RoundTripper, so the headers are set on a copy
+	req = req.Clone(ctx)
+	InjectHeaders(ctx, req.Header)
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(resp.StatusCode)...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(resp.StatusCode, trace.SpanKindClient))
+	return resp, nil
+}
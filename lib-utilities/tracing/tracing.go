@@ -0,0 +1,151 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package tracing sets up the OpenTelemetry tracing of the ODIMRA services and plugins
+// and holds the helpers to propagate the trace context over HTTP, RPC and message bus
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EndpointEnv is the environment variable with the base URL of the OTLP/HTTP collector,
+	// for e.g. http://localhost:4318. The spans are not exported if it is not set.
+	EndpointEnv = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	// TraceIDHeader is the response header carrying the trace ID of a northbound request
+	TraceIDHeader = "X-Trace-Id"
+
+	instrumentationName = "github.com/ODIM-Project/ODIM/lib-utilities/tracing"
+)
+
+// Init sets the global tracer provider of the service and the W3C trace context propagator.
+// The spans are always recorded so that the trace IDs are available, and they are exported
+// in batches to the collector set with OTEL_EXPORTER_OTLP_ENDPOINT.
+func Init(serviceName string) error {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(sdkresource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+		)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+	}
+	if endpoint := os.Getenv(EndpointEnv); endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid %v %v", EndpointEnv, endpoint)
+		}
+		options = append(options, sdktrace.WithBatcher(newExporter(endpoint)))
+		log.Info("Exporting traces to " + endpoint)
+	}
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(options...))
+	return nil
+}
+
+// Tracer returns the tracer with which the ODIMRA spans are started
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// TraceID returns the hex encoded ID of the trace carried by ctx, or an empty string
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
+
+// detachedContext carries the values of its parent context, without its deadline nor its cancellation
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+// Detach returns a context carrying the values of ctx, its span and its log correlation
+// fields, so that the work done with it is part of the trace without being cancelled
+// along with ctx, for e.g. with the request
+func Detach(ctx context.Context) context.Context {
+	return detachedContext{ctx}
+}
+
+// InjectHeaders sets the trace context carried by ctx on the HTTP headers
+func InjectHeaders(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// ExtractHeaders returns a copy of ctx with the trace context of the HTTP headers, if any
+func ExtractHeaders(ctx context.Context, header http.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// InjectMap sets the trace context carried by ctx in the map, for e.g. the RPC metadata
+func InjectMap(ctx context.Context, m map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, mapCarrier(m))
+}
+
+// ExtractMap returns a copy of ctx with the trace context of the map, if any.
+// The keys of the map are matched case insensitively.
+func ExtractMap(ctx context.Context, m map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, mapCarrier(m))
+}
+
+// mapCarrier is the propagation.TextMapCarrier of the string maps whose keys
+// may have been canonicalized on their way, like the go-micro metadata
+type mapCarrier map[string]string
+
+// Get returns the value of the key, matched case insensitively
+func (m mapCarrier) Get(key string) string {
+	if value, ok := m[key]; ok {
+		return value
+	}
+	for k, value := range m {
+		if strings.EqualFold(k, key) {
+			return value
+		}
+	}
+	return ""
+}
+
+// Set stores the value of the key
+func (m mapCarrier) Set(key, value string) {
+	m[key] = value
+}
+
+// Keys returns the keys of the map
+func (m mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,209 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	otlppb "github.com/ODIM-Project/ODIM/lib-utilities/proto/otlp"
+	"github.com/golang/protobuf/proto"
+	iris "github.com/kataras/iris/v12"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	testTraceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+)
+
+func TestMain(m *testing.M) {
+	if err := Init("test"); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestInit(t *testing.T) {
+	os.Setenv(EndpointEnv, "localhost")
+	defer os.Unsetenv(EndpointEnv)
+	if err := Init("test"); err == nil {
+		t.Errorf("Init() expected an error for an endpoint without scheme")
+	}
+}
+
+func TestExtractMap(t *testing.T) {
+	// the go-micro metadata keys are canonicalized over the transport
+	ctx := ExtractMap(context.Background(), map[string]string{"Traceparent": testTraceParent})
+	if got := TraceID(ctx); got != testTraceID {
+		t.Errorf("ExtractMap() trace ID = %v, want %v", got, testTraceID)
+	}
+	m := make(map[string]string)
+	InjectMap(ctx, m)
+	if m["traceparent"] != testTraceParent {
+		t.Errorf("InjectMap() traceparent = %v, want %v", m["traceparent"], testTraceParent)
+	}
+}
+
+type detachTestKey struct{}
+
+func TestDetach(t *testing.T) {
+	ctx, cancel := context.WithCancel(ExtractMap(context.Background(), map[string]string{"traceparent": testTraceParent}))
+	ctx = context.WithValue(ctx, detachTestKey{}, "value")
+	detached := Detach(ctx)
+	cancel()
+	if detached.Err() != nil {
+		t.Errorf("Detach() context cancelled along with the parent context")
+	}
+	if got := detached.Value(detachTestKey{}); got != "value" {
+		t.Errorf("Detach() value = %v, want value", got)
+	}
+	if got := TraceID(detached); got != testTraceID {
+		t.Errorf("Detach() trace ID = %v, want %v", got, testTraceID)
+	}
+}
+
+func TestIrisMiddleware(t *testing.T) {
+	app := iris.New()
+	app.UseGlobal(IrisMiddleware)
+	var handlerTraceID string
+	app.Get("/redfish/v1/Systems/{id}", func(ctx iris.Context) {
+		handlerTraceID = TraceID(ctx.Request().Context())
+	})
+	if err := app.Build(); err != nil {
+		t.Fatalf("error while building the application: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/redfish/v1/Systems/1", nil)
+	req.Header.Set("traceparent", testTraceParent)
+	rec := httptest.NewRecorder()
+	app.ServeHTTP(rec, req)
+	if handlerTraceID != testTraceID {
+		t.Errorf("IrisMiddleware() trace ID in handler = %v, want %v", handlerTraceID, testTraceID)
+	}
+	if got := rec.Header().Get(TraceIDHeader); got != testTraceID {
+		t.Errorf("IrisMiddleware() %v = %v, want %v", TraceIDHeader, got, testTraceID)
+	}
+
+	rec = httptest.NewRecorder()
+	app.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/redfish/v1/Systems/1", nil))
+	if handlerTraceID == "" || handlerTraceID == testTraceID {
+		t.Errorf("IrisMiddleware() trace ID in handler = %v, want a new trace ID", handlerTraceID)
+	}
+	if got := rec.Header().Get(TraceIDHeader); got != handlerTraceID {
+		t.Errorf("IrisMiddleware() %v = %v, want %v", TraceIDHeader, got, handlerTraceID)
+	}
+}
+
+func TestNewTransport(t *testing.T) {
+	var traceParent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get("traceparent")
+	}))
+	defer server.Close()
+
+	ctx := ExtractMap(context.Background(), map[string]string{"traceparent": testTraceParent})
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	client := &http.Client{Transport: NewTransport(nil)}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		t.Fatalf("NewTransport() unexpected error = %v", err)
+	}
+	resp.Body.Close()
+	got := ExtractHeaders(context.Background(), http.Header{"Traceparent": []string{traceParent}})
+	if TraceID(got) != testTraceID {
+		t.Errorf("NewTransport() traceparent = %v, want trace ID %v", traceParent, testTraceID)
+	}
+	if trace.SpanContextFromContext(got).SpanID().String() == "00f067aa0ba902b7" {
+		t.Errorf("NewTransport() traceparent = %v, want the client span as parent", traceParent)
+	}
+	if req.Header.Get("traceparent") != "" {
+		t.Errorf("NewTransport() modified the request headers")
+	}
+}
+
+func TestNewTransportTracedBase(t *testing.T) {
+	base := NewTransport(nil)
+	if got := NewTransport(base); got != base {
+		t.Errorf("NewTransport() wrapped a transport already tracing the requests")
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	shared := &http.Client{Timeout: time.Second}
+	client := NewClient(shared)
+	if shared.Transport != nil {
+		t.Errorf("NewClient() modified the transport of the shared client")
+	}
+	if _, traced := client.Transport.(*transport); !traced || client.Timeout != shared.Timeout {
+		t.Errorf("NewClient() = %+v, want a traced copy of %+v", client, shared)
+	}
+}
+
+func TestExporter(t *testing.T) {
+	var request []*otlppb.ResourceSpans
+	var path, contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType = r.URL.Path, r.Header.Get("Content-Type")
+		body, _ := ioutil.ReadAll(r.Body)
+		var export otlppb.ExportTraceServiceRequest
+		proto.Unmarshal(body, &export)
+		request = export.ResourceSpans
+	}))
+	defer server.Close()
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(newExporter(server.URL + "/")))
+	ctx := ExtractMap(context.Background(), map[string]string{"traceparent": testTraceParent})
+	_, span := provider.Tracer(instrumentationName).Start(ctx, "Systems.GetSystemResource", trace.WithSpanKind(trace.SpanKindServer))
+	span.SetStatus(codes.Error, "not found")
+	span.End()
+
+	if path != "/v1/traces" || contentType != "application/x-protobuf" {
+		t.Errorf("exporter request = %v %v, want /v1/traces application/x-protobuf", path, contentType)
+	}
+	if len(request) != 1 || len(request[0].ScopeSpans) != 1 || len(request[0].ScopeSpans[0].Spans) != 1 {
+		t.Fatalf("exporter request = %v, want one span", request)
+	}
+	got := request[0].ScopeSpans[0].Spans[0]
+	want := span.SpanContext().SpanID().String()
+	if hex.EncodeToString(got.TraceId) != testTraceID || hex.EncodeToString(got.SpanId) != want || hex.EncodeToString(got.ParentSpanId) != "00f067aa0ba902b7" {
+		t.Errorf("exporter span IDs = %x/%x/%x, want %v/%v/00f067aa0ba902b7", got.TraceId, got.SpanId, got.ParentSpanId, testTraceID, want)
+	}
+	if got.Name != "Systems.GetSystemResource" || got.Kind != otlppb.Span_SPAN_KIND_SERVER {
+		t.Errorf("exporter span = %v %v, want Systems.GetSystemResource SPAN_KIND_SERVER", got.Name, got.Kind)
+	}
+	if got.Status.GetCode() != otlppb.Status_STATUS_CODE_ERROR || got.Status.GetMessage() != "not found" {
+		t.Errorf("exporter span status = %v, want error status", got.Status)
+	}
+}
+
+func TestAnyValue(t *testing.T) {
+	got := anyValue(attribute.StringSliceValue([]string{"Systems", "Chassis"})).GetArrayValue().GetValues()
+	if len(got) != 2 || got[0].GetStringValue() != "Systems" || got[1].GetStringValue() != "Chassis" {
+		t.Errorf("anyValue() = %v, want the array of Systems and Chassis", got)
+	}
+	if got := anyValue(attribute.Int64Value(404)).GetIntValue(); got != 404 {
+		t.Errorf("anyValue() = %v, want 404", got)
+	}
+}
@@ -0,0 +1,177 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package tracing
+
+import (
+	otlppb "github.com/ODIM-Project/ODIM/lib-utilities/proto/otlp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// resourceSpans groups the spans by resource and instrumentation scope, the way
+// the upstream OTLP exporters do
+func resourceSpans(spans []sdktrace.ReadOnlySpan) []*otlppb.ResourceSpans {
+	type scopeKey struct {
+		resource attribute.Distinct
+		scope    instrumentation.Library
+	}
+	var result []*otlppb.ResourceSpans
+	resources := make(map[attribute.Distinct]*otlppb.ResourceSpans)
+	scopes := make(map[scopeKey]*otlppb.ScopeSpans)
+	for _, s := range spans {
+		resourceKey := s.Resource().Equivalent()
+		rs, ok := resources[resourceKey]
+		if !ok {
+			rs = &otlppb.ResourceSpans{
+				Resource:  &otlppb.Resource{Attributes: keyValues(s.Resource().Attributes())},
+				SchemaUrl: s.Resource().SchemaURL(),
+			}
+			resources[resourceKey] = rs
+			result = append(result, rs)
+		}
+		library := s.InstrumentationLibrary()
+		key := scopeKey{resource: resourceKey, scope: library}
+		ss, ok := scopes[key]
+		if !ok {
+			ss = &otlppb.ScopeSpans{
+				Scope:     &otlppb.InstrumentationScope{Name: library.Name, Version: library.Version},
+				SchemaUrl: library.SchemaURL,
+			}
+			scopes[key] = ss
+			rs.ScopeSpans = append(rs.ScopeSpans, ss)
+		}
+		ss.Spans = append(ss.Spans, span(s))
+	}
+	return result
+}
+
+// span returns the OTLP span of s
+func span(s sdktrace.ReadOnlySpan) *otlppb.Span {
+	traceID, spanID := s.SpanContext().TraceID(), s.SpanContext().SpanID()
+	result := &otlppb.Span{
+		TraceId:                traceID[:],
+		SpanId:                 spanID[:],
+		TraceState:             s.SpanContext().TraceState().String(),
+		Name:                   s.Name(),
+		Kind:                   spanKind(s.SpanKind()),
+		StartTimeUnixNano:      uint64(s.StartTime().UnixNano()),
+		EndTimeUnixNano:        uint64(s.EndTime().UnixNano()),
+		Attributes:             keyValues(s.Attributes()),
+		DroppedAttributesCount: uint32(s.DroppedAttributes()),
+		DroppedEventsCount:     uint32(s.DroppedEvents()),
+		DroppedLinksCount:      uint32(s.DroppedLinks()),
+		Status:                 &otlppb.Status{Code: statusCode(s.Status().Code), Message: s.Status().Description},
+	}
+	if parentID := s.Parent().SpanID(); parentID.IsValid() {
+		result.ParentSpanId = parentID[:]
+	}
+	for _, event := range s.Events() {
+		result.Events = append(result.Events, &otlppb.Span_Event{
+			Name:                   event.Name,
+			TimeUnixNano:           uint64(event.Time.UnixNano()),
+			Attributes:             keyValues(event.Attributes),
+			DroppedAttributesCount: uint32(event.DroppedAttributeCount),
+		})
+	}
+	for _, link := range s.Links() {
+		traceID, spanID := link.SpanContext.TraceID(), link.SpanContext.SpanID()
+		result.Links = append(result.Links, &otlppb.Span_Link{
+			TraceId:                traceID[:],
+			SpanId:                 spanID[:],
+			TraceState:             link.SpanContext.TraceState().String(),
+			Attributes:             keyValues(link.Attributes),
+			DroppedAttributesCount: uint32(link.DroppedAttributeCount),
+		})
+	}
+	return result
+}
+
+// spanKind returns the OTLP kind of a span
+func spanKind(kind trace.SpanKind) otlppb.Span_SpanKind {
+	switch kind {
+	case trace.SpanKindInternal:
+		return otlppb.Span_SPAN_KIND_INTERNAL
+	case trace.SpanKindServer:
+		return otlppb.Span_SPAN_KIND_SERVER
+	case trace.SpanKindClient:
+		return otlppb.Span_SPAN_KIND_CLIENT
+	case trace.SpanKindProducer:
+		return otlppb.Span_SPAN_KIND_PRODUCER
+	case trace.SpanKindConsumer:
+		return otlppb.Span_SPAN_KIND_CONSUMER
+	default:
+		return otlppb.Span_SPAN_KIND_UNSPECIFIED
+	}
+}
+
+// statusCode returns the OTLP status code of a span
+func statusCode(code codes.Code) otlppb.Status_StatusCode {
+	switch code {
+	case codes.Ok:
+		return otlppb.Status_STATUS_CODE_OK
+	case codes.Error:
+		return otlppb.Status_STATUS_CODE_ERROR
+	default:
+		return otlppb.Status_STATUS_CODE_UNSET
+	}
+}
+
+// keyValues returns the OTLP attributes of attrs
+func keyValues(attrs []attribute.KeyValue) []*otlppb.KeyValue {
+	var result []*otlppb.KeyValue
+	for _, kv := range attrs {
+		result = append(result, &otlppb.KeyValue{Key: string(kv.Key), Value: anyValue(kv.Value)})
+	}
+	return result
+}
+
+// anyValue returns the OTLP value of an attribute
+func anyValue(v attribute.Value) *otlppb.AnyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		return &otlppb.AnyValue{Value: &otlppb.AnyValue_BoolValue{BoolValue: v.AsBool()}}
+	case attribute.INT64:
+		return &otlppb.AnyValue{Value: &otlppb.AnyValue_IntValue{IntValue: v.AsInt64()}}
+	case attribute.FLOAT64:
+		return &otlppb.AnyValue{Value: &otlppb.AnyValue_DoubleValue{DoubleValue: v.AsFloat64()}}
+	case attribute.STRING:
+		return &otlppb.AnyValue{Value: &otlppb.AnyValue_StringValue{StringValue: v.AsString()}}
+	}
+	var values []*otlppb.AnyValue
+	switch v.Type() {
+	case attribute.BOOLSLICE:
+		for _, b := range v.AsBoolSlice() {
+			values = append(values, anyValue(attribute.BoolValue(b)))
+		}
+	case attribute.INT64SLICE:
+		for _, i := range v.AsInt64Slice() {
+			values = append(values, anyValue(attribute.Int64Value(i)))
+		}
+	case attribute.FLOAT64SLICE:
+		for _, f := range v.AsFloat64Slice() {
+			values = append(values, anyValue(attribute.Float64Value(f)))
+		}
+	case attribute.STRINGSLICE:
+		for _, s := range v.AsStringSlice() {
+			values = append(values, anyValue(attribute.StringValue(s)))
+		}
+	default:
+		return &otlppb.AnyValue{Value: &otlppb.AnyValue_StringValue{StringValue: "INVALID"}}
+	}
+	return &otlppb.AnyValue{Value: &otlppb.AnyValue_ArrayValue{ArrayValue: &otlppb.ArrayValue{Values: values}}}
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 
 	lutilconf "github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 )
 
 // Data will have the configuration data from config file
@@ -30,16 +31,20 @@ var Data configModel
 
 // configModel is for holding all the run time configurations for the svc-redfish-plugin
 type configModel struct {
-	FirmwareVersion         string            `json:"FirmwareVersion"` //FirmwareVersion of plugin of the plugin
-	RootServiceUUID         string            `json:"RootServiceUUID"`
-	SessionTimeoutInMinutes float64           `json:"SessionTimeoutInMinutes"` //plugin token time out in minutes
-	PluginConf              *PluginConf       `json:"PluginConf"`
-	LoadBalancerConf        *LoadBalancerConf `json:"LoadBalancerConf"`
-	EventConf               *EventConf        `json:"EventConf"`
-	MessageBusConf          *MessageBusConf   `json:"MessageBusConf"`
-	KeyCertConf             *KeyCertConf      `json:"KeyCertConf"`
-	URLTranslation          *URLTranslation   `json:"URLTranslation"`
-	TLSConf                 *TLSConf          `json:"TLSConf"`
+	FirmwareVersion         string             `json:"FirmwareVersion"` //FirmwareVersion of plugin of the plugin
+	RootServiceUUID         string             `json:"RootServiceUUID"`
+	SessionTimeoutInMinutes float64            `json:"SessionTimeoutInMinutes"` //plugin token time out in minutes
+	PluginConf              *PluginConf        `json:"PluginConf"`
+	LoadBalancerConf        *LoadBalancerConf  `json:"LoadBalancerConf"`
+	EventConf               *EventConf         `json:"EventConf"`
+	MessageBusConf          *MessageBusConf    `json:"MessageBusConf"`
+	KeyCertConf             *KeyCertConf       `json:"KeyCertConf"`
+	URLTranslation          *URLTranslation    `json:"URLTranslation"`
+	TLSConf                 *TLSConf           `json:"TLSConf"`
+	DeviceSessionConf       *DeviceSessionConf `json:"DeviceSessionConf"`
+	ConsoleConf             *ConsoleConf       `json:"ConsoleConf"`
+	PassThroughConf         *PassThroughConf   `json:"PassThroughConf"`
+	LogConf                 *LogConf           `json:"LogConf"`
 }
 
 //PluginConf is for holding all the plugin related configurations
@@ -95,6 +100,43 @@ type TLSConf struct {
 	PreferredCipherSuites []string `json:"PreferredCipherSuites"`
 }
 
+// DeviceSessionConf holds the configuration of the sessions and connections opened with the devices
+type DeviceSessionConf struct {
+	AuthType                    string  `json:"AuthType"`                    // Session or BasicAuth
+	IdleSessionTimeoutInMinutes float64 `json:"IdleSessionTimeoutInMinutes"` // idle time after which a session is deleted
+	MaxConcurrentRequests       int     `json:"MaxConcurrentRequests"`       // maximum number of concurrent requests per device
+}
+
+// LogConf holds the configuration of the logging of the plugin
+type LogConf struct {
+	Level  string `json:"Level"`  // level of the entries written, changed when the config file is modified
+	Format string `json:"Format"` // format of the entries, one of JSON or Syslog
+}
+
+// PassThroughConf holds the configuration of the forwarding to the devices of the requests
+// on the resources which have no dedicated route
+type PassThroughConf struct {
+	Enabled bool `json:"Enabled"` // registers the routes forwarding the requests, read when the plugin starts
+}
+
+// ConsoleConf holds the configuration of the console sessions brokered with the devices
+type ConsoleConf struct {
+	SSHPort              int    `json:"SSHPort"`              // port of the SSH server of the devices, for the serial console
+	SerialConsoleCommand string `json:"SerialConsoleCommand"` // command starting the serial console once logged in, the serial console sessions are refused when empty
+	VNCPort              int    `json:"VNCPort"`              // port of the VNC server of the devices, for the graphical console
+	TokenValidityInSecs  int    `json:"TokenValidityInSecs"`  // time within which a console session must be connected
+	KnownHostsFile       string `json:"KnownHostsFile"`       // known_hosts file the SSH host keys of the devices are verified against
+	InsecureHostKeys     bool   `json:"InsecureHostKeys"`     // accepts any SSH host key when no known hosts file is set
+}
+
+// Authentication types used with the devices
+const (
+	// SessionAuth is for authenticating with a Redfish session reused across the requests to a device
+	SessionAuth = "Session"
+	// BasicAuth is for authenticating each request to a device with its credentials
+	BasicAuth = "BasicAuth"
+)
+
 // SetConfiguration will extract the config data from file
 func SetConfiguration() error {
 	configFilePath := os.Getenv("PLUGIN_CONFIG_FILE_PATH")
@@ -144,11 +186,94 @@ func ValidateConfiguration() error {
 	if err := checkTLSConf(); err != nil {
 		return err
 	}
+	if err := checkDeviceSessionConf(); err != nil {
+		return err
+	}
+	if err := checkLogConf(); err != nil {
+		return err
+	}
+	checkConsoleConf()
+	checkPassThroughConf()
 	checkLBConf()
 	checkURLTranslationConf()
 	return nil
 }
 
+//Check or apply default values for the sessions opened with the devices
+func checkDeviceSessionConf() error {
+	if Data.DeviceSessionConf == nil {
+		log.Warn("No value set for DeviceSessionConf, setting default value")
+		Data.DeviceSessionConf = &DeviceSessionConf{}
+	}
+	switch Data.DeviceSessionConf.AuthType {
+	case "":
+		log.Warn("No value set for AuthType, setting default value")
+		Data.DeviceSessionConf.AuthType = SessionAuth
+	case SessionAuth, BasicAuth:
+	default:
+		return fmt.Errorf("Invalid value %v set for AuthType, supported values are %v and %v", Data.DeviceSessionConf.AuthType, SessionAuth, BasicAuth)
+	}
+	if Data.DeviceSessionConf.IdleSessionTimeoutInMinutes <= 0 {
+		log.Warn("No value set for IdleSessionTimeoutInMinutes, setting default value")
+		Data.DeviceSessionConf.IdleSessionTimeoutInMinutes = 5
+	}
+	if Data.DeviceSessionConf.MaxConcurrentRequests <= 0 {
+		log.Warn("No value set for MaxConcurrentRequests, setting default value")
+		Data.DeviceSessionConf.MaxConcurrentRequests = 8
+	}
+	return nil
+}
+
+//Check or apply default values for the console sessions brokered with the devices
+//Check or apply default values for the logging of the plugin
+func checkLogConf() error {
+	if Data.LogConf == nil {
+		log.Warn("No value set for LogConf, setting default value")
+		Data.LogConf = &LogConf{}
+	}
+	if Data.LogConf.Level == "" {
+		Data.LogConf.Level = logs.DefaultLevel
+	}
+	if Data.LogConf.Format == "" {
+		Data.LogConf.Format = logs.JSONFormat
+	}
+	if _, err := log.ParseLevel(Data.LogConf.Level); err != nil {
+		return fmt.Errorf("Invalid value %v set for log Level: %v", Data.LogConf.Level, err)
+	}
+	return logs.ValidateFormat(Data.LogConf.Format)
+}
+
+func checkPassThroughConf() {
+	if Data.PassThroughConf == nil {
+		log.Warn("No value set for PassThroughConf, setting default value")
+		Data.PassThroughConf = &PassThroughConf{}
+	}
+}
+
+func checkConsoleConf() {
+	if Data.ConsoleConf == nil {
+		log.Warn("No value set for ConsoleConf, setting default value")
+		Data.ConsoleConf = &ConsoleConf{}
+	}
+	if Data.ConsoleConf.SSHPort <= 0 {
+		log.Warn("No value set for SSHPort, setting default value")
+		Data.ConsoleConf.SSHPort = 22
+	}
+	if Data.ConsoleConf.VNCPort <= 0 {
+		log.Warn("No value set for VNCPort, setting default value")
+		Data.ConsoleConf.VNCPort = 5900
+	}
+	if Data.ConsoleConf.TokenValidityInSecs <= 0 {
+		log.Warn("No value set for TokenValidityInSecs, setting default value")
+		Data.ConsoleConf.TokenValidityInSecs = 60
+	}
+	if Data.ConsoleConf.KnownHostsFile == "" && Data.ConsoleConf.InsecureHostKeys {
+		log.Warn("No value set for KnownHostsFile and InsecureHostKeys set, the SSH host keys of the devices will not be verified")
+	} else if Data.ConsoleConf.KnownHostsFile == "" {
+		log.Warn("No value set for KnownHostsFile, the serial consoles are not available")
+	}
+}
+
 func checkPluginConf() error {
 	if Data.PluginConf == nil {
 		return fmt.Errorf("No value found for PluginConf")
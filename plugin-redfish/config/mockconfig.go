@@ -205,6 +205,20 @@ func SetUpMockConfig(t *testing.T) error {
 			"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
 		},
 	}
+	Data.DeviceSessionConf = &DeviceSessionConf{
+		AuthType:                    SessionAuth,
+		IdleSessionTimeoutInMinutes: 5,
+		MaxConcurrentRequests:       8,
+	}
+	Data.LogConf = &LogConf{
+		Level:  "info",
+		Format: "JSON",
+	}
+	Data.ConsoleConf = &ConsoleConf{
+		SSHPort:             22,
+		VNCPort:             5900,
+		TokenValidityInSecs: 60,
+	}
 	lutilconf.SetVerifyPeer(Data.TLSConf.VerifyPeer)
 	lutilconf.SetTLSMinVersion(Data.TLSConf.MinVersion)
 	lutilconf.SetTLSMaxVersion(Data.TLSConf.MaxVersion)
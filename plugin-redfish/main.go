@@ -22,6 +22,9 @@ import (
 	dc "github.com/ODIM-Project/ODIM/lib-messagebus/datacommunicator"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	lutilconf "github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfphandler"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmessagebus"
@@ -39,7 +42,8 @@ type TokenObject struct {
 	PublicKey []byte `json:"publicKey"`
 }
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
 	// verifying the uid of the user
@@ -51,9 +55,19 @@ func main() {
 		log.Fatal("While reading from config, got: " + err.Error())
 	}
 
+	if err := logs.Init(config.Data.PluginConf.ID, config.Data.LogConf.Format, config.Data.LogConf.Level); err != nil {
+		log.Fatal("While initializing logging, got: " + err.Error())
+	}
+
+	if err := tracing.Init(config.Data.PluginConf.ID); err != nil {
+		log.Fatal("While initializing tracing, got: " + err.Error())
+	}
+
 	if err := dc.SetConfiguration(config.Data.MessageBusConf.MessageQueueConfigFilePath); err != nil {
 		log.Fatal("While trying to set messagebus configuration, got: " + err.Error())
 	}
+	// the events not yet published are flushed when the plugin stops
+	defer dc.CloseProducers()
 
 	// CreateJobQueue defines the queue which will act as an infinite buffer
 	// In channel is an entry or input channel and the Out channel is an exit or output channel
@@ -104,6 +118,8 @@ func routers() *iris.Application {
 		}
 		next(w, r)
 	})
+	app.UseGlobal(tracing.IrisMiddleware, rfpmiddleware.PluginID, metrics.IrisMiddleware)
+	app.Get("/metrics", iris.FromStd(metrics.Handler()))
 
 	pluginRoutes := app.Party("/ODIM/v1")
 	{
@@ -154,6 +170,11 @@ func routers() *iris.Application {
 		biosParty.Get("/Settings", rfphandler.GetResource)
 		biosParty.Patch("/Settings", rfphandler.ChangeSettings)
 
+		// requests on the resources without a route are forwarded to the device
+		if config.Data.PassThroughConf.Enabled {
+			systems.Any("/{id}/{resource:path}", rfphandler.PassThrough)
+		}
+
 		chassis := pluginRoutes.Party("/Chassis")
 		chassis.Get("", rfphandler.GetResource)
 		chassis.Get("/{id}", rfphandler.GetResource)
@@ -190,6 +211,10 @@ func routers() *iris.Application {
 		chassisThermal.Get("#Fans/{id1}", rfphandler.GetResource)
 		chassisThermal.Get("#Temperatures/{id1}", rfphandler.GetResource)
 
+		if config.Data.PassThroughConf.Enabled {
+			chassis.Any("/{id}/{resource:path}", rfpmiddleware.BasicAuth, rfphandler.PassThrough)
+		}
+
 		// Manager routers
 		managers := pluginRoutes.Party("/Managers", rfpmiddleware.BasicAuth)
 		managers.Get("", rfphandler.GetManagersCollection)
@@ -204,11 +229,21 @@ func routers() *iris.Application {
 		managers.Get("/{id}/SerialInterface/{rid}", rfphandler.GetResource)
 		managers.Get("/{id}/VirtualMedia", rfphandler.GetResource)
 		managers.Get("/{id}/VirtualMedia/{rid}", rfphandler.GetResource)
+		// the manager actions and the virtual media updates are forwarded to the device as they are
+		managers.Post("/{id}/Actions/Manager.Reset", rfphandler.PassThrough)
+		managers.Post("/{id}/Actions/Manager.ResetToDefaults", rfphandler.PassThrough)
+		managers.Patch("/{id}/VirtualMedia/{rid}", rfphandler.PassThrough)
+		managers.Post("/{id}/VirtualMedia/{rid}/Actions/VirtualMedia.InsertMedia", rfphandler.PassThrough)
+		managers.Post("/{id}/VirtualMedia/{rid}/Actions/VirtualMedia.EjectMedia", rfphandler.PassThrough)
+		managers.Post("/{id}/Oem/ODIM/ConsoleSessions", rfphandler.CreateConsoleSession)
 		managers.Get("/{id}/LogServices", rfphandler.GetResource)
 		managers.Get("/{id}/LogServices/{rid}", rfphandler.GetResource)
 		managers.Get("/{id}/LogServices/{rid}/Entries", rfphandler.GetResource)
 		managers.Get("/{id}/LogServices/{rid}/Entries/{rid2}", rfphandler.GetResource)
 		managers.Post("/{id}/LogServices/{rid}/Actions/LogService.ClearLog", rfphandler.GetResource)
+		if config.Data.PassThroughConf.Enabled {
+			managers.Any("/{id}/{resource:path}", rfphandler.PassThrough)
+		}
 
 		//Registries routers
 		registries := pluginRoutes.Party("/Registries", rfpmiddleware.BasicAuth)
@@ -230,14 +265,25 @@ func routers() *iris.Application {
 		update.Get("/SoftwareInventory", rfphandler.GetResource)
 		update.Get("/SoftwareInventory/{id}", rfphandler.GetResource)
 
+		// Routes related to Certificate service, the actions are forwarded to the device as they are
+		certificateService := pluginRoutes.Party("/CertificateService", rfpmiddleware.BasicAuth)
+		certificateService.Get("", rfphandler.GetResource)
+		certificateService.Get("/CertificateLocations", rfphandler.GetResource)
+		certificateService.Post("/Actions/CertificateService.GenerateCSR", rfphandler.PassThrough)
+		certificateService.Post("/Actions/CertificateService.ReplaceCertificate", rfphandler.PassThrough)
+
 		//Adding routes related to telemetry service
 		telemetry := pluginRoutes.Party("/TelemetryService", rfpmiddleware.BasicAuth)
 		telemetry.Get("/MetricDefinitions", rfphandler.GetResource)
 		telemetry.Get("/MetricReportDefinitions", rfphandler.GetResource)
 		telemetry.Get("/MetricReports", rfphandler.GetResource)
 		telemetry.Get("/Triggers", rfphandler.GetResource)
+
+		// the console is authorized by the token of the console session, which is used only once
+		pluginRoutes.Get("/Console/{token}", rfphandler.ConnectConsole)
 	}
 	pluginRoutes.Get("/Status", rfphandler.GetPluginStatus)
+	pluginRoutes.Get("/EndpointCertificates", rfpmiddleware.BasicAuth, rfphandler.GetEndpointCertificates)
 	pluginRoutes.Post("/Startup", rfpmiddleware.BasicAuth, rfphandler.GetPluginStartup)
 	return app
 }
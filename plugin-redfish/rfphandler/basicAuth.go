@@ -16,11 +16,11 @@
 package rfphandler
 
 import (
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 	"time"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpresponse"
@@ -48,7 +48,7 @@ func Validate(ctx iris.Context) {
 	//Get token from Request
 	if ctx.GetHeader("X-Auth-Token") == "" && ctx.GetHeader("Authorization") == "" {
 		ctx.StatusCode(http.StatusUnauthorized)
-		log.Error("No valid authorization")
+		logs.Log(ctx.Request().Context()).Error("No valid authorization")
 		ctx.WriteString("No valid authorization")
 		return
 	}
@@ -57,7 +57,7 @@ func Validate(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -67,7 +67,7 @@ func Validate(ctx iris.Context) {
 	//Get device details from request
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
-		log.Error("Unable to collect data from request: " + err.Error())
+		logs.Log(ctx.Request().Context()).Error("Unable to collect data from request: " + err.Error())
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString("Unable to collect data from request: " + err.Error())
 		return
@@ -78,9 +78,9 @@ func Validate(ctx iris.Context) {
 		Password: string(deviceDetails.Password),
 	}
 
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		rfpresponse.SetErrorResponse(ctx, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -88,33 +88,33 @@ func Validate(ctx iris.Context) {
 	//Get ServiceRoot of the device
 	err = redfishClient.GetRootService(device)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		rfpresponse.SetErrorResponse(ctx, err.Error(), http.StatusBadRequest)
 		return
 	}
 	//Doing Get on device using basic Authentication
 	resp, err := redfishClient.BasicAuthWithDevice(device, device.RootNode.Systems.Oid)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		rfpresponse.SetErrorResponse(ctx, err.Error(), http.StatusBadRequest)
 		return
 	}
+	defer resp.Body.Close()
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		rfpresponse.SetErrorResponse(ctx, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	defer resp.Body.Close()
 	if resp.StatusCode == http.StatusUnauthorized {
 		ctx.StatusCode(resp.StatusCode)
 		ctx.JSON(string(body))
 		return
 	}
 	if resp.StatusCode >= 300 {
-		log.Error("Could not retrieve ComputerSystems for " + device.Host + " :" + string(body))
+		logs.Log(ctx.Request().Context()).Error("Could not retrieve ComputerSystems for " + device.Host + " :" + string(body))
 	}
 
 	response := rfpresponse.Device{
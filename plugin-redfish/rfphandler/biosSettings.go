@@ -16,10 +16,10 @@
 package rfphandler
 
 import (
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
@@ -48,7 +48,7 @@ func ChangeSettings(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -61,7 +61,7 @@ func ChangeSettings(ctx iris.Context) {
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
 		errorMessage := "Unable to collect data from request: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errorMessage)
 		return
@@ -73,10 +73,10 @@ func ChangeSettings(ctx iris.Context) {
 		PostBody: deviceDetails.PostBody,
 	}
 
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -84,7 +84,7 @@ func ChangeSettings(ctx iris.Context) {
 	resp, err := redfishClient.DeviceCall(device, uri, http.MethodPatch)
 	if err != nil {
 		errorMessage := "While trying to change bios settings, got: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		if resp == nil {
 			ctx.StatusCode(http.StatusInternalServerError)
 			ctx.WriteString(errorMessage)
@@ -95,7 +95,7 @@ func ChangeSettings(ctx iris.Context) {
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		body = []byte("While trying to change bios settings, got: " + err.Error())
-		log.Error(string(body))
+		logs.Log(ctx.Request().Context()).Error(string(body))
 	}
 	ctx.StatusCode(resp.StatusCode)
 	ctx.Write(body)
@@ -16,11 +16,11 @@
 package rfphandler
 
 import (
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
 	iris "github.com/kataras/iris/v12"
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -35,7 +35,7 @@ func SetDefaultBootOrder(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -52,7 +52,7 @@ func SetDefaultBootOrder(ctx iris.Context) {
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
 		errorMessage := "Unable to collect data from request: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errorMessage)
 		return
@@ -64,10 +64,10 @@ func SetDefaultBootOrder(ctx iris.Context) {
 		Password: string(deviceDetails.Password),
 	}
 
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -77,7 +77,7 @@ func SetDefaultBootOrder(ctx iris.Context) {
 	resp, err := redfishClient.SetDefaultBootOrder(device, uri)
 	if err != nil {
 		errorMessage := "While trying to set default boot order, got: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		if resp == nil {
 			ctx.StatusCode(http.StatusInternalServerError)
 			ctx.WriteString(errorMessage)
@@ -88,7 +88,7 @@ func SetDefaultBootOrder(ctx iris.Context) {
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		body = []byte("While trying to set default boot order, got: " + err.Error())
-		log.Error(string(body))
+		logs.Log(ctx.Request().Context()).Error(string(body))
 	}
 
 	ctx.StatusCode(resp.StatusCode)
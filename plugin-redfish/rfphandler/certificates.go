@@ -0,0 +1,29 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfphandler
+
+import (
+	"net/http"
+
+	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
+	iris "github.com/kataras/iris/v12"
+)
+
+// GetEndpointCertificates returns the certificate chains presented by the devices on the
+// last connections to them, odimra polls them for monitoring the expiry of the certificates
+func GetEndpointCertificates(ctx iris.Context) {
+	ctx.StatusCode(http.StatusOK)
+	ctx.JSON(rfputilities.DeviceCertificates())
+}
@@ -0,0 +1,211 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfphandler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
+	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
+	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
+	"github.com/gorilla/websocket"
+	iris "github.com/kataras/iris/v12"
+)
+
+// consoleSession is a console session created for a device, waiting to be connected
+type consoleSession struct {
+	device      *rfputilities.RedfishDevice
+	consoleType string
+	expiresAt   time.Time
+}
+
+// consoleRequest is the request body of the creation of a console session
+type consoleRequest struct {
+	ConsoleType string `json:"ConsoleType"`
+}
+
+var (
+	consoleSessionsLock sync.Mutex
+	// consoleSessions are the console sessions created, keyed by their token
+	consoleSessions = make(map[string]*consoleSession)
+
+	// dialConsole opens the console of the device, it is replaced in the unit tests
+	dialConsole = rfputilities.DialConsole
+
+	consoleUpgrader = websocket.Upgrader{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 4096,
+		// the console is only reached by the API service, which gives the token to the clients
+		CheckOrigin: func(r *http.Request) bool { return true },
+	}
+)
+
+// CreateConsoleSession creates a short-lived console session with the device, the returned token
+// is used once to connect to the console within the validity configured in ConsoleConf
+func CreateConsoleSession(ctx iris.Context) {
+	//Get token from Request
+	token := ctx.GetHeader("X-Auth-Token")
+	//Validating the token
+	if token != "" {
+		flag := TokenValidation(token)
+		if !flag {
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
+			ctx.StatusCode(http.StatusUnauthorized)
+			ctx.WriteString("Invalid/Expired X-Auth-Token")
+			return
+		}
+	}
+
+	var deviceDetails rfpmodel.Device
+	//Get device details from request
+	err := ctx.ReadJSON(&deviceDetails)
+	if err != nil {
+		errMsg := "Unable to collect data from request: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errMsg)
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.WriteString(errMsg)
+		return
+	}
+	var request consoleRequest
+	json.Unmarshal(deviceDetails.PostBody, &request)
+	if request.ConsoleType != rfputilities.SerialConsole && request.ConsoleType != rfputilities.GraphicalConsole {
+		errMsg := "ConsoleType must be " + rfputilities.SerialConsole + " or " + rfputilities.GraphicalConsole
+		logs.Log(ctx.Request().Context()).Error(errMsg)
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.WriteString(errMsg)
+		return
+	}
+
+	sessionToken, err := newConsoleToken()
+	if err != nil {
+		errMsg := "While trying to create the console session token, got: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errMsg)
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.WriteString(errMsg)
+		return
+	}
+	session := &consoleSession{
+		device: &rfputilities.RedfishDevice{
+			Host:     deviceDetails.Host,
+			Username: deviceDetails.Username,
+			Password: string(deviceDetails.Password),
+		},
+		consoleType: request.ConsoleType,
+		expiresAt:   time.Now().Add(time.Duration(pluginConfig.Data.ConsoleConf.TokenValidityInSecs) * time.Second),
+	}
+	consoleSessionsLock.Lock()
+	for key, value := range consoleSessions {
+		if time.Now().After(value.expiresAt) {
+			delete(consoleSessions, key)
+		}
+	}
+	consoleSessions[sessionToken] = session
+	consoleSessionsLock.Unlock()
+
+	logs.Log(ctx.Request().Context()).Info("Created a " + request.ConsoleType + " session with " + deviceDetails.Host)
+	ctx.StatusCode(http.StatusCreated)
+	ctx.JSON(map[string]interface{}{
+		"Token":     sessionToken,
+		"ExpiresAt": session.expiresAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// ConnectConsole upgrades the request to a WebSocket and relays it with the console of the device,
+// the token of the console session authorizes the request and can only be used once
+func ConnectConsole(ctx iris.Context) {
+	sessionToken := ctx.Params().Get("token")
+	consoleSessionsLock.Lock()
+	session, ok := consoleSessions[sessionToken]
+	delete(consoleSessions, sessionToken)
+	consoleSessionsLock.Unlock()
+	if !ok || time.Now().After(session.expiresAt) {
+		logs.Log(ctx.Request().Context()).Error("Invalid/Expired console session token")
+		ctx.StatusCode(http.StatusNotFound)
+		ctx.WriteString("Invalid/Expired console session token")
+		return
+	}
+
+	console, err := dialConsole(session.device, session.consoleType)
+	if err != nil {
+		errMsg := "While trying to open the " + session.consoleType + " of " + session.device.Host + ", got: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errMsg)
+		ctx.StatusCode(http.StatusBadGateway)
+		ctx.WriteString(errMsg)
+		return
+	}
+	defer console.Close()
+	conn, err := consoleUpgrader.Upgrade(ctx.ResponseWriter(), ctx.Request(), nil)
+	if err != nil {
+		// the upgrader has already replied to the client
+		logs.Log(ctx.Request().Context()).Error("While trying to upgrade the console connection, got: " + err.Error())
+		return
+	}
+	defer conn.Close()
+
+	logs.Log(ctx.Request().Context()).Info("Connected to the " + session.consoleType + " of " + session.device.Host)
+	relayConsole(conn, console)
+	logs.Log(ctx.Request().Context()).Info("Disconnected from the " + session.consoleType + " of " + session.device.Host)
+}
+
+// relayConsole copies the console to the WebSocket and the WebSocket to the console
+// until either side is closed
+func relayConsole(conn *websocket.Conn, console io.ReadWriteCloser) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		buffer := make([]byte, 4096)
+		for {
+			n, err := console.Read(buffer)
+			if n > 0 {
+				if werr := conn.WriteMessage(websocket.BinaryMessage, buffer[:n]); werr != nil {
+					return
+				}
+			}
+			if err != nil {
+				// closing the connection also stops the reading of the WebSocket
+				conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				conn.Close()
+				return
+			}
+		}
+	}()
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			break
+		}
+		if _, err := console.Write(message); err != nil {
+			break
+		}
+	}
+	console.Close()
+	<-done
+}
+
+// newConsoleToken returns a random token for a console session
+func newConsoleToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
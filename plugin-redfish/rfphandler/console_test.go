@@ -0,0 +1,93 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfphandler
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/plugin-redfish/config"
+	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
+	"github.com/gorilla/websocket"
+	iris "github.com/kataras/iris/v12"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+// mockDialConsole returns a console echoing what is written to it
+func mockDialConsole(device *rfputilities.RedfishDevice, consoleType string) (io.ReadWriteCloser, error) {
+	if device.Host != "localhost:1234" {
+		return nil, fmt.Errorf("connection refused")
+	}
+	console, echo := net.Pipe()
+	go io.Copy(echo, echo)
+	return console, nil
+}
+
+func mockConsoleApp() *iris.Application {
+	mockApp := iris.New()
+	redfishRoutes := mockApp.Party("/ODIM/v1")
+	redfishRoutes.Post("/Managers/{id}/Oem/ODIM/ConsoleSessions", CreateConsoleSession)
+	redfishRoutes.Get("/Console/{token}", ConnectConsole)
+	return mockApp
+}
+
+func TestConsole(t *testing.T) {
+	config.SetUpMockConfig(t)
+	dialConsole = mockDialConsole
+	defer func() {
+		dialConsole = rfputilities.DialConsole
+	}()
+
+	mockApp := mockConsoleApp()
+	e := httptest.New(t, mockApp)
+	requestBody := map[string]interface{}{
+		"ManagerAddress": "localhost:1234",
+		"UserName":       "admin",
+		"Password":       []byte("P@$$w0rd"),
+		"PostBody":       []byte(`{"ConsoleType":"SerialConsole"}`),
+	}
+	sessionToken := e.POST("/ODIM/v1/Managers/1/Oem/ODIM/ConsoleSessions").WithJSON(requestBody).Expect().
+		Status(http.StatusCreated).JSON().Object().Value("Token").String().Raw()
+
+	// Case for a console type which is not supported
+	requestBody["PostBody"] = []byte(`{"ConsoleType":"CommandShell"}`)
+	e.POST("/ODIM/v1/Managers/1/Oem/ODIM/ConsoleSessions").WithJSON(requestBody).Expect().Status(http.StatusBadRequest)
+
+	ts := nethttptest.NewServer(mockApp)
+	defer ts.Close()
+	consoleURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/ODIM/v1/Console/"
+
+	conn, _, err := websocket.DefaultDialer.Dial(consoleURL+sessionToken, nil)
+	if err != nil {
+		t.Fatalf("error while connecting to the console: %v", err)
+	}
+	conn.WriteMessage(websocket.BinaryMessage, []byte("ls\n"))
+	_, message, err := conn.ReadMessage()
+	if err != nil || string(message) != "ls\n" {
+		t.Errorf("console should be relayed, got %q, %v", message, err)
+	}
+	conn.Close()
+
+	// Case for a token which is already used
+	_, resp, err := websocket.DefaultDialer.Dial(consoleURL+sessionToken, nil)
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("console session token should only be used once")
+	}
+}
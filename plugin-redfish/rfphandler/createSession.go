@@ -16,13 +16,14 @@
 package rfphandler
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	iris "github.com/kataras/iris/v12"
 	"github.com/satori/go.uuid"
-	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/sha3"
 	"io/ioutil"
 	"net/http"
@@ -44,24 +45,24 @@ func CreateSession(ctx iris.Context) {
 	rawBodyAsBytes, err := ioutil.ReadAll(ctx.Request().Body)
 	if err != nil {
 		errorMessage := "Unable to validate the credentials: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errorMessage)
 	}
 	err = json.Unmarshal(rawBodyAsBytes, &userCreds)
 	if err != nil {
 		errorMessage := "Unable to unmarshal user details: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errorMessage)
 	}
 	//Validate the credentials
 	userName := userCreds.Username
 	password := userCreds.Password
-	validateResponse := validate(userName, password)
+	validateResponse := validate(ctx.Request().Context(), userName, password)
 	if !validateResponse {
 		errorMessage := "Invalid credentials for session creation"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		ctx.StatusCode(http.StatusUnauthorized)
 		ctx.WriteString(errorMessage)
 		return
@@ -76,7 +77,7 @@ func CreateSession(ctx iris.Context) {
 	ctx.Header("X-Auth-Token", token)
 }
 
-func validate(userName, password string) bool {
+func validate(ctx context.Context, userName, password string) bool {
 	//var err error
 	username := pluginConfig.Data.PluginConf.UserName
 	passwd := pluginConfig.Data.PluginConf.Password
@@ -88,7 +89,7 @@ func validate(userName, password string) bool {
 	hashSum := hash.Sum(nil)
 	hashedPassword := base64.URLEncoding.EncodeToString(hashSum)
 	if passwd != hashedPassword {
-		log.Error("Username/password does not match")
+		logs.Log(ctx).Error("Username/password does not match")
 		return false
 	}
 
@@ -16,11 +16,11 @@
 package rfphandler
 
 import (
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 	"strings"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
@@ -39,7 +39,7 @@ func GetResource(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -52,7 +52,7 @@ func GetResource(ctx iris.Context) {
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
 		errMsg := "Unable to collect data from request: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errMsg)
 		return
@@ -65,10 +65,10 @@ func GetResource(ctx iris.Context) {
 	}
 	//plainText, err := rfputilities.GetPlainText(device.Password)
 	//device.Password = plainText
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -78,7 +78,7 @@ func GetResource(ctx iris.Context) {
 	resp, err := redfishClient.GetWithBasicAuth(device, uri)
 	if err != nil {
 		errMsg := "Authentication failed: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		if resp == nil {
 			ctx.StatusCode(http.StatusInternalServerError)
 			ctx.WriteString(errMsg)
@@ -90,7 +90,7 @@ func GetResource(ctx iris.Context) {
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		errMsg := "While trying to read the response body, got: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -102,7 +102,7 @@ func GetResource(ctx iris.Context) {
 		return
 	}
 	if resp.StatusCode >= 300 {
-		log.Error("Could not retrieve generic resource for" + device.Host + ": \n" + string(body) + ":\n" + uri)
+		logs.Log(ctx.Request().Context()).Error("Could not retrieve generic resource for" + device.Host + ": \n" + string(body) + ":\n" + uri)
 
 	}
 	respData := string(body)
@@ -17,11 +17,11 @@ package rfphandler
 
 import (
 	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
 	iris "github.com/kataras/iris/v12"
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -36,7 +36,7 @@ func GetManagersCollection(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -81,7 +81,7 @@ func GetManagersInfo(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -124,10 +124,10 @@ func getInfoFromDevice(uri string, deviceDetails rfpmodel.Device, ctx iris.Conte
 		Username: deviceDetails.Username,
 		Password: string(deviceDetails.Password),
 	}
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -137,7 +137,7 @@ func getInfoFromDevice(uri string, deviceDetails rfpmodel.Device, ctx iris.Conte
 	resp, err := redfishClient.GetWithBasicAuth(device, uri)
 	if err != nil {
 		errMsg := "Authentication failed: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		if resp == nil {
 			ctx.StatusCode(http.StatusInternalServerError)
 			ctx.WriteString(errMsg)
@@ -149,7 +149,7 @@ func getInfoFromDevice(uri string, deviceDetails rfpmodel.Device, ctx iris.Conte
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		errMsg := "While trying to read the response body, got: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -161,7 +161,7 @@ func getInfoFromDevice(uri string, deviceDetails rfpmodel.Device, ctx iris.Conte
 		return
 	}
 	if resp.StatusCode >= 300 {
-		log.Error("Could not retrieve generic resource for " + device.Host + ": \n" + string(body) + ":\n" + uri)
+		logs.Log(ctx.Request().Context()).Error("Could not retrieve generic resource for " + device.Host + ": \n" + string(body) + ":\n" + uri)
 	}
 	respData := string(body)
 	//replacing the resposne with north bound translation URL
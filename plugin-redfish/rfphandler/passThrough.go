@@ -0,0 +1,118 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfphandler
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
+	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
+	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
+	iris "github.com/kataras/iris/v12"
+)
+
+// PassThrough : Forwards the request on a resource which has no dedicated route to the device
+// with the same method, and returns the response of the device
+func PassThrough(ctx iris.Context) {
+	//Get token from Request
+	token := ctx.GetHeader("X-Auth-Token")
+	uri := ctx.Request().RequestURI
+	//replacing the request url with south bound translation URL
+	for key, value := range pluginConfig.Data.URLTranslation.SouthBoundURL {
+		uri = strings.Replace(uri, key, value, -1)
+	}
+	// the requests are forwarded with the credentials of the device, so they
+	// are refused when the caller didn't authenticate with the plugin
+	if token == "" && ctx.GetHeader("Authorization") == "" {
+		logs.Log(ctx.Request().Context()).Error("No valid authorization")
+		ctx.StatusCode(http.StatusUnauthorized)
+		ctx.WriteString("No valid authorization")
+		return
+	}
+	//Validating the token
+	if token != "" {
+		flag := TokenValidation(token)
+		if !flag {
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
+			ctx.StatusCode(http.StatusUnauthorized)
+			ctx.WriteString("Invalid/Expired X-Auth-Token")
+			return
+		}
+	}
+
+	var deviceDetails rfpmodel.Device
+
+	//Get device details from request
+	err := ctx.ReadJSON(&deviceDetails)
+	if err != nil {
+		errMsg := "Unable to collect data from request: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errMsg)
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.WriteString(errMsg)
+		return
+	}
+	device := &rfputilities.RedfishDevice{
+		Host:     deviceDetails.Host,
+		Username: deviceDetails.Username,
+		Password: string(deviceDetails.Password),
+		PostBody: deviceDetails.PostBody,
+	}
+
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
+	if err != nil {
+		errMsg := "While trying to create the redfish client, got:" + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errMsg)
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.WriteString(errMsg)
+		return
+	}
+	resp, err := redfishClient.DeviceCall(device, uri, ctx.Method())
+	if err != nil {
+		errMsg := "While trying to forward the " + ctx.Method() + " request on " + uri + ", got: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errMsg)
+		if resp == nil {
+			ctx.StatusCode(http.StatusInternalServerError)
+			ctx.WriteString(errMsg)
+			return
+		}
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		errMsg := "While trying to read the response body, got: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errMsg)
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.WriteString(errMsg)
+		return
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		ctx.StatusCode(http.StatusUnauthorized)
+		ctx.WriteString("Authtication with the device failed")
+		return
+	}
+	if resp.StatusCode >= 300 {
+		logs.Log(ctx.Request().Context()).Error("The " + ctx.Method() + " request on " + uri + " failed for " + device.Host + ": \n" + string(body))
+	}
+	respData := string(body)
+	//replacing the resposne with north bound translation URL
+	for key, value := range pluginConfig.Data.URLTranslation.NorthBoundURL {
+		respData = strings.Replace(respData, key, value, -1)
+	}
+	ctx.StatusCode(resp.StatusCode)
+	ctx.Write([]byte(respData))
+}
@@ -17,19 +17,19 @@ package rfphandler
 
 import (
 	"encoding/json"
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 	"reflect"
 	"sync"
 	"time"
 
-	iris "github.com/kataras/iris/v12"
 	//"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpresponse"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
+	iris "github.com/kataras/iris/v12"
 )
 
 // GetPluginStatus defines the GetPluginStatus iris handler.
@@ -41,7 +41,7 @@ func GetPluginStatus(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -80,7 +80,7 @@ func GetPluginStartup(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -91,7 +91,7 @@ func GetPluginStartup(ctx iris.Context) {
 	err := ctx.ReadJSON(&startup)
 	if err != nil {
 		errMsg := "Unable to collect data from request: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errMsg)
 		return
@@ -180,7 +180,8 @@ func checkCreateSub(startup rfpmodel.Startup, startUpResponse chan map[string]st
 				errorCh <- err
 				return
 			}
-			defer resp.Body.Close()
+			// the body is closed before the next request, so that the slot of the device is released
+			resp.Body.Close()
 
 			//Create new Subscription with details in odimra
 			req := rfpmodel.EvtSubPost{
@@ -221,6 +222,7 @@ func checkCreateSub(startup rfpmodel.Startup, startUpResponse chan map[string]st
 		}
 
 		//Subscribe to Events
+		resp.Body.Close()
 		resp, err = redfishClient.SubscribeForEvents(device)
 		if err != nil {
 			errorCh <- err
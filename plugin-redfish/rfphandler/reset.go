@@ -17,11 +17,11 @@ package rfphandler
 
 import (
 	"encoding/json"
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 	"strings"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
 	iris "github.com/kataras/iris/v12"
@@ -38,7 +38,7 @@ func ResetComputerSystem(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -54,7 +54,7 @@ func ResetComputerSystem(ctx iris.Context) {
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
 		errMsg := "Unable to collect data from request: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errMsg)
 		return
@@ -71,10 +71,10 @@ func ResetComputerSystem(ctx iris.Context) {
 	device.PostBody, _ = json.Marshal(rfpmodel.ResetPostRequest{
 		ResetType: resetType,
 	})
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -84,7 +84,7 @@ func ResetComputerSystem(ctx iris.Context) {
 	resp, err := redfishClient.ResetComputerSystem(device, uri)
 	if err != nil {
 		errorMessage := "While trying to reset, got: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		if resp == nil {
 			ctx.WriteString(errorMessage)
 			ctx.StatusCode(http.StatusInternalServerError)
@@ -95,7 +95,7 @@ func ResetComputerSystem(ctx iris.Context) {
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		body = []byte("While trying to read response body, got: " + err.Error())
-		log.Error(string(body))
+		logs.Log(ctx.Request().Context()).Error(string(body))
 	}
 
 	ctx.StatusCode(resp.StatusCode)
@@ -16,11 +16,11 @@
 package rfphandler
 
 import (
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 	"strings"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
@@ -40,7 +40,7 @@ func CreateVolume(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -53,7 +53,7 @@ func CreateVolume(ctx iris.Context) {
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
 		errMsg := "Unable to collect data from request: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errMsg)
 		return
@@ -65,10 +65,10 @@ func CreateVolume(ctx iris.Context) {
 		PostBody: deviceDetails.PostBody,
 	}
 
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -76,7 +76,7 @@ func CreateVolume(ctx iris.Context) {
 	resp, err := redfishClient.DeviceCall(device, uri, http.MethodPost)
 	if err != nil {
 		errorMessage := "While trying to create volume, got:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		if resp == nil {
 			ctx.StatusCode(http.StatusInternalServerError)
 			ctx.WriteString(errorMessage)
@@ -87,7 +87,7 @@ func CreateVolume(ctx iris.Context) {
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		body = []byte("While trying to read response body, got: " + err.Error())
-		log.Error(string(body))
+		logs.Log(ctx.Request().Context()).Error(string(body))
 	}
 	ctx.StatusCode(resp.StatusCode)
 	ctx.Write(body)
@@ -106,7 +106,7 @@ func DeleteVolume(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -119,7 +119,7 @@ func DeleteVolume(ctx iris.Context) {
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
 		errMsg := "Unable to collect data from request: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errMsg)
 		return
@@ -131,10 +131,10 @@ func DeleteVolume(ctx iris.Context) {
 		PostBody: deviceDetails.PostBody,
 	}
 
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -142,7 +142,7 @@ func DeleteVolume(ctx iris.Context) {
 	resp, err := redfishClient.DeviceCall(device, uri, http.MethodDelete)
 	if err != nil {
 		errorMessage := "While trying to delete volume, got:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		if resp == nil {
 			ctx.StatusCode(http.StatusInternalServerError)
 			ctx.WriteString(errorMessage)
@@ -153,7 +153,7 @@ func DeleteVolume(ctx iris.Context) {
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		body = []byte("While trying to read the response body, got: " + err.Error())
-		log.Error(string(body))
+		logs.Log(ctx.Request().Context()).Error(string(body))
 	}
 	ctx.StatusCode(resp.StatusCode)
 	ctx.Write(body)
@@ -16,15 +16,16 @@
 package rfphandler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	evtConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
 	iris "github.com/kataras/iris/v12"
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -38,7 +39,7 @@ func CreateEventSubscription(ctx iris.Context) {
 		return
 	}
 	//First delete existing matching subscription(our subscription) from device
-	deleteMatchingSubscriptions(device)
+	deleteMatchingSubscriptions(ctx.Request().Context(), device)
 
 	var reqPostBody rfpmodel.EvtSubPost
 	var reqData string
@@ -71,10 +72,10 @@ func CreateEventSubscription(ctx iris.Context) {
 		return
 	}
 
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "Internal processing error: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -84,7 +85,7 @@ func CreateEventSubscription(ctx iris.Context) {
 	//Subscribe to Events
 	resp, err = redfishClient.SubscribeForEvents(device)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(err.Error())
 		return
@@ -96,46 +97,46 @@ func CreateEventSubscription(ctx iris.Context) {
 }
 
 // Delete match subscription from device
-func deleteMatchingSubscriptions(device *rfputilities.RedfishDevice) {
+func deleteMatchingSubscriptions(ctx context.Context, device *rfputilities.RedfishDevice) {
 	// get all subscriptions
 	device.Location = "https://" + device.Host + "/redfish/v1/EventService/Subscriptions"
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx)
 	if err != nil {
-		log.Error("While trying to create the redfish client, got:" + err.Error())
+		logs.Log(ctx).Error("While trying to create the redfish client, got:" + err.Error())
 		return
 	}
 
 	//Get Subscription details to check if it is really ours
 	resp, err := redfishClient.GetSubscriptionDetail(device)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		errorMessage := fmt.Sprintf("Unable to get subscription details for URI: %v got %v", device.Location, resp.StatusCode)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return
 	}
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return
 	}
 	var subscriptionCollectionBody interface{}
 	err = json.Unmarshal(body, &subscriptionCollectionBody)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return
 	}
 	members := subscriptionCollectionBody.(map[string]interface{})["Members"]
 	for _, member := range members.([]interface{}) {
 		device.Location = member.(map[string]interface{})["@odata.id"].(string)
 		device.Location = "https://" + device.Host + device.Location
-		if isOurSubscription(device) {
+		if isOurSubscription(ctx, device) {
 			resp, err = redfishClient.DeleteSubscriptionDetail(device)
 			if err != nil {
-				log.Error(err.Error())
+				logs.Log(ctx).Error(err.Error())
 				return
 			}
 			resp.Body.Close()
@@ -143,34 +144,34 @@ func deleteMatchingSubscriptions(device *rfputilities.RedfishDevice) {
 	}
 	return
 }
-func isOurSubscription(device *rfputilities.RedfishDevice) bool {
+func isOurSubscription(ctx context.Context, device *rfputilities.RedfishDevice) bool {
 
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx)
 	if err != nil {
-		log.Error("Internal processing error: " + err.Error())
+		logs.Log(ctx).Error("Internal processing error: " + err.Error())
 		return false
 	}
 	//Get Subscription details to check if it is really ours
 	resp, err := redfishClient.GetSubscriptionDetail(device)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return false
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		errorMessage := fmt.Sprintf("Unable to get subscription details for URI: %v got %v", device.Location, resp.StatusCode)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return false
 	}
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return false
 	}
 	var subscriptionBody interface{}
 	err = json.Unmarshal(body, &subscriptionBody)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return false
 	}
 	subscriptionDestinationFromDevice := subscriptionBody.(map[string]interface{})["Destination"].(string)
@@ -188,10 +189,10 @@ func DeleteEventSubscription(ctx iris.Context) {
 	if err != nil {
 		return
 	}
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -200,7 +201,7 @@ func DeleteEventSubscription(ctx iris.Context) {
 	//Delete Subscription details
 	resp, err := redfishClient.DeleteSubscriptionDetail(device)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(err.Error())
 		return
@@ -221,7 +222,7 @@ func getDeviceDetails(ctx iris.Context) (*rfputilities.RedfishDevice, *rfpmodel.
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return nil, nil, fmt.Errorf("Invalid/Expired X-Auth-Token")
@@ -233,7 +234,7 @@ func getDeviceDetails(ctx iris.Context) (*rfputilities.RedfishDevice, *rfpmodel.
 	//Get device details from request
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
-		log.Error("Unable to collect data from request: " + err.Error())
+		logs.Log(ctx.Request().Context()).Error("Unable to collect data from request: " + err.Error())
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString("Error: bad request.")
 		return nil, nil, err
@@ -248,7 +249,7 @@ func getDeviceDetails(ctx iris.Context) (*rfputilities.RedfishDevice, *rfpmodel.
 	/*
 		plainText, err := descryptDevicePassword(deviceDetails.Password)
 		if err != nil {
-			log.Println("Error while trying decrypt data: ", err)
+			logs.Log(ctx.Request().Context()).Error("Error while trying decrypt data: " + err.Error())
 			ctx.StatusCode(http.StatusInternalServerError)
 			ctx.WriteString("Error while trying to decypt data")
 			return nil, nil, err
@@ -266,24 +267,24 @@ func getDeviceDetails(ctx iris.Context) (*rfputilities.RedfishDevice, *rfpmodel.
 func validateResponse(ctx iris.Context, device *rfputilities.RedfishDevice, resp *http.Response, method string) error {
 	var body []byte
 	var err error
+	defer resp.Body.Close()
 	body, err = ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(err.Error())
 		return err
 	}
-	defer resp.Body.Close()
 	if strings.EqualFold(method, http.MethodPost) {
 		// if there was an error for message ids means device haven't support of MessageIds
 		// So remove the MessageIds from the request and subscribe again.
 		if resp.StatusCode != http.StatusCreated {
 			removeMessageID(ctx, device)
 			// Subscribe to Events
-			redfishClient, err := rfputilities.GetRedfishClient()
+			redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 			if err != nil {
 				errMsg := "While trying to create the redfish client, got:" + err.Error()
-				log.Error(errMsg)
+				logs.Log(ctx.Request().Context()).Error(errMsg)
 				ctx.StatusCode(http.StatusInternalServerError)
 				ctx.WriteString(errMsg)
 				return err
@@ -291,7 +292,7 @@ func validateResponse(ctx iris.Context, device *rfputilities.RedfishDevice, resp
 
 			resp, err = redfishClient.SubscribeForEvents(device)
 			if err != nil {
-				log.Error(err.Error())
+				logs.Log(ctx.Request().Context()).Error(err.Error())
 				ctx.StatusCode(http.StatusInternalServerError)
 				ctx.WriteString(err.Error())
 				return err
@@ -299,7 +300,7 @@ func validateResponse(ctx iris.Context, device *rfputilities.RedfishDevice, resp
 			defer resp.Body.Close()
 			body, err = ioutil.ReadAll(resp.Body)
 			if err != nil {
-				log.Error(err.Error())
+				logs.Log(ctx.Request().Context()).Error(err.Error())
 				ctx.StatusCode(http.StatusInternalServerError)
 				ctx.WriteString(err.Error())
 				return err
@@ -317,7 +318,7 @@ func validateResponse(ctx iris.Context, device *rfputilities.RedfishDevice, resp
 		return errors.New("Authentication with the device failed")
 	}
 	if resp.StatusCode >= 300 {
-		log.Error("Subscription operation failed: \n%s\n\n" + string(body))
+		logs.Log(ctx.Request().Context()).Error("Subscription operation failed: \n%s\n\n" + string(body))
 	}
 	common.SetResponseHeader(ctx, header)
 	ctx.StatusCode(resp.StatusCode)
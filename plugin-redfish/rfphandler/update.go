@@ -16,11 +16,11 @@
 package rfphandler
 
 import (
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	pluginConfig "github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfputilities"
 	iris "github.com/kataras/iris/v12"
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -34,7 +34,7 @@ func SimpleUpdate(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -46,7 +46,7 @@ func SimpleUpdate(ctx iris.Context) {
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
 		errMsg := "Unable to collect data from request: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errMsg)
 		return
@@ -66,10 +66,10 @@ func SimpleUpdate(ctx iris.Context) {
 		PostBody: []byte(reqData),
 	}
 
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -78,7 +78,7 @@ func SimpleUpdate(ctx iris.Context) {
 	resp, err := redfishClient.DeviceCall(device, uri, http.MethodPost)
 	if err != nil {
 		errorMessage := "While trying to update BMC resource, got: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		if resp == nil {
 			ctx.StatusCode(http.StatusInternalServerError)
 			ctx.WriteString(errorMessage)
@@ -89,7 +89,7 @@ func SimpleUpdate(ctx iris.Context) {
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		body = []byte("While trying to read the response body, got: " + err.Error())
-		log.Error(string(body))
+		logs.Log(ctx.Request().Context()).Error(string(body))
 	}
 
 	ctx.StatusCode(resp.StatusCode)
@@ -104,7 +104,7 @@ func StartUpdate(ctx iris.Context) {
 	if token != "" {
 		flag := TokenValidation(token)
 		if !flag {
-			log.Error("Invalid/Expired X-Auth-Token")
+			logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Invalid/Expired X-Auth-Token")
 			return
@@ -116,7 +116,7 @@ func StartUpdate(ctx iris.Context) {
 	err := ctx.ReadJSON(&deviceDetails)
 	if err != nil {
 		errMsg := "Error while trying to collect data from request: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusBadRequest)
 		ctx.WriteString(errMsg)
 		return
@@ -128,10 +128,10 @@ func StartUpdate(ctx iris.Context) {
 		Password: string(deviceDetails.Password),
 	}
 
-	redfishClient, err := rfputilities.GetRedfishClient()
+	redfishClient, err := rfputilities.GetRedfishClientWithContext(ctx.Request().Context())
 	if err != nil {
 		errMsg := "While trying to create the redfish client, got:" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx.Request().Context()).Error(errMsg)
 		ctx.StatusCode(http.StatusInternalServerError)
 		ctx.WriteString(errMsg)
 		return
@@ -140,7 +140,7 @@ func StartUpdate(ctx iris.Context) {
 	resp, err := redfishClient.DeviceCall(device, uri, http.MethodPost)
 	if err != nil {
 		errorMessage := "While trying to update BMC resource, got: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		if resp == nil {
 			ctx.StatusCode(http.StatusInternalServerError)
 			ctx.WriteString(errorMessage)
@@ -151,7 +151,7 @@ func StartUpdate(ctx iris.Context) {
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		body = []byte("While trying to read the response body, got: " + err.Error())
-		log.Error(string(body))
+		logs.Log(ctx.Request().Context()).Error(string(body))
 	}
 
 	ctx.StatusCode(resp.StatusCode)
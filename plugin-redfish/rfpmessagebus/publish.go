@@ -16,6 +16,7 @@
 package rfpmessagebus
 
 import (
+	"context"
 	"encoding/json"
 	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
 	dc "github.com/ODIM-Project/ODIM/lib-messagebus/datacommunicator"
@@ -25,7 +26,8 @@ import (
 )
 
 // Publish function will handle events request in two originofcondition format
-// originofcondition can be with or without @odata.id
+// originofcondition can be with or without @odata.id, it returns once the event is queued
+// and the failure to publish it is logged
 func Publish(data interface{}) bool {
 	if data == nil {
 		log.Error("Nil data passed to event publisher")
@@ -33,16 +35,11 @@ func Publish(data interface{}) bool {
 	}
 	event := data.(common.Events)
 
-	K, err := dc.Communicator(dc.KAFKA, config.Data.MessageBusConf.MessageQueueConfigFilePath)
-	if err != nil {
-		log.Error("Unable communicate with kafka, got:" + err.Error())
-		return false
-	}
-	defer K.Close()
+	K := dc.GetProducer(dc.KAFKA, config.Data.MessageBusConf.MessageQueueConfigFilePath)
 	// Since we are deleting the first event from the eventlist,
 	// processing the first event
 	var message common.MessageData
-	err = json.Unmarshal(event.Request, &message)
+	err := json.Unmarshal(event.Request, &message)
 	if err != nil {
 		var messageData dmtf.Event
 		if err := json.Unmarshal(event.Request, &messageData); err != nil {
@@ -73,13 +70,15 @@ func Publish(data interface{}) bool {
 		event.Request, _ = json.Marshal(message)
 	}
 	topic := config.Data.MessageBusConf.EmbQueue[0]
-	if err := K.Distribute(topic, event); err != nil {
-		log.Error("Unable Publish events to kafka, got:" + err.Error())
-		return false
-	}
-	log.Info("forwarded event" + string(event.Request))
-	for _, eventMessage := range message.Events {
-		log.Info(eventMessage.EventType + " Event published")
-	}
+	K.DistributeAsync(context.Background(), topic, event, func(err error) {
+		if err != nil {
+			log.Error("Unable Publish events to kafka, got:" + err.Error())
+			return
+		}
+		log.Info("forwarded event" + string(event.Request))
+		for _, eventMessage := range message.Events {
+			log.Info(eventMessage.EventType + " Event published")
+		}
+	})
 	return true
 }
@@ -17,9 +17,9 @@ package rfpmiddleware
 
 import (
 	"encoding/base64"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	iris "github.com/kataras/iris/v12"
-	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/sha3"
 
 	"net/http"
@@ -36,14 +36,14 @@ func BasicAuth(ctx iris.Context) {
 			spl := strings.Split(basicAuth, " ")
 			data, err := base64.StdEncoding.DecodeString(spl[1])
 			if err != nil {
-				log.Error(err.Error())
+				logs.Log(ctx.Request().Context()).Error(err.Error())
 				ctx.StatusCode(http.StatusInternalServerError)
 				ctx.WriteString(err.Error())
 				return
 			}
 			userCred := strings.SplitN(string(data), ":", 2)
 			if len(userCred) < 2 {
-				log.Error("Not a valid basic auth")
+				logs.Log(ctx.Request().Context()).Error("Not a valid basic auth")
 				ctx.StatusCode(http.StatusUnauthorized)
 				ctx.WriteString("Not a valid basic auth")
 				return
@@ -51,7 +51,7 @@ func BasicAuth(ctx iris.Context) {
 			username = userCred[0]
 			password = userCred[1]
 		} else {
-			log.Error("Not a valid basic auth")
+			logs.Log(ctx.Request().Context()).Error("Not a valid basic auth")
 			ctx.StatusCode(http.StatusUnauthorized)
 			ctx.WriteString("Not a valid basic auth")
 			return
@@ -0,0 +1,57 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfputilities
+
+import (
+	"crypto/tls"
+	"sort"
+	"sync"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+)
+
+// deviceCertificates holds the certificate chains presented by the devices on the last
+// connections to them, which are reported to odimra for monitoring their expiry
+var deviceCertificates = struct {
+	lock         sync.Mutex
+	certificates map[string]common.EndpointCertificate
+}{
+	certificates: make(map[string]common.EndpointCertificate),
+}
+
+// recordDeviceCertificate records the certificate chain presented by a device on a TLS connection
+func recordDeviceCertificate(host string, state *tls.ConnectionState) {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return
+	}
+	certificate := common.NewEndpointCertificate(common.BMCEndpoint, host, state.PeerCertificates)
+	deviceCertificates.lock.Lock()
+	deviceCertificates.certificates[host] = certificate
+	deviceCertificates.lock.Unlock()
+}
+
+// DeviceCertificates returns the certificate chains presented by the devices, sorted by device
+func DeviceCertificates() []common.EndpointCertificate {
+	deviceCertificates.lock.Lock()
+	certificates := make([]common.EndpointCertificate, 0, len(deviceCertificates.certificates))
+	for _, certificate := range deviceCertificates.certificates {
+		certificates = append(certificates, certificate)
+	}
+	deviceCertificates.lock.Unlock()
+	sort.Slice(certificates, func(i, j int) bool {
+		return certificates[i].Endpoint < certificates[j].Endpoint
+	})
+	return certificates
+}
@@ -0,0 +1,47 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfputilities
+
+import (
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+)
+
+func TestDeviceCertificates(t *testing.T) {
+	server, device := startMockDevice(t, &mockDevice{})
+	defer server.Close()
+	client, err := GetRedfishClient()
+	if err != nil {
+		t.Fatalf("GetRedfishClient() unexpected error = %v", err)
+	}
+	getResource(t, client, device)
+
+	var recorded *common.EndpointCertificate
+	for _, certificate := range DeviceCertificates() {
+		if certificate.Endpoint == device.Host {
+			recorded = &certificate
+		}
+	}
+	if recorded == nil {
+		t.Fatalf("DeviceCertificates() didn't return the certificate of %s", device.Host)
+	}
+	if recorded.EndpointType != common.BMCEndpoint {
+		t.Errorf("DeviceCertificates() EndpointType = %v, want %v", recorded.EndpointType, common.BMCEndpoint)
+	}
+	if !recorded.ValidNotAfter.Equal(server.Certificate().NotAfter) {
+		t.Errorf("DeviceCertificates() ValidNotAfter = %v, want %v", recorded.ValidNotAfter, server.Certificate().NotAfter)
+	}
+}
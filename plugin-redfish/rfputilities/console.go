@@ -0,0 +1,151 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfputilities
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/plugin-redfish/config"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// Console types which can be brokered with the devices
+const (
+	// SerialConsole is the serial console of the system, reached with SSH on the BMC
+	SerialConsole = "SerialConsole"
+	// GraphicalConsole is the HTML5/VNC stream of the graphical console of the BMC
+	GraphicalConsole = "GraphicalConsole"
+)
+
+// consoleDialTimeout is the time within which the connection with the console of a device must be made
+const consoleDialTimeout = 30 * time.Second
+
+// sshConsole is the serial console of a device opened in an SSH session
+type sshConsole struct {
+	client  *ssh.Client
+	session *ssh.Session
+	stdin   io.WriteCloser
+	// output gets both the standard output and the standard error of the session
+	output *io.PipeReader
+}
+
+func (c *sshConsole) Read(p []byte) (int, error) {
+	return c.output.Read(p)
+}
+
+func (c *sshConsole) Write(p []byte) (int, error) {
+	return c.stdin.Write(p)
+}
+
+func (c *sshConsole) Close() error {
+	c.output.Close()
+	c.session.Close()
+	return c.client.Close()
+}
+
+// DialConsole opens the console of the given type with the device,
+// the returned connection relays the raw stream of the console
+func DialConsole(device *RedfishDevice, consoleType string) (io.ReadWriteCloser, error) {
+	switch consoleType {
+	case SerialConsole:
+		return dialSerialConsole(device)
+	case GraphicalConsole:
+		address := net.JoinHostPort(deviceHost(device.Host), strconv.Itoa(config.Data.ConsoleConf.VNCPort))
+		return net.DialTimeout("tcp", address, consoleDialTimeout)
+	}
+	return nil, fmt.Errorf("console type %s is not supported", consoleType)
+}
+
+// dialSerialConsole logs in to the device with SSH and starts the serial console in a terminal with
+// the configured SerialConsoleCommand. No shell is started on the device when the command is not set.
+func dialSerialConsole(device *RedfishDevice) (io.ReadWriteCloser, error) {
+	command := config.Data.ConsoleConf.SerialConsoleCommand
+	if command == "" {
+		return nil, fmt.Errorf("no SerialConsoleCommand is configured to start the serial console of the device")
+	}
+	hostKeyCallback, err := consoleHostKeyCallback()
+	if err != nil {
+		return nil, err
+	}
+	address := net.JoinHostPort(deviceHost(device.Host), strconv.Itoa(config.Data.ConsoleConf.SSHPort))
+	client, err := ssh.Dial("tcp", address, &ssh.ClientConfig{
+		User:            device.Username,
+		Auth:            []ssh.AuthMethod{ssh.Password(device.Password)},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         consoleDialTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to log in to %s: %v", address, err)
+	}
+	session, err := client.NewSession()
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("unable to open a session with %s: %v", address, err)
+	}
+	console := &sshConsole{client: client, session: session}
+	if console.stdin, err = session.StdinPipe(); err != nil {
+		console.Close()
+		return nil, err
+	}
+	var writer *io.PipeWriter
+	console.output, writer = io.Pipe()
+	session.Stdout = writer
+	session.Stderr = writer
+	if err = session.RequestPty("vt100", 24, 80, ssh.TerminalModes{ssh.ECHO: 1}); err != nil {
+		console.Close()
+		return nil, fmt.Errorf("unable to get a terminal on %s: %v", address, err)
+	}
+	if err = session.Start(command); err != nil {
+		console.Close()
+		return nil, fmt.Errorf("unable to start the serial console on %s: %v", address, err)
+	}
+	go func() {
+		// the readers of the console get EOF once the device ends the session
+		writer.CloseWithError(session.Wait())
+	}()
+	return console, nil
+}
+
+// consoleHostKeyCallback returns the verification of the SSH host keys of the devices against
+// the known hosts file. The host keys are only accepted without verification when the insecure
+// mode is explicitly set.
+func consoleHostKeyCallback() (ssh.HostKeyCallback, error) {
+	if config.Data.ConsoleConf.KnownHostsFile == "" {
+		if !config.Data.ConsoleConf.InsecureHostKeys {
+			return nil, fmt.Errorf("no known hosts file is configured to verify the SSH host key of the device")
+		}
+		log.Warn("the SSH host key of the device is not verified as InsecureHostKeys is set")
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+	callback, err := knownhosts.New(config.Data.ConsoleConf.KnownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the known hosts file: %v", err)
+	}
+	return callback, nil
+}
+
+// deviceHost returns the host of the device without the port of its Redfish service
+func deviceHost(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
@@ -0,0 +1,55 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfputilities
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/plugin-redfish/config"
+)
+
+func TestConsoleHostKeyCallback(t *testing.T) {
+	config.SetUpMockConfig(t)
+	config.Data.ConsoleConf.KnownHostsFile = ""
+	if _, err := consoleHostKeyCallback(); err == nil {
+		t.Errorf("consoleHostKeyCallback() expected an error without known hosts file")
+	}
+	config.Data.ConsoleConf.InsecureHostKeys = true
+	if callback, err := consoleHostKeyCallback(); err != nil || callback == nil {
+		t.Errorf("consoleHostKeyCallback() = %v, want the insecure callback", err)
+	}
+
+	knownHosts, err := ioutil.TempFile("", "known_hosts")
+	if err != nil {
+		t.Fatal("error while creating the known hosts file:", err)
+	}
+	knownHosts.Close()
+	defer os.Remove(knownHosts.Name())
+	config.Data.ConsoleConf.KnownHostsFile = knownHosts.Name()
+	if callback, err := consoleHostKeyCallback(); err != nil || callback == nil {
+		t.Errorf("consoleHostKeyCallback() = %v, want the known hosts callback", err)
+	}
+}
+
+func TestDialSerialConsoleWithoutCommand(t *testing.T) {
+	config.SetUpMockConfig(t)
+	config.Data.ConsoleConf.SerialConsoleCommand = ""
+	config.Data.ConsoleConf.InsecureHostKeys = true
+	if _, err := DialConsole(&RedfishDevice{Host: "127.0.0.1:1"}, SerialConsole); err == nil {
+		t.Errorf("DialConsole() expected an error without SerialConsoleCommand")
+	}
+}
@@ -16,15 +16,15 @@
 package rfputilities
 
 import (
-	"bytes"
-	"encoding/base64"
+	"context"
 	"encoding/json"
 	"fmt"
 	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
 	lutilconf "github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/gofrs/uuid"
-	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"net/http"
 )
@@ -63,6 +63,8 @@ type UnreachableRedfishDevice struct {
 //RedfishClient struct definition
 type RedfishClient struct {
 	httpClient *http.Client
+	// ctx carries the trace the requests to the devices are part of
+	ctx context.Context
 }
 
 // MarshalJSON Custom marshalling code used to prevent the display of password or authtoken
@@ -80,38 +82,35 @@ var redfishServiceRootURI = "/redfish/v1"
 
 // GetRedfishClient : Returns a new RedfishClient with insecure flag set.
 func GetRedfishClient() (*RedfishClient, error) {
-	var err error
-	newClient := RedfishClient{}
+	return GetRedfishClientWithContext(context.Background())
+}
+
+// GetRedfishClientWithContext : Returns a new RedfishClient whose requests to the devices
+// are part of the trace carried by ctx and propagate its trace context. The requests
+// are not cancelled with ctx.
+// The clients share the connections kept alive with the devices and the sessions opened with them.
+func GetRedfishClientWithContext(ctx context.Context) (*RedfishClient, error) {
 	httpConf := &lutilconf.HTTPConfig{
 		CACertificate: &config.Data.KeyCertConf.RootCACertificate,
 	}
-	if newClient.httpClient, err = httpConf.GetHTTPClientObj(); err != nil {
+	defaultClient, err := httpConf.GetHTTPClientObj()
+	if err != nil {
 		return nil, err
 	}
-	return &newClient, nil
+	return &RedfishClient{
+		httpClient: &http.Client{
+			Transport: tracing.NewTransport(getDeviceTransport()),
+			Timeout:   defaultClient.Timeout,
+		},
+		ctx: tracing.Detach(ctx),
+	}, nil
 }
 
 // Get : Executes the REST call with the specified host and URI, then returns the response object.
+// The request is authenticated with the token of the device only.
 func (client *RedfishClient) Get(device *RedfishDevice, requestURI string) (*http.Response, error) {
 	endpoint := fmt.Sprintf("https://%s%s", device.Host, requestURI)
-	req, err := http.NewRequest("GET", endpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Close = true
-	req.Header.Set("Accept", "application/json")
-	if device.Token != "" {
-		req.Header.Set("X-Auth-Token", device.Token)
-	}
-	req.Close = true
-	lutilconf.TLSConfMutex.RLock()
-	resp, err := client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return resp, err
-	}
-	return resp, nil
+	return client.send(device, http.MethodGet, endpoint, nil, false)
 }
 
 // GetRootService : Retrieves the ServiceRoot endpoint for the device and saves the return in the device object
@@ -123,12 +122,12 @@ func (client *RedfishClient) GetRootService(device *RedfishDevice) error {
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Error("While trying to read response body, got: ", err.Error())
+		logs.Log(client.ctx).Error("While trying to read response body, got: ", err.Error())
 		return err
 	}
 	if resp.StatusCode >= 300 {
 		errMessage := "Could not retrieve ServiceRoot for " + device.Host + ":" + string(body)
-		log.Error(errMessage)
+		logs.Log(client.ctx).Error(errMessage)
 		return fmt.Errorf(errMessage)
 	}
 	serviceRoot := &dmtf.ServiceRoot{}
@@ -137,246 +136,70 @@ func (client *RedfishClient) GetRootService(device *RedfishDevice) error {
 	return nil
 }
 
-// AuthWithDevice : Performs authentication with the given device and saves the token
+// AuthWithDevice : Performs authentication with the given device and saves the token.
+// The session opened with the device is shared with the other requests to the device.
 func (client *RedfishClient) AuthWithDevice(device *RedfishDevice) error {
 	if device.RootNode == nil {
 		return fmt.Errorf("No ServiceRoot found for device")
 	}
-
-	// TODO auth (Issue #22)
-	endpoint := fmt.Sprintf("https://%s%s", device.Host, "/redfish/v1/SessionService/Sessions")
-
-	var jsonStr = []byte(`{"UserName":"` + device.Username + `","Password":"` + string(device.Password) + `"}`)
-	req, _ := http.NewRequest("POST", endpoint, bytes.NewBuffer(jsonStr))
-	req.Close = true
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("OData-Version", "4.0")
-
-	req.Close = true
-
-	lutilconf.TLSConfMutex.RLock()
-	resp, err := client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return err
+	release := deviceSessions.acquireSlot(device.Host)
+	defer release()
+	token, rejected := client.sessionToken(deviceSessions.get(device), device, "")
+	if rejected != nil {
+		discardBody(rejected)
+		return fmt.Errorf("Authentication with the device failed: %v", rejected.Status)
 	}
-
-	defer resp.Body.Close()
-	device.Token = resp.Header["X-Auth-Token"][0]
-	log.Debug("Token: " + device.Token)
-
+	if token == "" {
+		return fmt.Errorf("Unable to open a session with the device")
+	}
+	device.Token = token
 	return nil
 }
 
 // BasicAuthWithDevice : Performs authentication with the given device and saves the token
 func (client *RedfishClient) BasicAuthWithDevice(device *RedfishDevice, requestURI string) (*http.Response, error) {
-	// if device.RootNode == nil {
-	// 	return errors.New("no ServiceRoot found for device")
-	// }
 	endpoint := fmt.Sprintf("https://%s%s", device.Host, requestURI)
-	req, err := http.NewRequest("GET", endpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Close = true
-	req.Header.Set("Accept", "application/json")
-	auth := device.Username + ":" + string(device.Password)
-	Basicauth := "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
-	req.Header.Add("Authorization", Basicauth)
-	req.Header.Add("Content-Type", "application/json")
-	req.Close = true
-
-	lutilconf.TLSConfMutex.RLock()
-	resp, err := client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return client.send(device, http.MethodGet, endpoint, nil, true)
 }
 
 // GetWithBasicAuth : Performs authentication with the given device and saves the token
 func (client *RedfishClient) GetWithBasicAuth(device *RedfishDevice, requestURI string) (*http.Response, error) {
-
 	endpoint := fmt.Sprintf("https://%s%s", device.Host, requestURI)
-	req, err := http.NewRequest("GET", endpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Close = true
-	req.Header.Set("Accept", "application/json")
-	auth := device.Username + ":" + string(device.Password)
-	Basicauth := "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
-	req.Header.Add("Authorization", Basicauth)
-	req.Header.Add("Content-Type", "application/json")
-	req.Close = true
-
-	lutilconf.TLSConfMutex.RLock()
-	resp, err := client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return client.send(device, http.MethodGet, endpoint, nil, true)
 }
 
 // SubscribeForEvents :Subscribes for events with Basic Auth
 func (client *RedfishClient) SubscribeForEvents(device *RedfishDevice) (*http.Response, error) {
 	endpoint := fmt.Sprintf("https://%s%s", device.Host, "/redfish/v1/EventService/Subscriptions")
-	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(device.PostBody))
-	if err != nil {
-		return nil, err
-	}
-	req.Close = true
-	req.Header.Set("Accept", "application/json")
-	auth := device.Username + ":" + string(device.Password)
-	Basicauth := "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
-	req.Header.Add("Authorization", Basicauth)
-	req.Header.Set("Content-Type", "application/json")
-	req.Close = true
-	var resp *http.Response
-	lutilconf.TLSConfMutex.RLock()
-	resp, err = client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return client.send(device, http.MethodPost, endpoint, device.PostBody, true)
 }
 
 // ResetComputerSystem :Reset the computer system with given ResetType
 func (client *RedfishClient) ResetComputerSystem(device *RedfishDevice, uri string) (*http.Response, error) {
-
 	endpoint := "https://" + device.Host + uri
-
-	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(device.PostBody))
-	if err != nil {
-		return nil, err
-	}
-	req.Close = true
-	req.Header.Set("Accept", "application/json")
-	auth := device.Username + ":" + string(device.Password)
-	Basicauth := "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
-	req.Header.Add("Authorization", Basicauth)
-	req.Header.Set("Content-Type", "application/json")
-	req.Close = true
-	var resp *http.Response
-	lutilconf.TLSConfMutex.RLock()
-	resp, err = client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return client.send(device, http.MethodPost, endpoint, device.PostBody, true)
 }
 
 // SetDefaultBootOrder : sets default boot order
 func (client *RedfishClient) SetDefaultBootOrder(device *RedfishDevice, uri string) (*http.Response, error) {
-
 	endpoint := "https://" + device.Host + uri
-
-	req, err := http.NewRequest(http.MethodPost, endpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Close = true
-	req.Header.Set("Accept", "application/json")
-	auth := device.Username + ":" + string(device.Password)
-	Basicauth := "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
-	req.Header.Add("Authorization", Basicauth)
-	req.Header.Set("Content-Type", "application/json")
-	req.Close = true
-	var resp *http.Response
-	lutilconf.TLSConfMutex.RLock()
-	resp, err = client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return client.send(device, http.MethodPost, endpoint, nil, true)
 }
 
 // DeleteSubscriptionDetail will accepts device struct
 // and it will delete the subscription detail
 func (client *RedfishClient) DeleteSubscriptionDetail(device *RedfishDevice) (*http.Response, error) {
-	req, err := http.NewRequest("DELETE", device.Location, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Close = true
-	auth := device.Username + ":" + string(device.Password)
-	Basicauth := "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
-	req.Header.Add("Authorization", Basicauth)
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	req.Close = true
-
-	lutilconf.TLSConfMutex.RLock()
-	resp, err := client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return client.send(device, http.MethodDelete, device.Location, nil, true)
 }
 
 // DeviceCall will call device with the given device details on the url given
-// TODO: use same method to all other calls in this file
 func (client *RedfishClient) DeviceCall(device *RedfishDevice, url, method string) (*http.Response, error) {
 	endpoint := fmt.Sprintf("https://%s%s", device.Host, url)
-	req, err := http.NewRequest(method, endpoint, bytes.NewBuffer(device.PostBody))
-	if err != nil {
-		return nil, err
-	}
-	req.Close = true
-	req.Header.Set("Accept", "application/json")
-	auth := device.Username + ":" + string(device.Password)
-	Basicauth := "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
-	req.Header.Add("Authorization", Basicauth)
-	req.Header.Set("Content-Type", "application/json")
-	req.Close = true
-	var resp *http.Response
-	lutilconf.TLSConfMutex.RLock()
-	resp, err = client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return client.send(device, method, endpoint, device.PostBody, true)
 }
 
 // GetSubscriptionDetail will accepts device struct
 // and it will get the subscription detail
 func (client *RedfishClient) GetSubscriptionDetail(device *RedfishDevice) (*http.Response, error) {
-	req, err := http.NewRequest("GET", device.Location, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Close = true
-	req.Header.Set("Accept", "application/json")
-	auth := device.Username + ":" + string(device.Password)
-	Basicauth := "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
-	req.Header.Add("Authorization", Basicauth)
-	req.Header.Add("Content-Type", "application/json")
-	req.Close = true
-
-	lutilconf.TLSConfMutex.RLock()
-	resp, err := client.httpClient.Do(req)
-	lutilconf.TLSConfMutex.RUnlock()
-	if err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+	return client.send(device, http.MethodGet, device.Location, nil, true)
 }
@@ -0,0 +1,360 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfputilities
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	lutilconf "github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/plugin-redfish/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	sessionServiceURI = "/redfish/v1/SessionService/Sessions"
+	// idleSessionCheckInterval is the interval at which the idle sessions are looked for
+	idleSessionCheckInterval = time.Minute
+)
+
+// deviceSession is the Redfish session opened with a device for a set of credentials
+type deviceSession struct {
+	// lock serializes the creation of the session, so that a single session is opened
+	lock     sync.Mutex
+	host     string
+	token    string
+	location string
+	lastUsed time.Time
+	// basicAuthUntil is set when the device doesn't support the sessions,
+	// to use the basic authentication without trying to open a session again
+	basicAuthUntil time.Time
+}
+
+// sessionStore holds the sessions opened with the devices, keyed by device and credentials
+type sessionStore struct {
+	lock     sync.Mutex
+	sessions map[string]*deviceSession
+	// slots limits the number of requests sent concurrently to each device
+	slots   map[string]chan struct{}
+	cleaner sync.Once
+}
+
+var (
+	deviceSessions = &sessionStore{
+		sessions: make(map[string]*deviceSession),
+		slots:    make(map[string]chan struct{}),
+	}
+	// deviceTransport is the transport shared by the requests to the devices, keeping
+	// the connections alive so that they are reused across the requests to a device
+	deviceTransport     *http.Transport
+	deviceTransportOnce sync.Once
+)
+
+// getDeviceTransport returns the transport of the requests to the devices.
+// It must be called after the TLS configuration of the default transport is loaded.
+func getDeviceTransport() *http.Transport {
+	deviceTransportOnce.Do(func() {
+		lutilconf.TLSConfMutex.RLock()
+		deviceTransport = lutilconf.DefaultHTTPTransport.Clone()
+		lutilconf.TLSConfMutex.RUnlock()
+		deviceTransport.DisableKeepAlives = false
+		deviceTransport.MaxIdleConnsPerHost = maxConcurrentRequests()
+	})
+	return deviceTransport
+}
+
+func sessionAuthEnabled() bool {
+	return config.Data.DeviceSessionConf == nil || config.Data.DeviceSessionConf.AuthType != config.BasicAuth
+}
+
+func maxConcurrentRequests() int {
+	if config.Data.DeviceSessionConf == nil {
+		return 0
+	}
+	return config.Data.DeviceSessionConf.MaxConcurrentRequests
+}
+
+func idleSessionTimeout() time.Duration {
+	if config.Data.DeviceSessionConf == nil || config.Data.DeviceSessionConf.IdleSessionTimeoutInMinutes <= 0 {
+		return 5 * time.Minute
+	}
+	return time.Duration(config.Data.DeviceSessionConf.IdleSessionTimeoutInMinutes * float64(time.Minute))
+}
+
+// acquireSlot waits until less than MaxConcurrentRequests requests are sent to the device,
+// and returns the function to call once the response of the device is received
+func (store *sessionStore) acquireSlot(host string) func() {
+	limit := maxConcurrentRequests()
+	if limit <= 0 {
+		return func() {}
+	}
+	store.lock.Lock()
+	slots, exists := store.slots[host]
+	if !exists {
+		slots = make(chan struct{}, limit)
+		store.slots[host] = slots
+	}
+	store.lock.Unlock()
+	slots <- struct{}{}
+	return func() { <-slots }
+}
+
+// get returns the session of the device for its credentials. The password is part
+// of the key, so that a session is never reused for other credentials.
+func (store *sessionStore) get(device *RedfishDevice) *deviceSession {
+	hash := sha256.Sum256([]byte(device.Host + "\x00" + device.Username + "\x00" + device.Password))
+	key := hex.EncodeToString(hash[:])
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	session, exists := store.sessions[key]
+	if !exists {
+		session = &deviceSession{host: device.Host}
+		store.sessions[key] = session
+	}
+	return session
+}
+
+// deleteIdleSessions deletes the sessions which were not used for IdleSessionTimeoutInMinutes
+// from the devices, so that they don't count against the session limits of the devices
+func (store *sessionStore) deleteIdleSessions(client *RedfishClient) {
+	store.lock.Lock()
+	sessions := make([]*deviceSession, 0, len(store.sessions))
+	for _, session := range store.sessions {
+		sessions = append(sessions, session)
+	}
+	store.lock.Unlock()
+	for _, session := range sessions {
+		// the slot is acquired before the lock of the session, like for the requests
+		release := store.acquireSlot(session.host)
+		session.lock.Lock()
+		if session.token != "" && time.Since(session.lastUsed) > idleSessionTimeout() {
+			client.deleteSession(session)
+			session.token, session.location = "", ""
+		}
+		session.lock.Unlock()
+		release()
+	}
+}
+
+// startSessionCleaner starts deleting the idle sessions in the background, once for the plugin
+func (store *sessionStore) startSessionCleaner() {
+	store.cleaner.Do(func() {
+		go func() {
+			for range time.Tick(idleSessionCheckInterval) {
+				client, err := GetRedfishClient()
+				if err != nil {
+					log.Error("unable to create the redfish client to delete the idle sessions: " + err.Error())
+					continue
+				}
+				store.deleteIdleSessions(client)
+			}
+		}()
+	})
+}
+
+// sessionToken returns the token of the session opened with the device, opening a session when
+// there is none or when the current token is stale. No token is returned when the device doesn't
+// support the sessions, along with the response of the device when it rejected the credentials.
+func (client *RedfishClient) sessionToken(session *deviceSession, device *RedfishDevice, stale string) (string, *http.Response) {
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	if session.token != "" && session.token != stale {
+		session.lastUsed = time.Now()
+		return session.token, nil
+	}
+	session.token, session.location = "", ""
+	if time.Now().Before(session.basicAuthUntil) {
+		return "", nil
+	}
+	credentials, _ := json.Marshal(map[string]string{
+		"UserName": device.Username,
+		"Password": device.Password,
+	})
+	req, err := http.NewRequestWithContext(client.ctx, http.MethodPost, "https://"+device.Host+sessionServiceURI, bytes.NewReader(credentials))
+	if err != nil {
+		return "", nil
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("OData-Version", "4.0")
+	resp, err := client.do(req)
+	if err != nil {
+		logs.Log(client.ctx).Warn("unable to open a session with " + device.Host + ", using basic authentication: " + err.Error())
+		return "", nil
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return "", resp
+	}
+	defer discardBody(resp)
+	token := resp.Header.Get("X-Auth-Token")
+	if resp.StatusCode >= 300 || token == "" {
+		logs.Log(client.ctx).Warn("sessions not supported by " + device.Host + ", using basic authentication: " + resp.Status)
+		session.basicAuthUntil = time.Now().Add(idleSessionTimeout())
+		return "", nil
+	}
+	session.token = token
+	session.location = resp.Header.Get("Location")
+	if strings.HasPrefix(session.location, "/") {
+		session.location = "https://" + device.Host + session.location
+	}
+	session.lastUsed = time.Now()
+	deviceSessions.startSessionCleaner()
+	return session.token, nil
+}
+
+// deleteSession deletes a session from the device. The caller holds the lock of the session
+// and a slot of the device.
+func (client *RedfishClient) deleteSession(session *deviceSession) {
+	if session.location == "" {
+		return
+	}
+	req, err := http.NewRequestWithContext(client.ctx, http.MethodDelete, session.location, nil)
+	if err != nil {
+		return
+	}
+	req.Header.Set("X-Auth-Token", session.token)
+	resp, err := client.do(req)
+	if err != nil {
+		logs.Log(client.ctx).Warn("unable to delete the idle session " + session.location + ": " + err.Error())
+		return
+	}
+	discardBody(resp)
+}
+
+// send sends a request to the device. When authenticate is set, the request is authenticated
+// with the session of the device, which is opened on the first request and opened again when the
+// device rejects its token, or with the basic authentication when the sessions are disabled or
+// not supported by the device. The token of the device is used instead when it is set.
+// The slot of the device is held until the body of the response is read or closed.
+func (client *RedfishClient) send(device *RedfishDevice, method, endpoint string, body []byte, authenticate bool) (*http.Response, error) {
+	release := deviceSessions.acquireSlot(device.Host)
+	resp, err := client.sendRequest(device, method, endpoint, body, authenticate)
+	if err != nil || resp == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &slotBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// slotBody is the body of a response, which releases the slot of the device once the body
+// is closed, or read until the end
+type slotBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+// Read reads the body, releasing the slot when the body has been read
+func (b *slotBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.once.Do(b.release)
+	}
+	return n, err
+}
+
+// Close closes the body and releases the slot
+func (b *slotBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// sendRequest sends a request to the device, the caller holds a slot of the device
+func (client *RedfishClient) sendRequest(device *RedfishDevice, method, endpoint string, body []byte, authenticate bool) (*http.Response, error) {
+	newRequest := func() (*http.Request, error) {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(client.ctx, method, endpoint, reqBody)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Content-Type", "application/json")
+		return req, nil
+	}
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	if !authenticate || device.Token != "" {
+		if device.Token != "" {
+			req.Header.Set("X-Auth-Token", device.Token)
+		}
+		return client.do(req)
+	}
+	if !sessionAuthEnabled() || device.Username == "" {
+		req.SetBasicAuth(device.Username, device.Password)
+		return client.do(req)
+	}
+	session := deviceSessions.get(device)
+	token, rejected := client.sessionToken(session, device, "")
+	if rejected != nil {
+		return rejected, nil
+	}
+	if token == "" {
+		req.SetBasicAuth(device.Username, device.Password)
+		return client.do(req)
+	}
+	req.Header.Set("X-Auth-Token", token)
+	resp, err := client.do(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	// the session expired or was deleted on the device, a new one is opened
+	discardBody(resp)
+	if req, err = newRequest(); err != nil {
+		return nil, err
+	}
+	token, rejected = client.sessionToken(session, device, token)
+	if rejected != nil {
+		return rejected, nil
+	}
+	if token == "" {
+		req.SetBasicAuth(device.Username, device.Password)
+	} else {
+		req.Header.Set("X-Auth-Token", token)
+	}
+	return client.do(req)
+}
+
+// do sends a request with the HTTP client, recording the certificate chain presented by the device
+func (client *RedfishClient) do(req *http.Request) (*http.Response, error) {
+	lutilconf.TLSConfMutex.RLock()
+	resp, err := client.httpClient.Do(req)
+	lutilconf.TLSConfMutex.RUnlock()
+	if err == nil {
+		recordDeviceCertificate(req.URL.Host, resp.TLS)
+	}
+	return resp, err
+}
+
+// discardBody reads and closes the body of a response, so that its connection can be reused
+func discardBody(resp *http.Response) {
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
@@ -0,0 +1,270 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package rfputilities
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/plugin-redfish/config"
+)
+
+// mockDevice is a Redfish service counting the sessions opened and the requests received
+type mockDevice struct {
+	lock              sync.Mutex
+	supportsSessions  bool
+	rejectCredentials bool
+	tokens            map[string]bool
+	sessionsOpened    int
+	sessionsDeleted   int
+	basicAuthRequests int
+	tokenRequests     int
+	inFlight          int32
+	maxInFlight       int32
+	delay             time.Duration
+}
+
+func (d *mockDevice) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	inFlight := atomic.AddInt32(&d.inFlight, 1)
+	defer atomic.AddInt32(&d.inFlight, -1)
+	d.lock.Lock()
+	if inFlight > d.maxInFlight {
+		d.maxInFlight = inFlight
+	}
+	d.lock.Unlock()
+	time.Sleep(d.delay)
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == sessionServiceURI:
+		if !d.supportsSessions {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if d.rejectCredentials {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		d.sessionsOpened++
+		token := fmt.Sprintf("token%d", d.sessionsOpened)
+		d.tokens[token] = true
+		w.Header().Set("X-Auth-Token", token)
+		w.Header().Set("Location", fmt.Sprintf("%s/%d", sessionServiceURI, d.sessionsOpened))
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, sessionServiceURI+"/"):
+		delete(d.tokens, r.Header.Get("X-Auth-Token"))
+		d.sessionsDeleted++
+	case r.Header.Get("X-Auth-Token") != "":
+		if !d.tokens[r.Header.Get("X-Auth-Token")] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		d.tokenRequests++
+	default:
+		if _, _, ok := r.BasicAuth(); !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		d.basicAuthRequests++
+	}
+}
+
+// expireSessions invalidates the tokens of the sessions, like a device deleting its idle sessions
+func (d *mockDevice) expireSessions() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.tokens = make(map[string]bool)
+}
+
+// startMockDevice starts the mock device with TLS, trusting its certificate in the plugin configuration
+func startMockDevice(t *testing.T, device *mockDevice) (*httptest.Server, *RedfishDevice) {
+	config.SetUpMockConfig(t)
+	device.tokens = make(map[string]bool)
+	server := httptest.NewTLSServer(device)
+	config.Data.KeyCertConf.RootCACertificate = pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	})
+	return server, &RedfishDevice{
+		Host:     strings.TrimPrefix(server.URL, "https://"),
+		Username: "admin",
+		Password: "password",
+	}
+}
+
+func getResource(t *testing.T, client *RedfishClient, device *RedfishDevice) int {
+	resp, err := client.GetWithBasicAuth(device, "/redfish/v1/Systems/1")
+	if err != nil {
+		t.Fatalf("GetWithBasicAuth() unexpected error = %v", err)
+	}
+	discardBody(resp)
+	return resp.StatusCode
+}
+
+func TestSessionReuse(t *testing.T) {
+	mock := &mockDevice{supportsSessions: true}
+	server, device := startMockDevice(t, mock)
+	defer server.Close()
+	client, err := GetRedfishClient()
+	if err != nil {
+		t.Fatalf("GetRedfishClient() unexpected error = %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		if status := getResource(t, client, device); status != http.StatusOK {
+			t.Fatalf("GetWithBasicAuth() status = %v, want %v", status, http.StatusOK)
+		}
+	}
+	if mock.sessionsOpened != 1 || mock.tokenRequests != 5 || mock.basicAuthRequests != 0 {
+		t.Errorf("got %v sessions, %v requests with token and %v with basic auth, want 1, 5 and 0",
+			mock.sessionsOpened, mock.tokenRequests, mock.basicAuthRequests)
+	}
+
+	// the session is opened again once the device deleted it
+	mock.expireSessions()
+	if status := getResource(t, client, device); status != http.StatusOK {
+		t.Fatalf("GetWithBasicAuth() status = %v, want %v", status, http.StatusOK)
+	}
+	if mock.sessionsOpened != 2 || mock.tokenRequests != 6 {
+		t.Errorf("got %v sessions and %v requests with token, want 2 and 6", mock.sessionsOpened, mock.tokenRequests)
+	}
+
+	// the session is not shared with other credentials
+	other := *device
+	other.Password = "other"
+	getResource(t, client, &other)
+	if mock.sessionsOpened != 3 {
+		t.Errorf("got %v sessions, want 3", mock.sessionsOpened)
+	}
+}
+
+func TestSessionNotSupported(t *testing.T) {
+	mock := &mockDevice{}
+	server, device := startMockDevice(t, mock)
+	defer server.Close()
+	client, _ := GetRedfishClient()
+	for i := 0; i < 3; i++ {
+		if status := getResource(t, client, device); status != http.StatusOK {
+			t.Fatalf("GetWithBasicAuth() status = %v, want %v", status, http.StatusOK)
+		}
+	}
+	if mock.basicAuthRequests != 3 {
+		t.Errorf("got %v requests with basic auth, want 3", mock.basicAuthRequests)
+	}
+}
+
+func TestSessionRejectedCredentials(t *testing.T) {
+	mock := &mockDevice{supportsSessions: true, rejectCredentials: true}
+	server, device := startMockDevice(t, mock)
+	defer server.Close()
+	client, _ := GetRedfishClient()
+	if status := getResource(t, client, device); status != http.StatusUnauthorized {
+		t.Errorf("GetWithBasicAuth() status = %v, want %v", status, http.StatusUnauthorized)
+	}
+	if mock.basicAuthRequests != 0 || mock.tokenRequests != 0 {
+		t.Errorf("the request was sent with rejected credentials")
+	}
+}
+
+func TestBasicAuthType(t *testing.T) {
+	mock := &mockDevice{supportsSessions: true}
+	server, device := startMockDevice(t, mock)
+	defer server.Close()
+	config.Data.DeviceSessionConf.AuthType = config.BasicAuth
+	client, _ := GetRedfishClient()
+	getResource(t, client, device)
+	if mock.sessionsOpened != 0 || mock.basicAuthRequests != 1 {
+		t.Errorf("got %v sessions and %v requests with basic auth, want 0 and 1", mock.sessionsOpened, mock.basicAuthRequests)
+	}
+}
+
+func TestDeleteIdleSessions(t *testing.T) {
+	mock := &mockDevice{supportsSessions: true}
+	server, device := startMockDevice(t, mock)
+	defer server.Close()
+	client, _ := GetRedfishClient()
+	getResource(t, client, device)
+	deviceSessions.deleteIdleSessions(client)
+	if mock.sessionsDeleted != 0 {
+		t.Fatalf("a session in use was deleted")
+	}
+	config.Data.DeviceSessionConf.IdleSessionTimeoutInMinutes = 0.0001
+	time.Sleep(10 * time.Millisecond)
+	deviceSessions.deleteIdleSessions(client)
+	if mock.sessionsDeleted != 1 || len(mock.tokens) != 0 {
+		t.Fatalf("got %v sessions deleted, want 1", mock.sessionsDeleted)
+	}
+	if session := deviceSessions.get(device); session.token != "" {
+		t.Errorf("the deleted session is still used")
+	}
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	mock := &mockDevice{delay: 20 * time.Millisecond}
+	server, device := startMockDevice(t, mock)
+	defer server.Close()
+	config.Data.DeviceSessionConf.MaxConcurrentRequests = 2
+	client, _ := GetRedfishClient()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.GetWithBasicAuth(device, "/redfish/v1/Systems/1")
+			if err == nil {
+				discardBody(resp)
+			}
+		}()
+	}
+	wg.Wait()
+	if mock.maxInFlight > 2 {
+		t.Errorf("got %v concurrent requests, want at most 2", mock.maxInFlight)
+	}
+}
+
+func TestSlotReleasedWithBody(t *testing.T) {
+	mock := &mockDevice{}
+	server, device := startMockDevice(t, mock)
+	defer server.Close()
+	config.Data.DeviceSessionConf.MaxConcurrentRequests = 1
+	client, _ := GetRedfishClient()
+	resp, err := client.GetWithBasicAuth(device, "/redfish/v1/Systems/1")
+	if err != nil {
+		t.Fatalf("GetWithBasicAuth() unexpected error = %v", err)
+	}
+	acquired := make(chan struct{})
+	go func() {
+		deviceSessions.acquireSlot(device.Host)()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("the slot of the device was released before the body was closed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	resp.Body.Close()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("the slot of the device was not released when the body was closed")
+	}
+}
@@ -37,6 +37,7 @@ import (
 	"encoding/pem"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/config"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpmodel"
 	"github.com/ODIM-Project/ODIM/plugin-redfish/rfpresponse"
@@ -101,6 +102,8 @@ func TrackConfigFileChanges(configFilePath string) {
 					// update the odim config
 					if err := config.SetConfiguration(); err != nil {
 						log.Error("error while trying to set configuration" + err.Error())
+					} else if err := logs.SetLevel(config.Data.LogConf.Level); err != nil {
+						log.Error("error while trying to set the log level: " + err.Error())
 					}
 				}
 				//Reading file to continue the watch
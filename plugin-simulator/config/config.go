@@ -0,0 +1,353 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package config ...
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	lutilconf "github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/fsnotify/fsnotify"
+)
+
+// Data will have the configuration data from config file
+var Data configModel
+
+// configModel is for holding all the run time configurations for the simulator plugin
+type configModel struct {
+	FirmwareVersion         string          `json:"FirmwareVersion"` //FirmwareVersion of plugin of the plugin
+	RootServiceUUID         string          `json:"RootServiceUUID"`
+	SessionTimeoutInMinutes float64         `json:"SessionTimeoutInMinutes"` //plugin token time out in minutes
+	PluginConf              *PluginConf     `json:"PluginConf"`
+	MessageBusConf          *MessageBusConf `json:"MessageBusConf"`
+	KeyCertConf             *KeyCertConf    `json:"KeyCertConf"`
+	URLTranslation          *URLTranslation `json:"URLTranslation"`
+	TLSConf                 *TLSConf        `json:"TLSConf"`
+	SimulatorConf           *SimulatorConf  `json:"SimulatorConf"`
+	LogConf                 *LogConf        `json:"LogConf"`
+}
+
+// PluginConf is for holding all the plugin related configurations
+type PluginConf struct {
+	ID       string `json:"ID"` // PluginID hold the id of the plugin
+	Host     string `json:"Host"`
+	Port     string `json:"Port"`
+	UserName string `json:"UserName"`
+	Password string `json:"Password"`
+}
+
+// MessageBusConf will have configuration data of MessageBusConf
+type MessageBusConf struct {
+	MessageQueueConfigFilePath string   `json:"MessageQueueConfigFilePath"` // Message Queue Config File Path
+	EmbType                    string   `json:"MessageBusType"`
+	EmbQueue                   []string `json:"MessageBusQueue"`
+}
+
+// KeyCertConf is for holding all security oriented configuration
+type KeyCertConf struct {
+	RootCACertificatePath string `json:"RootCACertificatePath"` // RootCACertificate will be added to truststore
+	PrivateKeyPath        string `json:"PrivateKeyPath"`        // plugin private key
+	CertificatePath       string `json:"CertificatePath"`       // plugin certificate
+	RootCACertificate     []byte
+	PrivateKey            []byte
+	Certificate           []byte
+}
+
+// URLTranslation ...
+type URLTranslation struct {
+	NorthBoundURL map[string]string `json:"NorthBoundURL"` // holds value of NorthBound Translation
+	SouthBoundURL map[string]string `json:"SouthBoundURL"` // holds value of SouthBound Translation
+}
+
+// TLSConf holds TLS confifurations used in https queries
+type TLSConf struct {
+	MinVersion            string   `json:"MinVersion"`
+	MaxVersion            string   `json:"MaxVersion"`
+	VerifyPeer            bool     `json:"VerifyPeer"`
+	PreferredCipherSuites []string `json:"PreferredCipherSuites"`
+}
+
+// LogConf holds the configuration of the logging of the plugin
+type LogConf struct {
+	Level  string `json:"Level"`  // level of the entries written
+	Format string `json:"Format"` // format of the entries, one of JSON or Syslog
+}
+
+// SimulatorConf holds the configuration of the simulated BMCs
+type SimulatorConf struct {
+	MockupsDirectory string    `json:"MockupsDirectory"` // directory holding the mockups, one directory per mockup
+	BMCs             []BMCConf `json:"BMCs"`
+}
+
+// BMCConf holds the configuration of a simulated BMC
+type BMCConf struct {
+	Address  string `json:"Address"`  // address the BMC is added to odimra with
+	UserName string `json:"UserName"` // credentials the BMC is added to odimra with
+	Password string `json:"Password"`
+	Mockup   string `json:"Mockup"` // name of the mockup directory of the BMC in MockupsDirectory
+}
+
+// SetConfiguration will extract the config data from file
+func SetConfiguration() error {
+	configFilePath := os.Getenv("PLUGIN_CONFIG_FILE_PATH")
+	if configFilePath == "" {
+		return fmt.Errorf("No value set to environment variable PLUGIN_CONFIG_FILE_PATH")
+	}
+	configData, err := ioutil.ReadFile(configFilePath)
+	if err != nil {
+		return fmt.Errorf("Failed to read the config file: %v", err)
+	}
+	err = json.Unmarshal(configData, &Data)
+	if err != nil {
+		return fmt.Errorf("Failed to unmarshal config data: %v", err)
+	}
+
+	return ValidateConfiguration()
+}
+
+// TrackConfigFileChanges monitors the config file of the plugin using fsnotify, the configuration
+// is read again when the file is changed and the log level is updated without restarting the plugin
+func TrackConfigFileChanges(configFilePath string) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if err := watcher.Add(configFilePath); err != nil {
+		log.Error(err)
+	}
+	go func() {
+		for {
+			select {
+			case fileEvent, ok := <-watcher.Events:
+				if !ok {
+					continue
+				}
+				if fileEvent.Op&fsnotify.Write == fsnotify.Write || fileEvent.Op&fsnotify.Remove == fsnotify.Remove {
+					log.Info("modified file: " + fileEvent.Name)
+					if err := SetConfiguration(); err != nil {
+						log.Error("error while trying to set configuration: " + err.Error())
+					} else if err := logs.SetLevel(Data.LogConf.Level); err != nil {
+						log.Error("error while trying to set the log level: " + err.Error())
+					}
+				}
+				//Reading file to continue the watch
+				watcher.Add(configFilePath)
+			case err, _ := <-watcher.Errors:
+				if err != nil {
+					log.Error(err)
+					defer watcher.Close()
+				}
+			}
+		}
+	}()
+}
+
+// ValidateConfiguration will validate configurations read and assign default values, where required
+func ValidateConfiguration() error {
+	if err := lutilconf.CheckRootServiceuuid(Data.RootServiceUUID); err != nil {
+		return err
+	}
+	if Data.FirmwareVersion == "" {
+		log.Warn("No value set for FirmwareVersion, setting default value")
+		Data.FirmwareVersion = "1.0"
+	}
+	if Data.RootServiceUUID == "" {
+		return fmt.Errorf("No value set for rootServiceUUID")
+	}
+	if Data.SessionTimeoutInMinutes == 0 {
+		log.Warn("No value set for SessionTimeoutInMinutes, setting default value")
+		Data.SessionTimeoutInMinutes = 30
+	}
+	if err := checkPluginConf(); err != nil {
+		return err
+	}
+	if err := checkMessageBusConf(); err != nil {
+		return err
+	}
+	if err := checkCertsAndKeysConf(); err != nil {
+		return err
+	}
+	if err := checkTLSConf(); err != nil {
+		return err
+	}
+	if err := checkSimulatorConf(); err != nil {
+		return err
+	}
+	if err := checkLogConf(); err != nil {
+		return err
+	}
+	checkURLTranslationConf()
+	return nil
+}
+
+// Check or apply default values for the logging of the plugin
+func checkLogConf() error {
+	if Data.LogConf == nil {
+		log.Warn("No value set for LogConf, setting default value")
+		Data.LogConf = &LogConf{}
+	}
+	if Data.LogConf.Level == "" {
+		Data.LogConf.Level = logs.DefaultLevel
+	}
+	if Data.LogConf.Format == "" {
+		Data.LogConf.Format = logs.JSONFormat
+	}
+	if _, err := log.ParseLevel(Data.LogConf.Level); err != nil {
+		return fmt.Errorf("Invalid value %v set for log Level: %v", Data.LogConf.Level, err)
+	}
+	return logs.ValidateFormat(Data.LogConf.Format)
+}
+
+func checkPluginConf() error {
+	if Data.PluginConf == nil {
+		return fmt.Errorf("No value found for PluginConf")
+	}
+	if Data.PluginConf.ID == "" {
+		log.Warn("No value set for Plugin ID, setting default value")
+		Data.PluginConf.ID = "SIM"
+	}
+	if Data.PluginConf.Host == "" {
+		return fmt.Errorf("No value set for plugin Host")
+	}
+	if Data.PluginConf.Port == "" {
+		return fmt.Errorf("No value set for plugin Port")
+	}
+	if Data.PluginConf.UserName == "" {
+		return fmt.Errorf("No value set for plugin Username")
+	}
+	if Data.PluginConf.Password == "" {
+		return fmt.Errorf("No value set for plugin Password")
+	}
+	return nil
+}
+
+// Check or apply default values for message bus to be used by this plugin
+func checkMessageBusConf() error {
+	if Data.MessageBusConf == nil {
+		return fmt.Errorf("No value found for MessageBusConf")
+	}
+	if _, err := os.Stat(Data.MessageBusConf.MessageQueueConfigFilePath); err != nil {
+		return fmt.Errorf("Value check failed for MessageQueueConfigFilePath:%s with %v", Data.MessageBusConf.MessageQueueConfigFilePath, err)
+	}
+	if Data.MessageBusConf.EmbType == "" {
+		log.Warn("No value set for MessageBusType, setting default value")
+		Data.MessageBusConf.EmbType = "Kafka"
+	}
+	if len(Data.MessageBusConf.EmbQueue) <= 0 {
+		log.Warn("No value set for MessageBusQueue, setting default value")
+		Data.MessageBusConf.EmbQueue = []string{"REDFISH-EVENTS-TOPIC"}
+	}
+	return nil
+}
+
+// Check or apply default values for certs/keys used by this plugin
+func checkCertsAndKeysConf() error {
+	var err error
+	if Data.KeyCertConf == nil {
+		return fmt.Errorf("No value found for KeyCertConf")
+	}
+	if Data.KeyCertConf.Certificate, err = ioutil.ReadFile(Data.KeyCertConf.CertificatePath); err != nil {
+		return fmt.Errorf("Value check failed for CertificatePath:%s with %v", Data.KeyCertConf.CertificatePath, err)
+	}
+	if Data.KeyCertConf.PrivateKey, err = ioutil.ReadFile(Data.KeyCertConf.PrivateKeyPath); err != nil {
+		return fmt.Errorf("Value check failed for PrivateKeyPath:%s with %v", Data.KeyCertConf.PrivateKeyPath, err)
+	}
+	if Data.KeyCertConf.RootCACertificate, err = ioutil.ReadFile(Data.KeyCertConf.RootCACertificatePath); err != nil {
+		return fmt.Errorf("Value check failed for RootCACertificatePath:%s with %v", Data.KeyCertConf.RootCACertificatePath, err)
+	}
+	return nil
+}
+
+// Check the simulated BMCs and the directories of their mockups
+func checkSimulatorConf() error {
+	if Data.SimulatorConf == nil {
+		return fmt.Errorf("No value found for SimulatorConf")
+	}
+	if _, err := os.Stat(Data.SimulatorConf.MockupsDirectory); err != nil {
+		return fmt.Errorf("Value check failed for MockupsDirectory:%s with %v", Data.SimulatorConf.MockupsDirectory, err)
+	}
+	if len(Data.SimulatorConf.BMCs) == 0 {
+		log.Warn("No value set for BMCs, no BMC will be simulated")
+	}
+	for _, bmc := range Data.SimulatorConf.BMCs {
+		if bmc.Address == "" || bmc.UserName == "" || bmc.Password == "" {
+			return fmt.Errorf("No value set for Address, UserName or Password of a simulated BMC")
+		}
+		if _, err := os.Stat(filepath.Join(Data.SimulatorConf.MockupsDirectory, bmc.Mockup)); bmc.Mockup == "" || err != nil {
+			return fmt.Errorf("Value check failed for Mockup:%s of the BMC %s", bmc.Mockup, bmc.Address)
+		}
+	}
+	return nil
+}
+
+// Check or apply default values for URL translation from ODIM <=> redfish
+func checkURLTranslationConf() {
+	if Data.URLTranslation == nil {
+		log.Warn("URL translation not provided, setting default value")
+		Data.URLTranslation = &URLTranslation{
+			NorthBoundURL: map[string]string{
+				"redfish": "ODIM",
+			},
+			SouthBoundURL: map[string]string{
+				"ODIM": "redfish",
+			},
+		}
+		return
+	}
+	if len(Data.URLTranslation.NorthBoundURL) <= 0 {
+		log.Warn("NorthBoundURL is empty, setting default value")
+		Data.URLTranslation.NorthBoundURL = map[string]string{
+			"redfish": "ODIM",
+		}
+	}
+	if len(Data.URLTranslation.SouthBoundURL) <= 0 {
+		log.Warn("SouthBoundURL is empty, setting default value")
+		Data.URLTranslation.SouthBoundURL = map[string]string{
+			"ODIM": "redfish",
+		}
+	}
+}
+
+func checkTLSConf() error {
+	if Data.TLSConf == nil {
+		log.Warn("TLSConf not provided, setting default value")
+		Data.TLSConf = &TLSConf{}
+		lutilconf.SetDefaultTLSConf()
+		return nil
+	}
+
+	var err error
+	lutilconf.SetVerifyPeer(Data.TLSConf.VerifyPeer)
+	if err = lutilconf.SetTLSMinVersion(Data.TLSConf.MinVersion); err != nil {
+		return err
+	}
+	if err = lutilconf.SetTLSMaxVersion(Data.TLSConf.MaxVersion); err != nil {
+		return err
+	}
+	if err = lutilconf.ValidateConfiguredTLSVersions(); err != nil {
+		return err
+	}
+	if err = lutilconf.SetPreferredCipherSuites(Data.TLSConf.PreferredCipherSuites); err != nil {
+		return err
+	}
+	return nil
+}
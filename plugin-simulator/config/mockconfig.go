@@ -0,0 +1,57 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package config ...
+package config
+
+import (
+	"testing"
+)
+
+// SetUpMockConfig set ups a mock ration for unit testing
+func SetUpMockConfig(t *testing.T) error {
+	Data.RootServiceUUID = "3bd1f589-117a-4cf9-89f2-da44ee8e2325"
+	Data.FirmwareVersion = "1.0"
+	Data.SessionTimeoutInMinutes = 30
+	Data.PluginConf = &PluginConf{
+		ID:       "SIM",
+		Host:     "127.0.0.1",
+		Port:     "45004",
+		UserName: "admin",
+		Password: "O01bKrP7Tzs7YoO3YvQt4pRa2J_R6HI34ZfP4MxbqNIYAVQVt2ewGXmhjvBfzMifM7bHFccXKGmdHvj3hY44Hw==",
+	}
+	Data.MessageBusConf = &MessageBusConf{
+		EmbType:  "Kafka",
+		EmbQueue: []string{"REDFISH-EVENTS-TOPIC"},
+	}
+	Data.URLTranslation = &URLTranslation{
+		NorthBoundURL: map[string]string{
+			"redfish": "ODIM",
+		},
+		SouthBoundURL: map[string]string{
+			"ODIM": "redfish",
+		},
+	}
+	Data.LogConf = &LogConf{
+		Level:  "info",
+		Format: "JSON",
+	}
+	Data.SimulatorConf = &SimulatorConf{
+		MockupsDirectory: "../mockups",
+		BMCs: []BMCConf{
+			{Address: "10.0.0.101", UserName: "admin", Password: "password", Mockup: "simulated-rackmount"},
+		},
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// (C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+package main
+
+import (
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	dc "github.com/ODIM-Project/ODIM/lib-messagebus/datacommunicator"
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	lutilconf "github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simhandler"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simmessagebus"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simmiddleware"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simulator"
+	iris "github.com/kataras/iris/v12"
+)
+
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
+
+func main() {
+	// verifying the uid of the user
+	if uid := os.Geteuid(); uid == 0 {
+		log.Fatal("Plugin Service should not be run as the root user")
+	}
+
+	if err := config.SetConfiguration(); err != nil {
+		log.Fatal("While reading from config, got: " + err.Error())
+	}
+
+	if err := logs.Init(config.Data.PluginConf.ID, config.Data.LogConf.Format, config.Data.LogConf.Level); err != nil {
+		log.Fatal("While initializing logging, got: " + err.Error())
+	}
+
+	// the log level is updated when the config file is changed
+	config.TrackConfigFileChanges(os.Getenv("PLUGIN_CONFIG_FILE_PATH"))
+
+	if err := tracing.Init(config.Data.PluginConf.ID); err != nil {
+		log.Fatal("While initializing tracing, got: " + err.Error())
+	}
+
+	if err := dc.SetConfiguration(config.Data.MessageBusConf.MessageQueueConfigFilePath); err != nil {
+		log.Fatal("While trying to set messagebus configuration, got: " + err.Error())
+	}
+	// the events not yet published are flushed when the plugin stops
+	defer dc.CloseProducers()
+
+	// CreateJobQueue defines the queue which will act as an infinite buffer
+	// In channel is an entry or input channel and the Out channel is an exit or output channel
+	simhandler.In, simhandler.Out = common.CreateJobQueue()
+
+	// RunReadWorkers will create a worker pool for doing a specific task
+	// which is passed to it as Publish method after reading the data from the channel.
+	go common.RunReadWorkers(simhandler.Out, simmessagebus.Publish, 5)
+
+	var bmcs []simulator.BMCConf
+	for _, bmc := range config.Data.SimulatorConf.BMCs {
+		bmcs = append(bmcs, simulator.BMCConf{
+			Address:         bmc.Address,
+			UserName:        bmc.UserName,
+			Password:        bmc.Password,
+			MockupDirectory: filepath.Join(config.Data.SimulatorConf.MockupsDirectory, bmc.Mockup),
+		})
+	}
+	var err error
+	if simhandler.Simulator, err = simulator.New(bmcs, simhandler.PublishEvent); err != nil {
+		log.Fatal("While loading the simulated BMCs, got: " + err.Error())
+	}
+
+	intializePluginStatus()
+	app()
+}
+
+func app() {
+	app := routers()
+	conf := &lutilconf.HTTPConfig{
+		Certificate:   &config.Data.KeyCertConf.Certificate,
+		PrivateKey:    &config.Data.KeyCertConf.PrivateKey,
+		CACertificate: &config.Data.KeyCertConf.RootCACertificate,
+		ServerAddress: config.Data.PluginConf.Host,
+		ServerPort:    config.Data.PluginConf.Port,
+	}
+	pluginServer, err := conf.GetHTTPServerObj()
+	if err != nil {
+		log.Fatal("Unable to initialize plugin : " + err.Error())
+	}
+	app.Run(iris.Server(pluginServer))
+}
+
+func routers() *iris.Application {
+	app := iris.New()
+	app.WrapRouter(func(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		path := r.URL.Path
+		if len(path) > 1 && path[len(path)-1] == '/' && path[len(path)-2] != '/' {
+			path = path[:len(path)-1]
+			r.RequestURI = path
+			r.URL.Path = path
+		}
+		next(w, r)
+	})
+	app.UseGlobal(tracing.IrisMiddleware, simmiddleware.PluginID, metrics.IrisMiddleware)
+	app.Get("/metrics", iris.FromStd(metrics.Handler()))
+
+	pluginRoutes := app.Party("/ODIM/v1")
+	{
+		pluginRoutes.Post("/validate", simmiddleware.BasicAuth, simhandler.Validate)
+		pluginRoutes.Post("/Sessions", simhandler.CreateSession)
+		pluginRoutes.Post("/Subscriptions", simmiddleware.BasicAuth, simhandler.CreateEventSubscription)
+		pluginRoutes.Delete("/Subscriptions", simmiddleware.BasicAuth, simhandler.DeleteEventSubscription)
+
+		// the requests on the resources of the devices are served by the simulated BMCs
+		systems := pluginRoutes.Party("/Systems", simmiddleware.BasicAuth)
+		systems.Get("", simhandler.Forward)
+		systems.Any("/{id}", simhandler.Forward)
+		systems.Any("/{id}/{resource:path}", simhandler.Forward)
+
+		chassis := pluginRoutes.Party("/Chassis", simmiddleware.BasicAuth)
+		chassis.Get("", simhandler.Forward)
+		chassis.Any("/{id}", simhandler.Forward)
+		chassis.Any("/{id}/{resource:path}", simhandler.Forward)
+
+		managers := pluginRoutes.Party("/Managers", simmiddleware.BasicAuth)
+		managers.Get("", simhandler.GetManagersCollection)
+		managers.Get("/{id}", simhandler.GetManagersInfo)
+		managers.Patch("/{id}", simhandler.Forward)
+		managers.Any("/{id}/{resource:path}", simhandler.Forward)
+
+		update := pluginRoutes.Party("/UpdateService", simmiddleware.BasicAuth)
+		update.Get("", simhandler.Forward)
+		update.Any("/{resource:path}", simhandler.Forward)
+
+		eventService := pluginRoutes.Party("/EventService", simmiddleware.BasicAuth)
+		eventService.Get("", simhandler.Forward)
+		eventService.Any("/{resource:path}", simhandler.Forward)
+	}
+	pluginRoutes.Get("/Status", simhandler.GetPluginStatus)
+	pluginRoutes.Post("/Startup", simmiddleware.BasicAuth, simhandler.GetPluginStartup)
+	return app
+}
+
+// intializePluginStatus sets plugin status
+func intializePluginStatus() {
+	simhandler.Status.Available = "yes"
+	simhandler.Status.Uptime = time.Now().Format(time.RFC3339)
+}
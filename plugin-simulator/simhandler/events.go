@@ -0,0 +1,48 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package simhandler ...
+package simhandler
+
+import (
+	"encoding/json"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	pluginConfig "github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// In Channel
+	In chan<- interface{}
+	// Out Channel
+	Out <-chan interface{}
+)
+
+// PublishEvent writes the event message of the simulated BMC of the address to the job queue
+// the events are published from, as the events received from the real BMCs
+func PublishEvent(address string, message common.MessageData) {
+	request, err := json.Marshal(message)
+	if err != nil {
+		log.Error("Unable to marshal the event of the BMC " + address + ": " + err.Error())
+		return
+	}
+	//replacing the event with north bound translation URL
+	reqData := translate(string(request), pluginConfig.Data.URLTranslation.NorthBoundURL)
+	// the job queue buffers the events, the write doesn't wait for their publication
+	In <- common.Events{
+		IP:      address,
+		Request: []byte(reqData),
+	}
+}
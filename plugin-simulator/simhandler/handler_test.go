@@ -0,0 +1,138 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package simhandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simulator"
+	iris "github.com/kataras/iris/v12"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+func mockSimulatorApp(t *testing.T) *iris.Application {
+	config.SetUpMockConfig(t)
+	var err error
+	Simulator, err = simulator.New([]simulator.BMCConf{
+		{
+			Address:         "10.0.0.101",
+			UserName:        "admin",
+			Password:        "password",
+			MockupDirectory: "../mockups/simulated-rackmount",
+		},
+	}, PublishEvent)
+	if err != nil {
+		t.Fatalf("simulator.New() error = %v", err)
+	}
+	In, Out = common.CreateJobQueue()
+
+	mockApp := iris.New()
+	pluginRoutes := mockApp.Party("/ODIM/v1")
+	pluginRoutes.Post("/validate", Validate)
+	pluginRoutes.Post("/Subscriptions", CreateEventSubscription)
+	pluginRoutes.Delete("/Subscriptions", DeleteEventSubscription)
+	pluginRoutes.Post("/Startup", GetPluginStartup)
+	pluginRoutes.Get("/Managers/{id}", GetManagersInfo)
+	pluginRoutes.Any("/Systems/{id}/{resource:path}", Forward)
+	return mockApp
+}
+
+func TestValidate(t *testing.T) {
+	e := httptest.New(t, mockSimulatorApp(t))
+	device := map[string]interface{}{
+		"ManagerAddress": "10.0.0.101",
+		"UserName":       "admin",
+		"Password":       []byte("password"),
+	}
+	bmc, _ := Simulator.BMC("10.0.0.101")
+	e.POST("/ODIM/v1/validate").WithJSON(device).Expect().Status(http.StatusOK).
+		JSON().Object().ValueEqual("device_UUID", bmc.ServiceRootUUID())
+
+	device["Password"] = []byte("wrong")
+	e.POST("/ODIM/v1/validate").WithJSON(device).Expect().Status(http.StatusUnauthorized)
+
+	device["ManagerAddress"] = "10.0.0.200"
+	e.POST("/ODIM/v1/validate").WithJSON(device).Expect().Status(http.StatusNotFound)
+}
+
+func TestForward(t *testing.T) {
+	e := httptest.New(t, mockSimulatorApp(t))
+	device := map[string]interface{}{
+		"ManagerAddress": "10.0.0.101",
+		"UserName":       "admin",
+		"Password":       []byte("password"),
+	}
+	e.GET("/ODIM/v1/Systems/1/Bios").WithJSON(device).Expect().Status(http.StatusOK).
+		JSON().Object().ValueEqual("@odata.id", "/ODIM/v1/Systems/1/Bios")
+
+	device["PostBody"] = []byte(`{"ResetType":"ForceOff"}`)
+	e.POST("/ODIM/v1/Systems/1/Actions/ComputerSystem.Reset").WithJSON(device).Expect().Status(http.StatusOK)
+	device["PostBody"] = []byte(`{"ResetType":"Explode"}`)
+	e.POST("/ODIM/v1/Systems/1/Actions/ComputerSystem.Reset").WithJSON(device).Expect().Status(http.StatusBadRequest)
+
+	device["Password"] = []byte("wrong")
+	e.GET("/ODIM/v1/Systems/1/Bios").WithJSON(device).Expect().Status(http.StatusBadRequest)
+
+	// the plugin manager is returned when the request has no device
+	e.GET("/ODIM/v1/Managers/"+config.Data.RootServiceUUID).Expect().Status(http.StatusOK).
+		JSON().Object().ValueEqual("ManagerType", "Service")
+}
+
+func TestEventSubscription(t *testing.T) {
+	e := httptest.New(t, mockSimulatorApp(t))
+	device := map[string]interface{}{
+		"ManagerAddress": "10.0.0.101",
+		"UserName":       "admin",
+		"Password":       []byte("password"),
+		"PostBody":       []byte(`{"Destination":"https://odim/ODIM/v1/Events","EventTypes":["Alert"],"Context":"ODIMRA_Event"}`),
+	}
+	e.POST("/ODIM/v1/Subscriptions").WithJSON(device).Expect().Status(http.StatusCreated).
+		Header("Location").Equal("https://10.0.0.101/ODIM/v1/EventService/Subscriptions/1")
+	// the subscription of the plugin is replaced
+	location := e.POST("/ODIM/v1/Subscriptions").WithJSON(device).Expect().Status(http.StatusCreated).
+		Header("Location").Equal("https://10.0.0.101/ODIM/v1/EventService/Subscriptions/2").Raw()
+
+	// the events of the subscription are published with the address of the BMC
+	device["PostBody"] = []byte(`{"ResetType":"ForceOff"}`)
+	e.POST("/ODIM/v1/Systems/1/Actions/ComputerSystem.Reset").WithJSON(device).Expect().Status(http.StatusOK)
+	select {
+	case data := <-Out:
+		event := data.(common.Events)
+		var message common.MessageData
+		json.Unmarshal(event.Request, &message)
+		if event.IP != "10.0.0.101" || message.Context != "ODIMRA_Event" || !strings.HasSuffix(message.Events[0].MessageID, "ServerPoweredOff") ||
+			message.Events[0].OriginOfCondition.Oid != "/ODIM/v1/Systems/1" {
+			t.Errorf("the event was published as %v %s", event.IP, event.Request)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("the event of the reset wasn't published")
+	}
+
+	startup := []map[string]interface{}{
+		{"Location": location, "EventTypes": []string{"Alert"}, "Device": device},
+	}
+	e.POST("/ODIM/v1/Startup").WithJSON(startup).Expect().Status(http.StatusOK).
+		JSON().Object().ValueEqual("10.0.0.101", location)
+
+	device["Location"] = location
+	e.DELETE("/ODIM/v1/Subscriptions").WithJSON(device).Expect().Status(http.StatusOK)
+	e.DELETE("/ODIM/v1/Subscriptions").WithJSON(device).Expect().Status(http.StatusNotFound)
+}
@@ -0,0 +1,88 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package simhandler ...
+package simhandler
+
+import (
+	"net/http"
+
+	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
+	pluginConfig "github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simmodel"
+	iris "github.com/kataras/iris/v12"
+)
+
+// GetManagersCollection returns the manager of the plugin when the request has no device,
+// or the managers of the simulated BMC of the device
+func GetManagersCollection(ctx iris.Context) {
+	if forwardToDevice(ctx) {
+		return
+	}
+	members := []*dmtf.Link{
+		&dmtf.Link{
+			Oid: "/ODIM/v1/Managers/" + pluginConfig.Data.RootServiceUUID,
+		},
+	}
+	ctx.StatusCode(http.StatusOK)
+	ctx.JSON(dmtf.Collection{
+		ODataContext: "/ODIM/v1/$metadata#ManagerCollection.ManagerCollection",
+		ODataID:      ctx.Request().RequestURI,
+		ODataType:    "#ManagerCollection.ManagerCollection",
+		Description:  "Managers view",
+		Name:         "Managers",
+		Members:      members,
+		MembersCount: len(members),
+	})
+}
+
+// GetManagersInfo returns the manager of the plugin when the request has no device,
+// or the manager of the simulated BMC of the device
+func GetManagersInfo(ctx iris.Context) {
+	if forwardToDevice(ctx) {
+		return
+	}
+	ctx.StatusCode(http.StatusOK)
+	ctx.JSON(dmtf.Manager{
+		ODataContext:    "/ODIM/v1/$metadata#Manager.Manager",
+		ODataID:         ctx.Request().RequestURI,
+		ODataType:       "#Manager.v1_3_3.Manager",
+		Name:            pluginConfig.Data.PluginConf.ID,
+		ManagerType:     "Service",
+		ID:              pluginConfig.Data.RootServiceUUID,
+		UUID:            pluginConfig.Data.RootServiceUUID,
+		FirmwareVersion: pluginConfig.Data.FirmwareVersion,
+		Status: &dmtf.Status{
+			State: "Enabled",
+		},
+	})
+}
+
+// forwardToDevice serves the request with the simulated BMC when the request has a device,
+// it returns false for the requests on the plugin
+func forwardToDevice(ctx iris.Context) bool {
+	if !validateToken(ctx) {
+		return true
+	}
+	var deviceDetails simmodel.Device
+	// if any error come while getting the device then request will be for  plugins manager
+	ctx.ReadJSON(&deviceDetails)
+	if deviceDetails.Host == "" {
+		return false
+	}
+	if bmc, ok := lookupBMC(ctx, deviceDetails.Host); ok {
+		forward(ctx, &deviceDetails, bmc, ctx.Method(), ctx.Request().RequestURI)
+	}
+	return true
+}
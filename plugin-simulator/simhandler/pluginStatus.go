@@ -0,0 +1,54 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package simhandler ...
+package simhandler
+
+import (
+	"net/http"
+	"time"
+
+	pluginConfig "github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simresponse"
+	iris "github.com/kataras/iris/v12"
+)
+
+// Status holds the status of the plugin
+var Status simresponse.Status
+
+// GetPluginStatus defines the GetPluginStatus iris handler.
+// and returns status
+func GetPluginStatus(ctx iris.Context) {
+	if !validateToken(ctx) {
+		return
+	}
+	var resp = simresponse.PluginStatusResponse{
+		Comment: "Plugin Status Response",
+		Name:    "Redfish BMC Simulator Plugin Status",
+		Version: pluginConfig.Data.FirmwareVersion,
+	}
+	resp.Status = Status
+	resp.Status.TimeStamp = time.Now().Format(time.RFC3339)
+	resp.EventMessageBus = simresponse.EventMessageBus{
+		EmbType: pluginConfig.Data.MessageBusConf.EmbType,
+	}
+	for _, queue := range pluginConfig.Data.MessageBusConf.EmbQueue {
+		resp.EventMessageBus.EmbQueue = append(resp.EventMessageBus.EmbQueue, simresponse.EmbQueue{
+			QueueName: queue,
+			QueueDesc: "Queue for redfish events",
+		})
+	}
+	ctx.StatusCode(http.StatusOK)
+	ctx.JSON(resp)
+}
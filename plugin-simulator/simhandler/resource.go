@@ -0,0 +1,140 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package simhandler ...
+package simhandler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	pluginConfig "github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simmodel"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simresponse"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simulator"
+	iris "github.com/kataras/iris/v12"
+)
+
+// Simulator holds the simulated BMCs the requests are served by
+var Simulator *simulator.Simulator
+
+// Validate authenticates with the simulated BMC and returns the UUID of its service root
+func Validate(ctx iris.Context) {
+	deviceDetails, bmc, ok := getBMC(ctx)
+	if !ok {
+		return
+	}
+	if !bmc.Authenticate(deviceDetails.Username, string(deviceDetails.Password)) {
+		logs.Log(ctx.Request().Context()).Error("Authentication with the BMC " + deviceDetails.Host + " failed")
+		ctx.StatusCode(http.StatusUnauthorized)
+		ctx.WriteString("Authentication with the device failed")
+		return
+	}
+	ctx.StatusCode(http.StatusOK)
+	ctx.JSON(simresponse.Device{
+		ServerIP:   deviceDetails.Host,
+		Username:   deviceDetails.Username,
+		DeviceUUID: bmc.ServiceRootUUID(),
+	})
+}
+
+// Forward serves the request with the simulated BMC of the device
+func Forward(ctx iris.Context) {
+	deviceDetails, bmc, ok := getBMC(ctx)
+	if !ok {
+		return
+	}
+	forward(ctx, deviceDetails, bmc, ctx.Method(), ctx.Request().RequestURI)
+}
+
+// forward serves the request on the URI with the simulated BMC, translating the URI and the
+// request body south bound and the response north bound
+func forward(ctx iris.Context, deviceDetails *simmodel.Device, bmc *simulator.BMC, method, uri string) {
+	if !bmc.Authenticate(deviceDetails.Username, string(deviceDetails.Password)) {
+		logs.Log(ctx.Request().Context()).Error("Authentication with the BMC " + deviceDetails.Host + " failed")
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.WriteString("Authentication with the device failed")
+		return
+	}
+	uri = translate(uri, pluginConfig.Data.URLTranslation.SouthBoundURL)
+	body := translate(string(deviceDetails.PostBody), pluginConfig.Data.URLTranslation.SouthBoundURL)
+	resp := bmc.Do(method, uri, []byte(body))
+	writeResponse(ctx, resp)
+}
+
+// getBMC reads the device of the request and returns its simulated BMC,
+// the error response is written when the device is not simulated
+func getBMC(ctx iris.Context) (*simmodel.Device, *simulator.BMC, bool) {
+	if !validateToken(ctx) {
+		return nil, nil, false
+	}
+	var deviceDetails simmodel.Device
+	//Get device details from request
+	if err := ctx.ReadJSON(&deviceDetails); err != nil {
+		logs.Log(ctx.Request().Context()).Error("Unable to collect data from request: " + err.Error())
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.WriteString("Unable to collect data from request: " + err.Error())
+		return nil, nil, false
+	}
+	bmc, ok := lookupBMC(ctx, deviceDetails.Host)
+	return &deviceDetails, bmc, ok
+}
+
+// validateToken validates the X-Auth-Token of the request, if any
+func validateToken(ctx iris.Context) bool {
+	token := ctx.GetHeader("X-Auth-Token")
+	if token != "" && !TokenValidation(token) {
+		logs.Log(ctx.Request().Context()).Error("Invalid/Expired X-Auth-Token")
+		ctx.StatusCode(http.StatusUnauthorized)
+		ctx.WriteString("Invalid/Expired X-Auth-Token")
+		return false
+	}
+	return true
+}
+
+// lookupBMC returns the simulated BMC of the address, the error response is written
+// when no BMC is simulated at the address
+func lookupBMC(ctx iris.Context, address string) (*simulator.BMC, bool) {
+	bmc, ok := Simulator.BMC(address)
+	if !ok {
+		errorMessage := "no BMC is simulated at " + address
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		resp := common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"BMC", address}, nil)
+		ctx.StatusCode(http.StatusNotFound)
+		ctx.JSON(resp.Body)
+	}
+	return bmc, ok
+}
+
+// writeResponse writes the response of the simulated BMC translated north bound
+func writeResponse(ctx iris.Context, resp simulator.Response) {
+	header := make(map[string]string)
+	for key, value := range resp.Header {
+		header[key] = translate(value, pluginConfig.Data.URLTranslation.NorthBoundURL)
+	}
+	common.SetResponseHeader(ctx, header)
+	ctx.StatusCode(resp.StatusCode)
+	ctx.Write([]byte(translate(string(resp.Body), pluginConfig.Data.URLTranslation.NorthBoundURL)))
+}
+
+// translate replaces the keys of the translation by their values in the data
+func translate(data string, translation map[string]string) string {
+	for key, value := range translation {
+		data = strings.Replace(data, key, value, -1)
+	}
+	return data
+}
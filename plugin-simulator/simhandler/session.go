@@ -0,0 +1,99 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package simhandler ...
+package simhandler
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	pluginConfig "github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simmodel"
+	iris "github.com/kataras/iris/v12"
+	uuid "github.com/satori/go.uuid"
+	"golang.org/x/crypto/sha3"
+)
+
+// TokenMap is used to define the plugin generated tokens
+type TokenMap struct {
+	Token    string
+	LastUsed time.Time
+}
+
+var tokenDetails []TokenMap
+
+// CreateSession is used to create session for odimra to interact with plugin
+func CreateSession(ctx iris.Context) {
+	var userCreds simmodel.Users
+	rawBodyAsBytes, err := ioutil.ReadAll(ctx.Request().Body)
+	if err != nil {
+		errorMessage := "Unable to validate the credentials: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.WriteString(errorMessage)
+		return
+	}
+	err = json.Unmarshal(rawBodyAsBytes, &userCreds)
+	if err != nil {
+		errorMessage := "Unable to unmarshal user details: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.WriteString(errorMessage)
+		return
+	}
+	if !validate(ctx.Request().Context(), userCreds.Username, userCreds.Password) {
+		errorMessage := "Invalid credentials for session creation"
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		ctx.StatusCode(http.StatusUnauthorized)
+		ctx.WriteString(errorMessage)
+		return
+	}
+	token := uuid.NewV4().String()
+	tokenDetails = append(tokenDetails, TokenMap{
+		Token:    token,
+		LastUsed: time.Now(),
+	})
+	ctx.StatusCode(http.StatusCreated)
+	ctx.Header("X-Auth-Token", token)
+}
+
+// TokenValidation validates sent token with the list of plugin generated tokens
+func TokenValidation(token string) bool {
+	for _, v := range tokenDetails {
+		if token == v.Token {
+			return true
+		}
+	}
+	return false
+}
+
+func validate(ctx context.Context, userName, password string) bool {
+	if pluginConfig.Data.PluginConf.UserName != userName {
+		return false
+	}
+	hash := sha3.New512()
+	hash.Write([]byte(password))
+	hashedPassword := base64.URLEncoding.EncodeToString(hash.Sum(nil))
+	if pluginConfig.Data.PluginConf.Password != hashedPassword {
+		logs.Log(ctx).Error("Username/password does not match")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,157 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package simhandler ...
+package simhandler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	pluginConfig "github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simmodel"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/simulator"
+	iris "github.com/kataras/iris/v12"
+)
+
+const subscriptionsURI = "/redfish/v1/EventService/Subscriptions"
+
+// CreateEventSubscription replaces the subscription of the plugin on the simulated BMC
+func CreateEventSubscription(ctx iris.Context) {
+	deviceDetails, bmc, ok := getBMC(ctx)
+	if !ok {
+		return
+	}
+	if !bmc.Authenticate(deviceDetails.Username, string(deviceDetails.Password)) {
+		logs.Log(ctx.Request().Context()).Error("Authentication with the BMC " + deviceDetails.Host + " failed")
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.WriteString("Authentication with the device failed")
+		return
+	}
+	var reqPostBody simmodel.EvtSubPost
+	reqData := translate(string(deviceDetails.PostBody), pluginConfig.Data.URLTranslation.SouthBoundURL)
+	if err := json.Unmarshal([]byte(reqData), &reqPostBody); err != nil {
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.WriteString(err.Error())
+		return
+	}
+	writeResponse(ctx, subscribe(ctx.Request().Context(), bmc, reqPostBody.EventTypes, reqPostBody.Context))
+}
+
+// DeleteEventSubscription deletes the subscription at the location of the request
+func DeleteEventSubscription(ctx iris.Context) {
+	deviceDetails, bmc, ok := getBMC(ctx)
+	if !ok {
+		return
+	}
+	location, err := url.Parse(deviceDetails.Location)
+	if err != nil {
+		logs.Log(ctx.Request().Context()).Error("Invalid subscription location " + deviceDetails.Location + ": " + err.Error())
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.WriteString(err.Error())
+		return
+	}
+	deviceDetails.PostBody = nil
+	forward(ctx, deviceDetails, bmc, http.MethodDelete, location.Path)
+}
+
+// subscribe deletes the subscriptions of the plugin on the BMC and subscribes to the event types,
+// the events of the simulated BMCs are delivered to the plugin directly whatever the destination
+func subscribe(ctx context.Context, bmc *simulator.BMC, eventTypes []string, evtContext string) simulator.Response {
+	for uri := range pluginSubscriptions(ctx, bmc) {
+		bmc.Do(http.MethodDelete, uri, nil)
+	}
+	body, _ := json.Marshal(simmodel.EvtSubPost{
+		Destination: eventDestination(),
+		EventTypes:  eventTypes,
+		Context:     evtContext,
+		Protocol:    "Redfish",
+	})
+	return bmc.Do(http.MethodPost, subscriptionsURI, body)
+}
+
+// pluginSubscriptions returns the event types of the subscriptions of the plugin on the BMC by URI
+func pluginSubscriptions(ctx context.Context, bmc *simulator.BMC) map[string][]string {
+	subscriptions := make(map[string][]string)
+	var collection struct {
+		Members []struct {
+			OdataID string `json:"@odata.id"`
+		} `json:"Members"`
+	}
+	resp := bmc.Do(http.MethodGet, subscriptionsURI, nil)
+	if err := json.Unmarshal(resp.Body, &collection); err != nil {
+		logs.Log(ctx).Error("Unable to read the subscriptions of the BMC " + bmc.Address + ": " + err.Error())
+		return subscriptions
+	}
+	for _, member := range collection.Members {
+		var subscription simmodel.EvtSubPost
+		resp := bmc.Do(http.MethodGet, member.OdataID, nil)
+		if err := json.Unmarshal(resp.Body, &subscription); err != nil {
+			continue
+		}
+		if subscription.Destination == eventDestination() {
+			subscriptions[member.OdataID] = subscription.EventTypes
+		}
+	}
+	return subscriptions
+}
+
+// eventDestination is the destination of the subscriptions of the plugin
+func eventDestination() string {
+	return "https://" + pluginConfig.Data.PluginConf.Host + ":" + pluginConfig.Data.PluginConf.Port
+}
+
+// GetPluginStartup subscribes to the event types of the devices when the subscriptions
+// of the plugin differ and returns the locations of the subscriptions by device
+func GetPluginStartup(ctx iris.Context) {
+	if !validateToken(ctx) {
+		return
+	}
+	var startup []simmodel.Startup
+	if err := ctx.ReadJSON(&startup); err != nil {
+		errMsg := "Unable to collect data from request: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errMsg)
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.WriteString(errMsg)
+		return
+	}
+	respBody := make(map[string]string)
+	for _, server := range startup {
+		bmc, ok := Simulator.BMC(server.Device.Host)
+		if !ok {
+			logs.Log(ctx.Request().Context()).Warn("no BMC is simulated at " + server.Device.Host)
+			continue
+		}
+		eventTypes := server.EventTypes
+		if len(eventTypes) == 0 {
+			eventTypes = []string{"Alert"}
+		}
+		location := ""
+		for uri, types := range pluginSubscriptions(ctx.Request().Context(), bmc) {
+			if reflect.DeepEqual(types, eventTypes) {
+				location = "https://" + bmc.Address + uri
+			}
+		}
+		if location == "" {
+			location = subscribe(ctx.Request().Context(), bmc, eventTypes, "Event Subscription").Header["Location"]
+		}
+		respBody[server.Device.Host] = translate(location, pluginConfig.Data.URLTranslation.NorthBoundURL)
+	}
+	ctx.StatusCode(http.StatusOK)
+	ctx.JSON(respBody)
+}
@@ -0,0 +1,46 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package simmessagebus ...
+package simmessagebus
+
+import (
+	"context"
+
+	dc "github.com/ODIM-Project/ODIM/lib-messagebus/datacommunicator"
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Publish function will publish the events of the simulated BMCs to the message bus, it
+// returns once the event is queued and the failure to publish it is logged
+func Publish(data interface{}) bool {
+	if data == nil {
+		log.Error("Nil data passed to event publisher")
+		return false
+	}
+	event := data.(common.Events)
+
+	K := dc.GetProducer(dc.KAFKA, config.Data.MessageBusConf.MessageQueueConfigFilePath)
+	topic := config.Data.MessageBusConf.EmbQueue[0]
+	K.DistributeAsync(context.Background(), topic, event, func(err error) {
+		if err != nil {
+			log.Error("Unable Publish events to kafka, got:" + err.Error())
+			return
+		}
+		log.Info("forwarded event" + string(event.Request))
+	})
+	return true
+}
@@ -0,0 +1,29 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package simmiddleware
+
+import (
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/plugin-simulator/config"
+	iris "github.com/kataras/iris/v12"
+)
+
+// PluginID adds the ID of the plugin to the correlation fields of the request context,
+// so the entries logged for the request carry it
+func PluginID(ctx iris.Context) {
+	req := ctx.Request()
+	ctx.ResetRequest(req.WithContext(logs.With(req.Context(), logs.PluginIDField, config.Data.PluginConf.ID)))
+	ctx.Next()
+}
@@ -0,0 +1,197 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package simulator
+
+import (
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+)
+
+// resetSystem changes the power state of the system, powering on the system applies
+// its pending settings
+func (b *BMC) resetSystem(uri string, system, request map[string]interface{}) Response {
+	resetType, resp := b.resetType(system, "ComputerSystem.Reset", request)
+	if resetType == "" {
+		return resp
+	}
+	on := system["PowerState"] == "On"
+	switch resetType {
+	case "On", "ForceOn":
+		if !on {
+			b.powerOn(uri, system)
+		}
+	case "ForceOff", "GracefulShutdown":
+		if on {
+			b.powerOff(uri, system)
+		}
+	case "GracefulRestart", "ForceRestart", "PowerCycle":
+		if on {
+			b.powerOff(uri, system)
+		}
+		b.powerOn(uri, system)
+	case "PushPowerButton":
+		if on {
+			b.powerOff(uri, system)
+		} else {
+			b.powerOn(uri, system)
+		}
+	case "Nmi":
+	default:
+		return errorResponse(http.StatusBadRequest, response.PropertyValueNotInList, "", resetType, "ResetType")
+	}
+	return successResponse(http.StatusOK)
+}
+
+// resetType returns the reset type of the request, or the error response if it's
+// missing or not allowed by the action
+func (b *BMC) resetType(resource map[string]interface{}, action string, request map[string]interface{}) (string, Response) {
+	resetType, _ := request["ResetType"].(string)
+	if resetType == "" {
+		return "", errorResponse(http.StatusBadRequest, response.PropertyMissing, "", "ResetType")
+	}
+	if values := allowableValues(resource, action, "ResetType"); values != nil && !contains(values, resetType) {
+		return "", errorResponse(http.StatusBadRequest, response.PropertyValueNotInList, "", resetType, "ResetType")
+	}
+	return resetType, Response{}
+}
+
+func (b *BMC) powerOn(uri string, system map[string]interface{}) {
+	b.setPowerState(system, "On")
+	b.applySettings(uri)
+	b.raiseEvent("Alert", "OK", serverPoweredOn, "The server is powered on.", uri)
+	b.raiseEvent("Alert", "OK", serverPostComplete, "The server completed the power-on self-test.", uri)
+}
+
+func (b *BMC) powerOff(uri string, system map[string]interface{}) {
+	b.setPowerState(system, "Off")
+	b.raiseEvent("Alert", "OK", serverPoweredOff, "The server is powered off.", uri)
+}
+
+// setPowerState sets the power state of the system and of its chassis
+func (b *BMC) setPowerState(system map[string]interface{}, powerState string) {
+	system["PowerState"] = powerState
+	links, _ := system["Links"].(map[string]interface{})
+	chassis, _ := links["Chassis"].([]interface{})
+	for _, link := range chassis {
+		if link, ok := link.(map[string]interface{}); ok {
+			if uri, ok := link["@odata.id"].(string); ok && b.resources[uri] != nil {
+				b.resources[uri]["PowerState"] = powerState
+			}
+		}
+	}
+}
+
+// applySettings applies the settings objects of the resources of the system to the
+// resources and empties the settings objects
+func (b *BMC) applySettings(systemURI string) {
+	for uri, resource := range b.resources {
+		if !strings.HasPrefix(uri, systemURI+"/") {
+			continue
+		}
+		settings, ok := b.resources[settingsObject(resource)]
+		if !ok {
+			continue
+		}
+		pending := false
+		for name, value := range settings {
+			if strings.HasPrefix(name, "@") || name == "Id" || name == "Name" || name == "Description" ||
+				reflect.DeepEqual(value, resource[name]) {
+				continue
+			}
+			if object, ok := value.(map[string]interface{}); ok {
+				if len(object) == 0 {
+					continue
+				}
+				settings[name] = map[string]interface{}{}
+			} else {
+				delete(settings, name)
+			}
+			merge(resource, map[string]interface{}{name: value})
+			pending = true
+		}
+		if pending {
+			b.raiseEvent("ResourceUpdated", "OK", resourceChanged, "The resource has been changed.", uri)
+		}
+	}
+}
+
+// setDefaultBootOrder restores the boot order of the system in the mockup
+func (b *BMC) setDefaultBootOrder(uri string, system map[string]interface{}) Response {
+	bootOrder, ok := b.defaultBootOrders[uri]
+	if !ok {
+		return errorResponse(http.StatusBadRequest, response.ActionNotSupported, "the system has no boot order", "ComputerSystem.SetDefaultBootOrder")
+	}
+	system["Boot"].(map[string]interface{})["BootOrder"] = deepCopy(bootOrder)
+	b.raiseEvent("ResourceUpdated", "OK", resourceChanged, "The resource has been changed.", uri)
+	return successResponse(http.StatusOK)
+}
+
+func (b *BMC) resetManager(uri string, manager, request map[string]interface{}) Response {
+	resetType, resp := b.resetType(manager, "Manager.Reset", request)
+	if resetType == "" {
+		return resp
+	}
+	b.raiseEvent("Alert", "OK", managerReset, "The manager has been reset.", uri, resetType)
+	return successResponse(http.StatusOK)
+}
+
+// simpleUpdate sets the version of the targets to the name of the image file without
+// its extension, all the firmware inventory is updated when the request has no targets
+func (b *BMC) simpleUpdate(request map[string]interface{}) Response {
+	imageURI, _ := request["ImageURI"].(string)
+	if imageURI == "" {
+		return errorResponse(http.StatusBadRequest, response.PropertyMissing, "", "ImageURI")
+	}
+	imageName := path.Base(imageURI)
+	version := strings.TrimSuffix(imageName, path.Ext(imageName))
+
+	var targets []string
+	if values, ok := request["Targets"].([]interface{}); ok {
+		for _, value := range values {
+			if target, ok := value.(string); ok {
+				targets = append(targets, cleanURI(target))
+			}
+		}
+	}
+	if len(targets) == 0 {
+		inventory, _ := b.resources[serviceRootURI+"/UpdateService"]["FirmwareInventory"].(map[string]interface{})
+		uri, _ := inventory["@odata.id"].(string)
+		targets = b.members(uri)
+	}
+	properties := map[string]string{
+		"SoftwareInventory": "Version",
+		"ComputerSystem":    "BiosVersion",
+		"Manager":           "FirmwareVersion",
+	}
+	for _, target := range targets {
+		resource, ok := b.resources[target]
+		if !ok {
+			return errorResponse(http.StatusBadRequest, response.ResourceNotFound, "", "Target", target)
+		}
+		if _, ok := properties[resourceType(resource)]; !ok {
+			return errorResponse(http.StatusBadRequest, response.ActionParameterNotSupported, "", target, "Targets")
+		}
+	}
+	for _, target := range targets {
+		resource := b.resources[target]
+		resource[properties[resourceType(resource)]] = version
+		b.raiseEvent("ResourceUpdated", "OK", updateSuccessful, "The update completed successfully.", target, imageURI, target)
+	}
+	return successResponse(http.StatusOK)
+}
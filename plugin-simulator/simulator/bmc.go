@@ -0,0 +1,453 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	uuid "github.com/satori/go.uuid"
+)
+
+// BMC is a simulated BMC serving the resources of its mockup
+type BMC struct {
+	Address  string
+	UserName string
+	Password string
+
+	lock      sync.Mutex
+	resources map[string]map[string]interface{}
+	// defaultBootOrders holds the boot order of the systems in the mockup, restored by
+	// the ComputerSystem.SetDefaultBootOrder action
+	defaultBootOrders map[string]interface{}
+	handler           EventHandler
+	// events holds the events raised by the request being processed
+	events             []common.Event
+	lastEventID        int
+	lastSubscriptionID int
+}
+
+// NewBMC returns the simulated BMC loaded from its mockup directory
+func NewBMC(conf BMCConf, handler EventHandler) (*BMC, error) {
+	resources, err := loadMockup(conf.MockupDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the mockup of the BMC %s: %v", conf.Address, err)
+	}
+	bmc := &BMC{
+		Address:           conf.Address,
+		UserName:          conf.UserName,
+		Password:          conf.Password,
+		resources:         resources,
+		defaultBootOrders: make(map[string]interface{}),
+		handler:           handler,
+	}
+	bmc.initialize()
+	return bmc, nil
+}
+
+// loadMockup reads the index.json files of the mockup directory, the directory is either
+// the root of the mockup or the service root of the mockup
+func loadMockup(directory string) (map[string]map[string]interface{}, error) {
+	root := filepath.Join(directory, "redfish", "v1")
+	if _, err := os.Stat(filepath.Join(root, "index.json")); err != nil {
+		root = directory
+	}
+	if _, err := os.Stat(filepath.Join(root, "index.json")); err != nil {
+		return nil, fmt.Errorf("no service root found in %s", directory)
+	}
+	resources := make(map[string]map[string]interface{})
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || info.Name() != "index.json" {
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var resource map[string]interface{}
+		if err := json.Unmarshal(data, &resource); err != nil {
+			return fmt.Errorf("invalid resource %s: %v", path, err)
+		}
+		rel, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		uri := serviceRootURI
+		if rel != "." {
+			uri += "/" + filepath.ToSlash(rel)
+		}
+		resources[uri] = resource
+		return nil
+	})
+	return resources, err
+}
+
+// initialize gives the BMC its own identity and makes sure it has an event service
+func (b *BMC) initialize() {
+	for uri, resource := range b.resources {
+		// the BMCs sharing a mockup get distinct UUIDs, which stay the same across restarts
+		if id, ok := resource["UUID"].(string); ok && id != "" {
+			resource["UUID"] = uuid.NewV5(uuid.NamespaceURL, b.Address+"/"+id).String()
+		}
+		if resourceType(resource) == "ComputerSystem" {
+			if boot, ok := resource["Boot"].(map[string]interface{}); ok && boot["BootOrder"] != nil {
+				b.defaultBootOrders[uri] = deepCopy(boot["BootOrder"])
+			}
+		}
+	}
+	if _, ok := b.resources[eventServiceURI]; !ok {
+		b.resources[eventServiceURI] = map[string]interface{}{
+			"@odata.id":      eventServiceURI,
+			"@odata.type":    "#EventService.v1_5_0.EventService",
+			"Id":             "EventService",
+			"Name":           "Event Service",
+			"ServiceEnabled": true,
+			"Subscriptions":  map[string]interface{}{"@odata.id": subscriptionsURI},
+		}
+		b.resources[serviceRootURI]["EventService"] = map[string]interface{}{"@odata.id": eventServiceURI}
+	}
+	if _, ok := b.resources[subscriptionsURI]; !ok {
+		b.resources[subscriptionsURI] = map[string]interface{}{
+			"@odata.id":           subscriptionsURI,
+			"@odata.type":         "#EventDestinationCollection.EventDestinationCollection",
+			"Name":                "Event Subscriptions",
+			"Members":             []interface{}{},
+			"Members@odata.count": 0,
+		}
+	}
+	for _, member := range b.members(subscriptionsURI) {
+		if id, err := strconv.Atoi(lastSegment(member)); err == nil && id > b.lastSubscriptionID {
+			b.lastSubscriptionID = id
+		}
+	}
+}
+
+// Authenticate checks the credentials against the ones of the BMC
+func (b *BMC) Authenticate(userName, password string) bool {
+	return userName == b.UserName && password == b.Password
+}
+
+// ServiceRootUUID returns the UUID of the service root of the BMC
+func (b *BMC) ServiceRootUUID() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	id, _ := b.resources[serviceRootURI]["UUID"].(string)
+	return id
+}
+
+// Do processes the request on the resource of the URI and sends the events raised
+// by the request to the subscribers
+func (b *BMC) Do(method, uri string, body []byte) Response {
+	uri = cleanURI(uri)
+	b.lock.Lock()
+	var resp Response
+	switch method {
+	case http.MethodGet:
+		resp = b.get(uri)
+	case http.MethodPatch:
+		resp = b.patch(uri, body)
+	case http.MethodPost:
+		resp = b.post(uri, body)
+	case http.MethodDelete:
+		resp = b.delete(uri)
+	default:
+		resp = errorResponse(http.StatusMethodNotAllowed, response.ActionNotSupported, "", method)
+	}
+	messages := b.eventMessages()
+	b.lock.Unlock()
+
+	if b.handler != nil {
+		for _, message := range messages {
+			b.handler(b.Address, message)
+		}
+	}
+	return resp
+}
+
+func (b *BMC) get(uri string) Response {
+	resource, ok := b.resources[uri]
+	if !ok {
+		return resourceNotFound(uri)
+	}
+	return jsonResponse(http.StatusOK, resource)
+}
+
+// patch updates the resource with the properties of the request, the properties of the
+// resources with a settings object are stored in the settings object to be applied on
+// the next reset of the system
+func (b *BMC) patch(uri string, body []byte) Response {
+	resource, ok := b.resources[uri]
+	if !ok {
+		return resourceNotFound(uri)
+	}
+	request, resp := decodeRequest(body)
+	if request == nil {
+		return resp
+	}
+	target, current := uri, resource
+	if settings := settingsObject(resource); settings != "" {
+		target = settings
+	} else if owner := b.settingsOwner(uri); owner != nil {
+		current = owner
+	}
+	if resp := validateProperties(current, request, ""); resp != nil {
+		return *resp
+	}
+	if _, ok := b.resources[target]; !ok {
+		b.resources[target] = map[string]interface{}{
+			"@odata.id":   target,
+			"@odata.type": resource["@odata.type"],
+		}
+	}
+	merge(b.resources[target], request)
+	b.raiseEvent("ResourceUpdated", "OK", resourceChanged, "The resource has been changed.", target)
+	return successResponse(http.StatusOK)
+}
+
+// post processes the actions of the resources and the creation of the members of the collections
+func (b *BMC) post(uri string, body []byte) Response {
+	request, resp := decodeRequest(body)
+	if request == nil {
+		return resp
+	}
+	if index := strings.Index(uri, "/Actions/"); index >= 0 {
+		resourceURI, action := uri[:index], uri[index+len("/Actions/"):]
+		resource, ok := b.resources[resourceURI]
+		if !ok {
+			return resourceNotFound(resourceURI)
+		}
+		if !hasAction(resource, action) {
+			return errorResponse(http.StatusNotFound, response.ActionNotSupported, "", action)
+		}
+		switch action {
+		case "ComputerSystem.Reset":
+			return b.resetSystem(resourceURI, resource, request)
+		case "ComputerSystem.SetDefaultBootOrder":
+			return b.setDefaultBootOrder(resourceURI, resource)
+		case "Manager.Reset":
+			return b.resetManager(resourceURI, resource, request)
+		case "UpdateService.SimpleUpdate":
+			return b.simpleUpdate(request)
+		case "EventService.SubmitTestEvent":
+			return b.submitTestEvent(request)
+		}
+		return errorResponse(http.StatusBadRequest, response.ActionNotSupported, "the action is not simulated", action)
+	}
+	collection, ok := b.resources[uri]
+	if !ok {
+		return resourceNotFound(uri)
+	}
+	switch {
+	case uri == subscriptionsURI:
+		return b.createSubscription(request)
+	case resourceType(collection) == "VolumeCollection":
+		return b.createVolume(uri, request)
+	}
+	return errorResponse(http.StatusMethodNotAllowed, response.ActionNotSupported, "", http.MethodPost)
+}
+
+// delete removes the event subscriptions and the volumes
+func (b *BMC) delete(uri string) Response {
+	if _, ok := b.resources[uri]; !ok {
+		return resourceNotFound(uri)
+	}
+	parent := parentURI(uri)
+	switch {
+	case parent == subscriptionsURI:
+		b.removeResource(uri)
+		return successResponse(http.StatusOK)
+	case resourceType(b.resources[parent]) == "VolumeCollection":
+		return b.deleteVolume(uri)
+	}
+	return errorResponse(http.StatusMethodNotAllowed, response.ResourceCannotBeDeleted, "")
+}
+
+// settingsOwner returns the resource whose settings object is the resource of the URI
+func (b *BMC) settingsOwner(uri string) map[string]interface{} {
+	for _, resource := range b.resources {
+		if settingsObject(resource) == uri {
+			return resource
+		}
+	}
+	return nil
+}
+
+// members returns the URIs of the members of the collection
+func (b *BMC) members(collectionURI string) []string {
+	var uris []string
+	members, _ := b.resources[collectionURI]["Members"].([]interface{})
+	for _, member := range members {
+		if link, ok := member.(map[string]interface{}); ok {
+			if uri, ok := link["@odata.id"].(string); ok {
+				uris = append(uris, uri)
+			}
+		}
+	}
+	return uris
+}
+
+// addResource adds the resource to the BMC and to its collection
+func (b *BMC) addResource(uri string, resource map[string]interface{}) {
+	b.resources[uri] = resource
+	collection := b.resources[parentURI(uri)]
+	members, _ := collection["Members"].([]interface{})
+	collection["Members"] = append(members, map[string]interface{}{"@odata.id": uri})
+	collection["Members@odata.count"] = len(members) + 1
+}
+
+// removeResource removes the resource from the BMC and from its collection
+func (b *BMC) removeResource(uri string) {
+	delete(b.resources, uri)
+	collection := b.resources[parentURI(uri)]
+	members, _ := collection["Members"].([]interface{})
+	remaining := []interface{}{}
+	for _, member := range members {
+		if link, ok := member.(map[string]interface{}); ok && link["@odata.id"] == uri {
+			continue
+		}
+		remaining = append(remaining, member)
+	}
+	collection["Members"] = remaining
+	collection["Members@odata.count"] = len(remaining)
+}
+
+// nextMemberID returns the ID following the highest numeric ID of the members of the collection
+func (b *BMC) nextMemberID(collectionURI string) string {
+	last := 0
+	for _, member := range b.members(collectionURI) {
+		if id, err := strconv.Atoi(lastSegment(member)); err == nil && id > last {
+			last = id
+		}
+	}
+	return strconv.Itoa(last + 1)
+}
+
+func resourceNotFound(uri string) Response {
+	return errorResponse(http.StatusNotFound, response.ResourceNotFound, "", "Resource", uri)
+}
+
+// decodeRequest returns the properties of the request body, or the error response
+// if the body is not a JSON object. An empty body has no properties.
+func decodeRequest(body []byte) (map[string]interface{}, Response) {
+	request := make(map[string]interface{})
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return request, Response{}
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil, errorResponse(http.StatusBadRequest, response.MalformedJSON, err.Error())
+	}
+	return request, Response{}
+}
+
+// validateProperties checks the properties of the request exist in the resource and
+// have one of the allowable values of the resource
+func validateProperties(resource, request map[string]interface{}, path string) *Response {
+	for name, value := range request {
+		if strings.HasPrefix(name, "@odata.") {
+			continue
+		}
+		current, ok := resource[name]
+		if !ok {
+			resp := errorResponse(http.StatusBadRequest, response.PropertyUnknown, "", path+name)
+			return &resp
+		}
+		if values, ok := resource[name+"@Redfish.AllowableValues"].([]interface{}); ok && !contains(values, value) {
+			resp := errorResponse(http.StatusBadRequest, response.PropertyValueNotInList, "", fmt.Sprintf("%v", value), path+name)
+			return &resp
+		}
+		currentObject, ok := current.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if object, ok := value.(map[string]interface{}); ok {
+			if resp := validateProperties(currentObject, object, path+name+"/"); resp != nil {
+				return resp
+			}
+		}
+	}
+	return nil
+}
+
+// merge copies the properties of src into dst, merging the objects present in both
+func merge(dst, src map[string]interface{}) {
+	for name, value := range src {
+		object, ok := value.(map[string]interface{})
+		current, isObject := dst[name].(map[string]interface{})
+		if ok && isObject {
+			merge(current, object)
+			continue
+		}
+		dst[name] = value
+	}
+}
+
+// settingsObject returns the URI of the settings object of the resource
+func settingsObject(resource map[string]interface{}) string {
+	settings, _ := resource["@Redfish.Settings"].(map[string]interface{})
+	object, _ := settings["SettingsObject"].(map[string]interface{})
+	uri, _ := object["@odata.id"].(string)
+	return uri
+}
+
+// resourceType returns the type of the resource without its namespace version,
+// ComputerSystem for #ComputerSystem.v1_10_0.ComputerSystem
+func resourceType(resource map[string]interface{}) string {
+	odataType, _ := resource["@odata.type"].(string)
+	odataType = strings.TrimPrefix(odataType, "#")
+	return strings.Split(odataType, ".")[0]
+}
+
+// hasAction tells whether the action is one of the actions of the resource
+func hasAction(resource map[string]interface{}, action string) bool {
+	actions, _ := resource["Actions"].(map[string]interface{})
+	_, ok := actions["#"+action]
+	return ok
+}
+
+// allowableValues returns the allowable values of the parameter of the action of the resource
+func allowableValues(resource map[string]interface{}, action, parameter string) []interface{} {
+	actions, _ := resource["Actions"].(map[string]interface{})
+	info, _ := actions["#"+action].(map[string]interface{})
+	values, _ := info[parameter+"@Redfish.AllowableValues"].([]interface{})
+	return values
+}
+
+func contains(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func deepCopy(value interface{}) interface{} {
+	data, _ := json.Marshal(value)
+	var copied interface{}
+	json.Unmarshal(data, &copied)
+	return copied
+}
@@ -0,0 +1,275 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package simulator
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+)
+
+const mockupDirectory = "../mockups/simulated-rackmount"
+
+// testBMC is a BMC of the mockup subscribed to all the events, the events are recorded
+type testBMC struct {
+	*BMC
+	t      *testing.T
+	events []common.Event
+}
+
+func newTestBMC(t *testing.T) *testBMC {
+	b := &testBMC{t: t}
+	bmc, err := NewBMC(BMCConf{
+		Address:         "10.0.0.1",
+		UserName:        "admin",
+		Password:        "password",
+		MockupDirectory: mockupDirectory,
+	}, func(address string, message common.MessageData) {
+		if address != "10.0.0.1" {
+			t.Errorf("event sent for the address %v", address)
+		}
+		b.events = append(b.events, message.Events...)
+	})
+	if err != nil {
+		t.Fatalf("NewBMC() error = %v", err)
+	}
+	b.BMC = bmc
+	b.do(http.MethodPost, subscriptionsURI, `{"Destination":"https://odim","Context":"ODIM"}`, http.StatusCreated)
+	return b
+}
+
+// do sends the request to the BMC and checks the status code of the response
+func (b *testBMC) do(method, uri, body string, statusCode int) Response {
+	b.t.Helper()
+	resp := b.Do(method, uri, []byte(body))
+	if resp.StatusCode != statusCode {
+		b.t.Fatalf("%v %v StatusCode = %v, want %v: %s", method, uri, resp.StatusCode, statusCode, resp.Body)
+	}
+	return resp
+}
+
+// get returns the resource of the URI
+func (b *testBMC) get(uri string) map[string]interface{} {
+	b.t.Helper()
+	var resource map[string]interface{}
+	if err := json.Unmarshal(b.do(http.MethodGet, uri, "", http.StatusOK).Body, &resource); err != nil {
+		b.t.Fatalf("GET %v returned an invalid body: %v", uri, err)
+	}
+	return resource
+}
+
+// messageIDs returns the message IDs of the recorded events and clears them
+func (b *testBMC) messageIDs() []string {
+	var ids []string
+	for _, event := range b.events {
+		ids = append(ids, event.MessageID)
+	}
+	b.events = nil
+	return ids
+}
+
+func TestNewBMC(t *testing.T) {
+	first, err := NewBMC(BMCConf{Address: "10.0.0.1", MockupDirectory: mockupDirectory}, nil)
+	if err != nil {
+		t.Fatalf("NewBMC() error = %v", err)
+	}
+	second, _ := NewBMC(BMCConf{Address: "10.0.0.2", MockupDirectory: mockupDirectory + "/redfish/v1"}, nil)
+	again, _ := NewBMC(BMCConf{Address: "10.0.0.1", MockupDirectory: mockupDirectory}, nil)
+	if first.ServiceRootUUID() == second.ServiceRootUUID() {
+		t.Errorf("NewBMC() gave the same UUID to the BMCs of the same mockup")
+	}
+	if first.ServiceRootUUID() != again.ServiceRootUUID() {
+		t.Errorf("NewBMC() gave different UUIDs to the same BMC")
+	}
+	if _, err := NewBMC(BMCConf{Address: "10.0.0.1", MockupDirectory: "mockups"}, nil); err == nil {
+		t.Errorf("NewBMC() loaded a mockup without service root")
+	}
+	conf := BMCConf{Address: "10.0.0.1", MockupDirectory: mockupDirectory}
+	if _, err := New([]BMCConf{conf, conf}, nil); err == nil {
+		t.Errorf("New() accepted the same address twice")
+	}
+}
+
+func TestGetAndPatch(t *testing.T) {
+	b := newTestBMC(t)
+	b.do(http.MethodGet, "/redfish/v1/Systems/1/?$expand=.", "", http.StatusOK)
+	b.do(http.MethodGet, "/redfish/v1/Systems/2", "", http.StatusNotFound)
+	b.do(http.MethodPut, "/redfish/v1/Systems/1", "{}", http.StatusMethodNotAllowed)
+
+	b.do(http.MethodPatch, "/redfish/v1/Systems/1", `{"Boot":{"BootSourceOverrideTarget":"Pxe","BootSourceOverrideEnabled":"Once"}}`, http.StatusOK)
+	boot := b.get("/redfish/v1/Systems/1")["Boot"].(map[string]interface{})
+	if boot["BootSourceOverrideTarget"] != "Pxe" || boot["BootSourceOverrideEnabled"] != "Once" || boot["BootOrder"] == nil {
+		t.Errorf("PATCH updated the boot properties to %v", boot)
+	}
+	b.do(http.MethodPatch, "/redfish/v1/Systems/1", `{"Boot":{"BootSourceOverrideTarget":"Floppy"}}`, http.StatusBadRequest)
+	b.do(http.MethodPatch, "/redfish/v1/Systems/1", `{"Boot":{"Unknown":"value"}}`, http.StatusBadRequest)
+	b.do(http.MethodPatch, "/redfish/v1/Systems/1", `{"Boot":`, http.StatusBadRequest)
+}
+
+func TestBiosSettings(t *testing.T) {
+	b := newTestBMC(t)
+	b.do(http.MethodPatch, "/redfish/v1/Systems/1/Bios/Settings", `{"Attributes":{"UnknownAttribute":"value"}}`, http.StatusBadRequest)
+	b.do(http.MethodPatch, "/redfish/v1/Systems/1/Bios/Settings", `{"Attributes":{"BootMode":"LegacyBios"}}`, http.StatusOK)
+	b.do(http.MethodPatch, "/redfish/v1/Systems/1/Bios", `{"Attributes":{"ProcTurboMode":"Disabled"}}`, http.StatusOK)
+	if mode := b.get("/redfish/v1/Systems/1/Bios")["Attributes"].(map[string]interface{})["BootMode"]; mode != "Uefi" {
+		t.Errorf("the BIOS settings were applied before the reset, BootMode = %v", mode)
+	}
+	b.events = nil
+
+	b.do(http.MethodPost, "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset", `{"ResetType":"ForceRestart"}`, http.StatusOK)
+	attributes := b.get("/redfish/v1/Systems/1/Bios")["Attributes"].(map[string]interface{})
+	if attributes["BootMode"] != "LegacyBios" || attributes["ProcTurboMode"] != "Disabled" || attributes["UsbControl"] != "UsbEnabled" {
+		t.Errorf("the reset applied the BIOS attributes %v", attributes)
+	}
+	if pending := b.get("/redfish/v1/Systems/1/Bios/Settings")["Attributes"]; !reflect.DeepEqual(pending, map[string]interface{}{}) {
+		t.Errorf("the reset left the pending BIOS attributes %v", pending)
+	}
+	want := []string{serverPoweredOff, resourceChanged, serverPoweredOn, serverPostComplete}
+	if got := b.messageIDs(); !reflect.DeepEqual(got, want) {
+		t.Errorf("the reset raised the events %v, want %v", got, want)
+	}
+}
+
+func TestResetSystem(t *testing.T) {
+	b := newTestBMC(t)
+	reset := "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset"
+	b.do(http.MethodPost, reset, `{}`, http.StatusBadRequest)
+	b.do(http.MethodPost, reset, `{"ResetType":"Explode"}`, http.StatusBadRequest)
+	b.do(http.MethodPost, "/redfish/v1/Systems/1/Actions/ComputerSystem.Explode", `{}`, http.StatusNotFound)
+
+	b.do(http.MethodPost, reset, `{"ResetType":"ForceOff"}`, http.StatusOK)
+	if state := b.get("/redfish/v1/Systems/1")["PowerState"]; state != "Off" {
+		t.Errorf("ForceOff set the PowerState to %v", state)
+	}
+	if state := b.get("/redfish/v1/Chassis/1")["PowerState"]; state != "Off" {
+		t.Errorf("ForceOff set the PowerState of the chassis to %v", state)
+	}
+	b.do(http.MethodPost, reset, `{"ResetType":"ForceOff"}`, http.StatusOK)
+	if got := b.messageIDs(); !reflect.DeepEqual(got, []string{serverPoweredOff}) {
+		t.Errorf("ForceOff raised the events %v", got)
+	}
+
+	b.do(http.MethodPost, reset, `{"ResetType":"PushPowerButton"}`, http.StatusOK)
+	if state := b.get("/redfish/v1/Systems/1")["PowerState"]; state != "On" {
+		t.Errorf("PushPowerButton set the PowerState to %v", state)
+	}
+	if got := b.messageIDs(); !reflect.DeepEqual(got, []string{serverPoweredOn, serverPostComplete}) {
+		t.Errorf("PushPowerButton raised the events %v", got)
+	}
+	b.do(http.MethodPost, "/redfish/v1/Managers/1/Actions/Manager.Reset", `{"ResetType":"GracefulRestart"}`, http.StatusOK)
+	if len(b.events) != 1 || b.events[0].OriginOfCondition.Oid != "/redfish/v1/Managers/1" {
+		t.Errorf("Manager.Reset raised the events %+v", b.events)
+	}
+}
+
+func TestSetDefaultBootOrder(t *testing.T) {
+	b := newTestBMC(t)
+	system := b.get("/redfish/v1/Systems/1")
+	defaultOrder := system["Boot"].(map[string]interface{})["BootOrder"]
+	b.do(http.MethodPatch, "/redfish/v1/Systems/1", `{"Boot":{"BootOrder":["Boot0003","Boot0001","Boot0002"]}}`, http.StatusOK)
+	b.do(http.MethodPost, "/redfish/v1/Systems/1/Actions/ComputerSystem.SetDefaultBootOrder", "", http.StatusOK)
+	order := b.get("/redfish/v1/Systems/1")["Boot"].(map[string]interface{})["BootOrder"]
+	if !reflect.DeepEqual(order, defaultOrder) {
+		t.Errorf("SetDefaultBootOrder set the boot order to %v, want %v", order, defaultOrder)
+	}
+}
+
+func TestVolumes(t *testing.T) {
+	b := newTestBMC(t)
+	volumes := "/redfish/v1/Systems/1/Storage/1/Volumes"
+	drives := `[{"@odata.id":"/redfish/v1/Systems/1/Storage/1/Drives/0"},{"@odata.id":"/redfish/v1/Systems/1/Storage/1/Drives/1"}]`
+	b.do(http.MethodPost, volumes, `{"Drives":`+drives+`}`, http.StatusBadRequest)
+	b.do(http.MethodPost, volumes, `{"RAIDType":"RAID6","Drives":`+drives+`}`, http.StatusBadRequest)
+	b.do(http.MethodPost, volumes, `{"RAIDType":"RAID5","Drives":`+drives+`}`, http.StatusBadRequest)
+	b.do(http.MethodPost, volumes, `{"RAIDType":"RAID0","Drives":[{"@odata.id":"/redfish/v1/Systems/1/Storage/1/Drives/9"}]}`, http.StatusBadRequest)
+
+	resp := b.do(http.MethodPost, volumes, `{"Name":"Mirror","RAIDType":"RAID1","Drives":`+drives+`}`, http.StatusCreated)
+	uri := resp.Header["Location"]
+	if uri != volumes+"/1" {
+		t.Fatalf("the volume was created at %v", uri)
+	}
+	volume := b.get(uri)
+	if volume["Name"] != "Mirror" || volume["CapacityBytes"] != float64(480103981056) {
+		t.Errorf("the volume was created as %v", volume)
+	}
+	if count := b.get(volumes)["Members@odata.count"]; count != float64(1) {
+		t.Errorf("the volume collection has %v members", count)
+	}
+	b.do(http.MethodDelete, uri, "", http.StatusNoContent)
+	b.do(http.MethodGet, uri, "", http.StatusNotFound)
+	if got := b.messageIDs(); !reflect.DeepEqual(got, []string{resourceCreated, resourceRemoved}) {
+		t.Errorf("the volume raised the events %v", got)
+	}
+	if len(b.get(volumes)["Members"].([]interface{})) != 0 {
+		t.Errorf("the volume wasn't removed from the collection")
+	}
+	b.do(http.MethodDelete, "/redfish/v1/Systems/1", "", http.StatusMethodNotAllowed)
+}
+
+func TestSimpleUpdate(t *testing.T) {
+	b := newTestBMC(t)
+	update := "/redfish/v1/UpdateService/Actions/UpdateService.SimpleUpdate"
+	b.do(http.MethodPost, update, `{}`, http.StatusBadRequest)
+	b.do(http.MethodPost, update, `{"ImageURI":"http://images/bmc-2.00.bin","Targets":["/redfish/v1/Chassis/1"]}`, http.StatusBadRequest)
+
+	b.do(http.MethodPost, update, `{"ImageURI":"http://images/bmc-2.00.bin","Targets":["/redfish/v1/Managers/1"]}`, http.StatusOK)
+	if version := b.get("/redfish/v1/Managers/1")["FirmwareVersion"]; version != "bmc-2.00" {
+		t.Errorf("the update set the FirmwareVersion to %v", version)
+	}
+	b.do(http.MethodPost, update, `{"ImageURI":"http://images/fw-3.bin"}`, http.StatusOK)
+	for _, uri := range []string{"/redfish/v1/UpdateService/FirmwareInventory/BMC", "/redfish/v1/UpdateService/FirmwareInventory/BIOS"} {
+		if version := b.get(uri)["Version"]; version != "fw-3" {
+			t.Errorf("the update set the Version of %v to %v", uri, version)
+		}
+	}
+	if got := b.messageIDs(); !reflect.DeepEqual(got, []string{updateSuccessful, updateSuccessful, updateSuccessful}) {
+		t.Errorf("the updates raised the events %v", got)
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	b := newTestBMC(t)
+	var messages []common.MessageData
+	b.handler = func(address string, message common.MessageData) {
+		messages = append(messages, message)
+	}
+	b.do(http.MethodPost, subscriptionsURI, `{"Destination":"https://other","EventTypes":["Unknown"]}`, http.StatusBadRequest)
+	b.do(http.MethodPost, subscriptionsURI, `{"EventTypes":["Alert"]}`, http.StatusBadRequest)
+	resp := b.do(http.MethodPost, subscriptionsURI, `{"Destination":"https://other","EventTypes":["ResourceAdded"],"Context":"other"}`, http.StatusCreated)
+	if resp.Header["Location"] != "https://10.0.0.1/redfish/v1/EventService/Subscriptions/2" {
+		t.Errorf("the subscription was created at %v", resp.Header["Location"])
+	}
+
+	testEvent := "/redfish/v1/EventService/Actions/EventService.SubmitTestEvent"
+	b.do(http.MethodPost, testEvent, `{"MessageId":"Test.1.0.Alert"}`, http.StatusNoContent)
+	if len(messages) != 1 || messages[0].Context != "ODIM" || messages[0].Events[0].EventType != "Alert" {
+		t.Errorf("the test alert was sent as %+v", messages)
+	}
+	messages = nil
+	b.do(http.MethodPost, testEvent, `{"MessageId":"Test.1.0.Added","EventType":"ResourceAdded"}`, http.StatusNoContent)
+	if len(messages) != 2 || messages[1].Context != "other" {
+		t.Errorf("the test event was sent as %+v", messages)
+	}
+
+	b.do(http.MethodDelete, subscriptionsURI+"/2", "", http.StatusOK)
+	messages = nil
+	b.do(http.MethodPost, testEvent, `{"MessageId":"Test.1.0.Added","EventType":"ResourceAdded"}`, http.StatusNoContent)
+	if len(messages) != 1 {
+		t.Errorf("the test event was sent to a deleted subscription")
+	}
+}
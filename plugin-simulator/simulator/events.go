@@ -0,0 +1,160 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+)
+
+const (
+	eventServiceURI  = serviceRootURI + "/EventService"
+	subscriptionsURI = eventServiceURI + "/Subscriptions"
+
+	// the message IDs of the events raised by the simulated BMCs, the power state
+	// messages are the ones odimra reacts to
+	serverPoweredOn    = "Simulator.1.0.ServerPoweredOn"
+	serverPoweredOff   = "Simulator.1.0.ServerPoweredOff"
+	serverPostComplete = "Simulator.1.0.ServerPostComplete"
+	managerReset       = "Simulator.1.0.ManagerReset"
+	resourceChanged    = "ResourceEvent.1.0.3.ResourceChanged"
+	resourceCreated    = "ResourceEvent.1.0.3.ResourceCreated"
+	resourceRemoved    = "ResourceEvent.1.0.3.ResourceRemoved"
+	updateSuccessful   = "Update.1.0.UpdateSuccessful"
+)
+
+// raiseEvent queues the event to be sent once the request is processed
+func (b *BMC) raiseEvent(eventType, severity, messageID, message, originOfCondition string, messageArgs ...string) {
+	b.lastEventID++
+	b.events = append(b.events, common.Event{
+		EventType:         eventType,
+		EventID:           strconv.Itoa(b.lastEventID),
+		Severity:          severity,
+		EventTimestamp:    time.Now().UTC().Format(time.RFC3339),
+		Message:           message,
+		MessageID:         messageID,
+		MessageArgs:       messageArgs,
+		OriginOfCondition: &common.Link{Oid: originOfCondition},
+	})
+}
+
+// eventMessages returns the event messages of the queued events for each subscription
+// and empties the queue
+func (b *BMC) eventMessages() []common.MessageData {
+	if len(b.events) == 0 {
+		return nil
+	}
+	var messages []common.MessageData
+	for _, uri := range b.members(subscriptionsURI) {
+		subscription := b.resources[uri]
+		var eventTypes []interface{}
+		if types, ok := subscription["EventTypes"].([]interface{}); ok {
+			eventTypes = types
+		}
+		var events []common.Event
+		for _, event := range b.events {
+			if len(eventTypes) == 0 || contains(eventTypes, event.EventType) {
+				events = append(events, event)
+			}
+		}
+		if len(events) == 0 {
+			continue
+		}
+		context, _ := subscription["Context"].(string)
+		messages = append(messages, common.MessageData{
+			OdataType: "#Event.v1_2_1.Event",
+			Name:      "Events",
+			Context:   context,
+			Events:    events,
+		})
+	}
+	b.events = nil
+	return messages
+}
+
+func (b *BMC) createSubscription(request map[string]interface{}) Response {
+	destination, _ := request["Destination"].(string)
+	if destination == "" {
+		return errorResponse(http.StatusBadRequest, response.PropertyMissing, "", "Destination")
+	}
+	eventTypes, ok := request["EventTypes"].([]interface{})
+	if !ok {
+		eventTypes = []interface{}{}
+	}
+	if supported, ok := b.resources[eventServiceURI]["EventTypesForSubscription"].([]interface{}); ok {
+		for _, eventType := range eventTypes {
+			if !contains(supported, eventType) {
+				return errorResponse(http.StatusBadRequest, response.PropertyValueNotInList, "", fmt.Sprintf("%v", eventType), "EventTypes")
+			}
+		}
+	}
+	protocol, _ := request["Protocol"].(string)
+	if protocol == "" {
+		protocol = "Redfish"
+	}
+	context, _ := request["Context"].(string)
+
+	b.lastSubscriptionID++
+	id := strconv.Itoa(b.lastSubscriptionID)
+	uri := subscriptionsURI + "/" + id
+	b.addResource(uri, map[string]interface{}{
+		"@odata.id":        uri,
+		"@odata.type":      "#EventDestination.v1_7_0.EventDestination",
+		"Id":               id,
+		"Name":             "Event Subscription " + id,
+		"Destination":      destination,
+		"EventTypes":       eventTypes,
+		"Context":          context,
+		"Protocol":         protocol,
+		"SubscriptionType": "RedfishEvent",
+	})
+	resp := successResponse(http.StatusCreated)
+	resp.Header["Location"] = "https://" + b.Address + uri
+	return resp
+}
+
+// submitTestEvent sends the event of the request to the subscribers
+func (b *BMC) submitTestEvent(request map[string]interface{}) Response {
+	messageID, _ := request["MessageId"].(string)
+	if messageID == "" {
+		return errorResponse(http.StatusBadRequest, response.PropertyMissing, "", "MessageId")
+	}
+	eventType := stringProperty(request, "EventType", "Alert")
+	severity := stringProperty(request, "Severity", "OK")
+	message := stringProperty(request, "Message", "Test event.")
+	originOfCondition := stringProperty(request, "OriginOfCondition", serviceRootURI)
+	var messageArgs []string
+	if args, ok := request["MessageArgs"].([]interface{}); ok {
+		for _, arg := range args {
+			messageArgs = append(messageArgs, fmt.Sprintf("%v", arg))
+		}
+	}
+	b.raiseEvent(eventType, severity, messageID, message, originOfCondition, messageArgs...)
+	return Response{StatusCode: http.StatusNoContent}
+}
+
+// stringProperty returns the string property of the request, or the default value
+// if the request doesn't have it
+func stringProperty(request map[string]interface{}, name, defaultValue string) string {
+	if value, ok := request[name].(string); ok && value != "" {
+		return value
+	}
+	return defaultValue
+}
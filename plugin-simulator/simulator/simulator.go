@@ -0,0 +1,133 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package simulator simulates the Redfish services of BMCs from mockups in the DMTF
+// public mockup format, keeping the state changed by the requests in memory
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+)
+
+const serviceRootURI = "/redfish/v1"
+
+// Response is the response of a simulated BMC to a request
+type Response struct {
+	StatusCode int
+	Header     map[string]string
+	Body       []byte
+}
+
+// EventHandler is called with the address of a simulated BMC for the event messages
+// the BMC sends to its subscribers
+type EventHandler func(address string, message common.MessageData)
+
+// BMCConf is the configuration of a simulated BMC
+type BMCConf struct {
+	Address         string
+	UserName        string
+	Password        string
+	MockupDirectory string
+}
+
+// Simulator holds the simulated BMCs by address
+type Simulator struct {
+	lock sync.RWMutex
+	bmcs map[string]*BMC
+}
+
+// New returns a simulator of the BMCs loaded from their mockups, the events of the BMCs
+// are passed to the handler
+func New(bmcs []BMCConf, handler EventHandler) (*Simulator, error) {
+	simulator := &Simulator{
+		bmcs: make(map[string]*BMC),
+	}
+	for _, conf := range bmcs {
+		if _, ok := simulator.bmcs[conf.Address]; ok {
+			return nil, fmt.Errorf("the BMC %s is configured more than once", conf.Address)
+		}
+		bmc, err := NewBMC(conf, handler)
+		if err != nil {
+			return nil, err
+		}
+		simulator.bmcs[conf.Address] = bmc
+	}
+	return simulator, nil
+}
+
+// BMC returns the simulated BMC of the address
+func (s *Simulator) BMC(address string) (*BMC, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	bmc, ok := s.bmcs[address]
+	return bmc, ok
+}
+
+// jsonResponse returns the response with the body marshalled to JSON
+func jsonResponse(statusCode int, body interface{}) Response {
+	data, _ := json.Marshal(body)
+	return Response{
+		StatusCode: statusCode,
+		Header: map[string]string{
+			"Content-type": "application/json; charset=utf-8",
+		},
+		Body: data,
+	}
+}
+
+// errorResponse returns the Redfish error response of the status message
+func errorResponse(statusCode int, statusMessage, errorMessage string, messageArgs ...interface{}) Response {
+	resp := common.GeneralError(int32(statusCode), statusMessage, errorMessage, messageArgs, nil)
+	return jsonResponse(statusCode, resp.Body)
+}
+
+// successResponse returns the response of a completed request
+func successResponse(statusCode int) Response {
+	return jsonResponse(statusCode, map[string]interface{}{
+		"@Message.ExtendedInfo": []response.Msg{
+			response.Msg{
+				MessageID: response.Success,
+				Message:   "Successfully Completed Request",
+				Severity:  "OK",
+			},
+		},
+	})
+}
+
+// cleanURI removes the query, the fragment and the trailing slash of the URI
+func cleanURI(uri string) string {
+	if index := strings.IndexAny(uri, "?#"); index >= 0 {
+		uri = uri[:index]
+	}
+	if len(uri) > 1 {
+		uri = strings.TrimSuffix(uri, "/")
+	}
+	return uri
+}
+
+// parentURI returns the URI of the parent of the resource
+func parentURI(uri string) string {
+	return uri[:strings.LastIndex(uri, "/")]
+}
+
+// lastSegment returns the last segment of the URI
+func lastSegment(uri string) string {
+	return uri[strings.LastIndex(uri, "/")+1:]
+}
@@ -0,0 +1,122 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package simulator
+
+import (
+	"net/http"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+)
+
+// minimumDrives holds the minimum number of drives of the RAID types
+var minimumDrives = map[string]int{
+	"RAID0":  1,
+	"RAID1":  2,
+	"RAID5":  3,
+	"RAID6":  4,
+	"RAID10": 4,
+}
+
+// createVolume creates the volume on the drives of the request, the capacity of the
+// volume is computed from the capacity of the drives and the RAID type
+func (b *BMC) createVolume(collectionURI string, request map[string]interface{}) Response {
+	raidType, _ := request["RAIDType"].(string)
+	if raidType == "" {
+		return errorResponse(http.StatusBadRequest, response.PropertyMissing, "", "RAIDType")
+	}
+	minimum, ok := minimumDrives[raidType]
+	if !ok || !b.supportsRAIDType(parentURI(collectionURI), raidType) {
+		return errorResponse(http.StatusBadRequest, response.PropertyValueNotInList, "", raidType, "RAIDType")
+	}
+	links, _ := request["Drives"].([]interface{})
+	if len(links) < minimum {
+		return errorResponse(http.StatusBadRequest, response.PropertyMissing, "the RAID type needs more drives", "Drives")
+	}
+	var capacities []float64
+	for _, link := range links {
+		object, _ := link.(map[string]interface{})
+		uri, _ := object["@odata.id"].(string)
+		drive, ok := b.resources[cleanURI(uri)]
+		if !ok || resourceType(drive) != "Drive" {
+			return errorResponse(http.StatusBadRequest, response.ResourceNotFound, "", "Drive", uri)
+		}
+		capacity, _ := drive["CapacityBytes"].(float64)
+		capacities = append(capacities, capacity)
+	}
+
+	id := b.nextMemberID(collectionURI)
+	uri := collectionURI + "/" + id
+	b.addResource(uri, map[string]interface{}{
+		"@odata.id":     uri,
+		"@odata.type":   "#Volume.v1_4_1.Volume",
+		"Id":            id,
+		"Name":          stringProperty(request, "Name", "Volume "+id),
+		"RAIDType":      raidType,
+		"CapacityBytes": volumeCapacity(raidType, capacities),
+		"Status":        map[string]interface{}{"State": "Enabled", "Health": "OK"},
+		"Links":         map[string]interface{}{"Drives": links},
+	})
+	b.raiseEvent("ResourceAdded", "OK", resourceCreated, "The resource has been created successfully.", uri)
+	resp := successResponse(http.StatusCreated)
+	resp.Header["Location"] = uri
+	return resp
+}
+
+func (b *BMC) deleteVolume(uri string) Response {
+	b.removeResource(uri)
+	b.raiseEvent("ResourceRemoved", "OK", resourceRemoved, "The resource has been removed successfully.", uri)
+	return Response{StatusCode: http.StatusNoContent}
+}
+
+// supportsRAIDType tells whether one of the controllers of the storage supports the
+// RAID type, the storages whose controllers don't list their RAID types support all of them
+func (b *BMC) supportsRAIDType(storageURI, raidType string) bool {
+	controllers, _ := b.resources[storageURI]["StorageControllers"].([]interface{})
+	listed := false
+	for _, controller := range controllers {
+		object, _ := controller.(map[string]interface{})
+		if types, ok := object["SupportedRAIDTypes"].([]interface{}); ok {
+			listed = true
+			if contains(types, raidType) {
+				return true
+			}
+		}
+	}
+	return !listed
+}
+
+// volumeCapacity returns the usable capacity of the drives with the RAID type
+func volumeCapacity(raidType string, capacities []float64) float64 {
+	var sum float64
+	smallest := capacities[0]
+	for _, capacity := range capacities {
+		sum += capacity
+		if capacity < smallest {
+			smallest = capacity
+		}
+	}
+	drives := float64(len(capacities))
+	switch raidType {
+	case "RAID1":
+		return smallest
+	case "RAID5":
+		return smallest * (drives - 1)
+	case "RAID6":
+		return smallest * (drives - 2)
+	case "RAID10":
+		return smallest * float64(len(capacities)/2)
+	}
+	return sum
+}
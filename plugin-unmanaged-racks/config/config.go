@@ -22,8 +22,10 @@ import (
 	"net/url"
 	"os"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/ODIM-Project/ODIM/plugin-unmanaged-racks/logging"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/satori/go.uuid"
 	"gopkg.in/yaml.v3"
@@ -47,6 +49,7 @@ type PluginConfig struct {
 	PKIPrivateKeyPath  string   `yaml:"PKIPrivateKeyPath" envconfig:"PKI_PRIVATE_KEY_PATH"`
 	PKICertificatePath string   `yaml:"PKICertificatePath" envconfig:"PKI_CERTIFICATE_PATH_PATH"`
 	LogLevel           string   `yaml:"LogLevel" envconfig:"LOG_LEVEL"`
+	LogFormat          string   `yaml:"LogFormat" envconfig:"LOG_FORMAT"`
 	RedisAddress       string   `yaml:"RedisAddress" envconfig:"REDIS_ADDRESS"`
 	SentinelMasterName string   `yaml:"SentinelMasterName" envconfig:"SENTINEL_MASTER_NAME"`
 }
@@ -77,10 +80,59 @@ func ReadPluginConfiguration() (*PluginConfig, error) {
 	return pc, validate(pc)
 }
 
+// TrackConfigFileChanges reads URP's configuration again whenever the file behind PLUGIN_CONFIG_FILE_PATH
+// is changed, and passes it to onChange, so that e.g. the log level is updated without restarting URP
+func TrackConfigFileChanges(onChange func(*PluginConfig)) {
+	cp := os.Getenv("PLUGIN_CONFIG_FILE_PATH")
+	if cp == "" {
+		return
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logging.Errorf("Cannot watch configuration file: %s", err)
+		return
+	}
+	if err := watcher.Add(cp); err != nil {
+		logging.Errorf("Cannot watch configuration file: %s", err)
+	}
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case fileEvent, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if fileEvent.Op&(fsnotify.Write|fsnotify.Remove) != 0 {
+					logging.Infof("Configuration file %s modified", fileEvent.Name)
+					if pc, err := ReadPluginConfiguration(); err != nil {
+						logging.Errorf("Cannot reload configuration: %s", err)
+					} else {
+						onChange(pc)
+					}
+				}
+				// the file replaced by a rename is watched again
+				watcher.Add(cp)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logging.Errorf("Error while watching configuration file: %s", err)
+			}
+		}
+	}()
+}
+
 func validate(pc *PluginConfig) error {
 	if pc.LogLevel == "" {
 		pc.LogLevel = "debug"
 	}
+	if pc.LogFormat == "" {
+		pc.LogFormat = logs.JSONFormat
+	}
+	if err := logs.ValidateFormat(pc.LogFormat); err != nil {
+		return err
+	}
 	if pc.OdimURL == "" {
 		return fmt.Errorf("OdimURL has to be specified")
 	}
@@ -17,7 +17,9 @@
 package logging
 
 import (
-	"github.com/antonfisher/nested-logrus-formatter"
+	"context"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/kataras/golog"
 	"github.com/sirupsen/logrus"
 )
@@ -54,13 +56,16 @@ func (l logger) Debug(args ...interface{}) {
 }
 
 func (l logger) SetLevel(lls string) {
-	ll, err := logrus.ParseLevel(lls)
-	if err != nil {
+	if err := logs.SetLevel(lls); err != nil {
 		l.Errorf("Cannot change log level to %s, defaulting to INFO", lls)
-		l.Logger.SetLevel(logrus.InfoLevel)
-
+		logs.SetLevel(logs.DefaultLevel)
 	}
-	l.Logger.SetLevel(ll)
+}
+
+// Init sets up URP's logger with the ODIMRA structured logging, the entries
+// are written as JSON objects or RFC 5424 syslog messages
+func Init(pluginName, logFormat, logLevel string) error {
+	return logs.Init(pluginName, logFormat, logLevel)
 }
 
 // SetLogLevel sets log level on URP's logger
@@ -68,6 +73,12 @@ func SetLogLevel(logLevel string) {
 	staticLogger.SetLevel(logLevel)
 }
 
+// WithContext returns the entry of URP logger with the correlation fields carried by ctx,
+// for e.g. the ID of the plugin of the requests
+func WithContext(ctx context.Context) *logrus.Entry {
+	return logs.Log(ctx)
+}
+
 // GetLogger returns instance of URP logger
 func GetLogger() golog.ExternalLogger {
 	return *staticLogger
@@ -125,8 +136,8 @@ func Fatalf(t string, i ...interface{}) {
 
 func init() {
 	ll := logrus.DebugLevel
-	l := logrus.New()
-	l.SetFormatter(&formatter.Formatter{})
+	l := logrus.StandardLogger()
+	l.SetLevel(ll)
 
 	staticLogger = &logger{
 		logLevel: &ll,
@@ -32,6 +32,8 @@ func main() {
 	logging.Infof("Starting URP v%s", version)
 	if pluginConfiguration, err := config.ReadPluginConfiguration(); err != nil {
 		logging.Fatal("error while reading from config", err)
+	} else if err := logging.Init("URP", pluginConfiguration.LogFormat, pluginConfiguration.LogLevel); err != nil {
+		logging.Fatal("error while initializing logging", err)
 	} else {
 		rest.InitializeAndRun(pluginConfiguration)
 	}
@@ -74,6 +74,9 @@ func Do(w http.ResponseWriter, r *http.Request, handler iris.Handler) {
 	app.ContextPool = context.New(func() context.Context {
 		return context.NewContext(app)
 	})
+	if err := app.Build(); err != nil {
+		panic(err)
+	}
 
 	ctx := app.ContextPool.Acquire(w, r)
 	handler(ctx)
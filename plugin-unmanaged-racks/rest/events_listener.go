@@ -46,7 +46,7 @@ func (eh *eventHandler) handleEvent(c iris.Context) {
 	raw := new(json.RawMessage)
 	err := c.ReadJSON(raw)
 	if err != nil {
-		logging.Errorf("Cannot read message body: %s", err)
+		logging.WithContext(c.Request().Context()).Errorf("Cannot read message body: %s", err)
 		c.StatusCode(http.StatusBadRequest)
 		return
 	}
@@ -54,7 +54,7 @@ func (eh *eventHandler) handleEvent(c iris.Context) {
 	message := new(redfish.Event)
 	err = json.Unmarshal([]byte(redfish.Translator.RedfishToODIM(string(*raw))), message)
 	if err != nil {
-		logging.Errorf("Cannot load '%s' message into redfish.Event struct: %s", string(*raw), err)
+		logging.WithContext(c.Request().Context()).Errorf("Cannot load '%s' message into redfish.Event struct: %s", string(*raw), err)
 		c.StatusCode(http.StatusBadRequest)
 		return
 	}
@@ -91,7 +91,7 @@ func (eh *eventHandler) handleEvent(c iris.Context) {
 		}, rackKey)
 
 		if err != nil {
-			logging.Errorf(
+			logging.WithContext(c.Request().Context()).Errorf(
 				"cannot consume message(%v): %v",
 				message,
 				fmt.Errorf("couldn't commit transaction: %w", err),
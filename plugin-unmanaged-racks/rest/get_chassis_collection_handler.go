@@ -37,10 +37,10 @@ type getChassisCollectionHandler struct {
 }
 
 func (c *getChassisCollectionHandler) handle(ctx context.Context) {
-	logging.Debug("Process GetChassisCollection request")
+	logging.WithContext(ctx.Request().Context()).Debug("Process GetChassisCollection request")
 	searchKey := db.CreateKey("Chassis")
 	keys, err := c.dao.Keys(stdCtx.TODO(), searchKey.WithWildcard().String()).Result()
-	logging.Debug("List of chassis entries:", keys)
+	logging.WithContext(ctx.Request().Context()).Debug("List of chassis entries:", keys)
 	if err != nil {
 		ctx.StatusCode(http.StatusBadRequest)
 	}
@@ -55,11 +55,11 @@ func (c *getChassisCollectionHandler) handle(ctx context.Context) {
 		)
 		collection.MembersCount++
 	}
-	logging.Debug("Populated collection with chassis IDs")
+	logging.WithContext(ctx.Request().Context()).Debug("Populated collection with chassis IDs")
 
 	ctx.StatusCode(http.StatusOK)
 	ctx.JSON(&collection)
-	logging.Debug("Finished processing GetChassisCollection request")
+	logging.WithContext(ctx.Request().Context()).Debug("Finished processing GetChassisCollection request")
 }
 
 func createChassisCollection() redfish.Collection {
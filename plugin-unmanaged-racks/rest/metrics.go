@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2020 Intel Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
+	"github.com/kataras/iris/v12"
+)
+
+// createMetricsConfigurer records the count and the latency of the requests served by each of
+// the routes with the metrics shared by the ODIMRA services, and serves them on /metrics
+func createMetricsConfigurer() iris.Configurator {
+	return func(application *iris.Application) {
+		application.UseGlobal(metrics.IrisMiddleware)
+		application.Get("/metrics", iris.FromStd(metrics.Handler()))
+	}
+}
@@ -19,6 +19,7 @@ package rest
 import (
 	"crypto/tls"
 	"encoding/json"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/ODIM-Project/ODIM/plugin-unmanaged-racks/config"
 	"github.com/ODIM-Project/ODIM/plugin-unmanaged-racks/db"
 	"github.com/ODIM-Project/ODIM/plugin-unmanaged-racks/logging"
@@ -75,7 +76,7 @@ func configureTLS(c *config.PluginConfig) host.Configurator {
 }
 
 func createApplication(pluginConfig *config.PluginConfig, dao *db.DAO, odimraHTTPClient *redfish.HTTPClient) *iris.Application {
-	return iris.New().Configure(createLoggersConfigurer(pluginConfig), createAPIHandlersConfigurer(odimraHTTPClient, dao, pluginConfig))
+	return iris.New().Configure(createLoggersConfigurer(pluginConfig), createAPIHandlersConfigurer(odimraHTTPClient, dao, pluginConfig), createMetricsConfigurer())
 }
 
 func createLoggersConfigurer(pluginConfig *config.PluginConfig) iris.Configurator {
@@ -88,11 +89,26 @@ func createLoggersConfigurer(pluginConfig *config.PluginConfig) iris.Configurato
 		app.Logger().SetLevel(pluginConfig.LogLevel)
 		//app log level adjusted to configured one
 		logging.SetLogLevel(pluginConfig.LogLevel)
+		//log levels adjusted when the configuration file is changed
+		config.TrackConfigFileChanges(func(pc *config.PluginConfig) {
+			app.Logger().SetLevel(pc.LogLevel)
+			logging.SetLogLevel(pc.LogLevel)
+		})
 		//enable request logger
 		app.Use(logger.New())
+		//request logs carry the ID of the plugin
+		app.UseGlobal(withPluginID)
 	}
 }
 
+// withPluginID adds the ID of URP to the correlation fields of the request context,
+// so the entries logged for the request carry it
+func withPluginID(c context.Context) {
+	req := c.Request()
+	c.ResetRequest(req.WithContext(logs.With(req.Context(), logs.PluginIDField, urpPluginName)))
+	c.Next()
+}
+
 func createAPIHandlersConfigurer(odimraHTTPClient *redfish.HTTPClient, dao *db.DAO, pluginConfig *config.PluginConfig) iris.Configurator {
 	return func(application *iris.Application) {
 
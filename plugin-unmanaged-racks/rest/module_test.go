@@ -30,7 +30,7 @@ import (
 	"github.com/ODIM-Project/ODIM/plugin-unmanaged-racks/redfish"
 
 	"github.com/alicebob/miniredis/v2"
-	"github.com/gavv/httpexpect"
+	"github.com/iris-contrib/httpexpect/v2"
 	"github.com/kataras/iris/v12"
 	"github.com/kataras/iris/v12/context"
 	"github.com/kataras/iris/v12/httptest"
@@ -534,6 +534,15 @@ func (o *odimstub) Stop() {
 	o.app.Shutdown(stdContext.TODO())
 }
 
+func Test_metrics_endpoint_exposes_served_requests(t *testing.T) {
+	testApp, _ := createTestApplication()
+	he := httptest.New(t, testApp)
+	he.GET("/ODIM/v1/Managers").Expect().Status(http.StatusUnauthorized)
+	he.GET("/metrics").Expect().
+		Status(http.StatusOK).
+		Body().Contains(`odimra_http_requests_total{code="401",method="GET",route="/ODIM/v1/Managers"}`)
+}
+
 func createTestApplication() (*iris.Application, *miniredis.Miniredis) {
 	r, err := miniredis.Run()
 	if err != nil {
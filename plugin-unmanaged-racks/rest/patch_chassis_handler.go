@@ -61,7 +61,7 @@ func (c *chassisUpdateHandler) handle(ctx context.Context) {
 	}
 
 	chassisURI := ctx.Request().RequestURI
-	logging.Debug("finding requested chassis with uri:", chassisURI)
+	logging.WithContext(ctx.Request().Context()).Debug("finding requested chassis with uri:", chassisURI)
 	requestedChassis, err := c.dao.FindChassis(chassisURI)
 	if err != nil {
 		createInternalError(ctx, err)
@@ -72,9 +72,9 @@ func (c *chassisUpdateHandler) handle(ctx context.Context) {
 		ctx.JSON(redfish.NewError().AddExtendedInfo(redfish.NewResourceNotFoundMsg("Chassis", ctx.Request().RequestURI, "")))
 		return
 	}
-	logging.Debug("found requested chassis with uri:", chassisURI)
+	logging.WithContext(ctx.Request().Context()).Debug("found requested chassis with uri:", chassisURI)
 
-	if violation, errCode := c.createValidator(requestedChassis, rur).Validate(); violation != nil {
+	if violation, errCode := c.createValidator(ctx.Request().Context(), requestedChassis, rur).Validate(); violation != nil {
 		ctx.StatusCode(*errCode)
 		ctx.JSON(redfish.NewError(*violation))
 		return
@@ -159,7 +159,7 @@ func (c *chassisUpdateHandler) handle(ctx context.Context) {
 	ctx.JSON(updatedChassis)
 }
 
-func (c *chassisUpdateHandler) createValidator(requestedChassis *redfish.Chassis, requestedChange *rackUpdateRequest) redfish.Validator {
+func (c *chassisUpdateHandler) createValidator(reqCtx stdCtx.Context, requestedChassis *redfish.Chassis, requestedChange *rackUpdateRequest) redfish.Validator {
 	return redfish.CompositeValidator(
 		redfish.NewValidator(
 			func() bool {
@@ -187,7 +187,7 @@ func (c *chassisUpdateHandler) createValidator(requestedChassis *redfish.Chassis
 			func() bool {
 				chassisCollection := new(redfish.Collection)
 				if err := c.redfishClient.Get("/redfish/v1/Chassis", chassisCollection); err != nil {
-					logging.Errorf("cannot read validate https://ODIMRA/redfish/v1/Chassis: %s", err)
+					logging.WithContext(reqCtx).Errorf("cannot read validate https://ODIMRA/redfish/v1/Chassis: %s", err)
 					return true
 				}
 				existingChassis := map[string]interface{}{}
@@ -214,7 +214,7 @@ func (c *chassisUpdateHandler) createValidator(requestedChassis *redfish.Chassis
 			func() bool {
 				unmanagedChassisKeys, err := c.dao.Keys(stdCtx.TODO(), db.CreateKey("Chassis").WithWildcard().String()).Result()
 				if err != nil {
-					logging.Errorf("DB: cannot read info about unmanaged racks: %s", err)
+					logging.WithContext(reqCtx).Errorf("DB: cannot read info about unmanaged racks: %s", err)
 					return true
 				}
 
@@ -44,7 +44,7 @@ type postChassisHandler struct {
 	pc  *config.PluginConfig
 }
 
-func (c *postChassisHandler) createValidator(chassis *redfish.Chassis) redfish.Validator {
+func (c *postChassisHandler) createValidator(reqCtx stdCtx.Context, chassis *redfish.Chassis) redfish.Validator {
 	return redfish.CompositeValidator(
 		redfish.NewValidator(
 			func() bool {
@@ -88,7 +88,7 @@ func (c *postChassisHandler) createValidator(chassis *redfish.Chassis) redfish.V
 					containedByOid := chassis.Links.ContainedBy[0].Oid
 					_, err := c.dao.Get(stdCtx.TODO(), db.CreateKey("Chassis", containedByOid).String()).Result()
 					if err != nil || err == redis.Nil {
-						logging.Errorf("cannot validate requested rack(%s): %s", chassis.Oid, err)
+						logging.WithContext(reqCtx).Errorf("cannot validate requested rack(%s): %s", chassis.Oid, err)
 					}
 					return err != nil
 				}
@@ -132,7 +132,7 @@ func (c *postChassisHandler) handle(ctx context.Context) {
 		return
 	}
 
-	if violation, errCode := c.createValidator(requestedChassis).Validate(); violation != nil {
+	if violation, errCode := c.createValidator(ctx.Request().Context(), requestedChassis).Validate(); violation != nil {
 		ctx.StatusCode(*errCode)
 		ctx.JSON(redfish.NewError(*violation))
 		return
@@ -28,7 +28,7 @@ type ExternalInterface struct {
 	CreateUser         func(asmodel.User) *errors.Error
 	GetUserDetails     func(string) (asmodel.User, *errors.Error)
 	GetRoleDetailsByID func(string) (asmodel.Role, *errors.Error)
-	UpdateUserDetails  func(asmodel.User, asmodel.User) *errors.Error
+	UpdateUserDetails  func(asmodel.User, asmodel.User, string) *errors.Error
 }
 
 // GetExternalInterface retrieves all the external connections account package functions uses
@@ -80,7 +80,7 @@ func mockGetUserDetails(userName string) (asmodel.User, *errors.Error) {
 	return user, nil
 }
 
-func mockUpdateUserDetails(user, newData asmodel.User) *errors.Error {
+func mockUpdateUserDetails(user, newData asmodel.User, ifMatch string) *errors.Error {
 	return nil
 }
 
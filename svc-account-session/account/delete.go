@@ -33,10 +33,11 @@ import (
 // Two parameters need to be passed to the function which are
 // the Session, which contains all the session related data, espically the ConfigureUsers privilege
 // and the accountID which is used for identifing the account to be deleted.
+// If ifMatch is not empty, the account is deleted only if its current ETag matches it.
 //
 // As return parameters RPC response, which contains status code, message, headers and data,
 // error will be passed back.
-func Delete(session *asmodel.Session, accountID string) response.RPC {
+func Delete(session *asmodel.Session, accountID, ifMatch string) response.RPC {
 	var resp response.RPC
 
 	// Default admin user account should not be deleted
@@ -85,7 +86,7 @@ func Delete(session *asmodel.Session, accountID string) response.RPC {
 		return resp
 	}
 
-	if derr := asmodel.DeleteUser(accountID); derr != nil {
+	if derr := asmodel.DeleteUser(accountID, ifMatch); derr != nil {
 		errorMessage := "Unable to delete user: " + derr.Error()
 		if errors.DBKeyNotFound == derr.ErrNo() {
 			resp.StatusCode = http.StatusNotFound
@@ -102,6 +103,8 @@ func Delete(session *asmodel.Session, accountID string) response.RPC {
 				},
 			}
 			resp.Body = args.CreateGenericErrorResponse()
+		} else if errors.PreconditionFailed == derr.ErrNo() {
+			resp = common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errorMessage, nil, nil)
 		} else {
 			resp.CreateInternalErrorResponse(errorMessage)
 		}
@@ -157,7 +157,7 @@ func TestDelete(t *testing.T) {
 			t.Fatalf("Error in creating mock admin user %v", err)
 		}
 		t.Run(tt.name, func(t *testing.T) {
-			got := Delete(tt.args.session, tt.args.accountID)
+			got := Delete(tt.args.session, tt.args.accountID, "")
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Delete() = %v, want %v", got, tt.want)
 			}
@@ -221,7 +221,7 @@ func TestDeleteDefaultAdminAccount(t *testing.T) {
 	for _, tt := range tests {
 
 		t.Run(tt.name, func(t *testing.T) {
-			got := Delete(tt.args.session, tt.args.accountID)
+			got := Delete(tt.args.session, tt.args.accountID, "")
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Delete() = %v, want %v", got, tt.want)
 			}
@@ -200,6 +200,9 @@ func GetAccount(session *asmodel.Session, accountID string) response.RPC {
 		"Transfer-Encoding": "chunked",
 		"OData-Version":     "4.0",
 	}
+	if etag, err := asmodel.GetUserETag(accountID); err == nil {
+		resp.Header["ETag"] = etag
+	}
 
 	commonResponse.CreateGenericResponse(resp.StatusMessage)
 	commonResponse.Message = ""
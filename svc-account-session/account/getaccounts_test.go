@@ -153,6 +153,10 @@ func TestGetAccount(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error in creating mock admin user %v", err)
 	}
+	etag, gerr := asmodel.GetUserETag("testUser1")
+	if gerr != nil {
+		t.Fatalf("Error in reading ETag of mock admin user %v", gerr)
+	}
 	type args struct {
 		session   *asmodel.Session
 		accountID string
@@ -207,6 +211,7 @@ func TestGetAccount(t *testing.T) {
 					"Link":              "</redfish/v1/SchemaStore/en/ManagerAccount.json/>; rel=describedby",
 					"Transfer-Encoding": "chunked",
 					"OData-Version":     "4.0",
+					"ETag":              etag,
 				},
 				Body: asresponse.Account{
 					Response: successResponse,
@@ -241,6 +246,7 @@ func TestGetAccount(t *testing.T) {
 					"Link":              "</redfish/v1/SchemaStore/en/ManagerAccount.json/>; rel=describedby",
 					"Transfer-Encoding": "chunked",
 					"OData-Version":     "4.0",
+					"ETag":              etag,
 				},
 				Body: asresponse.Account{
 					Response: successResponse,
@@ -275,9 +275,13 @@ func (e *ExternalInterface) Update(req *accountproto.UpdateAccountRequest, sessi
 		requestUser.Password = hashedPassword
 	}
 
-	if uerr := e.UpdateUserDetails(user, requestUser); uerr != nil {
+	if uerr := e.UpdateUserDetails(user, requestUser, req.IfMatch); uerr != nil {
 		errorMessage := "Unable to update user: " + uerr.Error()
-		resp.CreateInternalErrorResponse(errorMessage)
+		if errors.PreconditionFailed == uerr.ErrNo() {
+			resp = common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errorMessage, nil, nil)
+		} else {
+			resp.CreateInternalErrorResponse(errorMessage)
+		}
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
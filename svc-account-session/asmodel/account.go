@@ -18,6 +18,7 @@ package asmodel
 import (
 	"encoding/json"
 
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
 )
@@ -96,20 +97,35 @@ func GetUserDetails(userName string) (User, *errors.Error) {
 
 }
 
+// GetUserETag will fetch the ETag of the stored user entry
+func GetUserETag(userName string) (string, *errors.Error) {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return "", err
+	}
+	userdata, err := conn.Read("User", userName)
+	if err != nil {
+		return "", errors.PackError(err.ErrNo(), "error while trying to get user: ", err.Error())
+	}
+	return persistencemgr.ETag(userdata), nil
+}
+
 //DeleteUser will delete the user entry from the database based on the uuid
-func DeleteUser(key string) *errors.Error {
+// if ifMatch is not empty, the entry is deleted only if its ETag matches ifMatch
+func DeleteUser(key, ifMatch string) *errors.Error {
 	conn, err := common.GetDBConnection(common.OnDisk)
 	if err != nil {
 		return err
 	}
-	if err = conn.Delete("User", key); err != nil {
+	if err = conn.DeleteIfMatch("User", key, ifMatch); err != nil {
 		return err
 	}
 	return nil
 }
 
 // UpdateUserDetails will modify the current details to given changes
-func UpdateUserDetails(user, newData User) *errors.Error {
+// if ifMatch is not empty, the entry is updated only if its ETag matches ifMatch
+func UpdateUserDetails(user, newData User, ifMatch string) *errors.Error {
 
 	conn, err := common.GetDBConnection(common.OnDisk)
 	if err != nil {
@@ -125,7 +141,7 @@ func UpdateUserDetails(user, newData User) *errors.Error {
 	if newData.RoleID != "" {
 		user.RoleID = newData.RoleID
 	}
-	if _, err = conn.Update(table, user.UserName, user); err != nil {
+	if _, err = conn.UpdateIfMatch(table, user.UserName, user, ifMatch); err != nil {
 		return err
 	}
 	return nil
@@ -135,7 +135,7 @@ func TestDeleteUser(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := DeleteUser(tt.args.key); !reflect.DeepEqual(got, tt.want) {
+			if got := DeleteUser(tt.args.key, ""); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("DeleteUser() = %v, want %v", got, tt.want)
 			}
 		})
@@ -211,7 +211,7 @@ func TestUpdateUserDetails(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := UpdateUserDetails(user, tt.args.userData); (err != nil) != tt.wantErr {
+			if err := UpdateUserDetails(user, tt.args.userData, ""); (err != nil) != tt.wantErr {
 				t.Errorf("UpdateUserDetails() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -227,6 +227,31 @@ func TestUpdateUserDetailsNegativeTestCase(t *testing.T) {
 	user := User{}
 	mockData(common.OnDisk, "User", "successID", "user")
 	userData := User{UserName: "successID"}
-	err := UpdateUserDetails(user, userData)
+	err := UpdateUserDetails(user, userData, "")
 	assert.NotNil(t, err, "There should be an error")
 }
+
+func TestUpdateUserDetailsStaleETag(t *testing.T) {
+	common.SetUpMockConfig()
+	defer func() {
+		common.TruncateDB(common.OnDisk)
+		common.TruncateDB(common.InMemory)
+	}()
+	user := User{
+		UserName: "successID",
+		Password: "SomePassword",
+		RoleID:   "someRole",
+	}
+	mockData(common.OnDisk, "User", "successID", user)
+	etag, err := GetUserETag("successID")
+	if err != nil {
+		t.Fatalf("GetUserETag() error = %v", err)
+	}
+	if err = UpdateUserDetails(user, User{RoleID: common.RoleAdmin}, etag); err != nil {
+		t.Errorf("UpdateUserDetails() with current ETag error = %v", err)
+	}
+	err = UpdateUserDetails(user, User{RoleID: common.RoleMonitor}, etag)
+	if err == nil || err.ErrNo() != errors.PreconditionFailed {
+		t.Errorf("UpdateUserDetails() with stale ETag error = %v, want PreconditionFailed", err)
+	}
+}
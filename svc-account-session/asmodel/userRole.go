@@ -18,6 +18,7 @@ package asmodel
 import (
 	"encoding/json"
 
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
 )
@@ -62,14 +63,28 @@ func GetRoleDetailsByID(roleID string) (Role, *errors.Error) {
 	return role, nil
 }
 
+// GetRoleETag will fetch the ETag of the stored role entry
+func GetRoleETag(roleID string) (string, *errors.Error) {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return "", err
+	}
+	roleData, err := conn.Read("role", roleID)
+	if err != nil {
+		return "", errors.PackError(err.ErrNo(), "error while trying to get role details: ", err.Error())
+	}
+	return persistencemgr.ETag(roleData), nil
+}
+
 //UpdateRoleDetails will modify the current details to given changes
-func (r *Role) UpdateRoleDetails() *errors.Error {
+// if ifMatch is not empty, the entry is updated only if its ETag matches ifMatch
+func (r *Role) UpdateRoleDetails(ifMatch string) *errors.Error {
 
 	conn, err := common.GetDBConnection(common.OnDisk)
 	if err != nil {
 		return err
 	}
-	if _, err = conn.Update("role", r.ID, r); err != nil {
+	if _, err = conn.UpdateIfMatch("role", r.ID, r, ifMatch); err != nil {
 		return err
 	}
 	return nil
@@ -103,12 +118,13 @@ func GetAllRoles() ([]Role, *errors.Error) {
 }
 
 //Delete will delete the role entry from the database based on the uuid
-func (r *Role) Delete() *errors.Error {
+// if ifMatch is not empty, the entry is deleted only if its ETag matches ifMatch
+func (r *Role) Delete(ifMatch string) *errors.Error {
 	conn, err := common.GetDBConnection(common.OnDisk)
 	if err != nil {
 		return err
 	}
-	if err = conn.Delete("role", r.ID); err != nil {
+	if err = conn.DeleteIfMatch("role", r.ID, ifMatch); err != nil {
 		return err
 	}
 	return nil
@@ -122,7 +122,7 @@ func TestDeleteRole(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := role.Delete()
+			err := role.Delete("")
 			if !reflect.DeepEqual(err, tt.want) {
 				t.Errorf("Delete() = %v, want %v", err, tt.want)
 			}
@@ -138,7 +138,7 @@ func TestUpdateRoleDetails(t *testing.T) {
 		common.TruncateDB(common.InMemory)
 	}()
 	mockData(common.OnDisk, "role", role.ID, role)
-	err := role.UpdateRoleDetails()
+	err := role.UpdateRoleDetails("")
 	assert.Nil(t, err, "There should be no error")
 }
 
@@ -149,6 +149,25 @@ func TestUpdateRoleNegativeTestCase(t *testing.T) {
 		common.TruncateDB(common.InMemory)
 	}()
 	mockData(common.OnDisk, "role", role.ID, "role")
-	err := invalidRole.UpdateRoleDetails()
+	err := invalidRole.UpdateRoleDetails("")
 	assert.NotNil(t, err, "There should be an error")
 }
+
+func TestUpdateRoleDetailsStaleETag(t *testing.T) {
+	common.SetUpMockConfig()
+	defer func() {
+		common.TruncateDB(common.OnDisk)
+		common.TruncateDB(common.InMemory)
+	}()
+	mockData(common.OnDisk, "role", role.ID, role)
+	etag, err := GetRoleETag(role.ID)
+	assert.Nil(t, err, "There should be no error")
+	updatedRole := role
+	updatedRole.AssignedPrivileges = []string{common.PrivilegeLogin}
+	err = updatedRole.UpdateRoleDetails(etag)
+	assert.Nil(t, err, "There should be no error")
+	updatedRole.AssignedPrivileges = []string{common.PrivilegeConfigureSelf}
+	err = updatedRole.UpdateRoleDetails(etag)
+	assert.NotNil(t, err, "There should be an error")
+	assert.Equal(t, errors.PreconditionFailed, err.ErrNo(), "Stale ETag should be rejected")
+}
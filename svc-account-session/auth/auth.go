@@ -16,10 +16,11 @@
 package auth
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 	"net/http"
 	"time"
 
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	authproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/auth"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 )
@@ -28,25 +29,27 @@ import (
 // 1. It will check whether the session taken is valid
 // 2. fetch the previleges from DB against session token
 //    and check the service has the previlege
-func Auth(req *authproto.AuthRequest) (int32, string) {
+// The entries logged once the session is found carry the user of the session.
+func Auth(ctx context.Context, req *authproto.AuthRequest) (int32, string) {
 	go expiredSessionCleanUp()
 	if req.SessionToken == "" {
-		log.Error("Unable to validate the token, is empty")
+		logs.Log(ctx).Error("Unable to validate the token, is empty")
 		return http.StatusUnauthorized, response.NoValidSession
 	}
 	if len(req.Privileges) == 0 {
-		log.Error("Unable to validate the privileges, is empty")
+		logs.Log(ctx).Error("Unable to validate the privileges, is empty")
 		return http.StatusUnauthorized, response.NoValidSession
 	}
 	session, err := CheckSessionTimeOut(req.SessionToken)
 	if err != nil {
-		log.Error("Unable to check session timeout: " + err.Error())
+		logs.Log(ctx).Error("Unable to check session timeout: " + err.Error())
 		return err.GetAuthStatusCodeAndMessage()
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, session.UserName)
 	session.LastUsedTime = time.Now()
 	// Update Session
 	if err = session.Update(); err != nil {
-		log.Error("Unable to update session: " + err.Error())
+		logs.Log(ctx).Error("Unable to update session: " + err.Error())
 		return err.GetAuthStatusCodeAndMessage()
 	}
 
@@ -54,12 +57,13 @@ func Auth(req *authproto.AuthRequest) (int32, string) {
 	// if any of the privilege isn't assigned to service then return failure
 	for _, privilege := range req.Privileges {
 		if !session.Privileges[privilege] {
+			logs.Log(ctx).Info("Authorization failed, the session doesn't have the privilege " + privilege)
 			return http.StatusForbidden, response.InsufficientPrivilege
 		}
 	}
 
 	// TODO: Need to check OEM Privileges
 
-	log.Info("Authorization successful")
+	logs.Log(ctx).Info("Authorization successful")
 	return http.StatusOK, response.Success
 }
@@ -14,6 +14,7 @@
 package auth
 
 import (
+	"context"
 	"net/http"
 	"reflect"
 	"testing"
@@ -143,7 +144,7 @@ func TestAuth(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, got1 := Auth(tt.args.req)
+			got, got1 := Auth(context.TODO(), tt.args.req)
 			if !reflect.DeepEqual(got, int32(tt.want)) {
 				t.Errorf("Auth() = %v, want = %v", got, tt.want)
 			}
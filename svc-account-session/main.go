@@ -27,7 +27,8 @@ import (
 	"github.com/ODIM-Project/ODIM/svc-account-session/rpc"
 )
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
 	// verifying the uid of the user
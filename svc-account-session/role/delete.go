@@ -170,8 +170,13 @@ func Delete(req *roleproto.DeleteRoleRequest) *response.RPC {
 		return &resp
 	}
 
-	if derr := role.Delete(); derr != nil {
+	if derr := role.Delete(req.IfMatch); derr != nil {
 		errorMessage := "Unable to delete role: " + derr.Error()
+		if errors.PreconditionFailed == derr.ErrNo() {
+			resp = common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errorMessage, nil, nil)
+			log.Error(errorMessage)
+			return &resp
+		}
 		resp.CreateInternalErrorResponse(errorMessage)
 		log.Error(errorMessage)
 		return &resp
@@ -100,6 +100,9 @@ func GetRole(req *roleproto.GetRoleRequest, session *asmodel.Session) response.R
 	}
 	resp.StatusCode = http.StatusOK
 	resp.StatusMessage = response.Success
+	if etag, err := asmodel.GetRoleETag(req.Id); err == nil {
+		resp.Header["ETag"] = etag
+	}
 
 	commonResponse.CreateGenericResponse(resp.StatusMessage)
 	commonResponse.MessageID = ""
@@ -69,6 +69,14 @@ func TestGetRole(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error in creating mock admin user %v", err)
 	}
+	etag, gerr := asmodel.GetRoleETag(common.RoleAdmin)
+	if gerr != nil {
+		t.Fatalf("Error in reading ETag of mock admin role %v", gerr)
+	}
+	successHeader := map[string]string{"ETag": etag}
+	for key, value := range header {
+		successHeader[key] = value
+	}
 	type args struct {
 		req     *roleproto.GetRoleRequest
 		session *asmodel.Session
@@ -116,7 +124,7 @@ func TestGetRole(t *testing.T) {
 			want: response.RPC{
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Header:        header,
+				Header:        successHeader,
 				Body: asresponse.UserRole{
 					Response:           commonResponse,
 					AssignedPrivileges: []string{common.PrivilegeConfigureUsers},
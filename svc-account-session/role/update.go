@@ -22,6 +22,7 @@ import (
 	"reflect"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
 	roleproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/role"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-account-session/asmodel"
@@ -245,8 +246,12 @@ func Update(req *roleproto.UpdateRoleRequest, session *asmodel.Session) response
 		}
 		role.OEMPrivileges = updateReq.OEMPrivileges
 	}
-	if uerr := role.UpdateRoleDetails(); uerr != nil {
+	if uerr := role.UpdateRoleDetails(req.IfMatch); uerr != nil {
 		errorMessage := "error while trying to updating role:" + uerr.Error()
+		if errors.PreconditionFailed == uerr.ErrNo() {
+			log.Error(errorMessage)
+			return common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errorMessage, nil, nil)
+		}
 		resp.CreateInternalErrorResponse(errorMessage)
 		return resp
 	}
@@ -19,7 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	log "github.com/sirupsen/logrus"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
@@ -65,7 +65,7 @@ func (a *Account) Create(ctx context.Context, req *accountproto.CreateAccountReq
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 	err := session.UpdateLastUsedTime(req.SessionToken)
@@ -80,7 +80,7 @@ func (a *Account) Create(ctx context.Context, req *accountproto.CreateAccountReq
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -91,7 +91,7 @@ func (a *Account) Create(ctx context.Context, req *accountproto.CreateAccountReq
 	if jsonErr != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying marshal the response body for create account: " + jsonErr.Error()
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = data.StatusCode
@@ -132,7 +132,7 @@ func (a *Account) GetAllAccounts(ctx context.Context, req *accountproto.AccountR
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage, resp)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -147,7 +147,7 @@ func (a *Account) GetAllAccounts(ctx context.Context, req *accountproto.AccountR
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -156,7 +156,7 @@ func (a *Account) GetAllAccounts(ctx context.Context, req *accountproto.AccountR
 	if err != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying marshal the response body for get all accounts: " + err.Error()
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return fmt.Errorf(resp.StatusMessage)
 	}
 	resp.StatusCode = data.StatusCode
@@ -197,7 +197,7 @@ func (a *Account) GetAccount(ctx context.Context, req *accountproto.GetAccountRe
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -212,7 +212,7 @@ func (a *Account) GetAccount(ctx context.Context, req *accountproto.GetAccountRe
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -221,7 +221,7 @@ func (a *Account) GetAccount(ctx context.Context, req *accountproto.GetAccountRe
 	if err != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying marshal the response body for get account details: " + err.Error()
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return fmt.Errorf(resp.StatusMessage)
 	}
 	resp.StatusCode = data.StatusCode
@@ -262,7 +262,7 @@ func (a *Account) GetAccountServices(ctx context.Context, req *accountproto.Acco
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -277,7 +277,7 @@ func (a *Account) GetAccountServices(ctx context.Context, req *accountproto.Acco
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -286,7 +286,7 @@ func (a *Account) GetAccountServices(ctx context.Context, req *accountproto.Acco
 	if err != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying marshal the response body for get account details: " + err.Error()
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return fmt.Errorf(resp.StatusMessage)
 	}
 	resp.StatusCode = data.StatusCode
@@ -327,7 +327,7 @@ func (a *Account) Update(ctx context.Context, req *accountproto.UpdateAccountReq
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -342,7 +342,7 @@ func (a *Account) Update(ctx context.Context, req *accountproto.UpdateAccountReq
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -353,7 +353,7 @@ func (a *Account) Update(ctx context.Context, req *accountproto.UpdateAccountReq
 	if err != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying to marshal the response body for create account: " + err.Error()
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = data.StatusCode
@@ -394,7 +394,7 @@ func (a *Account) Delete(ctx context.Context, req *accountproto.DeleteAccountReq
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -409,17 +409,17 @@ func (a *Account) Delete(ctx context.Context, req *accountproto.DeleteAccountReq
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
-	data := account.Delete(sess, req.AccountID)
+	data := account.Delete(sess, req.AccountID, req.IfMatch)
 	var jsonErr error // jsonErr is created to protect the data in err
 	resp.Body, jsonErr = json.Marshal(data.Body)
 	if jsonErr != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying marshal the response body for delete account: " + jsonErr.Error()
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = data.StatusCode
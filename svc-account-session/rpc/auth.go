@@ -27,7 +27,7 @@ type Auth struct{}
 // IsAuthorized will accepts the request and send a request to Auth method
 // from session package, if its authorized then respond with the status code.
 func (a *Auth) IsAuthorized(ctx context.Context, req *authproto.AuthRequest, resp *authproto.AuthResponse) error {
-	statusCode, errorMessage := auth.Auth(req)
+	statusCode, errorMessage := auth.Auth(ctx, req)
 	resp.StatusCode = statusCode
 	resp.StatusMessage = errorMessage
 	return nil
@@ -19,7 +19,7 @@ package rpc
 import (
 	"context"
 	"encoding/json"
-	"log"
+	log "github.com/sirupsen/logrus"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
@@ -68,7 +68,7 @@ func (r *Role) CreateRole(ctx context.Context, req *roleproto.RoleRequest, resp
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -83,7 +83,7 @@ func (r *Role) CreateRole(ctx context.Context, req *roleproto.RoleRequest, resp
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -99,7 +99,7 @@ func (r *Role) CreateRole(ctx context.Context, req *roleproto.RoleRequest, resp
 		errorArgs[0].ErrorMessage = errorMessage
 		errorArgs[0].StatusMessage = resp.StatusMessage
 		resp.Body, _ = json.Marshal(args.CreateGenericErrorResponse())
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return nil
 	}
 	return nil
@@ -138,7 +138,7 @@ func (r *Role) GetRole(ctx context.Context, req *roleproto.GetRoleRequest, resp
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -153,7 +153,7 @@ func (r *Role) GetRole(ctx context.Context, req *roleproto.GetRoleRequest, resp
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -169,7 +169,7 @@ func (r *Role) GetRole(ctx context.Context, req *roleproto.GetRoleRequest, resp
 		errorArgs[0].ErrorMessage = errorMessage
 		errorArgs[0].StatusMessage = resp.StatusMessage
 		resp.Body, _ = json.Marshal(args.CreateGenericErrorResponse())
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return nil
 	}
 
@@ -208,7 +208,7 @@ func (r *Role) GetAllRoles(ctx context.Context, req *roleproto.GetRoleRequest, r
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -223,7 +223,7 @@ func (r *Role) GetAllRoles(ctx context.Context, req *roleproto.GetRoleRequest, r
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -239,7 +239,7 @@ func (r *Role) GetAllRoles(ctx context.Context, req *roleproto.GetRoleRequest, r
 		errorArgs[0].ErrorMessage = errorMessage
 		errorArgs[0].StatusMessage = resp.StatusMessage
 		resp.Body, _ = json.Marshal(args.CreateGenericErrorResponse())
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return nil
 	}
 
@@ -279,7 +279,7 @@ func (r *Role) UpdateRole(ctx context.Context, req *roleproto.UpdateRoleRequest,
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -294,7 +294,7 @@ func (r *Role) UpdateRole(ctx context.Context, req *roleproto.UpdateRoleRequest,
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error headers
 		}
-		log.Printf(errorMessage)
+		log.Error(errorMessage)
 		return nil
 	}
 
@@ -310,7 +310,7 @@ func (r *Role) UpdateRole(ctx context.Context, req *roleproto.UpdateRoleRequest,
 		errorArgs[0].ErrorMessage = errorMessage
 		errorArgs[0].StatusMessage = resp.StatusMessage
 		resp.Body, _ = json.Marshal(args.CreateGenericErrorResponse())
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return nil
 	}
 
@@ -344,7 +344,7 @@ func (r *Role) DeleteRole(ctx context.Context, req *roleproto.DeleteRoleRequest,
 		errorArgs[0].ErrorMessage = errorMessage
 		errorArgs[0].StatusMessage = resp.StatusMessage
 		resp.Body, _ = json.Marshal(args.CreateGenericErrorResponse())
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return nil
 	}
 
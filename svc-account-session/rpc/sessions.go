@@ -23,7 +23,7 @@ import (
 	"github.com/ODIM-Project/ODIM/svc-account-session/asresponse"
 	"github.com/ODIM-Project/ODIM/svc-account-session/session"
 
-	"log"
+	log "github.com/sirupsen/logrus"
 	"net/http"
 )
 
@@ -41,7 +41,7 @@ func (s *Session) CreateSession(ctx context.Context, req *sessionproto.SessionCr
 	if err != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying marshal the response body for create account: " + err.Error()
-		log.Printf(resp.StatusMessage)
+		log.Error(resp.StatusMessage)
 		return nil
 	}
 	resp.SessionId = sessionID
@@ -61,7 +61,7 @@ func (s *Session) DeleteSession(ctx context.Context, req *sessionproto.SessionRe
 	if err != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying marshal the response body for delete : " + err.Error()
-		log.Printf(response.StatusMessage)
+		log.Error(response.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = response.StatusCode
@@ -88,7 +88,7 @@ func (s *Session) GetSession(ctx context.Context, req *sessionproto.SessionReque
 	body, err := json.Marshal(response.Body)
 	if err != nil {
 		resp.StatusMessage = "error while trying marshal the response body for get session: " + err.Error()
-		log.Printf(response.StatusMessage)
+		log.Error(response.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = response.StatusCode
@@ -116,7 +116,7 @@ func (s *Session) GetAllActiveSessions(ctx context.Context, req *sessionproto.Se
 	if err != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying marshal the response body for get all active session: " + err.Error()
-		log.Printf(response.StatusMessage)
+		log.Error(response.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = response.StatusCode
@@ -136,7 +136,7 @@ func (s *Session) GetSessionService(ctx context.Context, req *sessionproto.Sessi
 	if err != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying marshal the response body for get session service: " + err.Error()
-		log.Printf(response.StatusMessage)
+		log.Error(response.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = response.StatusCode
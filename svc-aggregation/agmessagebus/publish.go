@@ -15,6 +15,7 @@
 package agmessagebus
 
 import (
+	"context"
 	"encoding/json"
 	log "github.com/sirupsen/logrus"
 
@@ -26,13 +27,6 @@ import (
 
 //Publish will takes the system id,Event type and publishes the data to message bus
 func Publish(systemID, eventType, collectionType string) {
-	k, err := dc.Communicator(dc.KAFKA, config.Data.MessageQueueConfigFilePath)
-	if err != nil {
-		log.Error("Unable to connect to kafka" + err.Error())
-		return
-	}
-
-	defer k.Close()
 	var event = common.Event{
 		EventID:   uuid.NewV4().String(),
 		MessageID: "ResourceEvent.1.0.3." + eventType,
@@ -41,6 +35,26 @@ func Publish(systemID, eventType, collectionType string) {
 			Oid: systemID,
 		},
 	}
+	publish(event, collectionType)
+}
+
+//PublishAggregateEvent publishes the event of a system joining or leaving an aggregate,
+//the aggregate is the origin of the event and the system is given in its message args
+func PublishAggregateEvent(aggregateURI, systemURI, eventType string) {
+	var event = common.Event{
+		EventID:     uuid.NewV4().String(),
+		MessageID:   "ResourceEvent.1.0.3." + eventType,
+		EventType:   eventType,
+		MessageArgs: []string{systemURI},
+		OriginOfCondition: &common.Link{
+			Oid: aggregateURI,
+		},
+	}
+	publish(event, "AggregateCollection")
+}
+
+func publish(event common.Event, collectionType string) {
+	k := dc.GetProducer(dc.KAFKA, config.Data.MessageQueueConfigFilePath)
 	var events = []common.Event{event}
 	var messageData = common.MessageData{
 		Name:      "Resource Event",
@@ -54,10 +68,11 @@ func Publish(systemID, eventType, collectionType string) {
 		Request: data,
 	}
 
-	if err := k.Distribute("REDFISH-EVENTS-TOPIC", mbevent); err != nil {
-		log.Error("Unable Publish events to kafka" + err.Error())
-		return
-	}
-	log.Info("Event Published")
-
+	k.DistributeAsync(context.Background(), "REDFISH-EVENTS-TOPIC", mbevent, func(err error) {
+		if err != nil {
+			log.Error("Unable Publish events to kafka" + err.Error())
+			return
+		}
+		log.Info("Event Published")
+	})
 }
@@ -21,8 +21,10 @@ import (
 	log "github.com/sirupsen/logrus"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	dmtfmodel "github.com/ODIM-Project/ODIM/lib-dmtf/model"
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
@@ -80,8 +82,21 @@ type AggregationSource struct {
 }
 
 // Aggregate payload is used for perform the operations on Aggregate
+// MembershipFilter is set for the dynamic aggregates, their Elements are then the
+// computer systems matching the $filter expression
 type Aggregate struct {
-	Elements []string `json:"Elements"`
+	Elements         []string `json:"Elements"`
+	MembershipFilter string   `json:"MembershipFilter,omitempty"`
+}
+
+// Backup is an encrypted copy of the on-disk data of ODIMRA. Data is the encrypted content of the
+// DB, and Key is the key encrypting Data, itself encrypted with the public key of ODIMRA.
+type Backup struct {
+	Version string `json:"Version"`
+	Created string `json:"Created"`
+	Key     []byte `json:"Key"`
+	Nonce   []byte `json:"Nonce"`
+	Data    []byte `json:"Data"`
 }
 
 // ConnectionMethod payload is used for perform the operations on connection method
@@ -218,10 +233,20 @@ func GenericSave(body []byte, table string, key string) error {
 		log.Error("GenericSave : error while trying to get DB Connection : " + err.Error())
 		return fmt.Errorf("error while trying to connecting to DB: %v", err.Error())
 	}
+	// the tags set on the device are kept across the rediscovery of its systems and chassis
+	body, errs := common.ApplyResourceTags(table, key, body)
+	if errs != nil {
+		log.Error("GenericSave : error while trying to apply the resource tags: " + errs.Error())
+		return errs
+	}
 	if err = connPool.AddResourceData(table, key, string(body)); err != nil {
 		log.Error("GenericSave : error while trying to add resource date to DB: " + err.Error())
 		return fmt.Errorf("error while trying to create new %v resource: %v", table, err.Error())
 	}
+	if err := common.UpdateFilterIndex(table, key, body); err != nil {
+		log.Error("GenericSave : error while trying to index the resource: " + err.Error())
+		return fmt.Errorf("error while trying to index %v resource: %v", table, err.Error())
+	}
 	return nil
 }
 
@@ -365,6 +390,9 @@ func DeleteSystem(key string) *errors.Error {
 	if err = connPool.DeleteServer(deleteKey); err != nil {
 		return errors.PackError(err.ErrNo(), "error while trying to delete compute system: ", err.Error())
 	}
+	if err = common.DeleteResourceTags(key); err != nil {
+		return errors.PackError(err.ErrNo(), "error while trying to delete the tags of the compute system: ", err.Error())
+	}
 	return nil
 }
 
@@ -493,10 +521,13 @@ func UpdateComputeSystem(key string, computeData interface{}) error {
 	if errs != nil {
 		return errs
 	}
+	if marshaledData, errs = common.ApplyResourceTags("ComputerSystem", key, marshaledData); errs != nil {
+		return errs
+	}
 	if _, err := conn.Update("ComputerSystem", key, string(marshaledData)); err != nil {
 		return err
 	}
-	return nil
+	return common.UpdateFilterIndex("ComputerSystem", key, marshaledData)
 }
 
 //GetResourceDetails fetches a resource from database using key
@@ -528,7 +559,7 @@ func GetString(index, match string) ([]string, error) {
 	}
 	list, err := conn.GetString(index, 0, "*"+match+"*", false)
 	if err != nil && err.Error() != "no data with ID found" {
-		fmt.Println("error while getting the data", err)
+		log.Error("error while getting the data: " + err.Error())
 		return []string{}, nil
 	}
 	return list, nil
@@ -684,6 +715,19 @@ func GetAggregationSourceInfo(aggregationSourceURI string) (AggregationSource, *
 	return aggregationSource, nil
 }
 
+// GetAggregationSourceETag fetches the ETag of the stored AggregationSource for the given aggregationSourceURI
+func GetAggregationSourceETag(aggregationSourceURI string) (string, *errors.Error) {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return "", err
+	}
+	data, err := conn.Read("AggregationSource", aggregationSourceURI)
+	if err != nil {
+		return "", errors.PackError(err.ErrNo(), "error: while trying to fetch Aggregation Source data: ", err.Error())
+	}
+	return persistencemgr.ETag(data), nil
+}
+
 // UpdateSystemData updates the bmc details
 func UpdateSystemData(system SaveSystem, key string) *errors.Error {
 	conn, err := common.GetDBConnection(common.OnDisk)
@@ -709,12 +753,13 @@ func UpdatePluginData(plugin Plugin, key string) *errors.Error {
 }
 
 // UpdateAggregtionSource updates the aggregation details
-func UpdateAggregtionSource(aggregationSource AggregationSource, key string) *errors.Error {
+// if ifMatch is not empty, the entry is updated only if its ETag matches ifMatch
+func UpdateAggregtionSource(aggregationSource AggregationSource, key, ifMatch string) *errors.Error {
 	conn, err := common.GetDBConnection(common.OnDisk)
 	if err != nil {
 		return err
 	}
-	if _, err := conn.Update("AggregationSource", key, aggregationSource); err != nil {
+	if _, err := conn.UpdateIfMatch("AggregationSource", key, aggregationSource, ifMatch); err != nil {
 		return err
 	}
 	return nil
@@ -857,6 +902,25 @@ func RemoveElementsFromAggregate(aggregate Aggregate, aggregateURL string) *erro
 	return nil
 }
 
+//UpdateAggregateElements replaces the elements of an aggregate, it is called while holding the DB
+//lock of the dynamic aggregates, which is also taken to create and delete the aggregates
+func UpdateAggregateElements(aggregateURL string, elements []string) *errors.Error {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return err
+	}
+	aggregate, err := GetAggregate(aggregateURL)
+	if err != nil {
+		return err
+	}
+	aggregate.Elements = elements
+	const table string = "Aggregate"
+	if _, err := conn.Update(table, aggregateURL, aggregate); err != nil {
+		return err
+	}
+	return nil
+}
+
 func removeElements(requestElements, presentElements []string) []string {
 	newElements := []string{}
 	var present bool
@@ -976,6 +1040,56 @@ func SavePluginManagerInfo(body []byte, table string, key string) error {
 	if err := conn.Create(table, key, string(body)); err != nil {
 		return errors.PackError(err.ErrNo(), "Unable to save the plugin data with SavePluginManagerInfo:  duplicate UUID: ", err.Error())
 	}
+	if err := common.UpdateFilterIndex(table, key, body); err != nil {
+		return fmt.Errorf("Unable to index the plugin data with SavePluginManagerInfo: %v", err.Error())
+	}
 
 	return nil
 }
+
+// BackupOnDiskData returns a copy of all the keys of the on-disk DB, the copy is
+// consistent only when the DB is not updated while it is taken
+func BackupOnDiskData() ([]persistencemgr.BackupEntry, *errors.Error) {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Backup()
+}
+
+// RestoreOnDiskData replaces the content of the on-disk DB with the keys of the backup
+// and flushes the in-memory DB, whose data refer to the replaced on-disk data
+func RestoreOnDiskData(backup []persistencemgr.BackupEntry) *errors.Error {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return err
+	}
+	if err := conn.Restore(backup); err != nil {
+		return err
+	}
+	inMemoryConn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return err
+	}
+	return inMemoryConn.CleanUpDB()
+}
+
+//TryLock takes the lock of the given name shared by the instances of the aggregator, the returned
+//token is empty when the lock is held by another instance
+func TryLock(name string, expiry time.Duration) (string, *errors.Error) {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return "", err
+	}
+	return conn.TryLock(name, expiry)
+}
+
+//Unlock releases the lock of the given name taken with TryLock
+func Unlock(name, token string) {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		log.Error("error while trying to release the lock " + name + ": " + err.Error())
+		return
+	}
+	conn.Unlock(name, token)
+}
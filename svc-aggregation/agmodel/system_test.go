@@ -987,10 +987,17 @@ func TestAggregationSource(t *testing.T) {
 	assert.Equal(t, data.UserName, req.UserName)
 	_, err = GetAggregationSourceInfo("/redfish/v1/AggregationService/AggregationSources/12345677651245-123433")
 	assert.NotNil(t, err, "Error Should not be nil")
-	err = UpdateAggregtionSource(req, aggregationSourceURI)
+	etag, err := GetAggregationSourceETag(aggregationSourceURI)
 	assert.Nil(t, err, "err should be nil")
-	err = UpdateAggregtionSource(req, "/redfish/v1/AggregationService/AggregationSources/12345677651245-123433")
+	err = UpdateAggregtionSource(req, aggregationSourceURI, "")
+	assert.Nil(t, err, "err should be nil")
+	err = UpdateAggregtionSource(req, "/redfish/v1/AggregationService/AggregationSources/12345677651245-123433", "")
+	assert.NotNil(t, err, "Error Should not be nil")
+	err = UpdateAggregtionSource(req, aggregationSourceURI, etag)
+	assert.Nil(t, err, "unchanged data should keep the ETag valid")
+	err = UpdateAggregtionSource(req, aggregationSourceURI, "\"stale\"")
 	assert.NotNil(t, err, "Error Should not be nil")
+	assert.Equal(t, errors.PreconditionFailed, err.ErrNo(), "stale ETag should be rejected")
 	data, err = GetAggregationSourceInfo(aggregationSourceURI)
 	assert.Nil(t, err, "err should be nil")
 	assert.Equal(t, data.HostName, req.HostName)
@@ -1206,6 +1213,41 @@ func TestAddElementsToAggregate(t *testing.T) {
 
 }
 
+func TestUpdateAggregateElements(t *testing.T) {
+	common.SetUpMockConfig()
+	defer func() {
+		err := common.TruncateDB(common.OnDisk)
+		if err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}()
+
+	aggregateURI := "/redfish/v1/AggregationService/Aggregates/71200a7e-e95c-435b-bec7-926de482da26"
+	req := Aggregate{
+		Elements: []string{
+			"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
+		},
+		MembershipFilter: "Manufacturer eq 'HPE'",
+	}
+	err := CreateAggregate(req, aggregateURI)
+	assert.Nil(t, err, "err should be nil")
+
+	elements := []string{
+		"/redfish/v1/Systems/c14d91b5-3333-48bb-a7b7-75f74a137d48:1",
+		"/redfish/v1/Systems/9119e175-36ad-4b27-99a6-4c3a149fc7da:1",
+	}
+	err = UpdateAggregateElements(aggregateURI, elements)
+	assert.Nil(t, err, "err should be nil")
+
+	data, err := GetAggregate(aggregateURI)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, elements, data.Elements, "elements should be replaced")
+	assert.Equal(t, req.MembershipFilter, data.MembershipFilter, "membership filter should be kept")
+
+	err = UpdateAggregateElements("/redfish/v1/AggregationService/Aggregates/12345", elements)
+	assert.NotNil(t, err, "err should not be nil")
+}
+
 func TestRemoveElementsFromAggregate(t *testing.T) {
 	common.SetUpMockConfig()
 	defer func() {
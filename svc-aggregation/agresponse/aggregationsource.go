@@ -29,5 +29,6 @@ type AggregationSourceResponse struct {
 // AggregateResponse defines the response for aggregate
 type AggregateResponse struct {
 	response.Response
-	Elements []string `json:"Elements"`
+	Elements         []string `json:"Elements"`
+	MembershipFilter string   `json:"MembershipFilter,omitempty"`
 }
@@ -45,8 +45,15 @@ type AggregationServiceResponse struct {
 
 //Actions struct definition
 type Actions struct {
-	Reset               Action `json:"#AggregationService.Reset"`
-	SetDefaultBootOrder Action `json:"#AggregationService.SetDefaultBootOrder"`
+	Reset               Action      `json:"#AggregationService.Reset"`
+	SetDefaultBootOrder Action      `json:"#AggregationService.SetDefaultBootOrder"`
+	Oem                 *OemActions `json:"Oem,omitempty"`
+}
+
+//OemActions struct definition for the ODIM specific actions
+type OemActions struct {
+	Backup  Action `json:"#ODIM.Backup"`
+	Restore Action `json:"#ODIM.Restore"`
 }
 
 //Status struct definition
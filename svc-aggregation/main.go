@@ -37,7 +37,8 @@ type Schema struct {
 	QueryKeys     []string `json:"queryKeys"`
 }
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
 	// verifying the uid of the user
@@ -51,11 +52,21 @@ func main() {
 	if err := dc.SetConfiguration(config.Data.MessageQueueConfigFilePath); err != nil {
 		log.Fatal("error while trying to set messagebus configuration: " + err.Error())
 	}
+	// the events not yet published are flushed when the service stops
+	defer dc.CloseProducers()
 
 	if err := common.CheckDBConnection(); err != nil {
 		log.Fatal("error while trying to check DB connection health: " + err.Error())
 	}
 
+	if err := common.RunMigrations(services.Aggregator, common.OnDisk, agmodel.Migrations); err != nil {
+		log.Fatal("error while trying to migrate the DB: " + err.Error())
+	}
+
+	if err := common.LoadFilterIndexes(); err != nil {
+		log.Fatal(err.Error())
+	}
+
 	var connectionMethoodInterface = agcommon.DBInterface{
 		GetAllKeysFromTableInterface: agmodel.GetAllKeysFromTable,
 		GetConnectionMethodInterface: agmodel.GetConnectionMethod,
@@ -77,13 +88,14 @@ func main() {
 	// Rediscover the Resources by looking in OnDisk DB, populate the resources in InMemory DB
 	//This happens only if the InMemory DB lost it contents due to DB reboot or host VM reboot.
 	p := system.ExternalInterface{
-		ContactClient:   pmbhandle.ContactPlugin,
-		Auth:            services.IsAuthorized,
-		PublishEventMB:  agmessagebus.Publish,
-		GetPluginStatus: agcommon.GetPluginStatus,
-		SubscribeToEMB:  services.SubscribeToEMB,
-		DecryptPassword: common.DecryptWithPrivateKey,
-		UpdateTask:      system.UpdateTaskData,
+		ContactClient:         pmbhandle.ContactPlugin,
+		Auth:                  services.IsAuthorized,
+		PublishEventMB:        agmessagebus.Publish,
+		PublishAggregateEvent: agmessagebus.PublishAggregateEvent,
+		GetPluginStatus:       agcommon.GetPluginStatus,
+		SubscribeToEMB:        services.SubscribeToEMB,
+		DecryptPassword:       common.DecryptWithPrivateKey,
+		UpdateTask:            system.UpdateTaskData,
 	}
 	go p.RediscoverResources()
 	agcommon.ConfigFilePath = os.Getenv("CONFIG_FILE_PATH")
@@ -25,8 +25,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	aggregatorproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/aggregator"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-aggregation/agresponse"
@@ -85,6 +88,14 @@ func (a *Aggregator) GetAggregationService(ctx context.Context, req *aggregatorp
 			SetDefaultBootOrder: agresponse.Action{
 				Target: "/redfish/v1/AggregationService/Actions/AggregationService.SetDefaultBootOrder/",
 			},
+			Oem: &agresponse.OemActions{
+				Backup: agresponse.Action{
+					Target: "/redfish/v1/AggregationService/Actions/Oem/ODIM.Backup/",
+				},
+				Restore: agresponse.Action{
+					Target: "/redfish/v1/AggregationService/Actions/Oem/ODIM.Restore/",
+				},
+			},
 		},
 		Aggregates: agresponse.OdataID{
 			OdataID: "/redfish/v1/AggregationService/Aggregates",
@@ -146,13 +157,34 @@ func (a *Aggregator) Reset(ctx context.Context, req *aggregatorproto.AggregatorR
 		log.Error(errMsg)
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
+	// the delete runs as a task, so a stale If-Match has to be rejected before the task is created
+	if req.IfMatch != "" {
+		etag, dbErr := a.connector.GetAggregationSourceETag(req.URL)
+		if dbErr != nil {
+			errMsg := "Unable to get AggregationSource: " + dbErr.Error()
+			if errors.DBKeyNotFound == dbErr.ErrNo() {
+				generateResponse(common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errMsg, []interface{}{"AggregationSource", req.URL}, nil), resp)
+			} else {
+				generateResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
+			}
+			logs.Log(ctx).Error(errMsg)
+			return nil
+		}
+		if !persistencemgr.MatchETag(req.IfMatch, etag) {
+			errMsg := "If-Match " + req.IfMatch + " does not match the current ETag of " + req.URL
+			generateResponse(common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errMsg, nil, nil), resp)
+			logs.Log(ctx).Error(errMsg)
+			return nil
+		}
+	}
 
 	// Task Service using RPC and get the taskID
 	taskURI, err := a.connector.CreateTask(sessionUserName)
 	if err != nil {
 		errMsg := "Unable to create task: " + err.Error()
 		generateResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return nil
 	}
 	taskID := strings.TrimPrefix(taskURI, "/redfish/v1/TaskService/Tasks/")
@@ -221,11 +253,12 @@ func (a *Aggregator) SetDefaultBootOrder(ctx context.Context, req *aggregatorpro
 		log.Error(errMsg)
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 	taskURI, err := a.connector.CreateTask(sessionUserName)
 	if err != nil {
 		errMsg := "Unable to create task: " + err.Error()
 		generateResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return nil
 	}
 	strArray := strings.Split(taskURI, "/")
@@ -245,7 +278,7 @@ func (a *Aggregator) SetDefaultBootOrder(ctx context.Context, req *aggregatorpro
 	})
 	if err != nil {
 		// print error as we are unable to communicate with svc-task and then return
-		log.Error("Unable to contact task-service with UpdateTask RPC : " + err.Error())
+		logs.Log(ctx).Error("Unable to contact task-service with UpdateTask RPC : " + err.Error())
 	}
 	go a.connector.SetDefaultBootOrder(taskID, sessionUserName, req)
 	// return 202 Accepted
@@ -281,6 +314,59 @@ func (a *Aggregator) UpdateSystemState(ctx context.Context, req *aggregatorproto
 	return a.connector.UpdateSystemState(req)
 }
 
+// UpdateDynamicAggregates defines the operations which handles the RPC request response
+// for the UpdateDynamicAggregates call to aggregator micro service.
+// It is called when a system is changed outside of the aggregator, for e.g. when its tags
+// are set, and schedules the evaluation of the membership of the dynamic aggregates for the
+// system of the URL, or for all the systems when the URL is empty.
+func (a *Aggregator) UpdateDynamicAggregates(ctx context.Context, req *aggregatorproto.AggregatorRequest, resp *aggregatorproto.AggregatorResponse) error {
+	if req.URL == "" {
+		a.connector.UpdateDynamicAggregates()
+	} else {
+		a.connector.UpdateDynamicAggregates(req.URL)
+	}
+	resp.StatusCode = http.StatusAccepted
+	return nil
+}
+
+// Backup defines the operations which handles the RPC request response
+// for the Backup action of aggregator micro service.
+// Taking a backup of the on-disk data is restricted to the administrators,
+// so the session needs the ConfigureManager and ConfigureUsers privileges.
+func (a *Aggregator) Backup(ctx context.Context, req *aggregatorproto.AggregatorRequest, resp *aggregatorproto.AggregatorResponse) error {
+	var oemprivileges []string
+	privileges := []string{common.PrivilegeConfigureManager, common.PrivilegeConfigureUsers}
+	authResp := a.connector.Auth(req.SessionToken, privileges, oemprivileges)
+	if authResp.StatusCode != http.StatusOK {
+		log.Error("Unable to authenticate session with token: " + req.SessionToken)
+		generateResponse(authResp, resp)
+		return nil
+	}
+	rpcResponce := a.connector.Backup(req)
+	generateResponse(rpcResponce, resp)
+	return nil
+}
+
+// Restore defines the operations which handles the RPC request response
+// for the Restore action of aggregator micro service.
+// Once the on-disk data is restored, the inventory of the servers is rediscovered.
+func (a *Aggregator) Restore(ctx context.Context, req *aggregatorproto.AggregatorRequest, resp *aggregatorproto.AggregatorResponse) error {
+	var oemprivileges []string
+	privileges := []string{common.PrivilegeConfigureManager, common.PrivilegeConfigureUsers}
+	authResp := a.connector.Auth(req.SessionToken, privileges, oemprivileges)
+	if authResp.StatusCode != http.StatusOK {
+		log.Error("Unable to authenticate session with token: " + req.SessionToken)
+		generateResponse(authResp, resp)
+		return nil
+	}
+	rpcResponce := a.connector.Restore(req)
+	if rpcResponce.StatusCode == http.StatusOK {
+		go a.connector.RediscoverResources()
+	}
+	generateResponse(rpcResponce, resp)
+	return nil
+}
+
 // AddAggregationSource function is for handling the RPC communication for AddAggregationSource
 func (a *Aggregator) AddAggregationSource(ctx context.Context, req *aggregatorproto.AggregatorRequest, resp *aggregatorproto.AggregatorResponse) error {
 
@@ -300,6 +386,7 @@ func (a *Aggregator) AddAggregationSource(ctx context.Context, req *aggregatorpr
 		log.Error(errMsg)
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 
 	// parsing the AggregationSourceRequest
 	var addRequest system.AggregationSource
@@ -307,7 +394,7 @@ func (a *Aggregator) AddAggregationSource(ctx context.Context, req *aggregatorpr
 	if err != nil {
 		errMsg := "Unable to parse the add request" + err.Error()
 		generateResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return nil
 	}
 
@@ -316,14 +403,14 @@ func (a *Aggregator) AddAggregationSource(ctx context.Context, req *aggregatorpr
 	if invalidParam != "" {
 		errMsg := "Mandatory field " + invalidParam + " Missing"
 		generateResponse(common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errMsg, []interface{}{invalidParam}, nil), resp)
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return nil
 	}
 	managerAddress := addRequest.HostName
 	err = validateManagerAddress(managerAddress)
 	if err != nil {
 		generateResponse(common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, err.Error(), []interface{}{managerAddress, "ManagerAddress"}, nil), resp)
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return nil
 	}
 
@@ -332,7 +419,7 @@ func (a *Aggregator) AddAggregationSource(ctx context.Context, req *aggregatorpr
 	if err != nil {
 		errMsg := "Unable to create the task: " + err.Error()
 		generateResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return nil
 	}
 	strArray := strings.Split(taskURI, "/")
@@ -401,7 +488,7 @@ func (a *Aggregator) GetAllAggregationSource(ctx context.Context, req *aggregato
 		generateResponse(authResp, resp)
 		return nil
 	}
-	data := a.connector.GetAggregationSourceCollection()
+	data := a.connector.GetAggregationSourceCollection(req.URL)
 	resp.StatusCode = data.StatusCode
 	resp.StatusMessage = data.StatusMessage
 	resp.Header = data.Header
@@ -479,13 +566,14 @@ func (a *Aggregator) DeleteAggregationSource(ctx context.Context, req *aggregato
 		log.Error(errMsg)
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 
 	// Task Service using RPC and get the taskID
 	taskURI, err := a.connector.CreateTask(sessionUserName)
 	if err != nil {
 		errMsg := "Unable to create task: " + err.Error()
 		generateResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return nil
 	}
 	var taskID string
@@ -701,13 +789,14 @@ func (a *Aggregator) ResetElementsOfAggregate(ctx context.Context, req *aggregat
 		log.Error(errMsg)
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 
 	// Task Service using RPC and get the taskID
 	taskURI, err := a.connector.CreateTask(sessionUserName)
 	if err != nil {
 		errMsg := "Unable to create task: " + err.Error()
 		generateResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return nil
 	}
 	taskID := strings.TrimPrefix(taskURI, "/redfish/v1/TaskService/Tasks/")
@@ -776,11 +865,12 @@ func (a *Aggregator) SetDefaultBootOrderElementsOfAggregate(ctx context.Context,
 		log.Error(errMsg)
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 	taskURI, err := a.connector.CreateTask(sessionUserName)
 	if err != nil {
 		errMsg := "Unable to create task: " + err.Error()
 		generateResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return nil
 	}
 	strArray := strings.Split(taskURI, "/")
@@ -800,7 +890,7 @@ func (a *Aggregator) SetDefaultBootOrderElementsOfAggregate(ctx context.Context,
 	})
 	if err != nil {
 		// print error as we are unable to communicate with svc-task and then return
-		log.Error("Unable to contact task-service with UpdateTask RPC : " + err.Error())
+		logs.Log(ctx).Error("Unable to contact task-service with UpdateTask RPC : " + err.Error())
 	}
 	go a.connector.SetDefaultBootOrderElementsOfAggregate(taskID, sessionUserName, req)
 	// return 202 Accepted
@@ -818,6 +908,67 @@ func (a *Aggregator) SetDefaultBootOrderElementsOfAggregate(ctx context.Context,
 	return nil
 }
 
+// BulkRequestElementsOfAggregate defines the operations which handles the RPC request response
+// for the BulkRequestElementsOfAggregate service of aggregation micro service.
+// The functionality retrives the request and return backs the response to
+// RPC according to the protoc file defined in the util-lib package.
+// The function also checks for the session time out of the token
+// which is present in the request.
+func (a *Aggregator) BulkRequestElementsOfAggregate(ctx context.Context, req *aggregatorproto.AggregatorRequest, resp *aggregatorproto.AggregatorResponse) error {
+	var oemprivileges []string
+
+	privileges := []string{common.PrivilegeConfigureComponents}
+	authResp := a.connector.Auth(req.SessionToken, privileges, oemprivileges)
+	if authResp.StatusCode != http.StatusOK {
+		log.Error("Unable to authenticate session with token: " + req.SessionToken)
+		generateResponse(authResp, resp)
+		return nil
+	}
+	sessionUserName, err := a.connector.GetSessionUserName(req.SessionToken)
+	if err != nil {
+		errMsg := "Unable to get session username: " + err.Error()
+		generateResponse(common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errMsg, nil, nil), resp)
+		log.Error(errMsg)
+		return nil
+	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
+
+	// Task Service using RPC and get the taskID
+	taskURI, err := a.connector.CreateTask(sessionUserName)
+	if err != nil {
+		errMsg := "Unable to create task: " + err.Error()
+		generateResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
+		logs.Log(ctx).Error(errMsg)
+		return nil
+	}
+	taskID := strings.TrimPrefix(taskURI, "/redfish/v1/TaskService/Tasks/")
+	err = a.connector.UpdateTask(common.TaskData{
+		TaskID:          taskID,
+		TargetURI:       req.URL,
+		TaskState:       common.Running,
+		TaskStatus:      common.OK,
+		PercentComplete: 0,
+		HTTPMethod:      http.MethodPost,
+	})
+	if err != nil {
+		// print error as we are unable to communicate with svc-task and then return
+		logs.Log(ctx).Error("Unable to contact task-service with UpdateTask RPC : " + err.Error())
+	}
+	go a.connector.BulkRequestElementsOfAggregate(ctx, taskID, sessionUserName, req)
+	// return 202 Accepted
+	var rpcResp = response.RPC{
+		StatusCode:    http.StatusAccepted,
+		StatusMessage: response.TaskStarted,
+		Header: map[string]string{
+			"Content-type": "application/json; charset=utf-8",
+			"Location":     "/taskmon/" + taskID,
+		},
+	}
+	generateTaskRespone(taskID, taskURI, &rpcResp)
+	generateResponse(rpcResp, resp)
+	return nil
+}
+
 // GetAllConnectionMethods defines the operations which handles the RPC request response
 // for the GetAllConnectionMethods service of systems micro service.
 // The functionality retrives the request and return backs the response to
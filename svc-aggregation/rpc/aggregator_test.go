@@ -297,6 +297,73 @@ func TestAggregator_RediscoverSystemInventory(t *testing.T) {
 	}
 }
 
+func TestAggregator_UpdateDynamicAggregates(t *testing.T) {
+	a := &Aggregator{connector: connector}
+	resp := &aggregatorproto.AggregatorResponse{}
+	if err := a.UpdateDynamicAggregates(context.TODO(), &aggregatorproto.AggregatorRequest{}, resp); err != nil {
+		t.Errorf("Aggregator.UpdateDynamicAggregates() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Aggregator.UpdateDynamicAggregates() StatusCode = %v, want %v", resp.StatusCode, http.StatusAccepted)
+	}
+}
+
+func TestAggregator_Backup(t *testing.T) {
+	a := &Aggregator{connector: connector}
+	tests := []struct {
+		name           string
+		req            *aggregatorproto.AggregatorRequest
+		wantStatusCode int32
+	}{
+		{
+			name:           "positive Backup",
+			req:            &aggregatorproto.AggregatorRequest{SessionToken: "validToken"},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "auth fail",
+			req:            &aggregatorproto.AggregatorRequest{SessionToken: "invalidToken"},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &aggregatorproto.AggregatorResponse{}
+			if a.Backup(context.TODO(), tt.req, resp); resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("Aggregator.Backup() got = %v, wantStatusCode %v", resp.StatusCode, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestAggregator_Restore(t *testing.T) {
+	a := &Aggregator{connector: connector}
+	tests := []struct {
+		name           string
+		req            *aggregatorproto.AggregatorRequest
+		wantStatusCode int32
+	}{
+		{
+			name:           "invalid request",
+			req:            &aggregatorproto.AggregatorRequest{SessionToken: "validToken", RequestBody: []byte(`{"Version":"0"}`)},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "auth fail",
+			req:            &aggregatorproto.AggregatorRequest{SessionToken: "invalidToken"},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &aggregatorproto.AggregatorResponse{}
+			if a.Restore(context.TODO(), tt.req, resp); resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("Aggregator.Restore() got = %v, wantStatusCode %v", resp.StatusCode, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
 func TestAggregator_ValidateManagerAddress(t *testing.T) {
 	type args struct {
 		name    string
@@ -1575,6 +1642,102 @@ func TestAggregator_ResetElementsOfAggregate(t *testing.T) {
 	}
 }
 
+func TestAggregator_BulkRequestElementsOfAggregate(t *testing.T) {
+	defer func() {
+		common.TruncateDB(common.OnDisk)
+		common.TruncateDB(common.InMemory)
+	}()
+	req := agmodel.Aggregate{
+		Elements: []string{
+			"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
+			"/redfish/v1/Systems/c14d91b5-3333-48bb-a7b7-75f74a137d48:1",
+		},
+	}
+	err := agmodel.CreateAggregate(req, "/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73")
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	successReq, _ := json.Marshal(system.BulkRequest{
+		BatchSize:   1,
+		TargetURI:   "Bios/Settings",
+		HTTPMethod:  http.MethodPatch,
+		RequestBody: map[string]interface{}{"Attributes": map[string]interface{}{"BootMode": "Uefi"}},
+	})
+
+	type args struct {
+		ctx  context.Context
+		req  *aggregatorproto.AggregatorRequest
+		resp *aggregatorproto.AggregatorResponse
+	}
+	tests := []struct {
+		name           string
+		a              *Aggregator
+		args           args
+		wantStatusCode int32
+	}{
+		{
+			name: "Positive cases",
+			a:    &Aggregator{connector: connector},
+			args: args{
+				req: &aggregatorproto.AggregatorRequest{
+					SessionToken: "validToken",
+					URL:          "/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73/Actions/Aggregate.BulkRequest",
+					RequestBody:  successReq,
+				},
+				resp: &aggregatorproto.AggregatorResponse{},
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "Invalid Token",
+			a:    &Aggregator{connector: connector},
+			args: args{
+				req: &aggregatorproto.AggregatorRequest{
+					SessionToken: "invalidToken",
+					URL:          "/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73/Actions/Aggregate.BulkRequest",
+					RequestBody:  successReq,
+				},
+				resp: &aggregatorproto.AggregatorResponse{},
+			},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "get session username fails",
+			a:    &Aggregator{connector: connector},
+			args: args{
+				req: &aggregatorproto.AggregatorRequest{
+					SessionToken: "noDetailsToken",
+					URL:          "/redfish/v1/AggregationService/Aggregates/12345/Actions/Aggregate.BulkRequest",
+					RequestBody:  successReq,
+				},
+				resp: &aggregatorproto.AggregatorResponse{},
+			},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "unable to create task",
+			a:    &Aggregator{connector: connector},
+			args: args{
+				req: &aggregatorproto.AggregatorRequest{
+					SessionToken: "noTaskToken",
+					URL:          "/redfish/v1/AggregationService/Aggregates/12345/Actions/Aggregate.BulkRequest",
+					RequestBody:  successReq,
+				},
+				resp: &aggregatorproto.AggregatorResponse{},
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.a.BulkRequestElementsOfAggregate(tt.args.ctx, tt.args.req, tt.args.resp); tt.args.resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("Aggregator.BulkRequestElementsOfAggregate() error = %v, wantStatusCode %v", tt.args.resp.StatusCode, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
 func TestAggregator_SetDefaultBootOrderElementsOfAggregate(t *testing.T) {
 	defer func() {
 		common.TruncateDB(common.OnDisk)
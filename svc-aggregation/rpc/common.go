@@ -53,14 +53,19 @@ func GetAggregator() *Aggregator {
 			DeleteSystem:             agmodel.DeleteSystem,
 			DeleteEventSubscription:  services.DeleteSubscription,
 			EventNotification:        agmessagebus.Publish,
+			PublishAggregateEvent:    agmessagebus.PublishAggregateEvent,
 			GetAllKeysFromTable:      agmodel.GetAllKeysFromTable,
 			GetConnectionMethod:      agmodel.GetConnectionMethod,
 			UpdateConnectionMethod:   agmodel.UpdateConnectionMethod,
 			GetPluginMgrAddr:         agmodel.GetPluginData,
 			GetAggregationSourceInfo: agmodel.GetAggregationSourceInfo,
+			GetAggregationSourceETag: agmodel.GetAggregationSourceETag,
 			GenericSave:              agmodel.GenericSave,
 			CheckActiveRequest:       agmodel.CheckActiveRequest,
 			DeleteActiveRequest:      agmodel.DeleteActiveRequest,
+			BackupOnDiskData:         agmodel.BackupOnDiskData,
+			RestoreOnDiskData:        agmodel.RestoreOnDiskData,
+			SendElementRequest:       system.SendElementRequest,
 		},
 	}
 }
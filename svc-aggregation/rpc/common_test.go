@@ -23,6 +23,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
 	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
@@ -53,9 +54,15 @@ var connector = &system.ExternalInterface{
 	GetConnectionMethod:      mockGetConnectionMethod,
 	UpdateConnectionMethod:   mockUpdateConnectionMethod,
 	GetAggregationSourceInfo: mockGetAggregationSourceInfo,
+	GetAggregationSourceETag: mockGetAggregationSourceETag,
 	GenericSave:              mockGenericSave,
 	CheckActiveRequest:       mockCheckActiveRequest,
 	DeleteActiveRequest:      mockDeleteActiveRequest,
+	BackupOnDiskData:         mockBackupOnDiskData,
+}
+
+func mockBackupOnDiskData() ([]persistencemgr.BackupEntry, *errors.Error) {
+	return []persistencemgr.BackupEntry{{Key: "System:someUUID", Type: "string", Values: []string{"someData"}}}, nil
 }
 
 func mockGetAggregationSourceInfo(reqURI string) (agmodel.AggregationSource, *errors.Error) {
@@ -76,6 +83,13 @@ func mockGetAggregationSourceInfo(reqURI string) (agmodel.AggregationSource, *er
 	return aggSource, errors.PackError(errors.DBKeyNotFound, "error while trying to get compute details: no data with the with key "+reqURI+" found")
 }
 
+func mockGetAggregationSourceETag(reqURI string) (string, *errors.Error) {
+	if reqURI == "/redfish/v1/AggregationService/AggregationSources/36474ba4-a201-46aa-badf-d8104da418e8" {
+		return "\"36474ba4\"", nil
+	}
+	return "", errors.PackError(errors.DBKeyNotFound, "error while trying to get compute details: no data with the with key "+reqURI+" found")
+}
+
 func mockGetAllKeysFromTable(table string) ([]string, error) {
 	if table == "ConnectionMethod" {
 		return []string{"/redfish/v1/AggregationService/ConnectionMethods/7ff3bd97-c41c-5de0-937d-85d390691b73"}, nil
@@ -86,7 +86,7 @@ func (e *ExternalInterface) addAggregationSource(taskID, targetURI, reqBody stri
 	exist, dErr := e.CheckActiveRequest(ipAddr)
 	if dErr != nil {
 		errMsg := fmt.Sprintf("Unable to collect the active request details from DB: %v", dErr.Error())
-		log.Println(errMsg)
+		log.Error(errMsg)
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, taskInfo)
 	}
 	if exist {
@@ -108,7 +108,7 @@ func (e *ExternalInterface) addAggregationSource(taskID, targetURI, reqBody stri
 	err := e.GenericSave(nil, "ActiveAddBMCRequest", ipAddr)
 	if err != nil {
 		errMsg := fmt.Sprintf("Unable to save the active request details from DB: %v", err.Error())
-		log.Println(errMsg)
+		log.Error(errMsg)
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, taskInfo)
 	}
 
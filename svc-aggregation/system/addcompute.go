@@ -57,27 +57,6 @@ func (e *ExternalInterface) addCompute(taskID, targetURI, pluginID string, perce
 
 	pluginContactRequest.Plugin = plugin
 	pluginContactRequest.StatusPoll = true
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		pluginContactRequest.HTTPMethodType = http.MethodPost
-		pluginContactRequest.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		pluginContactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := contactPlugin(pluginContactRequest, "error while getting the details "+pluginContactRequest.OID+": ")
-		if err != nil {
-			errMsg := err.Error()
-			log.Error(errMsg)
-			return common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, errMsg, getResponse.MsgArgs, taskInfo), "", nil
-		}
-		pluginContactRequest.Token = token
-	} else {
-		pluginContactRequest.LoginCredentials = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-	}
 	//get token from response and make the next REST call <currently custome url>
 
 	pluginContactRequest.DeviceInfo = saveSystem
@@ -245,6 +224,7 @@ func (e *ExternalInterface) addCompute(taskID, targetURI, pluginID string, perce
 	managersList, _ := agmodel.GetAllMatchingDetails("Managers", aggregationSourceID, common.InMemory)
 	pluginContactRequest.PublishEvent(chassisList, "ChassisCollection")
 	pluginContactRequest.PublishEvent(managersList, "ManagerCollection")
+	e.UpdateDynamicAggregates(h.SystemURL...)
 
 	h.PluginResponse = strings.Replace(h.PluginResponse, `/redfish/v1/Systems/`, `/redfish/v1/Systems/`+saveSystem.DeviceUUID+`:`, -1)
 	var list agresponse.List
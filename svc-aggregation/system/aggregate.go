@@ -63,6 +63,31 @@ func (e *ExternalInterface) CreateAggregate(req *aggregatorproto.AggregatorReque
 		return common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errMsg, []interface{}{"Elements"}, nil)
 	}
 
+	if createRequest.MembershipFilter != "" {
+		// the elements of a dynamic aggregate are the systems matching its filter
+		if len(createRequest.Elements) > 0 {
+			errMsg := "Elements can not be given with MembershipFilter"
+			log.Error(errMsg)
+			return common.GeneralError(http.StatusBadRequest, response.PropertyValueConflict, errMsg, []interface{}{"Elements", "MembershipFilter"}, nil)
+		}
+		// the aggregate is saved before the next update of the dynamic aggregates, so
+		// that the systems changed meanwhile are evaluated against its filter
+		token, lerr := lockDynamicAggregates()
+		if lerr != nil {
+			errMsg := "unable to create the aggregate: " + lerr.Error()
+			log.Error(errMsg)
+			return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
+		}
+		defer agmodel.Unlock(dynamicAggregatesLock, token)
+		createRequest.Elements, err = getAggregateMembers(createRequest.MembershipFilter)
+		if err != nil {
+			errMsg := "invalid membership filter for create an aggregate: " + err.Error()
+			log.Error(errMsg)
+			errArgs := []interface{}{createRequest.MembershipFilter, "MembershipFilter"}
+			return common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, errMsg, errArgs, nil)
+		}
+	}
+
 	statuscode, err := validateElements(createRequest.Elements)
 	if err != nil {
 		errMsg := "invalid elements for create an aggregate" + err.Error()
@@ -98,8 +123,9 @@ func (e *ExternalInterface) CreateAggregate(req *aggregatorproto.AggregatorReque
 	}
 	commonResponse.CreateGenericResponse(response.Created)
 	resp.Body = agresponse.AggregateResponse{
-		Response: commonResponse,
-		Elements: createRequest.Elements,
+		Response:         commonResponse,
+		Elements:         createRequest.Elements,
+		MembershipFilter: createRequest.MembershipFilter,
 	}
 	resp.StatusCode = http.StatusCreated
 	return resp
@@ -207,8 +233,9 @@ func (e *ExternalInterface) GetAggregate(req *aggregatorproto.AggregatorRequest)
 	}
 	commonResponse.CreateGenericResponse(response.Success)
 	resp.Body = agresponse.AggregateResponse{
-		Response: commonResponse,
-		Elements: aggregate.Elements,
+		Response:         commonResponse,
+		Elements:         aggregate.Elements,
+		MembershipFilter: aggregate.MembershipFilter,
 	}
 	return resp
 }
@@ -217,7 +244,15 @@ func (e *ExternalInterface) GetAggregate(req *aggregatorproto.AggregatorRequest)
 // if the aggregate id is present then delete from the db else return an error.
 func (e *ExternalInterface) DeleteAggregate(req *aggregatorproto.AggregatorRequest) response.RPC {
 	var resp response.RPC
-	_, err := agmodel.GetAggregate(req.URL)
+	// the aggregate is not deleted while its elements are updated, which would save it again
+	token, err := lockDynamicAggregates()
+	if err != nil {
+		errMsg := "unable to delete the aggregate: " + err.Error()
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
+	}
+	defer agmodel.Unlock(dynamicAggregatesLock, token)
+	_, err = agmodel.GetAggregate(req.URL)
 	if err != nil {
 		log.Error("error getting  Aggregate : " + err.Error())
 		errorMessage := err.Error()
@@ -289,6 +324,11 @@ func (e *ExternalInterface) AddElementsToAggregate(req *aggregatorproto.Aggregat
 		}
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 	}
+	if aggregate.MembershipFilter != "" {
+		errMsg := "elements of an aggregate with MembershipFilter can not be added"
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.ActionNotSupported, errMsg, []interface{}{"AddElements"}, nil)
+	}
 	if checkElementsPresent(addRequest.Elements, aggregate.Elements) {
 		errMsg := "Elements present in aggregate"
 		log.Error(errMsg)
@@ -320,8 +360,9 @@ func (e *ExternalInterface) AddElementsToAggregate(req *aggregatorproto.Aggregat
 	aggregate, _ = agmodel.GetAggregate(aggregateURL)
 	commonResponse.CreateGenericResponse(response.Success)
 	resp.Body = agresponse.AggregateResponse{
-		Response: commonResponse,
-		Elements: aggregate.Elements,
+		Response:         commonResponse,
+		Elements:         aggregate.Elements,
+		MembershipFilter: aggregate.MembershipFilter,
 	}
 	resp.StatusCode = http.StatusOK
 	return resp
@@ -369,6 +410,11 @@ func (e *ExternalInterface) RemoveElementsFromAggregate(req *aggregatorproto.Agg
 		}
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 	}
+	if aggregate.MembershipFilter != "" {
+		errMsg := "elements of an aggregate with MembershipFilter can not be removed"
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.ActionNotSupported, errMsg, []interface{}{"RemoveElements"}, nil)
+	}
 	if !checkRemovingElementsPresent(removeRequest.Elements, aggregate.Elements) {
 		errMsg := "Elements not present in aggregate"
 		log.Error(errMsg)
@@ -400,8 +446,9 @@ func (e *ExternalInterface) RemoveElementsFromAggregate(req *aggregatorproto.Agg
 	aggregate, _ = agmodel.GetAggregate(aggregateURL)
 	commonResponse.CreateGenericResponse(response.Success)
 	resp.Body = agresponse.AggregateResponse{
-		Response: commonResponse,
-		Elements: aggregate.Elements,
+		Response:         commonResponse,
+		Elements:         aggregate.Elements,
+		MembershipFilter: aggregate.MembershipFilter,
 	}
 	resp.StatusCode = http.StatusOK
 	return resp
@@ -491,61 +538,15 @@ func (e *ExternalInterface) ResetElementsOfAggregate(taskID string, sessionUserN
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, taskInfo)
 	}
 
-	// subTaskChan is a buffered channel with buffer size equal to total number of elements.
-	// this also helps while cancelling the task. even if the reader is not available for reading
-	// the channel buffer will collect them and allows gracefull exit for already spanned goroutines.
-	subTaskChan := make(chan int32, len(aggregate.Elements))
-	resp.StatusCode = http.StatusOK
-	var cancelled, partialResultFlag bool
-	var wg, writeWG sync.WaitGroup
-	go func() {
-		for i := 0; i < len(aggregate.Elements); i++ {
-			if cancelled == false { // task cancelled check to determine whether to collect status codes.
-				select {
-				case statusCode := <-subTaskChan:
-					if statusCode != http.StatusOK {
-						partialResultFlag = true
-						if resp.StatusCode < statusCode {
-							resp.StatusCode = statusCode
-						}
-					}
-
-					if i < len(aggregate.Elements)-1 {
-						percentComplete = int32(((i + 1) / len(aggregate.Elements)) * 100)
-						var task = fillTaskData(taskID, targetURI, string(req.RequestBody), resp, common.Running, common.OK, percentComplete, http.MethodPost)
-						err := e.UpdateTask(task)
-						if err != nil && err.Error() == common.Cancelling {
-							task = fillTaskData(taskID, targetURI, string(req.RequestBody), resp, common.Cancelled, common.OK, percentComplete, http.MethodPost)
-							e.UpdateTask(task)
-							cancelled = true
-						}
-					}
-				}
-			}
-			writeWG.Done()
-		}
-	}()
-
-	var tempIndex int
-	for _, element := range aggregate.Elements {
-		wg.Add(1)
-		writeWG.Add(1)
-		// tempIndex is for checking batch size, its increment on each iteration
-		// if its equal to batch size then reinitilise.
-		// if batch size is 0 then reset all the systems without any kind of batch and ignore the DelayBetweenBatchesInSeconds
-		tempIndex = tempIndex + 1
-		if resetRequest.BatchSize == 0 || tempIndex <= resetRequest.BatchSize {
-			go e.resetSystem(taskID, string(req.RequestBody), subTaskChan, sessionUserName, element, resetRequest.ResetType, &wg)
-		}
-
-		if tempIndex == resetRequest.BatchSize && resetRequest.BatchSize != 0 {
-			tempIndex = 0
-			time.Sleep(time.Second * time.Duration(resetRequest.DelayBetweenBatchesInSeconds))
-		}
-
+	result := e.applyInBatches(taskID, targetURI, string(req.RequestBody), aggregate.Elements, resetRequest.BatchSize,
+		resetRequest.DelayBetweenBatchesInSeconds, 0, func(element string, subTaskChan chan<- int32, wg *sync.WaitGroup) {
+			e.resetSystem(taskID, string(req.RequestBody), subTaskChan, sessionUserName, element, resetRequest.ResetType, wg)
+		})
+	if result.cancelled {
+		runtime.Goexit()
 	}
-	wg.Wait()
-	writeWG.Wait()
+	resp.StatusCode = result.statusCode
+	partialResultFlag := result.failed > 0
 	taskStatus := common.OK
 	if partialResultFlag {
 		taskStatus = common.Warning
@@ -582,6 +583,76 @@ func (e *ExternalInterface) ResetElementsOfAggregate(taskID string, sessionUserN
 	return resp
 }
 
+// batchResult is the outcome of a request applied to the elements of an aggregate in batches
+type batchResult struct {
+	statusCode int32
+	failed     int
+	skipped    int
+	cancelled  bool
+}
+
+// applyInBatches calls apply for each element, batchSize elements at a time, waiting
+// delayInSeconds between two batches. apply sends the status code of the subtask
+// of the element on subTaskChan, which is used to update the progress of the task.
+// A batch size of 0 applies the request to all the elements at once.
+// The remaining batches are skipped once failureThreshold elements failed, if
+// failureThreshold isn't 0, or when the task is cancelled.
+func (e *ExternalInterface) applyInBatches(taskID, targetURI, reqBody string, elements []string, batchSize, delayInSeconds, failureThreshold int,
+	apply func(element string, subTaskChan chan<- int32, wg *sync.WaitGroup)) batchResult {
+	result := batchResult{statusCode: http.StatusOK}
+	if batchSize <= 0 || batchSize > len(elements) {
+		batchSize = len(elements)
+	}
+	// subTaskChan is a buffered channel with buffer size equal to total number of elements.
+	// this allows gracefull exit for already spanned goroutines even if the task is cancelled.
+	subTaskChan := make(chan int32, len(elements))
+	for start := 0; start < len(elements); start += batchSize {
+		if start > 0 {
+			if failureThreshold > 0 && result.failed >= failureThreshold {
+				result.skipped = len(elements) - start
+				log.Warn(fmt.Sprintf("%d elements failed, the request is not applied to the remaining %d elements", result.failed, result.skipped))
+				break
+			}
+			time.Sleep(time.Second * time.Duration(delayInSeconds))
+		}
+		end := start + batchSize
+		if end > len(elements) {
+			end = len(elements)
+		}
+		var wg sync.WaitGroup
+		for _, element := range elements[start:end] {
+			wg.Add(1)
+			go apply(element, subTaskChan, &wg)
+		}
+		wg.Wait()
+		for i := start; i < end; i++ {
+			statusCode := <-subTaskChan
+			if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+				result.failed++
+				if statusCode < http.StatusMultipleChoices {
+					statusCode = http.StatusInternalServerError
+				}
+				if result.statusCode < statusCode {
+					result.statusCode = statusCode
+				}
+			}
+		}
+		if end < len(elements) {
+			percentComplete := int32(end * 100 / len(elements))
+			resp := response.RPC{StatusCode: result.statusCode}
+			var task = fillTaskData(taskID, targetURI, reqBody, resp, common.Running, common.OK, percentComplete, http.MethodPost)
+			err := e.UpdateTask(task)
+			if err != nil && err.Error() == common.Cancelling {
+				task = fillTaskData(taskID, targetURI, reqBody, resp, common.Cancelled, common.OK, percentComplete, http.MethodPost)
+				e.UpdateTask(task)
+				result.cancelled = true
+				return result
+			}
+		}
+	}
+	return result
+}
+
 func (e *ExternalInterface) resetSystem(taskID, reqBody string, subTaskChan chan<- int32, sessionUserName, element, resetType string, wg *sync.WaitGroup) {
 	defer wg.Done()
 	log.Info("INFO: reset(type: " + resetType + ") of the target " + element + " has been started.")
@@ -649,30 +720,6 @@ func (e *ExternalInterface) resetSystem(taskID, reqBody string, subTaskChan chan
 	pluginContactRequest.StatusPoll = true
 	pluginContactRequest.TaskRequest = reqBody
 
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		pluginContactRequest.HTTPMethodType = http.MethodPost
-		pluginContactRequest.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		pluginContactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := contactPlugin(pluginContactRequest, "error while logging in to plugin: ")
-		if err != nil {
-			subTaskChan <- getResponse.StatusCode
-			errMsg := err.Error()
-			log.Error(errMsg)
-			common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, errMsg, getResponse.MsgArgs, taskInfo)
-			return
-		}
-		pluginContactRequest.Token = token
-	} else {
-		pluginContactRequest.LoginCredentials = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	// Adding system state entry to db
 	postRequest := make(map[string]interface{})
 	postRequest["ResetType"] = resetType
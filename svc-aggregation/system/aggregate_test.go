@@ -76,6 +76,18 @@ func TestExternalInterface_CreateAggregate(t *testing.T) {
 		},
 	})
 	missingparamReq, _ := json.Marshal(agmodel.Aggregate{})
+	filterReq, _ := json.Marshal(agmodel.Aggregate{
+		MembershipFilter: "Id eq 1",
+	})
+	conflictReq, _ := json.Marshal(agmodel.Aggregate{
+		Elements: []string{
+			"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
+		},
+		MembershipFilter: "Id eq 1",
+	})
+	invalidFilterReq, _ := json.Marshal(agmodel.Aggregate{
+		MembershipFilter: "Id eq",
+	})
 
 	p := getMockExternalInterface()
 	type args struct {
@@ -147,6 +159,42 @@ func TestExternalInterface_CreateAggregate(t *testing.T) {
 				StatusCode: http.StatusBadRequest,
 			},
 		},
+		{
+			name: "positive case with membership filter",
+			e:    p,
+			args: args{
+				req: &aggregatorproto.AggregatorRequest{
+					RequestBody: filterReq,
+				},
+			},
+			want: response.RPC{
+				StatusCode: http.StatusCreated,
+			},
+		},
+		{
+			name: "with elements and membership filter",
+			e:    p,
+			args: args{
+				req: &aggregatorproto.AggregatorRequest{
+					RequestBody: conflictReq,
+				},
+			},
+			want: response.RPC{
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "with invalid membership filter",
+			e:    p,
+			args: args{
+				req: &aggregatorproto.AggregatorRequest{
+					RequestBody: invalidFilterReq,
+				},
+			},
+			want: response.RPC{
+				StatusCode: http.StatusBadRequest,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -345,6 +393,14 @@ func TestExternalInterface_AddElementsToAggregate(t *testing.T) {
 	if err != nil {
 		t.Fatalf("error: %v", err)
 	}
+	dynamicReq := agmodel.Aggregate{
+		Elements:         []string{},
+		MembershipFilter: "Manufacturer eq HPE",
+	}
+	err = agmodel.CreateAggregate(dynamicReq, "/redfish/v1/AggregationService/Aggregates/bd1e4a08-3a5d-4ff7-9c4b-4f2b4b12e4a1")
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
 
 	reqData, _ := json.Marshal(map[string]interface{}{"@odata.id": "/redfish/v1/Systems/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1"})
 	err1 := mockSystemResourceData(reqData, "ComputerSystem", "/redfish/v1/Systems/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1")
@@ -462,6 +518,18 @@ func TestExternalInterface_AddElementsToAggregate(t *testing.T) {
 			},
 			wantStatusCode: http.StatusNotFound,
 		},
+		{
+			name: "Adding elements to aggregate with membership filter",
+			e:    p,
+			args: args{
+				req: &aggregatorproto.AggregatorRequest{
+					SessionToken: "validToken",
+					URL:          "/redfish/v1/AggregationService/Aggregates/bd1e4a08-3a5d-4ff7-9c4b-4f2b4b12e4a1/Actions/Aggregate.AddElements",
+					RequestBody:  successReq,
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
 		{
 			name: "with missing parameters",
 			e:    p,
@@ -0,0 +1,175 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package system
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	aggregatorproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/aggregator"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/svc-aggregation/agmodel"
+)
+
+// BackupVersion is the version of the format of the backups, a backup is restored only
+// when its version is the same
+const BackupVersion = "1"
+
+// backupKeySize is the size in bytes of the AES key encrypting a backup
+const backupKeySize = 32
+
+// Backup is the handler for taking a backup of the on-disk data of ODIMRA.
+// The content of the DB is encrypted with a random key, which is returned along
+// with the backup after being encrypted with the public key of ODIMRA.
+func (e *ExternalInterface) Backup(req *aggregatorproto.AggregatorRequest) response.RPC {
+	entries, err := e.BackupOnDiskData()
+	if err != nil {
+		errMsg := "error while trying to backup the on-disk data: " + err.Error()
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
+	}
+	data, jerr := json.Marshal(entries)
+	if jerr != nil {
+		errMsg := "error while trying to marshal the backup: " + jerr.Error()
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
+	}
+	backup, berr := e.encryptBackup(data)
+	if berr != nil {
+		errMsg := "error while trying to encrypt the backup: " + berr.Error()
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
+	}
+	log.Info("backup of the on-disk data is taken with " + fmt.Sprint(len(entries)) + " keys")
+	return response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Header: map[string]string{
+			"Cache-Control": "no-cache",
+			"Connection":    "keep-alive",
+			"Content-type":  "application/json; charset=utf-8",
+			"OData-Version": "4.0",
+		},
+		Body: backup,
+	}
+}
+
+// Restore is the handler for restoring a backup taken with the Backup action.
+// The on-disk data of ODIMRA is replaced with the content of the backup.
+func (e *ExternalInterface) Restore(req *aggregatorproto.AggregatorRequest) response.RPC {
+	var backup agmodel.Backup
+	if err := json.Unmarshal(req.RequestBody, &backup); err != nil {
+		errMsg := "unable to parse the restore request: " + err.Error()
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errMsg, nil, nil)
+	}
+	if backup.Version == "" {
+		errMsg := "property Version missing in the restore request"
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errMsg, []interface{}{"Version"}, nil)
+	}
+	if backup.Version != BackupVersion {
+		errMsg := "backup version " + backup.Version + " is not supported, expected version is " + BackupVersion
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueNotInList, errMsg, []interface{}{backup.Version, "Version"}, nil)
+	}
+	data, err := e.decryptBackup(backup)
+	if err != nil {
+		errMsg := "unable to decrypt the backup: " + err.Error()
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, errMsg, []interface{}{"[redacted]", "Data"}, nil)
+	}
+	var entries []persistencemgr.BackupEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		errMsg := "unable to read the content of the backup: " + err.Error()
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, errMsg, []interface{}{"[redacted]", "Data"}, nil)
+	}
+	if rerr := e.RestoreOnDiskData(entries); rerr != nil {
+		errMsg := "error while trying to restore the on-disk data: " + rerr.Error()
+		log.Error(errMsg)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
+	}
+	log.Info("on-disk data is restored from the backup created at " + backup.Created)
+	resp := response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Header: map[string]string{
+			"Content-type":  "application/json; charset=utf-8",
+			"OData-Version": "4.0",
+		},
+	}
+	var commonResponse response.Response
+	commonResponse.CreateGenericResponse(resp.StatusMessage)
+	resp.Body = commonResponse
+	return resp
+}
+
+// encryptBackup encrypts the content of a backup with a random AES-GCM key
+func (e *ExternalInterface) encryptBackup(data []byte) (agmodel.Backup, error) {
+	backup := agmodel.Backup{
+		Version: BackupVersion,
+		Created: time.Now().UTC().Format(time.RFC3339),
+	}
+	key := make([]byte, backupKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return backup, err
+	}
+	gcm, err := newBackupCipher(key)
+	if err != nil {
+		return backup, err
+	}
+	backup.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(backup.Nonce); err != nil {
+		return backup, err
+	}
+	backup.Data = gcm.Seal(nil, backup.Nonce, data, []byte(backup.Version))
+	if backup.Key, err = e.EncryptPassword(key); err != nil {
+		return backup, err
+	}
+	return backup, nil
+}
+
+// decryptBackup returns the content of a backup encrypted with encryptBackup
+func (e *ExternalInterface) decryptBackup(backup agmodel.Backup) ([]byte, error) {
+	key, err := e.DecryptPassword(backup.Key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newBackupCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(backup.Nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce size %d", len(backup.Nonce))
+	}
+	return gcm.Open(nil, backup.Nonce, backup.Data, []byte(backup.Version))
+}
+
+func newBackupCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
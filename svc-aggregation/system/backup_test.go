@@ -0,0 +1,149 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package system
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	aggregatorproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/aggregator"
+	"github.com/ODIM-Project/ODIM/svc-aggregation/agmodel"
+)
+
+var backupEntries = []persistencemgr.BackupEntry{
+	{Key: "System:6d4a0a66-7efa-578e-83cf-44dc68d2874e", Type: "string", Values: []string{`"{\"ManagerAddress\":\"10.0.0.1\"}"`}},
+	{Key: "ResourceTags:6d4a0a66-7efa-578e-83cf-44dc68d2874e", Type: "string", Values: []string{`"{\"Tags\":[\"rack1\"]}"`}},
+}
+
+func mockBackupOnDiskData() ([]persistencemgr.BackupEntry, *errors.Error) {
+	return backupEntries, nil
+}
+
+func TestExternalInterface_BackupRestore(t *testing.T) {
+	var restored []persistencemgr.BackupEntry
+	p := &ExternalInterface{
+		EncryptPassword:  stubDevicePassword,
+		DecryptPassword:  stubDevicePassword,
+		BackupOnDiskData: mockBackupOnDiskData,
+		RestoreOnDiskData: func(entries []persistencemgr.BackupEntry) *errors.Error {
+			restored = entries
+			return nil
+		},
+	}
+	resp := p.Backup(&aggregatorproto.AggregatorRequest{})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Backup() status code = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	backup := resp.Body.(agmodel.Backup)
+	if backup.Version != BackupVersion {
+		t.Errorf("Backup() version = %v, want %v", backup.Version, BackupVersion)
+	}
+	if backup.Created == "" {
+		t.Error("Backup() creation time is not set")
+	}
+	plain, _ := json.Marshal(backupEntries)
+	if reflect.DeepEqual(backup.Data, plain) {
+		t.Error("Backup() data is not encrypted")
+	}
+	body, _ := json.Marshal(backup)
+
+	resp = p.Restore(&aggregatorproto.AggregatorRequest{RequestBody: body})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Restore() status code = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	if !reflect.DeepEqual(restored, backupEntries) {
+		t.Errorf("Restore() restored = %v, want %v", restored, backupEntries)
+	}
+}
+
+func TestExternalInterface_Restore(t *testing.T) {
+	p := &ExternalInterface{
+		EncryptPassword:  stubDevicePassword,
+		DecryptPassword:  stubDevicePassword,
+		BackupOnDiskData: mockBackupOnDiskData,
+		RestoreOnDiskData: func(entries []persistencemgr.BackupEntry) *errors.Error {
+			return errors.PackError(errors.UndefinedErrorType, "DB is not reachable")
+		},
+	}
+	backup := p.Backup(&aggregatorproto.AggregatorRequest{}).Body.(agmodel.Backup)
+	body := func(modify func(*agmodel.Backup)) []byte {
+		b := backup
+		b.Data = append([]byte{}, backup.Data...)
+		modify(&b)
+		data, _ := json.Marshal(b)
+		return data
+	}
+	tests := []struct {
+		name string
+		body []byte
+		want int32
+	}{
+		{
+			name: "malformed request",
+			body: []byte(`{"Version":`),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "missing version",
+			body: body(func(b *agmodel.Backup) { b.Version = "" }),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "unsupported version",
+			body: body(func(b *agmodel.Backup) { b.Version = "0" }),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "tampered data",
+			body: body(func(b *agmodel.Backup) { b.Data[0] ^= 0xff }),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "invalid key",
+			body: body(func(b *agmodel.Backup) { b.Key = []byte("passwordWithInvalidEncryption") }),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "DB error",
+			body: body(func(b *agmodel.Backup) {}),
+			want: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := p.Restore(&aggregatorproto.AggregatorRequest{RequestBody: tt.body})
+			if resp.StatusCode != tt.want {
+				t.Errorf("Restore() status code = %v, want %v", resp.StatusCode, tt.want)
+			}
+		})
+	}
+}
+
+func TestExternalInterface_Backup_DBError(t *testing.T) {
+	p := &ExternalInterface{
+		EncryptPassword: stubDevicePassword,
+		BackupOnDiskData: func() ([]persistencemgr.BackupEntry, *errors.Error) {
+			return nil, errors.PackError(errors.UndefinedErrorType, "DB is not reachable")
+		},
+	}
+	resp := p.Backup(&aggregatorproto.AggregatorRequest{})
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Backup() status code = %v, want %v", resp.StatusCode, http.StatusInternalServerError)
+	}
+}
@@ -217,29 +217,6 @@ func (e *ExternalInterface) collectAndSetDefaultOrder(taskID, serverURI, reqJSON
 	pluginContactRequest.StatusPoll = true
 	pluginContactRequest.TaskRequest = reqJSON
 
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		pluginContactRequest.HTTPMethodType = http.MethodPost
-		pluginContactRequest.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		pluginContactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := contactPlugin(pluginContactRequest, "error while logging in to plugin: ")
-		if err != nil {
-			subTaskChannel <- getResponse.StatusCode
-			errMsg := err.Error()
-			log.Error(errMsg)
-			common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, errMsg, getResponse.MsgArgs, taskInfo)
-			return
-		}
-		pluginContactRequest.Token = token
-	} else {
-		pluginContactRequest.LoginCredentials = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	postRequest := make(map[string]interface{})
 	postBody, _ := json.Marshal(postRequest)
 	target.PostBody = postBody
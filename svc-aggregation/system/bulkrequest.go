@@ -0,0 +1,311 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	aggregatorproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/aggregator"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/svc-aggregation/agmodel"
+)
+
+// BulkRequest is struct for applying a request to the elements of an aggregate
+type BulkRequest struct {
+	BatchSize                    int         `json:"BatchSize"`
+	DelayBetweenBatchesInSeconds int         `json:"DelayBetweenBatchesInSeconds"`
+	FailureThreshold             int         `json:"FailureThreshold"`
+	TargetURI                    string      `json:"TargetURI"`
+	HTTPMethod                   string      `json:"HTTPMethod"`
+	RequestBody                  interface{} `json:"RequestBody"`
+}
+
+// bulkRequestMethods are the HTTP methods a bulk request can be sent with, they all need the
+// ConfigureComponents privilege checked for the bulk request, as for the pass-through requests
+var bulkRequestMethods = []string{http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete}
+
+// BulkRequestElementsOfAggregate is the handler for applying a request to the elements of an aggregate.
+// The request is sent to the resource at TargetURI, relative to each element, in batches of BatchSize
+// elements, and each element has its own subtask. A TargetURI of the SimpleUpdate action sends the
+// action once per element, with the element as its Targets. The request on each element is sent with
+// SendElementRequest to the service owning the resource, as if it was sent to svc-api with the session
+// of the bulk request; the resources not modelled by ODIMRA must be allowed by the PassThroughConf.
+// The entries are logged with the correlation fields of ctx, along with the system of each element.
+func (e *ExternalInterface) BulkRequestElementsOfAggregate(ctx context.Context, taskID string, sessionUserName string, req *aggregatorproto.AggregatorRequest) response.RPC {
+	var resp response.RPC
+	targetURI := req.URL
+
+	taskInfo := &common.TaskUpdateInfo{TaskID: taskID, TargetURI: targetURI, UpdateTask: e.UpdateTask, TaskRequest: string(req.RequestBody)}
+
+	var bulkRequest BulkRequest
+	if err := json.Unmarshal(req.RequestBody, &bulkRequest); err != nil {
+		errMsg := "error while trying to validate request fields: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errMsg, nil, taskInfo)
+	}
+
+	// Validating the request JSON properties for case sensitive
+	invalidProperties, err := common.RequestParamsCaseValidator(req.RequestBody, bulkRequest)
+	if err != nil {
+		errMsg := "error while validating request parameters: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, taskInfo)
+	} else if invalidProperties != "" {
+		errorMessage := "error: one or more properties given in the request body are not valid, ensure properties are listed in uppercamelcase "
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyUnknown, errorMessage, []interface{}{invalidProperties}, taskInfo)
+	}
+
+	if statusCode, statusMessage, messageArgs, err := bulkRequest.validateRequestFields(); err != nil {
+		errMsg := "error while trying to validate request fields: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		return common.GeneralError(statusCode, statusMessage, errMsg, messageArgs, taskInfo)
+	}
+
+	url := strings.Split(req.URL, "/redfish/v1/AggregationService/Aggregates/")
+	aggregateID := strings.Split(url[1], "/")[0]
+
+	aggregateURL := "/redfish/v1/AggregationService/Aggregates/" + aggregateID
+	aggregate, aggErr := agmodel.GetAggregate(aggregateURL)
+	if aggErr != nil {
+		errorMessage := aggErr.Error()
+		logs.Log(ctx).Error("error getting aggregate : " + errorMessage)
+		if errors.DBKeyNotFound == aggErr.ErrNo() {
+			return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, aggErr.Error(), []interface{}{"Aggregate", req.URL}, taskInfo)
+		}
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, taskInfo)
+	}
+
+	// the request is not sent to any element when it can't be sent to all of them
+	for _, element := range aggregate.Elements {
+		uri := bulkRequestTargetURI(element, bulkRequest.TargetURI)
+		if route, _ := routeElementRequest(bulkRequest.HTTPMethod, uri); route.name == passThroughRoute.name &&
+			(config.Data.PassThroughConf == nil || !config.Data.PassThroughConf.Allows(uri)) {
+			errMsg := "error: the resource " + uri + " is not available for pass-through"
+			logs.Log(ctx).Error(errMsg)
+			return common.GeneralError(http.StatusBadRequest, response.PropertyValueNotInList, errMsg, []interface{}{bulkRequest.TargetURI, "TargetURI"}, taskInfo)
+		}
+	}
+
+	result := e.applyInBatches(taskID, targetURI, string(req.RequestBody), aggregate.Elements, bulkRequest.BatchSize,
+		bulkRequest.DelayBetweenBatchesInSeconds, bulkRequest.FailureThreshold, func(element string, subTaskChan chan<- int32, wg *sync.WaitGroup) {
+			e.sendBulkRequest(ctx, taskID, string(req.RequestBody), subTaskChan, sessionUserName, req.SessionToken, element, bulkRequest, wg)
+		})
+	if result.cancelled {
+		runtime.Goexit()
+	}
+	if result.failed > 0 {
+		errMsg := fmt.Sprintf("the %s request failed on %d of the %d elements of the aggregate", bulkRequest.HTTPMethod, result.failed, len(aggregate.Elements))
+		if result.skipped > 0 {
+			errMsg += fmt.Sprintf(" and was not sent to the remaining %d elements", result.skipped)
+		}
+		errMsg += ". for more information please check SubTasks in URI: /redfish/v1/TaskService/Tasks/" + taskID
+		logs.Log(ctx).Error(errMsg)
+		return common.GeneralError(result.statusCode, response.GeneralError, errMsg, nil, taskInfo)
+	}
+
+	resp.Header = map[string]string{
+		"Cache-Control":     "no-cache",
+		"Connection":        "keep-alive",
+		"Content-type":      "application/json; charset=utf-8",
+		"Transfer-Encoding": "chunked",
+		"OData-Version":     "4.0",
+	}
+	logs.Log(ctx).Info("the " + bulkRequest.HTTPMethod + " request is successfully applied to all the elements. for more information please check SubTasks in URI: /redfish/v1/TaskService/Tasks/" + taskID)
+	resp.StatusCode = http.StatusOK
+	resp.StatusMessage = response.Success
+	args := response.Args{
+		Code:    resp.StatusMessage,
+		Message: "Request completed successfully",
+	}
+	resp.Body = args.CreateGenericErrorResponse()
+	var task = fillTaskData(taskID, targetURI, string(req.RequestBody), resp, common.Completed, common.OK, 100, http.MethodPost)
+	err = e.UpdateTask(task)
+	if err != nil && err.Error() == common.Cancelling {
+		task = fillTaskData(taskID, targetURI, string(req.RequestBody), resp, common.Cancelled, common.Critical, 100, http.MethodPost)
+		e.UpdateTask(task)
+		runtime.Goexit()
+	}
+	return resp
+}
+
+// sendBulkRequest sends the request of a bulk request to an element of an aggregate, within a subtask
+func (e *ExternalInterface) sendBulkRequest(ctx context.Context, taskID, reqBody string, subTaskChan chan<- int32, sessionUserName, sessionToken, element string, bulkRequest BulkRequest, wg *sync.WaitGroup) {
+	defer wg.Done()
+	targetURI := bulkRequestTargetURI(element, bulkRequest.TargetURI)
+	ctx = logs.With(ctx, logs.SystemIDField, strings.Split(element[strings.LastIndex(element, "/")+1:], ":")[0])
+	logs.Log(ctx).Info("INFO: " + bulkRequest.HTTPMethod + " request on the target " + targetURI + " has been started.")
+	//Create the child Task
+	subTaskURI, err := e.CreateChildTask(sessionUserName, taskID)
+	if err != nil {
+		subTaskChan <- http.StatusInternalServerError
+		logs.Log(ctx).Error("error while trying to create sub task")
+		return
+	}
+	subTaskID := strings.TrimSuffix(subTaskURI, "/")
+	subTaskID = subTaskID[strings.LastIndex(subTaskID, "/")+1:]
+
+	var body []byte
+	if bulkRequest.RequestBody != nil {
+		body, _ = json.Marshal(bulkRequest.RequestBody)
+	}
+	if bulkRequest.TargetURI == simpleUpdateURI {
+		// the action is sent once per element, with the element as its target
+		updateRequest, _ := bulkRequest.RequestBody.(map[string]interface{})
+		if updateRequest == nil {
+			updateRequest = map[string]interface{}{}
+		}
+		updateRequest["Targets"] = []string{element}
+		body, _ = json.Marshal(updateRequest)
+	}
+	resp := e.SendElementRequest(ctx, ElementRequest{
+		SessionToken: sessionToken,
+		Method:       bulkRequest.HTTPMethod,
+		URI:          targetURI,
+		RequestBody:  body,
+	})
+	subTaskChan <- resp.StatusCode
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		errMsg := "error while sending the " + bulkRequest.HTTPMethod + " request to " + targetURI
+		logs.Log(ctx).Error(errMsg + ": " + resp.StatusMessage)
+		task := fillTaskData(subTaskID, targetURI, reqBody, resp, common.Exception, common.Critical, 100, bulkRequest.HTTPMethod)
+		if uerr := e.UpdateTask(task); uerr != nil && uerr.Error() == common.Cancelling {
+			task = fillTaskData(subTaskID, targetURI, reqBody, resp, common.Cancelled, common.Critical, 100, bulkRequest.HTTPMethod)
+			e.UpdateTask(task)
+		}
+		return
+	}
+
+	// the response of the service is kept in the subtask, as it may hold the created resource
+	// or the task monitor of the operation started on the element
+	if resp.Body == nil {
+		resp.Body = response.ErrorClass{
+			Code:    response.Success,
+			Message: "Request completed successfully.",
+		}
+	}
+	header := map[string]string{
+		"Cache-Control":     "no-cache",
+		"Connection":        "keep-alive",
+		"Content-type":      "application/json; charset=utf-8",
+		"Transfer-Encoding": "chunked",
+		"OData-Version":     "4.0",
+		"Location":          targetURI,
+	}
+	for key, value := range resp.Header {
+		header[key] = value
+	}
+	resp.Header = header
+	var task = fillTaskData(subTaskID, targetURI, reqBody, resp, common.Completed, common.OK, 100, bulkRequest.HTTPMethod)
+	err = e.UpdateTask(task)
+	if err != nil && err.Error() == common.Cancelling {
+		task = fillTaskData(subTaskID, targetURI, reqBody, resp, common.Cancelled, common.Critical, 100, bulkRequest.HTTPMethod)
+		e.UpdateTask(task)
+	}
+}
+
+// bulkRequestTargetURI returns the URI of the resource at the TargetURI of an element,
+// the URI of the SimpleUpdate action being the same for all the elements
+func bulkRequestTargetURI(element, targetURI string) string {
+	switch targetURI {
+	case "":
+		return element
+	case simpleUpdateURI:
+		return simpleUpdateURI
+	}
+	return element + "/" + targetURI
+}
+
+// validateRequestFields validates the fields of the bulk request, and returns
+// the status code, the status message and the message arguments of the error
+func (validateReq *BulkRequest) validateRequestFields() (int32, string, []interface{}, error) {
+	if validateReq.HTTPMethod == "" {
+		return http.StatusBadRequest, response.PropertyMissing, []interface{}{"HTTPMethod"}, fmt.Errorf("property HTTPMethod missing in the request")
+	}
+	validateReq.HTTPMethod = strings.ToUpper(validateReq.HTTPMethod)
+	var allowed bool
+	for _, method := range bulkRequestMethods {
+		if method == validateReq.HTTPMethod {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return http.StatusBadRequest, response.PropertyValueNotInList, []interface{}{validateReq.HTTPMethod, "HTTPMethod"},
+			fmt.Errorf("HTTPMethod %s is not one of %s", validateReq.HTTPMethod, strings.Join(bulkRequestMethods, ", "))
+	}
+	// TargetURI is relative to the elements, it can't leave the resources of the systems,
+	// except for the SimpleUpdate action which is sent with the elements as its targets
+	validateReq.TargetURI = strings.TrimSuffix(validateReq.TargetURI, "/")
+	if validateReq.TargetURI == simpleUpdateURI {
+		if validateReq.HTTPMethod != http.MethodPost {
+			return http.StatusBadRequest, response.PropertyValueNotInList, []interface{}{validateReq.HTTPMethod, "HTTPMethod"},
+				fmt.Errorf("HTTPMethod of the TargetURI %s must be %s", simpleUpdateURI, http.MethodPost)
+		}
+		if _, ok := validateReq.RequestBody.(map[string]interface{}); !ok {
+			return http.StatusBadRequest, response.PropertyValueTypeError, []interface{}{fmt.Sprint(validateReq.RequestBody), "RequestBody"},
+				fmt.Errorf("RequestBody of the TargetURI %s must be an object", simpleUpdateURI)
+		}
+	} else if !isRelativePath(validateReq.TargetURI) {
+		return http.StatusBadRequest, response.PropertyValueFormatError, []interface{}{validateReq.TargetURI, "TargetURI"},
+			fmt.Errorf("TargetURI %s isn't a path relative to the elements of the aggregate", validateReq.TargetURI)
+	}
+	for property, value := range map[string]int{
+		"BatchSize":                    validateReq.BatchSize,
+		"DelayBetweenBatchesInSeconds": validateReq.DelayBetweenBatchesInSeconds,
+		"FailureThreshold":             validateReq.FailureThreshold,
+	} {
+		if value < 0 {
+			return http.StatusBadRequest, response.PropertyValueFormatError, []interface{}{strconv.Itoa(value), property},
+				fmt.Errorf("property %s can't be negative", property)
+		}
+	}
+	return http.StatusOK, "", nil, nil
+}
+
+// isRelativePath reports if the path stays below the element it's relative to: it has no leading
+// slash, no query or fragment, and no empty, "." or ".." segments, raw or percent-encoded. The path is
+// decoded until it no longer changes, as the services or the plugins may decode it again.
+func isRelativePath(targetURI string) bool {
+	if targetURI == "" {
+		return true
+	}
+	for {
+		if strings.HasPrefix(targetURI, "/") || strings.ContainsAny(targetURI, "?#") ||
+			path.Clean(targetURI) != targetURI || targetURI == "." || targetURI == ".." || strings.HasPrefix(targetURI, "../") {
+			return false
+		}
+		decoded, err := url.PathUnescape(targetURI)
+		if err != nil {
+			return false
+		}
+		if decoded == targetURI {
+			return true
+		}
+		targetURI = decoded
+	}
+}
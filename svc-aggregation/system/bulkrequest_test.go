@@ -0,0 +1,336 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	aggregatorproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/aggregator"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/svc-aggregation/agmodel"
+)
+
+// bulkRequestRecorder records the requests sent to the services for the elements,
+// the requests on the system c14d91b5-3333-48bb-a7b7-75f74a137d48 fail
+type bulkRequestRecorder struct {
+	lock     sync.Mutex
+	requests []string
+}
+
+func (r *bulkRequestRecorder) sendElementRequest(ctx context.Context, req ElementRequest) response.RPC {
+	r.lock.Lock()
+	r.requests = append(r.requests, req.Method+" "+req.URI+" "+req.SessionToken+" "+string(req.RequestBody))
+	r.lock.Unlock()
+	if strings.Contains(req.URI+string(req.RequestBody), "c14d91b5-3333-48bb-a7b7-75f74a137d48") {
+		return common.GeneralError(http.StatusBadRequest, response.GeneralError, "error", nil, nil)
+	}
+	return response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Body:          map[string]interface{}{"MessageId": "Base.1.0.Success"},
+	}
+}
+
+// enablePassThrough enables the pass-through requests, except on the URIs matching the denied pattern
+func enablePassThrough(t *testing.T, denied string) {
+	config.Data.PassThroughConf = &config.PassThroughConf{Enabled: true, DenyList: []string{denied}}
+	if err := config.Data.PassThroughConf.Compile(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+}
+
+func TestExternalInterface_BulkRequestElementsOfAggregate(t *testing.T) {
+	common.MuxLock.Lock()
+	config.SetUpMockConfig(t)
+	common.MuxLock.Unlock()
+	enablePassThrough(t, "/Managers/")
+	defer func() {
+		common.TruncateDB(common.OnDisk)
+		common.TruncateDB(common.InMemory)
+	}()
+	aggregates := map[string][]string{
+		"7ff3bd97-c41c-5de0-937d-85d390691b73": []string{
+			"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
+			"/redfish/v1/Systems/a8a1b4d2-0d8e-4d4b-9b0c-32c8fdbb0a57:1",
+		},
+		"e5d8ad6c-4a1e-4e1f-8f1b-2d0ef7a06b5d": []string{
+			"/redfish/v1/Systems/c14d91b5-3333-48bb-a7b7-75f74a137d48:1",
+			"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
+			"/redfish/v1/Systems/a8a1b4d2-0d8e-4d4b-9b0c-32c8fdbb0a57:1",
+		},
+	}
+	for id, elements := range aggregates {
+		if err := agmodel.CreateAggregate(agmodel.Aggregate{Elements: elements}, "/redfish/v1/AggregationService/Aggregates/"+id); err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		taskID         string
+		aggregateID    string
+		request        map[string]interface{}
+		wantStatusCode int32
+		wantRequests   int
+	}{
+		{
+			name:        "PATCH of the BIOS settings",
+			taskID:      "someID",
+			aggregateID: "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request: map[string]interface{}{
+				"TargetURI":   "Bios/Settings",
+				"HTTPMethod":  "patch",
+				"RequestBody": map[string]interface{}{"Attributes": map[string]interface{}{"BootMode": "Uefi"}},
+				"BatchSize":   1,
+			},
+			wantStatusCode: http.StatusOK,
+			wantRequests:   2,
+		},
+		{
+			name:           "request to the systems",
+			taskID:         "subTaskWithSlash",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "PATCH", "RequestBody": map[string]interface{}{"IndicatorLED": "Lit"}},
+			wantStatusCode: http.StatusOK,
+			wantRequests:   2,
+		},
+		{
+			name:           "failure on an element",
+			taskID:         "someID",
+			aggregateID:    "e5d8ad6c-4a1e-4e1f-8f1b-2d0ef7a06b5d",
+			request:        map[string]interface{}{"TargetURI": "Storage/1/Volumes", "HTTPMethod": "POST", "BatchSize": 1},
+			wantStatusCode: http.StatusBadRequest,
+			wantRequests:   3,
+		},
+		{
+			name:           "failure threshold reached",
+			taskID:         "someID",
+			aggregateID:    "e5d8ad6c-4a1e-4e1f-8f1b-2d0ef7a06b5d",
+			request:        map[string]interface{}{"TargetURI": "Storage/1/Volumes", "HTTPMethod": "POST", "BatchSize": 1, "FailureThreshold": 1},
+			wantStatusCode: http.StatusBadRequest,
+			wantRequests:   1,
+		},
+		{
+			name:           "subtask creation failure",
+			taskID:         "taskWithoutChild",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "DELETE", "TargetURI": "Storage/1/Volumes/1"},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:           "invalid aggregate id",
+			taskID:         "someID",
+			aggregateID:    "12345",
+			request:        map[string]interface{}{"HTTPMethod": "PATCH"},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "missing HTTPMethod",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"TargetURI": "Bios/Settings"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "unsupported HTTPMethod",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "GET"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "absolute TargetURI",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "PATCH", "TargetURI": "/redfish/v1/Managers/1"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "TargetURI outside of the systems",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "PATCH", "TargetURI": "Bios/../../../Managers/1"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "TargetURI with encoded dot-dot segments",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "PATCH", "TargetURI": "Bios/%2e%2E/%252e%252e/Managers/1"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "TargetURI with a dot segment",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "PATCH", "TargetURI": "./Bios/Settings"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "SimpleUpdate with another method than POST",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "PATCH", "TargetURI": simpleUpdateURI, "RequestBody": map[string]interface{}{}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "TargetURI denied for pass-through",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "PATCH", "TargetURI": "Oem/Managers/1"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "negative BatchSize",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"HTTPMethod": "PATCH", "BatchSize": -1},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "property in lower case",
+			taskID:         "someID",
+			aggregateID:    "7ff3bd97-c41c-5de0-937d-85d390691b73",
+			request:        map[string]interface{}{"httpMethod": "PATCH"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &bulkRequestRecorder{}
+			e := getMockExternalInterface()
+			e.SendElementRequest = recorder.sendElementRequest
+			reqBody, _ := json.Marshal(tt.request)
+			req := &aggregatorproto.AggregatorRequest{
+				SessionToken: "validToken",
+				URL:          "/redfish/v1/AggregationService/Aggregates/" + tt.aggregateID + "/Actions/Aggregate.BulkRequest",
+				RequestBody:  reqBody,
+			}
+			if got := e.BulkRequestElementsOfAggregate(context.TODO(), tt.taskID, "someUser", req); got.StatusCode != tt.wantStatusCode {
+				t.Errorf("ExternalInterface.BulkRequestElementsOfAggregate() = %v, want %v", got.StatusCode, tt.wantStatusCode)
+			}
+			if len(recorder.requests) != tt.wantRequests {
+				t.Errorf("ExternalInterface.BulkRequestElementsOfAggregate() sent %v, want %v requests", recorder.requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestExternalInterface_BulkRequestElementsOfAggregate_targetURI(t *testing.T) {
+	common.MuxLock.Lock()
+	config.SetUpMockConfig(t)
+	common.MuxLock.Unlock()
+	defer func() {
+		common.TruncateDB(common.OnDisk)
+	}()
+	err := agmodel.CreateAggregate(agmodel.Aggregate{Elements: []string{"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1"}},
+		"/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73")
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	tests := []struct {
+		name        string
+		requestBody string
+		want        string
+	}{
+		{
+			name:        "modelled resource, pass-through disabled",
+			requestBody: `{"TargetURI":"Bios/Settings/","HTTPMethod":"PATCH","RequestBody":{"Attributes":{"BootMode":"Uefi"}}}`,
+			want:        `PATCH /redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1/Bios/Settings validToken {"Attributes":{"BootMode":"Uefi"}}`,
+		},
+		{
+			name:        "SimpleUpdate",
+			requestBody: `{"TargetURI":"` + simpleUpdateURI + `","HTTPMethod":"POST","RequestBody":{"ImageURI":"http://images/bios.bin"}}`,
+			want: `POST ` + simpleUpdateURI + ` validToken ` +
+				`{"ImageURI":"http://images/bios.bin","Targets":["/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &bulkRequestRecorder{}
+			e := getMockExternalInterface()
+			e.SendElementRequest = recorder.sendElementRequest
+			req := &aggregatorproto.AggregatorRequest{
+				SessionToken: "validToken",
+				URL:          "/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73/Actions/Aggregate.BulkRequest",
+				RequestBody:  []byte(tt.requestBody),
+			}
+			if got := e.BulkRequestElementsOfAggregate(context.TODO(), "someID", "someUser", req); got.StatusCode != http.StatusOK {
+				t.Fatalf("ExternalInterface.BulkRequestElementsOfAggregate() = %v, want %v", got.StatusCode, http.StatusOK)
+			}
+			if len(recorder.requests) != 1 || recorder.requests[0] != tt.want {
+				t.Errorf("ExternalInterface.BulkRequestElementsOfAggregate() sent %v, want %v", recorder.requests, tt.want)
+			}
+		})
+	}
+}
+
+func TestExternalInterface_BulkRequestElementsOfAggregate_passThroughDisabled(t *testing.T) {
+	common.MuxLock.Lock()
+	config.SetUpMockConfig(t)
+	common.MuxLock.Unlock()
+	defer func() {
+		common.TruncateDB(common.OnDisk)
+	}()
+	err := agmodel.CreateAggregate(agmodel.Aggregate{Elements: []string{"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1"}},
+		"/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73")
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	recorder := &bulkRequestRecorder{}
+	e := getMockExternalInterface()
+	e.SendElementRequest = recorder.sendElementRequest
+	req := &aggregatorproto.AggregatorRequest{
+		SessionToken: "validToken",
+		URL:          "/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73/Actions/Aggregate.BulkRequest",
+		RequestBody:  []byte(`{"TargetURI":"Oem/Controls/1","HTTPMethod":"PATCH","RequestBody":{"SetPoint":10}}`),
+	}
+	if got := e.BulkRequestElementsOfAggregate(context.TODO(), "someID", "someUser", req); got.StatusCode != http.StatusBadRequest {
+		t.Errorf("ExternalInterface.BulkRequestElementsOfAggregate() = %v, want %v", got.StatusCode, http.StatusBadRequest)
+	}
+	if len(recorder.requests) != 0 {
+		t.Errorf("ExternalInterface.BulkRequestElementsOfAggregate() sent %v, want no request", recorder.requests)
+	}
+}
+
+func TestRouteElementRequest(t *testing.T) {
+	tests := []struct {
+		method string
+		uri    string
+		want   string
+	}{
+		{http.MethodPost, "/redfish/v1/Systems/uuid:1/Actions/ComputerSystem.Reset", "ComputerSystemReset"},
+		{http.MethodPost, "/redfish/v1/Systems/uuid:1/Actions/ComputerSystem.SetDefaultBootOrder", "SetDefaultBootOrder"},
+		{http.MethodPatch, "/redfish/v1/Systems/uuid:1", "ChangeBootOrderSettings"},
+		{http.MethodPatch, "/redfish/v1/Systems/uuid:1/Bios/Settings", "ChangeBiosSettings"},
+		{http.MethodPost, "/redfish/v1/Systems/uuid:1/Storage/1/Volumes", "CreateVolume"},
+		{http.MethodDelete, "/redfish/v1/Systems/uuid:1/Storage/1/Volumes/2", "DeleteVolume"},
+		{http.MethodPost, simpleUpdateURI, "SimpleUpdate"},
+		{http.MethodPost, "/redfish/v1/Systems/uuid:1/Bios/Settings", "PassThrough"},
+		{http.MethodPatch, "/redfish/v1/Systems/uuid:1/Oem/Controls/1", "PassThrough"},
+	}
+	for _, tt := range tests {
+		if got, _ := routeElementRequest(tt.method, tt.uri); got.name != tt.want {
+			t.Errorf("routeElementRequest(%v, %v) = %v, want %v", tt.method, tt.uri, got.name, tt.want)
+		}
+	}
+}
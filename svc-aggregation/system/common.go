@@ -29,12 +29,15 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+	"github.com/ODIM-Project/ODIM/lib-rest-client/pluginclient"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
 	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
 	taskproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/task"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/lib-utilities/schemavalidation"
 	"github.com/ODIM-Project/ODIM/lib-utilities/services"
 	"github.com/ODIM-Project/ODIM/svc-aggregation/agcommon"
 	"github.com/ODIM-Project/ODIM/svc-aggregation/agmessagebus"
@@ -67,14 +70,19 @@ type ExternalInterface struct {
 	DeleteSystem             func(string) *errors.Error
 	DeleteEventSubscription  func(string) (*eventsproto.EventSubResponse, error)
 	EventNotification        func(string, string, string)
+	PublishAggregateEvent    func(string, string, string)
 	GetAllKeysFromTable      func(string) ([]string, error)
 	GetConnectionMethod      func(string) (agmodel.ConnectionMethod, *errors.Error)
 	UpdateConnectionMethod   func(agmodel.ConnectionMethod, string) *errors.Error
 	GetPluginMgrAddr         func(string) (agmodel.Plugin, *errors.Error)
 	GetAggregationSourceInfo func(string) (agmodel.AggregationSource, *errors.Error)
+	GetAggregationSourceETag func(string) (string, *errors.Error)
 	GenericSave              func([]byte, string, string) error
 	CheckActiveRequest       func(string) (bool, *errors.Error)
 	DeleteActiveRequest      func(string) *errors.Error
+	BackupOnDiskData         func() ([]persistencemgr.BackupEntry, *errors.Error)
+	RestoreOnDiskData        func([]persistencemgr.BackupEntry) *errors.Error
+	SendElementRequest       func(context.Context, ElementRequest) response.RPC
 }
 
 type responseStatus struct {
@@ -90,7 +98,6 @@ type getResourceRequest struct {
 	SystemID          string
 	DeviceUUID        string
 	DeviceInfo        interface{}
-	LoginCredentials  map[string]string
 	ParentOID         string
 	OID               string
 	ContactClient     func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
@@ -98,7 +105,6 @@ type getResourceRequest struct {
 	Plugin            agmodel.Plugin
 	TaskRequest       string
 	HTTPMethodType    string
-	Token             string
 	StatusPoll        bool
 	CreateSubcription func([]string)
 	PublishEvent      func([]string, string)
@@ -254,56 +260,54 @@ func UpdateTaskData(taskData common.TaskData) error {
 	return nil
 }
 
+// contactPlugin sends the request to the plugin with the session token or the basic authentication
+// of the plugin, checking the status of the plugin before the last attempt when StatusPoll is set
 func contactPlugin(req getResourceRequest, errorMessage string) ([]byte, string, responseStatus, error) {
 	var resp responseStatus
-	pluginResp, err := callPlugin(req)
-	if err != nil {
-		if req.StatusPoll {
-			if req.GetPluginStatus(req.Plugin) {
-				pluginResp, err = callPlugin(req)
-			}
-		}
-		if err != nil {
-			errorMessage = errorMessage + err.Error()
-			resp.StatusCode = http.StatusServiceUnavailable
-			resp.StatusMessage = response.CouldNotEstablishConnection
-			resp.MsgArgs = []interface{}{"https://" + req.Plugin.IP + ":" + req.Plugin.Port + req.OID}
-			return nil, "", resp, fmt.Errorf(errorMessage)
+	pluginRequest := pluginclient.Request{
+		Plugin: pluginclient.Plugin{
+			ID:                req.Plugin.ID,
+			IP:                req.Plugin.IP,
+			Port:              req.Plugin.Port,
+			Username:          req.Plugin.Username,
+			Password:          req.Plugin.Password,
+			PreferredAuthType: req.Plugin.PreferredAuthType,
+		},
+		Method:        req.HTTPMethodType,
+		URI:           req.OID,
+		Body:          req.DeviceInfo,
+		ContactClient: req.ContactClient,
+	}
+	if req.StatusPoll {
+		pluginRequest.StatusCheck = func() bool {
+			return req.GetPluginStatus(req.Plugin)
 		}
 	}
-
-	defer pluginResp.Body.Close()
-	body, err := ioutil.ReadAll(pluginResp.Body)
+	pluginResp, err := pluginclient.Do(pluginRequest)
 	if err != nil {
-		errorMessage := "error while trying to read plugin response body: " + err.Error()
-		resp.StatusCode = http.StatusInternalServerError
-		resp.StatusMessage = response.InternalError
-		return nil, "", resp, fmt.Errorf(errorMessage)
-	}
-
-	if pluginResp.StatusCode != http.StatusCreated && pluginResp.StatusCode != http.StatusOK {
-		if pluginResp.StatusCode == http.StatusUnauthorized {
-			errorMessage += "error: invalid resource username/password"
-			resp.StatusCode = int32(pluginResp.StatusCode)
-			resp.StatusMessage = response.ResourceAtURIUnauthorized
-			resp.MsgArgs = []interface{}{"https://" + req.Plugin.IP + ":" + req.Plugin.Port + req.OID}
-			log.Error(errorMessage)
-			return nil, "", resp, fmt.Errorf(errorMessage)
-		}
-		errorMessage += string(body)
-		resp.StatusCode = int32(pluginResp.StatusCode)
-		resp.StatusMessage = response.InternalError
+		errorMessage = errorMessage + err.Error()
+		resp.StatusCode = err.StatusCode
+		resp.StatusMessage = err.StatusMessage
+		resp.MsgArgs = err.MsgArgs
 		log.Error(errorMessage)
-		return body, "", resp, fmt.Errorf(errorMessage)
+		return err.Body, "", resp, fmt.Errorf(errorMessage)
 	}
 
-	data := string(body)
-	//replacing the resposne with north bound translation URL
-	for key, value := range getTranslationURL(northBoundURL) {
-		data = strings.Replace(data, key, value, -1)
-	}
 	resp.StatusCode = int32(pluginResp.StatusCode)
-	return []byte(data), pluginResp.Header.Get("X-Auth-Token"), resp, nil
+	if req.HTTPMethodType == http.MethodGet {
+		return validateResource(req, pluginResp.Body), pluginResp.Header.Get("X-Auth-Token"), resp, nil
+	}
+	return pluginResp.Body, pluginResp.Header.Get("X-Auth-Token"), resp, nil
+}
+
+// validateResource validates the resource returned by the plugin against its Redfish schema,
+// the resource being identified by the URI it's exposed with on the northbound
+func validateResource(req getResourceRequest, data []byte) []byte {
+	resourceURI := strings.TrimSuffix(req.OID, "/")
+	if req.DeviceUUID != "" {
+		resourceURI = updateResourceDataWithUUID(resourceURI, req.DeviceUUID)
+	}
+	return schemavalidation.Validate(req.Plugin.ID, resourceURI, data)
 }
 
 // keyFormation is to form the key to insert in DB
@@ -1057,18 +1061,6 @@ func removeRetrievalLinks(retrievalLinks map[string]bool, parentoid string, reso
 	return
 }
 
-func callPlugin(req getResourceRequest) (*http.Response, error) {
-	var oid string
-	for key, value := range getTranslationURL(southBoundURL) {
-		oid = strings.Replace(req.OID, key, value, -1)
-	}
-	var reqURL = "https://" + req.Plugin.IP + ":" + req.Plugin.Port + oid
-	if strings.EqualFold(req.Plugin.PreferredAuthType, "BasicAuth") {
-		return req.ContactClient(reqURL, req.HTTPMethodType, "", oid, req.DeviceInfo, req.LoginCredentials)
-	}
-	return req.ContactClient(reqURL, req.HTTPMethodType, req.Token, oid, req.DeviceInfo, nil)
-}
-
 func updateManagerName(data []byte, pluginID string) []byte {
 	var managersMap map[string]interface{}
 	json.Unmarshal(data, &managersMap)
@@ -1198,26 +1190,6 @@ func checkStatus(pluginContactRequest getResourceRequest, req AddResourceRequest
 	}
 	pluginContactRequest.Plugin = plugin
 	pluginContactRequest.StatusPoll = true
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		pluginContactRequest.HTTPMethodType = http.MethodPost
-		pluginContactRequest.DeviceInfo = map[string]interface{}{
-			"Username": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		pluginContactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := contactPlugin(pluginContactRequest, "error while creating the session: ")
-		if err != nil {
-			errMsg := err.Error()
-			log.Error(errMsg)
-			return common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, errMsg, getResponse.MsgArgs, taskInfo), getResponse.StatusCode, queueList
-		}
-		pluginContactRequest.Token = token
-	} else {
-		pluginContactRequest.LoginCredentials = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-	}
 
 	// Verfiying the plugin Status
 	pluginContactRequest.HTTPMethodType = http.MethodGet
@@ -189,10 +189,6 @@ func (e *ExternalInterface) deletePlugin(oid string) response.RPC {
 	pluginContactRequest.Plugin = plugin
 	pluginContactRequest.StatusPoll = false
 	pluginContactRequest.HTTPMethodType = http.MethodGet
-	pluginContactRequest.LoginCredentials = map[string]string{
-		"UserName": plugin.Username,
-		"Password": string(plugin.Password),
-	}
 	pluginContactRequest.OID = "/ODIM/v1/Status"
 	_, _, _, err := contactPlugin(pluginContactRequest, "error while getting the details "+pluginContactRequest.OID+": ")
 	if err == nil { // no err means plugin is still up, so we can't remove it
@@ -311,6 +307,7 @@ func (e *ExternalInterface) deleteCompute(key string, index int) response.RPC {
 		e.EventNotification(chassis, "ResourceRemoved", "ChassisCollection")
 	}
 	e.EventNotification(key, "ResourceRemoved", "SystemsCollection")
+	e.UpdateDynamicAggregates(key)
 	resp.Header = map[string]string{
 		"Cache-Control":     "no-cache",
 		"Transfer-Encoding": "chunked",
@@ -0,0 +1,285 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package system
+
+import (
+	"encoding/json"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/svc-aggregation/agmodel"
+)
+
+const (
+	// dynamicAggregatesDelay is the delay after which the dynamic aggregates are updated once a system
+	// changed, so that the systems changing together, e.g. during the rediscovery, are evaluated at once
+	dynamicAggregatesDelay = 5 * time.Second
+	// dynamicAggregatesLock is the DB lock held while the dynamic aggregates are updated, so that
+	// the instances of the aggregator don't update the elements of the aggregates at the same time
+	dynamicAggregatesLock = "DynamicAggregates"
+	// dynamicAggregatesLockExpiry releases the lock if the instance holding it stops while updating
+	dynamicAggregatesLockExpiry = 5 * time.Minute
+	// dynamicAggregatesLockTimeout is how long the requests creating or deleting an aggregate
+	// wait for the lock, which is checked every dynamicAggregatesLockRetry
+	dynamicAggregatesLockTimeout = 30 * time.Second
+	dynamicAggregatesLockRetry   = 100 * time.Millisecond
+)
+
+// dynamicAggregatesUpdate holds the systems changed since the last update of the dynamic aggregates
+type dynamicAggregatesUpdate struct {
+	lock sync.Mutex
+	// systems holds the URIs of the changed systems, all the systems are evaluated when all is set
+	systems   map[string]bool
+	all       bool
+	scheduled bool
+	// running serializes the updates of the dynamic aggregates by the instance
+	running sync.Mutex
+}
+
+var pendingDynamicAggregates = &dynamicAggregatesUpdate{systems: make(map[string]bool)}
+
+// UpdateDynamicAggregates schedules the evaluation of the MembershipFilter of the dynamic aggregates
+// against the given computer systems, or against all the computer systems when none is given.
+// The evaluation runs in the background after dynamicAggregatesDelay, along with the systems
+// changed meanwhile, so that the callers don't wait for it.
+func (e *ExternalInterface) UpdateDynamicAggregates(systemURIs ...string) {
+	pending := pendingDynamicAggregates
+	pending.lock.Lock()
+	defer pending.lock.Unlock()
+	pending.all = pending.all || len(systemURIs) == 0
+	for _, systemURI := range systemURIs {
+		pending.systems[strings.TrimSuffix(systemURI, "/")] = true
+	}
+	if !pending.scheduled {
+		pending.scheduled = true
+		time.AfterFunc(dynamicAggregatesDelay, e.runDynamicAggregatesUpdate)
+	}
+}
+
+// runDynamicAggregatesUpdate evaluates the dynamic aggregates against the systems changed since the
+// last update. The update is postponed while another instance of the aggregator holds the DB lock.
+func (e *ExternalInterface) runDynamicAggregatesUpdate() {
+	pending := pendingDynamicAggregates
+	pending.running.Lock()
+	defer pending.running.Unlock()
+	token, err := agmodel.TryLock(dynamicAggregatesLock, dynamicAggregatesLockExpiry)
+	if err != nil {
+		log.Error("error while trying to lock the dynamic aggregates: " + err.Error())
+	}
+	if token == "" {
+		time.AfterFunc(dynamicAggregatesDelay, e.runDynamicAggregatesUpdate)
+		return
+	}
+	defer agmodel.Unlock(dynamicAggregatesLock, token)
+
+	pending.lock.Lock()
+	systems, all := pending.systems, pending.all
+	pending.systems, pending.all, pending.scheduled = make(map[string]bool), false, false
+	pending.lock.Unlock()
+	var systemURIs []string
+	if !all {
+		for systemURI := range systems {
+			systemURIs = append(systemURIs, systemURI)
+		}
+	}
+	e.updateDynamicAggregates(systemURIs)
+}
+
+// lockDynamicAggregates waits for the DB lock held while the dynamic aggregates are updated, so that
+// an aggregate is not created or deleted while agmodel.UpdateAggregateElements replaces the elements
+// of the aggregates. The returned token is released with agmodel.Unlock.
+func lockDynamicAggregates() (string, *errors.Error) {
+	deadline := time.Now().Add(dynamicAggregatesLockTimeout)
+	for {
+		token, err := agmodel.TryLock(dynamicAggregatesLock, dynamicAggregatesLockExpiry)
+		if err != nil || token != "" {
+			return token, err
+		}
+		if time.Now().After(deadline) {
+			return "", errors.PackError(errors.UndefinedErrorType, "timed out while waiting for the update of the dynamic aggregates")
+		}
+		time.Sleep(dynamicAggregatesLockRetry)
+	}
+}
+
+// updateDynamicAggregates re-evaluates the MembershipFilter of the dynamic aggregates against the given
+// computer systems, or against all the computer systems in the inventory when none is given. The given
+// systems are added to or removed from the elements of the aggregates depending on their filter, and
+// an event is published for each system which joined or left an aggregate.
+// The caller holds the DB lock of the dynamic aggregates.
+func (e *ExternalInterface) updateDynamicAggregates(systemURIs []string) {
+	aggregateURIs, err := agmodel.GetAllKeysFromTable("Aggregate")
+	if err != nil {
+		log.Error("error while trying to get the aggregates: " + err.Error())
+		return
+	}
+	var systems map[string]map[string]interface{}
+	for _, aggregateURI := range aggregateURIs {
+		aggregate, err := agmodel.GetAggregate(aggregateURI)
+		if err != nil {
+			log.Error("error while trying to get the aggregate " + aggregateURI + ": " + err.Error())
+			continue
+		}
+		if aggregate.MembershipFilter == "" {
+			continue
+		}
+		filter, ferr := common.ParseFilter(aggregate.MembershipFilter)
+		if ferr != nil {
+			log.Error("invalid membership filter of the aggregate " + aggregateURI + ": " + ferr.Error())
+			continue
+		}
+		// the systems are read once for all the dynamic aggregates
+		if systems == nil {
+			systems = getFilterableSystems(systemURIs)
+		}
+		var elements []string
+		if len(systemURIs) == 0 {
+			elements = matchSystems(filter, systems)
+		} else {
+			elements = append(missingElements(aggregate.Elements, systemURIs), matchSystems(filter, systems)...)
+			sort.Strings(elements)
+		}
+		joined := missingElements(elements, aggregate.Elements)
+		left := missingElements(aggregate.Elements, elements)
+		if len(joined) == 0 && len(left) == 0 {
+			continue
+		}
+		if err := agmodel.UpdateAggregateElements(aggregateURI, elements); err != nil {
+			log.Error("error while trying to update the elements of the aggregate " + aggregateURI + ": " + err.Error())
+			continue
+		}
+		log.Info("membership of the aggregate " + aggregateURI + " is updated")
+		for _, element := range joined {
+			e.PublishAggregateEvent(aggregateURI, element, "ResourceAdded")
+		}
+		for _, element := range left {
+			e.PublishAggregateEvent(aggregateURI, element, "ResourceRemoved")
+		}
+	}
+}
+
+// getAggregateMembers returns the computer systems matching the membership filter of an aggregate
+func getAggregateMembers(membershipFilter string) ([]string, error) {
+	filter, err := common.ParseFilter(membershipFilter)
+	if err != nil {
+		return nil, err
+	}
+	return matchSystems(filter, getFilterableSystems(nil)), nil
+}
+
+// getFilterableSystems returns the given computer systems, or all the computer systems of the inventory
+// when none is given, with the properties a membership filter can refer to. The systems which are not
+// in the inventory anymore are left out.
+func getFilterableSystems(systemURIs []string) map[string]map[string]interface{} {
+	systems := make(map[string]map[string]interface{})
+	keys := systemURIs
+	if len(keys) == 0 {
+		var err *errors.Error
+		if keys, err = agmodel.GetAllMatchingDetails("ComputerSystem", "", common.InMemory); err != nil {
+			log.Error("error while trying to get the systems: " + err.Error())
+			return systems
+		}
+	}
+	for _, key := range keys {
+		system, err := getFilterableSystem(key)
+		if err != nil {
+			if err.ErrNo() != errors.DBKeyNotFound {
+				log.Error(err.Error())
+			}
+			continue
+		}
+		systems[key] = system
+	}
+	return systems
+}
+
+// getFilterableSystem returns a computer system with the properties a membership filter can refer to.
+// AggregationSource is the aggregation source of the system, and Location is taken from the chassis
+// of the system when the system doesn't have one.
+func getFilterableSystem(key string) (map[string]interface{}, *errors.Error) {
+	data, err := agmodel.GetSystem(key)
+	if err != nil {
+		return nil, errors.PackError(err.ErrNo(), "error while trying to get the system ", key, ": ", err.Error())
+	}
+	var system map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &system); err != nil {
+		return nil, errors.PackError(errors.JSONUnmarshalFailed, "error while trying to read the system ", key, ": ", err)
+	}
+	system["AggregationSource"] = "/redfish/v1/AggregationService/AggregationSources/" + key[strings.LastIndex(key, "/")+1:]
+	if _, ok := system["Location"]; !ok {
+		if location := getChassisLocation(system); location != nil {
+			system["Location"] = location
+		}
+	}
+	return system, nil
+}
+
+// getChassisLocation returns the location of the first chassis linked to the system
+func getChassisLocation(system map[string]interface{}) interface{} {
+	links, _ := system["Links"].(map[string]interface{})
+	chassisLinks, _ := links["Chassis"].([]interface{})
+	if len(chassisLinks) == 0 {
+		return nil
+	}
+	link, _ := chassisLinks[0].(map[string]interface{})
+	chassisURI, _ := link["@odata.id"].(string)
+	if chassisURI == "" {
+		return nil
+	}
+	data, err := agmodel.GetResource("Chassis", chassisURI)
+	if err != nil {
+		return nil
+	}
+	var chassis map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &chassis); err != nil {
+		return nil
+	}
+	return chassis["Location"]
+}
+
+// matchSystems returns the sorted URIs of the systems satisfying the filter
+func matchSystems(filter *common.Filter, systems map[string]map[string]interface{}) []string {
+	elements := []string{}
+	for key, system := range systems {
+		if filter.Match(system) {
+			elements = append(elements, key)
+		}
+	}
+	sort.Strings(elements)
+	return elements
+}
+
+// missingElements returns the elements which are not in the other elements
+func missingElements(elements, otherElements []string) []string {
+	var missing []string
+	for _, element := range elements {
+		found := false
+		for _, otherElement := range otherElements {
+			if element == otherElement {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, element)
+		}
+	}
+	return missing
+}
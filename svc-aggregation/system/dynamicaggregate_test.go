@@ -0,0 +1,224 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package system
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/svc-aggregation/agmodel"
+)
+
+type aggregateEvent struct {
+	aggregateURI, systemURI, eventType string
+}
+
+func TestExternalInterface_UpdateDynamicAggregates(t *testing.T) {
+	config.SetUpMockConfig(t)
+	// the updates scheduled by the other tests wait until the end of the test
+	pendingDynamicAggregates.running.Lock()
+	defer pendingDynamicAggregates.running.Unlock()
+	defer func() {
+		common.TruncateDB(common.OnDisk)
+		common.TruncateDB(common.InMemory)
+	}()
+
+	systems := map[string]map[string]interface{}{
+		"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1": {
+			"Id": "1", "Manufacturer": "HPE", "Model": "ProLiant DL360 Gen10",
+			"Links": map[string]interface{}{
+				"Chassis": []interface{}{
+					map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1"},
+				},
+			},
+		},
+		"/redfish/v1/Systems/c14d91b5-3333-48bb-a7b7-75f74a137d48:1": {
+			"Id": "1", "Manufacturer": "HPE", "Model": "ProLiant DL380 Gen10",
+		},
+		"/redfish/v1/Systems/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1": {
+			"Id": "1", "Manufacturer": "Dell", "Model": "PowerEdge R640",
+		},
+	}
+	for key, system := range systems {
+		data, _ := json.Marshal(system)
+		if err := mockSystemResourceData(data, "ComputerSystem", key); err != nil {
+			t.Fatalf("Error in creating mock resource data :%v", err)
+		}
+	}
+	chassis, _ := json.Marshal(map[string]interface{}{
+		"Location": map[string]interface{}{
+			"Placement": map[string]interface{}{"Rack": "R1"},
+		},
+	})
+	if err := mockSystemResourceData(chassis, "Chassis", "/redfish/v1/Chassis/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1"); err != nil {
+		t.Fatalf("Error in creating mock resource data :%v", err)
+	}
+
+	aggregates := map[string]agmodel.Aggregate{
+		"/redfish/v1/AggregationService/Aggregates/1": {
+			Elements:         []string{"/redfish/v1/Systems/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1"},
+			MembershipFilter: "Manufacturer eq HPE",
+		},
+		"/redfish/v1/AggregationService/Aggregates/2": {
+			Elements:         []string{},
+			MembershipFilter: "Location/Placement/Rack eq R1",
+		},
+		"/redfish/v1/AggregationService/Aggregates/3": {
+			Elements:         []string{},
+			MembershipFilter: "AggregationSource eq /redfish/v1/AggregationService/AggregationSources/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1",
+		},
+		"/redfish/v1/AggregationService/Aggregates/4": {
+			Elements: []string{"/redfish/v1/Systems/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1"},
+		},
+	}
+	for uri, aggregate := range aggregates {
+		if err := agmodel.CreateAggregate(aggregate, uri); err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}
+
+	var events []aggregateEvent
+	e := getMockExternalInterface()
+	e.PublishAggregateEvent = func(aggregateURI, systemURI, eventType string) {
+		events = append(events, aggregateEvent{aggregateURI, systemURI, eventType})
+	}
+	e.updateDynamicAggregates(nil)
+
+	want := map[string][]string{
+		"/redfish/v1/AggregationService/Aggregates/1": {
+			"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
+			"/redfish/v1/Systems/c14d91b5-3333-48bb-a7b7-75f74a137d48:1",
+		},
+		"/redfish/v1/AggregationService/Aggregates/2": {
+			"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
+		},
+		"/redfish/v1/AggregationService/Aggregates/3": {
+			"/redfish/v1/Systems/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1",
+		},
+		"/redfish/v1/AggregationService/Aggregates/4": {
+			"/redfish/v1/Systems/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1",
+		},
+	}
+	for uri, elements := range want {
+		aggregate, err := agmodel.GetAggregate(uri)
+		if err != nil {
+			t.Fatalf("error: %v", err)
+		}
+		if !reflect.DeepEqual(aggregate.Elements, elements) {
+			t.Errorf("elements of %v = %v, want %v", uri, aggregate.Elements, elements)
+		}
+	}
+	if len(events) != 5 {
+		t.Errorf("UpdateDynamicAggregates() published %v, want 5 events", events)
+	}
+	removed := aggregateEvent{
+		"/redfish/v1/AggregationService/Aggregates/1",
+		"/redfish/v1/Systems/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1",
+		"ResourceRemoved",
+	}
+	found := false
+	for _, event := range events {
+		found = found || event == removed
+	}
+	if !found {
+		t.Errorf("UpdateDynamicAggregates() published %v, want %v", events, removed)
+	}
+
+	// nothing is published when the membership doesn't change
+	events = nil
+	e.updateDynamicAggregates(nil)
+	if len(events) != 0 {
+		t.Errorf("UpdateDynamicAggregates() published %v, want no event", events)
+	}
+
+	// only the given systems are evaluated, the systems left out of the inventory are removed
+	dell := "/redfish/v1/Systems/8c624444-87f4-4cfa-b5f9-074cd8cd114d:1"
+	dl380 := "/redfish/v1/Systems/c14d91b5-3333-48bb-a7b7-75f74a137d48:1"
+	conn, _ := common.GetDBConnection(common.InMemory)
+	data, _ := json.Marshal(map[string]interface{}{"Id": "1", "Manufacturer": "HPE", "Model": "ProLiant DL20 Gen10"})
+	if _, err := conn.Update("ComputerSystem", dell, string(data)); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if err := conn.Delete("ComputerSystem", dl380); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	e.updateDynamicAggregates([]string{dell, dl380})
+	aggregate, _ := agmodel.GetAggregate("/redfish/v1/AggregationService/Aggregates/1")
+	wantElements := []string{"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1", dell}
+	if !reflect.DeepEqual(aggregate.Elements, wantElements) {
+		t.Errorf("elements of the aggregate 1 = %v, want %v", aggregate.Elements, wantElements)
+	}
+	aggregate, _ = agmodel.GetAggregate("/redfish/v1/AggregationService/Aggregates/3")
+	if len(aggregate.Elements) != 1 || aggregate.Elements[0] != dell {
+		t.Errorf("elements of the aggregate 3 = %v, want [%v]", aggregate.Elements, dell)
+	}
+}
+
+func TestExternalInterface_ScheduleDynamicAggregates(t *testing.T) {
+	pending := pendingDynamicAggregates
+	// the scheduled update waits until the end of the test
+	pending.running.Lock()
+	defer pending.running.Unlock()
+	pending.lock.Lock()
+	pending.systems, pending.all, pending.scheduled = make(map[string]bool), false, false
+	pending.lock.Unlock()
+
+	e := getMockExternalInterface()
+	e.UpdateDynamicAggregates("/redfish/v1/Systems/uuid:1/")
+	e.UpdateDynamicAggregates("/redfish/v1/Systems/uuid:2")
+	pending.lock.Lock()
+	defer pending.lock.Unlock()
+	want := map[string]bool{"/redfish/v1/Systems/uuid:1": true, "/redfish/v1/Systems/uuid:2": true}
+	if !reflect.DeepEqual(pending.systems, want) || pending.all || !pending.scheduled {
+		t.Errorf("UpdateDynamicAggregates() pending systems = %v, all = %v, want %v", pending.systems, pending.all, want)
+	}
+}
+
+func TestLockDynamicAggregates(t *testing.T) {
+	config.SetUpMockConfig(t)
+	token, err := agmodel.TryLock(dynamicAggregatesLock, dynamicAggregatesLockExpiry)
+	if err != nil || token == "" {
+		t.Fatalf("TryLock() = %v, %v, want the lock", token, err)
+	}
+	locked := make(chan string)
+	go func() {
+		token, _ := lockDynamicAggregates()
+		locked <- token
+	}()
+	select {
+	case <-locked:
+		t.Fatal("lockDynamicAggregates() took the lock held by the update of the dynamic aggregates")
+	case <-time.After(3 * dynamicAggregatesLockRetry):
+	}
+	agmodel.Unlock(dynamicAggregatesLock, token)
+	if token = <-locked; token == "" {
+		t.Fatal("lockDynamicAggregates() didn't take the released lock")
+	}
+	agmodel.Unlock(dynamicAggregatesLock, token)
+}
+
+func TestMissingElements(t *testing.T) {
+	got := missingElements([]string{"a", "b", "c"}, []string{"b"})
+	if !reflect.DeepEqual(got, []string{"a", "c"}) {
+		t.Errorf("missingElements() = %v, want [a c]", got)
+	}
+	if got := missingElements([]string{"a"}, []string{"a"}); len(got) != 0 {
+		t.Errorf("missingElements() = %v, want none", got)
+	}
+}
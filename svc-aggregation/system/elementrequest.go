@@ -0,0 +1,245 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	systemsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/systems"
+	updateproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/update"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/lib-utilities/services"
+)
+
+// simpleUpdateURI is the URI of the SimpleUpdate action, the only TargetURI of a bulk request which
+// isn't relative to the elements, the elements are then the Targets of the action
+const simpleUpdateURI = "/redfish/v1/UpdateService/Actions/UpdateService.SimpleUpdate"
+
+// ElementRequest is the request of a bulk request on an element of an aggregate
+type ElementRequest struct {
+	SessionToken string
+	Method       string
+	URI          string // URI of the resource, /redfish/v1/Systems/{id}/{TargetURI} or simpleUpdateURI
+	RequestBody  []byte
+}
+
+// elementRequestRoute is a northbound operation an element request is sent to, when its method
+// is method and its URI matches pattern. The submatches of the URI are passed to send.
+type elementRequestRoute struct {
+	name    string
+	method  string
+	pattern *regexp.Regexp
+	send    func(ctx context.Context, req ElementRequest, parts []string) (rpcResponse, error)
+}
+
+// rpcResponse holds the fields of the responses of the services
+type rpcResponse struct {
+	StatusCode    int32
+	StatusMessage string
+	Header        map[string]string
+	Body          []byte
+}
+
+// elementRequestRoutes are the operations of the services the element requests are sent to. The requests
+// matching none of them are sent to the PassThrough of svc-systems, so they're subject to the PassThroughConf.
+var elementRequestRoutes = []elementRequestRoute{
+	{
+		name:    "ComputerSystemReset",
+		method:  http.MethodPost,
+		pattern: regexp.MustCompile(`^/redfish/v1/Systems/([^/]+)/Actions/ComputerSystem\.Reset$`),
+		send: func(ctx context.Context, req ElementRequest, parts []string) (rpcResponse, error) {
+			resp, err := systemsService().ComputerSystemReset(ctx, &systemsproto.ComputerSystemResetRequest{
+				SessionToken: req.SessionToken,
+				SystemID:     parts[1],
+				RequestBody:  req.RequestBody,
+			})
+			return systemsResponse(resp), err
+		},
+	},
+	{
+		name:    "SetDefaultBootOrder",
+		method:  http.MethodPost,
+		pattern: regexp.MustCompile(`^/redfish/v1/Systems/([^/]+)/Actions/ComputerSystem\.SetDefaultBootOrder$`),
+		send: func(ctx context.Context, req ElementRequest, parts []string) (rpcResponse, error) {
+			resp, err := systemsService().SetDefaultBootOrder(ctx, &systemsproto.DefaultBootOrderRequest{
+				SessionToken: req.SessionToken,
+				SystemID:     parts[1],
+			})
+			return systemsResponse(resp), err
+		},
+	},
+	{
+		name:    "ChangeBootOrderSettings",
+		method:  http.MethodPatch,
+		pattern: regexp.MustCompile(`^/redfish/v1/Systems/([^/]+)$`),
+		send: func(ctx context.Context, req ElementRequest, parts []string) (rpcResponse, error) {
+			resp, err := systemsService().ChangeBootOrderSettings(ctx, &systemsproto.BootOrderSettingsRequest{
+				SessionToken: req.SessionToken,
+				SystemID:     parts[1],
+				RequestBody:  req.RequestBody,
+			})
+			return systemsResponse(resp), err
+		},
+	},
+	{
+		name:    "ChangeBiosSettings",
+		method:  http.MethodPatch,
+		pattern: regexp.MustCompile(`^/redfish/v1/Systems/([^/]+)/Bios/Settings$`),
+		send: func(ctx context.Context, req ElementRequest, parts []string) (rpcResponse, error) {
+			resp, err := systemsService().ChangeBiosSettings(ctx, &systemsproto.BiosSettingsRequest{
+				SessionToken: req.SessionToken,
+				SystemID:     parts[1],
+				RequestBody:  req.RequestBody,
+			})
+			return systemsResponse(resp), err
+		},
+	},
+	{
+		name:    "CreateVolume",
+		method:  http.MethodPost,
+		pattern: regexp.MustCompile(`^/redfish/v1/Systems/([^/]+)/Storage/([^/]+)/Volumes$`),
+		send: func(ctx context.Context, req ElementRequest, parts []string) (rpcResponse, error) {
+			resp, err := systemsService().CreateVolume(ctx, &systemsproto.VolumeRequest{
+				SessionToken:    req.SessionToken,
+				SystemID:        parts[1],
+				StorageInstance: parts[2],
+				RequestBody:     req.RequestBody,
+			})
+			return systemsResponse(resp), err
+		},
+	},
+	{
+		name:    "DeleteVolume",
+		method:  http.MethodDelete,
+		pattern: regexp.MustCompile(`^/redfish/v1/Systems/([^/]+)/Storage/([^/]+)/Volumes/([^/]+)$`),
+		send: func(ctx context.Context, req ElementRequest, parts []string) (rpcResponse, error) {
+			resp, err := systemsService().DeleteVolume(ctx, &systemsproto.VolumeRequest{
+				SessionToken:    req.SessionToken,
+				SystemID:        parts[1],
+				StorageInstance: parts[2],
+				VolumeID:        parts[3],
+				RequestBody:     req.RequestBody,
+			})
+			return systemsResponse(resp), err
+		},
+	},
+	{
+		name:    "SimpleUpdate",
+		method:  http.MethodPost,
+		pattern: regexp.MustCompile(`^` + regexp.QuoteMeta(simpleUpdateURI) + `$`),
+		send: func(ctx context.Context, req ElementRequest, parts []string) (rpcResponse, error) {
+			update := updateproto.NewUpdateService(services.Update, services.Service.Client())
+			resp, err := update.SimepleUpdate(ctx, &updateproto.UpdateRequest{
+				SessionToken: req.SessionToken,
+				URL:          req.URI,
+				RequestBody:  req.RequestBody,
+			})
+			if resp == nil {
+				return rpcResponse{}, err
+			}
+			return rpcResponse{StatusCode: resp.StatusCode, StatusMessage: resp.StatusMessage, Header: resp.Header, Body: resp.Body}, err
+		},
+	},
+}
+
+// passThroughRoute sends the element requests matching none of the elementRequestRoutes to svc-systems
+var passThroughRoute = elementRequestRoute{
+	name: "PassThrough",
+	send: func(ctx context.Context, req ElementRequest, parts []string) (rpcResponse, error) {
+		resp, err := systemsService().PassThrough(ctx, &systemsproto.PassThroughRequest{
+			SessionToken: req.SessionToken,
+			URL:          req.URI,
+			Method:       req.Method,
+			RequestBody:  req.RequestBody,
+		})
+		return systemsResponse(resp), err
+	},
+}
+
+// routeElementRequest returns the route of the element request along with the submatches of its URI
+func routeElementRequest(method, uri string) (elementRequestRoute, []string) {
+	for _, route := range elementRequestRoutes {
+		if route.method != method {
+			continue
+		}
+		if parts := route.pattern.FindStringSubmatch(uri); parts != nil {
+			return route, parts
+		}
+	}
+	return passThroughRoute, nil
+}
+
+// SendElementRequest sends the request on an element of an aggregate to the service owning the
+// resource, the same way as svc-api does for the request sent on the element itself, so the
+// session of the bulk request is authorized by the service, and the inventory, the ETags and
+// the tasks are updated by the service. The response of the service is returned.
+func SendElementRequest(ctx context.Context, req ElementRequest) response.RPC {
+	route, parts := routeElementRequest(req.Method, req.URI)
+	resp, err := route.send(detachedContext{ctx}, req, parts)
+	if err != nil {
+		errMsg := "error while trying to send the " + req.Method + " request on " + req.URI + " with " + route.name + " RPC: " + err.Error()
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
+	}
+	rpcResp := response.RPC{
+		StatusCode:    resp.StatusCode,
+		StatusMessage: resp.StatusMessage,
+		Header:        resp.Header,
+	}
+	var body interface{}
+	if len(resp.Body) != 0 && json.Unmarshal(resp.Body, &body) == nil {
+		rpcResp.Body = body
+	}
+	return rpcResp
+}
+
+// systemsService returns the client of svc-systems
+func systemsService() systemsproto.SystemsService {
+	return systemsproto.NewSystemsService(services.Systems, services.Service.Client())
+}
+
+// systemsResponse returns the fields of the response of svc-systems, which is nil on an RPC error
+func systemsResponse(resp *systemsproto.SystemsResponse) rpcResponse {
+	if resp == nil {
+		return rpcResponse{}
+	}
+	return rpcResponse{StatusCode: resp.StatusCode, StatusMessage: resp.StatusMessage, Header: resp.Header, Body: resp.Body}
+}
+
+// detachedContext keeps the values of a context, as the correlation fields and the trace,
+// without its deadline and cancellation: the bulk requests are applied after the RPC
+// which started them returned
+type detachedContext struct {
+	context.Context
+}
+
+// Deadline reports no deadline
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done returns nil, the context is never cancelled
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err returns nil, the context is never cancelled
+func (detachedContext) Err() error {
+	return nil
+}
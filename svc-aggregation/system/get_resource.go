@@ -12,6 +12,7 @@
 package system
 
 import (
+	"encoding/json"
 	log "github.com/sirupsen/logrus"
 	"net/http"
 	"strings"
@@ -25,7 +26,7 @@ import (
 
 // GetAggregationSourceCollection is to fetch all the AggregationSourceURI uri's and returns with created collection
 // of AggregationSource data from odim
-func (e *ExternalInterface) GetAggregationSourceCollection() response.RPC {
+func (e *ExternalInterface) GetAggregationSourceCollection(reqURI string) response.RPC {
 	aggregationSourceKeys, err := e.GetAllKeysFromTable("AggregationSource")
 	if err != nil {
 		errorMessage := err.Error()
@@ -66,7 +67,20 @@ func (e *ExternalInterface) GetAggregationSourceCollection() response.RPC {
 		MembersCount: len(members),
 		Members:      members,
 	}
-	return resp
+	return common.ApplyQueryOptions(resp, reqURI, common.EachMember(e.getAggregationSourceMember))
+}
+
+// getAggregationSourceMember builds the AggregationSource for expanding the collection
+func (e *ExternalInterface) getAggregationSourceMember(odataID string) (string, *errors.Error) {
+	resp := e.GetAggregationSource(odataID)
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.PackError(errors.UndefinedErrorType, "unable to get aggregation source ", odataID)
+	}
+	data, err := json.Marshal(resp.Body)
+	if err != nil {
+		return "", errors.PackError(errors.UndefinedErrorType, err)
+	}
+	return string(data), nil
 }
 
 // GetAggregationSource is used  to fetch the AggregationSource with given aggregation source uri
@@ -114,6 +128,9 @@ func (e *ExternalInterface) GetAggregationSource(reqURI string) response.RPC {
 		"Transfer-Encoding": "chunked",
 		"OData-Version":     "4.0",
 	}
+	if etag, err := e.GetAggregationSourceETag(reqURI); err == nil {
+		resp.Header["ETag"] = etag
+	}
 	commonResponse.CreateGenericResponse(response.Success)
 	commonResponse.Message = ""
 	commonResponse.MessageID = ""
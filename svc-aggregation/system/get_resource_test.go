@@ -55,6 +55,14 @@ func mockGetAggregationSourceInfo(reqURI string) (agmodel.AggregationSource, *er
 	}
 	return aggSource, errors.PackError(errors.DBKeyNotFound, "error: while trying to fetch Aggregation Source data: no data with the with key "+reqURI+" found")
 }
+
+func mockGetAggregationSourceETag(reqURI string) (string, *errors.Error) {
+	if reqURI == "/redfish/v1/AggregationService/AggregationSources/36474ba4-a201-46aa-badf-d8104da418e8" {
+		return "\"36474ba4\"", nil
+	}
+	return "", errors.PackError(errors.DBKeyNotFound, "error: while trying to fetch Aggregation Source data: no data with the with key "+reqURI+" found")
+}
+
 func TestGetAggregationSourceCollection(t *testing.T) {
 	commonResponse := response.Response{
 		OdataType:    "#AggregationSourceCollection.AggregationSourceCollection",
@@ -99,13 +107,38 @@ func TestGetAggregationSourceCollection(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.p.GetAggregationSourceCollection(); !reflect.DeepEqual(got, tt.want) {
+			if got := tt.p.GetAggregationSourceCollection("/redfish/v1/AggregationService/AggregationSources"); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetAggregationSourceCollection() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
+func TestGetAggregationSourceCollectionWithQuery(t *testing.T) {
+	p := &ExternalInterface{
+		GetAllKeysFromTable: mockGetAllKeysFromTable,
+	}
+	resp := p.GetAggregationSourceCollection("/redfish/v1/AggregationService/AggregationSources?$top=0")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GetAggregationSourceCollection() StatusCode = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	body := resp.Body.(map[string]interface{})
+	if members := body["Members"].([]interface{}); len(members) != 0 {
+		t.Errorf("GetAggregationSourceCollection() Members = %v, want empty", members)
+	}
+	if body["Members@odata.nextLink"] != "/redfish/v1/AggregationService/AggregationSources?$skip=0" {
+		t.Errorf("GetAggregationSourceCollection() Members@odata.nextLink = %v, want the remaining members", body["Members@odata.nextLink"])
+	}
+	if body["Members@odata.count"] != float64(1) {
+		t.Errorf("GetAggregationSourceCollection() Members@odata.count = %v, want 1", body["Members@odata.count"])
+	}
+
+	resp = p.GetAggregationSourceCollection("/redfish/v1/AggregationService/AggregationSources?$skip=abc")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("GetAggregationSourceCollection() StatusCode = %v, want %v", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
 func TestGetAggregationSource(t *testing.T) {
 
 	commonResponse := response.Response{
@@ -125,6 +158,7 @@ func TestGetAggregationSource(t *testing.T) {
 		"Content-type":      "application/json; charset=utf-8",
 		"Transfer-Encoding": "chunked",
 		"OData-Version":     "4.0",
+		"ETag":              "\"36474ba4\"",
 	}
 	commonResponse.CreateGenericResponse(response.Success)
 	commonResponse.Message = ""
@@ -146,6 +180,7 @@ func TestGetAggregationSource(t *testing.T) {
 	p := &ExternalInterface{
 		GetConnectionMethod:      mockGetConnectionMethod,
 		GetAggregationSourceInfo: mockGetAggregationSourceInfo,
+		GetAggregationSourceETag: mockGetAggregationSourceETag,
 	}
 
 	type args struct {
@@ -79,27 +79,7 @@ func (e *ExternalInterface) RediscoverSystemInventory(deviceUUID, systemURL stri
 	req.GetPluginStatus = e.GetPluginStatus
 	req.Plugin = plugin
 	req.StatusPoll = true
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		req.HTTPMethodType = http.MethodPost
-		req.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		req.OID = "/ODIM/v1/Sessions"
-		_, token, _, err := contactPlugin(req, "error while getting the details "+req.OID+": ")
-		if err != nil {
-			log.Error(err.Error())
-			return
-		}
-		req.Token = token
-	} else {
-		req.LoginCredentials = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
+	req.HTTPMethodType = http.MethodGet
 	// check whether delete operation for the system is intiated
 	udaptedSystemURI := strings.Replace(systemURL, "/redfish/v1/Systems/", "/redfish/v1/Systems/"+deviceUUID+":", -1)
 	if strings.Contains(systemURL, "/Storage") {
@@ -149,6 +129,7 @@ func (e *ExternalInterface) RediscoverSystemInventory(deviceUUID, systemURL stri
 		chassisEstimatedWork := int32(15)
 		progress = h.getAllRootInfo("", progress, chassisEstimatedWork, req)
 	}
+	e.UpdateDynamicAggregates(udaptedSystemURI)
 
 	var responseBody = map[string]string{
 		"UUID": deviceUUID,
@@ -244,27 +225,7 @@ func (e *ExternalInterface) getTargetSystemCollection(target agmodel.Target) ([]
 	req.GetPluginStatus = e.GetPluginStatus
 	req.Plugin = plugin
 	req.StatusPoll = true
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		req.HTTPMethodType = http.MethodPost
-		req.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		req.OID = "/ODIM/v1/Sessions"
-		_, token, _, err := contactPlugin(req, "error while getting the details "+req.OID+": ")
-		if err != nil {
-			log.Error(err.Error())
-			return nil, err
-		}
-		req.Token = token
-	} else {
-		req.LoginCredentials = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
+	req.HTTPMethodType = http.MethodGet
 
 	req.DeviceUUID = target.DeviceUUID
 	req.DeviceInfo = target
@@ -53,26 +53,7 @@ func (e *ExternalInterface) UpdateSystemState(updateReq *aggregatorproto.UpdateS
 	req.GetPluginStatus = e.GetPluginStatus
 	req.Plugin = plugin
 	req.StatusPoll = true
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		req.HTTPMethodType = http.MethodPost
-		req.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		req.OID = "/ODIM/v1/Sessions"
-		_, token, _, err := contactPlugin(req, "error while getting the details "+req.OID+": ")
-		if err != nil {
-			return err
-		}
-		req.Token = token
-	} else {
-		req.LoginCredentials = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
+	req.HTTPMethodType = http.MethodGet
 
 	req.DeviceUUID = updateReq.SystemUUID
 	req.DeviceInfo = target
@@ -103,5 +84,6 @@ func (e *ExternalInterface) UpdateSystemState(updateReq *aggregatorproto.UpdateS
 	if err := agmodel.UpdateIndex(searchForm, key, computerSystemUUID); err != nil {
 		return fmt.Errorf("error: updating server index failed with err %v", err)
 	}
+	e.UpdateDynamicAggregates(key)
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
 	aggregatorproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/aggregator"
@@ -43,6 +44,23 @@ func (e *ExternalInterface) UpdateAggregationSource(req *aggregatorproto.Aggrega
 		}
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 	}
+	// reject a stale If-Match before the plugin is contacted, the DB update below re-checks it atomically
+	if req.IfMatch != "" {
+		etag, dbErr := e.GetAggregationSourceETag(req.URL)
+		if dbErr != nil {
+			errorMessage := "Unable to get AggregationSource ETag: " + dbErr.Error()
+			log.Error(errorMessage)
+			if errors.DBKeyNotFound == dbErr.ErrNo() {
+				return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"AggregationSource", req.URL}, nil)
+			}
+			return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+		}
+		if !persistencemgr.MatchETag(req.IfMatch, etag) {
+			errorMessage := "If-Match " + req.IfMatch + " does not match the current ETag of " + req.URL
+			log.Error(errorMessage)
+			return common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errorMessage, nil, nil)
+		}
+	}
 	// parse the request
 	var updateRequest map[string]interface{}
 	err := json.Unmarshal(req.RequestBody, &updateRequest)
@@ -107,10 +125,13 @@ func (e *ExternalInterface) UpdateAggregationSource(req *aggregatorproto.Aggrega
 	aggregationSource.UserName = updateRequest["UserName"].(string)
 	aggregationSource.Password = updateRequest["Password"].([]byte)
 
-	dbErr = agmodel.UpdateAggregtionSource(aggregationSource, req.URL)
+	dbErr = agmodel.UpdateAggregtionSource(aggregationSource, req.URL, req.IfMatch)
 	if dbErr != nil {
 		errMsg := "error while trying to update aggregation source info: " + dbErr.Error()
-		fmt.Println(errMsg)
+		log.Error(errMsg)
+		if errors.PreconditionFailed == dbErr.ErrNo() {
+			return common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errMsg, nil, nil)
+		}
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
 	}
 
@@ -184,26 +205,6 @@ func (e *ExternalInterface) updateManagerAggregationSource(aggregationSourceID,
 
 	pluginContactRequest.Plugin = plugin
 	pluginContactRequest.StatusPoll = true
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		pluginContactRequest.HTTPMethodType = http.MethodPost
-		pluginContactRequest.DeviceInfo = map[string]interface{}{
-			"Username": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		pluginContactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := contactPlugin(pluginContactRequest, "error while creating the session: ")
-		if err != nil {
-			errMsg := err.Error()
-			log.Error(errMsg)
-			return common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, errMsg, getResponse.MsgArgs, nil)
-		}
-		pluginContactRequest.Token = token
-	} else {
-		pluginContactRequest.LoginCredentials = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-	}
 
 	// Verfiying the plugin Status
 	pluginContactRequest.HTTPMethodType = http.MethodGet
@@ -298,28 +299,6 @@ func (e *ExternalInterface) updateBMCAggregationSource(aggregationSourceID, plug
 	pluginContactRequest.Plugin = plugin
 	pluginContactRequest.StatusPoll = true
 
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		pluginContactRequest.HTTPMethodType = http.MethodPost
-		pluginContactRequest.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		pluginContactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := contactPlugin(pluginContactRequest, "error while logging in to plugin: ")
-		if err != nil {
-			errMsg := err.Error()
-			log.Error(errMsg)
-			return common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, errMsg, getResponse.MsgArgs, nil)
-		}
-		pluginContactRequest.Token = token
-	} else {
-		pluginContactRequest.LoginCredentials = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	// validate the device credentials
 	var saveSystem = agmodel.SaveSystem{
 		ManagerAddress: updateRequest["HostName"].(string),
@@ -387,14 +387,18 @@ func TestExternalInterface_UpdateAggregationSource(t *testing.T) {
 	errMsg = "field " + param + " Missing"
 	resp6 := common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errMsg, []interface{}{param}, nil)
 	param = "HostName UserName Password "
-	errMsg = "error while trying to authenticate the compute server: error: invalid resource username/password"
+	errMsg = "error while trying to authenticate the compute server: error: got 401 from the plugin ILO_v1.0.0 for POST /ODIM/v1/validate: {\"MessageId\": \"Base.1.0.Success\"}"
 	resp7 := common.GeneralError(http.StatusUnauthorized, response.ResourceAtURIUnauthorized, errMsg, []interface{}{"https://localhost:9091/ODIM/v1/validate"}, nil)
 	errMsg = "field " + param + " Missing"
 	resp8 := common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errMsg, []interface{}{param}, nil)
 
+	errMsg = "If-Match \"stale\" does not match the current ETag of /redfish/v1/AggregationService/AggregationSources/123456"
+	resp9 := common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errMsg, nil, nil)
+
 	common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errMsg, []interface{}{param}, nil)
 	p := getMockExternalInterface()
 	p.ContactClient = testUpdateContactClient
+	p.GetAggregationSourceETag = agmodel.GetAggregationSourceETag
 	type args struct {
 		req *aggregatorproto.AggregatorRequest
 	}
@@ -492,6 +496,18 @@ func TestExternalInterface_UpdateAggregationSource(t *testing.T) {
 			},
 			want: resp8,
 		},
+		{
+			name: "stale If-Match",
+			e:    p,
+			args: args{
+				req: &aggregatorproto.AggregatorRequest{
+					URL:         "/redfish/v1/AggregationService/AggregationSources/123456",
+					RequestBody: successReqBMC,
+					IfMatch:     `"stale"`,
+				},
+			},
+			want: resp9,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -16,23 +16,24 @@
 package handle
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	accountproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/account"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	iris "github.com/kataras/iris/v12"
-	log "github.com/sirupsen/logrus"
 	"net/http"
 )
 
 // AccountRPCs defines all the RPC methods in account service
 type AccountRPCs struct {
-	GetServiceRPC     func(accountproto.AccountRequest) (*accountproto.AccountResponse, error)
-	CreateRPC         func(accountproto.CreateAccountRequest) (*accountproto.AccountResponse, error)
-	GetAllAccountsRPC func(accountproto.AccountRequest) (*accountproto.AccountResponse, error)
-	GetAccountRPC     func(accountproto.GetAccountRequest) (*accountproto.AccountResponse, error)
-	UpdateRPC         func(accountproto.UpdateAccountRequest) (*accountproto.AccountResponse, error)
-	DeleteRPC         func(accountproto.DeleteAccountRequest) (*accountproto.AccountResponse, error)
+	GetServiceRPC     func(context.Context, accountproto.AccountRequest) (*accountproto.AccountResponse, error)
+	CreateRPC         func(context.Context, accountproto.CreateAccountRequest) (*accountproto.AccountResponse, error)
+	GetAllAccountsRPC func(context.Context, accountproto.AccountRequest) (*accountproto.AccountResponse, error)
+	GetAccountRPC     func(context.Context, accountproto.GetAccountRequest) (*accountproto.AccountResponse, error)
+	UpdateRPC         func(context.Context, accountproto.UpdateAccountRequest) (*accountproto.AccountResponse, error)
+	DeleteRPC         func(context.Context, accountproto.DeleteAccountRequest) (*accountproto.AccountResponse, error)
 }
 
 // GetAccountService defines the GetAccountService iris handler.
@@ -46,17 +47,17 @@ func (a *AccountRPCs) GetAccountService(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := a.GetServiceRPC(req)
+	resp, err := a.GetServiceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -79,7 +80,7 @@ func (a *AccountRPCs) CreateAccount(ctx iris.Context) {
 	err := ctx.ReadJSON(&req)
 	if err != nil {
 		errorMessage := "error while trying to get JSON body from the account create request body: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -90,7 +91,7 @@ func (a *AccountRPCs) CreateAccount(ctx iris.Context) {
 
 	if sessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -105,10 +106,10 @@ func (a *AccountRPCs) CreateAccount(ctx iris.Context) {
 		RequestBody:  request,
 	}
 
-	resp, err := a.CreateRPC(createRequest)
+	resp, err := a.CreateRPC(ctx.Request().Context(), createRequest)
 	if err != nil && resp == nil {
 		errorMessage := "something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -132,17 +133,17 @@ func (a *AccountRPCs) GetAllAccounts(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := a.GetAllAccountsRPC(req)
+	resp, err := a.GetAllAccountsRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -168,17 +169,17 @@ func (a *AccountRPCs) GetAccount(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := a.GetAccountRPC(req)
+	resp, err := a.GetAccountRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -202,7 +203,7 @@ func (a *AccountRPCs) UpdateAccount(ctx iris.Context) {
 	err := ctx.ReadJSON(&req)
 	if err != nil {
 		errorMessage := "error while trying to get JSON body from the account update request body: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -214,7 +215,7 @@ func (a *AccountRPCs) UpdateAccount(ctx iris.Context) {
 
 	if sessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -228,12 +229,13 @@ func (a *AccountRPCs) UpdateAccount(ctx iris.Context) {
 		SessionToken: sessionToken,
 		AccountID:    accountID,
 		RequestBody:  request,
+		IfMatch:      ctx.GetHeader("If-Match"),
 	}
 
-	resp, err := a.UpdateRPC(updateRequest)
+	resp, err := a.UpdateRPC(ctx.Request().Context(), updateRequest)
 	if err != nil && resp == nil {
 		errorMessage := "something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -255,21 +257,22 @@ func (a *AccountRPCs) DeleteAccount(ctx iris.Context) {
 	req := accountproto.DeleteAccountRequest{
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
 		AccountID:    ctx.Params().Get("id"),
+		IfMatch:      ctx.GetHeader("If-Match"),
 	}
 
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := a.DeleteRPC(req)
+	resp, err := a.DeleteRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -23,7 +24,7 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func mockGetAccountServiceRPC(req accountproto.AccountRequest) (*accountproto.AccountResponse, error) {
+func mockGetAccountServiceRPC(ctx context.Context, req accountproto.AccountRequest) (*accountproto.AccountResponse, error) {
 	if req.SessionToken == "TokenRPC" {
 		return nil, errors.New("RPC Error")
 	}
@@ -32,7 +33,7 @@ func mockGetAccountServiceRPC(req accountproto.AccountRequest) (*accountproto.Ac
 	}, nil
 }
 
-func mockCreateAccountRPC(req accountproto.CreateAccountRequest) (*accountproto.AccountResponse, error) {
+func mockCreateAccountRPC(ctx context.Context, req accountproto.CreateAccountRequest) (*accountproto.AccountResponse, error) {
 	if req.SessionToken == "TokenRPC" {
 		return nil, errors.New("RPC Error")
 	}
@@ -41,7 +42,7 @@ func mockCreateAccountRPC(req accountproto.CreateAccountRequest) (*accountproto.
 	}, nil
 }
 
-func mockGetAllAccountsRPC(req accountproto.AccountRequest) (*accountproto.AccountResponse, error) {
+func mockGetAllAccountsRPC(ctx context.Context, req accountproto.AccountRequest) (*accountproto.AccountResponse, error) {
 	if req.SessionToken == "TokenRPC" {
 		return nil, errors.New("RPC Error")
 	}
@@ -50,7 +51,7 @@ func mockGetAllAccountsRPC(req accountproto.AccountRequest) (*accountproto.Accou
 	}, nil
 }
 
-func mockGetAccountRPC(req accountproto.GetAccountRequest) (*accountproto.AccountResponse, error) {
+func mockGetAccountRPC(ctx context.Context, req accountproto.GetAccountRequest) (*accountproto.AccountResponse, error) {
 	if req.SessionToken == "TokenRPC" {
 		return nil, errors.New("RPC Error")
 	}
@@ -59,7 +60,7 @@ func mockGetAccountRPC(req accountproto.GetAccountRequest) (*accountproto.Accoun
 	}, nil
 }
 
-func mockUpdateAccountRPC(req accountproto.UpdateAccountRequest) (*accountproto.AccountResponse, error) {
+func mockUpdateAccountRPC(ctx context.Context, req accountproto.UpdateAccountRequest) (*accountproto.AccountResponse, error) {
 	if req.SessionToken == "TokenRPC" {
 		return nil, errors.New("RPC Error")
 	}
@@ -68,7 +69,7 @@ func mockUpdateAccountRPC(req accountproto.UpdateAccountRequest) (*accountproto.
 	}, nil
 }
 
-func mockDeleteAccountRPC(req accountproto.DeleteAccountRequest) (*accountproto.AccountResponse, error) {
+func mockDeleteAccountRPC(ctx context.Context, req accountproto.DeleteAccountRequest) (*accountproto.AccountResponse, error) {
 	if req.SessionToken == "TokenRPC" {
 		return nil, errors.New("RPC Error")
 	}
@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -23,23 +24,23 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func testDeleteComputeRPC(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testDeleteComputeRPC(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	return &aggregatorproto.AggregatorResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
-func testAddComputeRPC(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testAddComputeRPC(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	return &aggregatorproto.AggregatorResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
-func testAddComputeRPCWithRPCError(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testAddComputeRPCWithRPCError(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	return &aggregatorproto.AggregatorResponse{}, errors.New("Unable to RPC Call")
 }
-func testDeleteComputeRPCWIthRPCError(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testDeleteComputeRPCWIthRPCError(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	return &aggregatorproto.AggregatorResponse{}, errors.New("Unable to RPC Call")
 }
-func testGetAggregationService(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testGetAggregationService(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	var response = &aggregatorproto.AggregatorResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &aggregatorproto.AggregatorResponse{
@@ -58,7 +59,7 @@ func testGetAggregationService(req aggregatorproto.AggregatorRequest) (*aggregat
 	return response, nil
 }
 
-func testAddAggregationSourceRPCCall(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testAddAggregationSourceRPCCall(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	var response = &aggregatorproto.AggregatorResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &aggregatorproto.AggregatorResponse{
@@ -77,7 +78,7 @@ func testAddAggregationSourceRPCCall(req aggregatorproto.AggregatorRequest) (*ag
 	return response, nil
 }
 
-func testGetAllAggregationSourceRPC(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testGetAllAggregationSourceRPC(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	var response = &aggregatorproto.AggregatorResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &aggregatorproto.AggregatorResponse{
@@ -96,7 +97,7 @@ func testGetAllAggregationSourceRPC(req aggregatorproto.AggregatorRequest) (*agg
 	return response, nil
 }
 
-func testUpdateAggregationSourceRPCCall(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testUpdateAggregationSourceRPCCall(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	var response = &aggregatorproto.AggregatorResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &aggregatorproto.AggregatorResponse{
@@ -115,7 +116,7 @@ func testUpdateAggregationSourceRPCCall(req aggregatorproto.AggregatorRequest) (
 	return response, nil
 }
 
-func testGetAggregationSourceRPC(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testGetAggregationSourceRPC(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	var response = &aggregatorproto.AggregatorResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &aggregatorproto.AggregatorResponse{
@@ -134,7 +135,7 @@ func testGetAggregationSourceRPC(req aggregatorproto.AggregatorRequest) (*aggreg
 	return response, nil
 }
 
-func testDeleteAggregationSourceRPCCall(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testDeleteAggregationSourceRPCCall(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	var response = &aggregatorproto.AggregatorResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &aggregatorproto.AggregatorResponse{
@@ -153,7 +154,7 @@ func testDeleteAggregationSourceRPCCall(req aggregatorproto.AggregatorRequest) (
 	return response, nil
 }
 
-func testAggregateRPCCall(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testAggregateRPCCall(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	var response = &aggregatorproto.AggregatorResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &aggregatorproto.AggregatorResponse{
@@ -176,7 +177,7 @@ func testAggregateRPCCall(req aggregatorproto.AggregatorRequest) (*aggregatorpro
 	return response, nil
 }
 
-func testGetAggregateRPCCall(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testGetAggregateRPCCall(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	var response = &aggregatorproto.AggregatorResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &aggregatorproto.AggregatorResponse{
@@ -199,7 +200,7 @@ func testGetAggregateRPCCall(req aggregatorproto.AggregatorRequest) (*aggregator
 	return response, nil
 }
 
-func testDeleteAggregateRPCCall(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func testDeleteAggregateRPCCall(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 	var response = &aggregatorproto.AggregatorResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &aggregatorproto.AggregatorResponse{
@@ -319,6 +320,55 @@ func TestGetAggregationService(t *testing.T) {
 	).WithHeader("X-Auth-Token", "token").Expect().Status(http.StatusInternalServerError)
 }
 
+func TestBackup(t *testing.T) {
+	var a AggregatorRPCs
+	a.BackupRPC = testGetAggregationService
+	testApp := iris.New()
+	redfishRoutes := testApp.Party("/redfish/v1/AggregationService")
+	redfishRoutes.Post("/Actions/Oem/ODIM.Backup", a.Backup)
+	test := httptest.New(t, testApp)
+	test.POST(
+		"/redfish/v1/AggregationService/Actions/Oem/ODIM.Backup",
+	).WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusOK)
+	test.POST(
+		"/redfish/v1/AggregationService/Actions/Oem/ODIM.Backup",
+	).WithHeader("X-Auth-Token", "InvalidToken").Expect().Status(http.StatusUnauthorized)
+	test.POST(
+		"/redfish/v1/AggregationService/Actions/Oem/ODIM.Backup",
+	).WithHeader("X-Auth-Token", "").Expect().Status(http.StatusUnauthorized)
+	test.POST(
+		"/redfish/v1/AggregationService/Actions/Oem/ODIM.Backup",
+	).WithHeader("X-Auth-Token", "token").Expect().Status(http.StatusInternalServerError)
+}
+
+func TestRestore(t *testing.T) {
+	var a AggregatorRPCs
+	a.RestoreRPC = testGetAggregationService
+	testApp := iris.New()
+	redfishRoutes := testApp.Party("/redfish/v1/AggregationService")
+	redfishRoutes.Post("/Actions/Oem/ODIM.Restore", a.Restore)
+	test := httptest.New(t, testApp)
+	backup := map[string]interface{}{
+		"Version": "1",
+		"Created": "2020-11-02T10:00:00Z",
+		"Key":     "a2V5",
+		"Nonce":   "bm9uY2U=",
+		"Data":    "ZGF0YQ==",
+	}
+	test.POST(
+		"/redfish/v1/AggregationService/Actions/Oem/ODIM.Restore",
+	).WithHeader("X-Auth-Token", "ValidToken").WithJSON(backup).Expect().Status(http.StatusOK)
+	test.POST(
+		"/redfish/v1/AggregationService/Actions/Oem/ODIM.Restore",
+	).WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusBadRequest)
+	test.POST(
+		"/redfish/v1/AggregationService/Actions/Oem/ODIM.Restore",
+	).WithHeader("X-Auth-Token", "").WithJSON(backup).Expect().Status(http.StatusUnauthorized)
+	test.POST(
+		"/redfish/v1/AggregationService/Actions/Oem/ODIM.Restore",
+	).WithHeader("X-Auth-Token", "token").WithJSON(backup).Expect().Status(http.StatusInternalServerError)
+}
+
 var oem = map[string]interface{}{
 	"PluginID": "ILO",
 }
@@ -640,6 +690,45 @@ func TestResetAggregateElements(t *testing.T) {
 	).WithHeader("X-Auth-Token", "token").WithJSON(aggregateRequest).Expect().Status(http.StatusInternalServerError)
 }
 
+func TestBulkRequestAggregateElements(t *testing.T) {
+	var a AggregatorRPCs
+	a.BulkRequestAggregateElementsRPC = testGetAggregateRPCCall
+	var aggregateRequest = map[string]interface{}{
+		"TargetURI":   "Bios/Settings",
+		"HTTPMethod":  "PATCH",
+		"RequestBody": map[string]interface{}{"Attributes": map[string]interface{}{"BootMode": "Uefi"}},
+		"BatchSize":   2,
+	}
+	testApp := iris.New()
+	redfishRoutes := testApp.Party("/redfish/v1/AggregationService/Aggregates/{id}/Actions/Aggregate.BulkRequest")
+	redfishRoutes.Post("/", a.BulkRequestAggregateElements)
+	test := httptest.New(t, testApp)
+	// test with valid token
+	test.POST(
+		"/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73/Actions/Aggregate.BulkRequest",
+	).WithHeader("X-Auth-Token", "ValidToken").WithJSON(aggregateRequest).Expect().Status(http.StatusOK)
+
+	// test with Invalid token
+	test.POST(
+		"/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73/Actions/Aggregate.BulkRequest",
+	).WithHeader("X-Auth-Token", "InvalidToken").WithJSON(aggregateRequest).Expect().Status(http.StatusUnauthorized)
+
+	// test without token
+	test.POST(
+		"/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73/Actions/Aggregate.BulkRequest",
+	).WithHeader("X-Auth-Token", "").WithJSON(aggregateRequest).Expect().Status(http.StatusUnauthorized)
+
+	// test without request body
+	test.POST(
+		"/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73/Actions/Aggregate.BulkRequest",
+	).WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusBadRequest)
+
+	// test for RPC Error
+	test.POST(
+		"/redfish/v1/AggregationService/Aggregates/7ff3bd97-c41c-5de0-937d-85d390691b73/Actions/Aggregate.BulkRequest",
+	).WithHeader("X-Auth-Token", "token").WithJSON(aggregateRequest).Expect().Status(http.StatusInternalServerError)
+}
+
 func TestSetDefaultBootOrderAggregateElements(t *testing.T) {
 	var a AggregatorRPCs
 	a.SetDefaultBootOrderAggregateElementsRPC = testGetAggregateRPCCall
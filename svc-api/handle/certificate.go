@@ -0,0 +1,93 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package handle
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	iris "github.com/kataras/iris/v12"
+)
+
+// GetCertificateService is the handler for getting the certificate service
+func (mgr *ManagersRPCs) GetCertificateService(ctx iris.Context) {
+	mgr.certificateServiceResource(ctx, mgr.GetCertificateServiceRPC)
+}
+
+// GetCertificateLocations is the handler for getting the certificates of odimra and of all the devices
+func (mgr *ManagersRPCs) GetCertificateLocations(ctx iris.Context) {
+	mgr.certificateServiceResource(ctx, mgr.GetCertificateLocationsRPC)
+}
+
+// GetEndpointCertificates is the handler for getting the certificates presented by odimra, the plugins and the devices
+func (mgr *ManagersRPCs) GetEndpointCertificates(ctx iris.Context) {
+	mgr.certificateServiceResource(ctx, mgr.GetEndpointCertificatesRPC)
+}
+
+// GenerateCSR is the handler for the CertificateService.GenerateCSR action
+func (mgr *ManagersRPCs) GenerateCSR(ctx iris.Context) {
+	mgr.managerAction(ctx, mgr.GenerateCSRRPC)
+}
+
+// ReplaceCertificate is the handler for the CertificateService.ReplaceCertificate action
+func (mgr *ManagersRPCs) ReplaceCertificate(ctx iris.Context) {
+	mgr.managerAction(ctx, mgr.ReplaceCertificateRPC)
+}
+
+// certificateServiceResource does the rpc call getting a resource of the certificate service
+func (mgr *ManagersRPCs) certificateServiceResource(ctx iris.Context, resourceRPC func(context.Context, managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)) {
+	req := managersproto.ManagerRequest{
+		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
+		URL:          ctx.Request().RequestURI,
+	}
+	if req.SessionToken == "" {
+		errorMessage := "error: no X-Auth-Token found in request header"
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusUnauthorized)
+		ctx.JSON(&response.Body)
+		return
+	}
+	resp, err := resourceRPC(ctx.Request().Context(), req)
+	if err != nil {
+		errorMessage := "error:  RPC error:" + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.JSON(&response.Body)
+		return
+	}
+
+	common.SetResponseHeader(ctx, resp.Header)
+	ctx.StatusCode(int(resp.StatusCode))
+	ctx.Write(resp.Body)
+}
+
+// CertificateServiceMethodNotAllowed builds the response for the unallowed http operation
+// on the certificate service URLs and returns 405 error.
+func CertificateServiceMethodNotAllowed(ctx iris.Context) {
+	switch ctx.Request().URL.Path {
+	case "/redfish/v1/CertificateService/Actions/CertificateService.GenerateCSR",
+		"/redfish/v1/CertificateService/Actions/CertificateService.ReplaceCertificate":
+		ctx.ResponseWriter().Header().Set("Allow", "POST")
+	default:
+		ctx.ResponseWriter().Header().Set("Allow", "GET")
+	}
+	fillMethodNotAllowedErrorResponse(ctx)
+}
@@ -0,0 +1,76 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package handle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	iris "github.com/kataras/iris/v12"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+func mockGetCertificateServiceRPC(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+	if req.SessionToken == "TokenRPC" {
+		return nil, fmt.Errorf("RPC Error")
+	}
+	return &managersproto.ManagerResponse{
+		StatusCode:    http.StatusOK,
+		StatusMessage: "Success",
+		Header:        map[string]string{"Content-type": "application/json; charset=utf-8"},
+		Body:          []byte(`{"@odata.id":"/redfish/v1/CertificateService"}`),
+	}, nil
+}
+
+func mockGenerateCSRRPC(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	return &managersproto.ManagerResponse{
+		StatusCode:    http.StatusOK,
+		StatusMessage: "Success",
+		Body:          []byte(`{"CSRString":"CSR"}`),
+	}, nil
+}
+
+func TestCertificateService(t *testing.T) {
+	var mgr ManagersRPCs
+	mgr.GetCertificateServiceRPC = mockGetCertificateServiceRPC
+	mgr.GetEndpointCertificatesRPC = mockGetCertificateServiceRPC
+	mgr.GenerateCSRRPC = mockGenerateCSRRPC
+	mockApp := iris.New()
+	redfishRoutes := mockApp.Party("/redfish/v1/CertificateService")
+	redfishRoutes.SetRegisterRule(iris.RouteSkip)
+	redfishRoutes.Get("/", mgr.GetCertificateService)
+	redfishRoutes.Get("/Oem/ODIM/EndpointCertificates", mgr.GetEndpointCertificates)
+	redfishRoutes.Post("/Actions/CertificateService.GenerateCSR", mgr.GenerateCSR)
+	redfishRoutes.Any("/Oem/ODIM/EndpointCertificates", CertificateServiceMethodNotAllowed)
+	redfishRoutes.Any("/Actions/CertificateService.GenerateCSR", CertificateServiceMethodNotAllowed)
+	test := httptest.New(t, mockApp)
+
+	test.GET("/redfish/v1/CertificateService").WithHeader("X-Auth-Token", "ValidToken").Expect().
+		Status(http.StatusOK).JSON().Object().ValueEqual("@odata.id", "/redfish/v1/CertificateService")
+	test.GET("/redfish/v1/CertificateService").Expect().Status(http.StatusUnauthorized)
+	test.GET("/redfish/v1/CertificateService").WithHeader("X-Auth-Token", "TokenRPC").Expect().Status(http.StatusInternalServerError)
+
+	test.GET("/redfish/v1/CertificateService/Oem/ODIM/EndpointCertificates").WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusOK)
+	test.DELETE("/redfish/v1/CertificateService/Oem/ODIM/EndpointCertificates").WithHeader("X-Auth-Token", "ValidToken").
+		Expect().Status(http.StatusMethodNotAllowed).Header("Allow").Equal("GET")
+
+	test.POST("/redfish/v1/CertificateService/Actions/CertificateService.GenerateCSR").WithHeader("X-Auth-Token", "ValidToken").
+		WithJSON(map[string]interface{}{"CommonName": "odimra"}).Expect().Status(http.StatusOK)
+	test.GET("/redfish/v1/CertificateService/Actions/CertificateService.GenerateCSR").WithHeader("X-Auth-Token", "ValidToken").
+		Expect().Status(http.StatusMethodNotAllowed).Header("Allow").Equal("POST")
+}
@@ -17,25 +17,26 @@
 package handle
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	chassisproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/chassis"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 
 	"github.com/kataras/iris/v12"
-	log "github.com/sirupsen/logrus"
 )
 
 // ChassisRPCs defines all the RPC methods in system service
 type ChassisRPCs struct {
-	GetChassisCollectionRPC func(req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error)
-	GetChassisResourceRPC   func(req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error)
-	GetChassisRPC           func(req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error)
-	CreateChassisRPC        func(req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error)
-	DeleteChassisRPC        func(req chassisproto.DeleteChassisRequest) (*chassisproto.GetChassisResponse, error)
-	UpdateChassisRPC        func(req chassisproto.UpdateChassisRequest) (*chassisproto.GetChassisResponse, error)
+	GetChassisCollectionRPC func(ctx context.Context, req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error)
+	GetChassisResourceRPC   func(ctx context.Context, req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error)
+	GetChassisRPC           func(ctx context.Context, req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error)
+	CreateChassisRPC        func(ctx context.Context, req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error)
+	DeleteChassisRPC        func(ctx context.Context, req chassisproto.DeleteChassisRequest) (*chassisproto.GetChassisResponse, error)
+	UpdateChassisRPC        func(ctx context.Context, req chassisproto.UpdateChassisRequest) (*chassisproto.GetChassisResponse, error)
 }
 
 //CreateChassis creates a new chassis
@@ -44,7 +45,7 @@ func (chassis *ChassisRPCs) CreateChassis(ctx iris.Context) {
 	e := ctx.ReadJSON(requestBody)
 	if e != nil {
 		errorMessage := "error while trying to read obligatory json body: " + e.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest)
 		common.SetResponseHeader(ctx, response.Header)
@@ -52,7 +53,7 @@ func (chassis *ChassisRPCs) CreateChassis(ctx iris.Context) {
 		return
 	}
 
-	rpcResp, rpcErr := chassis.CreateChassisRPC(
+	rpcResp, rpcErr := chassis.CreateChassisRPC(ctx.Request().Context(), 
 		chassisproto.CreateChassisRequest{
 			RequestBody:  *requestBody,
 			SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
@@ -60,7 +61,7 @@ func (chassis *ChassisRPCs) CreateChassis(ctx iris.Context) {
 	)
 
 	if rpcErr != nil {
-		log.Error("RPC error:" + rpcErr.Error())
+		logs.Log(ctx.Request().Context()).Error("RPC error:" + rpcErr.Error())
 		re := common.GeneralError(http.StatusInternalServerError, response.InternalError, rpcErr.Error(), nil, nil)
 		writeResponse(ctx, re.Header, re.StatusCode, re.Body)
 		return
@@ -87,16 +88,16 @@ func (chassis *ChassisRPCs) GetChassisCollection(ctx iris.Context) {
 		URL:          ctx.Request().RequestURI}
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := chassis.GetChassisCollectionRPC(req)
+	resp, err := chassis.GetChassisCollectionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := " RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -120,16 +121,16 @@ func (chassis *ChassisRPCs) GetChassisResource(ctx iris.Context) {
 		URL:          ctx.Request().RequestURI}
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := chassis.GetChassisResourceRPC(req)
+	resp, err := chassis.GetChassisResourceRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := " RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -152,16 +153,16 @@ func (chassis *ChassisRPCs) GetChassis(ctx iris.Context) {
 		URL:          ctx.Request().RequestURI}
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := chassis.GetChassisRPC(req)
+	resp, err := chassis.GetChassisRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -179,21 +180,21 @@ func (chassis *ChassisRPCs) UpdateChassis(ctx iris.Context) {
 	e := ctx.ReadJSON(requestBody)
 	if e != nil {
 		errorMessage := "error while trying to read obligatory json body: " + e.Error()
-		log.Println(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest)
 		common.SetResponseHeader(ctx, response.Header)
 		ctx.JSON(&response.Body)
 		return
 	}
-	rr, rerr := chassis.UpdateChassisRPC(chassisproto.UpdateChassisRequest{
+	rr, rerr := chassis.UpdateChassisRPC(ctx.Request().Context(), chassisproto.UpdateChassisRequest{
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
 		URL:          ctx.Request().RequestURI,
 		RequestBody:  *requestBody,
 	})
 
 	if rerr != nil {
-		log.Println("RPC error:" + rerr.Error())
+		logs.Log(ctx.Request().Context()).Error("RPC error:" + rerr.Error())
 		re := common.GeneralError(http.StatusInternalServerError, response.InternalError, rerr.Error(), nil, nil)
 		writeResponse(ctx, re.Header, re.StatusCode, re.Body)
 		return
@@ -205,13 +206,13 @@ func (chassis *ChassisRPCs) UpdateChassis(ctx iris.Context) {
 
 //DeleteChassis deletes a chassis
 func (chassis *ChassisRPCs) DeleteChassis(ctx iris.Context) {
-	rpcResp, rpcErr := chassis.DeleteChassisRPC(chassisproto.DeleteChassisRequest{
+	rpcResp, rpcErr := chassis.DeleteChassisRPC(ctx.Request().Context(), chassisproto.DeleteChassisRequest{
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
 		URL:          ctx.Request().RequestURI,
 	})
 
 	if rpcErr != nil {
-		log.Println("RPC error:" + rpcErr.Error())
+		logs.Log(ctx.Request().Context()).Error("RPC error:" + rpcErr.Error())
 		re := common.GeneralError(http.StatusInternalServerError, response.InternalError, rpcErr.Error(), nil, nil)
 		writeResponse(ctx, re.Header, re.StatusCode, re.Body)
 		return
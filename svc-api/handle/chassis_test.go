@@ -15,6 +15,7 @@
 package handle
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -26,12 +27,12 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func mockGetChassisResource(chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
+func mockGetChassisResource(context.Context, chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
 	return &chassisproto.GetChassisResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
-func mockGetChassisResourceWithRPCError(chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
+func mockGetChassisResourceWithRPCError(context.Context, chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
 	return &chassisproto.GetChassisResponse{}, errors.New("Unable to RPC Call")
 }
 
@@ -150,7 +151,7 @@ func TestChassisRPCs_CreateChassisWithNoInputBody(t *testing.T) {
 
 func TestChassisRPCs_CreateChassisWithRPCError(t *testing.T) {
 	sut := ChassisRPCs{
-		CreateChassisRPC: func(req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error) {
+		CreateChassisRPC: func(ctx context.Context, req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error) {
 			return nil, fmt.Errorf("RPC ERROR")
 		},
 	}
@@ -182,7 +183,7 @@ func TestChassisRPCs_CreateChassis(t *testing.T) {
 	}
 
 	sut := ChassisRPCs{
-		CreateChassisRPC: func(req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error) {
+		CreateChassisRPC: func(ctx context.Context, req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error) {
 			return &expectedRPCResponse, nil
 		},
 	}
@@ -225,7 +226,7 @@ func TestChassisRPCs_CreateChassisWithMalformedBody(t *testing.T) {
 	}
 
 	sut := ChassisRPCs{
-		CreateChassisRPC: func(req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error) {
+		CreateChassisRPC: func(ctx context.Context, req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error) {
 			return &expectedRPCResponse, nil
 		},
 	}
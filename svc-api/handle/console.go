@@ -0,0 +1,188 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package handle
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/gorilla/websocket"
+	iris "github.com/kataras/iris/v12"
+)
+
+var (
+	// dialPluginConsole connects to the WebSocket of the plugin relaying the console,
+	// it is replaced in the unit tests
+	dialPluginConsole = dialConsole
+
+	// consoleSessionCheckInterval is the interval at which the session of the user who created
+	// a connected console session is checked, it is replaced in the unit tests
+	consoleSessionCheckInterval = 30 * time.Second
+
+	consoleUpgrader = websocket.Upgrader{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 4096,
+		// the console session token authorizes the connection, the clients may be served from any origin
+		CheckOrigin: func(r *http.Request) bool { return true },
+	}
+)
+
+// ConnectConsole is the handler for the connection to a console session of a manager.
+// The request is upgraded to a WebSocket which is relayed with the console of the device
+// through the plugin, the console session token in the URI authorizes the connection.
+// The console is closed once the session of the user who created the console session is gone,
+// for e.g. when the user logs out.
+func (mgr *ManagersRPCs) ConnectConsole(ctx iris.Context) {
+	req := managersproto.ConsoleSessionRequest{
+		ManagerID: ctx.Params().Get("id"),
+		Token:     ctx.Params().Get("token"),
+	}
+	resp, err := mgr.ConnectConsoleSessionRPC(ctx.Request().Context(), req)
+	if err != nil {
+		errorMessage := "error:  RPC error:" + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.JSON(&response.Body)
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		ctx.StatusCode(int(resp.StatusCode))
+		ctx.Write(resp.Body)
+		return
+	}
+	reqCtx := logs.With(ctx.Request().Context(), logs.SessionUserField, resp.UserName)
+
+	pluginConn, err := dialPluginConsole(resp.ConsoleURL)
+	if err != nil {
+		errorMessage := "error while trying to connect to the console of the manager " + req.ManagerID + ": " + err.Error()
+		logs.Log(reqCtx).Error(errorMessage)
+		response := common.GeneralError(http.StatusServiceUnavailable, response.CouldNotEstablishConnection, errorMessage, []interface{}{resp.ConsoleURL}, nil)
+		ctx.StatusCode(http.StatusServiceUnavailable)
+		ctx.JSON(&response.Body)
+		return
+	}
+	defer pluginConn.Close()
+	clientConn, err := consoleUpgrader.Upgrade(ctx.ResponseWriter(), ctx.Request(), nil)
+	if err != nil {
+		// the upgrader has already replied to the client
+		logs.Log(reqCtx).Error("error while trying to upgrade the console connection: " + err.Error())
+		return
+	}
+	defer clientConn.Close()
+
+	connectedAt := time.Now()
+	logs.Log(reqCtx).Info("audit: user " + resp.UserName + " connected to the " + resp.ConsoleType + " of the manager " +
+		req.ManagerID + " from " + ctx.RemoteAddr())
+	stop := make(chan struct{})
+	go mgr.watchConsoleSession(reqCtx, resp.SessionToken, stop, func() {
+		logs.Log(reqCtx).Info("audit: the session of the user " + resp.UserName + " is no longer valid, closing the " +
+			resp.ConsoleType + " of the manager " + req.ManagerID)
+		clientConn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "the session is no longer valid"), time.Now().Add(time.Second))
+		clientConn.Close()
+		pluginConn.Close()
+	})
+	relayWebSockets(clientConn, pluginConn)
+	close(stop)
+	logs.Log(reqCtx).Info("audit: user " + resp.UserName + " disconnected from the " + resp.ConsoleType + " of the manager " +
+		req.ManagerID + " from " + ctx.RemoteAddr() + " after " + time.Since(connectedAt).Round(time.Second).String())
+}
+
+// watchConsoleSession checks the session of the user who created the console session until stop is
+// closed, and closes the console once the session is no longer authorized. The console in use keeps
+// the session alive, like any other request of the user. The entries are logged with the correlation
+// fields of ctx.
+func (mgr *ManagersRPCs) watchConsoleSession(ctx context.Context, sessionToken string, stop <-chan struct{}, closeConsole func()) {
+	ticker := time.NewTicker(consoleSessionCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		resp := mgr.Auth(sessionToken, []string{common.PrivilegeConfigureManager}, []string{})
+		switch resp.StatusCode {
+		case http.StatusOK:
+		case http.StatusUnauthorized, http.StatusForbidden:
+			closeConsole()
+			return
+		default:
+			// the console is kept when the session can't be checked
+			logs.Log(ctx).Warn("unable to check the session of a console session, status code: " + strconv.Itoa(int(resp.StatusCode)))
+		}
+	}
+}
+
+// dialConsole connects to the WebSocket of the plugin, the certificate of the plugin
+// is verified against the CA certificate of ODIMRA
+func dialConsole(consoleURL string) (*websocket.Conn, error) {
+	tlsConfig := &tls.Config{}
+	// the CA certificate and the TLS settings are replaced under the lock when the configuration changes
+	config.TLSConfMutex.RLock()
+	httpConf := &config.HTTPConfig{
+		CACertificate: &config.Data.KeyCertConf.RootCACertificate,
+	}
+	err := httpConf.LoadCertificates(tlsConfig)
+	if err == nil {
+		config.Client.SetTLSConfig(tlsConfig)
+	}
+	config.TLSConfMutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	dialer := websocket.Dialer{
+		TLSClientConfig:  tlsConfig,
+		HandshakeTimeout: time.Duration(config.DefaultTLSHandShakeTimeout) * time.Second,
+	}
+	conn, _, err := dialer.Dial(consoleURL, nil)
+	return conn, err
+}
+
+// relayWebSockets copies the messages of each WebSocket to the other one until either side is closed
+func relayWebSockets(client, plugin *websocket.Conn) {
+	done := make(chan struct{}, 2)
+	copyMessages := func(from, to *websocket.Conn) {
+		defer func() {
+			// closing both connections stops the copy in the other direction
+			to.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			client.Close()
+			plugin.Close()
+			done <- struct{}{}
+		}()
+		for {
+			messageType, message, err := from.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := to.WriteMessage(messageType, message); err != nil {
+				return
+			}
+		}
+	}
+	go copyMessages(client, plugin)
+	go copyMessages(plugin, client)
+	<-done
+	<-done
+}
@@ -0,0 +1,158 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package handle
+
+import (
+	"context"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/gorilla/websocket"
+	iris "github.com/kataras/iris/v12"
+)
+
+func mockConnectConsoleSessionRPC(ctx context.Context, req managersproto.ConsoleSessionRequest) (*managersproto.ConsoleSessionResponse, error) {
+	if req.ManagerID == "uuid:1" && req.Token == "consoleToken" {
+		return &managersproto.ConsoleSessionResponse{
+			StatusCode:   http.StatusOK,
+			ConsoleURL:   "wss://plugin/ODIM/v1/Console/pluginToken",
+			UserName:     "admin",
+			ConsoleType:  "SerialConsole",
+			SessionToken: "validToken",
+		}, nil
+	}
+	return &managersproto.ConsoleSessionResponse{
+		StatusCode: http.StatusNotFound,
+		Body:       []byte(`{"Response":"NotFound"}`),
+	}, nil
+}
+
+// startMockPluginConsole starts a WebSocket echoing the messages, in place of the console relayed by the plugin
+func startMockPluginConsole() *nethttptest.Server {
+	return nethttptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := consoleUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			conn.WriteMessage(messageType, message)
+		}
+	}))
+}
+
+func TestConnectConsole(t *testing.T) {
+	plugin := startMockPluginConsole()
+	defer plugin.Close()
+	dialPluginConsole = func(consoleURL string) (*websocket.Conn, error) {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(plugin.URL, "http"), nil)
+		return conn, err
+	}
+	defer func() {
+		dialPluginConsole = dialConsole
+	}()
+
+	var mgr ManagersRPCs
+	mgr.ConnectConsoleSessionRPC = mockConnectConsoleSessionRPC
+	mockApp := iris.New()
+	redfishRoutes := mockApp.Party("/redfish/v1/Managers")
+	redfishRoutes.Get("/{id}/Oem/ODIM/ConsoleSessions/{token}", mgr.ConnectConsole)
+	mockApp.Build()
+	ts := nethttptest.NewServer(mockApp)
+	defer ts.Close()
+	consoleURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/redfish/v1/Managers/uuid:1/Oem/ODIM/ConsoleSessions/"
+
+	conn, _, err := websocket.DefaultDialer.Dial(consoleURL+"consoleToken", nil)
+	if err != nil {
+		t.Fatalf("error while connecting to the console: %v", err)
+	}
+	defer conn.Close()
+	conn.WriteMessage(websocket.BinaryMessage, []byte("ls\n"))
+	messageType, message, err := conn.ReadMessage()
+	if err != nil || messageType != websocket.BinaryMessage || string(message) != "ls\n" {
+		t.Errorf("console should be relayed, got %q, %v", message, err)
+	}
+
+	_, resp, err := websocket.DefaultDialer.Dial(consoleURL+"unknownToken", nil)
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unknown console session should not be connected")
+	}
+}
+
+func TestConnectConsoleSessionGone(t *testing.T) {
+	plugin := startMockPluginConsole()
+	defer plugin.Close()
+	dialPluginConsole = func(consoleURL string) (*websocket.Conn, error) {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(plugin.URL, "http"), nil)
+		return conn, err
+	}
+	consoleSessionCheckInterval = 10 * time.Millisecond
+	defer func() {
+		dialPluginConsole = dialConsole
+		consoleSessionCheckInterval = 30 * time.Second
+	}()
+
+	var mux sync.Mutex
+	loggedOut := false
+	var mgr ManagersRPCs
+	mgr.ConnectConsoleSessionRPC = mockConnectConsoleSessionRPC
+	mgr.Auth = func(sessionToken string, privileges, oemPrivileges []string) response.RPC {
+		mux.Lock()
+		defer mux.Unlock()
+		if sessionToken != "validToken" || loggedOut {
+			return common.GeneralError(http.StatusUnauthorized, response.NoValidSession, "invalid session", nil, nil)
+		}
+		return common.GeneralError(http.StatusOK, response.Success, "", nil, nil)
+	}
+	mockApp := iris.New()
+	redfishRoutes := mockApp.Party("/redfish/v1/Managers")
+	redfishRoutes.Get("/{id}/Oem/ODIM/ConsoleSessions/{token}", mgr.ConnectConsole)
+	mockApp.Build()
+	ts := nethttptest.NewServer(mockApp)
+	defer ts.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+
+		"/redfish/v1/Managers/uuid:1/Oem/ODIM/ConsoleSessions/consoleToken", nil)
+	if err != nil {
+		t.Fatalf("error while connecting to the console: %v", err)
+	}
+	defer conn.Close()
+	// the console is kept while the session is valid
+	time.Sleep(5 * consoleSessionCheckInterval)
+	conn.WriteMessage(websocket.BinaryMessage, []byte("ls\n"))
+	if _, message, err := conn.ReadMessage(); err != nil || string(message) != "ls\n" {
+		t.Fatalf("console should be relayed while the session is valid, got %q, %v", message, err)
+	}
+
+	mux.Lock()
+	loggedOut = true
+	mux.Unlock()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, _, err = conn.ReadMessage()
+	if !websocket.IsCloseError(err, websocket.ClosePolicyViolation) {
+		t.Errorf("console should be closed once the session is gone, got %v", err)
+	}
+}
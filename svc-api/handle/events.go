@@ -16,11 +16,12 @@
 package handle
 
 import (
+	"context"
 	"encoding/json"
-	log "github.com/sirupsen/logrus"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	iris "github.com/kataras/iris/v12"
@@ -28,12 +29,13 @@ import (
 
 // EventsRPCs defines all the RPC methods in Events service
 type EventsRPCs struct {
-	GetEventServiceRPC                 func(eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error)
-	CreateEventSubscriptionRPC         func(eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error)
-	SubmitTestEventRPC                 func(eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error)
-	GetEventSubscriptionRPC            func(eventsproto.EventRequest) (*eventsproto.EventSubResponse, error)
-	DeleteEventSubscriptionRPC         func(eventsproto.EventRequest) (*eventsproto.EventSubResponse, error)
-	GetEventSubscriptionsCollectionRPC func(eventsproto.EventRequest) (*eventsproto.EventSubResponse, error)
+	GetEventServiceRPC                 func(context.Context, eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error)
+	CreateEventSubscriptionRPC         func(context.Context, eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error)
+	SubmitTestEventRPC                 func(context.Context, eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error)
+	GetEventSubscriptionRPC            func(context.Context, eventsproto.EventRequest) (*eventsproto.EventSubResponse, error)
+	DeleteEventSubscriptionRPC         func(context.Context, eventsproto.EventRequest) (*eventsproto.EventSubResponse, error)
+	GetEventSubscriptionsCollectionRPC func(context.Context, eventsproto.EventRequest) (*eventsproto.EventSubResponse, error)
+	ReplayEventsRPC                    func(context.Context, eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error)
 }
 
 // GetEventService is the handler to get the Event Service details.
@@ -43,15 +45,15 @@ func (e *EventsRPCs) GetEventService(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := e.GetEventServiceRPC(req)
+	resp, err := e.GetEventServiceRPC(ctx.Request().Context(), req)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -71,7 +73,7 @@ func (e *EventsRPCs) CreateEventSubscription(ctx iris.Context) {
 	err := ctx.ReadJSON(&SubscriptionReq)
 	if err != nil {
 		errorMessage := "error while trying to get JSON body from the event subscription request body: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -82,7 +84,7 @@ func (e *EventsRPCs) CreateEventSubscription(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -90,9 +92,9 @@ func (e *EventsRPCs) CreateEventSubscription(ctx iris.Context) {
 	}
 	req.PostBody, _ = json.Marshal(&SubscriptionReq)
 
-	resp, err := e.CreateEventSubscriptionRPC(req)
+	resp, err := e.CreateEventSubscriptionRPC(ctx.Request().Context(), req)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -112,7 +114,7 @@ func (e *EventsRPCs) SubmitTestEvent(ctx iris.Context) {
 	err := ctx.ReadJSON(&SubmitTestEventReq)
 	if err != nil {
 		errorMessage := "error while trying to get JSON body from the SubmitTestEvent request body: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -123,7 +125,7 @@ func (e *EventsRPCs) SubmitTestEvent(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -131,9 +133,50 @@ func (e *EventsRPCs) SubmitTestEvent(ctx iris.Context) {
 	}
 	req.PostBody, _ = json.Marshal(&SubmitTestEventReq)
 
-	resp, err := e.SubmitTestEventRPC(req)
+	resp, err := e.SubmitTestEventRPC(ctx.Request().Context(), req)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
+		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
+		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
+		ctx.JSON(&response.Body)
+		return
+	}
+
+	common.SetResponseHeader(ctx, resp.Header)
+	ctx.StatusCode(int(resp.StatusCode))
+	ctx.Write(resp.Body)
+}
+
+// ReplayEvents is the handler to replay the events consumed since the given time
+func (e *EventsRPCs) ReplayEvents(ctx iris.Context) {
+	var req eventsproto.EventSubRequest
+	// Read Post Body from Request
+	var replayEventsReq interface{}
+	err := ctx.ReadJSON(&replayEventsReq)
+	if err != nil {
+		errorMessage := "error while trying to get JSON body from the ReplayEvents request body: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusBadRequest) // TODO: add error headers
+		ctx.JSON(&response.Body)
+		return
+	}
+
+	req.SessionToken = ctx.Request().Header.Get("X-Auth-Token")
+
+	if req.SessionToken == "" {
+		errorMessage := "no X-Auth-Token found in request header"
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
+		ctx.JSON(&response.Body)
+		return
+	}
+	req.PostBody, _ = json.Marshal(&replayEventsReq)
+
+	resp, err := e.ReplayEventsRPC(ctx.Request().Context(), req)
+	if err != nil {
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -153,16 +196,16 @@ func (e *EventsRPCs) GetEventSubscription(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := e.GetEventSubscriptionRPC(req)
+	resp, err := e.GetEventSubscriptionRPC(ctx.Request().Context(), req)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response)
@@ -182,16 +225,16 @@ func (e *EventsRPCs) DeleteEventSubscription(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := e.DeleteEventSubscriptionRPC(req)
+	resp, err := e.DeleteEventSubscriptionRPC(ctx.Request().Context(), req)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -207,19 +250,20 @@ func (e *EventsRPCs) DeleteEventSubscription(ctx iris.Context) {
 func (e *EventsRPCs) GetEventSubscriptionsCollection(ctx iris.Context) {
 	var req eventsproto.EventRequest
 	req.SessionToken = ctx.Request().Header.Get("X-Auth-Token")
+	req.URL = ctx.Request().RequestURI
 
 	if req.SessionToken == "" {
 		errorMessage := "no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := e.GetEventSubscriptionsCollectionRPC(req)
+	resp, err := e.GetEventSubscriptionsCollectionRPC(ctx.Request().Context(), req)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
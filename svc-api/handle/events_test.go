@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -24,7 +25,7 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func mockGetEventServiceRPC(req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
+func mockGetEventServiceRPC(ctx context.Context, req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
 	var response *eventsproto.EventSubResponse
 	if req.SessionToken == "ValidToken" {
 		response = &eventsproto.EventSubResponse{
@@ -43,7 +44,7 @@ func mockGetEventServiceRPC(req eventsproto.EventSubRequest) (*eventsproto.Event
 	}
 	return response, nil
 }
-func mockCreateEventSubscriptionRPC(req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
+func mockCreateEventSubscriptionRPC(ctx context.Context, req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
 	var response *eventsproto.EventSubResponse
 	if req.SessionToken == "ValidToken" {
 		response = &eventsproto.EventSubResponse{
@@ -63,7 +64,7 @@ func mockCreateEventSubscriptionRPC(req eventsproto.EventSubRequest) (*eventspro
 	return response, nil
 }
 
-func mockEventSubscriptionRPC(req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
+func mockEventSubscriptionRPC(ctx context.Context, req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
 	var response *eventsproto.EventSubResponse
 	if req.SessionToken == "ValidToken" && req.EventSubscriptionID == "1A" {
 		response = &eventsproto.EventSubResponse{
@@ -82,7 +83,7 @@ func mockEventSubscriptionRPC(req eventsproto.EventRequest) (*eventsproto.EventS
 	}
 	return response, nil
 }
-func mockGetEventSubscriptionRPC(req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
+func mockGetEventSubscriptionRPC(ctx context.Context, req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
 	var response *eventsproto.EventSubResponse
 	if req.SessionToken == "ValidToken" {
 		response = &eventsproto.EventSubResponse{
@@ -224,6 +225,43 @@ func TestSubmitTestEventRPC(t *testing.T) {
 	).WithHeader("X-Auth-Token", "token").WithJSON(body).Expect().Status(http.StatusInternalServerError)
 }
 
+func TestReplayEventsRPC(t *testing.T) {
+	var event EventsRPCs
+	event.ReplayEventsRPC = mockGetEventServiceRPC
+
+	mockApp := iris.New()
+	redfishRoutes := mockApp.Party("/redfish/v1")
+	redfishRoutes.Post("/EventService/Actions/Oem/ODIM.ReplayEvents", event.ReplayEvents)
+	body := map[string]interface{}{
+		"StartTime": "2020-11-02T10:00:00Z",
+	}
+	e := httptest.New(t, mockApp)
+	// test with valid token
+	e.POST(
+		"/redfish/v1/EventService/Actions/Oem/ODIM.ReplayEvents",
+	).WithHeader("X-Auth-Token", "ValidToken").WithJSON(body).Expect().Status(http.StatusOK)
+
+	// test with Invalid token
+	e.POST(
+		"/redfish/v1/EventService/Actions/Oem/ODIM.ReplayEvents",
+	).WithHeader("X-Auth-Token", "InValidToken").WithJSON(body).Expect().Status(http.StatusUnauthorized)
+
+	// test without token
+	e.POST(
+		"/redfish/v1/EventService/Actions/Oem/ODIM.ReplayEvents",
+	).WithHeader("X-Auth-Token", "").WithJSON(body).Expect().Status(http.StatusUnauthorized)
+
+	// test without requestBody
+	e.POST(
+		"/redfish/v1/EventService/Actions/Oem/ODIM.ReplayEvents",
+	).WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusBadRequest)
+
+	// test for RPC error
+	e.POST(
+		"/redfish/v1/EventService/Actions/Oem/ODIM.ReplayEvents",
+	).WithHeader("X-Auth-Token", "token").WithJSON(body).Expect().Status(http.StatusInternalServerError)
+}
+
 func TestDeleteEventSubscriptionRPC(t *testing.T) {
 	var s EventsRPCs
 	s.DeleteEventSubscriptionRPC = mockGetEventSubscriptionRPC
@@ -16,11 +16,12 @@
 package handle
 
 import (
+	"context"
 	"encoding/json"
-	log "github.com/sirupsen/logrus"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	fabricsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/fabrics"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	iris "github.com/kataras/iris/v12"
@@ -28,9 +29,9 @@ import (
 
 // FabricRPCs defines all the RPC methods in fabric service
 type FabricRPCs struct {
-	GetFabricResourceRPC    func(fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error)
-	UpdateFabricResourceRPC func(fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error)
-	DeleteFabricResourceRPC func(fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error)
+	GetFabricResourceRPC    func(context.Context, fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error)
+	UpdateFabricResourceRPC func(context.Context, fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error)
+	DeleteFabricResourceRPC func(context.Context, fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error)
 }
 
 // GetFabricCollection defines the GetFabricCollection iris handler.
@@ -43,17 +44,17 @@ func (f *FabricRPCs) GetFabricCollection(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -80,17 +81,17 @@ func (f *FabricRPCs) GetFabric(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -117,17 +118,17 @@ func (f *FabricRPCs) GetFabricSwitchCollection(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -154,17 +155,17 @@ func (f *FabricRPCs) GetFabricSwitch(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -191,17 +192,17 @@ func (f *FabricRPCs) GetSwitchPortCollection(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -228,17 +229,17 @@ func (f *FabricRPCs) GetSwitchPort(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -265,17 +266,17 @@ func (f *FabricRPCs) GetFabricZoneCollection(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -302,17 +303,17 @@ func (f *FabricRPCs) GetFabricZone(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -339,17 +340,17 @@ func (f *FabricRPCs) GetFabricEndPointCollection(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -376,17 +377,17 @@ func (f *FabricRPCs) GetFabricEndPoints(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -413,17 +414,17 @@ func (f *FabricRPCs) GetFabricAddressPoolCollection(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -450,17 +451,17 @@ func (f *FabricRPCs) GetFabricAddressPool(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.GetFabricResourceRPC(req)
+	resp, err := f.GetFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -488,7 +489,7 @@ func (f *FabricRPCs) UpdateFabricResource(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -498,7 +499,7 @@ func (f *FabricRPCs) UpdateFabricResource(ctx iris.Context) {
 	err := ctx.ReadJSON(&createReq)
 	if err != nil {
 		errorMessage := "error while trying to get JSON body from the  request body: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -510,17 +511,17 @@ func (f *FabricRPCs) UpdateFabricResource(ctx iris.Context) {
 	request, err := json.Marshal(createReq)
 	if err != nil {
 		errorMessage := "error while trying to create JSON request body: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 	req.RequestBody = request
-	resp, err := f.UpdateFabricResourceRPC(req)
+	resp, err := f.UpdateFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -542,17 +543,17 @@ func (f *FabricRPCs) DeleteFabricResource(ctx iris.Context) {
 
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := f.DeleteFabricResourceRPC(req)
+	resp, err := f.DeleteFabricResourceRPC(ctx.Request().Context(), req)
 	if err != nil && resp == nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -23,7 +24,7 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func mockGetFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
+func mockGetFabricResource(ctx context.Context, req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
 	var response = &fabricsproto.FabricResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &fabricsproto.FabricResponse{
@@ -42,7 +43,7 @@ func mockGetFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.Fabric
 	return response, nil
 }
 
-func mockDeleteFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
+func mockDeleteFabricResource(ctx context.Context, req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
 	var response = &fabricsproto.FabricResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &fabricsproto.FabricResponse{
@@ -61,7 +62,7 @@ func mockDeleteFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.Fab
 	return response, nil
 }
 
-func mockUpdateFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
+func mockUpdateFabricResource(ctx context.Context, req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
 	var response = &fabricsproto.FabricResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &fabricsproto.FabricResponse{
@@ -22,10 +22,9 @@ import (
 	"net/http"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	errResponse "github.com/ODIM-Project/ODIM/lib-utilities/response"
 	srv "github.com/ODIM-Project/ODIM/lib-utilities/services"
 	"github.com/ODIM-Project/ODIM/svc-api/models"
@@ -68,7 +67,19 @@ func getService(microServices []string, uuid string) models.ServiceRoot {
 			Sessions: models.Sessions{
 				OdataID: "/redfish/v1/SessionService/Sessions"},
 		},
-		Registries: &models.Service{OdataID: "/redfish/v1/Registries"},
+		Registries:  &models.Service{OdataID: "/redfish/v1/Registries"},
+		JSONSchemas: &models.Service{OdataID: "/redfish/v1/JsonSchemas"},
+		ProtocolFeaturesSupported: &models.PFSupported{
+			ExpandQuery: &models.ExpandQuery{
+				ExpandAll: true,
+				Levels:    true,
+				MaxLevels: common.MaxExpandLevels,
+				NoLinks:   true,
+			},
+			FilterQuery:  true,
+			SelectQuery:  true,
+			TopSkipQuery: true,
+		},
 	}
 	// To discover the services we need registry
 	//Get Service options to retrive the Registry from it.
@@ -98,8 +109,6 @@ func getService(microServices []string, uuid string) models.ServiceRoot {
 					serviceRoot.SessionService = &models.Service{OdataID: servicePath}
 				}
 			}
-		case "JSONSchemas":
-			serviceRoot.JSONSchemas = &models.Service{OdataID: servicePath}
 		case "Systems":
 			serviceNodes, err := reg.GetService(srv.Systems)
 
@@ -159,6 +168,13 @@ func getService(microServices []string, uuid string) models.ServiceRoot {
 					serviceRoot.TelemetryService = &models.Service{OdataID: servicePath}
 				}
 			}
+		case "CertificateService":
+			serviceNodes, err := reg.GetService(srv.Managers)
+			if err == nil {
+				if len(serviceNodes) != 0 {
+					serviceRoot.CertificateService = &models.Service{OdataID: servicePath}
+				}
+			}
 		}
 	}
 
@@ -195,7 +211,7 @@ func GetOdata(ctx iris.Context) {
 		if service == "Service" {
 			Odata.Value = append(Odata.Value, &models.Value{Name: service, Kind: "Singleton", URL: "/redfish/v1/"})
 		} else if service == "JsonSchemas" {
-			Odata.Value = append(Odata.Value, &models.Value{Name: service, Kind: "Singleton", URL: "/redfish/v1/Schemas"})
+			Odata.Value = append(Odata.Value, &models.Value{Name: service, Kind: "Singleton", URL: "/redfish/v1/JsonSchemas"})
 		} else if service == "Sessions" {
 			Odata.Value = append(Odata.Value, &models.Value{Name: service, Kind: "Singleton", URL: "/redfish/v1/SessionService/Sessions/"})
 		} else {
@@ -213,551 +229,16 @@ func GetOdata(ctx iris.Context) {
 	ctx.JSON(Odata)
 }
 
+// metadataDocument is the $metadata document, built by LoadMetadata when the service starts
+var metadataDocument []byte
+
+// LoadMetadata builds the $metadata document served by GetMetadata
+func LoadMetadata() {
+	metadataDocument, _ = xml.Marshal(getMetadata())
+}
+
 //GetMetadata build response body and headers for the GET operation on /redfish/v1/$metadata
 func GetMetadata(ctx iris.Context) {
-	Metadata := models.Metadata{
-		Version:   "4.0",
-		Xmlnsedmx: "http://docs.oasis-open.org/odata/ns/edmx",
-		TopReference: []models.Reference{
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/AccountService_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "AccountService"},
-					models.Include{Namespace: "AccountService.v1_6_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/AddressPool_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "AddressPool.v1_1_0"},
-					models.Include{Namespace: "AddressPool.v1_1_0a"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/AddressPoolCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "AddressPoolCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Aggregate_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Aggregate"},
-					models.Include{Namespace: "Aggregate.v1_0_0"},
-					models.Include{Namespace: "Aggregate.v1_0_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/AggregateCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "AggregateCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/AggregationService_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "AggregationService"},
-					models.Include{Namespace: "AggregationService.v1_0_0"},
-					models.Include{Namespace: "AggregationService.v1_0_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/AggregationSource_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "AggregationSource"},
-					models.Include{Namespace: "AggregationSource.v1_0_0"},
-					models.Include{Namespace: "AggregationSource.v1_1_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/AggregationSourceCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "AggregationSourceCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/AttributeRegistry_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "AttributeRegistry"},
-					models.Include{Namespace: "AttributeRegistry.v1_0_0"},
-					models.Include{Namespace: "AttributeRegistry.v1_1_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Bios_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Bios"},
-					models.Include{Namespace: "Bios.v1_0_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/BootOption_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "BootOption"},
-					models.Include{Namespace: "BootOption.v1_0_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/BootOptionCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "BootOptionCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Chassis_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Chassis"},
-					models.Include{Namespace: "Chassis.v1_6_0"},
-					models.Include{Namespace: "Chassis.v1_10_2"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ChassisCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ChassisCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ComputerSystem_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ComputerSystem"},
-					models.Include{Namespace: "ComputerSystem.v1_4_0"},
-					models.Include{Namespace: "ComputerSystem.v1_10_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ComputerSystemCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ComputerSystemCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ConnectionMethod_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ConnectionMethod"},
-					models.Include{Namespace: "ConnectionMethod.v1_0_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ConnectionMethodCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ConnectionMethodCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Drive_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Drive"},
-					models.Include{Namespace: "Drive.v1_8_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Endpoint_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Endpoint.v1_3_1"},
-					models.Include{Namespace: "Endpoint.v1_4_0"},
-					models.Include{Namespace: "Endpoint.v1_5_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/EndpointCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "EndpointCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/EthernetInterface_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "EthernetInterface"},
-					models.Include{Namespace: "EthernetInterface.v1_4_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/EthernetInterfaceCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "EthernetInterfaceCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/EthernetInterfaces_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "EthernetInterfaces"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/EventDestination_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "EventDestination"},
-					models.Include{Namespace: "EventDestination.v1_7_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/EventDestinationCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "EventDestinationCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/EventService_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "EventService"},
-					models.Include{Namespace: "EventService.v1_5_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Fabric_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Fabric.v1_0_5"},
-					models.Include{Namespace: "Fabric.v1_1_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/FabricCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "FabricCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/FirmwareInventory_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "FirmwareInventory"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/FirmwareInventoryCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "FirmwareInventoryCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/HostInterface_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "HostInterface"},
-					models.Include{Namespace: "HostInterface.v1_1_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/HostInterfaceCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "HostInterfaceCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/IPAddresses_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "IPAddresses"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/LogEntry_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "LogEntry"},
-					models.Include{Namespace: "LogEntry.v1_1_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/LogEntryCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "LogEntryCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/LogService_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "LogService"},
-					models.Include{Namespace: "LogService.v1_0_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Manager_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Manager"},
-					models.Include{Namespace: "Manager.v1_3_3"},
-					models.Include{Namespace: "Manager.v1_5_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ManagerAccount_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ManagerAccount"},
-					models.Include{Namespace: "ManagerAccount.v1_4_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ManagerAccountCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ManagerAccountCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ManagerCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ManagerCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ManagerNetworkProtocol_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ManagerNetworkProtocol"},
-					models.Include{Namespace: "ManagerNetworkProtocol.v1_0_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Memory_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Memory"},
-					models.Include{Namespace: "Memory.v1_7_0"},
-					models.Include{Namespace: "Memory.v1_7_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/MemoryCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "MemoryCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/MemoryDomainCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "MemoryDomainCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Message_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Message"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/MessageRegistry_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "MessageRegistry"},
-					models.Include{Namespace: "MessageRegistry.v1_0_0"},
-					models.Include{Namespace: "MessageRegistry.v1_1_3"},
-					models.Include{Namespace: "MessageRegistry.v1_2_0"},
-					models.Include{Namespace: "MessageRegistry.v1_3_0"},
-					models.Include{Namespace: "MessageRegistry.v1_3_1"},
-					models.Include{Namespace: "MessageRegistry.v1_3_2"},
-					models.Include{Namespace: "MessageRegistry.v1_3_3"},
-					models.Include{Namespace: "MessageRegistry.v1_4_0"},
-					models.Include{Namespace: "MessageRegistry.v1_4_1"},
-					models.Include{Namespace: "MessageRegistry.v1_4_2"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/MessageRegistryCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "MessageRegistryCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/MessageRegistryFile_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "MessageRegistryFile"},
-					models.Include{Namespace: "MessageRegistryFile.v1_1_0"},
-					models.Include{Namespace: "MessageRegistryFile.v1_1_1"},
-					models.Include{Namespace: "MessageRegistryFile.v1_1_2"},
-					models.Include{Namespace: "MessageRegistryFile.v1_1_3"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/MessageRegistryFileCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "MessageRegistryFileCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/NetworkAdapter_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "NetworkAdapter"},
-					models.Include{Namespace: "NetworkAdapter.v1_2_0"},
-					models.Include{Namespace: "NetworkAdapter.v1_3_0"},
-					models.Include{Namespace: "NetworkAdapter.v1_4_0"},
-					models.Include{Namespace: "NetworkAdapter.v1_5_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/NetworkAdapterCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "NetworkAdapterCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/NetworkInterface_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "NetworkInterface"},
-					models.Include{Namespace: "NetworkInterface.v1_1_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/NetworkInterfaceCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "NetworkInterfaceCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/PCIeDevice_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "PCIeDevice"},
-					models.Include{Namespace: "PCIeDevice.v1_4_0"},
-					models.Include{Namespace: "PCIeDevice.v1_5_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Port_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Port"},
-					models.Include{Namespace: "Port.v1_1_3"},
-					models.Include{Namespace: "Port.v1_2_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/PortCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "PortCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Power_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Power"},
-					models.Include{Namespace: "Power.v1_3_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/PrivilegeRegistry_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "PrivilegeRegistry"},
-					models.Include{Namespace: "PrivilegeRegistry.v1_0_0"},
-					models.Include{Namespace: "PrivilegeRegistry.v1_1_4"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Processor_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Processor"},
-					models.Include{Namespace: "Processor.v1_0_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ProcessorCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ProcessorCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/RedfishExtensions_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "RedfishExtensions.v1_0_0", Alias: "Redfish"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Redundancy_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Redundancy"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Resource_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Resource"},
-					models.Include{Namespace: "Resource.v1_8_3"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Role_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Role"},
-					models.Include{Namespace: "Role.v1_2_4"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/RoleCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "RoleCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/SecureBoot_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "SecureBoot"},
-					models.Include{Namespace: "SecureBoot.v1_0_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ServiceRoot_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ServiceRoot"},
-					models.Include{Namespace: "ServiceRoot.v1_1_0"},
-					models.Include{Namespace: "ServiceRoot.v1_5_0"},
-					models.Include{Namespace: "ServiceRoot.v1_9_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Session_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Session"},
-					models.Include{Namespace: "Session.v1_2_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/SessionCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "SessionCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/SessionService_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "SessionService"},
-					models.Include{Namespace: "SessionService.v1_1_6"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Settings_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Settings"},
-					models.Include{Namespace: "Settings.v1_0_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/SoftwareInventoryCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "SoftwareInventoryCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Storage_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Storage"},
-					models.Include{Namespace: "Storage.v1_7_1"},
-					models.Include{Namespace: "Storage.v1_8_0"},
-					models.Include{Namespace: "Storage.v1_8_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/StorageCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "StorageCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Switch_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Switch"},
-					models.Include{Namespace: "Switch.v1_2_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/SwitchCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "SwitchCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Task_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Task"},
-					models.Include{Namespace: "Task.v1_5_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/TaskCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "TaskCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/TaskService_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "TaskService"},
-					models.Include{Namespace: "TaskService.v1_1_4"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Thermal_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Thermal"},
-					models.Include{Namespace: "Thermal.v1_1_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/UpdateService_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "UpdateService"},
-					models.Include{Namespace: "UpdateService.v1_1_1"},
-					models.Include{Namespace: "UpdateService.v1_6_0"},
-					models.Include{Namespace: "UpdateService.v1_8_1"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/VirtualMedia_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "VirtualMedia"},
-					models.Include{Namespace: "VirtualMedia.v1_2_0"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/VirtualMediaCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "VirtualMediaCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/VLanNetworkInterface_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "VLanNetworkInterface"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/VolumeCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "VolumeCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/Zone_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "Zone.v1_3_0"},
-					models.Include{Namespace: "Zone.v1_4_0"},
-				},
-			},
-
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/ZoneCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "ZoneCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/SerialInterfaceCollection_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "SerialInterfaceCollection"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/SerialInterface_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "SerialInterface.v1_1_7"},
-				},
-			},
-			models.Reference{URI: "http://redfish.dmtf.org/schemas/v1/TelemetryService_v1.xml",
-				TopInclude: []models.Include{
-					models.Include{Namespace: "TelemetryService.v1_1_7"},
-				},
-			},
-		},
-	}
 	ctx.Gzip(true)
 
 	var headers = map[string]string{
@@ -766,9 +247,8 @@ func GetMetadata(ctx iris.Context) {
 		"Transfer-Encoding": "chunked",
 		"Content-type":      "application/xml; charset=utf-8",
 	}
-	xmlData, _ := xml.Marshal(Metadata)
 	SetResponseHeaders(ctx, headers)
-	ctx.Write(xmlData)
+	ctx.Write(metadataDocument)
 
 }
 
@@ -784,7 +264,7 @@ func (r *Registry) GetRegistryFileCollection(ctx iris.Context) {
 	sessionToken := ctx.Request().Header.Get("X-Auth-Token")
 	if sessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, errResponse.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error      headers
 		ctx.JSON(&response.Body)
@@ -792,7 +272,7 @@ func (r *Registry) GetRegistryFileCollection(ctx iris.Context) {
 	}
 	authResp := r.Auth(sessionToken, []string{common.PrivilegeLogin}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authorize token")
+		logs.Log(ctx.Request().Context()).Error("error while trying to authorize token")
 		ctx.StatusCode(int(authResp.StatusCode))
 		SetResponseHeaders(ctx, authResp.Header)
 		ctx.JSON(authResp.Body)
@@ -810,7 +290,7 @@ func (r *Registry) GetRegistryFileCollection(ctx iris.Context) {
 	registryStore := config.Data.RegistryStorePath
 	regFiles, err := ioutil.ReadDir(registryStore)
 	if err != nil {
-		log.Fatal(err.Error())
+		logs.Log(ctx.Request().Context()).Fatal(err.Error())
 	}
 	//Construct the Response body
 	var listMembers []response.ListMember
@@ -871,7 +351,7 @@ func (r *Registry) GetMessageRegistryFileID(ctx iris.Context) {
 
 	if sessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, errResponse.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error      headers
 		ctx.JSON(&response.Body)
@@ -879,7 +359,7 @@ func (r *Registry) GetMessageRegistryFileID(ctx iris.Context) {
 	}
 	authResp := r.Auth(sessionToken, []string{common.PrivilegeLogin}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authorize token")
+		logs.Log(ctx.Request().Context()).Error("error while trying to authorize token")
 		ctx.StatusCode(int(authResp.StatusCode))
 		SetResponseHeaders(ctx, authResp.Header)
 		ctx.JSON(authResp.Body)
@@ -898,7 +378,7 @@ func (r *Registry) GetMessageRegistryFileID(ctx iris.Context) {
 
 	reqRegistryFileName := regFileID + ".json"
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 	}
 	// Constuct the registry file names slice
 	var regFileNames []string
@@ -910,7 +390,7 @@ func (r *Registry) GetMessageRegistryFileID(ctx iris.Context) {
 	regFileKeys, err := models.GetAllRegistryFileNamesFromDB("Registries")
 	if err != nil {
 		// log Critical message but proceed
-		log.Error("error: while trying to get the Registry files (Keys/FileNames only)from DB")
+		logs.Log(ctx.Request().Context()).Error("error: while trying to get the Registry files (Keys/FileNames only)from DB")
 	}
 	regFileNames = append(regFileNames, regFileKeys...)
 	for _, regFile := range regFileNames {
@@ -925,7 +405,7 @@ func (r *Registry) GetMessageRegistryFileID(ctx iris.Context) {
 			"Content-type": "application/json; charset=utf-8", //   TODO: add all error headers
 		}
 		SetResponseHeaders(ctx, responseHeader)
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusNotFound, errResponse.ResourceNotFound, errorMessage, []interface{}{"RegistryFile", regFileID}, nil)
 		ctx.StatusCode(http.StatusNotFound) // TODO: add error      headers
 		ctx.JSON(&response.Body)
@@ -972,7 +452,7 @@ func (r *Registry) GetMessageRegistryFile(ctx iris.Context) {
 		responseHeader := map[string]string{
 			"Content-type": "application/json; charset=utf-8", //   TODO: add all error headers
 		}
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, errResponse.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error      headers
 		SetResponseHeaders(ctx, responseHeader)
@@ -981,7 +461,7 @@ func (r *Registry) GetMessageRegistryFile(ctx iris.Context) {
 	}
 	authResp := r.Auth(sessionToken, []string{common.PrivilegeLogin}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authorize token")
+		logs.Log(ctx.Request().Context()).Error("error while trying to authorize token")
 		ctx.StatusCode(int(authResp.StatusCode))
 		SetResponseHeaders(ctx, authResp.Header)
 		ctx.JSON(authResp.Body)
@@ -1005,12 +485,12 @@ func (r *Registry) GetMessageRegistryFile(ctx iris.Context) {
 		content, err = models.GetRegistryFile("Registries", regFileID)
 		if content == nil {
 			// file Not found, send 404 error
-			log.Error("got error while retreiving fom DB")
+			logs.Log(ctx.Request().Context()).Error("got error while retreiving fom DB")
 			errorMessage := "error: Resource not found"
 			responseHeader := map[string]string{
 				"Content-type": "application/json; charset=utf-8", //   TODO: add all error headers
 			}
-			log.Error(errorMessage)
+			logs.Log(ctx.Request().Context()).Error(errorMessage)
 			response := common.GeneralError(http.StatusNotFound, errResponse.ResourceNotFound, errorMessage, []interface{}{"RegistryFile", regFileID}, nil)
 			ctx.StatusCode(http.StatusNotFound) // TODO: add error      headers
 			SetResponseHeaders(ctx, responseHeader)
@@ -1019,16 +499,16 @@ func (r *Registry) GetMessageRegistryFile(ctx iris.Context) {
 		}
 	}
 	var data interface{}
-	log.Error("Before Unmarshalling Data")
+	logs.Log(ctx.Request().Context()).Error("Before Unmarshalling Data")
 	err = json.Unmarshal(content, &data)
 	if err != nil {
 		//return fmt.Errorf("error while trying to unmarshal the config data: %v", err)
-		log.Error(err.Error())
+		logs.Log(ctx.Request().Context()).Error(err.Error())
 		errorMessage := "error: Resource not found"
 		responseHeader := map[string]string{
 			"Content-type": "application/json; charset=utf-8", //   TODO: add all error headers
 		}
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError)
 		SetResponseHeaders(ctx, responseHeader)
@@ -1116,6 +596,8 @@ func ManagersMethodNotAllowed(ctx iris.Context) {
 		ctx.ResponseWriter().Header().Set("Allow", "")
 	case "/redfish/v1/Managers/" + systemID + "/LogServices/" + subID + "Actions/LogService.ClearLog":
 		ctx.ResponseWriter().Header().Set("Allow", "POST")
+	case "/redfish/v1/Managers/" + systemID + "/Oem/ODIM/ConsoleSessions":
+		ctx.ResponseWriter().Header().Set("Allow", "POST")
 	default:
 		ctx.ResponseWriter().Header().Set("Allow", "GET")
 	}
@@ -1145,6 +627,13 @@ func RegMethodNotAllowed(ctx iris.Context) {
 	return
 }
 
+// SchemaMethodNotAllowed holds builds reponse for the unallowed http operation on JsonSchemas, SchemaStore and Oem/Odim report URLs and returns 405 error.
+func SchemaMethodNotAllowed(ctx iris.Context) {
+	ctx.ResponseWriter().Header().Set("Allow", "GET")
+	fillMethodNotAllowedErrorResponse(ctx)
+	return
+}
+
 // EvtMethodNotAllowed holds builds reponse for the unallowed http operation on Events URLs and returns 405 error.
 func EvtMethodNotAllowed(ctx iris.Context) {
 	url := ctx.Request().URL
@@ -1158,6 +647,8 @@ func EvtMethodNotAllowed(ctx iris.Context) {
 		ctx.ResponseWriter().Header().Set("Allow", "")
 	case "/redfish/v1/EventService/Actions/EventService.SubmitTestEvent":
 		ctx.ResponseWriter().Header().Set("Allow", "POST")
+	case "/redfish/v1/EventService/Actions/Oem/ODIM.ReplayEvents":
+		ctx.ResponseWriter().Header().Set("Allow", "POST")
 	}
 	fillMethodNotAllowedErrorResponse(ctx)
 	return
@@ -1196,6 +687,8 @@ func AggregateMethodNotAllowed(ctx iris.Context) {
 		ctx.ResponseWriter().Header().Set("Allow", "POST")
 	case "/redfish/v1/AggregationService/Aggregates/" + aggregateID + "Actions/Aggregate.SetDefaultBootOrder/":
 		ctx.ResponseWriter().Header().Set("Allow", "POST")
+	case "/redfish/v1/AggregationService/Aggregates/" + aggregateID + "Actions/Aggregate.BulkRequest/":
+		ctx.ResponseWriter().Header().Set("Allow", "POST")
 	}
 	fillMethodNotAllowedErrorResponse(ctx)
 	return
@@ -1,15 +1,15 @@
-//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+// (C) Copyright [2020] Hewlett Packard Enterprise Development LP
 //
-//Licensed under the Apache License, Version 2.0 (the "License"); you may
-//not use this file except in compliance with the License. You may obtain
-//a copy of the License at
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
 //
-//    http://www.apache.org/licenses/LICENSE-2.0
+//	http://www.apache.org/licenses/LICENSE-2.0
 //
-//Unless required by applicable law or agreed to in writing, software
-//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
-//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
-//License for the specific language governing permissions and limitations
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
 // under the License.
 package handle
 
@@ -19,13 +19,14 @@ import (
 	"testing"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-api/models"
 	iris "github.com/kataras/iris/v12"
 	"github.com/kataras/iris/v12/httptest"
 )
 
-//TestGetVersion is unittest method for GetVersion func.
+// TestGetVersion is unittest method for GetVersion func.
 func TestGetVersion(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish")
@@ -46,7 +47,7 @@ func mockGetService(a []string, b string) models.ServiceRoot {
 	return models.ServiceRoot{}
 }
 
-//TestGetServiceRoot is unittest method for GetServiceRoot func.
+// TestGetServiceRoot is unittest method for GetServiceRoot func.
 func TestGetServiceRoot(t *testing.T) {
 	s := ServiceRoot{getService: mockGetService}
 
@@ -60,7 +61,7 @@ func TestGetServiceRoot(t *testing.T) {
 	e.GET("/redfish/v1").Expect().Status(http.StatusOK)
 }
 
-//TestGetOdata is unittest method for GetOdata func.
+// TestGetOdata is unittest method for GetOdata func.
 func TestGetOdata(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish")
@@ -79,8 +80,10 @@ func TestGetOdata(t *testing.T) {
 
 }
 
-//TestGetMetadata is unittest method for GetOdata func.
+// TestGetMetadata is unittest method for GetOdata func.
 func TestGetMetadata(t *testing.T) {
+	defer setUpMockSchemaStore(t)()
+	LoadMetadata()
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish")
 	redfishRoutes.Get("/v1/$metadata", GetMetadata)
@@ -95,10 +98,16 @@ func TestGetMetadata(t *testing.T) {
 	for _, field := range list {
 		e.GET("/redfish/v1/$metadata").Expect().Status(http.StatusOK).Body().Contains(field)
 	}
+	e.GET("/redfish/v1/$metadata").Expect().Status(http.StatusOK).Body().Contains(`<edmx:Reference Uri="/redfish/v1/SchemaStore/en/ServiceRoot_v1.xml"><edmx:Include Namespace="ServiceRoot"></edmx:Include><edmx:Include Namespace="ServiceRoot.v1_9_0"></edmx:Include></edmx:Reference>`)
+
+	// an empty document is served when the schema store is not configured
+	config.Data.SchemaStorePath = ""
+	LoadMetadata()
+	e.GET("/redfish/v1/$metadata").Expect().Status(http.StatusOK).Body().Equal(`<edmx:Edmx xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx" Version="4.0"></edmx:Edmx>`)
 
 }
 
-//TestAsMethodNotAllowed is unittest method for AsMethodNotAllowed func.
+// TestAsMethodNotAllowed is unittest method for AsMethodNotAllowed func.
 func TestAsMethodNotAllowed(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish")
@@ -111,7 +120,7 @@ func TestAsMethodNotAllowed(t *testing.T) {
 	e.DELETE("/redfish/v1/AccountService").Expect().Status(http.StatusMethodNotAllowed)
 }
 
-//TestSsMethodNotAllowed is unittest method for SsMethodNotAllowed func.
+// TestSsMethodNotAllowed is unittest method for SsMethodNotAllowed func.
 func TestSsMethodNotAllowed(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish")
@@ -124,7 +133,7 @@ func TestSsMethodNotAllowed(t *testing.T) {
 	e.DELETE("/redfish/v1/SessionService").Expect().Status(http.StatusMethodNotAllowed)
 }
 
-//TestSystemsMethodNotAllowed is unittest method for SystemsMethodNotAllowed func.
+// TestSystemsMethodNotAllowed is unittest method for SystemsMethodNotAllowed func.
 func TestSystemsMethodNotAllowed(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish")
@@ -204,8 +213,8 @@ func TestSystemsMethodNotAllowed(t *testing.T) {
 	e.DELETE("/redfish/v1/Systems/" + systemID + "/Processors/{rid}").Expect().Status(http.StatusMethodNotAllowed)
 }
 
-//TestMethodNotAllowedForLogServices is unit test method for
-//LogService path in ManagersMethodNotAllowed and SystemsMethodNotAllowed funcs.
+// TestMethodNotAllowedForLogServices is unit test method for
+// LogService path in ManagersMethodNotAllowed and SystemsMethodNotAllowed funcs.
 func TestMethodNotAllowedForLogServices(t *testing.T) {
 	logServicesURI := "{id}/LogServices/{rID}"
 	entriesURI := logServicesURI + "/Entries"
@@ -343,7 +352,7 @@ func TestGetMessageRegistryFile(t *testing.T) {
 	test.GET("/redfish/v1/registries/Base.1.0.0.json").WithHeader("X-Auth-Token", "invalidToken").Expect().Status(http.StatusUnauthorized)
 }
 
-//TestTsMethodNotAllowed is unittest method for TsMethodNotAllowed func.
+// TestTsMethodNotAllowed is unittest method for TsMethodNotAllowed func.
 func TestTsMethodNotAllowed(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish/v1")
@@ -365,7 +374,7 @@ func TestTsMethodNotAllowed(t *testing.T) {
 	e.PUT("/redfish/v1/TaskService/Tasks/{TaskID}").Expect().Status(http.StatusMethodNotAllowed)
 }
 
-//TestEvtMethodNotAllowed is unittest method for EvtMethodNotAllowed func.
+// TestEvtMethodNotAllowed is unittest method for EvtMethodNotAllowed func.
 func TestEvtMethodNotAllowed(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish/v1")
@@ -389,7 +398,7 @@ func TestEvtMethodNotAllowed(t *testing.T) {
 	e.PATCH("/redfish/v1/EventService/Subscriptions").Expect().Status(http.StatusMethodNotAllowed)
 }
 
-//TestAggMethodNotAllowed is unittest method for AggMethodNotAllowed func.
+// TestAggMethodNotAllowed is unittest method for AggMethodNotAllowed func.
 func TestAggMethodNotAllowed(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish/v1")
@@ -415,7 +424,7 @@ func TestAggMethodNotAllowed(t *testing.T) {
 	e.DELETE("/redfish/v1/AggregationService/ConnectionMethods/" + connMethodID).Expect().Status(http.StatusMethodNotAllowed)
 }
 
-//TestFabricsMethodNotAllowed is unittest method for FabricsMethodNotAllowed func.
+// TestFabricsMethodNotAllowed is unittest method for FabricsMethodNotAllowed func.
 func TestFabricsMethodNotAllowed(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish/v1")
@@ -428,7 +437,7 @@ func TestFabricsMethodNotAllowed(t *testing.T) {
 	e.DELETE("/redfish/v1/Fabrics").Expect().Status(http.StatusMethodNotAllowed)
 }
 
-//TestChassisMethodNotAllowed is unittest method for ChassisMethodNotAllowed func.
+// TestChassisMethodNotAllowed is unittest method for ChassisMethodNotAllowed func.
 func TestChassisMethodNotAllowed(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish")
@@ -574,7 +583,7 @@ func TestManagersMethodNotAllowed(t *testing.T) {
 	e.DELETE("/redfish/v1/Managers/{id}").Expect().Status(http.StatusMethodNotAllowed)
 }
 
-//TestAggregateMethodNotAllowed is unittest method for AggregateMethodNotAllowed func.
+// TestAggregateMethodNotAllowed is unittest method for AggregateMethodNotAllowed func.
 func TestAggregateMethodNotAllowed(t *testing.T) {
 	router := iris.New()
 	redfishRoutes := router.Party("/redfish/v1/AggregationService/Aggregates")
@@ -16,10 +16,13 @@
 package handle
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	iris "github.com/kataras/iris/v12"
@@ -27,28 +30,43 @@ import (
 
 // ManagersRPCs defines all the RPC methods in account service
 type ManagersRPCs struct {
-	GetManagersCollectionRPC func(req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)
-	GetManagersRPC           func(req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)
-	GetManagersResourceRPC   func(req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)
+	GetManagersCollectionRPC   func(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)
+	GetManagersRPC             func(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)
+	GetManagersResourceRPC     func(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)
+	ResetManagerRPC            func(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error)
+	ResetManagerToDefaultsRPC  func(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error)
+	InsertVirtualMediaRPC      func(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error)
+	EjectVirtualMediaRPC       func(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error)
+	UpdateVirtualMediaRPC      func(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error)
+	CreateConsoleSessionRPC    func(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error)
+	ConnectConsoleSessionRPC   func(ctx context.Context, req managersproto.ConsoleSessionRequest) (*managersproto.ConsoleSessionResponse, error)
+	GetCertificateServiceRPC   func(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)
+	GetCertificateLocationsRPC func(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)
+	GetEndpointCertificatesRPC func(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error)
+	GenerateCSRRPC             func(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error)
+	ReplaceCertificateRPC      func(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error)
+	// Auth checks the session of the user who created a console session while the console is connected
+	Auth func(string, []string, []string) response.RPC
 }
 
 //GetManagersCollection fetches all managers
 func (mgr *ManagersRPCs) GetManagersCollection(ctx iris.Context) {
 	req := managersproto.ManagerRequest{
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
+		URL:          ctx.Request().RequestURI,
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := mgr.GetManagersCollectionRPC(req)
+	resp, err := mgr.GetManagersCollectionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error:  RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -69,16 +87,16 @@ func (mgr *ManagersRPCs) GetManager(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := mgr.GetManagersRPC(req)
+	resp, err := mgr.GetManagersRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -103,16 +121,16 @@ func (mgr *ManagersRPCs) GetManagersResource(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := mgr.GetManagersResourceRPC(req)
+	resp, err := mgr.GetManagersResourceRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error:  RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -123,3 +141,78 @@ func (mgr *ManagersRPCs) GetManagersResource(ctx iris.Context) {
 	ctx.StatusCode(int(resp.StatusCode))
 	ctx.Write(resp.Body)
 }
+
+// ResetManager is the handler for the Manager.Reset action
+func (mgr *ManagersRPCs) ResetManager(ctx iris.Context) {
+	mgr.managerAction(ctx, mgr.ResetManagerRPC)
+}
+
+// ResetManagerToDefaults is the handler for the Manager.ResetToDefaults action
+func (mgr *ManagersRPCs) ResetManagerToDefaults(ctx iris.Context) {
+	mgr.managerAction(ctx, mgr.ResetManagerToDefaultsRPC)
+}
+
+// InsertVirtualMedia is the handler for the VirtualMedia.InsertMedia action
+func (mgr *ManagersRPCs) InsertVirtualMedia(ctx iris.Context) {
+	mgr.managerAction(ctx, mgr.InsertVirtualMediaRPC)
+}
+
+// EjectVirtualMedia is the handler for the VirtualMedia.EjectMedia action
+func (mgr *ManagersRPCs) EjectVirtualMedia(ctx iris.Context) {
+	mgr.managerAction(ctx, mgr.EjectVirtualMediaRPC)
+}
+
+// UpdateVirtualMedia is the handler for the PATCH on a virtual media
+func (mgr *ManagersRPCs) UpdateVirtualMedia(ctx iris.Context) {
+	mgr.managerAction(ctx, mgr.UpdateVirtualMediaRPC)
+}
+
+// CreateConsoleSession is the handler for the creation of a console session on a manager
+func (mgr *ManagersRPCs) CreateConsoleSession(ctx iris.Context) {
+	mgr.managerAction(ctx, mgr.CreateConsoleSessionRPC)
+}
+
+// managerAction reads the request body which may be empty for the actions
+// without parameters, and does the rpc call of the action
+func (mgr *ManagersRPCs) managerAction(ctx iris.Context, actionRPC func(context.Context, managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error)) {
+	request, err := ioutil.ReadAll(ctx.Request().Body)
+	if err != nil || (len(request) != 0 && !json.Valid(request)) {
+		errorMessage := "error while trying to get JSON body from the request body"
+		if err != nil {
+			errorMessage += ": " + err.Error()
+		}
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.JSON(&response.Body)
+		return
+	}
+	req := managersproto.ManagerActionRequest{
+		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
+		ManagerID:    ctx.Params().Get("id"),
+		ResourceID:   ctx.Params().Get("rid"),
+		URL:          ctx.Request().URL.Path,
+		RequestBody:  request,
+	}
+	if req.SessionToken == "" {
+		errorMessage := "error: no X-Auth-Token found in request header"
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusUnauthorized)
+		ctx.JSON(&response.Body)
+		return
+	}
+	resp, err := actionRPC(ctx.Request().Context(), req)
+	if err != nil {
+		errorMessage := "error:  RPC error:" + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.JSON(&response.Body)
+		return
+	}
+
+	common.SetResponseHeader(ctx, resp.Header)
+	ctx.StatusCode(int(resp.StatusCode))
+	ctx.Write(resp.Body)
+}
@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -23,7 +24,7 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func mockGetManagersRequest(req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+func mockGetManagersRequest(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
 	var response = &managersproto.ManagerResponse{}
 	if req.ManagerID == "1A" && req.SessionToken == "ValidToken" {
 		response = &managersproto.ManagerResponse{
@@ -49,7 +50,7 @@ func mockGetManagersRequest(req managersproto.ManagerRequest) (*managersproto.Ma
 	return response, nil
 }
 
-func mockGetManagersResourceRequest(req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+func mockGetManagersResourceRequest(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
 	var response = &managersproto.ManagerResponse{}
 	if req.ManagerID == "1A" && req.ResourceID == "1B" && req.SessionToken == "ValidToken" {
 		response = &managersproto.ManagerResponse{
@@ -86,7 +87,7 @@ func mockGetManagersResourceRequest(req managersproto.ManagerRequest) (*managers
 	}
 	return response, nil
 }
-func mockGetManagersCollectionRequest(req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+func mockGetManagersCollectionRequest(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
 	var response = &managersproto.ManagerResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &managersproto.ManagerResponse{
@@ -216,3 +217,45 @@ func TestGetManagerResource_RPCError(t *testing.T) {
 		"/redfish/v1/Managers/3A/NetworkInterfaces/1B",
 	).WithHeader("X-Auth-Token", "InvalidToken").Expect().Status(http.StatusInternalServerError)
 }
+
+func mockManagerActionRequest(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	if req.ManagerID == "3A" {
+		return &managersproto.ManagerResponse{}, fmt.Errorf("RPC Error")
+	}
+	return &managersproto.ManagerResponse{
+		StatusCode:    202,
+		StatusMessage: "Accepted",
+		Header:        map[string]string{"Location": "/taskmon/task12345"},
+		Body:          []byte(`{"Response":"Accepted"}`),
+	}, nil
+}
+
+func TestManagerActions(t *testing.T) {
+	var mgr ManagersRPCs
+	mgr.ResetManagerRPC = mockManagerActionRequest
+	mgr.InsertVirtualMediaRPC = mockManagerActionRequest
+	mgr.EjectVirtualMediaRPC = mockManagerActionRequest
+	mgr.UpdateVirtualMediaRPC = mockManagerActionRequest
+	mockApp := iris.New()
+	redfishRoutes := mockApp.Party("/redfish/v1/Managers")
+	redfishRoutes.Post("/{id}/Actions/Manager.Reset", mgr.ResetManager)
+	redfishRoutes.Patch("/{id}/VirtualMedia/{rid}", mgr.UpdateVirtualMedia)
+	redfishRoutes.Post("/{id}/VirtualMedia/{rid}/Actions/VirtualMedia.InsertMedia", mgr.InsertVirtualMedia)
+	redfishRoutes.Post("/{id}/VirtualMedia/{rid}/Actions/VirtualMedia.EjectMedia", mgr.EjectVirtualMedia)
+	test := httptest.New(t, mockApp)
+	test.POST(
+		"/redfish/v1/Managers/uuid:1/Actions/Manager.Reset",
+	).WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusAccepted).Header("Location").Equal("/taskmon/task12345")
+	test.POST(
+		"/redfish/v1/Managers/uuid:1/VirtualMedia/1/Actions/VirtualMedia.InsertMedia",
+	).WithHeader("X-Auth-Token", "ValidToken").WithJSON(map[string]string{"Image": "http://10.0.0.2/boot.iso"}).Expect().Status(http.StatusAccepted)
+	test.POST(
+		"/redfish/v1/Managers/uuid:1/VirtualMedia/1/Actions/VirtualMedia.EjectMedia",
+	).WithHeader("X-Auth-Token", "").Expect().Status(http.StatusUnauthorized)
+	test.PATCH(
+		"/redfish/v1/Managers/uuid:1/VirtualMedia/1",
+	).WithHeader("X-Auth-Token", "ValidToken").WithBytes([]byte(`{"Image":`)).Expect().Status(http.StatusBadRequest)
+	test.PATCH(
+		"/redfish/v1/Managers/3A/VirtualMedia/1",
+	).WithHeader("X-Auth-Token", "ValidToken").WithJSON(map[string]bool{"Inserted": false}).Expect().Status(http.StatusInternalServerError)
+}
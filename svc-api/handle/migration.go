@@ -0,0 +1,72 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package handle
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	odimErrors "github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	errResponse "github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/svc-api/response"
+	iris "github.com/kataras/iris/v12"
+)
+
+// migrationReportURI is the URI of the report of the DB migrations run by the services
+const migrationReportURI = "/redfish/v1/Oem/Odim/MigrationReport"
+
+// Migration defines Auth which helps with authorization, and GetMigrationReports
+// which fetches the migration reports of the services
+type Migration struct {
+	Auth                func(string, []string, []string) errResponse.RPC
+	GetMigrationReports func() ([]common.MigrationReport, *odimErrors.Error)
+}
+
+// GetMigrationReport gives the migrations of the DB applied by each service, the migration which
+// failed when the service last started, if any, and the schema version of the tables of the service
+func (m *Migration) GetMigrationReport(ctx iris.Context) {
+	if !authorizeLogin(ctx, m.Auth) {
+		return
+	}
+	reports, err := m.GetMigrationReports()
+	if err != nil {
+		errorMessage := "error while trying to get the migration reports: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.JSON(&response.Body)
+		return
+	}
+	sort.Slice(reports, func(i, k int) bool {
+		return reports[i].Service < reports[k].Service
+	})
+	resp := response.MigrationReport{
+		OdataID:     migrationReportURI,
+		ID:          "MigrationReport",
+		Name:        "Migration Report",
+		Description: "Migrations of the DB run by the services when they start",
+		Services:    reports,
+	}
+	var headers = map[string]string{
+		"Allow":             "GET",
+		"Content-type":      "application/json; charset=utf-8",
+		"Cache-Control":     "no-cache",
+		"Transfer-Encoding": "chunked",
+	}
+	SetResponseHeaders(ctx, headers)
+	ctx.JSON(resp)
+}
@@ -0,0 +1,80 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package handle
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	iris "github.com/kataras/iris/v12"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+func mockGetMigrationReports() ([]common.MigrationReport, *errors.Error) {
+	return []common.MigrationReport{
+		{
+			Service: "svc.task",
+			Applied: []persistencemgr.MigrationResult{{Table: "task", Version: 1, Description: "initial schema of the tasks"}},
+			Failed:  []persistencemgr.MigrationResult{},
+			Tables:  []persistencemgr.MigrationStatus{{Table: "task", CurrentVersion: 1, LatestVersion: 1}},
+		},
+		{
+			Service: "svc.events",
+			Applied: []persistencemgr.MigrationResult{{Table: "Subscription", Version: 1}},
+			Failed:  []persistencemgr.MigrationResult{{Table: "Subscription", Version: 2, Error: "migration failed"}},
+			Tables:  []persistencemgr.MigrationStatus{{Table: "Subscription", CurrentVersion: 1, LatestVersion: 2}},
+		},
+	}, nil
+}
+
+func TestGetMigrationReport(t *testing.T) {
+	m := Migration{
+		Auth:                authMock,
+		GetMigrationReports: mockGetMigrationReports,
+	}
+	router := iris.New()
+	redfishRoutes := router.Party("/redfish/v1")
+	redfishRoutes.Get("/Oem/Odim/MigrationReport", m.GetMigrationReport)
+	test := httptest.New(t, router)
+	resp := test.GET("/redfish/v1/Oem/Odim/MigrationReport").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusOK).JSON().Object()
+	resp.Value("@odata.id").Equal("/redfish/v1/Oem/Odim/MigrationReport")
+	services := resp.Value("Services").Array()
+	services.Length().Equal(2)
+	events := services.Element(0).Object()
+	events.Value("Service").Equal("svc.events")
+	events.Value("Applied").Array().Element(0).Object().Value("Version").Equal(1)
+	events.Value("Failed").Array().Element(0).Object().Value("Error").Equal("migration failed")
+	events.Value("Tables").Array().Element(0).Object().Value("LatestVersion").Equal(2)
+	services.Element(1).Object().Value("Service").Equal("svc.task")
+	test.GET("/redfish/v1/Oem/Odim/MigrationReport").Expect().Status(http.StatusUnauthorized)
+	test.GET("/redfish/v1/Oem/Odim/MigrationReport").WithHeader("X-Auth-Token", "invalidToken").Expect().Status(http.StatusUnauthorized)
+}
+
+func TestGetMigrationReportDBError(t *testing.T) {
+	m := Migration{
+		Auth: authMock,
+		GetMigrationReports: func() ([]common.MigrationReport, *errors.Error) {
+			return nil, errors.PackError(errors.DBConnFailed, "connection refused")
+		},
+	}
+	router := iris.New()
+	redfishRoutes := router.Party("/redfish/v1")
+	redfishRoutes.Get("/Oem/Odim/MigrationReport", m.GetMigrationReport)
+	test := httptest.New(t, router)
+	test.GET("/redfish/v1/Oem/Odim/MigrationReport").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusInternalServerError)
+}
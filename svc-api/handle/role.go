@@ -16,22 +16,23 @@
 package handle
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	roleproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/role"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	iris "github.com/kataras/iris/v12"
-	log "github.com/sirupsen/logrus"
 	"net/http"
 )
 
 // RoleRPCs defines all the RPC methods in role
 type RoleRPCs struct {
-	GetAllRolesRPC func(roleproto.GetRoleRequest) (*roleproto.RoleResponse, error)
-	CreateRoleRPC  func(roleproto.RoleRequest) (*roleproto.RoleResponse, error)
-	GetRoleRPC     func(roleproto.GetRoleRequest) (*roleproto.RoleResponse, error)
-	UpdateRoleRPC  func(roleproto.UpdateRoleRequest) (*roleproto.RoleResponse, error)
-	DeleteRoleRPC  func(roleproto.DeleteRoleRequest) (*roleproto.RoleResponse, error)
+	GetAllRolesRPC func(context.Context, roleproto.GetRoleRequest) (*roleproto.RoleResponse, error)
+	CreateRoleRPC  func(context.Context, roleproto.RoleRequest) (*roleproto.RoleResponse, error)
+	GetRoleRPC     func(context.Context, roleproto.GetRoleRequest) (*roleproto.RoleResponse, error)
+	UpdateRoleRPC  func(context.Context, roleproto.UpdateRoleRequest) (*roleproto.RoleResponse, error)
+	DeleteRoleRPC  func(context.Context, roleproto.DeleteRoleRequest) (*roleproto.RoleResponse, error)
 }
 
 // GetAllRoles defines the GetAllRoles iris handler.
@@ -42,17 +43,17 @@ func (r *RoleRPCs) GetAllRoles(ctx iris.Context) {
 	req := roleproto.GetRoleRequest{SessionToken: ctx.Request().Header.Get("X-Auth-Token")}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 
-	resp, err := r.GetAllRolesRPC(req)
+	resp, err := r.GetAllRolesRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -74,7 +75,7 @@ func (r *RoleRPCs) CreateRole(ctx iris.Context) {
 	//Read Body from Request
 	err := ctx.ReadJSON(&req)
 	if err != nil {
-		log.Error("Error while trying to collect data from request: " + err.Error())
+		logs.Log(ctx.Request().Context()).Error("Error while trying to collect data from request: " + err.Error())
 		errorMessage := "error while trying to get JSON body from the account create request body: " + err.Error()
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest)
@@ -85,7 +86,7 @@ func (r *RoleRPCs) CreateRole(ctx iris.Context) {
 	sessionToken := ctx.Request().Header.Get("X-Auth-Token")
 	if sessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -99,10 +100,10 @@ func (r *RoleRPCs) CreateRole(ctx iris.Context) {
 		RequestBody:  request,
 	}
 
-	resp, err := r.CreateRoleRPC(roleRequest)
+	resp, err := r.CreateRoleRPC(ctx.Request().Context(), roleRequest)
 	if err != nil {
 		errorMessage := "RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -123,7 +124,7 @@ func (r *RoleRPCs) GetRole(ctx iris.Context) {
 	req := roleproto.GetRoleRequest{SessionToken: ctx.Request().Header.Get("X-Auth-Token")}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -131,10 +132,10 @@ func (r *RoleRPCs) GetRole(ctx iris.Context) {
 	}
 	req.Id = ctx.Params().Get("id")
 
-	resp, err := r.GetRoleRPC(req)
+	resp, err := r.GetRoleRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -159,7 +160,7 @@ func (r *RoleRPCs) UpdateRole(ctx iris.Context) {
 	var roleReq interface{}
 	err := ctx.ReadJSON(&roleReq)
 	if err != nil {
-		log.Error("Error while trying to collect data from request: " + err.Error())
+		logs.Log(ctx.Request().Context()).Error("Error while trying to collect data from request: " + err.Error())
 		errorMessage := "error while trying to get JSON body from the role update request body: " + err.Error()
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest)
@@ -170,18 +171,19 @@ func (r *RoleRPCs) UpdateRole(ctx iris.Context) {
 	req.SessionToken = ctx.Request().Header.Get("X-Auth-Token")
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 	req.Id = ctx.Params().Get("id")
+	req.IfMatch = ctx.GetHeader("If-Match")
 	req.UpdateRequest, _ = json.Marshal(&roleReq)
-	resp, err := r.UpdateRoleRPC(req)
+	resp, err := r.UpdateRoleRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -201,18 +203,19 @@ func (r *RoleRPCs) DeleteRole(ctx iris.Context) {
 	req.SessionToken = ctx.Request().Header.Get("X-Auth-Token")
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
 	req.ID = ctx.Params().Get("id")
+	req.IfMatch = ctx.GetHeader("If-Match")
 
-	resp, err := r.DeleteRoleRPC(req)
+	resp, err := r.DeleteRoleRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -23,45 +24,45 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func mockGetAllRolesRPC(roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
+func mockGetAllRolesRPC(context.Context, roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
 	return &roleproto.RoleResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
-func mockGetAllRolesRPCWithRPCError(roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
+func mockGetAllRolesRPCWithRPCError(context.Context, roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
 	return &roleproto.RoleResponse{}, errors.New("Unable to RPC Call")
 }
 
-func mockCreateRoleRPC(roleproto.RoleRequest) (*roleproto.RoleResponse, error) {
+func mockCreateRoleRPC(context.Context, roleproto.RoleRequest) (*roleproto.RoleResponse, error) {
 	return &roleproto.RoleResponse{
 		StatusCode: http.StatusCreated,
 	}, nil
 }
 
-func mockCreateRoleRPCWithRPCError(roleproto.RoleRequest) (*roleproto.RoleResponse, error) {
+func mockCreateRoleRPCWithRPCError(context.Context, roleproto.RoleRequest) (*roleproto.RoleResponse, error) {
 	return &roleproto.RoleResponse{}, errors.New("Unable to RPC Call")
 }
 
-func mockGetRoleRPC(roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
+func mockGetRoleRPC(context.Context, roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
 	return &roleproto.RoleResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
 
-func mockGetRoleRPCWithRPCError(roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
+func mockGetRoleRPCWithRPCError(context.Context, roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
 	return &roleproto.RoleResponse{}, errors.New("Unable to RPC Call")
 }
 
-func mockUpdateRoleRPC(roleproto.UpdateRoleRequest) (*roleproto.RoleResponse, error) {
+func mockUpdateRoleRPC(context.Context, roleproto.UpdateRoleRequest) (*roleproto.RoleResponse, error) {
 	return &roleproto.RoleResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
 
-func mockUpdateRoleRPCWithRPCError(roleproto.UpdateRoleRequest) (*roleproto.RoleResponse, error) {
+func mockUpdateRoleRPCWithRPCError(context.Context, roleproto.UpdateRoleRequest) (*roleproto.RoleResponse, error) {
 	return &roleproto.RoleResponse{}, errors.New("Unable to RPC Call")
 }
-func mockDeleteRoleRPC(roleproto.DeleteRoleRequest) (*roleproto.RoleResponse, error) {
+func mockDeleteRoleRPC(context.Context, roleproto.DeleteRoleRequest) (*roleproto.RoleResponse, error) {
 	return &roleproto.RoleResponse{
 		StatusCode: http.StatusOK,
 	}, nil
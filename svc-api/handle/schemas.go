@@ -0,0 +1,332 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package handle
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	odimErrors "github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	errResponse "github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/lib-utilities/schemavalidation"
+	"github.com/ODIM-Project/ODIM/svc-api/models"
+	"github.com/ODIM-Project/ODIM/svc-api/response"
+	iris "github.com/kataras/iris/v12"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// schemaStoreURI is the URI under which the files of the schema store are served
+	schemaStoreURI = "/redfish/v1/SchemaStore/en/"
+	// jsonSchemasURI is the URI of the JsonSchemaFile collection
+	jsonSchemasURI = "/redfish/v1/JsonSchemas"
+	// schemaValidationReportURI is the URI of the report of the schema violations found in the plugin responses
+	schemaValidationReportURI = "/redfish/v1/Oem/Odim/SchemaValidationReport"
+)
+
+var (
+	errSchemaStoreNotConfigured = errors.New("SchemaStorePath is not configured")
+	errInvalidSchemaFileName    = errors.New("invalid schema file name")
+)
+
+// JSONSchema defines Auth which helps with authorization, and GetSchemaViolationReports
+// which fetches the schema violation reports of the resources returned by the plugins
+type JSONSchema struct {
+	Auth                      func(string, []string, []string) errResponse.RPC
+	GetSchemaViolationReports func() ([]schemavalidation.Report, *odimErrors.Error)
+}
+
+// jsonSchemaFile holds the properties of a JSON schema file used in the JsonSchemaFile resource
+type jsonSchemaFile struct {
+	ID    string `json:"$id"`
+	Title string `json:"title"`
+}
+
+// csdlFile holds the namespaces defined by a CSDL schema file
+type csdlFile struct {
+	Schemas []struct {
+		Namespace string `xml:"Namespace,attr"`
+	} `xml:"DataServices>Schema"`
+}
+
+// GetJSONSchemaFileCollection builds the collection of the JSON schema files available in the schema store
+func (j *JSONSchema) GetJSONSchemaFileCollection(ctx iris.Context) {
+	if !j.authorize(ctx) {
+		return
+	}
+	var headers = map[string]string{
+		"Allow":             "GET",
+		"Cache-Control":     "no-cache",
+		"Link":              "<" + schemaStoreURI + "JsonSchemaFileCollection.json/>; rel=describedby",
+		"Transfer-Encoding": "chunked",
+	}
+	listMembers := []response.ListMember{}
+	for _, schemaFile := range getSchemaStoreFiles(".json") {
+		listMembers = append(listMembers, response.ListMember{
+			OdataID: jsonSchemasURI + "/" + strings.TrimSuffix(schemaFile, ".json"),
+		})
+	}
+	schemaCollectionResp := response.ListResponse{
+		OdataContext: "/redfish/v1/$metadata#JsonSchemaFileCollection.JsonSchemaFileCollection",
+		OdataID:      jsonSchemasURI,
+		OdataType:    "#JsonSchemaFileCollection.JsonSchemaFileCollection",
+		Name:         "JSON Schema File Collection",
+		Description:  "JSON Schema File Repository",
+		MembersCount: len(listMembers),
+		Members:      listMembers,
+	}
+	SetResponseHeaders(ctx, headers)
+	ctx.JSON(schemaCollectionResp)
+}
+
+// GetJSONSchemaFile gives the detailed information about a JSON schema file and its locations
+func (j *JSONSchema) GetJSONSchemaFile(ctx iris.Context) {
+	if !j.authorize(ctx) {
+		return
+	}
+	schemaFileID := ctx.Params().Get("id")
+	content, err := readSchemaStoreFile(schemaFileID + ".json")
+	if err != nil {
+		logs.Log(ctx.Request().Context()).Error("error while reading the JSON schema file " + schemaFileID + ": " + err.Error())
+		fillSchemaFileNotFoundResponse(ctx, "JsonSchemaFile", schemaFileID)
+		return
+	}
+	var schemaFile jsonSchemaFile
+	if err := json.Unmarshal(content, &schemaFile); err != nil {
+		logs.Log(ctx.Request().Context()).Error("error while trying to unmarshal the JSON schema file " + schemaFileID + ": " + err.Error())
+	}
+	var headers = map[string]string{
+		"Allow":             "GET",
+		"Content-type":      "application/json; charset=utf-8",
+		"Cache-Control":     "no-cache",
+		"Link":              "<" + schemaStoreURI + "JsonSchemaFile.json/>; rel=describedby",
+		"Transfer-Encoding": "chunked",
+	}
+	resp := response.JSONSchemaFile{
+		ID:           schemaFileID,
+		OdataContext: "/redfish/v1/$metadata#JsonSchemaFile.JsonSchemaFile",
+		OdataID:      jsonSchemasURI + "/" + schemaFileID,
+		OdataType:    "#JsonSchemaFile.v1_1_4.JsonSchemaFile",
+		Name:         schemaFileID + " Schema File",
+		Description:  schemaFileID + " Schema File Location",
+		Languages:    []string{"en"},
+		Schema:       schemaFile.Title,
+		Location: []response.Location{
+			response.Location{
+				Language:       "en",
+				PublicationURI: schemaFile.ID,
+				URI:            schemaStoreURI + schemaFileID + ".json",
+			},
+		},
+	}
+	SetResponseHeaders(ctx, headers)
+	ctx.JSON(resp)
+}
+
+// GetSchemaStoreFile retrieves a JSON schema or CSDL file of the schema store
+func (j *JSONSchema) GetSchemaStoreFile(ctx iris.Context) {
+	if !j.authorize(ctx) {
+		return
+	}
+	schemaFileName := strings.TrimSuffix(ctx.Params().Get("file"), "/")
+	content, err := readSchemaStoreFile(schemaFileName)
+	if err != nil {
+		logs.Log(ctx.Request().Context()).Error("error while reading the schema file " + schemaFileName + ": " + err.Error())
+		fillSchemaFileNotFoundResponse(ctx, "SchemaFile", schemaFileName)
+		return
+	}
+	contentType := "application/json; charset=utf-8"
+	if strings.HasSuffix(schemaFileName, ".xml") {
+		contentType = "application/xml; charset=utf-8"
+	}
+	var headers = map[string]string{
+		"Allow":             "GET",
+		"Content-type":      contentType,
+		"Cache-Control":     "no-cache",
+		"Transfer-Encoding": "chunked",
+	}
+	SetResponseHeaders(ctx, headers)
+	ctx.Write(content)
+}
+
+// GetSchemaValidationReport gives the schema violations found in the resources returned by the plugins,
+// grouped by plugin. Only the resources which were invalid when they were last retrieved are reported.
+func (j *JSONSchema) GetSchemaValidationReport(ctx iris.Context) {
+	if !j.authorize(ctx) {
+		return
+	}
+	reports, err := j.GetSchemaViolationReports()
+	if err != nil {
+		errorMessage := "error while trying to get the schema violation reports: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusInternalServerError)
+		ctx.JSON(&response.Body)
+		return
+	}
+	sort.Slice(reports, func(i, k int) bool {
+		if reports[i].PluginID != reports[k].PluginID {
+			return reports[i].PluginID < reports[k].PluginID
+		}
+		return reports[i].Resource < reports[k].Resource
+	})
+	mode := config.SchemaValidationOff
+	if config.Data.SchemaValidationConf != nil && config.Data.SchemaValidationConf.Mode != "" {
+		mode = config.Data.SchemaValidationConf.Mode
+	}
+	resp := response.SchemaValidationReport{
+		OdataID:     schemaValidationReportURI,
+		ID:          "SchemaValidationReport",
+		Name:        "Schema Validation Report",
+		Description: "Schema violations found in the resources returned by the plugins",
+		Mode:        mode,
+		Plugins:     []response.PluginSchemaViolations{},
+	}
+	for _, report := range reports {
+		last := len(resp.Plugins) - 1
+		if last < 0 || resp.Plugins[last].PluginID != report.PluginID {
+			resp.Plugins = append(resp.Plugins, response.PluginSchemaViolations{PluginID: report.PluginID})
+			last++
+		}
+		resp.Plugins[last].ResourceCount++
+		resp.Plugins[last].ViolationCount += len(report.Violations)
+		resp.Plugins[last].Resources = append(resp.Plugins[last].Resources, report)
+	}
+	var headers = map[string]string{
+		"Allow":             "GET",
+		"Content-type":      "application/json; charset=utf-8",
+		"Cache-Control":     "no-cache",
+		"Transfer-Encoding": "chunked",
+	}
+	SetResponseHeaders(ctx, headers)
+	ctx.JSON(resp)
+}
+
+// authorize checks the session token of the request has the Login privilege.
+// The error response is written and false is returned if it is not the case.
+func (j *JSONSchema) authorize(ctx iris.Context) bool {
+	return authorizeLogin(ctx, j.Auth)
+}
+
+// authorizeLogin checks with auth that the session token of the request has the Login privilege.
+// The error response is written and false is returned if it is not the case.
+func authorizeLogin(ctx iris.Context, auth func(string, []string, []string) errResponse.RPC) bool {
+	sessionToken := ctx.Request().Header.Get("X-Auth-Token")
+	if sessionToken == "" {
+		errorMessage := "error: no X-Auth-Token found in request header"
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
+		response := common.GeneralError(http.StatusUnauthorized, errResponse.NoValidSession, errorMessage, nil, nil)
+		ctx.StatusCode(http.StatusUnauthorized)
+		ctx.JSON(&response.Body)
+		return false
+	}
+	authResp := auth(sessionToken, []string{common.PrivilegeLogin}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		logs.Log(ctx.Request().Context()).Error("error while trying to authorize token")
+		ctx.StatusCode(int(authResp.StatusCode))
+		SetResponseHeaders(ctx, authResp.Header)
+		ctx.JSON(authResp.Body)
+		return false
+	}
+	return true
+}
+
+// fillSchemaFileNotFoundResponse writes the 404 error response for a schema file missing in the schema store
+func fillSchemaFileNotFoundResponse(ctx iris.Context, resourceType, resourceID string) {
+	errorMessage := "error: resource not found"
+	response := common.GeneralError(http.StatusNotFound, errResponse.ResourceNotFound, errorMessage, []interface{}{resourceType, resourceID}, nil)
+	SetResponseHeaders(ctx, map[string]string{
+		"Content-type": "application/json; charset=utf-8",
+	})
+	ctx.StatusCode(http.StatusNotFound)
+	ctx.JSON(&response.Body)
+}
+
+// getSchemaStoreFiles returns the names of the files of the schema store with the given extension.
+// Hidden files are skipped, and no file is returned if the schema store is not configured.
+func getSchemaStoreFiles(extension string) []string {
+	if config.Data.SchemaStorePath == "" {
+		return nil
+	}
+	files, err := ioutil.ReadDir(config.Data.SchemaStorePath)
+	if err != nil {
+		log.Error("error while reading the schema store: " + err.Error())
+		return nil
+	}
+	var fileNames []string
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || !strings.HasSuffix(file.Name(), extension) {
+			continue
+		}
+		fileNames = append(fileNames, file.Name())
+	}
+	return fileNames
+}
+
+// readSchemaStoreFile reads a file of the schema store. Only the JSON schema and CSDL files
+// directly under the schema store can be read.
+func readSchemaStoreFile(fileName string) ([]byte, error) {
+	if config.Data.SchemaStorePath == "" {
+		return nil, errSchemaStoreNotConfigured
+	}
+	if fileName != filepath.Base(fileName) || strings.HasPrefix(fileName, ".") ||
+		!(strings.HasSuffix(fileName, ".json") || strings.HasSuffix(fileName, ".xml")) {
+		return nil, errInvalidSchemaFileName
+	}
+	return ioutil.ReadFile(filepath.Join(config.Data.SchemaStorePath, fileName))
+}
+
+// getMetadata builds the $metadata document referencing the namespaces of the CSDL files of the schema store.
+// The document has no reference when the schema store is not configured or has no CSDL file.
+func getMetadata() models.Metadata {
+	metadata := models.Metadata{
+		Version:      "4.0",
+		Xmlnsedmx:    "http://docs.oasis-open.org/odata/ns/edmx",
+		TopReference: []models.Reference{},
+	}
+	for _, schemaFile := range getSchemaStoreFiles(".xml") {
+		content, err := readSchemaStoreFile(schemaFile)
+		if err != nil {
+			log.Error("error while reading the CSDL file " + schemaFile + ": " + err.Error())
+			continue
+		}
+		var csdl csdlFile
+		if err := xml.Unmarshal(content, &csdl); err != nil {
+			log.Error("error while trying to unmarshal the CSDL file " + schemaFile + ": " + err.Error())
+			continue
+		}
+		if len(csdl.Schemas) == 0 {
+			continue
+		}
+		reference := models.Reference{URI: schemaStoreURI + schemaFile}
+		for _, schema := range csdl.Schemas {
+			reference.TopInclude = append(reference.TopInclude, models.Include{Namespace: schema.Namespace})
+		}
+		metadata.TopReference = append(metadata.TopReference, reference)
+	}
+	if len(metadata.TopReference) == 0 {
+		log.Warn("no CSDL file found in the schema store, the $metadata document has no reference")
+	}
+	return metadata
+}
@@ -0,0 +1,202 @@
+// (C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+package handle
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/schemavalidation"
+	iris "github.com/kataras/iris/v12"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+const (
+	testJSONSchema = `{
+    "$id": "http://redfish.dmtf.org/schemas/v1/ServiceRoot.v1_9_0.json",
+    "title": "#ServiceRoot.v1_9_0.ServiceRoot"
+}`
+	testCSDLSchema = `<?xml version="1.0" encoding="UTF-8"?>
+<edmx:Edmx xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx" Version="4.0">
+  <edmx:DataServices>
+    <Schema xmlns="http://docs.oasis-open.org/odata/ns/edm" Namespace="ServiceRoot"/>
+    <Schema xmlns="http://docs.oasis-open.org/odata/ns/edm" Namespace="ServiceRoot.v1_9_0"/>
+  </edmx:DataServices>
+</edmx:Edmx>`
+)
+
+// setUpMockSchemaStore creates a schema store with a JSON schema and a CSDL file
+func setUpMockSchemaStore(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "schemastore")
+	if err != nil {
+		t.Fatalf("error while creating the schema store: %v", err)
+	}
+	files := map[string]string{
+		"ServiceRoot.v1_9_0.json": testJSONSchema,
+		"ServiceRoot_v1.xml":      testCSDLSchema,
+		".hidden.json":            testJSONSchema,
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("error while writing the schema file %v: %v", name, err)
+		}
+	}
+	config.Data.SchemaStorePath = dir
+	return func() {
+		config.Data.SchemaStorePath = ""
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGetJSONSchemaFileCollection(t *testing.T) {
+	defer setUpMockSchemaStore(t)()
+	j := JSONSchema{
+		Auth: authMock,
+	}
+	router := iris.New()
+	redfishRoutes := router.Party("/redfish/v1")
+	redfishRoutes.Get("/JsonSchemas", j.GetJSONSchemaFileCollection)
+	test := httptest.New(t, router)
+	resp := test.GET("/redfish/v1/JsonSchemas").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusOK).JSON().Object()
+	resp.Value("Members@odata.count").Equal(1)
+	resp.Value("Members").Array().Element(0).Object().Value("@odata.id").Equal("/redfish/v1/JsonSchemas/ServiceRoot.v1_9_0")
+	test.GET("/redfish/v1/JsonSchemas").Expect().Status(http.StatusUnauthorized)
+	test.GET("/redfish/v1/JsonSchemas").WithHeader("X-Auth-Token", "invalidToken").Expect().Status(http.StatusUnauthorized)
+}
+
+func TestGetJSONSchemaFile(t *testing.T) {
+	defer setUpMockSchemaStore(t)()
+	j := JSONSchema{
+		Auth: authMock,
+	}
+	router := iris.New()
+	redfishRoutes := router.Party("/redfish/v1")
+	redfishRoutes.Get("/JsonSchemas/{id}", j.GetJSONSchemaFile)
+	test := httptest.New(t, router)
+	resp := test.GET("/redfish/v1/JsonSchemas/ServiceRoot.v1_9_0").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusOK).JSON().Object()
+	resp.Value("Schema").Equal("#ServiceRoot.v1_9_0.ServiceRoot")
+	location := resp.Value("Location").Array().Element(0).Object()
+	location.Value("Uri").Equal("/redfish/v1/SchemaStore/en/ServiceRoot.v1_9_0.json")
+	location.Value("PublicationUri").Equal("http://redfish.dmtf.org/schemas/v1/ServiceRoot.v1_9_0.json")
+	test.GET("/redfish/v1/JsonSchemas/UnknownID").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusNotFound)
+	test.GET("/redfish/v1/JsonSchemas/.hidden").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusNotFound)
+	test.GET("/redfish/v1/JsonSchemas/ServiceRoot.v1_9_0").Expect().Status(http.StatusUnauthorized)
+	test.GET("/redfish/v1/JsonSchemas/ServiceRoot.v1_9_0").WithHeader("X-Auth-Token", "invalidToken").Expect().Status(http.StatusUnauthorized)
+}
+
+func TestGetSchemaStoreFile(t *testing.T) {
+	defer setUpMockSchemaStore(t)()
+	j := JSONSchema{
+		Auth: authMock,
+	}
+	router := iris.New()
+	redfishRoutes := router.Party("/redfish/v1")
+	redfishRoutes.Get("/SchemaStore/en/{file}", j.GetSchemaStoreFile)
+	test := httptest.New(t, router)
+	test.GET("/redfish/v1/SchemaStore/en/ServiceRoot.v1_9_0.json").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusOK).Body().Equal(testJSONSchema)
+	test.GET("/redfish/v1/SchemaStore/en/ServiceRoot_v1.xml").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusOK).ContentType("application/xml")
+	test.GET("/redfish/v1/SchemaStore/en/Unknown.json").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusNotFound)
+	test.GET("/redfish/v1/SchemaStore/en/..%2Fpasswd.json").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusNotFound)
+	test.GET("/redfish/v1/SchemaStore/en/ServiceRoot.v1_9_0.json").Expect().Status(http.StatusUnauthorized)
+	test.GET("/redfish/v1/SchemaStore/en/ServiceRoot.v1_9_0.json").WithHeader("X-Auth-Token", "invalidToken").Expect().Status(http.StatusUnauthorized)
+
+	config.Data.SchemaStorePath = ""
+	test.GET("/redfish/v1/SchemaStore/en/ServiceRoot.v1_9_0.json").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusNotFound)
+}
+
+// TestSchemaMethodNotAllowed is the unit test method for SchemaMethodNotAllowed func.
+func mockGetSchemaViolationReports() ([]schemavalidation.Report, *errors.Error) {
+	return []schemavalidation.Report{
+		{
+			Resource:   "/redfish/v1/Systems/uuid:1",
+			PluginID:   "GRF",
+			Violations: []schemavalidation.Violation{{Property: "/Status/Health", Type: "enum"}},
+		},
+		{
+			Resource:   "/redfish/v1/Chassis/uuid2:1",
+			PluginID:   "ILO",
+			Violations: []schemavalidation.Violation{{Property: "/Oem"}, {Property: "/Links"}},
+		},
+		{
+			Resource:   "/redfish/v1/Chassis/uuid:1",
+			PluginID:   "GRF",
+			Violations: []schemavalidation.Violation{{Property: "/PowerState", Type: "enum"}},
+		},
+	}, nil
+}
+
+func TestGetSchemaValidationReport(t *testing.T) {
+	config.Data.SchemaValidationConf = &config.SchemaValidationConf{Mode: config.SchemaValidationReport}
+	defer func() {
+		config.Data.SchemaValidationConf = nil
+	}()
+	j := JSONSchema{
+		Auth:                      authMock,
+		GetSchemaViolationReports: mockGetSchemaViolationReports,
+	}
+	router := iris.New()
+	redfishRoutes := router.Party("/redfish/v1")
+	redfishRoutes.Get("/Oem/Odim/SchemaValidationReport", j.GetSchemaValidationReport)
+	test := httptest.New(t, router)
+	resp := test.GET("/redfish/v1/Oem/Odim/SchemaValidationReport").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusOK).JSON().Object()
+	resp.Value("Mode").Equal(config.SchemaValidationReport)
+	plugins := resp.Value("Plugins").Array()
+	plugins.Length().Equal(2)
+	grf := plugins.Element(0).Object()
+	grf.Value("PluginID").Equal("GRF")
+	grf.Value("ResourceCount").Equal(2)
+	grf.Value("ViolationCount").Equal(2)
+	grf.Value("Resources").Array().Element(0).Object().Value("Resource").Equal("/redfish/v1/Chassis/uuid:1")
+	plugins.Element(1).Object().Value("ViolationCount").Equal(2)
+	test.GET("/redfish/v1/Oem/Odim/SchemaValidationReport").Expect().Status(http.StatusUnauthorized)
+	test.GET("/redfish/v1/Oem/Odim/SchemaValidationReport").WithHeader("X-Auth-Token", "invalidToken").Expect().Status(http.StatusUnauthorized)
+}
+
+func TestGetSchemaValidationReportDBError(t *testing.T) {
+	j := JSONSchema{
+		Auth: authMock,
+		GetSchemaViolationReports: func() ([]schemavalidation.Report, *errors.Error) {
+			return nil, errors.PackError(errors.DBConnFailed, "connection refused")
+		},
+	}
+	router := iris.New()
+	redfishRoutes := router.Party("/redfish/v1")
+	redfishRoutes.Get("/Oem/Odim/SchemaValidationReport", j.GetSchemaValidationReport)
+	test := httptest.New(t, router)
+	test.GET("/redfish/v1/Oem/Odim/SchemaValidationReport").WithHeader("X-Auth-Token", "validToken").Expect().Status(http.StatusInternalServerError)
+}
+
+func TestSchemaMethodNotAllowed(t *testing.T) {
+	router := iris.New()
+	redfishRoutes := router.Party("/redfish/v1")
+	redfishRoutes.Any("/JsonSchemas", SchemaMethodNotAllowed)
+	redfishRoutes.Any("/JsonSchemas/{id}", SchemaMethodNotAllowed)
+	redfishRoutes.Any("/SchemaStore/en/{file}", SchemaMethodNotAllowed)
+	redfishRoutes.Any("/Oem/Odim/SchemaValidationReport", SchemaMethodNotAllowed)
+	e := httptest.New(t, router)
+
+	//Check for status code 405 for http methods which are not allowed on schema URLs
+	for _, uri := range []string{"/redfish/v1/JsonSchemas", "/redfish/v1/JsonSchemas/ServiceRoot.v1_9_0", "/redfish/v1/SchemaStore/en/ServiceRoot.v1_9_0.json",
+		"/redfish/v1/Oem/Odim/SchemaValidationReport"} {
+		e.POST(uri).Expect().Status(http.StatusMethodNotAllowed)
+		e.PUT(uri).Expect().Status(http.StatusMethodNotAllowed)
+		e.PATCH(uri).Expect().Status(http.StatusMethodNotAllowed)
+		e.DELETE(uri).Expect().Status(http.StatusMethodNotAllowed)
+	}
+}
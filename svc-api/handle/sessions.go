@@ -16,11 +16,12 @@
 package handle
 
 import (
+	"context"
 	"encoding/json"
-	log "github.com/sirupsen/logrus"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	sessionproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/session"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	iris "github.com/kataras/iris/v12"
@@ -28,11 +29,11 @@ import (
 
 // SessionRPCs defines all the RPC methods in session service
 type SessionRPCs struct {
-	CreateSessionRPC        func(sessionproto.SessionCreateRequest) (*sessionproto.SessionCreateResponse, error)
-	DeleteSessionRPC        func(string, string) (*sessionproto.SessionResponse, error)
-	GetSessionRPC           func(string, string) (*sessionproto.SessionResponse, error)
-	GetAllActiveSessionsRPC func(string, string) (*sessionproto.SessionResponse, error)
-	GetSessionServiceRPC    func() (*sessionproto.SessionResponse, error)
+	CreateSessionRPC        func(context.Context, sessionproto.SessionCreateRequest) (*sessionproto.SessionCreateResponse, error)
+	DeleteSessionRPC        func(context.Context, string, string) (*sessionproto.SessionResponse, error)
+	GetSessionRPC           func(context.Context, string, string) (*sessionproto.SessionResponse, error)
+	GetAllActiveSessionsRPC func(context.Context, string, string) (*sessionproto.SessionResponse, error)
+	GetSessionServiceRPC    func(ctx context.Context) (*sessionproto.SessionResponse, error)
 }
 
 // CreateSession defines the Create session iris handler
@@ -43,8 +44,8 @@ func (s *SessionRPCs) CreateSession(ctx iris.Context) {
 	var req interface{}
 	err := ctx.ReadJSON(&req)
 	if err != nil {
-		errorMessage := "error while trying to get JSON body from the session create request body: %v" + err.Error()
-		log.Printf(errorMessage)
+		errorMessage := "error while trying to get JSON body from the session create request body: " + err.Error()
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -58,11 +59,11 @@ func (s *SessionRPCs) CreateSession(ctx iris.Context) {
 		RequestBody: request,
 	}
 
-	resp, err := s.CreateSessionRPC(createRequest)
+	resp, err := s.CreateSessionRPC(ctx.Request().Context(), createRequest)
 
 	if err != nil && resp == nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -87,17 +88,17 @@ func (s *SessionRPCs) DeleteSession(ctx iris.Context) {
 	sessionToken := ctx.Request().Header.Get("X-Auth-Token")
 	if sessionToken == "" {
 		errorMessage := "error: session token is missing"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response)
 		return
 	}
 
-	resp, err := s.DeleteSessionRPC(sessionID, sessionToken)
+	resp, err := s.DeleteSessionRPC(ctx.Request().Context(), sessionID, sessionToken)
 	if err != nil && resp == nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -117,10 +118,10 @@ func (s *SessionRPCs) GetSession(ctx iris.Context) {
 	sessionID := ctx.Params().Get("sessionID")
 	sessionToken := ctx.Request().Header.Get("X-Auth-Token")
 
-	resp, err := s.GetSessionRPC(sessionID, sessionToken)
+	resp, err := s.GetSessionRPC(ctx.Request().Context(), sessionID, sessionToken)
 	if err != nil && resp == nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -144,10 +145,10 @@ func (s *SessionRPCs) GetAllActiveSessions(ctx iris.Context) {
 	sessionID := ctx.Params().Get("sessionID")
 	sessionToken := ctx.Request().Header.Get("X-Auth-Token")
 
-	resp, err := s.GetAllActiveSessionsRPC(sessionID, sessionToken)
+	resp, err := s.GetAllActiveSessionsRPC(ctx.Request().Context(), sessionID, sessionToken)
 	if err != nil && resp == nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -160,10 +161,10 @@ func (s *SessionRPCs) GetAllActiveSessions(ctx iris.Context) {
 
 // GetSessionService will do the rpc call to get session service
 func (s *SessionRPCs) GetSessionService(ctx iris.Context) {
-	resp, err := s.GetSessionServiceRPC()
+	resp, err := s.GetSessionServiceRPC(ctx.Request().Context())
 	if err != nil && resp == nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -23,47 +24,47 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func mockCreateSessionRPC(req sessionproto.SessionCreateRequest) (*sessionproto.SessionCreateResponse, error) {
+func mockCreateSessionRPC(ctx context.Context, req sessionproto.SessionCreateRequest) (*sessionproto.SessionCreateResponse, error) {
 	return &sessionproto.SessionCreateResponse{
 		StatusCode: http.StatusCreated,
 		Header:     map[string]string{"Location": "sample"},
 	}, nil
 }
 
-func mockCreateSessionRPCError(req sessionproto.SessionCreateRequest) (*sessionproto.SessionCreateResponse, error) {
+func mockCreateSessionRPCError(ctx context.Context, req sessionproto.SessionCreateRequest) (*sessionproto.SessionCreateResponse, error) {
 	return nil, errors.New("RPC Error")
 }
 
-func mockDeleteSessionRPC(sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
+func mockDeleteSessionRPC(ctx context.Context, sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
 	return &sessionproto.SessionResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
 
-func mockSessionRPCError(sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
+func mockSessionRPCError(ctx context.Context, sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
 	return nil, errors.New("RPC Error")
 }
 
-func mockGetSessionRPC(sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
+func mockGetSessionRPC(ctx context.Context, sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
 	return &sessionproto.SessionResponse{
 		StatusCode: http.StatusOK,
 		Header:     map[string]string{"Location": "sample"},
 	}, nil
 }
 
-func mockGetAllActiveSessionsRPC(sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
+func mockGetAllActiveSessionsRPC(ctx context.Context, sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
 	return &sessionproto.SessionResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
 
-func mockGetSessionServiceRPC() (*sessionproto.SessionResponse, error) {
+func mockGetSessionServiceRPC(ctx context.Context) (*sessionproto.SessionResponse, error) {
 	return &sessionproto.SessionResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
 
-func mockGetSessionServiceRPCError() (*sessionproto.SessionResponse, error) {
+func mockGetSessionServiceRPCError(ctx context.Context) (*sessionproto.SessionResponse, error) {
 	return nil, errors.New("RPC Error")
 }
 
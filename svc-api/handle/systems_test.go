@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -23,7 +24,7 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func mockGetSystemRequest(req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
+func mockGetSystemRequest(ctx context.Context, req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
 	var response = &systemsproto.SystemsResponse{}
 	if req.URL == "/redfish/v1/Systems/1A" && req.SessionToken == "ValidToken" {
 		response = &systemsproto.SystemsResponse{
@@ -55,7 +56,7 @@ func mockGetSystemRequest(req systemsproto.GetSystemsRequest) (*systemsproto.Sys
 	return response, nil
 }
 
-func mockGetSystemsCollection(req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
+func mockGetSystemsCollection(ctx context.Context, req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
 	var response = &systemsproto.SystemsResponse{}
 	if req.URL == "/redfish/v1/Systems" && req.SessionToken == "ValidToken" {
 		response = &systemsproto.SystemsResponse{
@@ -159,13 +160,13 @@ func TestGetSystem_InvalidToken(t *testing.T) {
 	).WithHeader("X-Auth-Token", "InvalidToken").Expect().Status(http.StatusUnauthorized)
 }
 
-func mockGetSystemResource(systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
+func mockGetSystemResource(context.Context, systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
 	return &systemsproto.SystemsResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
 
-func mockGetSystemResourceRPCError(systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
+func mockGetSystemResourceRPCError(context.Context, systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
 	return &systemsproto.SystemsResponse{}, errors.New("RPC Error")
 }
 
@@ -208,7 +209,7 @@ func TestSystemRPCs_GetSystemResourceWithoutToken(t *testing.T) {
 	).Expect().Status(http.StatusUnauthorized)
 }
 
-func mockChangeBiosSettings(req systemsproto.BiosSettingsRequest) (*systemsproto.SystemsResponse, error) {
+func mockChangeBiosSettings(ctx context.Context, req systemsproto.BiosSettingsRequest) (*systemsproto.SystemsResponse, error) {
 	var response = &systemsproto.SystemsResponse{}
 	if req.SessionToken == "" {
 		response = &systemsproto.SystemsResponse{
@@ -298,7 +299,7 @@ func TestChangeBiosSettingsNegativeTestCases(t *testing.T) {
 	).WithJSON(map[string]string{"Sample": "Body"}).WithHeader("X-Auth-Token", "TokenRPC").Expect().Status(http.StatusInternalServerError)
 }
 
-func mockChangeBootOrderSettings(req systemsproto.BootOrderSettingsRequest) (*systemsproto.SystemsResponse, error) {
+func mockChangeBootOrderSettings(ctx context.Context, req systemsproto.BootOrderSettingsRequest) (*systemsproto.SystemsResponse, error) {
 	var response = &systemsproto.SystemsResponse{}
 	if req.SessionToken == "" {
 		response = &systemsproto.SystemsResponse{
@@ -369,7 +370,7 @@ func TestChangeBootOrderSettingsNegativeTestCases(t *testing.T) {
 	).WithHeader("X-Auth-Token", "Token").Expect().Status(http.StatusBadRequest)
 }
 
-func mockComputerSystemReset(req systemsproto.ComputerSystemResetRequest) (*systemsproto.SystemsResponse, error) {
+func mockComputerSystemReset(ctx context.Context, req systemsproto.ComputerSystemResetRequest) (*systemsproto.SystemsResponse, error) {
 	var response = &systemsproto.SystemsResponse{}
 	if req.SessionToken == "" {
 		response = &systemsproto.SystemsResponse{
@@ -421,7 +422,7 @@ func TestComputerSystemResetWithValidData(t *testing.T) {
 	).WithJSON(map[string]string{"Sample": "Body"}).WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusOK)
 }
 
-func mockSetDefaultBootOrder(req systemsproto.DefaultBootOrderRequest) (*systemsproto.SystemsResponse, error) {
+func mockSetDefaultBootOrder(ctx context.Context, req systemsproto.DefaultBootOrderRequest) (*systemsproto.SystemsResponse, error) {
 	var response = &systemsproto.SystemsResponse{}
 	if req.SessionToken == "" {
 		response = &systemsproto.SystemsResponse{
@@ -471,7 +472,7 @@ func TestSetDefaultBootOrderWithValidData(t *testing.T) {
 }
 
 // Create volume unit tests
-func mockCreateVolume(req systemsproto.VolumeRequest) (*systemsproto.SystemsResponse, error) {
+func mockCreateVolume(ctx context.Context, req systemsproto.VolumeRequest) (*systemsproto.SystemsResponse, error) {
 	var response = &systemsproto.SystemsResponse{}
 	if req.SessionToken == "" {
 		response = &systemsproto.SystemsResponse{
@@ -561,7 +562,7 @@ func TestCreateVolumeNegativeTestCases(t *testing.T) {
 	).WithJSON(map[string]string{"Sample": "Body"}).WithHeader("X-Auth-Token", "TokenRPC").Expect().Status(http.StatusInternalServerError)
 }
 
-func mockDeleteVolume(req systemsproto.VolumeRequest) (*systemsproto.SystemsResponse, error) {
+func mockDeleteVolume(ctx context.Context, req systemsproto.VolumeRequest) (*systemsproto.SystemsResponse, error) {
 	var response = &systemsproto.SystemsResponse{}
 	if req.SessionToken == "" {
 		response = &systemsproto.SystemsResponse{
@@ -626,3 +627,72 @@ func TestDeleteVolume(t *testing.T) {
 		"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1/Storage/ArrayControllers-0/Volumes/2",
 	).WithJSON(map[string]string{"Sample": "Body"}).WithHeader("X-Auth-Token", "TokenRPC").Expect().Status(http.StatusInternalServerError)
 }
+
+func mockPassThrough(ctx context.Context, req systemsproto.PassThroughRequest) (*systemsproto.SystemsResponse, error) {
+	if req.SessionToken == "TokenRPC" {
+		return &systemsproto.SystemsResponse{}, errors.New("Unable to RPC Call")
+	}
+	if req.SessionToken != "ValidToken" {
+		return &systemsproto.SystemsResponse{
+			StatusCode:    http.StatusUnauthorized,
+			StatusMessage: "Unauthorized",
+			Body:          []byte(`{"Response":"Unauthorized"}`),
+		}, nil
+	}
+	if req.URL != "/redfish/v1/Chassis/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1/Controls/1" {
+		return &systemsproto.SystemsResponse{
+			StatusCode:    http.StatusNotFound,
+			StatusMessage: "NotFound",
+			Body:          []byte(`{"Response":"NotFound"}`),
+		}, nil
+	}
+	if req.Method == http.MethodPatch {
+		if string(req.RequestBody) != `{"SetPoint":50}` {
+			return &systemsproto.SystemsResponse{
+				StatusCode:    http.StatusBadRequest,
+				StatusMessage: "BadRequest",
+				Body:          []byte(`{"Response":"BadRequest"}`),
+			}, nil
+		}
+		return &systemsproto.SystemsResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	return &systemsproto.SystemsResponse{
+		StatusCode:    http.StatusOK,
+		StatusMessage: "Success",
+		Body:          []byte(`{"Response":"Success"}`),
+	}, nil
+}
+
+func TestPassThrough(t *testing.T) {
+	var sys SystemRPCs
+	sys.PassThroughRPC = mockPassThrough
+	sys.GetSystemResourceRPC = mockGetSystemResource
+	mockApp := iris.New()
+	redfishRoutes := mockApp.Party("/redfish/v1/Chassis")
+	redfishRoutes.Get("/{id}/Assembly", sys.GetSystemResource)
+	redfishRoutes.Any("/{id}/{resource:path}", sys.PassThrough)
+
+	e := httptest.New(t, mockApp)
+	uri := "/redfish/v1/Chassis/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1/Controls/1"
+	// test with valid token
+	e.GET(uri).WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusOK)
+	e.PATCH(uri).WithBytes([]byte(`{"SetPoint":50}`)).WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusNoContent)
+
+	// test with malformed body
+	e.PATCH(uri).WithBytes([]byte(`{"SetPoint":`)).WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusBadRequest)
+
+	// test with Invalid token
+	e.GET(uri).WithHeader("X-Auth-Token", "InvalidToken").Expect().Status(http.StatusUnauthorized)
+
+	// test without token
+	e.GET(uri).Expect().Status(http.StatusUnauthorized)
+
+	// test with unknown resource
+	e.GET("/redfish/v1/Chassis/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1/Controls/2").WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusNotFound)
+
+	// test with rpc error
+	e.GET(uri).WithHeader("X-Auth-Token", "TokenRPC").Expect().Status(http.StatusInternalServerError)
+
+	// test the routed resources aren't forwarded
+	e.GET("/redfish/v1/Chassis/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1/Assembly").WithHeader("X-Auth-Token", "ValidToken").Expect().Status(http.StatusOK)
+}
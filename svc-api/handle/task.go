@@ -16,10 +16,11 @@
 package handle
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	taskproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/task"
 	errResponse "github.com/ODIM-Project/ODIM/lib-utilities/response"
 	iris "github.com/kataras/iris/v12"
@@ -27,13 +28,13 @@ import (
 
 // TaskRPCs defines all the RPC methods in task service
 type TaskRPCs struct {
-	DeleteTaskRPC     func(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
-	GetTaskRPC        func(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
-	GetSubTasksRPC    func(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
-	GetSubTaskRPC     func(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
-	GetTaskMonitorRPC func(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
-	TaskCollectionRPC func(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
-	GetTaskServiceRPC func(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
+	DeleteTaskRPC     func(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
+	GetTaskRPC        func(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
+	GetSubTasksRPC    func(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
+	GetSubTaskRPC     func(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
+	GetTaskMonitorRPC func(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
+	TaskCollectionRPC func(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
+	GetTaskServiceRPC func(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
 }
 
 // DeleteTask deletes the task with given TaskID
@@ -44,12 +45,12 @@ func (task *TaskRPCs) DeleteTask(ctx iris.Context) {
 		TaskID:       ctx.Params().Get("TaskID"),
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
 	}
-	response, err := task.DeleteTaskRPC(req)
+	response, err := task.DeleteTaskRPC(ctx.Request().Context(), req)
 	common.SetResponseHeader(ctx, response.Header)
 
 	if err != nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -70,12 +71,12 @@ func (task *TaskRPCs) GetTaskStatus(ctx iris.Context) {
 		TaskID:       ctx.Params().Get("TaskID"),
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
 	}
-	response, err := task.GetTaskRPC(req)
+	response, err := task.GetTaskRPC(ctx.Request().Context(), req)
 	common.SetResponseHeader(ctx, response.Header)
 
 	if err != nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -96,12 +97,12 @@ func (task *TaskRPCs) GetSubTasks(ctx iris.Context) {
 		TaskID:       ctx.Params().Get("TaskID"),
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
 	}
-	response, err := task.GetSubTasksRPC(req)
+	response, err := task.GetSubTasksRPC(ctx.Request().Context(), req)
 	common.SetResponseHeader(ctx, response.Header)
 
 	if err != nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -123,12 +124,12 @@ func (task *TaskRPCs) GetSubTask(ctx iris.Context) {
 		SubTaskID:    ctx.Params().Get("subTaskID"),
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
 	}
-	response, err := task.GetSubTaskRPC(req)
+	response, err := task.GetSubTaskRPC(ctx.Request().Context(), req)
 	common.SetResponseHeader(ctx, response.Header)
 
 	if err != nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -149,12 +150,12 @@ func (task *TaskRPCs) GetTaskMonitor(ctx iris.Context) {
 		TaskID:       ctx.Params().Get("TaskID"),
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
 	}
-	response, err := task.GetTaskMonitorRPC(req)
+	response, err := task.GetTaskMonitorRPC(ctx.Request().Context(), req)
 	common.SetResponseHeader(ctx, response.Header)
 
 	if err != nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -173,21 +174,22 @@ func (task *TaskRPCs) GetTaskMonitor(ctx iris.Context) {
 func (task *TaskRPCs) TaskCollection(ctx iris.Context) {
 	req := &taskproto.GetTaskRequest{
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
+		URL:          ctx.Request().RequestURI,
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, errResponse.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	response, err := task.TaskCollectionRPC(req)
+	response, err := task.TaskCollectionRPC(ctx.Request().Context(), req)
 	common.SetResponseHeader(ctx, response.Header)
 
 	if err != nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -207,12 +209,12 @@ func (task *TaskRPCs) GetTaskService(ctx iris.Context) {
 	req := &taskproto.GetTaskRequest{
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
 	}
-	response, err := task.GetTaskServiceRPC(req)
+	response, err := task.GetTaskServiceRPC(ctx.Request().Context(), req)
 	common.SetResponseHeader(ctx, response.Header)
 
 	if err != nil {
 		errorMessage := "RPC error: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
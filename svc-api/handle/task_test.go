@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -23,7 +24,7 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func mockGetTaskStatus(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func mockGetTaskStatus(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	var response = &taskproto.TaskResponse{}
 	if req.TaskID == "1A" && req.SessionToken == "ValidToken" {
 		response = &taskproto.TaskResponse{
@@ -49,7 +50,7 @@ func mockGetTaskStatus(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse,
 	}
 	return response, nil
 }
-func mockTaskCollection(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func mockTaskCollection(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	var response = &taskproto.TaskResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &taskproto.TaskResponse{
@@ -68,7 +69,7 @@ func mockTaskCollection(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse,
 	}
 	return response, nil
 }
-func mockGetTaskService(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func mockGetTaskService(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	var response = &taskproto.TaskResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &taskproto.TaskResponse{
@@ -86,7 +87,7 @@ func mockGetTaskService(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse,
 	}
 	return response, nil
 }
-func mockGetSubTasks(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func mockGetSubTasks(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	var response = &taskproto.TaskResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &taskproto.TaskResponse{
@@ -104,7 +105,7 @@ func mockGetSubTasks(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, er
 	}
 	return response, nil
 }
-func mockGetSubTask(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func mockGetSubTask(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	var response = &taskproto.TaskResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &taskproto.TaskResponse{
@@ -122,7 +123,7 @@ func mockGetSubTask(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, err
 	}
 	return response, nil
 }
-func mockGetTaskMonitor(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func mockGetTaskMonitor(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	var response = &taskproto.TaskResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &taskproto.TaskResponse{
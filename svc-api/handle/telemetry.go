@@ -16,10 +16,11 @@
 package handle
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	telemetryproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/telemetry"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	iris "github.com/kataras/iris/v12"
@@ -27,16 +28,16 @@ import (
 
 // TelemetryRPCs used to define the service RPC function
 type TelemetryRPCs struct {
-	GetTelemetryServiceRPC                 func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
-	GetMetricDefinitionCollectionRPC       func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
-	GetMetricReportDefinitionCollectionRPC func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
-	GetMetricReportCollectionRPC           func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
-	GetTriggerCollectionRPC                func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
-	GetMetricDefinitionRPC                 func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
-	GetMetricReportDefinitionRPC           func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
-	GetMetricReportRPC                     func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
-	GetTriggerRPC                          func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
-	UpdateTriggerRPC                       func(telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	GetTelemetryServiceRPC                 func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	GetMetricDefinitionCollectionRPC       func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	GetMetricReportDefinitionCollectionRPC func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	GetMetricReportCollectionRPC           func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	GetTriggerCollectionRPC                func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	GetMetricDefinitionRPC                 func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	GetMetricReportDefinitionRPC           func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	GetMetricReportRPC                     func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	GetTriggerRPC                          func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
+	UpdateTriggerRPC                       func(context.Context, telemetryproto.TelemetryRequest) (*telemetryproto.TelemetryResponse, error)
 }
 
 // GetTelemetryService is the handler for getting TelemetryService details
@@ -46,16 +47,16 @@ func (a *TelemetryRPCs) GetTelemetryService(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetTelemetryServiceRPC(req)
+	resp, err := a.GetTelemetryServiceRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -75,16 +76,16 @@ func (a *TelemetryRPCs) GetMetricDefinitionCollection(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetMetricDefinitionCollectionRPC(req)
+	resp, err := a.GetMetricDefinitionCollectionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -104,16 +105,16 @@ func (a *TelemetryRPCs) GetMetricReportDefinitionCollection(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetMetricReportDefinitionCollectionRPC(req)
+	resp, err := a.GetMetricReportDefinitionCollectionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -133,16 +134,16 @@ func (a *TelemetryRPCs) GetMetricReportCollection(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetMetricReportCollectionRPC(req)
+	resp, err := a.GetMetricReportCollectionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -162,16 +163,16 @@ func (a *TelemetryRPCs) GetTriggerCollection(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetTriggerCollectionRPC(req)
+	resp, err := a.GetTriggerCollectionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -191,16 +192,16 @@ func (a *TelemetryRPCs) GetMetricDefinition(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetMetricDefinitionRPC(req)
+	resp, err := a.GetMetricDefinitionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -220,16 +221,16 @@ func (a *TelemetryRPCs) GetMetricReportDefinition(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetMetricReportDefinitionRPC(req)
+	resp, err := a.GetMetricReportDefinitionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -249,16 +250,16 @@ func (a *TelemetryRPCs) GetMetricReport(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetMetricReportRPC(req)
+	resp, err := a.GetMetricReportRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -278,16 +279,16 @@ func (a *TelemetryRPCs) GetTrigger(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetTriggerRPC(req)
+	resp, err := a.GetTriggerRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -307,16 +308,16 @@ func (a *TelemetryRPCs) UpdateTrigger(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.UpdateTriggerRPC(req)
+	resp, err := a.UpdateTriggerRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -23,7 +24,7 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func testTelemetryService(req teleproto.TelemetryRequest) (*teleproto.TelemetryResponse, error) {
+func testTelemetryService(ctx context.Context, req teleproto.TelemetryRequest) (*teleproto.TelemetryResponse, error) {
 	var response = &teleproto.TelemetryResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &teleproto.TelemetryResponse{
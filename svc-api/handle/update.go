@@ -16,11 +16,12 @@
 package handle
 
 import (
+	"context"
 	"encoding/json"
-	log "github.com/sirupsen/logrus"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	updateproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/update"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	iris "github.com/kataras/iris/v12"
@@ -28,13 +29,13 @@ import (
 
 // UpdateRPCs used to define the service RPC function
 type UpdateRPCs struct {
-	GetUpdateServiceRPC               func(updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
-	SimpleUpdateRPC                   func(updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
-	StartUpdateRPC                    func(updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
-	GetFirmwareInventoryRPC           func(updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
-	GetFirmwareInventoryCollectionRPC func(updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
-	GetSoftwareInventoryRPC           func(updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
-	GetSoftwareInventoryCollectionRPC func(updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
+	GetUpdateServiceRPC               func(context.Context, updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
+	SimpleUpdateRPC                   func(context.Context, updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
+	StartUpdateRPC                    func(context.Context, updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
+	GetFirmwareInventoryRPC           func(context.Context, updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
+	GetFirmwareInventoryCollectionRPC func(context.Context, updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
+	GetSoftwareInventoryRPC           func(context.Context, updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
+	GetSoftwareInventoryCollectionRPC func(context.Context, updateproto.UpdateRequest) (*updateproto.UpdateResponse, error)
 }
 
 // GetUpdateService is the handler for getting UpdateService details
@@ -44,16 +45,16 @@ func (a *UpdateRPCs) GetUpdateService(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetUpdateServiceRPC(req)
+	resp, err := a.GetUpdateServiceRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -70,19 +71,20 @@ func (a *UpdateRPCs) GetUpdateService(ctx iris.Context) {
 func (a *UpdateRPCs) GetFirmwareInventoryCollection(ctx iris.Context) {
 	req := updateproto.UpdateRequest{
 		SessionToken: ctx.Request().Header.Get("X-Auth-Token"),
+		URL:          ctx.Request().RequestURI,
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetFirmwareInventoryCollectionRPC(req)
+	resp, err := a.GetFirmwareInventoryCollectionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -102,16 +104,16 @@ func (a *UpdateRPCs) GetSoftwareInventoryCollection(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetSoftwareInventoryCollectionRPC(req)
+	resp, err := a.GetSoftwareInventoryCollectionRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -133,16 +135,16 @@ func (a *UpdateRPCs) GetFirmwareInventory(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetFirmwareInventoryRPC(req)
+	resp, err := a.GetFirmwareInventoryRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -164,16 +166,16 @@ func (a *UpdateRPCs) GetSoftwareInventory(ctx iris.Context) {
 	}
 	if req.SessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
 		return
 	}
-	resp, err := a.GetSoftwareInventoryRPC(req)
+	resp, err := a.GetSoftwareInventoryRPC(ctx.Request().Context(), req)
 	if err != nil {
 		errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -192,7 +194,7 @@ func (a *UpdateRPCs) SimpleUpdate(ctx iris.Context) {
 	err := ctx.ReadJSON(&req)
 	if err != nil {
 		errorMessage := "error while trying to get JSON body from the simple update request body: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusBadRequest) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -201,7 +203,7 @@ func (a *UpdateRPCs) SimpleUpdate(ctx iris.Context) {
 	sessionToken := ctx.Request().Header.Get("X-Auth-Token")
 	if sessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -213,10 +215,10 @@ func (a *UpdateRPCs) SimpleUpdate(ctx iris.Context) {
 		SessionToken: sessionToken,
 		RequestBody:  request,
 	}
-	resp, err := a.SimpleUpdateRPC(updateRequest)
+	resp, err := a.SimpleUpdateRPC(ctx.Request().Context(), updateRequest)
 	if err != nil {
 		errorMessage := "RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -233,7 +235,7 @@ func (a *UpdateRPCs) StartUpdate(ctx iris.Context) {
 	sessionToken := ctx.Request().Header.Get("X-Auth-Token")
 	if sessionToken == "" {
 		errorMessage := "error: no X-Auth-Token found in request header"
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusUnauthorized) // TODO: add error headers
 		ctx.JSON(&response.Body)
@@ -242,10 +244,10 @@ func (a *UpdateRPCs) StartUpdate(ctx iris.Context) {
 	updateRequest := updateproto.UpdateRequest{
 		SessionToken: sessionToken,
 	}
-	resp, err := a.StartUpdateRPC(updateRequest)
+	resp, err := a.StartUpdateRPC(ctx.Request().Context(), updateRequest)
 	if err != nil {
 		errorMessage := "RPC error:" + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx.Request().Context()).Error(errorMessage)
 		response := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
 		ctx.StatusCode(http.StatusInternalServerError) // TODO: add error headers
 		ctx.JSON(&response.Body)
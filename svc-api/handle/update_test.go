@@ -14,6 +14,7 @@
 package handle
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -23,7 +24,7 @@ import (
 	"github.com/kataras/iris/v12/httptest"
 )
 
-func testGetUpdateService(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func testGetUpdateService(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 	var response = &updateproto.UpdateResponse{}
 	if req.SessionToken == "ValidToken" {
 		response = &updateproto.UpdateResponse{
@@ -42,13 +43,13 @@ func testGetUpdateService(req updateproto.UpdateRequest) (*updateproto.UpdateRes
 	return response, nil
 }
 
-func mockGetInventory(updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func mockGetInventory(context.Context, updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 	return &updateproto.UpdateResponse{
 		StatusCode: http.StatusOK,
 	}, nil
 }
 
-func mockSimpleUpdate(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func mockSimpleUpdate(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 	var response = &updateproto.UpdateResponse{}
 	if req.SessionToken == "" {
 		response = &updateproto.UpdateResponse{
@@ -73,7 +74,7 @@ func mockSimpleUpdate(req updateproto.UpdateRequest) (*updateproto.UpdateRespons
 	return response, nil
 }
 
-func mockStartUpdate(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func mockStartUpdate(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 	var response = &updateproto.UpdateResponse{}
 	if req.SessionToken == "" {
 		response = &updateproto.UpdateResponse{
@@ -23,15 +23,19 @@ import (
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
 	sessionproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/session"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/lib-utilities/services"
+	"github.com/ODIM-Project/ODIM/svc-api/handle"
 	"github.com/ODIM-Project/ODIM/svc-api/router"
 	"github.com/ODIM-Project/ODIM/svc-api/rpc"
 	iris "github.com/kataras/iris/v12"
 )
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
 	// verifying the uid of the user
@@ -39,6 +43,8 @@ func main() {
 		log.Fatal("Api Service should not be run as the root user")
 	}
 	router := router.Router()
+	// records the count and latency of the requests served by each of the routes
+	router.UseGlobal(tracing.IrisMiddleware, metrics.IrisMiddleware)
 
 	//WrapRouter method removes the trailing slash from the URL if present in the request and convert the URL to lower case.
 	router.WrapRouter(func(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
@@ -105,7 +111,7 @@ func main() {
 
 				var req sessionproto.SessionCreateRequest
 				req.RequestBody = sessionReqData
-				resp, err := rpc.DoSessionCreationRequest(req)
+				resp, err := rpc.DoSessionCreationRequest(r.Context(), req)
 				if err != nil && resp == nil {
 					errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
 					log.Error(errorMessage)
@@ -124,7 +130,7 @@ func main() {
 						return
 					}
 					errorMessage := "error: failed to create a sesssion"
-					log.Println(errorMessage)
+					log.Error(errorMessage)
 					body, _ := json.Marshal(common.GeneralError(resp.StatusCode, resp.StatusMessage, errorMessage, nil, nil).Body)
 					w.Write([]byte(body))
 					return
@@ -150,18 +156,31 @@ func main() {
 	if err != nil {
 		log.Fatal(err.Error())
 	}
+	handle.LoadMetadata()
 
 	err = services.InitializeService(services.APIClient)
 	if err != nil {
 		log.Fatal("service initialisation failed: " + err.Error())
 	}
 
+	// the certificate of the API gateway is served from the certificate store, so that it
+	// can be replaced with the CertificateService, which saves it in the DB, without a restart
+	err = config.APIGatewayCertificates.Load(
+		config.Data.APIGatewayConf.CertificatePath,
+		config.Data.APIGatewayConf.PrivateKeyPath,
+		config.Data.KeyCertConf.RootCACertificatePath,
+	)
+	if err != nil {
+		log.Fatal("service initialisation failed: " + err.Error())
+	}
+	go config.APIGatewayCertificates.WatchFiles()
+	go common.WatchKeyPair(common.APIGatewayKeyPair, config.APIGatewayCertificates)
+
 	conf := &config.HTTPConfig{
-		Certificate:   &config.Data.APIGatewayConf.Certificate,
-		PrivateKey:    &config.Data.APIGatewayConf.PrivateKey,
-		CACertificate: &config.Data.KeyCertConf.RootCACertificate,
-		ServerAddress: config.Data.APIGatewayConf.Host,
-		ServerPort:    config.Data.APIGatewayConf.Port,
+		CACertificate:    &config.Data.KeyCertConf.RootCACertificate,
+		ServerAddress:    config.Data.APIGatewayConf.Host,
+		ServerPort:       config.Data.APIGatewayConf.Port,
+		CertificateStore: config.APIGatewayCertificates,
 	}
 	apiServer, err := conf.GetHTTPServerObj()
 	if err != nil {
@@ -0,0 +1,170 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	iris "github.com/kataras/iris/v12"
+)
+
+// ETagMiddleware adds a strong ETag header to every successful GET response and
+// evaluates the conditional request headers.
+//
+// For GET, the ETag sent by the backend service (computed from the stored JSON) is
+// used, otherwise the ETag is computed from the response body. A matching
+// If-None-Match turns the response into 304 Not Modified.
+//
+// For PATCH, PUT and DELETE with If-Match, the routes of ifMatchServiceRoutes are left
+// to the owning service, which checks If-Match against the stored data atomically with the
+// write. For the other routes the current ETag of the resource is fetched with an internal
+// GET on the same path and a mismatch is rejected with 412 Precondition Failed. The write
+// is rejected as well when the current ETag can't be fetched.
+func ETagMiddleware(ctx iris.Context) {
+	switch ctx.Method() {
+	case http.MethodGet:
+		ctx.Record()
+		ctx.Next()
+		setETag(ctx)
+	case http.MethodPatch, http.MethodPut, http.MethodDelete:
+		ifMatch := ctx.GetHeader("If-Match")
+		if ifMatch == "" || isIfMatchServiceRoute(ctx) {
+			ctx.Next()
+			return
+		}
+		etag, statusCode, err := currentETag(ctx)
+		if err != nil {
+			errorMessage := "error: unable to fetch the current ETag of " + ctx.Path() + ": " + err.Error()
+			logs.Log(ctx.Request().Context()).Error(errorMessage)
+			statusMessage := response.InternalError
+			if statusCode == http.StatusPreconditionFailed {
+				statusMessage = response.PreconditionFailed
+			}
+			resp := common.GeneralError(int32(statusCode), statusMessage, errorMessage, nil, nil)
+			common.SetResponseHeader(ctx, map[string]string{
+				"Content-type": "application/json; charset=utf-8",
+			})
+			ctx.StatusCode(statusCode)
+			ctx.JSON(&resp.Body)
+			return
+		}
+		if !persistencemgr.MatchETag(ifMatch, etag) {
+			errorMessage := "error: If-Match " + ifMatch + " does not match the current ETag " + etag + " of " + ctx.Path()
+			logs.Log(ctx.Request().Context()).Error(errorMessage)
+			resp := common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errorMessage, nil, nil)
+			common.SetResponseHeader(ctx, map[string]string{
+				"Content-type": "application/json; charset=utf-8",
+				"ETag":         etag,
+			})
+			ctx.StatusCode(http.StatusPreconditionFailed)
+			ctx.JSON(&resp.Body)
+			return
+		}
+		ctx.Next()
+	default:
+		ctx.Next()
+	}
+}
+
+// setETag sets the ETag header of a recorded GET response and answers
+// a matching If-None-Match with 304 Not Modified
+func setETag(ctx iris.Context) {
+	recorder, ok := ctx.IsRecording()
+	if !ok || ctx.GetStatusCode() != http.StatusOK {
+		return
+	}
+	etag := recorder.Header().Get("ETag")
+	if etag == "" {
+		etag = persistencemgr.ETag(string(recorder.Body()))
+		recorder.Header().Set("ETag", etag)
+	}
+	ifNoneMatch := ctx.GetHeader("If-None-Match")
+	if ifNoneMatch != "" && persistencemgr.MatchETag(ifNoneMatch, etag) {
+		recorder.ResetBody()
+		recorder.Header().Del("Content-Length")
+		recorder.Header().Del("Content-type")
+		ctx.StatusCode(http.StatusNotModified)
+	}
+}
+
+// ifMatchServiceRoutes are the routes whose handlers pass If-Match to the owning service,
+// which compares it with the ETag of the stored data
+var ifMatchServiceRoutes = map[string]bool{
+	http.MethodPatch + " /redfish/v1/AccountService/Accounts/{id}":                true,
+	http.MethodDelete + " /redfish/v1/AccountService/Accounts/{id}":               true,
+	http.MethodPatch + " /redfish/v1/AccountService/Roles/{id}":                   true,
+	http.MethodDelete + " /redfish/v1/AccountService/Roles/{id}":                  true,
+	http.MethodPatch + " /redfish/v1/Systems/{id}":                                true,
+	http.MethodPatch + " /redfish/v1/Systems/{id}/Bios/Settings":                  true,
+	http.MethodPatch + " /redfish/v1/AggregationService/AggregationSources/{id}":  true,
+	http.MethodDelete + " /redfish/v1/AggregationService/AggregationSources/{id}": true,
+}
+
+// isIfMatchServiceRoute reports whether the If-Match of the request is checked by the owning service
+func isIfMatchServiceRoute(ctx iris.Context) bool {
+	route := ctx.GetCurrentRoute()
+	return route != nil && ifMatchServiceRoutes[route.Method()+" "+route.Path()]
+}
+
+// currentETag fetches the ETag of the requested resource with an internal GET request on
+// the same path, made with the same session. The status code to respond with is returned
+// along with the error when the ETag couldn't be fetched.
+func currentETag(ctx iris.Context) (string, int, error) {
+	req, err := http.NewRequest(http.MethodGet, ctx.Request().URL.Path, nil)
+	if err != nil {
+		return "", http.StatusInternalServerError, err
+	}
+	req.RequestURI = ctx.Request().URL.EscapedPath()
+	req.RemoteAddr = ctx.Request().RemoteAddr
+	req.Host = ctx.Request().Host
+	// the session created for basic auth is already present in X-Auth-Token,
+	// Session-ID is dropped so that the internal request doesn't delete it
+	req.Header.Set("X-Auth-Token", ctx.GetHeader("X-Auth-Token"))
+	rec := &etagRecorder{header: http.Header{}, statusCode: http.StatusOK}
+	ctx.Application().ServeHTTP(rec, req)
+	if rec.statusCode != http.StatusOK {
+		return "", http.StatusPreconditionFailed, fmt.Errorf("the resource could not be read, status code %d", rec.statusCode)
+	}
+	etag := rec.header.Get("ETag")
+	if strings.TrimSpace(etag) == "" {
+		return "", http.StatusInternalServerError, fmt.Errorf("no ETag in the response")
+	}
+	return etag, http.StatusOK, nil
+}
+
+// etagRecorder is the http.ResponseWriter used for the internal GET request,
+// only the status code and the headers are retained
+type etagRecorder struct {
+	header     http.Header
+	statusCode int
+}
+
+func (r *etagRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *etagRecorder) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (r *etagRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+}
@@ -0,0 +1,99 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+	iris "github.com/kataras/iris/v12"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+const mockBody = `{"@odata.id":"/redfish/v1/AccountService/Accounts/admin"}`
+
+func mockETagApp() *iris.Application {
+	mockApp := iris.New()
+	mockApp.UseGlobal(ETagMiddleware)
+	redfishRoutes := mockApp.Party("/redfish/v1")
+	redfishRoutes.Get("/AccountService/Accounts/{id}", func(ctx iris.Context) {
+		if ctx.GetHeader("X-Auth-Token") == "" {
+			ctx.StatusCode(http.StatusUnauthorized)
+			return
+		}
+		ctx.StatusCode(http.StatusOK)
+		ctx.Write([]byte(mockBody))
+	})
+	redfishRoutes.Patch("/AccountService/Accounts/{id}", func(ctx iris.Context) {
+		ctx.StatusCode(http.StatusOK)
+	})
+	redfishRoutes.Get("/Chassis/{id}", func(ctx iris.Context) {
+		if ctx.GetHeader("X-Auth-Token") == "" {
+			ctx.StatusCode(http.StatusUnauthorized)
+			return
+		}
+		ctx.StatusCode(http.StatusOK)
+		ctx.Write([]byte(mockBody))
+	})
+	redfishRoutes.Patch("/Chassis/{id}", func(ctx iris.Context) {
+		ctx.StatusCode(http.StatusOK)
+	})
+	redfishRoutes.Get("/AccountService/Roles/{id}", func(ctx iris.Context) {
+		ctx.ResponseWriter().Header().Set("ETag", `"backend"`)
+		ctx.StatusCode(http.StatusOK)
+		ctx.Write([]byte(mockBody))
+	})
+	return mockApp
+}
+
+func TestETagMiddleware_Get(t *testing.T) {
+	e := httptest.New(t, mockETagApp())
+	etag := persistencemgr.ETag(mockBody)
+	e.GET(
+		"/redfish/v1/AccountService/Accounts/admin",
+	).WithHeader("X-Auth-Token", "token").Expect().Status(http.StatusOK).Header("ETag").Equal(etag)
+	e.GET(
+		"/redfish/v1/AccountService/Accounts/admin",
+	).WithHeader("X-Auth-Token", "token").WithHeader("If-None-Match", etag).Expect().Status(http.StatusNotModified)
+	e.GET(
+		"/redfish/v1/AccountService/Accounts/admin",
+	).WithHeader("X-Auth-Token", "token").WithHeader("If-None-Match", `"stale"`).Expect().Status(http.StatusOK)
+	e.GET(
+		"/redfish/v1/AccountService/Roles/Administrator",
+	).WithHeader("X-Auth-Token", "token").Expect().Status(http.StatusOK).Header("ETag").Equal(`"backend"`)
+}
+
+func TestETagMiddleware_IfMatch(t *testing.T) {
+	e := httptest.New(t, mockETagApp())
+	etag := persistencemgr.ETag(mockBody)
+	e.PATCH(
+		"/redfish/v1/Chassis/1",
+	).WithHeader("X-Auth-Token", "token").WithHeader("If-Match", etag).Expect().Status(http.StatusOK)
+	e.PATCH(
+		"/redfish/v1/Chassis/1",
+	).WithHeader("X-Auth-Token", "token").WithHeader("If-Match", `"stale"`).Expect().Status(http.StatusPreconditionFailed)
+	e.PATCH(
+		"/redfish/v1/Chassis/1",
+	).WithHeader("X-Auth-Token", "token").Expect().Status(http.StatusOK)
+	// the resource couldn't be read with the session, so the write is rejected
+	e.PATCH(
+		"/redfish/v1/Chassis/1",
+	).WithHeader("If-Match", etag).Expect().Status(http.StatusPreconditionFailed)
+	// the If-Match of the accounts is checked by the account service
+	e.PATCH(
+		"/redfish/v1/AccountService/Accounts/admin",
+	).WithHeader("If-Match", `"stale"`).Expect().Status(http.StatusOK)
+}
@@ -16,9 +16,11 @@
 package middleware
 
 import (
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/ODIM-Project/ODIM/svc-api/rpc"
 	iris "github.com/kataras/iris/v12"
-	log "github.com/sirupsen/logrus"
 )
 
 //SessionDelMiddleware is used to delete session created for basic auth
@@ -28,11 +30,21 @@ func SessionDelMiddleware(ctx iris.Context) {
 	sessionID := ctx.Request().Header.Get("Session-ID")
 	sessionToken := ctx.Request().Header.Get("X-Auth-Token")
 	if sessionID != "" {
-		resp, err := rpc.DeleteSessionRequest(sessionID, sessionToken)
+		resp, err := rpc.DeleteSessionRequest(ctx.Request().Context(), sessionID, sessionToken)
 		if err != nil && resp == nil {
 			errorMessage := "error: something went wrong with the RPC calls: " + err.Error()
-			log.Error(errorMessage)
+			logs.Log(ctx.Request().Context()).Error(errorMessage)
 			return
 		}
 	}
 }
+
+// SystemIDMiddleware adds the UUID of the system of the {id} parameter to the correlation fields
+// of the request context, so the entries logged for the request carry it
+func SystemIDMiddleware(ctx iris.Context) {
+	if id := ctx.Params().Get("id"); strings.Contains(id, ":") {
+		req := ctx.Request()
+		ctx.ResetRequest(req.WithContext(logs.With(req.Context(), logs.SystemIDField, strings.Split(id, ":")[0])))
+	}
+	ctx.Next()
+}
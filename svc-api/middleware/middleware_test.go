@@ -0,0 +1,36 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	iris "github.com/kataras/iris/v12"
+	"github.com/kataras/iris/v12/httptest"
+)
+
+func TestSystemIDMiddleware(t *testing.T) {
+	mockApp := iris.New()
+	systems := mockApp.Party("/redfish/v1/Systems", SystemIDMiddleware)
+	systems.Get("/{id}", func(ctx iris.Context) {
+		ctx.WriteString(fmt.Sprint(logs.Log(ctx.Request().Context()).Data[logs.SystemIDField]))
+	})
+	e := httptest.New(t, mockApp)
+	e.GET("/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1").Expect().Status(http.StatusOK).Body().Equal("6d4a0a66-7efa-578e-83cf-44dc68d2874e")
+	e.GET("/redfish/v1/Systems/odim").Expect().Status(http.StatusOK).Body().Equal("<nil>")
+}
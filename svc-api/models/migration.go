@@ -0,0 +1,43 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetMigrationReports fetches the migration reports of all the services from the on-disk DB
+func GetMigrationReports() ([]common.MigrationReport, *errors.Error) {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return nil, err
+	}
+	services, err := conn.GetAllDetails(common.MigrationReportTable)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]common.MigrationReport, 0, len(services))
+	for _, service := range services {
+		report, err := common.GetMigrationReport(service)
+		if err != nil {
+			log.Error("error while trying to read the migration report of " + service + ": " + err.Error())
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
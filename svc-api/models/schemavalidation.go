@@ -0,0 +1,54 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/schemavalidation"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetSchemaViolationReports fetches the schema violation reports of all the resources from the in-memory DB
+func GetSchemaViolationReports() ([]schemavalidation.Report, *errors.Error) {
+	conn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return nil, err
+	}
+	resourceURIs, err := conn.GetAllDetails(schemavalidation.Table)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]schemavalidation.Report, 0, len(resourceURIs))
+	for _, resourceURI := range resourceURIs {
+		data, err := conn.Read(schemavalidation.Table, resourceURI)
+		if err != nil {
+			// the report is deleted when the resource is found valid again
+			if err.ErrNo() != errors.DBKeyNotFound {
+				log.Error("error while trying to read the schema violation report of " + resourceURI + ": " + err.Error())
+			}
+			continue
+		}
+		var report schemavalidation.Report
+		if err := json.Unmarshal([]byte(data), &report); err != nil {
+			log.Error("error while trying to unmarshal the schema violation report of " + resourceURI + ": " + err.Error())
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
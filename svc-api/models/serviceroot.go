@@ -37,6 +37,7 @@ type ServiceRoot struct {
 	Managers                  *Service     `json:"Managers,omitempty"`
 	UpdateService             *Service     `json:"UpdateService,omitempty"`
 	TelemetryService          *Service     `json:"TelemetryService,omitempty"`
+	CertificateService        *Service     `json:"CertificateService,omitempty"`
 	Links                     Links        `json:"Links"`
 	Name                      string       `json:"Name"`
 	OEM                       OEM          `json:"Oem"`
@@ -47,10 +48,11 @@ type ServiceRoot struct {
 //PFSupported struct definition
 type PFSupported struct {
 	ExcerptQuery    bool         `json:"ExcerptQuery"`
-	ExpandQuery     *ExpandQuery `json:"ExpandQuery:omitempty"`
+	ExpandQuery     *ExpandQuery `json:"ExpandQuery,omitempty"`
 	FilterQuery     bool         `json:"FilterQuery"`
 	OnlyMemberQuery bool         `json:"OnlyMemberQuery"`
 	SelectQuery     bool         `json:"SelectQuery"`
+	TopSkipQuery    bool         `json:"TopSkipQuery"`
 }
 
 //ExpandQuery struct definition
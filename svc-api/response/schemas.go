@@ -0,0 +1,61 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package response ...
+package response
+
+import (
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/schemavalidation"
+)
+
+// JSONSchemaFile defines the JSON schema file resource
+type JSONSchemaFile struct {
+	ID           string     `json:"Id"`
+	OdataContext string     `json:"@odata.context"`
+	OdataID      string     `json:"@odata.id"`
+	OdataType    string     `json:"@odata.type"`
+	Name         string     `json:"Name"`
+	Description  string     `json:"Description"`
+	Languages    []string   `json:"Languages"`
+	Location     []Location `json:"Location"`
+	Schema       string     `json:"Schema"`
+}
+
+// SchemaValidationReport defines the report of the schema violations found in the resources returned by the plugins
+type SchemaValidationReport struct {
+	OdataID     string                   `json:"@odata.id"`
+	ID          string                   `json:"Id"`
+	Name        string                   `json:"Name"`
+	Description string                   `json:"Description"`
+	Mode        string                   `json:"Mode"`
+	Plugins     []PluginSchemaViolations `json:"Plugins"`
+}
+
+// PluginSchemaViolations defines the schema violations found in the resources returned by a plugin
+type PluginSchemaViolations struct {
+	PluginID       string                    `json:"PluginID"`
+	ResourceCount  int                       `json:"ResourceCount"`
+	ViolationCount int                       `json:"ViolationCount"`
+	Resources      []schemavalidation.Report `json:"Resources"`
+}
+
+// MigrationReport defines the report of the migrations of the DB run by the services when they start
+type MigrationReport struct {
+	OdataID     string                   `json:"@odata.id"`
+	ID          string                   `json:"Id"`
+	Name        string                   `json:"Name"`
+	Description string                   `json:"Description"`
+	Services    []common.MigrationReport `json:"Services"`
+}
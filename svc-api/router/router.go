@@ -17,9 +17,11 @@
 package router
 
 import (
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	srv "github.com/ODIM-Project/ODIM/lib-utilities/services"
 	"github.com/ODIM-Project/ODIM/svc-api/handle"
 	"github.com/ODIM-Project/ODIM/svc-api/middleware"
+	"github.com/ODIM-Project/ODIM/svc-api/models"
 	"github.com/ODIM-Project/ODIM/svc-api/rpc"
 
 	"github.com/kataras/iris/v12"
@@ -59,8 +61,11 @@ func Router() *iris.Application {
 		RemoveElementsFromAggregateRPC:          rpc.DoRemoveElementsFromAggregate,
 		ResetAggregateElementsRPC:               rpc.DoResetAggregateElements,
 		SetDefaultBootOrderAggregateElementsRPC: rpc.DoSetDefaultBootOrderAggregateElements,
+		BulkRequestAggregateElementsRPC:         rpc.DoBulkRequestAggregateElements,
 		GetAllConnectionMethodsRPC:              rpc.DoGetAllConnectionMethods,
 		GetConnectionMethodRPC:                  rpc.DoGetConnectionMethod,
+		BackupRPC:                               rpc.DoBackupRequest,
+		RestoreRPC:                              rpc.DoRestoreRequest,
 	}
 
 	s := handle.SessionRPCs{
@@ -91,6 +96,7 @@ func Router() *iris.Application {
 		ChangeBootOrderSettingsRPC: rpc.ChangeBootOrderSettings,
 		CreateVolumeRPC:            rpc.CreateVolume,
 		DeleteVolumeRPC:            rpc.DeleteVolume,
+		PassThroughRPC:             rpc.PassThrough,
 	}
 
 	cha := handle.ChassisRPCs{
@@ -109,6 +115,7 @@ func Router() *iris.Application {
 		GetEventSubscriptionRPC:            rpc.DoGetEventSubscription,
 		DeleteEventSubscriptionRPC:         rpc.DoDeleteEventSubscription,
 		GetEventSubscriptionsCollectionRPC: rpc.DoGetEventSubscriptionsCollection,
+		ReplayEventsRPC:                    rpc.DoReplayEvents,
 	}
 
 	fab := handle.FabricRPCs{
@@ -118,9 +125,22 @@ func Router() *iris.Application {
 	}
 
 	manager := handle.ManagersRPCs{
-		GetManagersCollectionRPC: rpc.GetManagersCollection,
-		GetManagersRPC:           rpc.GetManagers,
-		GetManagersResourceRPC:   rpc.GetManagersResource,
+		GetManagersCollectionRPC:   rpc.GetManagersCollection,
+		GetManagersRPC:             rpc.GetManagers,
+		GetManagersResourceRPC:     rpc.GetManagersResource,
+		ResetManagerRPC:            rpc.ResetManager,
+		ResetManagerToDefaultsRPC:  rpc.ResetManagerToDefaults,
+		InsertVirtualMediaRPC:      rpc.InsertVirtualMedia,
+		EjectVirtualMediaRPC:       rpc.EjectVirtualMedia,
+		UpdateVirtualMediaRPC:      rpc.UpdateVirtualMedia,
+		CreateConsoleSessionRPC:    rpc.CreateConsoleSession,
+		ConnectConsoleSessionRPC:   rpc.ConnectConsoleSession,
+		GetCertificateServiceRPC:   rpc.GetCertificateService,
+		GetCertificateLocationsRPC: rpc.GetCertificateLocations,
+		GetEndpointCertificatesRPC: rpc.GetEndpointCertificates,
+		GenerateCSRRPC:             rpc.GenerateCSR,
+		ReplaceCertificateRPC:      rpc.ReplaceCertificate,
+		Auth:                       srv.IsAuthorized,
 	}
 
 	update := handle.UpdateRPCs{
@@ -150,9 +170,19 @@ func Router() *iris.Application {
 		Auth: srv.IsAuthorized,
 	}
 
+	jsonSchema := handle.JSONSchema{
+		Auth:                      srv.IsAuthorized,
+		GetSchemaViolationReports: models.GetSchemaViolationReports,
+	}
+	migration := handle.Migration{
+		Auth:                srv.IsAuthorized,
+		GetMigrationReports: models.GetMigrationReports,
+	}
+
 	serviceRoot := handle.InitServiceRoot()
 
 	router := iris.New()
+	router.UseGlobal(middleware.ETagMiddleware)
 
 	taskmon := router.Party("/taskmon")
 	taskmon.SetRegisterRule(iris.RouteSkip)
@@ -176,6 +206,25 @@ func Router() *iris.Application {
 	registry.Any("/", handle.RegMethodNotAllowed)
 	registry.Any("/{id}", handle.RegMethodNotAllowed)
 
+	jsonSchemas := v1.Party("/JsonSchemas")
+	jsonSchemas.SetRegisterRule(iris.RouteSkip)
+	jsonSchemas.Get("/", jsonSchema.GetJSONSchemaFileCollection)
+	jsonSchemas.Get("/{id}", jsonSchema.GetJSONSchemaFile)
+	jsonSchemas.Any("/", handle.SchemaMethodNotAllowed)
+	jsonSchemas.Any("/{id}", handle.SchemaMethodNotAllowed)
+
+	schemaStore := v1.Party("/SchemaStore/en")
+	schemaStore.SetRegisterRule(iris.RouteSkip)
+	schemaStore.Get("/{file}", jsonSchema.GetSchemaStoreFile)
+	schemaStore.Any("/{file}", handle.SchemaMethodNotAllowed)
+
+	odimOem := v1.Party("/Oem/Odim")
+	odimOem.SetRegisterRule(iris.RouteSkip)
+	odimOem.Get("/SchemaValidationReport", jsonSchema.GetSchemaValidationReport)
+	odimOem.Any("/SchemaValidationReport", handle.SchemaMethodNotAllowed)
+	odimOem.Get("/MigrationReport", migration.GetMigrationReport)
+	odimOem.Any("/MigrationReport", handle.SchemaMethodNotAllowed)
+
 	session := v1.Party("/SessionService")
 	session.SetRegisterRule(iris.RouteSkip)
 	session.Get("/", s.GetSessionService)
@@ -215,7 +264,7 @@ func Router() *iris.Application {
 	task.Any("/Tasks", handle.TsMethodNotAllowed)
 	task.Any("/Tasks/{TaskID}", handle.TsMethodNotAllowed)
 
-	systems := v1.Party("/Systems", middleware.SessionDelMiddleware)
+	systems := v1.Party("/Systems", middleware.SessionDelMiddleware, middleware.SystemIDMiddleware)
 	systems.SetRegisterRule(iris.RouteSkip)
 	systems.Get("/", system.GetSystemsCollection)
 	systems.Get("/{id}", system.GetSystem)
@@ -265,7 +314,7 @@ func Router() *iris.Application {
 	systems.Any("/{id}/Bios", handle.SystemsMethodNotAllowed)
 	systems.Any("/{id}/Processors/{rid}", handle.SystemsMethodNotAllowed)
 
-	storage := v1.Party("/Systems/{id}/Storage", middleware.SessionDelMiddleware)
+	storage := v1.Party("/Systems/{id}/Storage", middleware.SessionDelMiddleware, middleware.SystemIDMiddleware)
 	storage.SetRegisterRule(iris.RouteSkip)
 	storage.Get("/", system.GetSystemResource)
 	storage.Get("/{rid}", system.GetSystemResource)
@@ -292,6 +341,10 @@ func Router() *iris.Application {
 	aggregation.Any("/Actions/AggregationService.Reset/", handle.AggMethodNotAllowed)
 	aggregation.Post("/Actions/AggregationService.SetDefaultBootOrder/", pc.SetDefaultBootOrder)
 	aggregation.Any("/Actions/AggregationService.SetDefaultBootOrder/", handle.AggMethodNotAllowed)
+	aggregation.Post("/Actions/Oem/ODIM.Backup/", pc.Backup)
+	aggregation.Any("/Actions/Oem/ODIM.Backup/", handle.AggMethodNotAllowed)
+	aggregation.Post("/Actions/Oem/ODIM.Restore/", pc.Restore)
+	aggregation.Any("/Actions/Oem/ODIM.Restore/", handle.AggMethodNotAllowed)
 	aggregation.Any("/", handle.AggMethodNotAllowed)
 	aggregationSource := aggregation.Party("/AggregationSources", middleware.SessionDelMiddleware)
 	aggregationSource.Post("/", pc.AddAggregationSource)
@@ -323,8 +376,10 @@ func Router() *iris.Application {
 	aggregates.Any("/{id}/Actions/Aggregate.Reset/", handle.AggregateMethodNotAllowed)
 	aggregates.Post("/{id}/Actions/Aggregate.SetDefaultBootOrder/", pc.SetDefaultBootOrderAggregateElements)
 	aggregates.Any("/{id}/Actions/Aggregate.SetDefaultBootOrder/", handle.AggregateMethodNotAllowed)
+	aggregates.Post("/{id}/Actions/Aggregate.BulkRequest/", pc.BulkRequestAggregateElements)
+	aggregates.Any("/{id}/Actions/Aggregate.BulkRequest/", handle.AggregateMethodNotAllowed)
 
-	chassis := v1.Party("/Chassis", middleware.SessionDelMiddleware)
+	chassis := v1.Party("/Chassis", middleware.SessionDelMiddleware, middleware.SystemIDMiddleware)
 	chassis.SetRegisterRule(iris.RouteSkip)
 	chassis.Get("/", cha.GetChassisCollection)
 	chassis.Post("/", cha.CreateChassis)
@@ -398,10 +453,12 @@ func Router() *iris.Application {
 	events.Get("/Subscriptions/{id}", evt.GetEventSubscription)
 	events.Post("/Subscriptions", evt.CreateEventSubscription)
 	events.Post("/Actions/EventService.SubmitTestEvent", evt.SubmitTestEvent)
+	events.Post("/Actions/Oem/ODIM.ReplayEvents", evt.ReplayEvents)
 	events.Delete("/Subscriptions/{id}", evt.DeleteEventSubscription)
 	events.Any("/", handle.EvtMethodNotAllowed)
 	events.Any("/Actions", handle.EvtMethodNotAllowed)
 	events.Any("/Actions/EventService.SubmitTestEvent", handle.EvtMethodNotAllowed)
+	events.Any("/Actions/Oem/ODIM.ReplayEvents", handle.EvtMethodNotAllowed)
 	events.Any("/Subscriptions", handle.EvtMethodNotAllowed)
 
 	fabrics := v1.Party("/Fabrics", middleware.SessionDelMiddleware)
@@ -444,7 +501,7 @@ func Router() *iris.Application {
 	fabrics.Any("/{id}/Endpoints/{endpoint_uuid}", handle.FabricsMethodNotAllowed)
 	fabrics.Any("/{id}/AddressPools/{addresspool_uuid}", handle.FabricsMethodNotAllowed)
 
-	managers := v1.Party("/Managers", middleware.SessionDelMiddleware)
+	managers := v1.Party("/Managers", middleware.SessionDelMiddleware, middleware.SystemIDMiddleware)
 	managers.SetRegisterRule(iris.RouteSkip)
 	managers.Get("/", manager.GetManagersCollection)
 	managers.Get("/{id}", manager.GetManager)
@@ -452,13 +509,24 @@ func Router() *iris.Application {
 	managers.Get("/{id}/EthernetInterfaces/{rid}", manager.GetManagersResource)
 	managers.Get("/{id}/NetworkProtocol", manager.GetManagersResource)
 	managers.Get("/{id}/NetworkProtocol/{rid}", manager.GetManagersResource)
+	managers.Get("/{id}/NetworkProtocol/HTTPS/Certificates", manager.GetManagersResource)
+	managers.Get("/{id}/NetworkProtocol/HTTPS/Certificates/{rid}", manager.GetManagersResource)
+	managers.Get("/{id}/Oem/ODIM/RPC/Certificates", manager.GetManagersResource)
+	managers.Get("/{id}/Oem/ODIM/RPC/Certificates/{rid}", manager.GetManagersResource)
 	managers.Get("/{id}/HostInterfaces", manager.GetManagersResource)
 	managers.Get("/{id}/HostInterfaces/{rid}", manager.GetManagersResource)
 
 	managers.Get("/{id}/SerialInterface", manager.GetManagersResource)
 	managers.Get("/{id}/SerialInterface/{rid}", manager.GetManagersResource)
+	managers.Post("/{id}/Actions/Manager.Reset", manager.ResetManager)
+	managers.Post("/{id}/Actions/Manager.ResetToDefaults", manager.ResetManagerToDefaults)
 	managers.Get("/{id}/VirtualMedia", manager.GetManagersResource)
 	managers.Get("/{id}/VirtualMedia/{rid}", manager.GetManagersResource)
+	managers.Patch("/{id}/VirtualMedia/{rid}", manager.UpdateVirtualMedia)
+	managers.Post("/{id}/VirtualMedia/{rid}/Actions/VirtualMedia.InsertMedia", manager.InsertVirtualMedia)
+	managers.Post("/{id}/VirtualMedia/{rid}/Actions/VirtualMedia.EjectMedia", manager.EjectVirtualMedia)
+	managers.Post("/{id}/Oem/ODIM/ConsoleSessions", manager.CreateConsoleSession)
+	managers.Get("/{id}/Oem/ODIM/ConsoleSessions/{token}", manager.ConnectConsole)
 	managers.Get("/{id}/LogServices", manager.GetManagersResource)
 	managers.Get("/{id}/LogServices/{rid}", manager.GetManagersResource)
 	managers.Get("/{id}/LogServices/{rid}/Entries", manager.GetManagersResource)
@@ -470,9 +538,28 @@ func Router() *iris.Application {
 	managers.Any("/{id}/LogServices/{rid}/Entries/{rid2}", handle.ManagersMethodNotAllowed)
 	managers.Any("/{id}/LogServices/{rid}/Actions", handle.ManagersMethodNotAllowed)
 	managers.Any("/{id}/LogServices/{rid}/Actions/LogService.ClearLog", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/Actions/Manager.Reset", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/Actions/Manager.ResetToDefaults", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/VirtualMedia", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/VirtualMedia/{rid}", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/VirtualMedia/{rid}/Actions/VirtualMedia.InsertMedia", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/VirtualMedia/{rid}/Actions/VirtualMedia.EjectMedia", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/Oem/ODIM/ConsoleSessions", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/Oem/ODIM/ConsoleSessions/{token}", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/NetworkProtocol/HTTPS/Certificates", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/NetworkProtocol/HTTPS/Certificates/{rid}", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/Oem/ODIM/RPC/Certificates", handle.ManagersMethodNotAllowed)
+	managers.Any("/{id}/Oem/ODIM/RPC/Certificates/{rid}", handle.ManagersMethodNotAllowed)
 	managers.Any("/", handle.ManagersMethodNotAllowed)
 	managers.Any("/{id}", handle.ManagersMethodNotAllowed)
 
+	// the requests on the resources which are not routed above are forwarded to the plugins
+	if config.Data.PassThroughConf != nil && config.Data.PassThroughConf.Enabled {
+		systems.Any("/{id}/{resource:path}", system.PassThrough)
+		chassis.Any("/{id}/{resource:path}", system.PassThrough)
+		managers.Any("/{id}/{resource:path}", system.PassThrough)
+	}
+
 	updateService := v1.Party("/UpdateService", middleware.SessionDelMiddleware)
 	updateService.SetRegisterRule(iris.RouteSkip)
 	updateService.Get("/", update.GetUpdateService)
@@ -483,6 +570,19 @@ func Router() *iris.Application {
 	updateService.Get("/SoftwareInventory", update.GetSoftwareInventoryCollection)
 	updateService.Get("/SoftwareInventory/{softwareInventory_id}", update.GetSoftwareInventory)
 
+	certificateService := v1.Party("/CertificateService", middleware.SessionDelMiddleware)
+	certificateService.SetRegisterRule(iris.RouteSkip)
+	certificateService.Get("/", manager.GetCertificateService)
+	certificateService.Get("/CertificateLocations", manager.GetCertificateLocations)
+	certificateService.Get("/Oem/ODIM/EndpointCertificates", manager.GetEndpointCertificates)
+	certificateService.Post("/Actions/CertificateService.GenerateCSR", manager.GenerateCSR)
+	certificateService.Post("/Actions/CertificateService.ReplaceCertificate", manager.ReplaceCertificate)
+	certificateService.Any("/", handle.CertificateServiceMethodNotAllowed)
+	certificateService.Any("/CertificateLocations", handle.CertificateServiceMethodNotAllowed)
+	certificateService.Any("/Oem/ODIM/EndpointCertificates", handle.CertificateServiceMethodNotAllowed)
+	certificateService.Any("/Actions/CertificateService.GenerateCSR", handle.CertificateServiceMethodNotAllowed)
+	certificateService.Any("/Actions/CertificateService.ReplaceCertificate", handle.CertificateServiceMethodNotAllowed)
+
 	telemetryService := v1.Party("/TelemetryService", middleware.SessionDelMiddleware)
 	telemetryService.SetRegisterRule(iris.RouteSkip)
 	telemetryService.Get("/", telemetry.GetTelemetryService)
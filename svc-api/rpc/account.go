@@ -24,11 +24,11 @@ import (
 
 // DoGetAccountServiceRequest defines the RPC call function for
 // the GetAccountService from account-session micro service
-func DoGetAccountServiceRequest(req accountproto.AccountRequest) (*accountproto.AccountResponse, error) {
+func DoGetAccountServiceRequest(ctx context.Context, req accountproto.AccountRequest) (*accountproto.AccountResponse, error) {
 
 	account := accountproto.NewAccountService(services.AccountSession, services.Service.Client())
 
-	resp, err := account.GetAccountServices(context.TODO(), &req)
+	resp, err := account.GetAccountServices(ctx, &req)
 	if err != nil && resp == nil {
 		return nil, fmt.Errorf("error: something went wrong with rpc call: %v", err)
 	}
@@ -38,11 +38,11 @@ func DoGetAccountServiceRequest(req accountproto.AccountRequest) (*accountproto.
 
 // DoAccountCreationRequest defines the RPC call function for
 // the AccountCreation from account-session micro service
-func DoAccountCreationRequest(req accountproto.CreateAccountRequest) (*accountproto.AccountResponse, error) {
+func DoAccountCreationRequest(ctx context.Context, req accountproto.CreateAccountRequest) (*accountproto.AccountResponse, error) {
 
 	account := accountproto.NewAccountService(services.AccountSession, services.Service.Client())
 
-	resp, err := account.Create(context.TODO(), &req)
+	resp, err := account.Create(ctx, &req)
 	if err != nil && resp == nil {
 		return nil, fmt.Errorf("error: something went wrong with rpc call: %v", err)
 	}
@@ -52,11 +52,11 @@ func DoAccountCreationRequest(req accountproto.CreateAccountRequest) (*accountpr
 
 // DoGetAllAccountRequest defines the RPC call function for
 // the GetAllAccount from account-session micro service
-func DoGetAllAccountRequest(req accountproto.AccountRequest) (*accountproto.AccountResponse, error) {
+func DoGetAllAccountRequest(ctx context.Context, req accountproto.AccountRequest) (*accountproto.AccountResponse, error) {
 
 	account := accountproto.NewAccountService(services.AccountSession, services.Service.Client())
 
-	resp, err := account.GetAllAccounts(context.TODO(), &req)
+	resp, err := account.GetAllAccounts(ctx, &req)
 	if err != nil && resp == nil {
 		return nil, fmt.Errorf("error: something went wrong with rpc call: %v", err)
 	}
@@ -66,11 +66,11 @@ func DoGetAllAccountRequest(req accountproto.AccountRequest) (*accountproto.Acco
 
 // DoGetAccountRequest defines the RPC call function for
 // the GetAccount from account-session micro service
-func DoGetAccountRequest(req accountproto.GetAccountRequest) (*accountproto.AccountResponse, error) {
+func DoGetAccountRequest(ctx context.Context, req accountproto.GetAccountRequest) (*accountproto.AccountResponse, error) {
 
 	account := accountproto.NewAccountService(services.AccountSession, services.Service.Client())
 
-	resp, err := account.GetAccount(context.TODO(), &req)
+	resp, err := account.GetAccount(ctx, &req)
 	if err != nil && resp == nil {
 		return nil, fmt.Errorf("error: something went wrong with rpc call: %v", err)
 	}
@@ -80,11 +80,11 @@ func DoGetAccountRequest(req accountproto.GetAccountRequest) (*accountproto.Acco
 
 // DoUpdateAccountRequest defines the RPC call function for
 // the UpdateAccount from account-session micro service
-func DoUpdateAccountRequest(req accountproto.UpdateAccountRequest) (*accountproto.AccountResponse, error) {
+func DoUpdateAccountRequest(ctx context.Context, req accountproto.UpdateAccountRequest) (*accountproto.AccountResponse, error) {
 
 	account := accountproto.NewAccountService(services.AccountSession, services.Service.Client())
 
-	resp, err := account.Update(context.TODO(), &req)
+	resp, err := account.Update(ctx, &req)
 	if err != nil && resp == nil {
 		return nil, fmt.Errorf("error: something went wrong with rpc call: %v", err)
 	}
@@ -94,11 +94,11 @@ func DoUpdateAccountRequest(req accountproto.UpdateAccountRequest) (*accountprot
 
 // DoAccountDeleteRequest defines the RPC call function for
 // the AccountDelete from account-session micro service
-func DoAccountDeleteRequest(req accountproto.DeleteAccountRequest) (*accountproto.AccountResponse, error) {
+func DoAccountDeleteRequest(ctx context.Context, req accountproto.DeleteAccountRequest) (*accountproto.AccountResponse, error) {
 
 	account := accountproto.NewAccountService(services.AccountSession, services.Service.Client())
 
-	resp, err := account.Delete(context.TODO(), &req)
+	resp, err := account.Delete(ctx, &req)
 	if err != nil && resp == nil {
 		return nil, fmt.Errorf("error: something went wrong with rpc call: %v", err)
 	}
@@ -25,11 +25,11 @@ import (
 
 // DoGetAggregationService defines the RPC call function for
 // the GetAggregationService from aggregator micro service
-func DoGetAggregationService(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoGetAggregationService(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.GetAggregationService(context.TODO(), &req)
+	resp, err := aggregator.GetAggregationService(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -39,11 +39,11 @@ func DoGetAggregationService(req aggregatorproto.AggregatorRequest) (*aggregator
 
 // DoResetRequest defines the RPC call function for
 // the Reset from aggregator micro service
-func DoResetRequest(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoResetRequest(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.Reset(context.TODO(), &req)
+	resp, err := aggregator.Reset(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -53,11 +53,39 @@ func DoResetRequest(req aggregatorproto.AggregatorRequest) (*aggregatorproto.Agg
 
 // DoSetDefaultBootOrderRequest defines the RPC call function for
 // the SetDefaultBootOrder from aggregator micro service
-func DoSetDefaultBootOrderRequest(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoSetDefaultBootOrderRequest(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.SetDefaultBootOrder(context.TODO(), &req)
+	resp, err := aggregator.SetDefaultBootOrder(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+
+	return resp, err
+}
+
+// DoBackupRequest defines the RPC call function for
+// the Backup from aggregator micro service
+func DoBackupRequest(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+
+	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
+
+	resp, err := aggregator.Backup(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+
+	return resp, err
+}
+
+// DoRestoreRequest defines the RPC call function for
+// the Restore from aggregator micro service
+func DoRestoreRequest(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+
+	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
+
+	resp, err := aggregator.Restore(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -67,11 +95,11 @@ func DoSetDefaultBootOrderRequest(req aggregatorproto.AggregatorRequest) (*aggre
 
 // DoAddAggregationSource defines the RPC call function for
 // the AddAggregationSource from aggregator micro service
-func DoAddAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoAddAggregationSource(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.AddAggregationSource(context.TODO(), &req)
+	resp, err := aggregator.AddAggregationSource(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -81,11 +109,11 @@ func DoAddAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregatorp
 
 // DoGetAllAggregationSource defines the RPC call function for
 // the GetAllAggregationSource from aggregator micro service
-func DoGetAllAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoGetAllAggregationSource(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.GetAllAggregationSource(context.TODO(), &req)
+	resp, err := aggregator.GetAllAggregationSource(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -95,11 +123,11 @@ func DoGetAllAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregat
 
 // DoGetAggregationSource defines the RPC call function for
 // the GetAggregationSource from aggregator micro service
-func DoGetAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoGetAggregationSource(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.GetAggregationSource(context.TODO(), &req)
+	resp, err := aggregator.GetAggregationSource(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -109,11 +137,11 @@ func DoGetAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregatorp
 
 // DoUpdateAggregationSource defines the RPC call function for
 // the UpdateAggregationSource from aggregator micro service
-func DoUpdateAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoUpdateAggregationSource(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.UpdateAggregationSource(context.TODO(), &req)
+	resp, err := aggregator.UpdateAggregationSource(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -123,11 +151,11 @@ func DoUpdateAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregat
 
 // DoDeleteAggregationSource defines the RPC call function for
 // the DeleteAggregationSource  from aggregator micro service
-func DoDeleteAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoDeleteAggregationSource(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.DeleteAggregationSource(context.TODO(), &req)
+	resp, err := aggregator.DeleteAggregationSource(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -137,11 +165,11 @@ func DoDeleteAggregationSource(req aggregatorproto.AggregatorRequest) (*aggregat
 
 // DoCreateAggregate defines the RPC call function for
 // the CreateAggregate from aggregator micro service
-func DoCreateAggregate(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoCreateAggregate(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.CreateAggregate(context.TODO(), &req)
+	resp, err := aggregator.CreateAggregate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -151,11 +179,11 @@ func DoCreateAggregate(req aggregatorproto.AggregatorRequest) (*aggregatorproto.
 
 // DoGetAggregateCollection defines the RPC call function for
 // the get aggregate collections from aggregator micro service
-func DoGetAggregateCollection(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoGetAggregateCollection(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.GetAllAggregates(context.TODO(), &req)
+	resp, err := aggregator.GetAllAggregates(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -165,11 +193,11 @@ func DoGetAggregateCollection(req aggregatorproto.AggregatorRequest) (*aggregato
 
 // DoGeteAggregate defines the RPC call function for
 // the get aggregate from aggregator micro service
-func DoGeteAggregate(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoGeteAggregate(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.GetAggregate(context.TODO(), &req)
+	resp, err := aggregator.GetAggregate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -179,11 +207,11 @@ func DoGeteAggregate(req aggregatorproto.AggregatorRequest) (*aggregatorproto.Ag
 
 // DoDeleteAggregate defines the RPC call function for
 // the delete aggregate from aggregator micro service
-func DoDeleteAggregate(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoDeleteAggregate(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.DeleteAggregate(context.TODO(), &req)
+	resp, err := aggregator.DeleteAggregate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -193,11 +221,11 @@ func DoDeleteAggregate(req aggregatorproto.AggregatorRequest) (*aggregatorproto.
 
 // DoAddElementsToAggregate defines the RPC call function for
 // the add elements to an aggregate from aggregator micro service
-func DoAddElementsToAggregate(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoAddElementsToAggregate(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.AddElementsToAggregate(context.TODO(), &req)
+	resp, err := aggregator.AddElementsToAggregate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -207,11 +235,11 @@ func DoAddElementsToAggregate(req aggregatorproto.AggregatorRequest) (*aggregato
 
 // DoRemoveElementsFromAggregate defines the RPC call function for
 // the remove elements from an aggregate from aggregator micro service
-func DoRemoveElementsFromAggregate(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoRemoveElementsFromAggregate(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.RemoveElementsFromAggregate(context.TODO(), &req)
+	resp, err := aggregator.RemoveElementsFromAggregate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -221,11 +249,11 @@ func DoRemoveElementsFromAggregate(req aggregatorproto.AggregatorRequest) (*aggr
 
 // DoResetAggregateElements defines the RPC call function for
 // the reset elements of an aggregate from aggregator micro service
-func DoResetAggregateElements(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoResetAggregateElements(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.ResetElementsOfAggregate(context.TODO(), &req)
+	resp, err := aggregator.ResetElementsOfAggregate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -235,11 +263,25 @@ func DoResetAggregateElements(req aggregatorproto.AggregatorRequest) (*aggregato
 
 // DoSetDefaultBootOrderAggregateElements defines the RPC call function for
 // the set default boot order elements of an aggregate from aggregator micro service
-func DoSetDefaultBootOrderAggregateElements(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoSetDefaultBootOrderAggregateElements(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+
+	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
+
+	resp, err := aggregator.SetDefaultBootOrderElementsOfAggregate(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("RPC error: %v", err)
+	}
+
+	return resp, err
+}
+
+// DoBulkRequestAggregateElements defines the RPC call function for
+// the bulk request on the elements of an aggregate from aggregator micro service
+func DoBulkRequestAggregateElements(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.SetDefaultBootOrderElementsOfAggregate(context.TODO(), &req)
+	resp, err := aggregator.BulkRequestElementsOfAggregate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -249,11 +291,11 @@ func DoSetDefaultBootOrderAggregateElements(req aggregatorproto.AggregatorReques
 
 // DoGetAllConnectionMethods defines the RPC call function for
 // the get connection method collection from aggregator micro service
-func DoGetAllConnectionMethods(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoGetAllConnectionMethods(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.GetAllConnectionMethods(context.TODO(), &req)
+	resp, err := aggregator.GetAllConnectionMethods(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -263,11 +305,11 @@ func DoGetAllConnectionMethods(req aggregatorproto.AggregatorRequest) (*aggregat
 
 // DoGetConnectionMethod defines the RPC call function for
 // the get on connection method from aggregator micro service
-func DoGetConnectionMethod(req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
+func DoGetConnectionMethod(ctx context.Context, req aggregatorproto.AggregatorRequest) (*aggregatorproto.AggregatorResponse, error) {
 
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 
-	resp, err := aggregator.GetConnectionMethod(context.TODO(), &req)
+	resp, err := aggregator.GetConnectionMethod(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -25,9 +25,9 @@ import (
 )
 
 //GetChassisCollection will do the rpc call to collect all chassis
-func GetChassisCollection(req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
+func GetChassisCollection(ctx context.Context, req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
 	asService := chassisproto.NewChassisService(services.Systems, services.Service.Client())
-	resp, err := asService.GetChassisCollection(context.TODO(), &req)
+	resp, err := asService.GetChassisCollection(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -35,9 +35,9 @@ func GetChassisCollection(req chassisproto.GetChassisRequest) (*chassisproto.Get
 }
 
 //GetChassisResource will do the rpc call to collect Chassis Resource
-func GetChassisResource(req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
+func GetChassisResource(ctx context.Context, req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
 	asService := chassisproto.NewChassisService(services.Systems, services.Service.Client())
-	resp, err := asService.GetChassisResource(context.TODO(), &req)
+	resp, err := asService.GetChassisResource(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -45,9 +45,9 @@ func GetChassisResource(req chassisproto.GetChassisRequest) (*chassisproto.GetCh
 }
 
 //GetChassis will do the rpc call to  System Resource
-func GetChassis(req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
+func GetChassis(ctx context.Context, req chassisproto.GetChassisRequest) (*chassisproto.GetChassisResponse, error) {
 	asService := chassisproto.NewChassisService(services.Systems, services.Service.Client())
-	resp, err := asService.GetChassisInfo(context.TODO(), &req)
+	resp, err := asService.GetChassisInfo(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -55,9 +55,9 @@ func GetChassis(req chassisproto.GetChassisRequest) (*chassisproto.GetChassisRes
 }
 
 //CreateChassis will do the rpc call to create a Chassis
-func CreateChassis(req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error) {
+func CreateChassis(ctx context.Context, req chassisproto.CreateChassisRequest) (*chassisproto.GetChassisResponse, error) {
 	service := chassisproto.NewChassisService(services.Systems, services.Service.Client())
-	resp, err := service.CreateChassis(context.TODO(), &req)
+	resp, err := service.CreateChassis(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -65,9 +65,9 @@ func CreateChassis(req chassisproto.CreateChassisRequest) (*chassisproto.GetChas
 }
 
 //DeleteChassis will do the rpc call to delete a chassis
-func DeleteChassis(req chassisproto.DeleteChassisRequest) (*chassisproto.GetChassisResponse, error) {
+func DeleteChassis(ctx context.Context, req chassisproto.DeleteChassisRequest) (*chassisproto.GetChassisResponse, error) {
 	service := chassisproto.NewChassisService(services.Systems, services.Service.Client())
-	resp, err := service.DeleteChassis(context.TODO(), &req)
+	resp, err := service.DeleteChassis(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
@@ -75,9 +75,9 @@ func DeleteChassis(req chassisproto.DeleteChassisRequest) (*chassisproto.GetChas
 }
 
 //UpdateChassis will do the rpc call to update a chassis
-func UpdateChassis(req chassisproto.UpdateChassisRequest) (*chassisproto.GetChassisResponse, error) {
+func UpdateChassis(ctx context.Context, req chassisproto.UpdateChassisRequest) (*chassisproto.GetChassisResponse, error) {
 	service := chassisproto.NewChassisService(services.Systems, services.Service.Client())
-	resp, err := service.UpdateChassis(context.TODO(), &req)
+	resp, err := service.UpdateChassis(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("RPC error: %v", err)
 	}
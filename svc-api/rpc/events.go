@@ -25,11 +25,11 @@ import (
 
 // DoGetEventService defines the RPC call function for
 // the GetEventService from events micro service
-func DoGetEventService(req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
+func DoGetEventService(ctx context.Context, req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
 
 	events := eventsproto.NewEventsService(services.Events, services.Service.Client())
 
-	resp, err := events.GetEventService(context.TODO(), &req)
+	resp, err := events.GetEventService(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -39,11 +39,11 @@ func DoGetEventService(req eventsproto.EventSubRequest) (*eventsproto.EventSubRe
 
 // DoCreateEventSubscription defines the RPC call function for
 // the CreateEventSubscription from events micro service
-func DoCreateEventSubscription(req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
+func DoCreateEventSubscription(ctx context.Context, req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
 
 	events := eventsproto.NewEventsService(services.Events, services.Service.Client())
 
-	resp, err := events.CreateEventSubscription(context.TODO(), &req)
+	resp, err := events.CreateEventSubscription(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -53,11 +53,25 @@ func DoCreateEventSubscription(req eventsproto.EventSubRequest) (*eventsproto.Ev
 
 // DoSubmitTestEvent defines the RPC call function for
 // the SubmitTestEvent from events micro service
-func DoSubmitTestEvent(req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
+func DoSubmitTestEvent(ctx context.Context, req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
 
 	events := eventsproto.NewEventsService(services.Events, services.Service.Client())
 
-	resp, err := events.SubmitTestEvent(context.TODO(), &req)
+	resp, err := events.SubmitTestEvent(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+
+	return resp, err
+}
+
+// DoReplayEvents defines the RPC call function for
+// the ReplayEvents from events micro service
+func DoReplayEvents(ctx context.Context, req eventsproto.EventSubRequest) (*eventsproto.EventSubResponse, error) {
+
+	events := eventsproto.NewEventsService(services.Events, services.Service.Client())
+
+	resp, err := events.ReplayEvents(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -67,11 +81,11 @@ func DoSubmitTestEvent(req eventsproto.EventSubRequest) (*eventsproto.EventSubRe
 
 // DoGetEventSubscription defines the RPC call function for
 // the DoGetEventSubscription from events micro service
-func DoGetEventSubscription(req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
+func DoGetEventSubscription(ctx context.Context, req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
 
 	events := eventsproto.NewEventsService(services.Events, services.Service.Client())
 
-	resp, err := events.GetEventSubscription(context.TODO(), &req)
+	resp, err := events.GetEventSubscription(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -81,11 +95,11 @@ func DoGetEventSubscription(req eventsproto.EventRequest) (*eventsproto.EventSub
 
 // DoDeleteEventSubscription defines the RPC call function for
 // the DoDeleteEventSubscription from events micro service
-func DoDeleteEventSubscription(req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
+func DoDeleteEventSubscription(ctx context.Context, req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
 
 	events := eventsproto.NewEventsService(services.Events, services.Service.Client())
 
-	resp, err := events.DeleteEventSubscription(context.TODO(), &req)
+	resp, err := events.DeleteEventSubscription(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -95,11 +109,11 @@ func DoDeleteEventSubscription(req eventsproto.EventRequest) (*eventsproto.Event
 
 // DoGetEventSubscriptionsCollection defines the RPC call function for
 // the DoGetEventSubscription from events micro service
-func DoGetEventSubscriptionsCollection(req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
+func DoGetEventSubscriptionsCollection(ctx context.Context, req eventsproto.EventRequest) (*eventsproto.EventSubResponse, error) {
 
 	events := eventsproto.NewEventsService(services.Events, services.Service.Client())
 
-	resp, err := events.GetEventSubscriptionsCollection(context.TODO(), &req)
+	resp, err := events.GetEventSubscriptionsCollection(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
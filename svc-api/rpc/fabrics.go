@@ -25,11 +25,11 @@ import (
 
 // GetFabricResource defines the RPC call function for
 // the GetFabricResource from fabrics micro service
-func GetFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
+func GetFabricResource(ctx context.Context, req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
 
 	fab := fabricsproto.NewFabricsService(services.Fabrics, services.Service.Client())
 
-	resp, err := fab.GetFabricResource(context.TODO(), &req)
+	resp, err := fab.GetFabricResource(ctx, &req)
 	if err != nil && resp == nil {
 		return nil, fmt.Errorf("error: something went wrong with rpc call: %v", err)
 	}
@@ -39,11 +39,11 @@ func GetFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.FabricResp
 
 // UpdateFabricResource defines the RPC call function for creating/updating
 // the Fabric Resource such as Endpoints, Zones from fabrics micro service
-func UpdateFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
+func UpdateFabricResource(ctx context.Context, req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
 
 	fab := fabricsproto.NewFabricsService(services.Fabrics, services.Service.Client())
 
-	resp, err := fab.UpdateFabricResource(context.TODO(), &req)
+	resp, err := fab.UpdateFabricResource(ctx, &req)
 	if err != nil && resp == nil {
 		return nil, fmt.Errorf("error: something went wrong with rpc call: %v", err)
 	}
@@ -53,11 +53,11 @@ func UpdateFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.FabricR
 
 // DeleteFabricResource defines the RPC call function for
 // the DeleteFabricResource from fabrics micro service
-func DeleteFabricResource(req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
+func DeleteFabricResource(ctx context.Context, req fabricsproto.FabricRequest) (*fabricsproto.FabricResponse, error) {
 
 	fab := fabricsproto.NewFabricsService(services.Fabrics, services.Service.Client())
 
-	resp, err := fab.DeleteFabricResource(context.TODO(), &req)
+	resp, err := fab.DeleteFabricResource(ctx, &req)
 	if err != nil && resp == nil {
 		return nil, fmt.Errorf("error: something went wrong with rpc call: %v", err)
 	}
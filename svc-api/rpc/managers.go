@@ -24,9 +24,9 @@ import (
 )
 
 //GetManagersCollection will do the rpc call to collect Managers
-func GetManagersCollection(req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+func GetManagersCollection(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
 	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
-	resp, err := asService.GetManagersCollection(context.TODO(), &req)
+	resp, err := asService.GetManagersCollection(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -34,9 +34,9 @@ func GetManagersCollection(req managersproto.ManagerRequest) (*managersproto.Man
 }
 
 // GetManagers will do the rpc calls for the svc-managers
-func GetManagers(req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+func GetManagers(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
 	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
-	resp, err := asService.GetManager(context.TODO(), &req)
+	resp, err := asService.GetManager(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -44,9 +44,129 @@ func GetManagers(req managersproto.ManagerRequest) (*managersproto.ManagerRespon
 }
 
 // GetManagersResource will do the rpc calls for the svc-managers
-func GetManagersResource(req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+func GetManagersResource(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
 	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
-	resp, err := asService.GetManagersResource(context.TODO(), &req)
+	resp, err := asService.GetManagersResource(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// ResetManager will do the rpc call to svc-managers for the Manager.Reset action
+func ResetManager(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.ResetManager(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// ResetManagerToDefaults will do the rpc call to svc-managers for the Manager.ResetToDefaults action
+func ResetManagerToDefaults(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.ResetManagerToDefaults(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// InsertVirtualMedia will do the rpc call to svc-managers for the VirtualMedia.InsertMedia action
+func InsertVirtualMedia(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.InsertVirtualMedia(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// EjectVirtualMedia will do the rpc call to svc-managers for the VirtualMedia.EjectMedia action
+func EjectVirtualMedia(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.EjectVirtualMedia(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// UpdateVirtualMedia will do the rpc call to svc-managers for the PATCH on a virtual media
+func UpdateVirtualMedia(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.UpdateVirtualMedia(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// CreateConsoleSession will do the rpc call to svc-managers for the creation of a console session
+func CreateConsoleSession(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.CreateConsoleSession(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// ConnectConsoleSession will do the rpc call to svc-managers for the connection of a console session
+func ConnectConsoleSession(ctx context.Context, req managersproto.ConsoleSessionRequest) (*managersproto.ConsoleSessionResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.ConnectConsoleSession(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// GetCertificateService will do the rpc call to svc-managers for getting the certificate service
+func GetCertificateService(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.GetCertificateService(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// GetCertificateLocations will do the rpc call to svc-managers for getting the certificate locations
+func GetCertificateLocations(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.GetCertificateLocations(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// GetEndpointCertificates will do the rpc call to svc-managers for getting the certificates presented by the endpoints
+func GetEndpointCertificates(ctx context.Context, req managersproto.ManagerRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.GetEndpointCertificates(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// GenerateCSR will do the rpc call to svc-managers for the CertificateService.GenerateCSR action
+func GenerateCSR(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.GenerateCSR(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// ReplaceCertificate will do the rpc call to svc-managers for the CertificateService.ReplaceCertificate action
+func ReplaceCertificate(ctx context.Context, req managersproto.ManagerActionRequest) (*managersproto.ManagerResponse, error) {
+	asService := managersproto.NewManagersService(services.Managers, services.Service.Client())
+	resp, err := asService.ReplaceCertificate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -25,9 +25,9 @@ import (
 
 // CreateRole defines the RPC call function for
 // the CreateRole from account-session micro service
-func CreateRole(req roleproto.RoleRequest) (*roleproto.RoleResponse, error) {
+func CreateRole(ctx context.Context, req roleproto.RoleRequest) (*roleproto.RoleResponse, error) {
 	asService := roleproto.NewRolesService(services.AccountSession, services.Service.Client())
-	resp, err := asService.CreateRole(context.TODO(), &req)
+	resp, err := asService.CreateRole(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -36,9 +36,9 @@ func CreateRole(req roleproto.RoleRequest) (*roleproto.RoleResponse, error) {
 
 // GetRole defines the RPC call function for
 // the GetRole from account-session micro service
-func GetRole(req roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
+func GetRole(ctx context.Context, req roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
 	asService := roleproto.NewRolesService(services.AccountSession, services.Service.Client())
-	resp, err := asService.GetRole(context.TODO(), &req)
+	resp, err := asService.GetRole(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -48,9 +48,9 @@ func GetRole(req roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
 
 // GetAllRoles defines the RPC call function for
 // the GetAllRoles from account-session micro service
-func GetAllRoles(req roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
+func GetAllRoles(ctx context.Context, req roleproto.GetRoleRequest) (*roleproto.RoleResponse, error) {
 	asService := roleproto.NewRolesService(services.AccountSession, services.Service.Client())
-	resp, err := asService.GetAllRoles(context.TODO(), &req)
+	resp, err := asService.GetAllRoles(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -60,9 +60,9 @@ func GetAllRoles(req roleproto.GetRoleRequest) (*roleproto.RoleResponse, error)
 
 // UpdateRole defines the RPC call function for
 // the UpdateRole from account-session micro service
-func UpdateRole(req roleproto.UpdateRoleRequest) (*roleproto.RoleResponse, error) {
+func UpdateRole(ctx context.Context, req roleproto.UpdateRoleRequest) (*roleproto.RoleResponse, error) {
 	asService := roleproto.NewRolesService(services.AccountSession, services.Service.Client())
-	resp, err := asService.UpdateRole(context.TODO(), &req)
+	resp, err := asService.UpdateRole(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -71,9 +71,9 @@ func UpdateRole(req roleproto.UpdateRoleRequest) (*roleproto.RoleResponse, error
 }
 
 // DeleteRole defines the RPC call function for the DeleteRole from account-session microservice
-func DeleteRole(req roleproto.DeleteRoleRequest) (*roleproto.RoleResponse, error) {
+func DeleteRole(ctx context.Context, req roleproto.DeleteRoleRequest) (*roleproto.RoleResponse, error) {
 	asService := roleproto.NewRolesService(services.AccountSession, services.Service.Client())
-	resp, err := asService.DeleteRole(context.TODO(), &req)
+	resp, err := asService.DeleteRole(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
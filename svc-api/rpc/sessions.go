@@ -24,12 +24,12 @@ import (
 )
 
 // DoSessionCreationRequest will do the rpc calls for the auth
-func DoSessionCreationRequest(req sessionproto.SessionCreateRequest) (*sessionproto.SessionCreateResponse, error) {
+func DoSessionCreationRequest(ctx context.Context, req sessionproto.SessionCreateRequest) (*sessionproto.SessionCreateResponse, error) {
 
 	asService := sessionproto.NewSessionService(services.AccountSession, services.Service.Client())
 
 	// Call the CreateSession
-	rsp, err := asService.CreateSession(context.TODO(), &req)
+	rsp, err := asService.CreateSession(ctx, &req)
 	if err != nil && rsp == nil {
 		return nil, fmt.Errorf("error while trying to make create session rpc call: %v", err)
 	}
@@ -37,12 +37,12 @@ func DoSessionCreationRequest(req sessionproto.SessionCreateRequest) (*sessionpr
 }
 
 // DeleteSessionRequest will do the rpc call to delete session
-func DeleteSessionRequest(sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
+func DeleteSessionRequest(ctx context.Context, sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
 
 	asService := sessionproto.NewSessionService(services.AccountSession, services.Service.Client())
 
 	// Call the DeleteSession
-	rsp, err := asService.DeleteSession(context.TODO(), &sessionproto.SessionRequest{
+	rsp, err := asService.DeleteSession(ctx, &sessionproto.SessionRequest{
 		SessionId:    sessionID,
 		SessionToken: sessionToken,
 	})
@@ -54,12 +54,12 @@ func DeleteSessionRequest(sessionID, sessionToken string) (*sessionproto.Session
 }
 
 // GetSessionRequest will do the rpc call to get session
-func GetSessionRequest(sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
+func GetSessionRequest(ctx context.Context, sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
 
 	asService := sessionproto.NewSessionService(services.AccountSession, services.Service.Client())
 
 	// Call the GetSession
-	rsp, err := asService.GetSession(context.TODO(), &sessionproto.SessionRequest{
+	rsp, err := asService.GetSession(ctx, &sessionproto.SessionRequest{
 		SessionId:    sessionID,
 		SessionToken: sessionToken,
 	})
@@ -71,12 +71,12 @@ func GetSessionRequest(sessionID, sessionToken string) (*sessionproto.SessionRes
 }
 
 // GetAllActiveSessionRequest will do the rpc call to get session
-func GetAllActiveSessionRequest(sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
+func GetAllActiveSessionRequest(ctx context.Context, sessionID, sessionToken string) (*sessionproto.SessionResponse, error) {
 
 	asService := sessionproto.NewSessionService(services.AccountSession, services.Service.Client())
 
 	// Call the GetAllActiveSessions
-	rsp, err := asService.GetAllActiveSessions(context.TODO(), &sessionproto.SessionRequest{
+	rsp, err := asService.GetAllActiveSessions(ctx, &sessionproto.SessionRequest{
 		SessionId:    sessionID,
 		SessionToken: sessionToken,
 	})
@@ -88,11 +88,11 @@ func GetAllActiveSessionRequest(sessionID, sessionToken string) (*sessionproto.S
 }
 
 //GetSessionServiceRequest will do the rpc call to check session
-func GetSessionServiceRequest() (*sessionproto.SessionResponse, error) {
+func GetSessionServiceRequest(ctx context.Context) (*sessionproto.SessionResponse, error) {
 	asService := sessionproto.NewSessionService(services.AccountSession, services.Service.Client())
 
 	// Call the GetSessionService
-	rsp, err := asService.GetSessionService(context.TODO(), &sessionproto.SessionRequest{})
+	rsp, err := asService.GetSessionService(ctx, &sessionproto.SessionRequest{})
 	if err != nil && rsp == nil {
 		return nil, fmt.Errorf("error while trying to make get session service rpc call: %v", err)
 	}
@@ -24,9 +24,9 @@ import (
 )
 
 //GetSystemsCollection will do the rpc call to collect Systems from odimra
-func GetSystemsCollection(req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
+func GetSystemsCollection(ctx context.Context, req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
 	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
-	resp, err := asService.GetSystemsCollection(context.TODO(), &req)
+	resp, err := asService.GetSystemsCollection(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -34,9 +34,9 @@ func GetSystemsCollection(req systemsproto.GetSystemsRequest) (*systemsproto.Sys
 }
 
 // GetSystemRequestRPC will do the rpc calls for the svc-systems
-func GetSystemRequestRPC(req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
+func GetSystemRequestRPC(ctx context.Context, req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
 	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
-	resp, err := asService.GetSystems(context.TODO(), &req)
+	resp, err := asService.GetSystems(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -44,9 +44,9 @@ func GetSystemRequestRPC(req systemsproto.GetSystemsRequest) (*systemsproto.Syst
 }
 
 //GetSystemResource will do the rpc call to collect System Resource
-func GetSystemResource(req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
+func GetSystemResource(ctx context.Context, req systemsproto.GetSystemsRequest) (*systemsproto.SystemsResponse, error) {
 	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
-	resp, err := asService.GetSystemResource(context.TODO(), &req)
+	resp, err := asService.GetSystemResource(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -54,9 +54,9 @@ func GetSystemResource(req systemsproto.GetSystemsRequest) (*systemsproto.System
 }
 
 // ComputerSystemReset will do the rpc call to reset the computer system
-func ComputerSystemReset(req systemsproto.ComputerSystemResetRequest) (*systemsproto.SystemsResponse, error) {
+func ComputerSystemReset(ctx context.Context, req systemsproto.ComputerSystemResetRequest) (*systemsproto.SystemsResponse, error) {
 	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
-	resp, err := asService.ComputerSystemReset(context.TODO(), &req)
+	resp, err := asService.ComputerSystemReset(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -64,9 +64,9 @@ func ComputerSystemReset(req systemsproto.ComputerSystemResetRequest) (*systemsp
 }
 
 // SetDefaultBootOrder will do the rpc call to set the default boot order of computer system
-func SetDefaultBootOrder(req systemsproto.DefaultBootOrderRequest) (*systemsproto.SystemsResponse, error) {
+func SetDefaultBootOrder(ctx context.Context, req systemsproto.DefaultBootOrderRequest) (*systemsproto.SystemsResponse, error) {
 	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
-	resp, err := asService.SetDefaultBootOrder(context.TODO(), &req)
+	resp, err := asService.SetDefaultBootOrder(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -74,9 +74,9 @@ func SetDefaultBootOrder(req systemsproto.DefaultBootOrderRequest) (*systemsprot
 }
 
 // ChangeBiosSettings will do the rpc call to change bios settings
-func ChangeBiosSettings(req systemsproto.BiosSettingsRequest) (*systemsproto.SystemsResponse, error) {
+func ChangeBiosSettings(ctx context.Context, req systemsproto.BiosSettingsRequest) (*systemsproto.SystemsResponse, error) {
 	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
-	resp, err := asService.ChangeBiosSettings(context.TODO(), &req)
+	resp, err := asService.ChangeBiosSettings(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -84,9 +84,9 @@ func ChangeBiosSettings(req systemsproto.BiosSettingsRequest) (*systemsproto.Sys
 }
 
 // ChangeBootOrderSettings will do the rpc call to change Boot Order settings
-func ChangeBootOrderSettings(req systemsproto.BootOrderSettingsRequest) (*systemsproto.SystemsResponse, error) {
+func ChangeBootOrderSettings(ctx context.Context, req systemsproto.BootOrderSettingsRequest) (*systemsproto.SystemsResponse, error) {
 	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
-	resp, err := asService.ChangeBootOrderSettings(context.TODO(), &req)
+	resp, err := asService.ChangeBootOrderSettings(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -94,9 +94,9 @@ func ChangeBootOrderSettings(req systemsproto.BootOrderSettingsRequest) (*system
 }
 
 // CreateVolume will do the rpc call to create a volume under storage
-func CreateVolume(req systemsproto.VolumeRequest) (*systemsproto.SystemsResponse, error) {
+func CreateVolume(ctx context.Context, req systemsproto.VolumeRequest) (*systemsproto.SystemsResponse, error) {
 	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
-	resp, err := asService.CreateVolume(context.TODO(), &req)
+	resp, err := asService.CreateVolume(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -104,9 +104,19 @@ func CreateVolume(req systemsproto.VolumeRequest) (*systemsproto.SystemsResponse
 }
 
 // DeleteVolume will do the rpc call to DeleteVolume a volume under storage
-func DeleteVolume(req systemsproto.VolumeRequest) (*systemsproto.SystemsResponse, error) {
+func DeleteVolume(ctx context.Context, req systemsproto.VolumeRequest) (*systemsproto.SystemsResponse, error) {
 	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
-	resp, err := asService.DeleteVolume(context.TODO(), &req)
+	resp, err := asService.DeleteVolume(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error: RPC error: %v", err)
+	}
+	return resp, nil
+}
+
+// PassThrough will do the rpc call to forward a request on a resource which is not modelled to the plugin
+func PassThrough(ctx context.Context, req systemsproto.PassThroughRequest) (*systemsproto.SystemsResponse, error) {
+	asService := systemsproto.NewSystemsService(services.Systems, services.Service.Client())
+	resp, err := asService.PassThrough(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -28,10 +28,10 @@ import (
 )
 
 // DeleteTaskRequest will do the rpc calls for the svc-task DeleteTask
-func DeleteTaskRequest(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func DeleteTaskRequest(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	asService := taskproto.NewGetTaskService(services.Tasks, services.Service.Client())
 	// Call the DeleteTask
-	rsp, err := asService.DeleteTask(context.TODO(), req)
+	rsp, err := asService.DeleteTask(ctx, req)
 	if err != nil {
 		resp := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		body, _ := json.Marshal(resp.Body)
@@ -46,10 +46,10 @@ func DeleteTaskRequest(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse,
 }
 
 // GetTaskRequest will do the rpc calls for the svc-task GetTaskStatus
-func GetTaskRequest(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func GetTaskRequest(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	asService := taskproto.NewGetTaskService(services.Tasks, services.Service.Client())
 	// Call the GetTasks
-	rsp, err := asService.GetTasks(context.TODO(), req)
+	rsp, err := asService.GetTasks(ctx, req)
 	if err != nil {
 		resp := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		body, _ := json.Marshal(resp.Body)
@@ -64,10 +64,10 @@ func GetTaskRequest(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, err
 }
 
 // GetSubTasks will do the rpc calls for the svc-task GetSubTasks
-func GetSubTasks(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func GetSubTasks(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	tService := taskproto.NewGetTaskService(services.Tasks, services.Service.Client())
 	// Call the GetSubTasks
-	rsp, err := tService.GetSubTasks(context.TODO(), req)
+	rsp, err := tService.GetSubTasks(ctx, req)
 	if err != nil {
 		resp := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		body, _ := json.Marshal(resp.Body)
@@ -82,10 +82,10 @@ func GetSubTasks(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
 }
 
 // GetSubTask will do the rpc calls for the svc-task GetSubTask
-func GetSubTask(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func GetSubTask(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	tService := taskproto.NewGetTaskService(services.Tasks, services.Service.Client())
 	// Call the GetSubTask
-	rsp, err := tService.GetSubTask(context.TODO(), req)
+	rsp, err := tService.GetSubTask(ctx, req)
 	if err != nil {
 		resp := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		body, _ := json.Marshal(resp.Body)
@@ -100,10 +100,10 @@ func GetSubTask(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error)
 }
 
 // GetTaskMonitor will do the rpc calls for the svc-task GetTaskMonitor
-func GetTaskMonitor(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func GetTaskMonitor(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	tService := taskproto.NewGetTaskService(services.Tasks, services.Service.Client())
 	// perform rpc call to svc-task to get TaskMonitor resource
-	rsp, err := tService.GetTaskMonitor(context.TODO(), req)
+	rsp, err := tService.GetTaskMonitor(ctx, req)
 	if err != nil {
 		resp := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		body, _ := json.Marshal(resp.Body)
@@ -118,10 +118,10 @@ func GetTaskMonitor(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, err
 }
 
 //TaskCollection will perform the rpc call to svc-task TaskCollection
-func TaskCollection(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func TaskCollection(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	tService := taskproto.NewGetTaskService(services.Tasks, services.Service.Client())
 	// perform rpc call to svc-task to get TaskCollection resource
-	rsp, err := tService.TaskCollection(context.TODO(), req)
+	rsp, err := tService.TaskCollection(ctx, req)
 	if err != nil {
 		resp := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		body, _ := json.Marshal(resp.Body)
@@ -136,10 +136,10 @@ func TaskCollection(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, err
 }
 
 //GetTaskService will perform the rpc call to svc-task GetTaskService
-func GetTaskService(req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
+func GetTaskService(ctx context.Context, req *taskproto.GetTaskRequest) (*taskproto.TaskResponse, error) {
 	tService := taskproto.NewGetTaskService(services.Tasks, services.Service.Client())
 	// perform rpc call to svc-task to get TaskService resource
-	rsp, err := tService.GetTaskService(context.TODO(), req)
+	rsp, err := tService.GetTaskService(ctx, req)
 	if err != nil {
 		resp := common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 		body, _ := json.Marshal(resp.Body)
@@ -25,11 +25,11 @@ import (
 
 // DoGetUpdateService defines the RPC call function for
 // the GetUpdateService from update micro service
-func DoGetUpdateService(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func DoGetUpdateService(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 
 	update := updateproto.NewUpdateService(services.Update, services.Service.Client())
 
-	resp, err := update.GetUpdateService(context.TODO(), &req)
+	resp, err := update.GetUpdateService(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -39,10 +39,10 @@ func DoGetUpdateService(req updateproto.UpdateRequest) (*updateproto.UpdateRespo
 
 // DoGetFirmwareInventory defines the RPC call function for
 // the GetFirmwareInventory from update micro service
-func DoGetFirmwareInventory(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func DoGetFirmwareInventory(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 
 	update := updateproto.NewUpdateService(services.Update, services.Service.Client())
-	resp, err := update.GetFirmwareInventory(context.TODO(), &req)
+	resp, err := update.GetFirmwareInventory(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -52,10 +52,10 @@ func DoGetFirmwareInventory(req updateproto.UpdateRequest) (*updateproto.UpdateR
 
 // DoGetSoftwareInventory defines the RPC call function for
 // the GetSoftwareInventory from update micro service
-func DoGetSoftwareInventory(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func DoGetSoftwareInventory(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 
 	update := updateproto.NewUpdateService(services.Update, services.Service.Client())
-	resp, err := update.GetSoftwareInventory(context.TODO(), &req)
+	resp, err := update.GetSoftwareInventory(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -65,10 +65,10 @@ func DoGetSoftwareInventory(req updateproto.UpdateRequest) (*updateproto.UpdateR
 
 // DoGetFirmwareInventoryCollection defines the RPC call function for
 // the GetFirmwareInventory from update micro service
-func DoGetFirmwareInventoryCollection(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func DoGetFirmwareInventoryCollection(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 
 	update := updateproto.NewUpdateService(services.Update, services.Service.Client())
-	resp, err := update.GetFirmwareInventoryCollection(context.TODO(), &req)
+	resp, err := update.GetFirmwareInventoryCollection(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -78,10 +78,10 @@ func DoGetFirmwareInventoryCollection(req updateproto.UpdateRequest) (*updatepro
 
 // DoGetSoftwareInventoryCollection defines the RPC call function for
 // the GetSoftwareInventory from update micro service
-func DoGetSoftwareInventoryCollection(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func DoGetSoftwareInventoryCollection(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 
 	update := updateproto.NewUpdateService(services.Update, services.Service.Client())
-	resp, err := update.GetSoftwareInventoryCollection(context.TODO(), &req)
+	resp, err := update.GetSoftwareInventoryCollection(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -91,11 +91,11 @@ func DoGetSoftwareInventoryCollection(req updateproto.UpdateRequest) (*updatepro
 
 // DoSimpleUpdate defines the RPC call for
 // SimpleUpdate from update micro service
-func DoSimpleUpdate(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func DoSimpleUpdate(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 
 	update := updateproto.NewUpdateService(services.Update, services.Service.Client())
 
-	resp, err := update.SimepleUpdate(context.TODO(), &req)
+	resp, err := update.SimepleUpdate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -105,11 +105,11 @@ func DoSimpleUpdate(req updateproto.UpdateRequest) (*updateproto.UpdateResponse,
 
 // DoStartUpdate defines the RPC call for
 // StartUpdate from update micro service
-func DoStartUpdate(req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
+func DoStartUpdate(ctx context.Context, req updateproto.UpdateRequest) (*updateproto.UpdateResponse, error) {
 
 	update := updateproto.NewUpdateService(services.Update, services.Service.Client())
 
-	resp, err := update.StartUpdate(context.TODO(), &req)
+	resp, err := update.StartUpdate(ctx, &req)
 	if err != nil {
 		return nil, fmt.Errorf("error: RPC error: %v", err)
 	}
@@ -18,25 +18,25 @@
 package consumer
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	log "github.com/sirupsen/logrus"
+	"time"
 
 	dc "github.com/ODIM-Project/ODIM/lib-messagebus/datacommunicator"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
 )
 
-var (
-	// In Channel
-	In chan<- interface{}
-	// Out Channel
-	Out <-chan interface{}
-)
-
-var done = make(chan bool)
+// PublishEvents publishes the consumed events to the subscribers, the
+// message is acknowledged on the message bus once it returns
+var PublishEvents func(interface{}) bool
 
-// KafkaSubscriber consume messages from PMB
-func KafkaSubscriber(event interface{}) {
+// KafkaSubscriber consume messages from PMB, the trace context propagated
+// with the message in ctx is carried along with the events
+func KafkaSubscriber(ctx context.Context, event interface{}) {
 	byteData, _ := json.Marshal(&event)
 	var kafkaMessage common.Events
 
@@ -45,16 +45,9 @@ func KafkaSubscriber(event interface{}) {
 		log.Error("error while unmarshaling the event" + err.Error())
 		return
 	}
-	writeEventToJobQueue(kafkaMessage)
-}
-
-// writeEventToJobQueue align events to job queue
-func writeEventToJobQueue(kafkaMessage common.Events) {
-	// events contains a slice of event subscribed from kafka
-	var events = make([]interface{}, 0)
-	events = append(events, kafkaMessage)
-
-	go common.RunWriteWorkers(In, events, 5, done)
+	kafkaMessage.TraceContext = make(map[string]string)
+	tracing.InjectMap(ctx, kafkaMessage.TraceContext)
+	PublishEvents(kafkaMessage)
 }
 
 // Consume create a consumer for message bus
@@ -69,10 +62,26 @@ func Consume(topicName string) {
 		log.Error("Unable to connect to kafka" + err.Error())
 		return
 	}
-	// subscribe from message bus
-	if err := k.Accept(topicName, KafkaSubscriber); err != nil {
+	// subscribe from message bus, the consumers of all the instances
+	// share the topic partitions and the offsets are committed
+	// once the events are published
+	if err := k.AcceptWithContext(topicName, KafkaSubscriber); err != nil {
 		log.Error(err.Error())
 		return
 	}
 	return
 }
+
+// Replay publishes again the events on the topic from the given time,
+// the offsets of the consumers are not affected
+func Replay(topicName string, since time.Time) error {
+	config.TLSConfMutex.RLock()
+	messageQueueConfigFilePath := config.Data.MessageQueueConfigFilePath
+	config.TLSConfMutex.RUnlock()
+	k, err := dc.Communicator(dc.KAFKA, messageQueueConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("unable to connect to kafka: %v", err)
+	}
+	defer k.Close()
+	return k.Replay(topicName, since, KafkaSubscriber)
+}
@@ -16,8 +16,8 @@
 package consumer
 
 import (
+	"context"
 	"encoding/json"
-	"sync"
 	"testing"
 	"time"
 
@@ -43,7 +43,12 @@ func TestConsume(t *testing.T) {
 }
 
 func TestKafkaSubscriber(t *testing.T) {
-	In, Out = common.CreateJobQueue()
+	var published []interface{}
+	PublishEvents = func(event interface{}) bool {
+		published = append(published, event)
+		return true
+	}
+	defer func() { PublishEvents = nil }()
 	eventMessage := common.MessageData{
 		Name:    "Event",
 		Context: "context",
@@ -62,24 +67,18 @@ func TestKafkaSubscriber(t *testing.T) {
 		IP:      "10.1.2.3",
 		Request: event,
 	}
-	KafkaSubscriber(kafkaMessage)
-
-	var currentData int
+	KafkaSubscriber(context.TODO(), kafkaMessage)
 
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		for range Out {
-			currentData++
-		}
-		wg.Done()
-	}()
-	time.Sleep(2 * time.Second)
-	<-done
-	close(In)
-	wg.Wait()
+	if len(published) != 1 {
+		t.Fatalf("error: expected count is 1 but got %v", len(published))
+	}
+	if got := published[0].(common.Events); got.IP != kafkaMessage.IP {
+		t.Errorf("error: expected event from %v but got %v", kafkaMessage.IP, got.IP)
+	}
+}
 
-	if currentData != 1 {
-		t.Errorf("error: expected count is 1 but got %v", currentData)
+func TestReplay(t *testing.T) {
+	if err := Replay("topic", time.Now()); err == nil {
+		t.Errorf("error: expected error while replaying without message bus but got nil")
 	}
 }
@@ -16,6 +16,7 @@
 package evcommon
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	log "github.com/sirupsen/logrus"
@@ -27,7 +28,7 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ODIM-Project/ODIM/lib-rest-client/pmbhandle"
+	"github.com/ODIM-Project/ODIM/lib-rest-client/pluginclient"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
@@ -68,13 +69,11 @@ type SavedSystems struct {
 
 //PluginContactRequest holds the details required to contact the plugin
 type PluginContactRequest struct {
-	URL             string
-	HTTPMethodType  string
-	ContactClient   func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
-	PostBody        interface{}
-	LoginCredential map[string]string
-	Token           string
-	Plugin          *evmodel.Plugin
+	URL            string
+	HTTPMethodType string
+	ContactClient  func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
+	PostBody       interface{}
+	Plugin         *evmodel.Plugin
 }
 
 //StartUpMap holds required data for plugin startup
@@ -84,29 +83,6 @@ type StartUpMap struct {
 	Device     SavedSystems
 }
 
-// PluginToken interface to hold the token
-type PluginToken struct {
-	Tokens map[string]string
-	lock   sync.Mutex
-}
-
-// Token variable hold the all the XAuthToken  against the plguin ID
-var Token PluginToken
-
-// StoreToken to store the token ioto the  map
-func (p *PluginToken) StoreToken(plguinID, token string) {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-	p.Tokens[plguinID] = token
-}
-
-// GetToken to get the token from map
-func (p *PluginToken) GetToken(pluginID string) string {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-	return p.Tokens[pluginID]
-}
-
 // ConsumeTopic check the existing topic list if it is not present then it will add topic name to list and consume that topic
 func (e *EmbTopic) ConsumeTopic(topicName string) {
 	e.lock.RLock()
@@ -118,6 +94,24 @@ func (e *EmbTopic) ConsumeTopic(topicName string) {
 	}
 }
 
+// ReplayTopics replays the events on all the consuming topics since the given time,
+// the consumer offsets are not affected by the replay
+func (e *EmbTopic) ReplayTopics(since time.Time) {
+	e.lock.RLock()
+	topics := make([]string, 0, len(e.TopicsList))
+	for topicName := range e.TopicsList {
+		topics = append(topics, topicName)
+	}
+	e.lock.RUnlock()
+	for _, topicName := range topics {
+		if err := consumer.Replay(topicName, since); err != nil {
+			log.Error("error while replaying the events on topic " + topicName + ": " + err.Error())
+			continue
+		}
+		log.Info("replayed the events on topic " + topicName)
+	}
+}
+
 // EMBTopics used to store the list of all topics
 var EMBTopics EmbTopic
 
@@ -289,29 +283,11 @@ func callPluginStartUp(servers []SavedSystems, pluginID string) error {
 	contactRequest.HTTPMethodType = http.MethodPost
 	contactRequest.PostBody = startUpMap
 
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		contactRequest.HTTPMethodType = http.MethodPost
-		contactRequest.PostBody = map[string]interface{}{
-			"Username": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		contactRequest.URL = "/ODIM/v1/Sessions"
-		response, err := callPlugin(contactRequest)
-		if err != nil {
-			return err
-		}
-		contactRequest.Token = response.Header.Get("X-Auth-Token")
-	} else {
-		contactRequest.LoginCredential = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-	}
-	response, err := callPlugin(contactRequest)
+	response, err := CallPlugin(contactRequest)
 	if err != nil {
 		return err
 	}
+	defer response.Body.Close()
 
 	//return updateDeviceSubscriptionLocation(startUpMap[0].Device.ManagerAddress, response.Header.Get("location"))
 	bodyBytes, err := ioutil.ReadAll(response.Body)
@@ -324,16 +300,42 @@ func callPluginStartUp(servers []SavedSystems, pluginID string) error {
 	return updateDeviceSubscriptionLocation(r)
 }
 
-func callPlugin(req PluginContactRequest) (*http.Response, error) {
-	var reqURL = "https://" + req.Plugin.IP + ":" + req.Plugin.Port + req.URL
-	if strings.EqualFold(req.Plugin.PreferredAuthType, "XAuthToken") {
-		return pmbhandle.ContactPlugin(reqURL, req.HTTPMethodType, "", "", req.PostBody, nil)
-	}
-	if strings.EqualFold(req.Plugin.PreferredAuthType, "BasicAuth") {
-		return pmbhandle.ContactPlugin(reqURL, req.HTTPMethodType, "", "", req.PostBody, req.LoginCredential)
+// CallPlugin sends the request to the plugin with the session token or the basic authentication
+// of the plugin. An error is returned when the plugin couldn't be reached, the response of the
+// plugin being returned otherwise, with the error response of the plugin as a Redfish error response.
+func CallPlugin(req PluginContactRequest) (*http.Response, error) {
+	pluginResponse, err := pluginclient.Do(pluginclient.Request{
+		Plugin: pluginclient.Plugin{
+			ID:                req.Plugin.ID,
+			IP:                req.Plugin.IP,
+			Port:              req.Plugin.Port,
+			Username:          req.Plugin.Username,
+			Password:          req.Plugin.Password,
+			PreferredAuthType: req.Plugin.PreferredAuthType,
+		},
+		Method:        req.HTTPMethodType,
+		URI:           req.URL,
+		Body:          req.PostBody,
+		ContactClient: req.ContactClient,
+		StatusCheck: func() bool {
+			return GetPluginStatus(req.Plugin)
+		},
+	})
+	if err != nil {
+		if err.Body == nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: int(err.StatusCode),
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader(err.ResponseBody())),
+		}, nil
 	}
-	return pmbhandle.ContactPlugin(reqURL, req.HTTPMethodType, req.Token, "", req.PostBody, nil)
-
+	return &http.Response{
+		StatusCode: pluginResponse.StatusCode,
+		Header:     pluginResponse.Header,
+		Body:       ioutil.NopCloser(bytes.NewReader(pluginResponse.Body)),
+	}, nil
 }
 
 func getSubscribedEventsDetails(serverAddress string) (string, []string, error) {
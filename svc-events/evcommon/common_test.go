@@ -465,17 +465,6 @@ func TestCallPluginStartUp(t *testing.T) {
 	assert.NotNil(t, err, "error should not be nil")
 }
 
-func TestGetandStoreToken(t *testing.T) {
-	var result = &PluginToken{
-		Tokens: make(map[string]string),
-	}
-	plguinID := "GRF"
-	token := "token"
-	result.StoreToken(plguinID, token)
-	respToken := result.GetToken(plguinID)
-	assert.Equal(t, token, respToken, "respToken should be token")
-}
-
 func TestGetPluginStatus(t *testing.T) {
 	config.SetUpMockConfig(t)
 	ts := startTestServer()
@@ -22,16 +22,16 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-events/evcommon"
@@ -39,7 +39,7 @@ import (
 )
 
 // DeleteEventSubscriptions delete subscription data against given URL
-func (p *PluginContact) DeleteEventSubscriptions(req *eventsproto.EventRequest) response.RPC {
+func (p *PluginContact) DeleteEventSubscriptions(ctx context.Context, req *eventsproto.EventRequest) response.RPC {
 	var resp response.RPC
 	originResource := req.UUID
 	uuid, err := getUUID(originResource)
@@ -47,12 +47,13 @@ func (p *PluginContact) DeleteEventSubscriptions(req *eventsproto.EventRequest)
 		errorMessage := err.Error()
 		msgArgs := []interface{}{"OriginResource", originResource}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return resp
 	}
+	ctx = logs.With(ctx, logs.SystemIDField, uuid)
 	target, err := evmodel.GetTarget(uuid)
 	if err != nil {
-		log.Error("error while getting device details : " + err.Error())
+		logs.Log(ctx).Error("error while getting device details : " + err.Error())
 		errorMessage := err.Error()
 		msgArgs := []interface{}{"uuid", uuid}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
@@ -62,14 +63,14 @@ func (p *PluginContact) DeleteEventSubscriptions(req *eventsproto.EventRequest)
 	if errorMessage != "" {
 		msgArgs := []interface{}{"Host", target.ManagerAddress}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusNotFound, msgArgs, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp
 	}
 	searchKey := evcommon.GetSearchKey(deviceIPAddress, evmodel.SubscriptionIndex)
-	log.Info("Getting event subscription details of device: ", deviceIPAddress)
+	logs.Log(ctx).Info("Getting event subscription details of device: ", deviceIPAddress)
 	subscriptionDetails, err := evmodel.GetEvtSubscriptions(searchKey)
 	if err != nil && !strings.Contains(err.Error(), "No data found for the key") {
-		log.Error("error while getting event subscription details : " + err.Error())
+		logs.Log(ctx).Error("error while getting event subscription details : " + err.Error())
 		errorMessage := err.Error()
 		msgArgs := []interface{}{"Host", target.ManagerAddress}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusNotFound, msgArgs, &resp)
@@ -79,25 +80,25 @@ func (p *PluginContact) DeleteEventSubscriptions(req *eventsproto.EventRequest)
 		errorMessage := fmt.Sprintf("Subscription details not found for the requested device")
 		msgArgs := []interface{}{"Host", target.ManagerAddress}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusNotFound, msgArgs, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp
 	}
-	log.Info("Number of subscription present :", strconv.Itoa(len(subscriptionDetails)))
+	logs.Log(ctx).Info("Number of subscription present :", strconv.Itoa(len(subscriptionDetails)))
 	decryptedPasswordByte, err := common.DecryptWithPrivateKey(target.Password)
 	if err != nil {
 		// Frame the RPC response body and response Header below
 		errorMessage := "error while trying to decrypt device password: " + err.Error()
 		msgArgs := []interface{}{""}
 		evcommon.GenErrorResponse(errorMessage, response.InternalError, http.StatusInternalServerError, msgArgs, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp
 	}
 	target.Password = decryptedPasswordByte
 
 	// Delete Event Subscription from device also
-	err = p.deleteSubscription(target, originResource)
+	err = p.deleteSubscription(ctx, target, originResource)
 	if err != nil {
-		log.Error("error while deleting eventsubscription details : " + err.Error())
+		logs.Log(ctx).Error("error while deleting eventsubscription details : " + err.Error())
 		errorMessage := err.Error()
 		msgArgs := []interface{}{"Host", target.ManagerAddress}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
@@ -110,11 +111,11 @@ func (p *PluginContact) DeleteEventSubscriptions(req *eventsproto.EventRequest)
 		errorMessage := "Error while get subscription details of device : " + err.Error()
 		msgArgs := []interface{}{"Host", target.ManagerAddress}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp
 	}
 	originResource = deviceSubscription.OriginResources[0]
-	log.Info("Device subcription information", deviceSubscription.EventHostIP)
+	logs.Log(ctx).Info("Device subcription information", deviceSubscription.EventHostIP)
 
 	for _, evtSubscription := range subscriptionDetails {
 
@@ -128,7 +129,7 @@ func (p *PluginContact) DeleteEventSubscriptions(req *eventsproto.EventRequest)
 				errorMessage := "Error while Updating event subscription : " + err.Error()
 				msgArgs := []interface{}{"SubscriptionID", evtSubscription.SubscriptionID}
 				evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
-				log.Error(errorMessage)
+				logs.Log(ctx).Error(errorMessage)
 				return resp
 			}
 		} else {
@@ -140,7 +141,7 @@ func (p *PluginContact) DeleteEventSubscriptions(req *eventsproto.EventRequest)
 				errorMessage := "Error while Updating event subscription : " + err.Error()
 				msgArgs := []interface{}{"SubscriptionID", evtSubscription.SubscriptionID}
 				evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
-				log.Error(errorMessage)
+				logs.Log(ctx).Error(errorMessage)
 				return resp
 			}
 		}
@@ -151,7 +152,7 @@ func (p *PluginContact) DeleteEventSubscriptions(req *eventsproto.EventRequest)
 		errorMessage := "Error while deleting device subscription : " + err.Error()
 		msgArgs := []interface{}{"Host", target.ManagerAddress}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp
 	}
 
@@ -161,26 +162,27 @@ func (p *PluginContact) DeleteEventSubscriptions(req *eventsproto.EventRequest)
 }
 
 // deleteSubscription to the Event Subsciption
-func (p *PluginContact) deleteSubscription(target *evmodel.Target, originResource string) error {
+func (p *PluginContact) deleteSubscription(ctx context.Context, target *evmodel.Target, originResource string) error {
 
 	var plugin *evmodel.Plugin
+	ctx = logs.With(ctx, logs.PluginIDField, target.PluginID)
 	plugin, err := evmodel.GetPluginData(target.PluginID)
 	if err != nil {
 		return err
 	}
 
-	if _, errs := p.DeleteSubscriptions(originResource, "", plugin, target); errs != nil {
+	if _, errs := p.DeleteSubscriptions(ctx, originResource, "", plugin, target); errs != nil {
 		return errs
 	}
 	return nil
 }
 
 // DeleteEventSubscriptionsDetails delete subscription data against given subscription id
-func (p *PluginContact) DeleteEventSubscriptionsDetails(req *eventsproto.EventRequest) response.RPC {
+func (p *PluginContact) DeleteEventSubscriptionsDetails(ctx context.Context, req *eventsproto.EventRequest) response.RPC {
 	var resp response.RPC
 	authResp := p.Auth(req.SessionToken, []string{common.PrivilegeConfigureComponents}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authenticate session: status code: " + string(authResp.StatusCode) + ", status message: " + authResp.StatusMessage)
+		logs.Log(ctx).Error("error while trying to authenticate session: status code: " + string(authResp.StatusCode) + ", status message: " + authResp.StatusMessage)
 		return authResp
 	}
 	resp.Header = map[string]string{
@@ -194,7 +196,7 @@ func (p *PluginContact) DeleteEventSubscriptionsDetails(req *eventsproto.EventRe
 
 	subscriptionDetails, err := evmodel.GetEvtSubscriptions(req.EventSubscriptionID)
 	if err != nil && !strings.Contains(err.Error(), "No data found for the key") {
-		log.Error("error while deleting eventsubscription details : " + err.Error())
+		logs.Log(ctx).Error("error while deleting eventsubscription details : " + err.Error())
 		errorMessage := err.Error()
 		msgArgs := []interface{}{"SubscriptionID", req.EventSubscriptionID}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
@@ -203,7 +205,7 @@ func (p *PluginContact) DeleteEventSubscriptionsDetails(req *eventsproto.EventRe
 
 	if len(subscriptionDetails) < 1 {
 		errorMessage := fmt.Sprintf("Subscription details not found for subscription id: %s", req.EventSubscriptionID)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		var msgArgs = []interface{}{"SubscrfiptionID", req.EventSubscriptionID}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusNotFound, msgArgs, &resp)
 		return resp
@@ -214,16 +216,16 @@ func (p *PluginContact) DeleteEventSubscriptionsDetails(req *eventsproto.EventRe
 		// we need to match the subscripton id
 		if evtSubscription.SubscriptionID != req.EventSubscriptionID {
 			errorMessage := fmt.Sprintf("Subscription details not found for subscription id: %s", req.EventSubscriptionID)
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			var msgArgs = []interface{}{"SubscriptionID", req.EventSubscriptionID}
 			evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusNotFound, msgArgs, &resp)
 			return resp
 		}
 
 		// Delete and re subscrive Event Subscription
-		err = p.deleteAndReSubscribetoEvents(evtSubscription)
+		err = p.deleteAndReSubscribetoEvents(ctx, evtSubscription)
 		if err != nil {
-			log.Error("error while deleting eventsubscription details : " + err.Error())
+			logs.Log(ctx).Error("error while deleting eventsubscription details : " + err.Error())
 			errorMessage := err.Error()
 			msgArgs := []interface{}{"SubscriptionID", req.EventSubscriptionID}
 			evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
@@ -233,7 +235,7 @@ func (p *PluginContact) DeleteEventSubscriptionsDetails(req *eventsproto.EventRe
 		// Delete Event Subscription from the DB
 		err = evmodel.DeleteEvtSubscription(evtSubscription.SubscriptionID)
 		if err != nil {
-			log.Error("error while deleting eventsubscription details : " + err.Error())
+			logs.Log(ctx).Error("error while deleting eventsubscription details : " + err.Error())
 			errorMessage := err.Error()
 			msgArgs := []interface{}{"SubscriptionID", req.EventSubscriptionID}
 			evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusBadRequest, msgArgs, &resp)
@@ -258,7 +260,7 @@ func (p *PluginContact) DeleteEventSubscriptionsDetails(req *eventsproto.EventRe
 }
 
 // This function is to delete and re subscribe for Event Subscriptions
-func (p *PluginContact) deleteAndReSubscribetoEvents(evtSubscription evmodel.Subscription) error {
+func (p *PluginContact) deleteAndReSubscribetoEvents(ctx context.Context, evtSubscription evmodel.Subscription) error {
 	originResources := evtSubscription.OriginResources
 	for _, origin := range originResources {
 		// ignore if origin is empty
@@ -330,7 +332,7 @@ func (p *PluginContact) deleteAndReSubscribetoEvents(evtSubscription evmodel.Sub
 			Destination:   destination,
 		}
 
-		err = p.subscribe(subscriptionPost, origin, deleteflag)
+		err = p.subscribe(ctx, subscriptionPost, origin, deleteflag)
 		if err != nil {
 			return err
 		}
@@ -367,21 +369,23 @@ func isCollectionOriginResourceURI(origin string) bool {
 }
 
 // Subscribe to the Event Subsciption
-func (p *PluginContact) subscribe(subscriptionPost evmodel.EvtSubPost, origin string, deleteflag bool) error {
+func (p *PluginContact) subscribe(ctx context.Context, subscriptionPost evmodel.EvtSubPost, origin string, deleteflag bool) error {
 
 	if strings.Contains(origin, "Fabrics") {
-		return p.resubscribeFabricsSubscription(subscriptionPost, origin, deleteflag)
+		return p.resubscribeFabricsSubscription(ctx, subscriptionPost, origin, deleteflag)
 	}
 	originResource := origin
 	if isCollectionOriginResourceURI(originResource) {
-		log.Error("Collection of origin resource:" + originResource)
+		logs.Log(ctx).Error("Collection of origin resource:" + originResource)
 		return nil
 	}
-	target, _, err := getTargetDetails(originResource)
+	target, _, err := getTargetDetails(ctx, originResource)
 	if err != nil {
 		return err
 	}
 
+	ctx = logs.With(ctx, logs.SystemIDField, target.DeviceUUID)
+	ctx = logs.With(ctx, logs.PluginIDField, target.PluginID)
 	plugin, errs := evmodel.GetPluginData(target.PluginID)
 	if errs != nil {
 		return errs
@@ -392,7 +396,7 @@ func (p *PluginContact) subscribe(subscriptionPost evmodel.EvtSubPost, origin st
 		return fmt.Errorf("Error while marshalling subscription details: %s", err)
 	}
 	target.PostBody = postBody
-	_, err = p.DeleteSubscriptions(origin, "", plugin, target)
+	_, err = p.DeleteSubscriptions(ctx, origin, "", plugin, target)
 	if err != nil {
 		return err
 	}
@@ -405,24 +409,11 @@ func (p *PluginContact) subscribe(subscriptionPost evmodel.EvtSubPost, origin st
 	var contactRequest evcommon.PluginContactRequest
 
 	contactRequest.Plugin = plugin
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		token := p.getPluginToken(plugin)
-		if token == "" {
-			return fmt.Errorf("error: Unable to create session with plugin " + plugin.ID)
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.LoginCredential = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	contactRequest.URL = "/ODIM/v1/Subscriptions"
 	contactRequest.HTTPMethodType = http.MethodPost
 	contactRequest.PostBody = target
 
-	_, loc, _, err := p.PluginCall(contactRequest)
+	_, loc, _, err := p.PluginCall(ctx, contactRequest)
 	if err != nil {
 		return err
 	}
@@ -432,7 +423,7 @@ func (p *PluginContact) subscribe(subscriptionPost evmodel.EvtSubPost, origin st
 	if errorMessage != "" {
 		msgArgs := []interface{}{"Host", target.ManagerAddress}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusNotFound, msgArgs, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 	}
 	searchKey := evcommon.GetSearchKey(deviceIPAddress, evmodel.DeviceSubscriptionIndex)
 	devSub, err := evmodel.GetDeviceSubscriptions(searchKey)
@@ -449,13 +440,13 @@ func (p *PluginContact) subscribe(subscriptionPost evmodel.EvtSubPost, origin st
 }
 
 // DeleteFabricsSubscription will delete fabric subscription
-func (p *PluginContact) DeleteFabricsSubscription(originResource string, plugin *evmodel.Plugin) (response.RPC, error) {
+func (p *PluginContact) DeleteFabricsSubscription(ctx context.Context, originResource string, plugin *evmodel.Plugin) (response.RPC, error) {
 	var resp response.RPC
 	addr, errorMessage := evcommon.GetIPFromHostName(plugin.IP)
 	if errorMessage != "" {
 		var msgArgs = []interface{}{"ManagerAddress", plugin.IP}
 		evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusNotFound, msgArgs, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp, fmt.Errorf(errorMessage)
 	}
 	searchKey := evcommon.GetSearchKey(addr, evmodel.DeviceSubscriptionIndex)
@@ -470,13 +461,13 @@ func (p *PluginContact) DeleteFabricsSubscription(originResource string, plugin
 
 				var msgArgs = []interface{}{plugin.ID + " Plugin", addr}
 				evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusNotFound, msgArgs, &resp)
-				log.Error(errorMessage)
+				logs.Log(ctx).Error(errorMessage)
 				return resp, err
 			}
 		} else {
 			evcommon.GenErrorResponse(errorMessage, response.InternalError, http.StatusInternalServerError,
 				[]interface{}{}, &resp)
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return resp, err
 		}
 	}
@@ -484,41 +475,20 @@ func (p *PluginContact) DeleteFabricsSubscription(originResource string, plugin
 	var contactRequest evcommon.PluginContactRequest
 
 	contactRequest.Plugin = plugin
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		token := p.getPluginToken(plugin)
-		if token == "" {
-			evcommon.GenErrorResponse("error: Unable to create session with plugin "+plugin.ID, response.NoValidSession, http.StatusUnauthorized,
-				[]interface{}{}, &resp)
-			return resp, fmt.Errorf("error: Unable to create session with plugin " + plugin.ID)
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.LoginCredential = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 
 	// Call to delete subscription to plugin
 	contactRequest.URL = devSub.Location
 	contactRequest.HTTPMethodType = http.MethodDelete
 	contactRequest.PostBody = nil
-	resp, _, _, err = p.PluginCall(contactRequest)
+	resp, _, _, err = p.PluginCall(ctx, contactRequest)
 	if err != nil {
 		return resp, err
 	}
-	if resp.StatusCode == http.StatusUnauthorized && strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		resp, _, _, err = p.retryEventOperation(contactRequest)
-		if err != nil {
-			return resp, err
-		}
-	}
 	return resp, nil
 }
 
 //  resubscribeFabricsSubscription updates subscription fabric subscription details  by forming the super set of MessageIDs,EventTypes and ResourceTypes
-func (p *PluginContact) resubscribeFabricsSubscription(subscriptionPost evmodel.EvtSubPost, origin string, deleteflag bool) error {
+func (p *PluginContact) resubscribeFabricsSubscription(ctx context.Context, subscriptionPost evmodel.EvtSubPost, origin string, deleteflag bool) error {
 	originResources := getSuboridanteResourcesFromCollection(origin)
 	for _, origin := range originResources {
 		originResource := origin
@@ -530,17 +500,17 @@ func (p *PluginContact) resubscribeFabricsSubscription(subscriptionPost evmodel.
 		fabric, dberr := evmodel.GetFabricData(fabricID)
 		if dberr != nil {
 			errorMessage := "error while getting fabric data: " + dberr.Error()
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return fmt.Errorf(errorMessage)
 		}
 		plugin, errs := evmodel.GetPluginData(fabric.PluginID)
 		if errs != nil {
 			errorMessage := "error while getting plugin data: " + errs.Error()
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return fmt.Errorf(errorMessage)
 		}
 		// Deleting the fabric subscription
-		resp, err := p.DeleteFabricsSubscription(origin, plugin)
+		resp, err := p.DeleteFabricsSubscription(ctx, origin, plugin)
 		if err != nil {
 			if resp.StatusCode == http.StatusNotFound {
 				return nil
@@ -557,19 +527,6 @@ func (p *PluginContact) resubscribeFabricsSubscription(subscriptionPost evmodel.
 		var contactRequest evcommon.PluginContactRequest
 
 		contactRequest.Plugin = plugin
-		if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-			token := p.getPluginToken(plugin)
-			if token == "" {
-				return fmt.Errorf("error: Unable to create session with plugin " + plugin.ID)
-			}
-			contactRequest.Token = token
-		} else {
-			contactRequest.LoginCredential = map[string]string{
-				"UserName": plugin.Username,
-				"Password": string(plugin.Password),
-			}
-
-		}
 		// filling origin resource
 		subscriptionPost.OriginResources = []evmodel.OdataIDLink{
 			evmodel.OdataIDLink{
@@ -587,19 +544,13 @@ func (p *PluginContact) resubscribeFabricsSubscription(subscriptionPost evmodel.
 		contactRequest.URL = "/ODIM/v1/Subscriptions"
 		contactRequest.HTTPMethodType = http.MethodPost
 		err = json.Unmarshal([]byte(reqData), &contactRequest.PostBody)
-		log.Info("Resubscribe request" + reqData)
-		response, loc, _, err := p.PluginCall(contactRequest)
+		logs.Log(ctx).Info("Resubscribe request" + reqData)
+		response, loc, _, err := p.PluginCall(ctx, contactRequest)
 		if err != nil {
 			return err
 		}
-		if response.StatusCode == http.StatusUnauthorized && strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-			_, _, _, err = p.retryEventOperation(contactRequest)
-			if err != nil {
-				return err
-			}
-		}
-		log.Info("Resubscribe response status code: " + string(response.StatusCode))
-		log.Info("Resubscribe response body: ", response.Body)
+		logs.Log(ctx).Info("Resubscribe response status code: " + string(response.StatusCode))
+		logs.Log(ctx).Info("Resubscribe response body: ", response.Body)
 		addr, errorMessage := evcommon.GetIPFromHostName(plugin.IP)
 		if errorMessage != "" {
 			return fmt.Errorf(errorMessage)
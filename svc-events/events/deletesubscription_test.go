@@ -21,6 +21,7 @@
 package events
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -34,7 +35,6 @@ import (
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
-	"github.com/ODIM-Project/ODIM/svc-events/evcommon"
 	"github.com/ODIM-Project/ODIM/svc-events/evmodel"
 	"github.com/stretchr/testify/assert"
 )
@@ -344,10 +344,6 @@ func startTestServer() *httptest.Server {
 }
 
 func TestDeleteEventSubscription(t *testing.T) {
-	// Intializing plugin token
-	evcommon.Token.Tokens = map[string]string{
-		"ILO": "token",
-	}
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.InMemory)
@@ -374,7 +370,7 @@ func TestDeleteEventSubscription(t *testing.T) {
 		SessionToken:        "validToken",
 		EventSubscriptionID: "81de0110-c35a-4859-984c-072d6c5a32d7",
 	}
-	resp := pc.DeleteEventSubscriptionsDetails(req)
+	resp := pc.DeleteEventSubscriptionsDetails(context.Background(), req)
 	data := resp.Body.(response.Response)
 	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusOK")
 	assert.Equal(t, "81de0110-c35a-4859-984c-072d6c5a32d7", data.ID, "ID should be 81de0110-c35a-4859-984c-072d6c5a32d7")
@@ -384,7 +380,7 @@ func TestDeleteEventSubscription(t *testing.T) {
 		SessionToken:        "validToken",
 		EventSubscriptionID: "71de0110-c35a-4859-984c-072d6c5a32d8",
 	}
-	resp = pc.DeleteEventSubscriptionsDetails(req)
+	resp = pc.DeleteEventSubscriptionsDetails(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusOK")
 
 	// positive test case deletion of collection subscription
@@ -392,7 +388,7 @@ func TestDeleteEventSubscription(t *testing.T) {
 		SessionToken:        "validToken",
 		EventSubscriptionID: "71de0110-c35a-4859-984c-072d6c5a3211",
 	}
-	resp = pc.DeleteEventSubscriptionsDetails(req)
+	resp = pc.DeleteEventSubscriptionsDetails(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusOK")
 
 	// Negative test cases
@@ -401,23 +397,19 @@ func TestDeleteEventSubscription(t *testing.T) {
 		SessionToken:        "validToken",
 		EventSubscriptionID: "de018110-4859-984c-c35a-0a32d772d6c5",
 	}
-	resp = pc.DeleteEventSubscriptionsDetails(req1)
+	resp = pc.DeleteEventSubscriptionsDetails(context.Background(), req1)
 	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status Code should be StatusNotFound")
 
 	// Invalid token
 	req2 := &eventsproto.EventRequest{
 		SessionToken: "InValidToken",
 	}
-	resp = pc.DeleteEventSubscriptionsDetails(req2)
+	resp = pc.DeleteEventSubscriptionsDetails(context.Background(), req2)
 	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status Code should be StatusUnauthorized")
 }
 
 func TestDeleteEventSubscriptionOnDeletServer(t *testing.T) {
 	config.SetUpMockConfig(t)
-	// Intializing plugin token
-	evcommon.Token.Tokens = map[string]string{
-		"ILO": "token",
-	}
 	defer func() {
 		err := common.TruncateDB(common.InMemory)
 		if err != nil {
@@ -442,7 +434,7 @@ func TestDeleteEventSubscriptionOnDeletServer(t *testing.T) {
 		SessionToken: "validToken",
 		UUID:         "/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
 	}
-	resp := pc.DeleteEventSubscriptions(req)
+	resp := pc.DeleteEventSubscriptions(context.Background(), req)
 	assert.Equal(t, http.StatusNoContent, int(resp.StatusCode), "Status Code should be StatusNoContent")
 
 	// Negative test cases
@@ -451,7 +443,7 @@ func TestDeleteEventSubscriptionOnDeletServer(t *testing.T) {
 		SessionToken: "validToken",
 		UUID:         "de018110-4859-984c-c35a-0a32d772d6c5",
 	}
-	resp = pc.DeleteEventSubscriptions(req1)
+	resp = pc.DeleteEventSubscriptions(context.Background(), req1)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusNotFound")
 
 	// if UUID is is not present in DB
@@ -460,7 +452,7 @@ func TestDeleteEventSubscriptionOnDeletServer(t *testing.T) {
 		UUID:         "/redfish/v1/Systems/de018110-4859-984c-c35a-0a32d772d6c5:1",
 	}
 
-	resp = pc.DeleteEventSubscriptions(req1)
+	resp = pc.DeleteEventSubscriptions(context.Background(), req1)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusNotFound")
 
 	//storeTestEventDetails(t)
@@ -468,16 +460,12 @@ func TestDeleteEventSubscriptionOnDeletServer(t *testing.T) {
 		SessionToken: "validToken",
 		UUID:         "/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1",
 	}
-	resp = pc.DeleteEventSubscriptions(req)
+	resp = pc.DeleteEventSubscriptions(context.Background(), req)
 	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status Code should be StatusNotFound")
 
 }
 
 func TestDeleteEventSubscriptionOnFabrics(t *testing.T) {
-	// Intializing plugin token
-	evcommon.Token.Tokens = map[string]string{
-		"CFM": "token",
-	}
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.InMemory)
@@ -504,7 +492,7 @@ func TestDeleteEventSubscriptionOnFabrics(t *testing.T) {
 		SessionToken:        "validToken",
 		EventSubscriptionID: "71de0110-c35a-4859-984c-072d6c5a32d9",
 	}
-	resp := pc.DeleteEventSubscriptionsDetails(req)
+	resp := pc.DeleteEventSubscriptionsDetails(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusOK")
 }
 
@@ -556,10 +544,6 @@ func TestIsCollectionOriginResourceURI(t *testing.T) {
 }
 
 func TestDeleteFabricsSubscription(t *testing.T) {
-	// Intializing plugin token
-	evcommon.Token.Tokens = map[string]string{
-		"ILO": "token",
-	}
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.InMemory)
@@ -589,14 +573,14 @@ func TestDeleteFabricsSubscription(t *testing.T) {
 		PreferredAuthType: "BasicAuth",
 		PluginType:        "ILO",
 	}
-	resp, err := pc.DeleteFabricsSubscription("", plugin)
+	resp, err := pc.DeleteFabricsSubscription(context.Background(), "", plugin)
 	assert.Nil(t, err, "error should be nil")
 	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusOK")
 
 	// Negative test cases
 	// if subscription id is not present
 	plugin.IP = "10.10.10.10"
-	resp, err = pc.DeleteFabricsSubscription("", plugin)
+	resp, err = pc.DeleteFabricsSubscription(context.Background(), "", plugin)
 	assert.NotNil(t, err, "error should not be nil")
 	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status Code should be StatusNotFound")
 }
@@ -632,10 +616,10 @@ func TestDeleteSubscription(t *testing.T) {
 		PluginID:       "GRF",
 	}
 
-	err := pc.deleteSubscription(&target, "/redfish/v1/Systems")
+	err := pc.deleteSubscription(context.Background(), &target, "/redfish/v1/Systems")
 	assert.Nil(t, err, "error should be nil")
 
 	target.PluginID = "non-existent"
-	err = pc.deleteSubscription(&target, "/redfish/v1/Systems")
+	err = pc.deleteSubscription(context.Background(), &target, "/redfish/v1/Systems")
 	assert.NotNil(t, err, "error should not be nil")
 }
@@ -22,6 +22,7 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	log "github.com/sirupsen/logrus"
@@ -38,6 +39,7 @@ import (
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
 	taskproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/task"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
@@ -57,6 +59,7 @@ type PluginContact struct {
 	UpdateTask         func(common.TaskData) error
 	CreateChildTask    func(string, string) (string, error)
 	GetSessionUserName func(sessionToken string) (string, error)
+	ReplayTopics       func(since time.Time)
 }
 
 func fillTaskData(taskID, targetURI, request string, resp errResponse.RPC, taskState string, taskStatus string, percentComplete int32, httpMethod string) common.TaskData {
@@ -107,7 +110,7 @@ func removeOdataIDfromOriginResources(originResources []evmodel.OdataIDLink) []s
 }
 
 // CreateEventSubscription is a API to create event subscription
-func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName string, req *eventsproto.EventSubRequest) errResponse.RPC {
+func (p *PluginContact) CreateEventSubscription(ctx context.Context, taskID string, sessionUserName string, req *eventsproto.EventSubRequest) errResponse.RPC {
 	var (
 		err             error
 		resp            errResponse.RPC
@@ -130,7 +133,7 @@ func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName s
 		if strings.Contains(err.Error(), "evmodel.OdataIDLink") {
 			errorMessage = "Error processing subscription request: @odata.id key(s) is missing in origin resources list"
 		}
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 
 		resp = common.GeneralError(http.StatusBadRequest, errResponse.MalformedJSON, errorMessage, []interface{}{}, nil)
 		// Fill task and update
@@ -142,11 +145,11 @@ func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName s
 	invalidProperties, err := common.RequestParamsCaseValidator(req.PostBody, postRequest)
 	if err != nil {
 		errMsg := "error while validating request parameters: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
 	} else if invalidProperties != "" {
 		errorMessage := "error: one or more properties given in the request body are not valid, ensure properties are listed in uppercamelcase "
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		resp := common.GeneralError(http.StatusBadRequest, errResponse.PropertyUnknown, errorMessage, []interface{}{invalidProperties}, nil)
 		p.UpdateTask(fillTaskData(taskID, targetURI, string(req.PostBody), resp, common.Exception, common.Critical, percentComplete, http.MethodPost))
 		return resp
@@ -157,7 +160,7 @@ func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName s
 	if err != nil {
 		// Update the task here with error response
 		errorMessage := "error: request payload validation failed: " + err.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 
 		resp = common.GeneralError(statuscode, statusMessage, errorMessage, messageArgs, nil)
 		// Fill task and update
@@ -168,7 +171,7 @@ func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName s
 	//validate destination URI in the request
 	if !common.URIValidator(postRequest.Destination) {
 		errorMessage := "error: request body contains invalid value for Destination field, " + postRequest.Destination
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 
 		resp = common.GeneralError(http.StatusBadRequest, errResponse.PropertyValueFormatError, errorMessage, []interface{}{postRequest.Destination, "Destination"}, nil)
 		// Fill task and update
@@ -183,7 +186,7 @@ func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName s
 		errorMessage := "Error while get subscription details: " + err.Error()
 		evcommon.GenErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 			[]interface{}{}, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		p.UpdateTask(fillTaskData(taskID, targetURI, string(req.PostBody), resp, common.Exception, common.Critical, percentComplete, http.MethodPost))
 		return resp
 	}
@@ -192,7 +195,7 @@ func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName s
 			errorMessage := "Subscription already present for the requested destination"
 			evcommon.GenErrorResponse(errorMessage, errResponse.ResourceInUse, http.StatusConflict,
 				[]interface{}{}, &resp)
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			p.UpdateTask(fillTaskData(taskID, targetURI, string(req.PostBody), resp, common.Exception, common.Critical, percentComplete, http.MethodPost))
 			return resp
 		}
@@ -251,21 +254,21 @@ func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName s
 	}()
 
 	for _, origin := range originResources {
-		_, _, err := getTargetDetails(origin)
+		_, _, err := getTargetDetails(ctx, origin)
 		if err != nil {
 			collection, collectionName, collectionFlag, _ := checkCollection(origin)
 			wg.Add(1)
 			// for origin is collection
-			go p.createEventSubscrption(taskID, subTaskChan, sessionUserName, targetURI, postRequest, origin, result, &wg, collectionFlag, collectionName)
+			go p.createEventSubscrption(ctx, taskID, subTaskChan, sessionUserName, targetURI, postRequest, origin, result, &wg, collectionFlag, collectionName)
 			for i := 0; i < len(collection); i++ {
 				wg.Add(1)
 				// for suboridinate origin
-				go p.createEventSubscrption("", subTaskChan, sessionUserName, targetURI, postRequest, collection[i], result, &wg, false, "")
+				go p.createEventSubscrption(ctx, "", subTaskChan, sessionUserName, targetURI, postRequest, collection[i], result, &wg, false, "")
 			}
 			collectionList = append(collectionList, collection...)
 		} else {
 			wg.Add(1)
-			go p.createEventSubscrption(taskID, subTaskChan, sessionUserName, targetURI, postRequest, origin, result, &wg, false, "")
+			go p.createEventSubscrption(ctx, taskID, subTaskChan, sessionUserName, targetURI, postRequest, origin, result, &wg, false, "")
 		}
 	}
 
@@ -340,11 +343,14 @@ func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName s
 			OriginResources:      successfulSubscriptionList,
 			Hosts:                hosts,
 		}
+		if postRequest.Oem != nil {
+			evtSubscription.TagFilter = postRequest.Oem.ODIM.TagFilter
+		}
 
 		if err = evmodel.SaveEventSubscription(evtSubscription); err != nil {
 			// Update the task here with error response
 			errorMessage := "error while trying to save event subscription data: " + err.Error()
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 
 			resp = common.GeneralError(http.StatusInternalServerError, errResponse.InternalError, errorMessage, []interface{}{}, nil)
 			// Fill task and update
@@ -354,7 +360,7 @@ func (p *PluginContact) CreateEventSubscription(taskID string, sessionUserName s
 		}
 		locationHeader = resp.Header["Location"]
 	}
-	log.Info("Process Count," + strconv.Itoa(originResourceProcessedCount) +
+	logs.Log(ctx).Info("Process Count," + strconv.Itoa(originResourceProcessedCount) +
 		"successOriginResourceCount" + strconv.Itoa(successOriginResourceCount))
 	percentComplete = 100
 	if originResourceProcessedCount == successOriginResourceCount {
@@ -396,7 +402,7 @@ func removeDuplicatesFromSlice(slc *[]string, slcLen *int) {
 	return
 }
 
-func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origin, collectionName string, collectionFlag bool) (string, evresponse.EventResponse) {
+func (p *PluginContact) eventSubscription(ctx context.Context, postRequest evmodel.RequestBody, origin, collectionName string, collectionFlag bool) (string, evresponse.EventResponse) {
 	var resp evresponse.EventResponse
 	var err error
 	var plugin *evmodel.Plugin
@@ -404,12 +410,14 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 	var target *evmodel.Target
 	if !collectionFlag {
 		if strings.Contains(origin, "Fabrics") {
-			return p.createFabricSubscription(postRequest, origin, collectionName, collectionFlag)
+			return p.createFabricSubscription(ctx, postRequest, origin, collectionName, collectionFlag)
 		}
-		target, resp, err = getTargetDetails(origin)
+		target, resp, err = getTargetDetails(ctx, origin)
 		if err != nil {
 			return "", resp
 		}
+		ctx = logs.With(ctx, logs.SystemIDField, target.DeviceUUID)
+		ctx = logs.With(ctx, logs.PluginIDField, target.PluginID)
 
 		var errs *errors.Error
 		plugin, errs = evmodel.GetPluginData(target.PluginID)
@@ -417,27 +425,11 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 			errorMessage := "error while getting plugin data: " + errs.Error()
 			evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 				&resp, []interface{}{})
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return "", resp
 		}
 
 		contactRequest.Plugin = plugin
-		if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-			token := p.getPluginToken(plugin)
-			if token == "" {
-				evcommon.GenEventErrorResponse("error: Unable to create session with plugin "+plugin.ID, errResponse.NoValidSession, http.StatusUnauthorized,
-					&resp, []interface{}{})
-
-				return "", resp
-			}
-			contactRequest.Token = token
-
-		} else {
-			contactRequest.LoginCredential = map[string]string{
-				"UserName": plugin.Username,
-				"Password": string(plugin.Password),
-			}
-		}
 	}
 	var httpHeadersSlice = make([]evmodel.HTTPHeaders, 0)
 	httpHeadersSlice = append(httpHeadersSlice, evmodel.HTTPHeaders{ContentType: "application/json"})
@@ -454,14 +446,14 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 		HTTPHeaders:          httpHeadersSlice,
 		Context:              postRequest.Context,
 	}
-	res, err := p.IsEventsSubscribed("", origin, &subscriptionPost, plugin, target, collectionFlag, collectionName)
+	res, err := p.IsEventsSubscribed(ctx, "", origin, &subscriptionPost, plugin, target, collectionFlag, collectionName)
 	if err != nil {
 		resp.Response = res.Body
 		resp.StatusCode = int(res.StatusCode)
 		return "", resp
 	}
 	if collectionFlag {
-		log.Info("Saving device subscription details of collection subscription")
+		logs.Log(ctx).Info("Saving device subscription details of collection subscription")
 		err = saveDeviceSubscriptionDetails(evmodel.Subscription{
 			Location:       "",
 			EventHostIP:    collectionName,
@@ -471,7 +463,7 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 			errorMessage := "error while trying to save event subscription of device data: " + err.Error()
 			evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 				&resp, []interface{}{})
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return "", resp
 		}
 
@@ -492,46 +484,41 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 	contactRequest.HTTPMethodType = http.MethodPost
 	contactRequest.PostBody = target
 
-	log.Info("Subscription Request" + reqData)
+	logs.Log(ctx).Info("Subscription Request" + reqData)
 	response, err := p.callPlugin(contactRequest)
 	if err != nil {
-		if evcommon.GetPluginStatus(plugin) {
-			response, err = p.callPlugin(contactRequest)
-		}
-		if err != nil {
-			errorMessage := "error while unmarshaling the body : " + err.Error()
-			evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
-				&resp, []interface{}{})
-			log.Error(errorMessage)
-			return "", resp
-		}
+		errorMessage := "error while unmarshaling the body : " + err.Error()
+		evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
+			&resp, []interface{}{})
+		logs.Log(ctx).Error(errorMessage)
+		return "", resp
 	}
 	defer response.Body.Close()
-	log.Info("Subscription Response StatusCode:" + strconv.Itoa(int(response.StatusCode)))
+	logs.Log(ctx).Info("Subscription Response StatusCode:" + strconv.Itoa(int(response.StatusCode)))
 	if response.StatusCode != http.StatusCreated {
 		body, err := ioutil.ReadAll(response.Body)
 		if err != nil {
 			errorMessage := "error while trying to read response body: " + err.Error()
 			evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 				&resp, []interface{}{})
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return "", resp
 		}
-		log.Info("Subscription Response:" + string(body))
+		logs.Log(ctx).Info("Subscription Response:" + string(body))
 		var res interface{}
 		err = json.Unmarshal(body, &res)
 		if err != nil {
 			errorMessage := "error while unmarshaling the body : " + err.Error()
 			evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 				&resp, []interface{}{})
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return "", resp
 		}
 
 		errorMessage := "error while trying to create event subscription"
 		resp.Response = res
 		resp.StatusCode = response.StatusCode
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
 	// if Subscription location is empty then don't store event details in DB
@@ -540,7 +527,7 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 		errorMessage := "Subscription Location is missing in the response header"
 		evcommon.GenEventErrorResponse(errorMessage, errors.InternalError, http.StatusInternalServerError,
 			&resp, []interface{}{})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
 	// get the ip address from the host name
@@ -548,10 +535,10 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 	if errorMessage != "" {
 		evcommon.GenEventErrorResponse(errorMessage, errResponse.ResourceNotFound, http.StatusNotFound,
 			&resp, []interface{}{"ManagerAddress", target.ManagerAddress})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
-	log.Info("Saving device subscription details : ", deviceIPAddress)
+	logs.Log(ctx).Info("Saving device subscription details : ", deviceIPAddress)
 	evtSubscription := evmodel.Subscription{
 		Location:       locationHdr,
 		EventHostIP:    deviceIPAddress,
@@ -570,7 +557,7 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 		errorMessage := "error while trying to save event subscription of device data: " + err.Error()
 		evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 			&resp, []interface{}{})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
 
@@ -581,7 +568,7 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 		errorMessage := "error while unmarshaling the body : " + err.Error()
 		evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 			&resp, []interface{}{})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
 	resp.Response = outBody
@@ -596,7 +583,7 @@ func (p *PluginContact) eventSubscription(postRequest evmodel.RequestBody, origi
 // and also delete the subscription on device also
 // subscription: New Subscription
 // subscriptionDetails : subscription details stored in db for the particular device
-func (p *PluginContact) IsEventsSubscribed(token, origin string, subscription *evmodel.EvtSubPost, plugin *evmodel.Plugin, target *evmodel.Target, collectionFlag bool, collectionName string) (errResponse.RPC, error) {
+func (p *PluginContact) IsEventsSubscribed(ctx context.Context, token, origin string, subscription *evmodel.EvtSubPost, plugin *evmodel.Plugin, target *evmodel.Target, collectionFlag bool, collectionName string) (errResponse.RPC, error) {
 	var resp errResponse.RPC
 	var err error
 	var host, originResource, searchKey string
@@ -609,10 +596,10 @@ func (p *PluginContact) IsEventsSubscribed(token, origin string, subscription *e
 		if errorMessage != "" {
 			evcommon.GenErrorResponse(errorMessage, errResponse.ResourceNotFound, http.StatusNotFound,
 				[]interface{}{"ManagerAddress", target.ManagerAddress}, &resp)
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return resp, err
 		}
-		log.Info("After look up, manager address is: ", host)
+		logs.Log(ctx).Info("After look up, manager address is: ", host)
 		searchKey = evcommon.GetSearchKey(host, evmodel.SubscriptionIndex)
 	}
 	// uniqueMap is to ignore duplicate eventTypes
@@ -636,7 +623,7 @@ func (p *PluginContact) IsEventsSubscribed(token, origin string, subscription *e
 		errorMessage := "Error while get subscription details: " + err.Error()
 		evcommon.GenErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 			[]interface{}{}, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp, err
 	}
 	// if there is no subscription happened then create event subscription
@@ -669,7 +656,7 @@ func (p *PluginContact) IsEventsSubscribed(token, origin string, subscription *e
 						errorMessage := "Error while Updating event subscription : " + err.Error()
 						evcommon.GenErrorResponse(errorMessage, errResponse.ResourceNotFound, http.StatusBadRequest,
 							[]interface{}{"Subscription", "invalid value " + origin}, &resp)
-						log.Error(errorMessage)
+						logs.Log(ctx).Error(errorMessage)
 						return resp, err
 					}
 				} else {
@@ -681,7 +668,7 @@ func (p *PluginContact) IsEventsSubscribed(token, origin string, subscription *e
 						errorMessage := "Error while Updating event subscription : " + err.Error()
 						evcommon.GenErrorResponse(errorMessage, errResponse.ResourceNotFound, http.StatusBadRequest,
 							[]interface{}{"Subscription", "invalid value " + origin}, &resp)
-						log.Error(errorMessage)
+						logs.Log(ctx).Error(errorMessage)
 						return resp, err
 					}
 				}
@@ -712,14 +699,14 @@ func (p *PluginContact) IsEventsSubscribed(token, origin string, subscription *e
 		return resp, nil
 	}
 	if !collectionFlag {
-		log.Info("Delete Subscription from device")
+		logs.Log(ctx).Info("Delete Subscription from device")
 		if strings.Contains(originResource, "Fabrics") {
-			resp, err = p.DeleteFabricsSubscription(originResource, plugin)
+			resp, err = p.DeleteFabricsSubscription(ctx, originResource, plugin)
 			if err != nil {
 				return resp, err
 			}
 		} else {
-			resp, err = p.DeleteSubscriptions(originResource, token, plugin, target)
+			resp, err = p.DeleteSubscriptions(ctx, originResource, token, plugin, target)
 			if err != nil {
 				return resp, err
 			}
@@ -778,20 +765,15 @@ func checkEqual(newEventTypes, prevEventTypes []string) (errResponse.RPC, error)
 
 // PluginCall method is to call to given url and method
 // and validate the response and return
-func (p *PluginContact) PluginCall(req evcommon.PluginContactRequest) (errResponse.RPC, string, string, error) {
+func (p *PluginContact) PluginCall(ctx context.Context, req evcommon.PluginContactRequest) (errResponse.RPC, string, string, error) {
 	var resp errResponse.RPC
 	response, err := p.callPlugin(req)
 	if err != nil {
-		if evcommon.GetPluginStatus(req.Plugin) {
-			response, err = p.callPlugin(req)
-		}
-		if err != nil {
-			errorMessage := "Error : " + err.Error()
-			evcommon.GenErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
-				[]interface{}{}, &resp)
-			log.Error(errorMessage)
-			return resp, "", "", err
-		}
+		errorMessage := "Error : " + err.Error()
+		evcommon.GenErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
+			[]interface{}{}, &resp)
+		logs.Log(ctx).Error(errorMessage)
+		return resp, "", "", err
 	}
 	defer response.Body.Close()
 	body, err := ioutil.ReadAll(response.Body)
@@ -799,7 +781,7 @@ func (p *PluginContact) PluginCall(req evcommon.PluginContactRequest) (errRespon
 		errorMessage := "error while trying to read response body: " + err.Error()
 		evcommon.GenErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 			[]interface{}{}, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp, "", "", err
 	}
 	if !(response.StatusCode == http.StatusCreated || response.StatusCode == http.StatusOK) {
@@ -816,8 +798,8 @@ func (p *PluginContact) PluginCall(req evcommon.PluginContactRequest) (errRespon
 
 // CreateDefaultEventSubscription is creates the  subscription with event types which will be required to rediscover the inventory
 // after computer system restarts ,This will  triggered from   aggregation service whenever a computer system is added
-func (p *PluginContact) CreateDefaultEventSubscription(originResources, eventTypes, messageIDs, resourceTypes []string, protocol string) errResponse.RPC {
-	log.Info("Creation of default subscriptions started for:" + strings.Join(originResources, "::"))
+func (p *PluginContact) CreateDefaultEventSubscription(ctx context.Context, originResources, eventTypes, messageIDs, resourceTypes []string, protocol string) errResponse.RPC {
+	logs.Log(ctx).Info("Creation of default subscriptions started for:" + strings.Join(originResources, "::"))
 	var resp errResponse.RPC
 	var response evresponse.EventResponse
 	var partialResultFlag bool
@@ -837,9 +819,9 @@ func (p *PluginContact) CreateDefaultEventSubscription(originResources, eventTyp
 		postRequest.SubscriptionType = evmodel.SubscriptionType
 		postRequest.SubordinateResources = true
 		var host string
-		host, response = p.eventSubscription(postRequest, originResources[i], "", false)
+		host, response = p.eventSubscription(ctx, postRequest, originResources[i], "", false)
 		hosts = append(hosts, host)
-		go p.checkCollectionSubscription(originResources[i], protocol)
+		go p.checkCollectionSubscription(ctx, originResources[i], protocol)
 		if response.StatusCode != http.StatusCreated {
 			partialResultFlag = true
 			if response.StatusCode > bubbleUpStatusCode {
@@ -870,7 +852,7 @@ func (p *PluginContact) CreateDefaultEventSubscription(originResources, eventTyp
 		errorMessage := "error while trying to save event subscription data: " + err.Error()
 		evcommon.GenErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 			[]interface{}{}, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp
 	}
 	resp.Header = map[string]string{
@@ -882,7 +864,7 @@ func (p *PluginContact) CreateDefaultEventSubscription(originResources, eventTyp
 	}
 	resp.Body = response.Response
 	resp.StatusCode = http.StatusCreated
-	log.Info("Creation of default subscriptions completed for :" + strings.Join(originResources, "::"))
+	logs.Log(ctx).Info("Creation of default subscriptions completed for :" + strings.Join(originResources, "::"))
 	return resp
 }
 
@@ -936,6 +918,13 @@ func validateFields(request *evmodel.RequestBody) (int32, string, []interface{},
 		}
 	}
 
+	// the tag filter is evaluated on the tags of the devices when the events are forwarded
+	if request.Oem != nil && request.Oem.ODIM.TagFilter != "" {
+		if _, err := common.ParseFilter(request.Oem.ODIM.TagFilter); err != nil {
+			return http.StatusBadRequest, errResponse.PropertyValueFormatError, []interface{}{request.Oem.ODIM.TagFilter, "TagFilter"}, fmt.Errorf("Invalid TagFilter: %v", err)
+		}
+	}
+
 	return http.StatusOK, common.OK, []interface{}{}, nil
 }
 
@@ -977,14 +966,14 @@ func saveDeviceSubscriptionDetails(evtSubscription evmodel.Subscription) error {
 	return nil
 }
 
-func getTargetDetails(origin string) (*evmodel.Target, evresponse.EventResponse, error) {
+func getTargetDetails(ctx context.Context, origin string) (*evmodel.Target, evresponse.EventResponse, error) {
 	var resp evresponse.EventResponse
 
 	uuid, err := getUUID(origin)
 	if err != nil {
 		evcommon.GenEventErrorResponse(err.Error(), errResponse.ResourceNotFound, http.StatusNotFound,
 			&resp, []interface{}{"System", origin})
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return nil, resp, err
 	}
 
@@ -996,7 +985,7 @@ func getTargetDetails(origin string) (*evmodel.Target, evresponse.EventResponse,
 		errorMessage := "error while getting Systems(Target device Credentials) table details: " + err.Error()
 		evcommon.GenEventErrorResponse(errorMessage, errResponse.ResourceNotFound, http.StatusNotFound,
 			&resp, []interface{}{"Systems", origin})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return nil, resp, err
 	}
 	decryptedPasswordByte, err := common.DecryptWithPrivateKey(target.Password)
@@ -1005,7 +994,7 @@ func getTargetDetails(origin string) (*evmodel.Target, evresponse.EventResponse,
 		errorMessage := "error while trying to decrypt device password: " + err.Error()
 		evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 			&resp, []interface{}{})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return nil, resp, err
 	}
 	target.Password = decryptedPasswordByte
@@ -1013,7 +1002,7 @@ func getTargetDetails(origin string) (*evmodel.Target, evresponse.EventResponse,
 }
 
 // DeleteSubscriptions will delete subscription from device
-func (p *PluginContact) DeleteSubscriptions(originResource, token string, plugin *evmodel.Plugin, target *evmodel.Target) (errResponse.RPC, error) {
+func (p *PluginContact) DeleteSubscriptions(ctx context.Context, originResource, token string, plugin *evmodel.Plugin, target *evmodel.Target) (errResponse.RPC, error) {
 	var resp errResponse.RPC
 	var err error
 	var deviceSubscription *evmodel.DeviceSubscription
@@ -1021,7 +1010,7 @@ func (p *PluginContact) DeleteSubscriptions(originResource, token string, plugin
 	if errorMessage != "" {
 		evcommon.GenErrorResponse(errorMessage, errResponse.ResourceNotFound, http.StatusNotFound,
 			[]interface{}{"ManagerAddress", target.ManagerAddress}, &resp)
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp, err
 	}
 	searchKey := evcommon.GetSearchKey(addr, evmodel.DeviceSubscriptionIndex)
@@ -1035,29 +1024,13 @@ func (p *PluginContact) DeleteSubscriptions(originResource, token string, plugin
 		evcommon.GenErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 			[]interface{}{}, &resp)
 
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return resp, err
 	}
 
 	var contactRequest evcommon.PluginContactRequest
 
 	contactRequest.Plugin = plugin
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		token := p.getPluginToken(plugin)
-		if token == "" {
-			evcommon.GenErrorResponse("error: Unable to create session with plugin "+plugin.ID, errResponse.NoValidSession, http.StatusUnauthorized,
-				[]interface{}{}, &resp)
-			return resp, fmt.Errorf("error: Unable to create session with plugin " + plugin.ID)
-		}
-		contactRequest.Token = token
-
-	} else {
-		contactRequest.LoginCredential = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 
 	target.Location = deviceSubscription.Location
 
@@ -1066,7 +1039,7 @@ func (p *PluginContact) DeleteSubscriptions(originResource, token string, plugin
 	contactRequest.HTTPMethodType = http.MethodDelete
 	contactRequest.PostBody = target
 
-	resp, _, _, err = p.PluginCall(contactRequest)
+	resp, _, _, err = p.PluginCall(ctx, contactRequest)
 	if err != nil {
 		return resp, err
 	}
@@ -1086,7 +1059,7 @@ func getUUID(origin string) (string, error) {
 	return uuid, nil
 }
 
-func (p *PluginContact) createEventSubscrption(taskID string, subTaskChan chan<- int32, reqSessionToken string, targetURI string, request evmodel.RequestBody, originResource string, result *evresponse.MutexLock, wg *sync.WaitGroup, collectionFlag bool, collectionName string) {
+func (p *PluginContact) createEventSubscrption(ctx context.Context, taskID string, subTaskChan chan<- int32, reqSessionToken string, targetURI string, request evmodel.RequestBody, originResource string, result *evresponse.MutexLock, wg *sync.WaitGroup, collectionFlag bool, collectionName string) {
 	var (
 		subTaskURI      string
 		subTaskID       string
@@ -1100,14 +1073,14 @@ func (p *PluginContact) createEventSubscrption(taskID string, subTaskChan chan<-
 
 	reqBody, err = json.Marshal(request)
 	if err != nil {
-		log.Error("error while trying to marshal create event request: " + err.Error())
+		logs.Log(ctx).Error("error while trying to marshal create event request: " + err.Error())
 	}
 	reqJSON = string(reqBody)
 
 	if taskID != "" {
 		subTaskURI, err = p.CreateChildTask(reqSessionToken, taskID)
 		if err != nil {
-			log.Error("Error while creating the SubTask")
+			logs.Log(ctx).Error("Error while creating the SubTask")
 		}
 		trimmedURI := strings.TrimSuffix(subTaskURI, "/")
 		subTaskID = trimmedURI[strings.LastIndex(trimmedURI, "/")+1:]
@@ -1115,7 +1088,7 @@ func (p *PluginContact) createEventSubscrption(taskID string, subTaskChan chan<-
 		p.UpdateTask(fillTaskData(subTaskID, targetURI, reqJSON, resp, common.Running, common.OK, percentComplete, http.MethodPost))
 	}
 
-	host, response := p.eventSubscription(request, originResource, collectionName, collectionFlag)
+	host, response := p.eventSubscription(ctx, request, originResource, collectionName, collectionFlag)
 	resp.Body = response.Response
 	resp.Header = map[string]string{
 		"Cache-Control":     "no-cache",
@@ -1153,22 +1126,21 @@ func checkCollection(origin string) ([]string, string, bool, error) {
 		return collection, "FabricsCollection", true, err
 	case "/redfish/v1/TaskService/Tasks":
 		return []string{}, "TasksCollection", true, nil
+	case "/redfish/v1/AggregationService/Aggregates":
+		return []string{}, "AggregateCollection", true, nil
 	}
 	return []string{}, "", false, nil
 }
 
-// callPlugin check the given request url and PrefereAuth type plugin
+// callPlugin sends the request to the plugin with the ContactClient of the PluginContact
 func (p *PluginContact) callPlugin(req evcommon.PluginContactRequest) (*http.Response, error) {
-	var reqURL = "https://" + req.Plugin.IP + ":" + req.Plugin.Port + req.URL
-	if strings.EqualFold(req.Plugin.PreferredAuthType, "BasicAuth") {
-		return p.ContactClient(reqURL, req.HTTPMethodType, "", "", req.PostBody, req.LoginCredential)
-	}
-	return p.ContactClient(reqURL, req.HTTPMethodType, req.Token, "", req.PostBody, nil)
+	req.ContactClient = p.ContactClient
+	return evcommon.CallPlugin(req)
 }
 
 // checkCollectionSubscription checks if any collcetion based subscription exists
 // If its' exists it will  update the existing subscription information with newly added server origin
-func (p *PluginContact) checkCollectionSubscription(origin, protocol string) {
+func (p *PluginContact) checkCollectionSubscription(ctx context.Context, origin, protocol string) {
 	//Creating key to get all the System Collection subscription
 
 	var searchKey string
@@ -1258,7 +1230,7 @@ func (p *PluginContact) checkCollectionSubscription(origin, protocol string) {
 	}
 
 	// Subscribing newly added server with collated event list
-	host, response := p.eventSubscription(subscriptionPost, origin, "", false)
+	host, response := p.eventSubscription(ctx, subscriptionPost, origin, "", false)
 	if response.StatusCode != http.StatusCreated {
 		return
 	}
@@ -1266,7 +1238,7 @@ func (p *PluginContact) checkCollectionSubscription(origin, protocol string) {
 		evtSubscription.Hosts = append(evtSubscription.Hosts, host)
 		err = evmodel.UpdateEventSubscription(evtSubscription)
 		if err != nil {
-			log.Error("Error while Updating event subscription : " + err.Error())
+			logs.Log(ctx).Error("Error while Updating event subscription : " + err.Error())
 		}
 	}
 	// Get Device Subscription Details if collection is bmc and update chassis and managers uri
@@ -1286,7 +1258,7 @@ func (p *PluginContact) checkCollectionSubscription(origin, protocol string) {
 
 		err := evmodel.UpdateDeviceSubscriptionLocation(newDevSubscription)
 		if err != nil {
-			log.Error("Error while Updating Device subscription : " + err.Error())
+			logs.Log(ctx).Error("Error while Updating Device subscription : " + err.Error())
 		}
 	}
 
@@ -1305,12 +1277,12 @@ func createEventSubscriptionResponse() interface{} {
 	}
 }
 
-func (p *PluginContact) createFabricSubscription(postRequest evmodel.RequestBody, origin, collectionName string, collectionFlag bool) (string, evresponse.EventResponse) {
+func (p *PluginContact) createFabricSubscription(ctx context.Context, postRequest evmodel.RequestBody, origin, collectionName string, collectionFlag bool) (string, evresponse.EventResponse) {
 	var resp evresponse.EventResponse
 	var err error
 	var plugin *evmodel.Plugin
 	var contactRequest evcommon.PluginContactRequest
-	log.Info(origin)
+	logs.Log(ctx).Info(origin)
 	// Extract the fabric id from the Origin
 	fabricID := getFabricID(origin)
 	fabric, dberr := evmodel.GetFabricData(fabricID)
@@ -1318,7 +1290,7 @@ func (p *PluginContact) createFabricSubscription(postRequest evmodel.RequestBody
 		errorMessage := "error while getting fabric data: " + dberr.Error()
 		evcommon.GenEventErrorResponse(errorMessage, errResponse.ResourceNotFound, http.StatusNotFound,
 			&resp, []interface{}{"Fabrics", fabricID})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
 	var gerr *errors.Error
@@ -1327,25 +1299,10 @@ func (p *PluginContact) createFabricSubscription(postRequest evmodel.RequestBody
 		errorMessage := "error while getting plugin data: " + gerr.Error() + fabric.PluginID
 		evcommon.GenEventErrorResponse(errorMessage, errResponse.ResourceNotFound, http.StatusNotFound,
 			&resp, []interface{}{"Plugin", fabric.PluginID})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
 	contactRequest.Plugin = plugin
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		token := p.getPluginToken(plugin)
-		if token == "" {
-			evcommon.GenEventErrorResponse("error: Unable to create session with plugin "+plugin.ID, errResponse.NoValidSession, http.StatusUnauthorized,
-				&resp, []interface{}{})
-			log.Error("error: Unable to create session with plugin " + plugin.ID)
-			return "", resp
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.LoginCredential = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-	}
 	var httpHeadersSlice = make([]evmodel.HTTPHeaders, 0)
 	httpHeadersSlice = append(httpHeadersSlice, evmodel.HTTPHeaders{ContentType: "application/json"})
 	subscriptionPost := evmodel.EvtSubPost{
@@ -1382,13 +1339,13 @@ func (p *PluginContact) createFabricSubscription(postRequest evmodel.RequestBody
 	if errorMessage != "" {
 		evcommon.GenEventErrorResponse(errorMessage, errResponse.ResourceNotFound, http.StatusBadRequest,
 			&resp, []interface{}{"ManagerAddress", plugin.IP})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
 	var target = evmodel.Target{
 		ManagerAddress: deviceIPAddress,
 	}
-	res, err := p.IsEventsSubscribed("", origin, &subscriptionPost, plugin, &target, collectionFlag, collectionName)
+	res, err := p.IsEventsSubscribed(ctx, "", origin, &subscriptionPost, plugin, &target, collectionFlag, collectionName)
 	if err != nil {
 		resp.Response = res.Body
 		resp.StatusCode = int(res.StatusCode)
@@ -1407,50 +1364,38 @@ func (p *PluginContact) createFabricSubscription(postRequest evmodel.RequestBody
 
 	response, err := p.callPlugin(contactRequest)
 	if err != nil {
-		if evcommon.GetPluginStatus(plugin) {
-			response, err = p.callPlugin(contactRequest)
-		}
-		if err != nil {
-			evcommon.GenEventErrorResponse(err.Error(), errResponse.InternalError, http.StatusInternalServerError,
-				&resp, []interface{}{})
-			log.Error(err.Error())
-			return "", resp
-		}
+		evcommon.GenEventErrorResponse(err.Error(), errResponse.InternalError, http.StatusInternalServerError,
+			&resp, []interface{}{})
+		logs.Log(ctx).Error(err.Error())
+		return "", resp
 	}
 	defer response.Body.Close()
-	//retrying the operation if status code is 401
-	if response.StatusCode == http.StatusUnauthorized && strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		response, resp, err = p.retryEventSubscriptionOperation(contactRequest)
-		if err != nil {
-			return "", resp
-		}
-	}
 
-	log.Error("Subscription Response Status Code" + string(response.StatusCode))
+	logs.Log(ctx).Error("Subscription Response Status Code" + string(response.StatusCode))
 	if response.StatusCode != http.StatusCreated {
 		body, err := ioutil.ReadAll(response.Body)
 		if err != nil {
 			errorMessage := "error while trying to read response body: " + err.Error()
 			evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 				&resp, []interface{}{})
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return "", resp
 		}
 		errorMessage := "error while trying to create event subscription"
 		var res interface{}
-		log.Error("Subscription Response " + string(body))
+		logs.Log(ctx).Error("Subscription Response " + string(body))
 		err = json.Unmarshal(body, &res)
 		if err != nil {
 			errorMessage := "error while unmarshaling the body : " + err.Error()
 			evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 				&resp, []interface{}{})
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return "", resp
 		}
 
 		resp.Response = res
 		resp.StatusCode = response.StatusCode
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
 
@@ -1465,7 +1410,7 @@ func (p *PluginContact) createFabricSubscription(postRequest evmodel.RequestBody
 		errorMessage := "error while trying to save event subscription of device data: " + err.Error()
 		evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
 			&resp, []interface{}{})
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return "", resp
 	}
 
@@ -1484,71 +1429,6 @@ func getFabricID(origin string) string {
 	return ""
 }
 
-// getPluginToken will verify the if any token present to the plugin else it will create token for the new plugin
-func (p *PluginContact) getPluginToken(plugin *evmodel.Plugin) string {
-	authToken := evcommon.Token.GetToken(plugin.ID)
-	if authToken == "" {
-		return p.createToken(plugin)
-	}
-	return authToken
-}
-
-func (p *PluginContact) createToken(plugin *evmodel.Plugin) string {
-	var contactRequest evcommon.PluginContactRequest
-
-	contactRequest.Plugin = plugin
-	contactRequest.HTTPMethodType = http.MethodPost
-	contactRequest.PostBody = map[string]interface{}{
-		"Username": plugin.Username,
-		"Password": string(plugin.Password),
-	}
-	contactRequest.URL = "/ODIM/v1/Sessions"
-	_, _, token, err := p.PluginCall(contactRequest)
-	if err != nil {
-		log.Error(err.Error())
-	}
-	pluginToken := evcommon.PluginToken{
-		Tokens: make(map[string]string),
-	}
-	if token != "" {
-		pluginToken.StoreToken(plugin.ID, token)
-	}
-	return token
-}
-
-func (p *PluginContact) retryEventOperation(req evcommon.PluginContactRequest) (errResponse.RPC, string, string, error) {
-	var resp errResponse.RPC
-	var token = p.createToken(req.Plugin)
-	if token == "" {
-		evcommon.GenErrorResponse("error: Unable to create session with plugin "+req.Plugin.ID, errResponse.NoValidSession, http.StatusUnauthorized,
-			[]interface{}{}, &resp)
-		return resp, "", "", fmt.Errorf("error: Unable to create session with plugin")
-	}
-	req.Token = token
-	return p.PluginCall(req)
-}
-
-func (p *PluginContact) retryEventSubscriptionOperation(req evcommon.PluginContactRequest) (*http.Response, evresponse.EventResponse, error) {
-	var resp evresponse.EventResponse
-	var token = p.createToken(req.Plugin)
-	if token == "" {
-		evcommon.GenEventErrorResponse("error: Unable to create session with plugin "+req.Plugin.ID, errResponse.NoValidSession, http.StatusUnauthorized,
-			&resp, []interface{}{})
-		return nil, resp, fmt.Errorf("error: Unable to create session with plugin")
-	}
-	req.Token = token
-
-	response, err := p.callPlugin(req)
-	if err != nil {
-		errorMessage := "error while unmarshaling the body : " + err.Error()
-		evcommon.GenEventErrorResponse(errorMessage, errResponse.InternalError, http.StatusInternalServerError,
-			&resp, []interface{}{})
-		log.Error(errorMessage)
-		return nil, resp, err
-	}
-	return response, resp, err
-}
-
 // isHostPresent will check if hostip present in the hosts slice
 func isHostPresent(hosts []string, hostip string) bool {
 
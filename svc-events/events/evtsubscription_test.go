@@ -22,6 +22,7 @@ package events
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -217,7 +218,7 @@ func TestCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp := p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp := p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusCreated, int(resp.StatusCode), "Status Code should be StatusCreated")
 
 	// test with different event Types and same destinations
@@ -229,7 +230,7 @@ func TestCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusConflict, int(resp.StatusCode), "Status Code should be StatusCreated")
 
 	// test with same event Types
@@ -241,7 +242,7 @@ func TestCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusConflict, int(resp.StatusCode), "Status Code should be StatusConflict")
 
 	// test with different Destinations
@@ -253,7 +254,7 @@ func TestCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusCreated, int(resp.StatusCode), "Status Code should be StatusCreated")
 
 	// test another subscription with other OriginResources
@@ -268,7 +269,7 @@ func TestCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusCreated, int(resp.StatusCode), "Status Code should be StatusCreated")
 
 	// test case for collection
@@ -280,7 +281,7 @@ func TestCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusCreated, int(resp.StatusCode), "Status Code should be StatusCreated")
 }
 
@@ -328,7 +329,7 @@ func TestCreateEventSubscriptionwithHostName(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp := p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp := p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusCreated, int(resp.StatusCode), "Status Code should be StatusCreated")
 
 	_, err := evmodel.GetDeviceSubscriptions("*")
@@ -379,7 +380,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp := p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp := p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// invalid post body
@@ -387,7 +388,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     []byte(""),
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req1)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req1)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// if Destination is empty
@@ -398,7 +399,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req2)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req2)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// if Destination is invalid
@@ -409,7 +410,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req2)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req2)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 	SubscriptionReq["Destination"] = "https://10.24.1.24:8070/Destination1"
 
@@ -421,7 +422,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// if Protocol is invalid
@@ -432,7 +433,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 	SubscriptionReq["Protocol"] = "Redfish"
 
@@ -444,7 +445,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// if EventFormatType is invalid
@@ -455,7 +456,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 	SubscriptionReq["EventFormatType"] = "Event"
 
@@ -467,7 +468,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// if SubscriptionType is invalid
@@ -478,7 +479,7 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 	SubscriptionReq["SubscriptionType"] = "RedfishEvent"
 
@@ -490,17 +491,29 @@ func TestNegativeCasesCreateEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 	SubscriptionReq["ResourceTypes"] = []string{}
 
+	// if TagFilter is invalid
+	SubscriptionReq["Oem"] = map[string]interface{}{"ODIM": map[string]interface{}{"TagFilter": "Oem/ODIM/Tags eq"}}
 	postBody, _ = json.Marshal(&SubscriptionReq)
 
 	req = &eventsproto.EventSubRequest{
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
+	delete(SubscriptionReq, "Oem")
+
+	postBody, _ = json.Marshal(&SubscriptionReq)
+
+	req = &eventsproto.EventSubRequest{
+		SessionToken: "token",
+		PostBody:     postBody,
+	}
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 }
 
@@ -546,7 +559,7 @@ func TestCreateDefaultEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	p.CreateEventSubscription(taskID, sessionUserName, req)
+	p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 
 	systemURL := []string{"/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1"}
 	eventTypes := []string{"Alert"}
@@ -554,7 +567,7 @@ func TestCreateDefaultEventSubscription(t *testing.T) {
 	resourceTypes := []string{}
 	protocol := "redfish"
 
-	resp := p.CreateDefaultEventSubscription(systemURL, eventTypes, messageIDs, resourceTypes, protocol)
+	resp := p.CreateDefaultEventSubscription(context.Background(), systemURL, eventTypes, messageIDs, resourceTypes, protocol)
 	assert.Equal(t, http.StatusCreated, int(resp.StatusCode), "Status Code should be StatusCreated")
 
 }
@@ -606,7 +619,7 @@ func TestFabricEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp := p.CreateEventSubscription(taskID, sessionUserName, req)
+	resp := p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req)
 	assert.Equal(t, http.StatusCreated, int(resp.StatusCode), "Status Code should be StatusCreated")
 
 	// Negative test cases
@@ -621,13 +634,13 @@ func TestFabricEventSubscription(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     postBody,
 	}
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req1)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req1)
 	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status Code should be StatusCreated")
 
 	fabuuid = "11081de0-4859-984c-c35a-6c50732d72da"
 	mockFabricData(t, fabuuid, "CFM1")
 	// Invalid Plugin ID
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req1)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req1)
 	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status Code should be StatusCreated")
 
 	// Unauthorized token
@@ -644,7 +657,7 @@ func TestFabricEventSubscription(t *testing.T) {
 		PostBody:     postBody,
 	}
 
-	resp = p.CreateEventSubscription(taskID, sessionUserName, req2)
+	resp = p.CreateEventSubscription(context.Background(), taskID, sessionUserName, req2)
 	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status Code should be StatusCreated")
 }
 
@@ -717,7 +730,7 @@ func TestCheckCollectionSubscription(t *testing.T) {
 	}
 	originResources := "/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1"
 	protocol := "Redfish"
-	p.checkCollectionSubscription(originResources, protocol)
+	p.checkCollectionSubscription(context.Background(), originResources, protocol)
 	devSub, _ := evmodel.GetDeviceSubscriptions("*" + originResources)
 	assert.Equal(t, "https://10.4.1.2/ODIM/v1/Subscriptions/12", devSub.Location, "Location should be https://10.4.1.2/ODIM/v1/Subscriptions/12")
 	assert.Equal(t, "10.4.1.2", devSub.EventHostIP, "EventHostIP should be 10.4.1.2")
@@ -22,13 +22,16 @@
 package events
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-events/evcommon"
@@ -37,11 +40,11 @@ import (
 )
 
 // GetEventSubscriptionsDetails collects subscription data against given subscription id
-func (p *PluginContact) GetEventSubscriptionsDetails(req *eventsproto.EventRequest) response.RPC {
+func (p *PluginContact) GetEventSubscriptionsDetails(ctx context.Context, req *eventsproto.EventRequest) response.RPC {
 	var resp response.RPC
 	authResp := p.Auth(req.SessionToken, []string{common.PrivilegeConfigureComponents}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Printf("error while trying to authenticate session: status code: %v, status message: %v", authResp.StatusCode, authResp.StatusMessage)
+		logs.Log(ctx).Errorf("error while trying to authenticate session: status code: %v, status message: %v", authResp.StatusCode, authResp.StatusMessage)
 		return authResp
 	}
 	resp.Header = map[string]string{
@@ -55,12 +58,12 @@ func (p *PluginContact) GetEventSubscriptionsDetails(req *eventsproto.EventReque
 
 	subscriptionDetails, err := evmodel.GetEvtSubscriptions(req.EventSubscriptionID)
 	if err != nil && !strings.Contains(err.Error(), "No data found for the key") {
-		log.Printf("error getting eventsubscription details : %v", err)
+		logs.Log(ctx).Errorf("error getting eventsubscription details : %v", err)
 		errorMessage := err.Error()
 		return common.GeneralError(http.StatusBadRequest, response.ResourceNotFound, errorMessage, []interface{}{"EventSubscription", req.EventSubscriptionID}, nil)
 	}
 	if len(subscriptionDetails) < 1 {
-		log.Printf("Subscription details not found for ID: %v", req.EventSubscriptionID)
+		logs.Log(ctx).Errorf("Subscription details not found for ID: %v", req.EventSubscriptionID)
 		errorMessage := fmt.Sprintf("Subscription details not found for ID: %v", req.EventSubscriptionID)
 		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"EventSubscription", req.EventSubscriptionID}, nil)
 	}
@@ -71,36 +74,18 @@ func (p *PluginContact) GetEventSubscriptionsDetails(req *eventsproto.EventReque
 		// we need to match the subscripton id
 		if evtSubscription.SubscriptionID != req.EventSubscriptionID {
 			errorMessage := fmt.Sprintf("Subscription details not found for subscription id: %s", req.EventSubscriptionID)
-			log.Println(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			var msgArgs = []interface{}{"SubscriptionID", req.EventSubscriptionID}
 			evcommon.GenErrorResponse(errorMessage, response.ResourceNotFound, http.StatusNotFound, msgArgs, &resp)
 			return resp
 		}
 		// if requested subscription id not matched with stored subscription id then return error
 		if req.EventSubscriptionID != evtSubscription.SubscriptionID {
-			log.Printf("Subscription details not found for ID: %v", req.EventSubscriptionID)
+			logs.Log(ctx).Errorf("Subscription details not found for ID: %v", req.EventSubscriptionID)
 			errorMessage := fmt.Sprintf("Subscription details not found for ID: %v", req.EventSubscriptionID)
 			return common.GeneralError(http.StatusBadRequest, response.ResourceNotFound, errorMessage, []interface{}{"EventSubscription", req.EventSubscriptionID}, nil)
 		}
-		commonResponse := response.Response{
-			OdataType:    "#EventDestination.v1_7_0.EventDestination",
-			ID:           evtSubscription.SubscriptionID,
-			Name:         evtSubscription.Name,
-			OdataContext: "/redfish/v1/$metadata#EventDestination.EventDestination",
-			OdataID:      "/redfish/v1/EventService/Subscriptions/" + evtSubscription.SubscriptionID,
-		}
-
-		subscriptions = &evresponse.SubscriptionResponse{
-			Response:         commonResponse,
-			Destination:      evtSubscription.Destination,
-			Protocol:         evtSubscription.Protocol,
-			Context:          evtSubscription.Context,
-			EventTypes:       evtSubscription.EventTypes,
-			SubscriptionType: evtSubscription.SubscriptionType,
-			MessageIds:       evtSubscription.MessageIds,
-			ResourceTypes:    evtSubscription.ResourceTypes,
-			OriginResources:  updateOriginResourceswithOdataID(evtSubscription.OriginResources),
-		}
+		subscriptions = buildSubscriptionResponse(evtSubscription)
 	}
 	resp.Body = subscriptions
 	resp.StatusCode = http.StatusOK
@@ -108,6 +93,36 @@ func (p *PluginContact) GetEventSubscriptionsDetails(req *eventsproto.EventReque
 	return resp
 }
 
+// buildSubscriptionResponse builds the EventDestination resource from the subscription stored in DB
+func buildSubscriptionResponse(evtSubscription evmodel.Subscription) *evresponse.SubscriptionResponse {
+	commonResponse := response.Response{
+		OdataType:    "#EventDestination.v1_7_0.EventDestination",
+		ID:           evtSubscription.SubscriptionID,
+		Name:         evtSubscription.Name,
+		OdataContext: "/redfish/v1/$metadata#EventDestination.EventDestination",
+		OdataID:      "/redfish/v1/EventService/Subscriptions/" + evtSubscription.SubscriptionID,
+	}
+
+	var oem *evresponse.SubscriptionOem
+	if evtSubscription.TagFilter != "" {
+		oem = &evresponse.SubscriptionOem{
+			ODIM: evresponse.SubscriptionOdim{TagFilter: evtSubscription.TagFilter},
+		}
+	}
+	return &evresponse.SubscriptionResponse{
+		Response:         commonResponse,
+		Destination:      evtSubscription.Destination,
+		Protocol:         evtSubscription.Protocol,
+		Context:          evtSubscription.Context,
+		EventTypes:       evtSubscription.EventTypes,
+		SubscriptionType: evtSubscription.SubscriptionType,
+		MessageIds:       evtSubscription.MessageIds,
+		ResourceTypes:    evtSubscription.ResourceTypes,
+		OriginResources:  updateOriginResourceswithOdataID(evtSubscription.OriginResources),
+		Oem:              oem,
+	}
+}
+
 func updateOriginResourceswithOdataID(originResources []string) []evresponse.ListMember {
 	var originRes []evresponse.ListMember
 	for _, origin := range originResources {
@@ -117,11 +132,11 @@ func updateOriginResourceswithOdataID(originResources []string) []evresponse.Lis
 }
 
 // GetEventSubscriptionsCollection collects all subscription details
-func (p *PluginContact) GetEventSubscriptionsCollection(req *eventsproto.EventRequest) response.RPC {
+func (p *PluginContact) GetEventSubscriptionsCollection(ctx context.Context, req *eventsproto.EventRequest) response.RPC {
 	var resp response.RPC
 	authResp := p.Auth(req.SessionToken, []string{common.PrivilegeConfigureComponents}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Printf("error while trying to authenticate session: status code: %v, status message: %v", authResp.StatusCode, authResp.StatusMessage)
+		logs.Log(ctx).Errorf("error while trying to authenticate session: status code: %v, status message: %v", authResp.StatusCode, authResp.StatusMessage)
 		return authResp
 	}
 	resp.Header = map[string]string{
@@ -132,11 +147,13 @@ func (p *PluginContact) GetEventSubscriptionsCollection(req *eventsproto.EventRe
 		"OData-Version":     "4.0",
 	}
 	listMembers := []evresponse.ListMember{}
+	// subscriptions holds the subscriptions read, used for expanding the members
+	subscriptions := make(map[string]*evresponse.SubscriptionResponse)
 	searchKey := "*"
 
 	subscriptionDetails, err := evmodel.GetEvtSubscriptions(searchKey)
 	if err != nil && !strings.Contains(err.Error(), "No data found for the key") {
-		log.Printf("error getting eventsubscription details : %v", err)
+		logs.Log(ctx).Errorf("error getting eventsubscription details : %v", err)
 		errorMessage := err.Error()
 		return common.GeneralError(http.StatusServiceUnavailable, response.CouldNotEstablishConnection, errorMessage, []interface{}{config.Data.DBConf.InMemoryHost + ":" + config.Data.DBConf.InMemoryPort}, nil)
 	}
@@ -151,6 +168,7 @@ func (p *PluginContact) GetEventSubscriptionsCollection(req *eventsproto.EventRe
 		}
 
 		listMembers = append(listMembers, member)
+		subscriptions[member.OdataID] = buildSubscriptionResponse(evtSubscription)
 	}
 
 	eventResp := evresponse.ListResponse{
@@ -165,5 +183,16 @@ func (p *PluginContact) GetEventSubscriptionsCollection(req *eventsproto.EventRe
 	resp.Body = eventResp
 	resp.StatusCode = http.StatusOK
 	resp.StatusMessage = response.Success
-	return resp
+	// the subscriptions are already read, they're expanded without reading the DB again
+	return common.ApplyQueryOptions(resp, req.URL, common.EachMember(func(odataID string) (string, *errors.Error) {
+		subscription, ok := subscriptions[odataID]
+		if !ok {
+			return "", errors.PackError(errors.DBKeyNotFound, "no subscription found for ", odataID)
+		}
+		data, err := json.Marshal(subscription)
+		if err != nil {
+			return "", errors.PackError(errors.UndefinedErrorType, err)
+		}
+		return string(data), nil
+	}))
 }
@@ -21,6 +21,7 @@
 package events
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -55,17 +56,27 @@ func TestGetEventSubscriptionsCollection(t *testing.T) {
 	}
 
 	// positive test case
-	resp := pc.GetEventSubscriptionsCollection(req)
+	resp := pc.GetEventSubscriptionsCollection(context.Background(), req)
 	data := resp.Body.(evresponse.ListResponse)
 	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusOK")
 	assert.Equal(t, 8, data.MembersCount, "MembersCount should be 8")
 
+	// positive test case with $expand and $top
+	req.URL = "/redfish/v1/EventService/Subscriptions?$expand=*&$top=2"
+	resp = pc.GetEventSubscriptionsCollection(context.Background(), req)
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusOK")
+	expanded := resp.Body.(map[string]interface{})
+	members := expanded["Members"].([]interface{})
+	assert.Equal(t, 2, len(members), "Members should be limited to 2")
+	assert.NotNil(t, members[0].(map[string]interface{})["Destination"], "Members should be expanded")
+	assert.NotNil(t, expanded["Members@odata.nextLink"], "Members@odata.nextLink should be present")
+
 	// Negative test cases
 	// Invalid token
 	req1 := &eventsproto.EventRequest{
 		SessionToken: "InValidToken",
 	}
-	resp = pc.GetEventSubscriptionsCollection(req1)
+	resp = pc.GetEventSubscriptionsCollection(context.Background(), req1)
 	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status Code should be StatusUnauthorized")
 
 }
@@ -88,7 +99,7 @@ func TestGetEventSubscription(t *testing.T) {
 		SessionToken:        "validToken",
 		EventSubscriptionID: "81de0110-c35a-4859-984c-072d6c5a32d7",
 	}
-	resp := pc.GetEventSubscriptionsDetails(req)
+	resp := pc.GetEventSubscriptionsDetails(context.Background(), req)
 	data := resp.Body.(*evresponse.SubscriptionResponse)
 	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusOK")
 	assert.Equal(t, "81de0110-c35a-4859-984c-072d6c5a32d7", data.Response.ID, "ID should be 1")
@@ -100,11 +111,11 @@ func TestGetEventSubscription(t *testing.T) {
 	req1 := &eventsproto.EventRequest{
 		SessionToken: "InValidToken",
 	}
-	resp = pc.GetEventSubscriptionsDetails(req1)
+	resp = pc.GetEventSubscriptionsDetails(context.Background(), req1)
 	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status Code should be StatusUnauthorized")
 
 	common.TruncateDB(common.OnDisk)
-	resp = pc.GetEventSubscriptionsDetails(req)
+	resp = pc.GetEventSubscriptionsDetails(context.Background(), req)
 	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status Code should be StatusNotFound")
 
 }
@@ -29,40 +29,47 @@ import (
 	log "github.com/sirupsen/logrus"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ODIM-Project/ODIM/lib-rest-client/pmbhandle"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
 	aggregatorproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/aggregator"
 	fabricproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/fabrics"
 	"github.com/ODIM-Project/ODIM/lib-utilities/services"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
 	"github.com/ODIM-Project/ODIM/svc-events/evcommon"
 	"github.com/ODIM-Project/ODIM/svc-events/evmodel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // addFabric will add the new fabric resource to db when an event is ResourceAdded and
 // originofcondition has fabrics odataid.
-func addFabric(requestData, host string) {
+func addFabric(ctx context.Context, requestData, host string) {
 	var message common.MessageData
 	if err := json.Unmarshal([]byte(requestData), &message); err != nil {
-		log.Error("failed to unmarshal the incoming event: " + requestData + " with the error: " + err.Error())
+		logs.Log(ctx).Error("failed to unmarshal the incoming event: " + requestData + " with the error: " + err.Error())
 		return
 	}
 	for _, inEvent := range message.Events {
 		if inEvent.OriginOfCondition == nil || len(inEvent.OriginOfCondition.Oid) < 1 {
-			log.Info("event not forwarded : Originofcondition is empty in incoming event")
+			logs.Log(ctx).Info("event not forwarded : Originofcondition is empty in incoming event")
 			continue
 		}
 		if strings.EqualFold(inEvent.EventType, "ResourceAdded") &&
 			strings.HasPrefix(inEvent.OriginOfCondition.Oid, "/redfish/v1/Fabrics") {
-			addFabricRPCCall(inEvent.OriginOfCondition.Oid, host)
+			addFabricRPCCall(ctx, inEvent.OriginOfCondition.Oid, host)
 		}
 	}
 }
 
-// PublishEventsToDestination This method sends the event/alert to subscriber's destination
+// PublishEventsToDestination This method sends the event/alert to subscriber's destination,
+// it returns once the event is posted to all the destinations, including the retries
 // Takes:
 // 	data of type interface{}
 //Returns:
@@ -75,11 +82,17 @@ func PublishEventsToDestination(data interface{}) bool {
 	}
 	// Extract the Hostname/IP of the event source and Event from input parameter
 	event := data.(common.Events)
+	ctx, span := tracing.Tracer().Start(
+		tracing.ExtractMap(context.Background(), event.TraceContext),
+		"PublishEventsToDestination",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+	)
+	defer span.End()
 	host, _, err := net.SplitHostPort(event.IP)
 	if err != nil {
 		host = event.IP
 	}
-	log.Info("After splitting host address, IP is: ", host)
+	logs.Log(ctx).Info("After splitting host address, IP is: ", host)
 
 	var requestData = string(event.Request)
 	//replacing the resposne with north bound translation URL
@@ -91,20 +104,21 @@ func PublishEventsToDestination(data interface{}) bool {
 	var uuid string
 	var message common.MessageData
 
-	addFabric(requestData, host)
+	addFabric(ctx, requestData, host)
 	searchKey := evcommon.GetSearchKey(host, evmodel.DeviceSubscriptionIndex)
 	deviceSubscription, err := evmodel.GetDeviceSubscriptions(searchKey)
 	if err != nil {
-		log.Error("Failed to get the event destinations: ", err.Error())
+		logs.Log(ctx).Error("Failed to get the event destinations: ", err.Error())
 		return false
 	}
 
 	if len(deviceSubscription.OriginResources) < 1 {
-		log.Info("no origin resources found in device subscriptions")
+		logs.Log(ctx).Info("no origin resources found in device subscriptions")
 		return false
 	}
 
 	requestData, uuid = formatEvent(requestData, deviceSubscription.OriginResources[0], host)
+	ctx = logs.With(ctx, logs.SystemIDField, uuid)
 
 	searchKey = evcommon.GetSearchKey(host, evmodel.SubscriptionIndex)
 	subscriptions, err := evmodel.GetEvtSubscriptions(searchKey)
@@ -114,19 +128,25 @@ func PublishEventsToDestination(data interface{}) bool {
 
 	err = json.Unmarshal([]byte(requestData), &message)
 	if err != nil {
-		log.Error("failed to unmarshal the incoming event: ", requestData, " with the error: ", err.Error())
+		logs.Log(ctx).Error("failed to unmarshal the incoming event: ", requestData, " with the error: ", err.Error())
 		return false
 	}
 
+	// the tags of the device are read only when a subscription filters on them
+	var deviceTags map[string]interface{}
+	if isTagFilterSubscribed(subscriptions) {
+		deviceTags = getDeviceTags(ctx, uuid)
+	}
+
 	eventMap := make(map[string][]common.Event)
 	for _, inEvent := range message.Events {
 		if inEvent.OriginOfCondition == nil {
-			log.Info("event not forwarded : Originofcondition is empty in incoming event with body: ", requestData)
+			logs.Log(ctx).Info("event not forwarded : Originofcondition is empty in incoming event with body: ", requestData)
 			continue
 		}
 
 		if len(inEvent.OriginOfCondition.Oid) < 1 {
-			log.Info("event not forwarded : Originofcondition is empty in incoming event with body: ", requestData)
+			logs.Log(ctx).Info("event not forwarded : Originofcondition is empty in incoming event with body: ", requestData)
 			continue
 		}
 
@@ -140,7 +160,7 @@ func PublishEventsToDestination(data interface{}) bool {
 		}
 
 		if !resTypePresent {
-			log.Info("event not forwared: resource type of originofcondition not supported in event with body: ", requestData)
+			logs.Log(ctx).Info("event not forwared: resource type of originofcondition not supported in event with body: ", requestData)
 			continue
 		}
 
@@ -151,12 +171,12 @@ func PublishEventsToDestination(data interface{}) bool {
 			if sub.Destination != "" {
 				// check if hostip present in the hosts slice to make sure that it doesn't filter with the destination ip
 				if isHostPresent(sub.Hosts, host) {
-					if filterEventsToBeForwarded(sub, inEvent, deviceSubscription.OriginResources) {
+					if filterEventsToBeForwarded(ctx, sub, inEvent, deviceSubscription.OriginResources) && isTagFilterMatched(ctx, sub.TagFilter, deviceTags) {
 						eventMap[sub.Destination] = append(eventMap[sub.Destination], inEvent)
 						flag = true
 					}
 				} else {
-					log.Info("event not forwarded : No subscription for the incoming event's originofcondition")
+					logs.Log(ctx).Info("event not forwarded : No subscription for the incoming event's originofcondition")
 					flag = false
 				}
 
@@ -165,43 +185,52 @@ func PublishEventsToDestination(data interface{}) bool {
 
 		if strings.EqualFold("Alert", inEvent.EventType) {
 			if strings.Contains(inEvent.MessageID, "ServerPostDiscoveryComplete") || strings.Contains(inEvent.MessageID, "ServerPostComplete") {
-				go rediscoverSystemInventory(uuid, inEvent.OriginOfCondition.Oid)
+				go rediscoverSystemInventory(ctx, uuid, inEvent.OriginOfCondition.Oid)
 				flag = true
 			}
 			if strings.Contains(inEvent.MessageID, "ServerPoweredOn") || strings.Contains(inEvent.MessageID, "ServerPoweredOff") {
-				go updateSystemPowerState(uuid, inEvent.OriginOfCondition.Oid, inEvent.MessageID)
+				go updateSystemPowerState(ctx, uuid, inEvent.OriginOfCondition.Oid, inEvent.MessageID)
 				flag = true
 			}
 		} else if strings.EqualFold("ResourceAdded", message.Events[0].EventType) || strings.EqualFold("ResourceRemoved", message.Events[0].EventType) {
 			if strings.Contains(message.Events[0].OriginOfCondition.Oid, "Volumes") {
 				s := strings.Split(message.Events[0].OriginOfCondition.Oid, "/")
 				storageURI := fmt.Sprintf("/%s/%s/%s/%s/%s/", s[1], s[2], s[3], s[4], s[5])
-				go rediscoverSystemInventory(uuid, storageURI)
+				go rediscoverSystemInventory(ctx, uuid, storageURI)
 				flag = true
 			}
 		}
 	}
 
+	// the events are posted to the destinations concurrently, and the
+	// function returns once they are delivered so that the message is
+	// acknowledged on the message bus only after the delivery
+	var wg sync.WaitGroup
 	for key, value := range eventMap {
 		message.Events = value
 		data, err := json.Marshal(message)
 		if err != nil {
-			log.Error("unable to converts event into bytes: ", err.Error())
+			logs.Log(ctx).Error("unable to converts event into bytes: ", err.Error())
 			continue
 		}
-		go postEvent(key, data)
+		wg.Add(1)
+		go func(destination string, data []byte) {
+			defer wg.Done()
+			deliverEvent(ctx, destination, data)
+		}(key, data)
 	}
+	wg.Wait()
 	return flag
 }
 
-func filterEventsToBeForwarded(subscription evmodel.Subscription, event common.Event, originResources []string) bool {
+func filterEventsToBeForwarded(ctx context.Context, subscription evmodel.Subscription, event common.Event, originResources []string) bool {
 	eventTypes := subscription.EventTypes
 	messageIds := subscription.MessageIds
 	resourceTypes := subscription.ResourceTypes
 	originCondition := strings.TrimSuffix(event.OriginOfCondition.Oid, "/")
-	if (len(eventTypes) == 0 || isStringPresentInSlice(eventTypes, event.EventType, "event type")) &&
-		(len(messageIds) == 0 || isStringPresentInSlice(messageIds, event.MessageID, "message id")) &&
-		(len(resourceTypes) == 0 || isResourceTypeSubscribed(resourceTypes, event.OriginOfCondition.Oid, subscription.SubordinateResources)) {
+	if (len(eventTypes) == 0 || isStringPresentInSlice(ctx, eventTypes, event.EventType, "event type")) &&
+		(len(messageIds) == 0 || isStringPresentInSlice(ctx, messageIds, event.MessageID, "message id")) &&
+		(len(resourceTypes) == 0 || isResourceTypeSubscribed(ctx, resourceTypes, event.OriginOfCondition.Oid, subscription.SubordinateResources)) {
 		// if SubordinateResources is true then check if originofresource is top level of originofcondition
 		// if SubordinateResources is flase then check originofresource is same as originofcondition
 		for _, origin := range originResources {
@@ -216,10 +245,51 @@ func filterEventsToBeForwarded(subscription evmodel.Subscription, event common.E
 			}
 		}
 	}
-	log.Info("Event not forwarded : No subscription for the incoming event's originofcondition")
+	logs.Log(ctx).Info("Event not forwarded : No subscription for the incoming event's originofcondition")
 	return false
 }
 
+// isTagFilterSubscribed tells whether any of the subscriptions filters the events on the tags of the devices
+func isTagFilterSubscribed(subscriptions []evmodel.Subscription) bool {
+	for _, sub := range subscriptions {
+		if sub.TagFilter != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// getDeviceTags returns the tags of the device in the form of the Oem/ODIM section
+// of its systems, against which the tag filters of the subscriptions are evaluated
+func getDeviceTags(ctx context.Context, deviceUUID string) map[string]interface{} {
+	deviceTags := make(map[string]interface{})
+	tags, err := common.GetResourceTags(deviceUUID)
+	if err != nil {
+		logs.Log(ctx).Error("error while trying to get the tags of the device " + deviceUUID + ": " + err.Error())
+		return deviceTags
+	}
+	common.SetResourceTags(deviceTags, tags)
+	return deviceTags
+}
+
+// isTagFilterMatched tells whether the tags of the device match the tag filter of the subscription,
+// the subscriptions without a tag filter receive the events of all the devices
+func isTagFilterMatched(ctx context.Context, tagFilter string, deviceTags map[string]interface{}) bool {
+	if tagFilter == "" {
+		return true
+	}
+	filter, err := common.ParseFilter(tagFilter)
+	if err != nil {
+		logs.Log(ctx).Error("invalid tag filter " + tagFilter + ": " + err.Error())
+		return false
+	}
+	if !filter.Match(deviceTags) {
+		logs.Log(ctx).Info("Event not forwarded : tags of the device don't match the tag filter of the subscription")
+		return false
+	}
+	return true
+}
+
 // formatEvent will format the event string according to the odimra
 // add uuid:systemid/chassisid inplace of systemid/chassisid
 func formatEvent(event, originResource, hostIP string) (string, string) {
@@ -237,7 +307,7 @@ func formatEvent(event, originResource, hostIP string) (string, string) {
 	return event, uuid
 }
 
-func isResourceTypeSubscribed(resourceTypes []string, originOfCondition string, subordinateResources bool) bool {
+func isResourceTypeSubscribed(ctx context.Context, resourceTypes []string, originOfCondition string, subordinateResources bool) bool {
 	//If the incoming odata type field empty then return true
 	if originOfCondition == "" {
 		return true
@@ -270,11 +340,11 @@ func isResourceTypeSubscribed(resourceTypes []string, originOfCondition string,
 			}
 		}
 	}
-	log.Info("Event not forwarded : No subscription for the incoming event's originofcondition")
+	logs.Log(ctx).Info("Event not forwarded : No subscription for the incoming event's originofcondition")
 	return false
 }
 
-func isStringPresentInSlice(slice []string, str, message string) bool {
+func isStringPresentInSlice(ctx context.Context, slice []string, str, message string) bool {
 	//If the incoming event fields contains empty values return true
 	if str == "" {
 		return true
@@ -284,25 +354,30 @@ func isStringPresentInSlice(slice []string, str, message string) bool {
 			return true
 		}
 	}
-	log.Info("Event not forwarded : No subscription for the incoming event's ", message)
+	logs.Log(ctx).Info("Event not forwarded : No subscription for the incoming event's ", message)
 	return false
 }
 
-// postEvent will post the event to destination
-func postEvent(destination string, event []byte) {
+// deliverEvent posts the events published to the destinations, it's replaced in the unit tests
+var deliverEvent = postEvent
+
+// postEvent will post the event to destination as part of the trace carried by ctx
+func postEvent(ctx context.Context, destination string, event []byte) {
 	httpConf := &config.HTTPConfig{
 		CACertificate: &config.Data.KeyCertConf.RootCACertificate,
 	}
 	httpClient, err := httpConf.GetHTTPClientObj()
 	if err != nil {
-		log.Error("failed to get http client object: ", err.Error())
+		logs.Log(ctx).Error("failed to get http client object: ", err.Error())
 		return
 	}
+	httpClient = tracing.NewClient(httpClient)
 	req, err := http.NewRequest("POST", destination, bytes.NewBuffer(event))
 	if err != nil {
-		log.Error("error while getting new http request: ", err.Error())
+		logs.Log(ctx).Error("error while getting new http request: ", err.Error())
 		return
 	}
+	req = req.WithContext(ctx)
 	req.Close = true
 	req.Header.Set("Content-Type", "application/json")
 	var resp *http.Response
@@ -313,20 +388,22 @@ func postEvent(destination string, event []byte) {
 		config.TLSConfMutex.RUnlock()
 		if err == nil {
 			resp.Body.Close()
-			log.Printf("event post response: %v", resp)
+			logs.Log(ctx).Info("event posted to " + destination + ", status code: " + strconv.Itoa(resp.StatusCode))
+			metrics.ObserveEventDelivery(destination, resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices)
 			return
 		}
-		log.Println("Retrying event posting")
+		logs.Log(ctx).Info("Retrying event posting")
 		time.Sleep(time.Second * evcommon.DeliveryRetryIntervalSeconds)
 	}
-	log.Error("error while make https call to send the event: ", err.Error())
+	logs.Log(ctx).Error("error while make https call to send the event: ", err.Error())
+	metrics.ObserveEventDelivery(destination, false)
 	return
 }
 
 // rediscoverSystemInventory will be triggered when ever the System Restart or Power On
 // event is detected it will create a rpc for aggregation which will delete all system inventory //
 // and rediscover all of them
-func rediscoverSystemInventory(systemID, systemURL string) {
+func rediscoverSystemInventory(ctx context.Context, systemID, systemURL string) {
 	systemURL = strings.TrimSuffix(systemURL, "/")
 	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
 	_, err := aggregator.RediscoverSystemInventory(context.TODO(), &aggregatorproto.RediscoverSystemInventoryRequest{
@@ -334,14 +411,14 @@ func rediscoverSystemInventory(systemID, systemURL string) {
 		SystemURL: systemURL,
 	})
 	if err != nil {
-		log.Info("Error while rediscoverSystemInventroy")
+		logs.Log(ctx).Info("Error while rediscoverSystemInventroy")
 		return
 	}
-	log.Info("info: rediscovery of system and chasis started.")
+	logs.Log(ctx).Info("info: rediscovery of system and chasis started.")
 	return
 }
 
-func addFabricRPCCall(origin, address string) {
+func addFabricRPCCall(ctx context.Context, origin, address string) {
 	if strings.Contains(origin, "Zones") || strings.Contains(origin, "Endpoints") || strings.Contains(origin, "AddressPools") {
 		return
 	}
@@ -352,20 +429,20 @@ func addFabricRPCCall(origin, address string) {
 		Address:        address,
 	})
 	if err != nil {
-		log.Error("Error while AddFabric ", err.Error())
+		logs.Log(ctx).Error("Error while AddFabric ", err.Error())
 		return
 	}
 	p := PluginContact{
 		ContactClient: pmbhandle.ContactPlugin,
 	}
-	p.checkCollectionSubscription(origin, "Redfish")
-	log.Info("info: Fabric Added")
+	p.checkCollectionSubscription(ctx, origin, "Redfish")
+	logs.Log(ctx).Info("info: Fabric Added")
 	return
 }
 
 // updateSystemPowerState will be triggered when ever the System Powered Off event is received
 // When event is detected a rpc is created for aggregation which will update the system inventory
-func updateSystemPowerState(systemUUID, systemURI, state string) {
+func updateSystemPowerState(ctx context.Context, systemUUID, systemURI, state string) {
 
 	systemURI = strings.TrimSuffix(systemURI, "/")
 
@@ -374,7 +451,7 @@ func updateSystemPowerState(systemUUID, systemURI, state string) {
 	id := systemURI[index+1:]
 
 	if strings.ContainsAny(id, ":/-") {
-		log.Info("error: event contains invalid origin of condition - ", systemURI)
+		logs.Log(ctx).Info("error: event contains invalid origin of condition - ", systemURI)
 		return
 	}
 	if strings.Contains(state, "ServerPoweredOn") {
@@ -391,9 +468,9 @@ func updateSystemPowerState(systemUUID, systemURI, state string) {
 		UpdateVal:  state,
 	})
 	if err != nil {
-		log.Error("system power state update failed with ", err.Error())
+		logs.Log(ctx).Error("system power state update failed with ", err.Error())
 		return
 	}
-	log.Info("info: system power state update initiated")
+	logs.Log(ctx).Info("info: system power state update initiated")
 	return
 }
@@ -21,7 +21,9 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
+	"sync"
 	"testing"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
@@ -30,6 +32,21 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// mockDeliverEvent records the destinations of the events instead of posting them,
+// the returned function restores the delivery
+func mockDeliverEvent() (*[]string, func()) {
+	var mux sync.Mutex
+	var destinations []string
+	deliverEvent = func(ctx context.Context, destination string, event []byte) {
+		mux.Lock()
+		defer mux.Unlock()
+		destinations = append(destinations, destination)
+	}
+	return &destinations, func() {
+		deliverEvent = postEvent
+	}
+}
+
 func storeTestEventDetails(t *testing.T) {
 	subarr := []evmodel.Subscription{
 		// if SubordinateResources true
@@ -206,6 +223,8 @@ func TestPublishEventsToDestiantion(t *testing.T) {
 		}
 	}()
 	storeTestEventDetails(t)
+	_, restore := mockDeliverEvent()
+	defer restore()
 	messages := []common.MessageData{
 		{
 			OdataType: "#Event",
@@ -303,6 +322,8 @@ func TestPublishEventsToDestiantionWithMultipleEvents(t *testing.T) {
 		}
 	}()
 	storeTestEventDetails(t)
+	destinations, restore := mockDeliverEvent()
+	defer restore()
 	messages := []common.MessageData{
 		{
 			OdataType: "#Event",
@@ -347,4 +368,21 @@ func TestPublishEventsToDestiantionWithMultipleEvents(t *testing.T) {
 		flag := PublishEventsToDestination(event)
 		assert.True(t, flag)
 	}
+	assert.NotEmpty(t, *destinations, "events should be delivered before PublishEventsToDestination returns")
+}
+
+func TestIsTagFilterMatched(t *testing.T) {
+	deviceTags := make(map[string]interface{})
+	common.SetResourceTags(deviceTags, common.ResourceTags{
+		Tags:             []string{"prod"},
+		CustomProperties: map[string]string{"Owner": "team-a"},
+	})
+	assert.True(t, isTagFilterMatched(context.Background(), "", deviceTags), "events should be forwarded without a tag filter")
+	assert.True(t, isTagFilterMatched(context.Background(), "Oem/ODIM/Tags eq prod", deviceTags), "events of the tagged device should be forwarded")
+	assert.True(t, isTagFilterMatched(context.Background(), "Oem/ODIM/CustomProperties/Owner eq 'team-a'", deviceTags), "events of the device with the custom property should be forwarded")
+	assert.False(t, isTagFilterMatched(context.Background(), "Oem/ODIM/Tags eq lab", deviceTags), "events of the device without the tag should not be forwarded")
+	assert.False(t, isTagFilterMatched(context.Background(), "Oem/ODIM/Tags eq prod", map[string]interface{}{}), "events of the untagged device should not be forwarded")
+	assert.False(t, isTagFilterMatched(context.Background(), "Oem/ODIM/Tags eq", deviceTags), "events should not be forwarded with an invalid tag filter")
+	assert.True(t, isTagFilterSubscribed([]evmodel.Subscription{{}, {TagFilter: "Oem/ODIM/Tags eq prod"}}), "tag filter should be found")
+	assert.False(t, isTagFilterSubscribed([]evmodel.Subscription{{}}), "tag filter should not be found")
 }
@@ -0,0 +1,100 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+)
+
+// replayEventsRequest is the request body of the replay events action
+type replayEventsRequest struct {
+	StartTime string `json:"StartTime"`
+}
+
+// ReplayEvents is a helper method to handle the replay events request.
+// The events consumed from the message bus since the given start time
+// are published again to the subscribers in the background.
+func (p *PluginContact) ReplayEvents(ctx context.Context, req *eventsproto.EventSubRequest) response.RPC {
+	var resp response.RPC
+	authResp := p.Auth(req.SessionToken, []string{common.PrivilegeConfigureManager}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		logs.Log(ctx).Error("error while trying to authenticate session: status code: " +
+			strconv.Itoa(int(authResp.StatusCode)) + ", status message: " + authResp.StatusMessage)
+		return authResp
+	}
+
+	var replayReq replayEventsRequest
+	if err := json.Unmarshal(req.PostBody, &replayReq); err != nil {
+		errMsg := "unable to parse the replay events request: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errMsg, nil, nil)
+	}
+
+	// Validating the request JSON properties for case sensitive
+	invalidProperties, err := common.RequestParamsCaseValidator(req.PostBody, replayReq)
+	if err != nil {
+		errMsg := "error while validating request parameters: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
+	} else if invalidProperties != "" {
+		errorMessage := "error: one or more properties given in the request body are not valid, ensure properties are listed in uppercamelcase "
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyUnknown, errorMessage, []interface{}{invalidProperties}, nil)
+	}
+
+	if replayReq.StartTime == "" {
+		errMsg := "error: StartTime is a required parameter"
+		logs.Log(ctx).Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errMsg, []interface{}{"StartTime"}, nil)
+	}
+	since, err := time.Parse(time.RFC3339, replayReq.StartTime)
+	if err != nil {
+		errMsg := "error: StartTime must be in RFC3339 format: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, errMsg, []interface{}{replayReq.StartTime, "StartTime"}, nil)
+	}
+	if since.After(time.Now()) {
+		errMsg := "error: StartTime must not be in the future"
+		logs.Log(ctx).Error(errMsg)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, errMsg, []interface{}{replayReq.StartTime, "StartTime"}, nil)
+	}
+
+	logs.Log(ctx).Info("replaying the events since " + since.String())
+	go p.ReplayTopics(since)
+
+	resp.Header = map[string]string{
+		"Cache-Control":     "no-cache",
+		"Connection":        "keep-alive",
+		"Content-type":      "application/json; charset=utf-8",
+		"Transfer-Encoding": "chunked",
+		"OData-Version":     "4.0",
+	}
+	resp.StatusCode = http.StatusOK
+	resp.StatusMessage = response.Success
+	resp.Body = response.ErrorClass{
+		Code:    resp.StatusMessage,
+		Message: "Request completed successfully.",
+	}
+	return resp
+}
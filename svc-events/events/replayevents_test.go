@@ -0,0 +1,116 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package events
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+)
+
+func TestReplayEvents(t *testing.T) {
+	replayed := make(chan time.Time, 1)
+	p := &PluginContact{
+		Auth: mockIsAuthorized,
+		ReplayTopics: func(since time.Time) {
+			replayed <- since
+		},
+	}
+	tests := []struct {
+		name          string
+		sessionToken  string
+		body          string
+		statusCode    int32
+		statusMessage string
+	}{
+		{
+			name:          "valid request",
+			sessionToken:  "validToken",
+			body:          `{"StartTime":"2020-11-02T10:00:00Z"}`,
+			statusCode:    http.StatusOK,
+			statusMessage: response.Success,
+		},
+		{
+			name:          "invalid session",
+			sessionToken:  "invalidToken",
+			body:          `{"StartTime":"2020-11-02T10:00:00Z"}`,
+			statusCode:    http.StatusUnauthorized,
+			statusMessage: response.NoValidSession,
+		},
+		{
+			name:          "malformed body",
+			sessionToken:  "validToken",
+			body:          `{"StartTime":`,
+			statusCode:    http.StatusBadRequest,
+			statusMessage: response.MalformedJSON,
+		},
+		{
+			name:          "invalid property",
+			sessionToken:  "validToken",
+			body:          `{"startTime":"2020-11-02T10:00:00Z"}`,
+			statusCode:    http.StatusBadRequest,
+			statusMessage: response.PropertyUnknown,
+		},
+		{
+			name:          "missing start time",
+			sessionToken:  "validToken",
+			body:          `{}`,
+			statusCode:    http.StatusBadRequest,
+			statusMessage: response.PropertyMissing,
+		},
+		{
+			name:          "invalid start time",
+			sessionToken:  "validToken",
+			body:          `{"StartTime":"02-11-2020"}`,
+			statusCode:    http.StatusBadRequest,
+			statusMessage: response.PropertyValueFormatError,
+		},
+		{
+			name:          "start time in the future",
+			sessionToken:  "validToken",
+			body:          `{"StartTime":"` + time.Now().Add(time.Hour).Format(time.RFC3339) + `"}`,
+			statusCode:    http.StatusBadRequest,
+			statusMessage: response.PropertyValueFormatError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &eventsproto.EventSubRequest{
+				SessionToken: tt.sessionToken,
+				PostBody:     []byte(tt.body),
+			}
+			resp := p.ReplayEvents(context.Background(), req)
+			if resp.StatusCode != tt.statusCode {
+				t.Errorf("ReplayEvents(context.Background(), ) status code = %v, want %v", resp.StatusCode, tt.statusCode)
+			}
+			if resp.StatusMessage != tt.statusMessage {
+				t.Errorf("ReplayEvents(context.Background(), ) status message = %v, want %v", resp.StatusMessage, tt.statusMessage)
+			}
+		})
+	}
+
+	select {
+	case since := <-replayed:
+		if want := time.Date(2020, 11, 2, 10, 0, 0, 0, time.UTC); !since.Equal(want) {
+			t.Errorf("ReplayEvents(context.Background(), ) replayed since %v, want %v", since, want)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("ReplayEvents(context.Background(), ) did not replay the events")
+	}
+}
@@ -22,23 +22,24 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 	"net/http"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-events/evmodel"
 )
 
 // SubmitTestEvent is a helper method to handle the submit test event request.
-func (p *PluginContact) SubmitTestEvent(req *eventsproto.EventSubRequest) response.RPC {
+func (p *PluginContact) SubmitTestEvent(ctx context.Context, req *eventsproto.EventSubRequest) response.RPC {
 	var resp response.RPC
 	authResp := p.Auth(req.SessionToken, []string{common.PrivilegeConfigureComponents}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authenticate session: status code: " +
+		logs.Log(ctx).Error("error while trying to authenticate session: status code: " +
 			string(authResp.StatusCode) + ", status message: " + authResp.StatusMessage)
 		return authResp
 	}
@@ -47,13 +48,14 @@ func (p *PluginContact) SubmitTestEvent(req *eventsproto.EventSubRequest) respon
 	if err != nil {
 		// handle the error case with appropriate response body
 		errMsg := "error while trying to authenticate session: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errMsg, nil, nil)
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 
 	testEvent, statusMessage, errMsg, msgArgs := validAndGenSubTestReq(req.PostBody)
 	if statusMessage != response.Success {
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return common.GeneralError(http.StatusBadRequest, statusMessage, errMsg, msgArgs, nil)
 	}
 
@@ -62,7 +64,7 @@ func (p *PluginContact) SubmitTestEvent(req *eventsproto.EventSubRequest) respon
 	err = json.Unmarshal(req.PostBody, &eventObj)
 	if err != nil {
 		errMsg := "unable to parse the event request" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
 	}
 
@@ -70,11 +72,11 @@ func (p *PluginContact) SubmitTestEvent(req *eventsproto.EventSubRequest) respon
 	invalidProperties, err := common.RequestParamsCaseValidator(req.PostBody, eventObj)
 	if err != nil {
 		errMsg := "error while validating request parameters: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
 	} else if invalidProperties != "" {
 		errorMessage := "error: one or more properties given in the request body are not valid, ensure properties are listed in uppercamelcase "
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		resp := common.GeneralError(http.StatusBadRequest, response.PropertyUnknown, errorMessage, []interface{}{invalidProperties}, nil)
 		return resp
 	}
@@ -84,7 +86,7 @@ func (p *PluginContact) SubmitTestEvent(req *eventsproto.EventSubRequest) respon
 	if err != nil {
 		// Internall error
 		errMsg := "error while trying to find the event destination"
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil)
 	}
 	// we need common.MessageData to find the correct destination to send test event
@@ -95,9 +97,9 @@ func (p *PluginContact) SubmitTestEvent(req *eventsproto.EventSubRequest) respon
 
 		for _, origin := range sub.OriginResources {
 			if sub.Destination != "" {
-				if filterEventsToBeForwarded(sub, message.Events[0], []string{origin}) {
-					log.Info("Destination: " + sub.Destination)
-					go postEvent(sub.Destination, messageBytes)
+				if filterEventsToBeForwarded(ctx, sub, message.Events[0], []string{origin}) {
+					logs.Log(ctx).Info("Destination: " + sub.Destination)
+					go postEvent(context.TODO(), sub.Destination, messageBytes)
 				}
 			}
 		}
@@ -21,6 +21,7 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -78,7 +79,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     message,
 	}
-	resp := p.SubmitTestEvent(req)
+	resp := p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusOK")
 
 	// Negative - with invalid request body
@@ -86,7 +87,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte{},
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// Invalid token
@@ -94,7 +95,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "token",
 		PostBody:     message,
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status Code should be StatusUnauthorized")
 
 	// test case for get session user name
@@ -102,7 +103,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "InvalidToken",
 		PostBody:     message,
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status Code should be StatusUnauthorized")
 
 	// test case for invalid user name
@@ -110,7 +111,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     message,
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status Code should be StatusInternalServerError")
 
 	// with invalid messageId type
@@ -118,7 +119,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": 123}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid EventGroupId type
@@ -126,7 +127,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "EventGroupId": "aasdd"}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid EventId type
@@ -134,7 +135,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "EventId": 123}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid EventTimestamp type
@@ -142,7 +143,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "EventTimestamp": 123}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid EventType type
@@ -150,7 +151,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "EventType": 123}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid EventType data
@@ -158,7 +159,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "EventType": "123"}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid Message type
@@ -166,7 +167,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "Message": 123}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid MessageArgs type
@@ -174,7 +175,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "MessageArgs": "123"}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid OriginOfCondition type
@@ -182,7 +183,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "OriginOfCondition": 123}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid Severity type
@@ -190,7 +191,7 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "Severity": 123}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 
 	// with invalid Severity value
@@ -198,6 +199,6 @@ func TestSubmitTestEvent(t *testing.T) {
 		SessionToken: "validToken",
 		PostBody:     []byte(`{"MessageId": "123", "Severity": "123"}`),
 	}
-	resp = p.SubmitTestEvent(req)
+	resp = p.SubmitTestEvent(context.Background(), req)
 	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status Code should be StatusBadRequest")
 }
@@ -52,17 +52,30 @@ type OdataIDLink struct {
 
 //RequestBody is required to receive the post request payload
 type RequestBody struct {
-	Name                 string        `json:"Name"`
-	Destination          string        `json:"Destination" validate:"required"`
-	EventTypes           []string      `json:"EventTypes,omitempty"`
-	MessageIds           []string      `json:"MessageIds,omitempty"`
-	ResourceTypes        []string      `json:"ResourceTypes,omitempty"`
-	Context              string        `json:"Context"`
-	Protocol             string        `json:"Protocol" validate:"required"`
-	SubscriptionType     string        `json:"SubscriptionType"`
-	EventFormatType      string        `json:"EventFormatType"`
-	SubordinateResources bool          `json:"SubordinateResources"`
-	OriginResources      []OdataIDLink `json:"OriginResources"`
+	Name                 string           `json:"Name"`
+	Destination          string           `json:"Destination" validate:"required"`
+	EventTypes           []string         `json:"EventTypes,omitempty"`
+	MessageIds           []string         `json:"MessageIds,omitempty"`
+	ResourceTypes        []string         `json:"ResourceTypes,omitempty"`
+	Context              string           `json:"Context"`
+	Protocol             string           `json:"Protocol" validate:"required"`
+	SubscriptionType     string           `json:"SubscriptionType"`
+	EventFormatType      string           `json:"EventFormatType"`
+	SubordinateResources bool             `json:"SubordinateResources"`
+	OriginResources      []OdataIDLink    `json:"OriginResources"`
+	Oem                  *SubscriptionOem `json:"Oem,omitempty"`
+}
+
+// SubscriptionOem is the Oem section of an event subscription
+type SubscriptionOem struct {
+	ODIM SubscriptionOdim `json:"ODIM"`
+}
+
+// SubscriptionOdim holds the odimra specific properties of an event subscription
+type SubscriptionOdim struct {
+	// TagFilter is a $filter expression on the Oem/ODIM tags of the devices,
+	// only the events of the devices whose tags match the filter are forwarded
+	TagFilter string `json:"TagFilter,omitempty"`
 }
 
 //Subscription is a model to store the subscription details
@@ -88,6 +101,8 @@ type Subscription struct {
 	// Remove Location and EventHostIP
 	Location    string `json:"location,omitempty"`
 	EventHostIP string `json:"EventHostIP,omitempty"`
+	// To store the filter on the tags of the devices
+	TagFilter string `json:"TagFilter,omitempty"`
 }
 
 //DeviceSubscription is a model to store the subscription details of a device
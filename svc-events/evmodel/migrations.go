@@ -0,0 +1,85 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package evmodel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+)
+
+// Migrations are the changes of the schema of the records stored by the event service,
+// they are run on the on-disk DB when the service starts
+var Migrations = []persistencemgr.Migration{
+	{
+		Table:       SubscriptionIndex,
+		Version:     1,
+		Description: "remove the location and EventHostIP of the event subscriptions, they are kept in the device subscriptions",
+		Migrate:     removeSubscriptionLocation,
+	},
+}
+
+// removeSubscriptionLocation rewrites the event subscriptions stored with the location and the
+// EventHostIP of the device. The new record is added before the old one is removed, so that
+// the subscription is kept if the migration stops in between.
+func removeSubscriptionLocation(conn persistencemgr.ConnPool) error {
+	subscriptions, err := conn.GetEvtSubscriptions(SubscriptionIndex, "*")
+	if err != nil {
+		return err
+	}
+	for _, subscription := range subscriptions {
+		var evtSubscription Subscription
+		if err := json.Unmarshal([]byte(subscription), &evtSubscription); err != nil {
+			return fmt.Errorf("error while unmarshalling event subscription: %v", err)
+		}
+		if evtSubscription.Location == "" && evtSubscription.EventHostIP == "" {
+			continue
+		}
+		evtSubscription.Location = ""
+		evtSubscription.EventHostIP = ""
+		data, err := json.Marshal(evtSubscription)
+		if err != nil {
+			return fmt.Errorf("error while marshalling event subscription: %v", err)
+		}
+		updated, err := conn.GetEvtSubscriptions(SubscriptionIndex, escapeMatchPattern(string(data)))
+		if err != nil {
+			return err
+		}
+		if len(updated) == 0 {
+			if err := conn.CreateEvtSubscriptionIndex(SubscriptionIndex, string(data)); err != nil {
+				return err
+			}
+		}
+		if err := conn.DeleteEvtSubscriptions(SubscriptionIndex, escapeMatchPattern(subscription)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// escapeMatchPattern escapes the special characters of a MATCH pattern, so that the pattern
+// only matches the given value
+func escapeMatchPattern(value string) string {
+	escaped := make([]byte, 0, len(value))
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '*', '?', '[', ']', '\\':
+			escaped = append(escaped, '\\')
+		}
+		escaped = append(escaped, value[i])
+	}
+	return string(escaped)
+}
@@ -0,0 +1,89 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package evmodel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+)
+
+func TestRemoveSubscriptionLocation(t *testing.T) {
+	common.SetUpMockConfig()
+	defer func() {
+		err := common.TruncateDB(common.OnDisk)
+		if err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}()
+
+	legacy := Subscription{
+		SubscriptionID:  "1",
+		Destination:     "https://10.24.1.23:8080/destination",
+		Name:            "Event Subscription",
+		EventTypes:      []string{"Alert"},
+		OriginResources: []string{"/redfish/v1/Systems/uuid:1"},
+		Location:        "https://10.24.1.2/EventService/Subscriptions/1",
+		EventHostIP:     "10.24.1.2",
+	}
+	current := Subscription{
+		SubscriptionID:  "2",
+		Destination:     "https://10.24.1.23:8080/destination",
+		Name:            "Event Subscription",
+		EventTypes:      []string{"StatusChange"},
+		OriginResources: []string{"/redfish/v1/Systems/uuid:2"},
+	}
+	for _, sub := range []Subscription{legacy, current} {
+		if err := SaveEventSubscription(sub); err != nil {
+			t.Fatalf("Error while making save event subscriptions: %v\n", err.Error())
+		}
+	}
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	// running the migration again doesn't change the subscriptions
+	for i := 0; i < 2; i++ {
+		if err := removeSubscriptionLocation(conn); err != nil {
+			t.Fatalf("removeSubscriptionLocation() unexpected error = %v", err)
+		}
+	}
+
+	subscriptions, gerr := GetEvtSubscriptions("*")
+	if gerr != nil {
+		t.Fatalf("Error while getting event subscriptions: %v\n", gerr.Error())
+	}
+	if len(subscriptions) != 2 {
+		t.Fatalf("removeSubscriptionLocation() subscriptions = %v, want 2 subscriptions", subscriptions)
+	}
+	legacy.Location = ""
+	legacy.EventHostIP = ""
+	for _, sub := range subscriptions {
+		want := current
+		if sub.SubscriptionID == legacy.SubscriptionID {
+			want = legacy
+		}
+		if !reflect.DeepEqual(sub, want) {
+			t.Errorf("removeSubscriptionLocation() subscription = %v, want %v", sub, want)
+		}
+	}
+}
+
+func TestEscapeMatchPattern(t *testing.T) {
+	if got, want := escapeMatchPattern(`{"a":["*?\"]}`), `{"a":\["\*\?\\"\]}`; got != want {
+		t.Errorf("escapeMatchPattern() = %v, want %v", got, want)
+	}
+}
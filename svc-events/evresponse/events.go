@@ -24,14 +24,25 @@ import (
 // SubscriptionResponse is used to return response to end user
 type SubscriptionResponse struct {
 	response.Response
-	Destination      string       `json:"Destination,omitempty"`
-	Context          string       `json:"Context,omitempty"`
-	Protocol         string       `json:"Protocol,omitempty"`
-	EventTypes       []string     `json:"EventTypes,omitempty"`
-	SubscriptionType string       `json:"SubscriptionType,omitempty"`
-	MessageIds       []string     `json:"MessageIds,omitempty"`
-	ResourceTypes    []string     `json:"ResourceTypes,omitempty"`
-	OriginResources  []ListMember `json:"OriginResources,omitempty"`
+	Destination      string           `json:"Destination,omitempty"`
+	Context          string           `json:"Context,omitempty"`
+	Protocol         string           `json:"Protocol,omitempty"`
+	EventTypes       []string         `json:"EventTypes,omitempty"`
+	SubscriptionType string           `json:"SubscriptionType,omitempty"`
+	MessageIds       []string         `json:"MessageIds,omitempty"`
+	ResourceTypes    []string         `json:"ResourceTypes,omitempty"`
+	OriginResources  []ListMember     `json:"OriginResources,omitempty"`
+	Oem              *SubscriptionOem `json:"Oem,omitempty"`
+}
+
+// SubscriptionOem is the Oem section of an event subscription
+type SubscriptionOem struct {
+	ODIM SubscriptionOdim `json:"ODIM"`
+}
+
+// SubscriptionOdim holds the odimra specific properties of an event subscription
+type SubscriptionOdim struct {
+	TagFilter string `json:"TagFilter,omitempty"`
 }
 
 // ListResponse define list for odimra
@@ -114,8 +125,14 @@ type Action struct {
 	AllowableValues []string `json:"EventType@Redfish.AllowableValues"`
 }
 
-//Oem struct definition placeholder.
+//Oem struct definition for the ODIM specific actions
 type Oem struct {
+	ReplayEvents *OemAction `json:"#ODIM.ReplayEvents,omitempty"`
+}
+
+//OemAction struct definition
+type OemAction struct {
+	Target string `json:"target"`
 }
 
 // MutexLock is a struct for mutex lock and Response and hosts
@@ -25,11 +25,13 @@ import (
 	"github.com/ODIM-Project/ODIM/lib-utilities/services"
 	"github.com/ODIM-Project/ODIM/svc-events/consumer"
 	"github.com/ODIM-Project/ODIM/svc-events/evcommon"
+	"github.com/ODIM-Project/ODIM/svc-events/evmodel"
 	evt "github.com/ODIM-Project/ODIM/svc-events/events"
 	"github.com/ODIM-Project/ODIM/svc-events/rpc"
 )
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
 	// verifying the uid of the user
@@ -49,19 +51,21 @@ func main() {
 		log.Fatal("error while trying to check DB connection health: " + err.Error())
 	}
 
+	if err := common.RunMigrations(services.Events, common.OnDisk, evmodel.Migrations); err != nil {
+		log.Fatal("error while trying to migrate the DB: " + err.Error())
+	}
+
 	if err := services.InitializeService(services.Events); err != nil {
 		log.Fatal("fatal: error while trying to initialize the service: " + err.Error())
 	}
 
 	// Intializing the TopicsList
 	evcommon.EMBTopics.TopicsList = make(map[string]bool)
-	// Intializing plugin token
-	evcommon.Token.Tokens = make(map[string]string)
 	registerHandler()
 
-	// CreateJobQueue defines the queue which will act as an infinite buffer
-	// In channel is an entry or input channel and the Out channel is an exit or output channel
-	consumer.In, consumer.Out = common.CreateJobQueue()
+	// the consumed events are published to the destinations before
+	// the message is acknowledged on the message bus
+	consumer.PublishEvents = evt.PublishEventsToDestination
 
 	configFilePath := os.Getenv("CONFIG_FILE_PATH")
 	if configFilePath == "" {
@@ -71,9 +75,6 @@ func main() {
 	// TrackConfigFileChanges monitors the odim config changes using fsnotfiy
 	go common.TrackConfigFileChanges(configFilePath, eventChan)
 
-	// RunReadWorkers will create a worker pool for doing a specific task
-	// which is passed to it as PublishEventsToDestination method after reading the data from the channel.
-	common.RunReadWorkers(consumer.Out, evt.PublishEventsToDestination, 5)
 	startUPInterface := evcommon.StartUpInteraface{
 		DecryptPassword: common.DecryptWithPrivateKey,
 		EMBConsume:      consumer.Consume,
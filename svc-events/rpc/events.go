@@ -25,6 +25,7 @@ import (
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	eventsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/events"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-events/evcommon"
@@ -70,7 +71,7 @@ func (e *Events) GetEventService(ctx context.Context, req *eventsproto.EventSubR
 	privileges := []string{common.PrivilegeLogin}
 	authResp := e.IsAuthorizedRPC(req.SessionToken, privileges, oemprivileges)
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authenticate session: status code: " + string(authResp.StatusCode) +
+		logs.Log(ctx).Error("error while trying to authenticate session: status code: " + string(authResp.StatusCode) +
 			", status message: " + authResp.StatusMessage)
 		resp.Body = generateResponse(authResp.Body)
 		resp.StatusMessage = authResp.StatusMessage
@@ -110,7 +111,11 @@ func (e *Events) GetEventService(ctx context.Context, req *eventsproto.EventSubR
 					"ResourceRemoved",
 					"Alert"},
 			},
-			Oem: evresponse.Oem{},
+			Oem: evresponse.Oem{
+				ReplayEvents: &evresponse.OemAction{
+					Target: "/redfish/v1/EventService/Actions/Oem/ODIM.ReplayEvents",
+				},
+			},
 		},
 		DeliveryRetryAttempts:        evcommon.DeliveryRetryAttempts,
 		DeliveryRetryIntervalSeconds: evcommon.DeliveryRetryIntervalSeconds,
@@ -173,7 +178,7 @@ func (e *Events) CreateEventSubscription(ctx context.Context, req *eventsproto.E
 	// Athorize the request here
 	authResp := e.IsAuthorizedRPC(req.SessionToken, []string{common.PrivilegeConfigureComponents}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authenticate session: status code: " +
+		logs.Log(ctx).Error("error while trying to authenticate session: status code: " +
 			string(authResp.StatusCode) + ", status message: " + authResp.StatusMessage)
 		resp.Body = generateResponse(authResp.Body)
 		resp.StatusCode = authResp.StatusCode
@@ -184,9 +189,10 @@ func (e *Events) CreateEventSubscription(ctx context.Context, req *eventsproto.E
 		errorMessage := "error while trying to get the session username: " + err.Error()
 		resp.Body = generateResponse(common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage, nil, nil))
 		resp.StatusCode = http.StatusUnauthorized
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return err
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 	// Create the task and get the taskID
 	// Contact Task Service using RPC and get the taskID
 	taskURI, err := e.CreateTaskRPC(sessionUserName)
@@ -199,7 +205,7 @@ func (e *Events) CreateEventSubscription(ctx context.Context, req *eventsproto.E
 		resp.Header = map[string]string{
 			"Content-type": "application/json; charset=utf-8", // TODO: add all error   headers
 		}
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return fmt.Errorf(resp.StatusMessage)
 	}
 	strArray := strings.Split(taskURI, "/")
@@ -209,7 +215,7 @@ func (e *Events) CreateEventSubscription(ctx context.Context, req *eventsproto.E
 		taskID = strArray[len(strArray)-1]
 	}
 	//Spawn the thread to process the action asynchronously
-	go pc.CreateEventSubscription(taskID, sessionUserName, req)
+	go pc.CreateEventSubscription(ctx, taskID, sessionUserName, req)
 	// Return 202 accepted
 	resp.StatusCode = http.StatusAccepted
 	resp.Header = map[string]string{
@@ -232,12 +238,12 @@ func (e *Events) SubmitTestEvent(ctx context.Context, req *eventsproto.EventSubR
 		GetSessionUserName: e.GetSessionUserNameRPC,
 	}
 
-	data := pc.SubmitTestEvent(req)
+	data := pc.SubmitTestEvent(ctx, req)
 	resp.Body, err = json.Marshal(data.Body)
 	if err != nil {
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = "error while trying to marshal the response body for submit test event: " + err.Error()
-		log.Error(resp.StatusMessage)
+		logs.Log(ctx).Error(resp.StatusMessage)
 		return fmt.Errorf(resp.StatusMessage)
 	}
 	resp.StatusCode = data.StatusCode
@@ -257,14 +263,14 @@ func (e *Events) GetEventSubscriptionsCollection(ctx context.Context, req *event
 		Auth:          e.IsAuthorizedRPC,
 	}
 
-	data := pc.GetEventSubscriptionsCollection(req)
+	data := pc.GetEventSubscriptionsCollection(ctx, req)
 	resp.Body, err = json.Marshal(data.Body)
 	if err != nil {
 		errorMessage := "error while trying marshal the response body for get event subsciption : " + err.Error()
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = response.InternalError
 		resp.Body, _ = json.Marshal(common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil).Body)
-		log.Error(resp.StatusMessage)
+		logs.Log(ctx).Error(resp.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = data.StatusCode
@@ -284,14 +290,14 @@ func (e *Events) GetEventSubscription(ctx context.Context, req *eventsproto.Even
 		Auth:          e.IsAuthorizedRPC,
 	}
 
-	data := pc.GetEventSubscriptionsDetails(req)
+	data := pc.GetEventSubscriptionsDetails(ctx, req)
 	resp.Body, err = json.Marshal(data.Body)
 	if err != nil {
 		errorMessage := "error while trying marshal the response body for get event subsciption : " + err.Error()
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = response.InternalError
 		resp.Body, _ = json.Marshal(common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil).Body)
-		log.Error(resp.StatusMessage)
+		logs.Log(ctx).Error(resp.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = data.StatusCode
@@ -313,10 +319,10 @@ func (e *Events) DeleteEventSubscription(ctx context.Context, req *eventsproto.E
 	var data response.RPC
 	if req.UUID == "" {
 		// Delete Event Subscription when admin requested
-		data = pc.DeleteEventSubscriptionsDetails(req)
+		data = pc.DeleteEventSubscriptionsDetails(ctx, req)
 	} else {
 		// Delete Event Subscription to Device when Server get Deleted
-		data = pc.DeleteEventSubscriptions(req)
+		data = pc.DeleteEventSubscriptions(ctx, req)
 	}
 	resp.Body, err = json.Marshal(data.Body)
 	if err != nil {
@@ -324,7 +330,7 @@ func (e *Events) DeleteEventSubscription(ctx context.Context, req *eventsproto.E
 		resp.StatusCode = http.StatusInternalServerError
 		resp.StatusMessage = response.InternalError
 		resp.Body, _ = json.Marshal(common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil).Body)
-		log.Error(resp.StatusMessage)
+		logs.Log(ctx).Error(resp.StatusMessage)
 		return nil
 	}
 	resp.StatusCode = data.StatusCode
@@ -340,14 +346,14 @@ func (e *Events) CreateDefaultEventSubscription(ctx context.Context, req *events
 		ContactClient: e.ContactClientRPC,
 		Auth:          e.IsAuthorizedRPC,
 	}
-	pc.CreateDefaultEventSubscription(req.SystemID, req.EventTypes, req.MessageIDs, req.ResourceTypes, req.Protocol)
+	pc.CreateDefaultEventSubscription(ctx, req.SystemID, req.EventTypes, req.MessageIDs, req.ResourceTypes, req.Protocol)
 	return nil
 }
 
 //SubsribeEMB defines the operations which handles the RPC request response
 // it subscribe to the given event message bus queues
 func (e *Events) SubsribeEMB(ctx context.Context, req *eventsproto.SubscribeEMBRequest, resp *eventsproto.SubscribeEMBResponse) error {
-	log.Info("Subscribing on emb for plugin " + req.PluginID)
+	logs.Log(ctx).Info("Subscribing on emb for plugin " + req.PluginID)
 	for i := 0; i < len(req.EMBQueueName); i++ {
 		evcommon.EMBTopics.ConsumeTopic(req.EMBQueueName[i])
 	}
@@ -355,6 +361,31 @@ func (e *Events) SubsribeEMB(ctx context.Context, req *eventsproto.SubscribeEMBR
 	return nil
 }
 
+// ReplayEvents defines the operations which handles the RPC request response
+// for the replay events RPC call to events micro service.
+// The functionality is to publish again the events consumed since the given time.
+func (e *Events) ReplayEvents(ctx context.Context, req *eventsproto.EventSubRequest, resp *eventsproto.EventSubResponse) error {
+	var err error
+	pc := events.PluginContact{
+		Auth:         e.IsAuthorizedRPC,
+		ReplayTopics: evcommon.EMBTopics.ReplayTopics,
+	}
+
+	data := pc.ReplayEvents(ctx, req)
+	resp.Body, err = json.Marshal(data.Body)
+	if err != nil {
+		resp.StatusCode = http.StatusInternalServerError
+		resp.StatusMessage = "error while trying to marshal the response body for replay events: " + err.Error()
+		logs.Log(ctx).Error(resp.StatusMessage)
+		return fmt.Errorf(resp.StatusMessage)
+	}
+	resp.StatusCode = data.StatusCode
+	resp.StatusMessage = data.StatusMessage
+	resp.Header = data.Header
+
+	return nil
+}
+
 func generateTaskRespone(taskID, taskURI string, resp *eventsproto.EventSubResponse) {
 	commonResponse := response.Response{
 		OdataType:    "#Task.v1_4_2.Task",
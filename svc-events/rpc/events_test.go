@@ -451,3 +451,23 @@ func TestSubscribeEMB(t *testing.T) {
 	assert.Nil(t, err, "There should be no error")
 	assert.True(t, resp.Status, "status should be true")
 }
+
+func TestReplayEvents(t *testing.T) {
+	config.SetUpMockConfig(t)
+	var ctx context.Context
+	events := new(Events)
+	events.IsAuthorizedRPC = mockIsAuthorized
+	req := &eventsproto.EventSubRequest{
+		SessionToken: "validToken",
+		PostBody:     []byte(`{"StartTime":"2020-11-02T10:00:00Z"}`),
+	}
+	var resp = &eventsproto.EventSubResponse{}
+	err := events.ReplayEvents(ctx, req, resp)
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+
+	req.PostBody = []byte(`{"StartTime":"invalid"}`)
+	err = events.ReplayEvents(ctx, req, resp)
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be StatusBadRequest.")
+}
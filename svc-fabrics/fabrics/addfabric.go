@@ -16,12 +16,13 @@
 package fabrics
 
 import (
+	"context"
 	"fmt"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	fabricsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/fabrics"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-fabrics/fabmodel"
-	log "github.com/sirupsen/logrus"
 	"net"
 	"net/http"
 	"strings"
@@ -29,7 +30,7 @@ import (
 
 //AddFabric holds the logic for Adding fabric
 //It accepts post body and store the fabric details in DB
-func AddFabric(req *fabricsproto.AddFabricRequest) response.RPC {
+func AddFabric(ctx context.Context, req *fabricsproto.AddFabricRequest) response.RPC {
 	var resp response.RPC
 	origin := req.OriginResource
 	address := req.Address
@@ -37,7 +38,7 @@ func AddFabric(req *fabricsproto.AddFabricRequest) response.RPC {
 
 	pluginDetails, err := fabmodel.GetAllFabricPluginDetails()
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(),
 			[]interface{}{}, nil)
 	}
@@ -46,7 +47,7 @@ func AddFabric(req *fabricsproto.AddFabricRequest) response.RPC {
 
 		plugin, errs := fabmodel.GetPluginData(pluginkey)
 		if errs != nil {
-			log.Error(errs.Error())
+			logs.Log(ctx).Error(errs.Error())
 			return common.GeneralError(http.StatusInternalServerError, response.InternalError, errs.Error(),
 				[]interface{}{}, nil)
 		}
@@ -58,7 +59,7 @@ func AddFabric(req *fabricsproto.AddFabricRequest) response.RPC {
 			if err != nil {
 				errorMessage = "Can't lookup the ip from host name" + err.Error()
 			}
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errs.Error(),
 				[]interface{}{"IP Address", plugin.IP}, nil)
 		}
@@ -74,10 +75,11 @@ func AddFabric(req *fabricsproto.AddFabricRequest) response.RPC {
 		}
 	}
 	if pluginID == "" {
-		log.Error("error: plugin ID is empty")
+		logs.Log(ctx).Error("error: plugin ID is empty")
 		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, "error: no match found for plugin ID",
 			[]interface{}{"IP Address", address}, nil)
 	}
+	ctx = logs.With(ctx, logs.PluginIDField, pluginID)
 	fab := fabmodel.Fabric{
 		FabricUUID: uuid,
 		PluginID:   pluginID,
@@ -85,7 +87,7 @@ func AddFabric(req *fabricsproto.AddFabricRequest) response.RPC {
 
 	err = fab.AddFabricData(uuid)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(),
 			[]interface{}{}, nil)
 	}
@@ -97,7 +99,7 @@ func AddFabric(req *fabricsproto.AddFabricRequest) response.RPC {
 		"Transfer-Encoding": "chunked",
 		"OData-Version":     "4.0",
 	}
-	log.Info("Fabric Added")
+	logs.Log(ctx).Info("Fabric Added")
 	resp.StatusCode = http.StatusOK
 	resp.StatusMessage = response.Success
 	return resp
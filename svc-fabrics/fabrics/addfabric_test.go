@@ -16,6 +16,7 @@
 package fabrics
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -44,10 +45,10 @@ func TestAddFabric(t *testing.T) {
 		Address:        "10.10.10.10",
 	}
 
-	resp := AddFabric(req)
+	resp := AddFabric(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusOK, "should be same")
 
-	resp = AddFabric(req)
+	resp = AddFabric(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusInternalServerError, "should be same")
 }
 
@@ -68,10 +69,10 @@ func TestAddFabricInvalidPluginID(t *testing.T) {
 		Address:        "10.10.10.10",
 	}
 
-	resp := AddFabric(req)
+	resp := AddFabric(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusOK, "should be same")
 
-	resp = AddFabric(req)
+	resp = AddFabric(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusInternalServerError, "should be same")
 }
 
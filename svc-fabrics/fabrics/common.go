@@ -16,20 +16,20 @@
 package fabrics
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
 	fabricsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/fabrics"
 	"github.com/ODIM-Project/ODIM/svc-fabrics/fabresponse"
-	log "github.com/sirupsen/logrus"
-	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
-	"sync"
 
+	"github.com/ODIM-Project/ODIM/lib-rest-client/pluginclient"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-fabrics/fabmodel"
 )
@@ -41,13 +41,11 @@ type Fabrics struct {
 }
 
 type pluginContactRequest struct {
-	URL             string
-	HTTPMethodType  string
-	ContactClient   func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
-	PostBody        interface{}
-	LoginCredential map[string]string
-	Plugin          fabmodel.Plugin
-	Token           string
+	URL            string
+	HTTPMethodType string
+	ContactClient  func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
+	PostBody       interface{}
+	Plugin         fabmodel.Plugin
 }
 type responseStatus struct {
 	StatusCode    int32
@@ -55,12 +53,6 @@ type responseStatus struct {
 	Location      string
 }
 
-// PluginToken interface to hold the token
-type PluginToken struct {
-	Tokens map[string]string
-	lock   sync.Mutex
-}
-
 // Zones struct to check request body cases
 type Zones struct {
 	Name     string     `json:"Name"`
@@ -82,73 +74,41 @@ type Redundancy struct {
 	RedundencySet []dmtf.Link `json:"RedundencySet"`
 }
 
-// Token variable hold the all the XAuthToken  against the plguin ID
-var Token PluginToken
-
-func (p *PluginToken) storeToken(plguinID, token string) {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-	p.Tokens[plguinID] = token
-}
-
-func (p *PluginToken) getToken(pluginID string) string {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-	return p.Tokens[pluginID]
-}
-
-func contactPlugin(req pluginContactRequest, errorMessage string) ([]byte, string, responseStatus, error) {
+// contactPlugin sends the request to the plugin with the session token or the basic authentication
+// of the plugin. The error response of the plugin is returned as a Redfish error response.
+func contactPlugin(ctx context.Context, req pluginContactRequest, errorMessage string) ([]byte, string, responseStatus, error) {
 	var resp responseStatus
-
-	pluginResponse, err := callPlugin(req)
+	pluginResponse, err := pluginclient.Do(pluginclient.Request{
+		Plugin: pluginclient.Plugin{
+			ID:                req.Plugin.ID,
+			IP:                req.Plugin.IP,
+			Port:              req.Plugin.Port,
+			Username:          req.Plugin.Username,
+			Password:          req.Plugin.Password,
+			PreferredAuthType: req.Plugin.PreferredAuthType,
+		},
+		Method:        req.HTTPMethodType,
+		URI:           req.URL,
+		Body:          req.PostBody,
+		ContactClient: req.ContactClient,
+		StatusCheck: func() bool {
+			return getPluginStatus(ctx, req.Plugin)
+		},
+	})
 	if err != nil {
-		if getPluginStatus(req.Plugin) {
-			pluginResponse, err = callPlugin(req)
-		}
-		if err != nil {
-			errorMessage = errorMessage + err.Error()
-			resp.StatusCode = http.StatusInternalServerError
-			resp.StatusMessage = response.InternalError
-			log.Error(errorMessage)
-			return nil, "", resp, fmt.Errorf(errorMessage)
-		}
-	}
-	defer pluginResponse.Body.Close()
-	if !(pluginResponse.StatusCode == http.StatusCreated || pluginResponse.StatusCode == http.StatusOK) {
-		body, err := ioutil.ReadAll(pluginResponse.Body)
-		if err != nil {
-			errorMessage := "error while trying to read response body: " + err.Error()
-			resp.StatusCode = http.StatusInternalServerError
-			resp.StatusMessage = response.InternalError
-			log.Error(errorMessage)
-			return nil, "", resp, fmt.Errorf(errorMessage)
-		}
-		resp.StatusCode = int32(pluginResponse.StatusCode)
-		log.Info("Read response successfully")
-		return body, "", resp, fmt.Errorf("Read response successfully")
+		errorMessage = errorMessage + err.Error()
+		resp.StatusCode = err.StatusCode
+		resp.StatusMessage = err.StatusMessage
+		logs.Log(ctx).Error(errorMessage)
+		return err.ResponseBody(), "", resp, fmt.Errorf(errorMessage)
 	}
-	body, err := ioutil.ReadAll(pluginResponse.Body)
 	resp.StatusCode = int32(pluginResponse.StatusCode)
-	if err != nil {
-		errorMessage := "error while trying to read response body: " + err.Error()
-		resp.StatusCode = http.StatusInternalServerError
-		resp.StatusMessage = response.InternalError
-		return nil, "", resp, fmt.Errorf(errorMessage)
-	}
 	resp.Location = pluginResponse.Header.Get("Location")
-	return body, pluginResponse.Header.Get("X-Auth-Token"), resp, nil
-}
-
-func callPlugin(req pluginContactRequest) (*http.Response, error) {
-	var reqURL = "https://" + req.Plugin.IP + ":" + req.Plugin.Port + req.URL
-	if strings.EqualFold(req.Plugin.PreferredAuthType, "BasicAuth") {
-		req.ContactClient(reqURL, req.HTTPMethodType, "", "", req.PostBody, req.LoginCredential)
-	}
-	return req.ContactClient(reqURL, req.HTTPMethodType, req.Token, "", req.PostBody, nil)
+	return pluginResponse.Body, pluginResponse.Header.Get("X-Auth-Token"), resp, nil
 }
 
 // getPluginStatus checks the status of given plugin in configured interval
-func getPluginStatus(plugin fabmodel.Plugin) bool {
+func getPluginStatus(ctx context.Context, plugin fabmodel.Plugin) bool {
 	var pluginStatus = common.PluginStatus{
 		Method: http.MethodGet,
 		RequestBody: common.StatusRequest{
@@ -163,69 +123,21 @@ func getPluginStatus(plugin fabmodel.Plugin) bool {
 	}
 	status, _, _, err := pluginStatus.CheckStatus()
 	if err != nil && !status {
-		log.Error("Error While getting the status for plugin " + plugin.ID + err.Error())
+		logs.Log(ctx).Error("Error While getting the status for plugin " + plugin.ID + err.Error())
 		return status
 	}
-	log.Info("Status of plugin" + plugin.ID + strconv.FormatBool(status))
+	logs.Log(ctx).Info("Status of plugin" + plugin.ID + strconv.FormatBool(status))
 	return status
 }
 
-// getPluginToken will verify the if any token present to the plugin else it will create token for the new plugin
-func (f *Fabrics) getPluginToken(plugin fabmodel.Plugin) string {
-	authToken := Token.getToken(plugin.ID)
-	if authToken == "" {
-		return f.createToken(plugin)
-	}
-	return authToken
-}
-
-func (f *Fabrics) createToken(plugin fabmodel.Plugin) string {
-	var contactRequest pluginContactRequest
-
-	contactRequest.ContactClient = f.ContactClient
-	contactRequest.Plugin = plugin
-	contactRequest.HTTPMethodType = http.MethodPost
-	contactRequest.PostBody = map[string]interface{}{
-		"Username": plugin.Username,
-		"Password": string(plugin.Password),
-	}
-	contactRequest.URL = "/ODIM/v1/Sessions"
-	_, token, _, err := contactPlugin(contactRequest, "error while logging in to plugin: ")
-	if err != nil {
-		log.Error(err.Error())
-	}
-	if token != "" {
-		Token.storeToken(plugin.ID, token)
-	}
-	return token
-}
-
-// retryFabricsOperation will be called whenever  the unauthorized status code during the plugin call
-// This function will create a new session token reexcutes the plugin call
-func (f *Fabrics) retryFabricsOperation(req pluginContactRequest, errorMessage string) ([]byte, string, responseStatus, error) {
-	var resp response.RPC
-	var token = f.createToken(req.Plugin)
-	if token == "" {
-		resp = common.GeneralError(http.StatusUnauthorized, response.NoValidSession, "error: Unable to create session with plugin "+req.Plugin.ID,
-			[]interface{}{}, nil)
-		data, _ := json.Marshal(resp.Body)
-		return data, "", responseStatus{
-			StatusCode: resp.StatusCode,
-		}, fmt.Errorf("error: Unable to create session with plugin")
-	}
-	req.Token = token
-	return contactPlugin(req, errorMessage)
-
-}
-
-func (f *Fabrics) parseFabricsRequest(req *fabricsproto.FabricRequest) (pluginContactRequest, response.RPC, error) {
+func (f *Fabrics) parseFabricsRequest(ctx context.Context, req *fabricsproto.FabricRequest) (pluginContactRequest, response.RPC, error) {
 	var contactRequest pluginContactRequest
 	var resp response.RPC
 	sessionToken := req.SessionToken
 	authResp := f.Auth(sessionToken, []string{common.PrivilegeConfigureComponents}, []string{})
 	if authResp.StatusCode != http.StatusOK {
 		errMsg := "error while trying to authenticate session"
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return contactRequest, authResp, fmt.Errorf(errMsg)
 	}
 
@@ -233,13 +145,13 @@ func (f *Fabrics) parseFabricsRequest(req *fabricsproto.FabricRequest) (pluginCo
 		resp = getFabricCollection()
 		return contactRequest, resp, nil
 	}
-	log.Info("Request url" + req.URL)
+	logs.Log(ctx).Info("Request url" + req.URL)
 	fabID := getFabricID(req.URL)
-	log.Info("Fabric UUID" + fabID)
+	logs.Log(ctx).Info("Fabric UUID" + fabID)
 	fabric, err := fabmodel.GetManagingPluginIDForFabricID(fabID)
 	if err != nil {
 		errMsg := fmt.Sprintf("error while trying to get fabric Data: %v", err.Error())
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		resp = common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errMsg,
 			[]interface{}{"Plugin", "Fabric"}, nil)
 		return contactRequest, resp, err
@@ -248,7 +160,7 @@ func (f *Fabrics) parseFabricsRequest(req *fabricsproto.FabricRequest) (pluginCo
 	plugin, errs := fabmodel.GetPluginData(fabric.PluginID)
 	if errs != nil {
 		errMsg := fmt.Sprintf("error while trying to get plugin Data: %v", errs.Error())
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		resp = common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errMsg,
 			[]interface{}{"Plugin", "Fabric"}, nil)
 		return contactRequest, resp, errs
@@ -256,27 +168,10 @@ func (f *Fabrics) parseFabricsRequest(req *fabricsproto.FabricRequest) (pluginCo
 
 	contactRequest.ContactClient = f.ContactClient
 	contactRequest.Plugin = plugin
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		token := f.getPluginToken(plugin)
-		if token == "" {
-			var errorMessage = "error: Unable to create session with plugin " + plugin.ID
-			log.Error(errorMessage)
-			resp = common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage,
-				[]interface{}{}, nil)
-			return contactRequest, resp, fmt.Errorf(errorMessage)
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.LoginCredential = map[string]string{
-			"Username": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 
 	// Validating Post/Patch request properties are in uppercamelcase or not
 	if strings.EqualFold(req.Method, "POST") || strings.EqualFold(req.Method, "PATCH") {
-		valResp, err := validateReqParamsCase(req)
+		valResp, err := validateReqParamsCase(ctx, req)
 		if err != nil {
 			return contactRequest, valResp, err
 		}
@@ -295,7 +190,7 @@ func (f *Fabrics) parseFabricsRequest(req *fabricsproto.FabricRequest) (pluginCo
 	if !(req.Method == http.MethodGet || req.Method == http.MethodDelete) {
 		err := json.Unmarshal([]byte(reqData), &contactRequest.PostBody)
 		if err != nil {
-			log.Error("error while trying to get JSON request body: " + err.Error())
+			logs.Log(ctx).Error("error while trying to get JSON request body: " + err.Error())
 			resp = common.GeneralError(http.StatusBadRequest, response.MalformedJSON,
 				"error while trying to get JSON request body: "+err.Error(),
 				[]interface{}{}, nil)
@@ -305,41 +200,28 @@ func (f *Fabrics) parseFabricsRequest(req *fabricsproto.FabricRequest) (pluginCo
 	return contactRequest, resp, nil
 }
 
-func (f *Fabrics) parseFabricsResponse(pluginRequest pluginContactRequest, reqURI string) response.RPC {
+func (f *Fabrics) parseFabricsResponse(ctx context.Context, pluginRequest pluginContactRequest, reqURI string) response.RPC {
 	var resp response.RPC
 	var errorMessage = fmt.Sprintf("error while performing %s operation on %s: ", pluginRequest.HTTPMethodType, reqURI)
 	var header = map[string]string{"Content-type": "application/json; charset=utf-8"}
+	ctx = logs.With(ctx, logs.PluginIDField, pluginRequest.Plugin.ID)
 	//contactPlugin
-	body, _, getResponse, err := contactPlugin(pluginRequest, errorMessage)
+	body, _, getResponse, err := contactPlugin(ctx, pluginRequest, errorMessage)
 	if err != nil {
-		if getResponse.StatusCode == http.StatusUnauthorized && strings.EqualFold(pluginRequest.Plugin.PreferredAuthType, "XAuthToken") {
-			if body, _, getResponse, err = f.retryFabricsOperation(pluginRequest, errorMessage); err != nil {
-				data := string(body)
-				//replacing the resposne with north bound translation URL
-				for key, value := range config.Data.URLTranslation.NorthBoundURL {
-					data = strings.Replace(data, key, value, -1)
-				}
-				resp.StatusCode = getResponse.StatusCode
-				json.Unmarshal([]byte(data), &resp.Body)
-				resp.Header = header
-				return resp
-			}
-		} else {
-			data := string(body)
-			//replacing the resposne with north bound translation URL
-			for key, value := range config.Data.URLTranslation.NorthBoundURL {
-				data = strings.Replace(data, key, value, -1)
-			}
-			resp.StatusCode = getResponse.StatusCode
-			json.Unmarshal([]byte(data), &resp.Body)
-			resp.Header = header
-			return resp
+		data := string(body)
+		//replacing the resposne with north bound translation URL
+		for key, value := range config.Data.URLTranslation.NorthBoundURL {
+			data = strings.Replace(data, key, value, -1)
 		}
+		resp.StatusCode = getResponse.StatusCode
+		json.Unmarshal([]byte(data), &resp.Body)
+		resp.Header = header
+		return resp
 	}
-	return fillResponse(body, getResponse.Location, pluginRequest.HTTPMethodType, getResponse.StatusCode)
+	return fillResponse(ctx, body, getResponse.Location, pluginRequest.HTTPMethodType, getResponse.StatusCode)
 }
 
-func fillResponse(body []byte, location string, method string, statusCode int32) response.RPC {
+func fillResponse(ctx context.Context, body []byte, location string, method string, statusCode int32) response.RPC {
 	var resp response.RPC
 	data := string(body)
 	//replacing the resposne with north bound translation URL
@@ -351,7 +233,7 @@ func fillResponse(body []byte, location string, method string, statusCode int32)
 		var respData map[string]interface{}
 		err := json.Unmarshal([]byte(data), &respData)
 		if err != nil {
-			log.Printf(err.Error())
+			logs.Log(ctx).Error("error while trying to unmarshal the response of the plugin: " + err.Error())
 			return common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(),
 				[]interface{}{}, nil)
 		}
@@ -418,7 +300,7 @@ func getFabricCollection() response.RPC {
 }
 
 // Validating if request properties are in uppercamelcase or not
-func validateReqParamsCase(req *fabricsproto.FabricRequest) (response.RPC, error) {
+func validateReqParamsCase(ctx context.Context, req *fabricsproto.FabricRequest) (response.RPC, error) {
 	var resp response.RPC
 	var fabricRequest interface{}
 	//Checking the request type, whether it is Zones,AddressPool or Endpoints request
@@ -434,7 +316,7 @@ func validateReqParamsCase(req *fabricsproto.FabricRequest) (response.RPC, error
 	err := json.Unmarshal(req.RequestBody, &fabricRequest)
 	if err != nil {
 		errMsg := "unable to parse the fabrics request" + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), fmt.Errorf(errMsg)
 	}
 
@@ -442,11 +324,11 @@ func validateReqParamsCase(req *fabricsproto.FabricRequest) (response.RPC, error
 	invalidProperties, err := common.RequestParamsCaseValidator(req.RequestBody, fabricRequest)
 	if err != nil {
 		errMsg := "error while validating request parameters: " + err.Error()
-		log.Error(errMsg)
+		logs.Log(ctx).Error(errMsg)
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), fmt.Errorf(errMsg)
 	} else if invalidProperties != "" {
 		errorMessage := "error: one or more properties given in the request body are not valid, ensure properties are listed in uppercamelcase "
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		response := common.GeneralError(http.StatusBadRequest, response.PropertyUnknown, errorMessage, []interface{}{invalidProperties}, nil)
 		return response, fmt.Errorf(errorMessage)
 	}
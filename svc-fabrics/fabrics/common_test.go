@@ -16,6 +16,7 @@
 package fabrics
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
@@ -43,8 +44,6 @@ func mockFabricData(fabricID, pluginID string) error {
 }
 
 func TestFabrics_WithInvalidPluginData(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-	Token.Tokens["GRF"] = "234556"
 	common.SetUpMockConfig()
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -74,7 +73,7 @@ func TestFabrics_WithInvalidPluginData(t *testing.T) {
 		URL:          "/redfish/v1/Fabrics/fabid1/Zones/Zone1",
 		RequestBody:  postData,
 	}
-	resp := f.UpdateFabricResource(req)
+	resp := f.UpdateFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusNotFound, "should be same")
 
 	req = &fabricsproto.FabricRequest{
@@ -83,7 +82,7 @@ func TestFabrics_WithInvalidPluginData(t *testing.T) {
 		URL:          "/redfish/v1/Fabrics/fabid1/Zones/Zone1",
 	}
 
-	resp = f.DeleteFabricResource(req)
+	resp = f.DeleteFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusNotFound, "should be same")
 
 	req = &fabricsproto.FabricRequest{
@@ -91,13 +90,11 @@ func TestFabrics_WithInvalidPluginData(t *testing.T) {
 		SessionToken: "valid",
 		URL:          "/redfish/v1/Fabrics/fabid1/Zones/Zone1",
 	}
-	resp = f.GetFabricResource(req)
+	resp = f.GetFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusNotFound, "should be same")
 }
 
 func TestFabrics_WithInvalidURI(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-	Token.Tokens["CFM"] = "123456"
 	common.SetUpMockConfig()
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -126,7 +123,7 @@ func TestFabrics_WithInvalidURI(t *testing.T) {
 		RequestBody:  postData,
 		Method:       http.MethodPost,
 	}
-	resp := f.UpdateFabricResource(req)
+	resp := f.UpdateFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusNotFound, "should be same")
 
 	req = &fabricsproto.FabricRequest{
@@ -135,7 +132,7 @@ func TestFabrics_WithInvalidURI(t *testing.T) {
 		Method:       http.MethodDelete,
 	}
 
-	resp = f.DeleteFabricResource(req)
+	resp = f.DeleteFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusNotFound, "should be same")
 
 	req = &fabricsproto.FabricRequest{
@@ -144,13 +141,11 @@ func TestFabrics_WithInvalidURI(t *testing.T) {
 		Method:       http.MethodGet,
 	}
 
-	resp = f.GetFabricResource(req)
+	resp = f.GetFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusNotFound, "should be same")
 }
 
 func TestFabrics_WithInvaliPluginCredentials(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-	Token.Tokens["CFM"] = ""
 	common.SetUpMockConfig()
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -179,7 +174,7 @@ func TestFabrics_WithInvaliPluginCredentials(t *testing.T) {
 		RequestBody:  postData,
 		Method:       http.MethodPost,
 	}
-	resp := f.UpdateFabricResource(req)
+	resp := f.UpdateFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusUnauthorized, "should be same")
 
 	req = &fabricsproto.FabricRequest{
@@ -188,7 +183,7 @@ func TestFabrics_WithInvaliPluginCredentials(t *testing.T) {
 		Method:       http.MethodDelete,
 	}
 
-	resp = f.DeleteFabricResource(req)
+	resp = f.DeleteFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusUnauthorized, "should be same")
 
 	req = &fabricsproto.FabricRequest{
@@ -197,12 +192,11 @@ func TestFabrics_WithInvaliPluginCredentials(t *testing.T) {
 		Method:       http.MethodGet,
 	}
 
-	resp = f.GetFabricResource(req)
+	resp = f.GetFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusUnauthorized, "should be same")
 }
 
 func TestFabrics_WithBasicAuth(t *testing.T) {
-	Token.Tokens = make(map[string]string)
 
 	common.SetUpMockConfig()
 	defer func() {
@@ -231,13 +225,11 @@ func TestFabrics_WithBasicAuth(t *testing.T) {
 		Method:       http.MethodGet,
 	}
 
-	resp := f.GetFabricResource(req)
+	resp := f.GetFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusOK, "should be same")
 }
 
 func TestFabrics_WithInvalidData(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-	Token.Tokens["CFM"] = "234556"
 
 	common.SetUpMockConfig()
 	defer func() {
@@ -269,7 +261,7 @@ func TestFabrics_WithInvalidData(t *testing.T) {
 		Method:       "POST",
 	}
 
-	resp := f.UpdateFabricResource(req)
+	resp := f.UpdateFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusInternalServerError, "should be same")
 }
 
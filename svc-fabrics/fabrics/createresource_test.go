@@ -16,6 +16,7 @@
 package fabrics
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	fabricsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/fabrics"
@@ -34,7 +35,6 @@ func mockAuth(sessionToken string, privileges []string, oemPrivileges []string)
 	return common.GeneralError(http.StatusForbidden, response.InsufficientPrivilege, "error while trying to authenticate session", nil, nil)
 }
 func TestFabrics_UpdateFabricResource(t *testing.T) {
-	Token.Tokens = make(map[string]string)
 	common.SetUpMockConfig()
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -153,16 +153,14 @@ func TestFabrics_UpdateFabricResource(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.f.UpdateFabricResource(tt.args.req); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Fabrics.UpdateFabricResource() = %v, want %v", got, tt.want)
+			if got := tt.f.UpdateFabricResource(context.Background(), tt.args.req); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fabrics.UpdateFabricResource(context.Background(), ) = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
 func TestFabrics_UpdateFabricResourceWithNoValidSession(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-	Token.Tokens["CFM"] = "234556"
 	common.SetUpMockConfig()
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -224,8 +222,8 @@ func TestFabrics_UpdateFabricResourceWithNoValidSession(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.f.UpdateFabricResource(tt.args.req); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Fabrics.GetFabricResource() = %v, want %v", got, tt.want)
+			if got := tt.f.UpdateFabricResource(context.Background(), tt.args.req); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fabrics.GetFabricResource(context.Background(), ) = %v, want %v", got, tt.want)
 			}
 		})
 	}
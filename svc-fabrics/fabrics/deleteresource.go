@@ -16,6 +16,8 @@
 package fabrics
 
 import (
+	"context"
+
 	fabricsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/fabrics"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"net/http"
@@ -24,14 +26,14 @@ import (
 //DeleteFabricResource holds the logic for deleting specfic fabric resource
 // It accepts url and contacts the configured CFM plugin
 // and deletes the metioned fabric resoure such as Endpoints,Ports
-func (f *Fabrics) DeleteFabricResource(req *fabricsproto.FabricRequest) response.RPC {
+func (f *Fabrics) DeleteFabricResource(ctx context.Context, req *fabricsproto.FabricRequest) response.RPC {
 	var resp response.RPC
 	var contactRequest pluginContactRequest
 	req.Method = http.MethodDelete
 	var err error
-	contactRequest, resp, err = f.parseFabricsRequest(req)
+	contactRequest, resp, err = f.parseFabricsRequest(ctx, req)
 	if err != nil {
 		return resp
 	}
-	return f.parseFabricsResponse(contactRequest, req.URL)
+	return f.parseFabricsResponse(ctx, contactRequest, req.URL)
 }
@@ -27,6 +27,7 @@
 package fabrics
 
 import (
+	"context"
 	"net/http"
 	"reflect"
 	"testing"
@@ -37,7 +38,6 @@ import (
 )
 
 func TestFabrics_DeleteFabricResource(t *testing.T) {
-	Token.Tokens = make(map[string]string)
 	common.SetUpMockConfig()
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -149,16 +149,14 @@ func TestFabrics_DeleteFabricResource(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.f.DeleteFabricResource(tt.args.req); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Fabrics.DeleteFabricResource() = %v, want %v", got, tt.want)
+			if got := tt.f.DeleteFabricResource(context.Background(), tt.args.req); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fabrics.DeleteFabricResource(context.Background(), ) = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
 func TestFabrics_DeleteFabricResourceWithNoValidSession(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-	Token.Tokens["CFM"] = "234556"
 	common.SetUpMockConfig()
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -213,8 +211,8 @@ func TestFabrics_DeleteFabricResourceWithNoValidSession(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.f.DeleteFabricResource(tt.args.req); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Fabrics.GetFabricResource() = %v, want %v", got, tt.want)
+			if got := tt.f.DeleteFabricResource(context.Background(), tt.args.req); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fabrics.GetFabricResource(context.Background(), ) = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -16,6 +16,8 @@
 package fabrics
 
 import (
+	"context"
+
 	fabricsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/fabrics"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"net/http"
@@ -24,17 +26,17 @@ import (
 //GetFabricResource holds the logic for getting  specfic fabric resource
 //It accepts url and contacts the configured CFM plugin
 // and gets the metioned fabric resoure such as Endpoints,Ports
-func (f *Fabrics) GetFabricResource(req *fabricsproto.FabricRequest) response.RPC {
+func (f *Fabrics) GetFabricResource(ctx context.Context, req *fabricsproto.FabricRequest) response.RPC {
 	var resp response.RPC
 	var contactRequest pluginContactRequest
 	var err error
 	req.Method = http.MethodGet
-	contactRequest, resp, err = f.parseFabricsRequest(req)
+	contactRequest, resp, err = f.parseFabricsRequest(ctx, req)
 	if err != nil {
 		return resp
 	}
 	if req.URL == "/redfish/v1/Fabrics" {
 		return resp
 	}
-	return f.parseFabricsResponse(contactRequest, req.URL)
+	return f.parseFabricsResponse(ctx, contactRequest, req.URL)
 }
@@ -28,6 +28,7 @@ package fabrics
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"gotest.tools/assert"
 	"io/ioutil"
@@ -139,7 +140,6 @@ func mockContactClient(url, method, token string, odataID string, body interface
 	return nil, fmt.Errorf("InvalidRequest")
 }
 func TestFabrics_GetFabricResource(t *testing.T) {
-	Token.Tokens = make(map[string]string)
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -254,16 +254,14 @@ func TestFabrics_GetFabricResource(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.f.GetFabricResource(tt.args.req); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Fabrics.GetFabricResource() = %v, want %v", got, tt.want)
+			if got := tt.f.GetFabricResource(context.Background(), tt.args.req); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fabrics.GetFabricResource(context.Background(), ) = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
 func TestFabrics_GetFabricResourceWithNoValidSession(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-	Token.Tokens["CFM"] = "234556"
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -320,16 +318,14 @@ func TestFabrics_GetFabricResourceWithNoValidSession(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.f.GetFabricResource(tt.args.req); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Fabrics.GetFabricResource() = %v, want %v", got, tt.want)
+			if got := tt.f.GetFabricResource(context.Background(), tt.args.req); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fabrics.GetFabricResource(context.Background(), ) = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
 func TestFabricsCollection_WithInvalidPlugin(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-	Token.Tokens["CFM"] = "234556"
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -353,7 +349,7 @@ func TestFabricsCollection_WithInvalidPlugin(t *testing.T) {
 		Method:       http.MethodGet,
 	}
 
-	resp := f.GetFabricResource(req)
+	resp := f.GetFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusOK, "should be same")
 }
 
@@ -377,7 +373,7 @@ func TestFabricsCollection_emptyCollection(t *testing.T) {
 		Method:       http.MethodGet,
 	}
 
-	resp := f.GetFabricResource(req)
+	resp := f.GetFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusOK, "StatusCode should be statusok")
 	response := resp.Body.(fabresponse.FabricCollection)
 	assert.Equal(t, response.MembersCount, 0, "MembersCount should be 0")
@@ -408,7 +404,7 @@ func TestFabricsCollection_Collection(t *testing.T) {
 		Method:       http.MethodGet,
 	}
 
-	resp := f.GetFabricResource(req)
+	resp := f.GetFabricResource(context.Background(), req)
 	assert.Equal(t, int(resp.StatusCode), http.StatusOK, "StatusCode should be statusok")
 	response := resp.Body.(fabresponse.FabricCollection)
 	assert.Equal(t, response.MembersCount, 1, "MembersCount should be 0")
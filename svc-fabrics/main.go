@@ -22,11 +22,11 @@ import (
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	fabricsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/fabrics"
 	"github.com/ODIM-Project/ODIM/lib-utilities/services"
-	"github.com/ODIM-Project/ODIM/svc-fabrics/fabrics"
 	"github.com/ODIM-Project/ODIM/svc-fabrics/rpc"
 )
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
 
@@ -46,7 +46,6 @@ func main() {
 	if err := services.InitializeService(services.Fabrics); err != nil {
 		log.Fatal("fatal: error while trying to initialize service: %v" + err.Error())
 	}
-	fabrics.Token.Tokens = make(map[string]string)
 
 	configFilePath := os.Getenv("CONFIG_FILE_PATH")
 	if configFilePath == "" {
@@ -40,7 +40,7 @@ func (f *Fabrics) GetFabricResource(ctx context.Context, req *fabricsproto.Fabri
 		Auth:          f.IsAuthorizedRPC,
 		ContactClient: f.ContactClientRPC,
 	}
-	data := fab.GetFabricResource(req)
+	data := fab.GetFabricResource(ctx, req)
 	resp.Header = data.Header
 	resp.StatusCode = data.StatusCode
 	resp.StatusMessage = data.StatusMessage
@@ -55,7 +55,7 @@ func (f *Fabrics) UpdateFabricResource(ctx context.Context, req *fabricsproto.Fa
 		Auth:          f.IsAuthorizedRPC,
 		ContactClient: f.ContactClientRPC,
 	}
-	data := fab.UpdateFabricResource(req)
+	data := fab.UpdateFabricResource(ctx, req)
 	resp.Header = data.Header
 	resp.StatusCode = data.StatusCode
 	resp.Body = generateResponse(data.Body)
@@ -68,7 +68,7 @@ func (f *Fabrics) UpdateFabricResource(ctx context.Context, req *fabricsproto.Fa
 // AddFabric defines  the operation which handles the RPC request response for Add fabric
 func (f *Fabrics) AddFabric(ctx context.Context, req *fabricsproto.AddFabricRequest, resp *fabricsproto.FabricResponse) error {
 
-	data := fabrics.AddFabric(req)
+	data := fabrics.AddFabric(ctx, req)
 	resp.Header = data.Header
 	resp.StatusCode = data.StatusCode
 	resp.StatusMessage = data.StatusMessage
@@ -85,7 +85,7 @@ func (f *Fabrics) DeleteFabricResource(ctx context.Context, req *fabricsproto.Fa
 		Auth:          f.IsAuthorizedRPC,
 		ContactClient: f.ContactClientRPC,
 	}
-	data := fab.DeleteFabricResource(req)
+	data := fab.DeleteFabricResource(ctx, req)
 	resp.Header = data.Header
 	resp.StatusCode = data.StatusCode
 	resp.StatusMessage = data.StatusMessage
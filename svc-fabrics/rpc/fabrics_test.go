@@ -23,7 +23,6 @@ import (
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	"github.com/ODIM-Project/ODIM/svc-fabrics/fabmodel"
-	"github.com/ODIM-Project/ODIM/svc-fabrics/fabrics"
 	"io/ioutil"
 	"net/http"
 	"testing"
@@ -136,7 +135,6 @@ func mockContactClient(url, method, token string, odataID string, body interface
 	return nil, fmt.Errorf("InvalidRequest")
 }
 func TestFabrics_GetFabricResource(t *testing.T) {
-	fabrics.Token.Tokens = make(map[string]string)
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
@@ -186,7 +184,6 @@ func TestFabrics_GetFabricResource(t *testing.T) {
 }
 
 func TestFabrics_UpdateFabricResource(t *testing.T) {
-	fabrics.Token.Tokens = make(map[string]string)
 	postData, _ := json.Marshal(map[string]interface{}{
 		"@odata.id": "/redfish/v1/Fabrics",
 	})
@@ -240,7 +237,6 @@ func TestFabrics_UpdateFabricResource(t *testing.T) {
 }
 
 func TestFabrics_DeleteFabricResource(t *testing.T) {
-	fabrics.Token.Tokens = make(map[string]string)
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.OnDisk)
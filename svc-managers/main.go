@@ -14,12 +14,18 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/sirupsen/logrus"
 	"os"
+	"runtime"
+	"time"
 
+	dc "github.com/ODIM-Project/ODIM/lib-messagebus/datacommunicator"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	taskproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/task"
 	"github.com/ODIM-Project/ODIM/lib-utilities/services"
 	"github.com/ODIM-Project/ODIM/svc-managers/managers"
 	"github.com/ODIM-Project/ODIM/svc-managers/mgrcommon"
@@ -27,7 +33,8 @@ import (
 	"github.com/ODIM-Project/ODIM/svc-managers/rpc"
 )
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
 
@@ -40,10 +47,20 @@ func main() {
 		log.Fatal("fatal: error while trying set up configuration: %v" + err.Error())
 	}
 
+	if err := dc.SetConfiguration(config.Data.MessageQueueConfigFilePath); err != nil {
+		log.Fatal("error while trying to set messagebus configuration: " + err.Error())
+	}
+	// the events not yet published are flushed when the service stops
+	defer dc.CloseProducers()
+
 	if err := common.CheckDBConnection(); err != nil {
 		log.Fatal(err.Error())
 	}
 
+	if err := common.LoadFilterIndexes(); err != nil {
+		log.Fatal(err.Error())
+	}
+
 	var managerInterface = mgrcommon.DBInterface{
 		AddManagertoDBInterface: mgrmodel.AddManagertoDB,
 	}
@@ -61,8 +78,8 @@ func main() {
 	if err != nil {
 		log.Fatal("fatal: error while trying to initialize service: %v" + err.Error())
 	}
-	mgrcommon.Token.Tokens = make(map[string]string)
 	registerHandlers()
+	go managers.GetExternalInterface().MonitorCertificateExpiry()
 	if err = services.Service.Run(); err != nil {
 		log.Fatal("failed to run a service: " + err.Error())
 	}
@@ -73,10 +90,39 @@ func registerHandlers() {
 
 	manager.IsAuthorizedRPC = services.IsAuthorized
 	manager.EI = managers.GetExternalInterface()
+	manager.EI.Task = managers.Task{
+		CreateTask:         services.CreateTask,
+		UpdateTask:         updateTask,
+		GetSessionUserName: services.GetSessionUserName,
+	}
 
 	managersproto.RegisterManagersHandler(services.Service.Server(), manager)
 }
 
+// updateTask updates the task with the given data
+func updateTask(taskData common.TaskData) error {
+	respBody, _ := json.Marshal(taskData.Response.Body)
+	payLoad := &taskproto.Payload{
+		HTTPHeaders:   taskData.Response.Header,
+		HTTPOperation: taskData.HTTPMethod,
+		JSONBody:      taskData.TaskRequest,
+		StatusCode:    taskData.Response.StatusCode,
+		TargetURI:     taskData.TargetURI,
+		ResponseBody:  respBody,
+	}
+
+	err := services.UpdateTask(taskData.TaskID, taskData.TaskState, taskData.TaskStatus, taskData.PercentComplete, payLoad, time.Now())
+	if err != nil && (err.Error() == common.Cancelling) {
+		// the action is already sent to the device and can't be reverted
+		services.UpdateTask(taskData.TaskID, common.Cancelled, taskData.TaskStatus, taskData.PercentComplete, payLoad, time.Now())
+		if taskData.PercentComplete == 0 {
+			return fmt.Errorf("error while starting the task: %v", err)
+		}
+		runtime.Goexit()
+	}
+	return nil
+}
+
 func addManagertoDB(managerInterface mgrcommon.DBInterface) error {
 	mgr := mgrmodel.RAManager{
 		Name:            "odimra",
@@ -0,0 +1,301 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package managers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrcommon"
+)
+
+// ResetRequest is the request body of the Manager.Reset and Manager.ResetToDefaults actions
+type ResetRequest struct {
+	ResetType string `json:"ResetType"`
+}
+
+// InsertMediaRequest is the request body of the VirtualMedia.InsertMedia action
+type InsertMediaRequest struct {
+	Image                string `json:"Image"`
+	Inserted             *bool  `json:"Inserted"`
+	WriteProtected       *bool  `json:"WriteProtected"`
+	MediaType            string `json:"MediaType"`
+	TransferMethod       string `json:"TransferMethod"`
+	TransferProtocolType string `json:"TransferProtocolType"`
+	UserName             string `json:"UserName"`
+	Password             string `json:"Password"`
+}
+
+// VirtualMediaRequest is the request body of the PATCH on a virtual media,
+// only the properties which are writable on the virtual media are accepted
+type VirtualMediaRequest struct {
+	Image          *string `json:"Image"`
+	Inserted       *bool   `json:"Inserted"`
+	WriteProtected *bool   `json:"WriteProtected"`
+}
+
+// managerAction holds the details of an action performed on the manager of a device
+type managerAction struct {
+	method string
+	// request is the structure the request body is validated against
+	request interface{}
+	// required is the property which must be present in the request body
+	required string
+	// refresh is the URI of the resource to be refreshed in the inventory once the action is done
+	refresh string
+}
+
+// credentialProperties are the properties of the action requests which are masked in the task
+var credentialProperties = []string{"UserName", "Password"}
+
+// TaskRequest returns the request body of an action to be saved in the task, with the values of
+// the credentials masked. The request body is not saved when it is not a JSON object.
+func TaskRequest(requestBody []byte) string {
+	if len(requestBody) == 0 {
+		return ""
+	}
+	var request map[string]interface{}
+	if err := json.Unmarshal(requestBody, &request); err != nil {
+		return ""
+	}
+	for property := range request {
+		for _, credential := range credentialProperties {
+			if strings.EqualFold(property, credential) {
+				request[property] = "[redacted]"
+			}
+		}
+	}
+	data, _ := json.Marshal(request)
+	return string(data)
+}
+
+// ResetManager performs the Manager.Reset action on the manager of a device,
+// the outcome of the action is updated in the task
+func (e *ExternalInterface) ResetManager(ctx context.Context, taskID string, req *managersproto.ManagerActionRequest) response.RPC {
+	return e.performAction(ctx, taskID, req, managerAction{
+		method:  http.MethodPost,
+		request: &ResetRequest{},
+	})
+}
+
+// ResetManagerToDefaults performs the Manager.ResetToDefaults action on the manager of a device,
+// the outcome of the action is updated in the task
+func (e *ExternalInterface) ResetManagerToDefaults(ctx context.Context, taskID string, req *managersproto.ManagerActionRequest) response.RPC {
+	return e.performAction(ctx, taskID, req, managerAction{
+		method:   http.MethodPost,
+		request:  &ResetRequest{},
+		required: "ResetType",
+	})
+}
+
+// InsertVirtualMedia performs the VirtualMedia.InsertMedia action on a virtual media of a manager,
+// the virtual media is refreshed in the inventory and the outcome of the action is updated in the task
+func (e *ExternalInterface) InsertVirtualMedia(ctx context.Context, taskID string, req *managersproto.ManagerActionRequest) response.RPC {
+	return e.performAction(ctx, taskID, req, managerAction{
+		method:   http.MethodPost,
+		request:  &InsertMediaRequest{},
+		required: "Image",
+		refresh:  virtualMediaURI(req.URL),
+	})
+}
+
+// EjectVirtualMedia performs the VirtualMedia.EjectMedia action on a virtual media of a manager,
+// the virtual media is refreshed in the inventory and the outcome of the action is updated in the task
+func (e *ExternalInterface) EjectVirtualMedia(ctx context.Context, taskID string, req *managersproto.ManagerActionRequest) response.RPC {
+	return e.performAction(ctx, taskID, req, managerAction{
+		method:  http.MethodPost,
+		refresh: virtualMediaURI(req.URL),
+	})
+}
+
+// UpdateVirtualMedia patches the Image, Inserted and WriteProtected properties of a virtual media,
+// the virtual media is refreshed in the inventory and the outcome of the update is updated in the task
+func (e *ExternalInterface) UpdateVirtualMedia(ctx context.Context, taskID string, req *managersproto.ManagerActionRequest) response.RPC {
+	return e.performAction(ctx, taskID, req, managerAction{
+		method:  http.MethodPatch,
+		request: &VirtualMediaRequest{},
+		refresh: virtualMediaURI(req.URL),
+	})
+}
+
+// performAction validates the request, sends it to the plugin managing the device and
+// refreshes the inventory, the task is updated with the response of the action
+func (e *ExternalInterface) performAction(ctx context.Context, taskID string, req *managersproto.ManagerActionRequest, action managerAction) response.RPC {
+	taskRequest := TaskRequest(req.RequestBody)
+	taskInfo := &common.TaskUpdateInfo{TaskID: taskID, TargetURI: req.URL, UpdateTask: e.Task.UpdateTask, TaskRequest: taskRequest}
+
+	if action.request != nil {
+		if resp := validateActionRequest(ctx, req.RequestBody, action, taskInfo); resp != nil {
+			return *resp
+		}
+	}
+
+	requestData := strings.SplitN(req.ManagerID, ":", 2)
+	if len(requestData) <= 1 {
+		errorMessage := "error: the action is not supported on the manager " + req.ManagerID
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"Managers", req.ManagerID}, taskInfo)
+	}
+	uuid, managerID := requestData[0], requestData[1]
+	ctx = logs.With(ctx, logs.SystemIDField, uuid)
+
+	target, gerr := e.DB.GetTarget(uuid)
+	if gerr != nil {
+		errorMessage := "unable to get the target of the manager: " + gerr.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"Managers", req.ManagerID}, taskInfo)
+	}
+	decryptedPasswordByte, err := e.Device.DecryptDevicePassword(target.Password)
+	if err != nil {
+		errorMessage := "error while trying to decrypt device password: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, taskInfo)
+	}
+	ctx = logs.With(ctx, logs.PluginIDField, target.PluginID)
+	plugin, gerr := e.DB.GetPluginData(target.PluginID)
+	if gerr != nil {
+		errorMessage := "unable to get plugin details: " + gerr.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, taskInfo)
+	}
+
+	// the devices expect a JSON body on the actions, even when no parameter is given
+	postBody := req.RequestBody
+	if len(postBody) == 0 {
+		postBody = []byte("{}")
+	}
+	var contactRequest mgrcommon.PluginContactRequest
+	contactRequest.ContactClient = e.Device.ContactClient
+	contactRequest.Plugin = plugin
+	contactRequest.HTTPMethodType = action.method
+	contactRequest.DeviceInfo = map[string]interface{}{
+		"ManagerAddress": target.ManagerAddress,
+		"UserName":       target.UserName,
+		"Password":       decryptedPasswordByte,
+		"PostBody":       postBody,
+	}
+	contactRequest.OID = strings.Replace(req.URL, req.ManagerID, managerID, 1)
+	body, _, status, err := mgrcommon.ContactPlugin(ctx, contactRequest, "error while performing the action on "+req.URL+": ")
+	if err != nil {
+		resp := response.RPC{
+			StatusCode:    status.StatusCode,
+			StatusMessage: status.StatusMessage,
+			Header:        map[string]string{"Content-type": "application/json; charset=utf-8"},
+		}
+		json.Unmarshal(body, &resp.Body)
+		e.Task.UpdateTask(fillTaskData(taskID, req.URL, taskRequest, resp, common.Exception, common.Critical, 100, action.method))
+		return resp
+	}
+
+	if action.refresh != "" {
+		e.refreshResource(ctx, action.refresh, uuid, managerID)
+	}
+
+	resp := response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Header: map[string]string{
+			"Content-type":  "application/json; charset=utf-8",
+			"OData-Version": "4.0",
+		},
+	}
+	var respBody map[string]interface{}
+	if len(body) == 0 || json.Unmarshal(body, &respBody) != nil || len(respBody) == 0 {
+		var commonResponse response.Response
+		commonResponse.CreateGenericResponse(resp.StatusMessage)
+		resp.Body = commonResponse
+	} else {
+		resp.Body = respBody
+	}
+	e.Task.UpdateTask(fillTaskData(taskID, req.URL, taskRequest, resp, common.Completed, common.OK, 100, action.method))
+	return resp
+}
+
+// validateActionRequest validates the request body against the structure of the action,
+// the error response is returned when the request body is not valid
+func validateActionRequest(ctx context.Context, requestBody []byte, action managerAction, taskInfo *common.TaskUpdateInfo) *response.RPC {
+	if len(requestBody) == 0 {
+		requestBody = []byte("{}")
+	}
+	if err := json.Unmarshal(requestBody, action.request); err != nil {
+		errorMessage := "unable to parse the request body: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		resp := common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, taskInfo)
+		return &resp
+	}
+	// Validating the request JSON properties for case sensitive
+	invalidProperties, err := common.RequestParamsCaseValidator(requestBody, action.request)
+	if err != nil {
+		errorMessage := "unable to validate request parameters: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		resp := common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, taskInfo)
+		return &resp
+	} else if invalidProperties != "" {
+		errorMessage := "one or more properties given in the request body are not valid, ensure properties are listed in uppercamelcase "
+		logs.Log(ctx).Error(errorMessage)
+		resp := common.GeneralError(http.StatusBadRequest, response.PropertyUnknown, errorMessage, []interface{}{invalidProperties}, taskInfo)
+		return &resp
+	}
+	var requestMap, properties map[string]interface{}
+	json.Unmarshal(requestBody, &requestMap)
+	data, _ := json.Marshal(action.request)
+	json.Unmarshal(data, &properties)
+	for property := range requestMap {
+		if _, ok := properties[property]; !ok {
+			errorMessage := "the property " + property + " is not supported by the action"
+			logs.Log(ctx).Error(errorMessage)
+			resp := common.GeneralError(http.StatusBadRequest, response.PropertyUnknown, errorMessage, []interface{}{property}, taskInfo)
+			return &resp
+		}
+	}
+	if action.required != "" {
+		if value, ok := requestMap[action.required]; !ok || value == "" {
+			errorMessage := "'" + action.required + "' parameter cannot be empty"
+			logs.Log(ctx).Error(errorMessage)
+			resp := common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errorMessage, []interface{}{action.required}, taskInfo)
+			return &resp
+		}
+	}
+	return nil
+}
+
+// refreshResource gets the resource from the device and replaces it in the inventory,
+// the failure is only logged as the action itself is already done on the device
+func (e *ExternalInterface) refreshResource(ctx context.Context, uri, uuid, managerID string) {
+	data, err := e.getResourceInfoFromDevice(ctx, uri, uuid, managerID)
+	if err != nil {
+		logs.Log(ctx).Error("unable to refresh " + uri + " in the inventory: " + err.Error())
+		return
+	}
+	urlData := strings.Split(uri, "/")
+	tableName := urlData[len(urlData)-2]
+	if err := e.DB.SaveResource([]byte(data), tableName, uri); err != nil {
+		logs.Log(ctx).Error("unable to save " + uri + " in the inventory: " + err.Error())
+	}
+}
+
+// virtualMediaURI returns the URI of the virtual media the request is made on
+func virtualMediaURI(reqURL string) string {
+	if index := strings.Index(reqURL, "/Actions/"); index != -1 {
+		return reqURL[:index]
+	}
+	return strings.TrimSuffix(reqURL, "/")
+}
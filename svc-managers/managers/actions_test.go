@@ -0,0 +1,188 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package managers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockGetTarget(uuid string) (*mgrmodel.DeviceTarget, *errors.Error) {
+	if uuid != "uuid" {
+		return nil, errors.PackError(errors.DBKeyNotFound, "not found")
+	}
+	return &mgrmodel.DeviceTarget{
+		ManagerAddress: "10.0.0.1",
+		UserName:       "admin",
+		Password:       []byte("password"),
+		PluginID:       "somePlugin",
+	}, nil
+}
+
+func mockActionContactClient(url, method, token string, odataID string, body interface{}, loginCredential map[string]string) (*http.Response, error) {
+	switch url {
+	case "https://localhost:9093/ODIM/v1/Managers/1/Actions/Manager.Reset",
+		"https://localhost:9093/ODIM/v1/Managers/1/VirtualMedia/1/Actions/VirtualMedia.EjectMedia",
+		"https://localhost:9093/ODIM/v1/Managers/1/VirtualMedia/1":
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+		}, nil
+	case "https://localhost:9093/ODIM/v1/Managers/1/VirtualMedia/1/Actions/VirtualMedia.InsertMedia":
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"error":{"code":"Base.1.6.1.GeneralError"}}`)),
+		}, nil
+	}
+	return nil, fmt.Errorf("InvalidRequest")
+}
+
+type mockInventory struct {
+	tasks []common.TaskData
+	saved map[string]string
+}
+
+func (m *mockInventory) updateTask(taskData common.TaskData) error {
+	m.tasks = append(m.tasks, taskData)
+	return nil
+}
+
+func (m *mockInventory) saveResource(body []byte, table, key string) error {
+	m.saved[table+":"+key] = string(body)
+	return nil
+}
+
+func mockActionExternalInterface(inventory *mockInventory) *ExternalInterface {
+	e := mockGetExternalInterface()
+	e.Device.ContactClient = mockActionContactClient
+	e.Device.DecryptDevicePassword = func(password []byte) ([]byte, error) {
+		return password, nil
+	}
+	e.DB.GetTarget = mockGetTarget
+	e.DB.SaveResource = inventory.saveResource
+	e.Task.UpdateTask = inventory.updateTask
+	return e
+}
+
+func TestResetManager(t *testing.T) {
+	config.SetUpMockConfig(t)
+	inventory := &mockInventory{saved: map[string]string{}}
+	e := mockActionExternalInterface(inventory)
+
+	resp := e.ResetManager(context.Background(), "task1", &managersproto.ManagerActionRequest{
+		ManagerID:   "uuid:1",
+		URL:         "/redfish/v1/Managers/uuid:1/Actions/Manager.Reset",
+		RequestBody: []byte(`{"ResetType":"ForceRestart"}`),
+	})
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	assert.Equal(t, common.Completed, inventory.tasks[len(inventory.tasks)-1].TaskState, "task should be completed")
+	assert.Equal(t, 0, len(inventory.saved), "no resource should be refreshed")
+
+	resp = e.ResetManager(context.Background(), "task2", &managersproto.ManagerActionRequest{
+		ManagerID:   "uuid:1",
+		URL:         "/redfish/v1/Managers/uuid:1/Actions/Manager.Reset",
+		RequestBody: []byte(`{"resettype":"ForceRestart"}`),
+	})
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be StatusBadRequest.")
+
+	resp = e.ResetManager(context.Background(), "task3", &managersproto.ManagerActionRequest{
+		ManagerID: "unknown:1",
+		URL:       "/redfish/v1/Managers/unknown:1/Actions/Manager.Reset",
+	})
+	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status code should be StatusNotFound.")
+	assert.Equal(t, common.Exception, inventory.tasks[len(inventory.tasks)-1].TaskState, "task should be in exception")
+}
+
+func TestResetManagerToDefaultsWithoutResetType(t *testing.T) {
+	config.SetUpMockConfig(t)
+	inventory := &mockInventory{saved: map[string]string{}}
+	e := mockActionExternalInterface(inventory)
+
+	resp := e.ResetManagerToDefaults(context.Background(), "task1", &managersproto.ManagerActionRequest{
+		ManagerID:   "uuid:1",
+		URL:         "/redfish/v1/Managers/uuid:1/Actions/Manager.ResetToDefaults",
+		RequestBody: []byte(`{}`),
+	})
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be StatusBadRequest.")
+}
+
+func TestVirtualMediaActions(t *testing.T) {
+	config.SetUpMockConfig(t)
+	inventory := &mockInventory{saved: map[string]string{}}
+	e := mockActionExternalInterface(inventory)
+	vmURI := "/redfish/v1/Managers/uuid:1/VirtualMedia/1"
+
+	resp := e.EjectVirtualMedia(context.Background(), "task1", &managersproto.ManagerActionRequest{
+		ManagerID:  "uuid:1",
+		ResourceID: "1",
+		URL:        vmURI + "/Actions/VirtualMedia.EjectMedia",
+	})
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	_, ok := inventory.saved["VirtualMedia:"+vmURI]
+	assert.True(t, ok, "virtual media should be refreshed")
+
+	resp = e.UpdateVirtualMedia(context.Background(), "task2", &managersproto.ManagerActionRequest{
+		ManagerID:   "uuid:1",
+		ResourceID:  "1",
+		URL:         vmURI,
+		RequestBody: []byte(`{"Image":"http://10.0.0.2/boot.iso","Inserted":true}`),
+	})
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+
+	resp = e.UpdateVirtualMedia(context.Background(), "task3", &managersproto.ManagerActionRequest{
+		ManagerID:   "uuid:1",
+		ResourceID:  "1",
+		URL:         vmURI,
+		RequestBody: []byte(`{"MediaTypes":["CD"]}`),
+	})
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be StatusBadRequest.")
+
+	resp = e.InsertVirtualMedia(context.Background(), "task4", &managersproto.ManagerActionRequest{
+		ManagerID:   "uuid:1",
+		ResourceID:  "1",
+		URL:         vmURI + "/Actions/VirtualMedia.InsertMedia",
+		RequestBody: []byte(`{"Inserted":true}`),
+	})
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be StatusBadRequest.")
+
+	inventory.saved = map[string]string{}
+	resp = e.InsertVirtualMedia(context.Background(), "task5", &managersproto.ManagerActionRequest{
+		ManagerID:   "uuid:1",
+		ResourceID:  "1",
+		URL:         vmURI + "/Actions/VirtualMedia.InsertMedia",
+		RequestBody: []byte(`{"Image":"http://10.0.0.2/boot.iso"}`),
+	})
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be the status of the device.")
+	assert.Equal(t, common.Exception, inventory.tasks[len(inventory.tasks)-1].TaskState, "task should be in exception")
+	assert.Equal(t, 0, len(inventory.saved), "virtual media should not be refreshed")
+}
+
+func TestTaskRequest(t *testing.T) {
+	taskRequest := TaskRequest([]byte(`{"Image":"http://10.0.0.2/boot.iso","UserName":"admin","password":"secret"}`))
+	assert.Equal(t, `{"Image":"http://10.0.0.2/boot.iso","UserName":"[redacted]","password":"[redacted]"}`, taskRequest, "credentials should be masked")
+	assert.Equal(t, "", TaskRequest([]byte(`"Password":"secret"`)), "invalid request should not be saved")
+	assert.Equal(t, "", TaskRequest(nil), "empty request should be saved as is")
+}
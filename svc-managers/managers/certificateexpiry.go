@@ -0,0 +1,294 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package managers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrmodel"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrresponse"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	endpointCertificatesURI = certificateServiceURI + "/Oem/ODIM/EndpointCertificates"
+	// rootCAEndpoint is the endpoint the root CA certificate of odimra is recorded for
+	rootCAEndpoint = "Root CA"
+	// the alerts of the expiry of the certificates are published as events of the managers
+	managerCollection = "ManagerCollection"
+)
+
+// MonitorCertificateExpiry checks the expiry of the certificates of odimra, of the plugins and of
+// the devices at the CheckIntervalInMins of the CertificateExpiryConf
+func (e *ExternalInterface) MonitorCertificateExpiry() {
+	for {
+		e.CheckCertificateExpiry(time.Now())
+		time.Sleep(time.Duration(config.Data.CertificateExpiryConf.CheckIntervalInMins) * time.Minute)
+	}
+}
+
+// CheckCertificateExpiry records the certificates of odimra and the certificates presented by the
+// devices to the plugins, the certificates of the plugins being recorded on every request to them.
+// An alert is raised for the manager of each endpoint whose certificate gets closer to its expiry
+// than one of the AlertThresholdsInDays of the CertificateExpiryConf.
+func (e *ExternalInterface) CheckCertificateExpiry(now time.Time) {
+	// resources holds the manager of each endpoint, the alerts of its certificate are raised for
+	resources := make(map[string]string)
+	odimraManager := "/redfish/v1/Managers/" + config.Data.RootServiceUUID
+	for _, certificate := range e.odimraEndpointCertificates() {
+		resources[certificate.Endpoint] = odimraManager
+		if err := e.DB.SaveEndpointCertificate(certificate); err != nil {
+			log.Error("unable to save the certificate of " + certificate.Endpoint + ": " + err.Error())
+		}
+	}
+
+	managers := e.getManagerURIs()
+	targets, gerr := e.DB.GetAllTargets()
+	if gerr != nil {
+		log.Error("unable to get the devices for checking the expiry of their certificates: " + gerr.Error())
+		return
+	}
+	for _, target := range targets {
+		resources[target.ManagerAddress] = managers.device(target.DeviceUUID, odimraManager)
+	}
+	plugins, gerr := e.DB.GetAllPlugins()
+	if gerr != nil {
+		log.Error("unable to get the plugins for checking the expiry of their certificates: " + gerr.Error())
+		return
+	}
+	for _, plugin := range plugins {
+		resources[plugin.IP+":"+plugin.Port] = managers.plugin(plugin.ID, odimraManager)
+		certificates, err := e.Device.GetEndpointCertificates(plugin, e.Device.ContactClient)
+		if err != nil {
+			// the plugins which don't report the certificates of the devices are skipped
+			log.Info("unable to get the certificates of the devices of the plugin " + plugin.ID + ": " + err.Error())
+			continue
+		}
+		for _, certificate := range certificates {
+			if _, ok := resources[certificate.Endpoint]; !ok {
+				continue
+			}
+			certificate.EndpointType = common.BMCEndpoint
+			if err := e.DB.SaveEndpointCertificate(certificate); err != nil {
+				log.Error("unable to save the certificate of " + certificate.Endpoint + ": " + err.Error())
+			}
+		}
+	}
+
+	certificates, err := e.DB.GetEndpointCertificates()
+	if err != nil {
+		log.Error("unable to get the certificates of the endpoints: " + err.Error())
+		return
+	}
+	for _, certificate := range certificates {
+		resource, ok := resources[certificate.Endpoint]
+		if !ok {
+			// the plugin or the device was removed
+			e.DB.DeleteEndpointCertificate(certificate.Endpoint)
+			e.DB.DeleteCertificateExpiryAlert(certificate.Endpoint)
+			continue
+		}
+		e.alertCertificateExpiry(certificate, resource, now)
+	}
+}
+
+// alertCertificateExpiry raises an alert when the certificate got closer to its expiry than one of the
+// thresholds since the last alert raised for it, and once it expired. The alerts are raised again
+// for a new certificate.
+func (e *ExternalInterface) alertCertificateExpiry(certificate common.EndpointCertificate, resource string, now time.Time) {
+	thresholds := config.Data.CertificateExpiryConf.AlertThresholdsInDays
+	remaining := certificate.ValidNotAfter.Sub(now)
+	threshold := -1
+	for _, days := range thresholds {
+		if remaining <= time.Duration(days)*24*time.Hour {
+			threshold = days
+		}
+	}
+	if remaining <= 0 {
+		threshold = 0
+	}
+	if threshold < 0 {
+		return
+	}
+	alert, err := e.DB.GetCertificateExpiryAlert(certificate.Endpoint)
+	if err == nil && alert.ValidNotAfter.Equal(certificate.ValidNotAfter) && alert.ThresholdInDays <= threshold {
+		return
+	}
+
+	event := common.Event{
+		EventType:      "Alert",
+		Severity:       "Warning",
+		EventTimestamp: now.UTC().Format(time.RFC3339),
+		MessageID:      "ResourceEvent.1.0.3.ResourceWarningThresholdExceeded",
+		Message: fmt.Sprintf("The certificate of the %s endpoint %s expires on %s.", certificate.EndpointType,
+			certificate.Endpoint, certificate.ValidNotAfter.UTC().Format(time.RFC3339)),
+		MessageArgs:       []string{"ValidNotAfter", strconv.Itoa(threshold)},
+		OriginOfCondition: &common.Link{Oid: resource},
+	}
+	if threshold == 0 {
+		event.Message = fmt.Sprintf("The certificate of the %s endpoint %s expired on %s.", certificate.EndpointType,
+			certificate.Endpoint, certificate.ValidNotAfter.UTC().Format(time.RFC3339))
+	}
+	if threshold == 0 || threshold == thresholds[len(thresholds)-1] {
+		event.Severity = "Critical"
+		event.MessageID = "ResourceEvent.1.0.3.ResourceErrorThresholdExceeded"
+	}
+	log.Warn(event.Message)
+	e.PublishEvent(event, managerCollection)
+	if err := e.DB.SaveCertificateExpiryAlert(certificate.Endpoint, mgrmodel.CertificateExpiryAlert{
+		ValidNotAfter:   certificate.ValidNotAfter,
+		ThresholdInDays: threshold,
+	}); err != nil {
+		log.Error("unable to save the alert of the expiry of the certificate of " + certificate.Endpoint + ": " + err.Error())
+	}
+}
+
+// GetEndpointCertificates returns the certificates presented by odimra, the plugins and the devices
+func (e *ExternalInterface) GetEndpointCertificates(ctx context.Context, req *managersproto.ManagerRequest) response.RPC {
+	certificates, err := e.DB.GetEndpointCertificates()
+	if err != nil {
+		errorMessage := "error while getting the certificates of the endpoints: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	endpoints := make([]mgrresponse.EndpointCertificate, 0, len(certificates))
+	for _, certificate := range certificates {
+		endpoint := mgrresponse.EndpointCertificate{
+			Endpoint:      certificate.Endpoint,
+			EndpointType:  certificate.EndpointType,
+			ValidNotAfter: certificate.ValidNotAfter.UTC().Format(time.RFC3339),
+			LastSeen:      certificate.LastSeen.UTC().Format(time.RFC3339),
+		}
+		for _, chainCertificate := range certificate.Chain {
+			endpoint.Chain = append(endpoint.Chain, mgrresponse.ChainCertificate{
+				Subject:        mgrresponse.CertificateIdentifier{CommonName: chainCertificate.Subject},
+				Issuer:         mgrresponse.CertificateIdentifier{CommonName: chainCertificate.Issuer},
+				SerialNumber:   chainCertificate.SerialNumber,
+				Fingerprint:    chainCertificate.Fingerprint,
+				ValidNotBefore: chainCertificate.ValidNotBefore.UTC().Format(time.RFC3339),
+				ValidNotAfter:  chainCertificate.ValidNotAfter.UTC().Format(time.RFC3339),
+			})
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Header:        certificateResponseHeader(),
+		Body: mgrresponse.EndpointCertificates{
+			OdataID:        endpointCertificatesURI,
+			ID:             "EndpointCertificates",
+			Name:           "Endpoint Certificates",
+			Description:    "Certificates presented by odimra, the plugins and the devices",
+			Endpoints:      endpoints,
+			EndpointsCount: len(endpoints),
+		},
+	}
+}
+
+// odimraEndpointCertificates returns the certificates of odimra in use, and the root CA certificate
+func (e *ExternalInterface) odimraEndpointCertificates() []common.EndpointCertificate {
+	var certificates []common.EndpointCertificate
+	for _, certificate := range odimraCertificates() {
+		certificatePEM, _, err := e.getKeyPair(&certificate)
+		if err != nil {
+			log.Error("unable to read the " + certificate.name + " certificate: " + err.Error())
+			continue
+		}
+		chain, err := parseCertificateChain(certificatePEM)
+		if err != nil {
+			log.Error("unable to parse the " + certificate.name + " certificate: " + err.Error())
+			continue
+		}
+		certificates = append(certificates, common.NewEndpointCertificate(common.ODIMRAEndpoint, certificate.name, chain))
+	}
+	if chain, err := parseCertificateChain(config.Data.KeyCertConf.RootCACertificate); err == nil {
+		certificates = append(certificates, common.NewEndpointCertificate(common.ODIMRAEndpoint, rootCAEndpoint, chain))
+	}
+	return certificates
+}
+
+// managerURIs holds the managers of the plugins, keyed by plugin, and the managers of the devices,
+// keyed by the uuid of the device
+type managerURIs struct {
+	plugins map[string]string
+	devices map[string]string
+}
+
+// getManagerURIs returns the managers of the plugins and of the devices
+func (e *ExternalInterface) getManagerURIs() managerURIs {
+	managers := managerURIs{
+		plugins: make(map[string]string),
+		devices: make(map[string]string),
+	}
+	keys, err := e.DB.GetAllKeysFromTable("Managers")
+	if err != nil {
+		log.Error("unable to get the managers: " + err.Error())
+		return managers
+	}
+	for _, key := range keys {
+		id := strings.TrimPrefix(key, "/redfish/v1/Managers/")
+		if index := strings.Index(id, ":"); index > 0 {
+			deviceUUID := id[:index]
+			if existing, ok := managers.devices[deviceUUID]; !ok || key < existing {
+				managers.devices[deviceUUID] = key
+			}
+			continue
+		}
+		if id == config.Data.RootServiceUUID {
+			continue
+		}
+		data, err := e.DB.GetManagerByURL(key)
+		if err != nil {
+			continue
+		}
+		var manager map[string]interface{}
+		if json.Unmarshal([]byte(data), &manager) != nil {
+			continue
+		}
+		// the managers of the plugins are named after the plugins
+		if name, ok := manager["Name"].(string); ok {
+			managers.plugins[name] = key
+		}
+	}
+	return managers
+}
+
+// plugin returns the manager of the plugin, defaultURI when it's not known
+func (m managerURIs) plugin(pluginID, defaultURI string) string {
+	if uri, ok := m.plugins[pluginID]; ok {
+		return uri
+	}
+	return defaultURI
+}
+
+// device returns the manager of the device, defaultURI when it's not known
+func (m managerURIs) device(deviceUUID, defaultURI string) string {
+	if uri, ok := m.devices[deviceUUID]; ok {
+		return uri
+	}
+	return defaultURI
+}
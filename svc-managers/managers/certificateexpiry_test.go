@@ -0,0 +1,200 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package managers
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrmodel"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrresponse"
+)
+
+const (
+	pluginManagerURI = "/redfish/v1/Managers/pluginManager"
+	deviceManagerURI = "/redfish/v1/Managers/uuid:1"
+)
+
+// mockCertificateStore holds the certificates, the alerts and the events of the expiry checks
+type mockCertificateStore struct {
+	certificates       map[string]common.EndpointCertificate
+	deviceCertificates []common.EndpointCertificate
+	alerts             map[string]mgrmodel.CertificateExpiryAlert
+	events             map[string][]common.Event
+}
+
+func newMockCertificateStore() *mockCertificateStore {
+	return &mockCertificateStore{
+		certificates: make(map[string]common.EndpointCertificate),
+		alerts:       make(map[string]mgrmodel.CertificateExpiryAlert),
+		events:       make(map[string][]common.Event),
+	}
+}
+
+func (s *mockCertificateStore) externalInterface() *ExternalInterface {
+	e := mockGetExternalInterface()
+	e.PublishEvent = func(event common.Event, collectionType string) {
+		s.events[event.OriginOfCondition.Oid] = append(s.events[event.OriginOfCondition.Oid], event)
+	}
+	e.Device.GetEndpointCertificates = func(plugin mgrmodel.Plugin, contactClient func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)) ([]common.EndpointCertificate, error) {
+		return s.deviceCertificates, nil
+	}
+	e.DB.GetAllKeysFromTable = func(table string) ([]string, error) {
+		return []string{deviceManagerURI, pluginManagerURI}, nil
+	}
+	e.DB.GetManagerByURL = func(url string) (string, *errors.Error) {
+		return `{"Name":"GRF"}`, nil
+	}
+	e.DB.GetAllTargets = func() ([]mgrmodel.DeviceTarget, *errors.Error) {
+		return []mgrmodel.DeviceTarget{{ManagerAddress: "10.0.0.1", DeviceUUID: "uuid", PluginID: "GRF"}}, nil
+	}
+	e.DB.GetAllPlugins = func() ([]mgrmodel.Plugin, *errors.Error) {
+		return []mgrmodel.Plugin{{IP: "localhost", Port: "45001", ID: "GRF"}}, nil
+	}
+	e.DB.GetEndpointCertificates = func() ([]common.EndpointCertificate, error) {
+		var certificates []common.EndpointCertificate
+		for _, certificate := range s.certificates {
+			certificates = append(certificates, certificate)
+		}
+		sort.Slice(certificates, func(i, j int) bool {
+			return certificates[i].Endpoint < certificates[j].Endpoint
+		})
+		return certificates, nil
+	}
+	e.DB.SaveEndpointCertificate = func(certificate common.EndpointCertificate) error {
+		s.certificates[certificate.Endpoint] = certificate
+		return nil
+	}
+	e.DB.DeleteEndpointCertificate = func(endpoint string) error {
+		delete(s.certificates, endpoint)
+		return nil
+	}
+	e.DB.GetCertificateExpiryAlert = func(endpoint string) (*mgrmodel.CertificateExpiryAlert, *errors.Error) {
+		alert, ok := s.alerts[endpoint]
+		if !ok {
+			return nil, errors.PackError(errors.DBKeyNotFound, "no alert for "+endpoint)
+		}
+		return &alert, nil
+	}
+	e.DB.SaveCertificateExpiryAlert = func(endpoint string, alert mgrmodel.CertificateExpiryAlert) *errors.Error {
+		s.alerts[endpoint] = alert
+		return nil
+	}
+	e.DB.DeleteCertificateExpiryAlert = func(endpoint string) *errors.Error {
+		delete(s.alerts, endpoint)
+		return nil
+	}
+	return e
+}
+
+func TestCheckCertificateExpiry(t *testing.T) {
+	config.SetUpMockConfig(t)
+	now := time.Now()
+	store := newMockCertificateStore()
+	store.certificates["localhost:45001"] = common.EndpointCertificate{
+		Endpoint:      "localhost:45001",
+		EndpointType:  common.PluginEndpoint,
+		ValidNotAfter: now.Add(20 * 24 * time.Hour),
+	}
+	store.certificates["10.0.0.2"] = common.EndpointCertificate{
+		Endpoint:      "10.0.0.2",
+		EndpointType:  common.BMCEndpoint,
+		ValidNotAfter: now.Add(time.Hour),
+	}
+	store.alerts["10.0.0.2"] = mgrmodel.CertificateExpiryAlert{ValidNotAfter: now.Add(time.Hour), ThresholdInDays: 1}
+	store.deviceCertificates = []common.EndpointCertificate{
+		{Endpoint: "10.0.0.1", ValidNotAfter: now.Add(5 * 24 * time.Hour)},
+		{Endpoint: "10.0.0.9", ValidNotAfter: now.Add(5 * 24 * time.Hour)},
+	}
+	e := store.externalInterface()
+
+	e.CheckCertificateExpiry(now)
+	if _, ok := store.certificates["10.0.0.9"]; ok {
+		t.Errorf("CheckCertificateExpiry() saved the certificate of an unknown device")
+	}
+	if _, ok := store.certificates["10.0.0.2"]; ok {
+		t.Errorf("CheckCertificateExpiry() didn't delete the certificate of a removed device")
+	}
+	if _, ok := store.alerts["10.0.0.2"]; ok {
+		t.Errorf("CheckCertificateExpiry() didn't delete the alert of a removed device")
+	}
+	if store.certificates["10.0.0.1"].EndpointType != common.BMCEndpoint {
+		t.Errorf("CheckCertificateExpiry() saved the certificate of the device as %v", store.certificates["10.0.0.1"].EndpointType)
+	}
+	deviceEvents := store.events[deviceManagerURI]
+	if len(deviceEvents) != 1 || deviceEvents[0].Severity != "Warning" || deviceEvents[0].MessageArgs[1] != "7" {
+		t.Errorf("CheckCertificateExpiry() raised the alerts %+v for the device, want a warning for 7 days", deviceEvents)
+	}
+	pluginEvents := store.events[pluginManagerURI]
+	if len(pluginEvents) != 1 || pluginEvents[0].Severity != "Warning" || pluginEvents[0].MessageArgs[1] != "30" {
+		t.Errorf("CheckCertificateExpiry() raised the alerts %+v for the plugin, want a warning for 30 days", pluginEvents)
+	}
+
+	// the alerts are not raised again until the next threshold
+	e.CheckCertificateExpiry(now.Add(3 * 24 * time.Hour))
+	if len(store.events[deviceManagerURI]) != 1 || len(store.events[pluginManagerURI]) != 1 {
+		t.Errorf("CheckCertificateExpiry() raised the alerts again before the next threshold")
+	}
+
+	// the last threshold raises a critical alert
+	e.CheckCertificateExpiry(now.Add(4*24*time.Hour + 12*time.Hour))
+	deviceEvents = store.events[deviceManagerURI]
+	if len(deviceEvents) != 2 || deviceEvents[1].Severity != "Critical" ||
+		deviceEvents[1].MessageID != "ResourceEvent.1.0.3.ResourceErrorThresholdExceeded" {
+		t.Errorf("CheckCertificateExpiry() raised the alerts %+v for the device, want a critical alert", deviceEvents)
+	}
+
+	// a new certificate raises the alerts again
+	store.deviceCertificates[0].ValidNotAfter = now.Add(25 * 24 * time.Hour)
+	e.CheckCertificateExpiry(now)
+	deviceEvents = store.events[deviceManagerURI]
+	if len(deviceEvents) != 3 || deviceEvents[2].MessageArgs[1] != "30" {
+		t.Errorf("CheckCertificateExpiry() raised the alerts %+v for the new certificate of the device", deviceEvents)
+	}
+}
+
+func TestGetEndpointCertificates(t *testing.T) {
+	config.SetUpMockConfig(t)
+	validNotAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newMockCertificateStore()
+	store.certificates["10.0.0.1"] = common.EndpointCertificate{
+		Endpoint:      "10.0.0.1",
+		EndpointType:  common.BMCEndpoint,
+		ValidNotAfter: validNotAfter,
+		Chain: []common.CertificateInfo{
+			{Subject: "bmc", Issuer: "ca", ValidNotAfter: validNotAfter},
+		},
+	}
+	e := store.externalInterface()
+
+	resp := e.GetEndpointCertificates(context.Background(), &managersproto.ManagerRequest{})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GetEndpointCertificates(context.Background(), ) StatusCode = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	body := resp.Body.(mgrresponse.EndpointCertificates)
+	if body.EndpointsCount != 1 || body.Endpoints[0].Endpoint != "10.0.0.1" {
+		t.Fatalf("GetEndpointCertificates(context.Background(), ) returned the endpoints %+v", body.Endpoints)
+	}
+	if body.Endpoints[0].ValidNotAfter != "2030-01-01T00:00:00Z" || body.Endpoints[0].Chain[0].Subject.CommonName != "bmc" {
+		t.Errorf("GetEndpointCertificates(context.Background(), ) returned the certificate %+v", body.Endpoints[0])
+	}
+}
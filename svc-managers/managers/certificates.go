@@ -0,0 +1,614 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package managers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrcommon"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrresponse"
+)
+
+// URIs of the certificate service
+const (
+	certificateServiceURI   = "/redfish/v1/CertificateService"
+	certificateLocationsURI = certificateServiceURI + "/CertificateLocations"
+	generateCSRURI          = certificateServiceURI + "/Actions/CertificateService.GenerateCSR"
+	replaceCertificateURI   = certificateServiceURI + "/Actions/CertificateService.ReplaceCertificate"
+)
+
+// key pair algorithm and lengths of the keys generated for the certificates of odimra
+const (
+	keyPairAlgorithmRSA = "TPM_ALG_RSA"
+	defaultKeyBitLength = 2048
+)
+
+// deviceResourceURI matches the URIs of the resources of the devices, which hold the uuid of the device
+var deviceResourceURI = regexp.MustCompile(`^/redfish/v1/(Systems|Managers|Chassis)/([^/:]+):[^/]+/`)
+
+// GenerateCSRRequest is the request body of the CertificateService.GenerateCSR action
+type GenerateCSRRequest struct {
+	CertificateCollection *dmtf.Link `json:"CertificateCollection"`
+	CommonName            string     `json:"CommonName"`
+	AlternativeNames      []string   `json:"AlternativeNames"`
+	Organization          string     `json:"Organization"`
+	OrganizationalUnit    string     `json:"OrganizationalUnit"`
+	City                  string     `json:"City"`
+	State                 string     `json:"State"`
+	Country               string     `json:"Country"`
+	Email                 string     `json:"Email"`
+	ContactPerson         string     `json:"ContactPerson"`
+	ChallengePassword     string     `json:"ChallengePassword"`
+	KeyPairAlgorithm      string     `json:"KeyPairAlgorithm"`
+	KeyBitLength          int        `json:"KeyBitLength"`
+	KeyCurveID            string     `json:"KeyCurveId"`
+	KeyUsage              []string   `json:"KeyUsage"`
+}
+
+// ReplaceCertificateRequest is the request body of the CertificateService.ReplaceCertificate action
+type ReplaceCertificateRequest struct {
+	CertificateString string     `json:"CertificateString"`
+	CertificateType   string     `json:"CertificateType"`
+	CertificateURI    *dmtf.Link `json:"CertificateUri"`
+}
+
+// odimraCertificate is a certificate of odimra which can be replaced through the certificate service,
+// each certificate is the only member of its collection
+type odimraCertificate struct {
+	name string
+	// keyPair is the name of the key pair in the DB, the files of the configuration are
+	// served until the certificate is replaced with the certificate service
+	keyPair         string
+	collectionURI   string
+	certificatePath string
+	privateKeyPath  string
+}
+
+// odimraCertificates returns the certificates of the API gateway and of the RPCs between the services
+func odimraCertificates() []odimraCertificate {
+	managerURI := "/redfish/v1/Managers/" + config.Data.RootServiceUUID
+	return []odimraCertificate{
+		{
+			name:            "API gateway",
+			keyPair:         common.APIGatewayKeyPair,
+			collectionURI:   managerURI + "/NetworkProtocol/HTTPS/Certificates",
+			certificatePath: config.Data.APIGatewayConf.CertificatePath,
+			privateKeyPath:  config.Data.APIGatewayConf.PrivateKeyPath,
+		},
+		{
+			name:            "RPC",
+			keyPair:         common.RPCKeyPair,
+			collectionURI:   managerURI + "/Oem/ODIM/RPC/Certificates",
+			certificatePath: config.Data.KeyCertConf.RPCCertificatePath,
+			privateKeyPath:  config.Data.KeyCertConf.RPCPrivateKeyPath,
+		},
+	}
+}
+
+// findODIMRACertificate returns the certificate of odimra the URI is of, either its collection or
+// the certificate itself, nil is returned for the certificates of the devices
+func findODIMRACertificate(uri string) *odimraCertificate {
+	uri = strings.TrimSuffix(strings.SplitN(uri, "?", 2)[0], "/")
+	for _, certificate := range odimraCertificates() {
+		if uri == certificate.collectionURI || uri == certificate.memberURI() {
+			return &certificate
+		}
+	}
+	return nil
+}
+
+func (c *odimraCertificate) memberURI() string {
+	return c.collectionURI + "/1"
+}
+
+// pendingKeyPair is the name of the key pair in the DB holding the private key generated with the last
+// CSR, the private key replaces the one in use when the certificate signed from the CSR replaces the certificate
+func (c *odimraCertificate) pendingKeyPair() string {
+	return c.keyPair + ":pending"
+}
+
+// getKeyPair returns the key pair in use, the one saved in the DB when the certificate was
+// replaced with the certificate service, or else the one of the files of the configuration
+func (e *ExternalInterface) getKeyPair(certificate *odimraCertificate) ([]byte, []byte, error) {
+	certificatePEM, privateKeyPEM, gerr := e.DB.GetKeyPair(certificate.keyPair)
+	if gerr == nil {
+		return certificatePEM, privateKeyPEM, nil
+	}
+	if gerr.ErrNo() != errors.DBKeyNotFound {
+		return nil, nil, gerr
+	}
+	certificatePEM, err := ioutil.ReadFile(certificate.certificatePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	privateKeyPEM, err = ioutil.ReadFile(certificate.privateKeyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return certificatePEM, privateKeyPEM, nil
+}
+
+// GetCertificateService returns the certificate service of odimra
+func (e *ExternalInterface) GetCertificateService(ctx context.Context, req *managersproto.ManagerRequest) response.RPC {
+	return response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Header:        certificateResponseHeader(),
+		Body: mgrresponse.CertificateService{
+			OdataContext: "/redfish/v1/$metadata#CertificateService.CertificateService",
+			OdataID:      certificateServiceURI,
+			OdataType:    "#CertificateService.v1_0_3.CertificateService",
+			ID:           "CertificateService",
+			Name:         "Certificate Service",
+			Description:  "Certificates of odimra and of the devices",
+			Actions: mgrresponse.CertificateServiceActions{
+				GenerateCSR:        mgrresponse.ActionTarget{Target: generateCSRURI},
+				ReplaceCertificate: mgrresponse.ActionTarget{Target: replaceCertificateURI},
+			},
+			CertificateLocations: dmtf.Link{Oid: certificateLocationsURI},
+			Oem: mgrresponse.CertificateServiceOem{
+				ODIM: mgrresponse.CertificateServiceOemODIM{
+					EndpointCertificates: dmtf.Link{Oid: endpointCertificatesURI},
+				},
+			},
+		},
+	}
+}
+
+// GetCertificateLocations returns the certificates of odimra and of all the devices, the certificate
+// locations of the devices are read from the devices through their plugins
+func (e *ExternalInterface) GetCertificateLocations(ctx context.Context, req *managersproto.ManagerRequest) response.RPC {
+	var certificates []dmtf.Link
+	for _, certificate := range odimraCertificates() {
+		certificates = append(certificates, dmtf.Link{Oid: certificate.memberURI()})
+	}
+
+	managerURIs, err := e.DB.GetAllKeysFromTable("Managers")
+	if err != nil {
+		logs.Log(ctx).Error("unable to get the managers: " + err.Error())
+	}
+	var devices = map[string]bool{}
+	for _, managerURI := range managerURIs {
+		managerID := strings.TrimPrefix(managerURI, "/redfish/v1/Managers/")
+		if requestData := strings.SplitN(managerID, ":", 2); len(requestData) > 1 {
+			devices[requestData[0]] = true
+		}
+	}
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for uuid := range devices {
+		wg.Add(1)
+		go func(uuid string) {
+			defer wg.Done()
+			ctx := logs.With(ctx, logs.SystemIDField, uuid)
+			data, err := e.getResourceInfoFromDevice(ctx, certificateLocationsURI, uuid, "")
+			if err != nil {
+				// the devices which don't support the certificate service are left out
+				logs.Log(ctx).Warn("unable to get the certificate locations of the device " + uuid + ": " + err.Error())
+				return
+			}
+			var locations mgrresponse.CertificateLocations
+			json.Unmarshal([]byte(data), &locations)
+			lock.Lock()
+			certificates = append(certificates, locations.Links.Certificates...)
+			lock.Unlock()
+		}(uuid)
+	}
+	wg.Wait()
+	sort.Slice(certificates, func(i, j int) bool {
+		return certificates[i].Oid < certificates[j].Oid
+	})
+
+	return response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Header:        certificateResponseHeader(),
+		Body: mgrresponse.CertificateLocations{
+			OdataContext: "/redfish/v1/$metadata#CertificateLocations.CertificateLocations",
+			OdataID:      certificateLocationsURI,
+			OdataType:    "#CertificateLocations.v1_0_2.CertificateLocations",
+			ID:           "CertificateLocations",
+			Name:         "Certificate Locations",
+			Links: mgrresponse.CertificateLocationsLinks{
+				Certificates:      certificates,
+				CertificatesCount: len(certificates),
+			},
+		},
+	}
+}
+
+// GenerateCSR performs the CertificateService.GenerateCSR action. For the certificates of odimra
+// the private key is generated by odimra and kept until the certificate is replaced,
+// the action is sent to the device through its plugin for the certificates of the devices.
+func (e *ExternalInterface) GenerateCSR(ctx context.Context, req *managersproto.ManagerActionRequest) response.RPC {
+	var request GenerateCSRRequest
+	if resp := validateActionRequest(ctx, req.RequestBody, managerAction{request: &request, required: "CommonName"}, nil); resp != nil {
+		return *resp
+	}
+	if request.CertificateCollection == nil || request.CertificateCollection.Oid == "" {
+		errorMessage := "'CertificateCollection' parameter cannot be empty"
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errorMessage, []interface{}{"CertificateCollection"}, nil)
+	}
+	if certificate := findODIMRACertificate(request.CertificateCollection.Oid); certificate != nil {
+		return e.generateODIMRACSR(ctx, certificate, request)
+	}
+	return e.deviceCertificateAction(ctx, request.CertificateCollection.Oid, generateCSRURI, req.RequestBody)
+}
+
+// ReplaceCertificate performs the CertificateService.ReplaceCertificate action. The certificates of odimra
+// are saved in the DB, from which they are loaded by all the services without a restart,
+// the action is sent to the device through its plugin for the certificates of the devices.
+func (e *ExternalInterface) ReplaceCertificate(ctx context.Context, req *managersproto.ManagerActionRequest, userName string) response.RPC {
+	var request ReplaceCertificateRequest
+	if resp := validateActionRequest(ctx, req.RequestBody, managerAction{request: &request, required: "CertificateString"}, nil); resp != nil {
+		return *resp
+	}
+	if request.CertificateURI == nil || request.CertificateURI.Oid == "" {
+		errorMessage := "'CertificateUri' parameter cannot be empty"
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyMissing, errorMessage, []interface{}{"CertificateUri"}, nil)
+	}
+	if certificate := findODIMRACertificate(request.CertificateURI.Oid); certificate != nil {
+		return e.replaceODIMRACertificate(ctx, certificate, request, userName)
+	}
+	resp := e.deviceCertificateAction(ctx, request.CertificateURI.Oid, replaceCertificateURI, req.RequestBody)
+	if resp.StatusCode < http.StatusMultipleChoices {
+		logs.Log(ctx).Info("audit: user " + userName + " replaced the certificate " + request.CertificateURI.Oid)
+	}
+	return resp
+}
+
+// getODIMRACertificate returns the collection or the certificate of odimra the URI is of
+func (e *ExternalInterface) getODIMRACertificate(ctx context.Context, certificate *odimraCertificate, uri string) response.RPC {
+	uri = strings.TrimSuffix(strings.SplitN(uri, "?", 2)[0], "/")
+	if uri == certificate.collectionURI {
+		return response.RPC{
+			StatusCode:    http.StatusOK,
+			StatusMessage: response.Success,
+			Header:        certificateResponseHeader(),
+			Body: mgrresponse.CertificateCollection{
+				OdataContext: "/redfish/v1/$metadata#CertificateCollection.CertificateCollection",
+				OdataID:      certificate.collectionURI,
+				OdataType:    "#CertificateCollection.CertificateCollection",
+				Name:         certificate.name + " certificates",
+				Members:      []dmtf.Link{{Oid: certificate.memberURI()}},
+				MembersCount: 1,
+			},
+		}
+	}
+
+	certificatePEM, _, err := e.getKeyPair(certificate)
+	if err != nil {
+		errorMessage := "unable to read the " + certificate.name + " certificate: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	chain, err := parseCertificateChain(certificatePEM)
+	if err != nil {
+		errorMessage := "unable to parse the " + certificate.name + " certificate: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	leaf := chain[0]
+	certificateType := "PEM"
+	if len(chain) > 1 {
+		certificateType = "PEMchain"
+	}
+	fingerprint := sha256.Sum256(leaf.Raw)
+	return response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Header:        certificateResponseHeader(),
+		Body: mgrresponse.Certificate{
+			OdataContext:             "/redfish/v1/$metadata#Certificate.Certificate",
+			OdataID:                  certificate.memberURI(),
+			OdataType:                "#Certificate.v1_2_4.Certificate",
+			ID:                       "1",
+			Name:                     certificate.name + " certificate",
+			CertificateString:        string(certificatePEM),
+			CertificateType:          certificateType,
+			Issuer:                   certificateIdentifier(leaf.Issuer),
+			Subject:                  certificateIdentifier(leaf.Subject),
+			ValidNotBefore:           leaf.NotBefore.UTC().Format(time.RFC3339),
+			ValidNotAfter:            leaf.NotAfter.UTC().Format(time.RFC3339),
+			SerialNumber:             leaf.SerialNumber.Text(16),
+			Fingerprint:              hex.EncodeToString(fingerprint[:]),
+			FingerprintHashAlgorithm: "TPM_ALG_SHA256",
+		},
+	}
+}
+
+// generateODIMRACSR generates a private key and the CSR of a certificate of odimra, the private key
+// is saved in the DB, and is used once the certificate signed from the CSR is given
+func (e *ExternalInterface) generateODIMRACSR(ctx context.Context, certificate *odimraCertificate, request GenerateCSRRequest) response.RPC {
+	if request.KeyPairAlgorithm != "" && request.KeyPairAlgorithm != keyPairAlgorithmRSA {
+		errorMessage := "KeyPairAlgorithm must be " + keyPairAlgorithmRSA + " for the certificates of odimra"
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueNotInList, errorMessage, []interface{}{request.KeyPairAlgorithm, "KeyPairAlgorithm"}, nil)
+	}
+	keyBitLength := request.KeyBitLength
+	if keyBitLength == 0 {
+		keyBitLength = defaultKeyBitLength
+	}
+	if keyBitLength != 2048 && keyBitLength != 3072 && keyBitLength != 4096 {
+		errorMessage := "KeyBitLength must be 2048, 3072 or 4096 for the certificates of odimra"
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueNotInList, errorMessage, []interface{}{keyBitLength, "KeyBitLength"}, nil)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, keyBitLength)
+	if err != nil {
+		errorMessage := "unable to generate the private key: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	template := x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:         request.CommonName,
+			Organization:       nonEmpty(request.Organization),
+			OrganizationalUnit: nonEmpty(request.OrganizationalUnit),
+			Locality:           nonEmpty(request.City),
+			Province:           nonEmpty(request.State),
+			Country:            nonEmpty(request.Country),
+		},
+		EmailAddresses: nonEmpty(request.Email),
+	}
+	for _, name := range request.AlternativeNames {
+		if ip := net.ParseIP(name); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, name)
+		}
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &template, key)
+	if err != nil {
+		errorMessage := "unable to create the CSR: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, errorMessage, []interface{}{request.CommonName, "CommonName"}, nil)
+	}
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := e.DB.SaveKeyPair(certificate.pendingKeyPair(), nil, privateKeyPEM); err != nil {
+		errorMessage := "unable to save the private key of the CSR: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+
+	return response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Header:        certificateResponseHeader(),
+		Body: map[string]interface{}{
+			"CSRString":             string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+			"CertificateCollection": dmtf.Link{Oid: certificate.collectionURI},
+		},
+	}
+}
+
+// replaceODIMRACertificate replaces a certificate of odimra, the certificate must be signed by the
+// CA of odimra and be either of the private key generated with the last CSR or of the private key in use
+func (e *ExternalInterface) replaceODIMRACertificate(ctx context.Context, certificate *odimraCertificate, request ReplaceCertificateRequest, userName string) response.RPC {
+	if request.CertificateType != "" && request.CertificateType != "PEM" && request.CertificateType != "PEMchain" {
+		errorMessage := "CertificateType must be PEM or PEMchain for the certificates of odimra"
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueNotInList, errorMessage, []interface{}{request.CertificateType, "CertificateType"}, nil)
+	}
+	chain, err := parseCertificateChain([]byte(request.CertificateString))
+	if err != nil {
+		errorMessage := "unable to parse the certificate: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, errorMessage, []interface{}{"CertificateString", "CertificateString"}, nil)
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(config.Data.KeyCertConf.RootCACertificate)
+	intermediates := x509.NewCertPool()
+	var certificatePEM []byte
+	for i, cert := range chain {
+		if i > 0 {
+			intermediates.AddCert(cert)
+		}
+		certificatePEM = append(certificatePEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	_, err = chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		errorMessage := "the certificate is not signed by the CA of odimra: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, errorMessage, []interface{}{"CertificateString", "CertificateString"}, nil)
+	}
+
+	_, privateKeyPEM, gerr := e.DB.GetKeyPair(certificate.pendingKeyPair())
+	pendingKey := gerr == nil
+	if _, err := tls.X509KeyPair(certificatePEM, privateKeyPEM); !pendingKey || err != nil {
+		pendingKey = false
+		_, privateKeyPEM, err = e.getKeyPair(certificate)
+		if err != nil {
+			errorMessage := "unable to read the private key of the " + certificate.name + " certificate: " + err.Error()
+			logs.Log(ctx).Error(errorMessage)
+			return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+		}
+		if _, err := tls.X509KeyPair(certificatePEM, privateKeyPEM); err != nil {
+			errorMessage := "the certificate is neither of the private key of the last CSR nor of the private key in use: " + err.Error()
+			logs.Log(ctx).Error(errorMessage)
+			return common.GeneralError(http.StatusBadRequest, response.PropertyValueFormatError, errorMessage, []interface{}{"CertificateString", "CertificateString"}, nil)
+		}
+	}
+
+	// the certificate and its private key are saved together, each of the services
+	// loads the key pair from the DB with common.WatchKeyPair
+	if err := e.DB.SaveKeyPair(certificate.keyPair, certificatePEM, privateKeyPEM); err != nil {
+		errorMessage := "unable to save the " + certificate.name + " certificate: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	if pendingKey {
+		if err := e.DB.DeleteKeyPair(certificate.pendingKeyPair()); err != nil {
+			logs.Log(ctx).Warn("unable to delete the private key of the CSR of the " + certificate.name + " certificate: " + err.Error())
+		}
+	}
+	logs.Log(ctx).Info("audit: user " + userName + " replaced the " + certificate.name + " certificate of odimra")
+	return e.getODIMRACertificate(ctx, certificate, certificate.memberURI())
+}
+
+// deviceCertificateAction sends an action of the certificate service to the plugin of the device
+// the certificate belongs to, the uuid of the device is removed from the URIs of the request
+// and added to the URIs of the response
+func (e *ExternalInterface) deviceCertificateAction(ctx context.Context, certificateURI, actionURI string, requestBody []byte) response.RPC {
+	match := deviceResourceURI.FindStringSubmatch(certificateURI)
+	if match == nil {
+		errorMessage := "error: the certificate " + certificateURI + " doesn't belong to any device"
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"Certificates", certificateURI}, nil)
+	}
+	uuid := match[2]
+	ctx = logs.With(ctx, logs.SystemIDField, uuid)
+	target, gerr := e.DB.GetTarget(uuid)
+	if gerr != nil {
+		errorMessage := "unable to get the target of the certificate: " + gerr.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"Certificates", certificateURI}, nil)
+	}
+	decryptedPasswordByte, err := e.Device.DecryptDevicePassword(target.Password)
+	if err != nil {
+		errorMessage := "error while trying to decrypt device password: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	ctx = logs.With(ctx, logs.PluginIDField, target.PluginID)
+	plugin, gerr := e.DB.GetPluginData(target.PluginID)
+	if gerr != nil {
+		errorMessage := "unable to get plugin details: " + gerr.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+
+	var contactRequest mgrcommon.PluginContactRequest
+	contactRequest.ContactClient = e.Device.ContactClient
+	contactRequest.Plugin = plugin
+	contactRequest.HTTPMethodType = http.MethodPost
+	contactRequest.DeviceInfo = map[string]interface{}{
+		"ManagerAddress": target.ManagerAddress,
+		"UserName":       target.UserName,
+		"Password":       decryptedPasswordByte,
+		"PostBody":       []byte(strings.Replace(string(requestBody), "/"+uuid+":", "/", -1)),
+	}
+	contactRequest.OID = actionURI
+	body, _, status, err := mgrcommon.ContactPlugin(ctx, contactRequest, "error while performing "+actionURI+" on "+certificateURI+": ")
+	resp := response.RPC{
+		StatusCode:    status.StatusCode,
+		StatusMessage: status.StatusMessage,
+		Header:        certificateResponseHeader(),
+	}
+	if err != nil {
+		json.Unmarshal(body, &resp.Body)
+		return resp
+	}
+	resp.StatusMessage = response.Success
+	var respBody map[string]interface{}
+	if len(body) == 0 || json.Unmarshal([]byte(mgrcommon.TranslateDeviceURIs(string(body), uuid)), &respBody) != nil {
+		var commonResponse response.Response
+		commonResponse.CreateGenericResponse(resp.StatusMessage)
+		resp.Body = commonResponse
+	} else {
+		resp.Body = respBody
+	}
+	return resp
+}
+
+func certificateResponseHeader() map[string]string {
+	return map[string]string{
+		"Cache-Control":     "no-cache",
+		"Connection":        "keep-alive",
+		"Content-type":      "application/json; charset=utf-8",
+		"Transfer-Encoding": "chunked",
+		"OData-Version":     "4.0",
+	}
+}
+
+// parseCertificateChain returns the certificates of the PEM, the first one being the certificate itself
+func parseCertificateChain(certificatePEM []byte) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, certificatePEM = pem.Decode(certificatePEM)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, certificate)
+	}
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no certificate found")
+	}
+	return chain, nil
+}
+
+func certificateIdentifier(name pkix.Name) mgrresponse.CertificateIdentifier {
+	first := func(values []string) string {
+		if len(values) == 0 {
+			return ""
+		}
+		return values[0]
+	}
+	return mgrresponse.CertificateIdentifier{
+		CommonName:         name.CommonName,
+		Organization:       first(name.Organization),
+		OrganizationalUnit: first(name.OrganizationalUnit),
+		City:               first(name.Locality),
+		State:              first(name.Province),
+		Country:            first(name.Country),
+	}
+}
+
+func nonEmpty(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
@@ -0,0 +1,231 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package managers
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrcommon"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrresponse"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockCA signs the certificates of the tests
+type mockCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	pem         []byte
+}
+
+func newMockCA(t *testing.T) *mockCA {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "mock CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error while creating the CA: %v", err)
+	}
+	certificate, _ := x509.ParseCertificate(der)
+	return &mockCA{
+		certificate: certificate,
+		key:         key,
+		pem:         pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// sign returns the certificate of the public key signed by the CA
+func (ca *mockCA) sign(t *testing.T, commonName string, publicKey interface{}) string {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, publicKey, ca.key)
+	if err != nil {
+		t.Fatalf("error while signing the certificate: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func mockCertificateContactClient(url, method, token string, odataID string, body interface{}, loginCredential map[string]string) (*http.Response, error) {
+	if url == "https://localhost:9093/ODIM/v1/CertificateService/Actions/CertificateService.GenerateCSR" {
+		postBody := body.(map[string]interface{})["PostBody"].([]byte)
+		if !bytes.Contains(postBody, []byte(`"/redfish/v1/Managers/1/NetworkProtocol/HTTPS/Certificates"`)) {
+			return nil, fmt.Errorf("uuid of the device should be removed from the request")
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{"CSRString":"deviceCSR",` +
+				`"CertificateCollection":{"@odata.id":"/ODIM/v1/Managers/1/NetworkProtocol/HTTPS/Certificates"}}`)),
+		}, nil
+	}
+	return nil, fmt.Errorf("InvalidRequest")
+}
+
+func TestGetCertificateLocations(t *testing.T) {
+	config.SetUpMockConfig(t)
+	e := mockGetExternalInterface()
+	e.DB.GetAllKeysFromTable = func(table string) ([]string, error) {
+		return []string{"/redfish/v1/Managers/" + config.Data.RootServiceUUID, "/redfish/v1/Managers/uuid:1", "/redfish/v1/Managers/uuid:2"}, nil
+	}
+	e.Device.GetDeviceInfo = func(ctx context.Context, req mgrcommon.ResourceInfoRequest) (string, error) {
+		if req.UUID != "uuid" || req.URL != certificateLocationsURI {
+			return "", fmt.Errorf("error")
+		}
+		return `{"Links":{"Certificates":[{"@odata.id":"/redfish/v1/Managers/uuid:1/NetworkProtocol/HTTPS/Certificates/1"}]}}`, nil
+	}
+
+	resp := e.GetCertificateService(context.Background(), &managersproto.ManagerRequest{})
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	service := resp.Body.(mgrresponse.CertificateService)
+	assert.Equal(t, replaceCertificateURI, service.Actions.ReplaceCertificate.Target, "action target should be returned")
+
+	resp = e.GetCertificateLocations(context.Background(), &managersproto.ManagerRequest{})
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	locations := resp.Body.(mgrresponse.CertificateLocations)
+	assert.Equal(t, 3, locations.Links.CertificatesCount, "certificates of odimra and of the device should be listed once")
+	var uris []string
+	for _, link := range locations.Links.Certificates {
+		uris = append(uris, link.Oid)
+	}
+	assert.Contains(t, uris, "/redfish/v1/Managers/uuid:1/NetworkProtocol/HTTPS/Certificates/1", "certificate of the device should be listed")
+	assert.Contains(t, uris, "/redfish/v1/Managers/"+config.Data.RootServiceUUID+"/Oem/ODIM/RPC/Certificates/1", "RPC certificate should be listed")
+}
+
+func TestReplaceODIMRACertificate(t *testing.T) {
+	config.SetUpMockConfig(t)
+	dir, err := ioutil.TempDir("", "certificates")
+	if err != nil {
+		t.Fatalf("error while creating the directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	ca := newMockCA(t)
+	config.Data.KeyCertConf.RootCACertificate = ca.pem
+	config.Data.APIGatewayConf.CertificatePath = filepath.Join(dir, "server.crt")
+	config.Data.APIGatewayConf.PrivateKeyPath = filepath.Join(dir, "server.key")
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	ioutil.WriteFile(config.Data.APIGatewayConf.PrivateKeyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+	ioutil.WriteFile(config.Data.APIGatewayConf.CertificatePath, []byte(ca.sign(t, "odimra", &key.PublicKey)), 0644)
+	collectionURI := "/redfish/v1/Managers/" + config.Data.RootServiceUUID + "/NetworkProtocol/HTTPS/Certificates"
+	e := mockGetExternalInterface()
+
+	resp := e.GetManagersResource(context.Background(), &managersproto.ManagerRequest{ManagerID: config.Data.RootServiceUUID, URL: collectionURI + "/1"})
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	assert.Equal(t, "odimra", resp.Body.(mgrresponse.Certificate).Subject.CommonName, "certificate in use should be returned")
+
+	resp = e.GenerateCSR(context.Background(), &managersproto.ManagerActionRequest{
+		RequestBody: []byte(`{"CertificateCollection":{"@odata.id":"` + collectionURI + `"},"CommonName":"odimra.example.com",` +
+			`"AlternativeNames":["odimra.example.com","10.0.0.1"],"Organization":"ODIM"}`),
+	})
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	block, _ := pem.Decode([]byte(resp.Body.(map[string]interface{})["CSRString"].(string)))
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatalf("error while parsing the CSR: %v", err)
+	}
+	assert.Equal(t, "odimra.example.com", csr.Subject.CommonName, "CSR should be of the common name")
+	assert.Equal(t, 1, len(csr.IPAddresses), "IP address should be an alternative name")
+
+	// a certificate of another CA is refused
+	resp = e.ReplaceCertificate(context.Background(), &managersproto.ManagerActionRequest{
+		RequestBody: []byte(`{"CertificateUri":{"@odata.id":"` + collectionURI + `/1"},"CertificateType":"PEM",` +
+			`"CertificateString":` + jsonString(newMockCA(t).sign(t, "odimra.example.com", csr.PublicKey)) + `}`),
+	}, "admin")
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be StatusBadRequest.")
+
+	signed := ca.sign(t, "odimra.example.com", csr.PublicKey)
+	resp = e.ReplaceCertificate(context.Background(), &managersproto.ManagerActionRequest{
+		RequestBody: []byte(`{"CertificateUri":{"@odata.id":"` + collectionURI + `/1"},"CertificateType":"PEM",` +
+			`"CertificateString":` + jsonString(signed) + `}`),
+	}, "admin")
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	assert.Equal(t, "odimra.example.com", resp.Body.(mgrresponse.Certificate).Subject.CommonName, "replaced certificate should be returned")
+	certificatePEM, privateKeyPEM, gerr := e.DB.GetKeyPair(common.APIGatewayKeyPair)
+	if gerr != nil {
+		t.Fatalf("error while reading the key pair: %v", gerr)
+	}
+	assert.Equal(t, signed, string(certificatePEM), "certificate should be saved")
+	if _, err := tls.X509KeyPair(certificatePEM, privateKeyPEM); err != nil {
+		t.Errorf("private key of the CSR should be saved with the certificate: %v", err)
+	}
+	_, _, gerr = e.DB.GetKeyPair(common.APIGatewayKeyPair + ":pending")
+	assert.NotNil(t, gerr, "private key of the CSR should be in use")
+	fileCertificatePEM, _ := ioutil.ReadFile(config.Data.APIGatewayConf.CertificatePath)
+	assert.NotEqual(t, signed, string(fileCertificatePEM), "certificate files should not be written")
+
+	// the certificate in use is the one saved in the DB
+	resp = e.GetManagersResource(context.Background(), &managersproto.ManagerRequest{ManagerID: config.Data.RootServiceUUID, URL: collectionURI + "/1"})
+	assert.Equal(t, "odimra.example.com", resp.Body.(mgrresponse.Certificate).Subject.CommonName, "replaced certificate should be in use")
+}
+
+func TestGenerateDeviceCSR(t *testing.T) {
+	config.SetUpMockConfig(t)
+	e := mockActionExternalInterface(&mockInventory{saved: map[string]string{}})
+	e.Device.ContactClient = mockCertificateContactClient
+
+	resp := e.GenerateCSR(context.Background(), &managersproto.ManagerActionRequest{
+		RequestBody: []byte(`{"CertificateCollection":{"@odata.id":"/redfish/v1/Managers/uuid:1/NetworkProtocol/HTTPS/Certificates"},"CommonName":"bmc"}`),
+	})
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	body := resp.Body.(map[string]interface{})
+	assert.Equal(t, "deviceCSR", body["CSRString"], "CSR of the device should be returned")
+	collection := body["CertificateCollection"].(map[string]interface{})
+	assert.Equal(t, "/redfish/v1/Managers/uuid:1/NetworkProtocol/HTTPS/Certificates", collection["@odata.id"], "uuid of the device should be added to the response")
+
+	resp = e.GenerateCSR(context.Background(), &managersproto.ManagerActionRequest{
+		RequestBody: []byte(`{"CertificateCollection":{"@odata.id":"/redfish/v1/Managers/unknown/Certificates"},"CommonName":"bmc"}`),
+	})
+	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status code should be StatusNotFound.")
+
+	resp = e.GenerateCSR(context.Background(), &managersproto.ManagerActionRequest{
+		RequestBody: []byte(`{"CommonName":"bmc"}`),
+	})
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be StatusBadRequest.")
+}
+
+func jsonString(value string) string {
+	data, _ := json.Marshal(value)
+	return strings.TrimSpace(string(data))
+}
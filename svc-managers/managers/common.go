@@ -15,10 +15,14 @@
 package managers
 
 import (
+	"context"
+
 	"github.com/ODIM-Project/ODIM/lib-rest-client/pmbhandle"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-managers/mgrcommon"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrmessagebus"
 	"github.com/ODIM-Project/ODIM/svc-managers/mgrmodel"
 	"net/http"
 )
@@ -27,38 +31,101 @@ import (
 type ExternalInterface struct {
 	Device Device
 	DB     DB
+	Task   Task
+	// PublishEvent publishes the event of a resource of the collection to the message bus
+	PublishEvent func(common.Event, string)
 }
 
 // Device struct to inject the contact device function into the handlers
 type Device struct {
-	GetDeviceInfo         func(mgrcommon.ResourceInfoRequest) (string, error)
+	GetDeviceInfo         func(context.Context, mgrcommon.ResourceInfoRequest) (string, error)
 	ContactClient         func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
 	DecryptDevicePassword func([]byte) ([]byte, error)
+	// GetEndpointCertificates returns the certificate chains presented by the devices to a plugin
+	GetEndpointCertificates func(mgrmodel.Plugin, func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)) ([]common.EndpointCertificate, error)
 }
 
 // DB struct to inject the contact DB function into the handlers
 type DB struct {
 	GetAllKeysFromTable func(string) ([]string, error)
 	GetManagerByURL     func(string) (string, *errors.Error)
+	GetManagersByURL    func([]string) (map[string]string, *errors.Error)
 	GetPluginData       func(string) (mgrmodel.Plugin, *errors.Error)
 	UpdateManagersData  func(string, map[string]interface{}) error
 	GetResource         func(string, string) (string, *errors.Error)
+	GetTarget           func(string) (*mgrmodel.DeviceTarget, *errors.Error)
+	SaveResource        func([]byte, string, string) error
+	SaveConsoleSession  func(string, mgrmodel.ConsoleSession) *errors.Error
+	TakeConsoleSession  func(string) (*mgrmodel.ConsoleSession, *errors.Error)
+	GetAllPlugins       func() ([]mgrmodel.Plugin, *errors.Error)
+	GetAllTargets       func() ([]mgrmodel.DeviceTarget, *errors.Error)
+	// the certificate chains presented by the endpoints and the alerts raised for their expiry
+	GetEndpointCertificates      func() ([]common.EndpointCertificate, error)
+	SaveEndpointCertificate      func(common.EndpointCertificate) error
+	DeleteEndpointCertificate    func(string) error
+	GetCertificateExpiryAlert    func(string) (*mgrmodel.CertificateExpiryAlert, *errors.Error)
+	SaveCertificateExpiryAlert   func(string, mgrmodel.CertificateExpiryAlert) *errors.Error
+	DeleteCertificateExpiryAlert func(string) *errors.Error
+	// the key pairs of odimra replaced with the certificate service
+	GetKeyPair    func(string) ([]byte, []byte, *errors.Error)
+	SaveKeyPair   func(string, []byte, []byte) error
+	DeleteKeyPair func(string) error
+}
+
+// Task struct to inject the task service functions into the handlers,
+// the functions are set by the service as they need the service to be initialized
+type Task struct {
+	CreateTask         func(string) (string, error)
+	UpdateTask         func(common.TaskData) error
+	GetSessionUserName func(string) (string, error)
 }
 
 // GetExternalInterface retrieves all the external connections managers package functions uses
 func GetExternalInterface() *ExternalInterface {
 	return &ExternalInterface{
 		Device: Device{
-			GetDeviceInfo:         mgrcommon.GetResourceInfoFromDevice,
-			ContactClient:         pmbhandle.ContactPlugin,
-			DecryptDevicePassword: common.DecryptWithPrivateKey,
+			GetDeviceInfo:           mgrcommon.GetResourceInfoFromDevice,
+			ContactClient:           pmbhandle.ContactPlugin,
+			DecryptDevicePassword:   common.DecryptWithPrivateKey,
+			GetEndpointCertificates: mgrcommon.GetPluginEndpointCertificates,
 		},
 		DB: DB{
 			GetAllKeysFromTable: mgrmodel.GetAllKeysFromTable,
 			GetManagerByURL:     mgrmodel.GetManagerByURL,
+			GetManagersByURL:    mgrmodel.GetManagersByURL,
 			GetPluginData:       mgrmodel.GetPluginData,
 			UpdateManagersData:  mgrmodel.UpdateManagersData,
 			GetResource:         mgrmodel.GetResource,
+			GetTarget:           mgrmodel.GetTarget,
+			SaveResource:        mgrmodel.SaveResource,
+			SaveConsoleSession:  mgrmodel.SaveConsoleSession,
+			TakeConsoleSession:  mgrmodel.TakeConsoleSession,
+			GetAllPlugins:       mgrmodel.GetAllPlugins,
+			GetAllTargets:       mgrmodel.GetAllTargets,
+
+			GetEndpointCertificates:      common.GetEndpointCertificates,
+			SaveEndpointCertificate:      common.SaveEndpointCertificate,
+			DeleteEndpointCertificate:    common.DeleteEndpointCertificate,
+			GetCertificateExpiryAlert:    mgrmodel.GetCertificateExpiryAlert,
+			SaveCertificateExpiryAlert:   mgrmodel.SaveCertificateExpiryAlert,
+			DeleteCertificateExpiryAlert: mgrmodel.DeleteCertificateExpiryAlert,
+			GetKeyPair:                   common.GetKeyPair,
+			SaveKeyPair:                  common.SaveKeyPair,
+			DeleteKeyPair:                common.DeleteKeyPair,
 		},
+		PublishEvent: mgrmessagebus.Publish,
+	}
+}
+
+func fillTaskData(taskID, targetURI, request string, resp response.RPC, taskState string, taskStatus string, percentComplete int32, httpMethod string) common.TaskData {
+	return common.TaskData{
+		TaskID:          taskID,
+		TargetURI:       targetURI,
+		TaskRequest:     request,
+		Response:        resp,
+		TaskState:       taskState,
+		TaskStatus:      taskStatus,
+		PercentComplete: percentComplete,
+		HTTPMethod:      httpMethod,
 	}
 }
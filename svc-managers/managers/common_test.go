@@ -16,6 +16,7 @@ package managers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
@@ -46,6 +47,7 @@ func TestGetExternalInterface(t *testing.T) {
 }
 
 func mockGetExternalInterface() *ExternalInterface {
+	keyPairs := make(map[string][2][]byte)
 	return &ExternalInterface{
 		Device: Device{
 			GetDeviceInfo: mockGetDeviceInfo,
@@ -54,9 +56,25 @@ func mockGetExternalInterface() *ExternalInterface {
 		DB: DB{
 			GetAllKeysFromTable: mockGetAllKeysFromTable,
 			GetManagerByURL:     mockGetManagerByURL,
+			GetManagersByURL:    common.EachMember(mockGetManagerByURL),
 			GetPluginData:       mockGetPluginData,
 			UpdateManagersData:  mockUpdateManagersData,
 			GetResource:         mockGetResource,
+			GetKeyPair: func(name string) ([]byte, []byte, *errors.Error) {
+				keyPair, exists := keyPairs[name]
+				if !exists {
+					return nil, nil, errors.PackError(errors.DBKeyNotFound, "no key pair ", name)
+				}
+				return keyPair[0], keyPair[1], nil
+			},
+			SaveKeyPair: func(name string, certificatePEM, privateKeyPEM []byte) error {
+				keyPairs[name] = [2][]byte{certificatePEM, privateKeyPEM}
+				return nil
+			},
+			DeleteKeyPair: func(name string) error {
+				delete(keyPairs, name)
+				return nil
+			},
 		},
 	}
 }
@@ -141,7 +159,7 @@ func mockGetResource(table, key string) (string, *errors.Error) {
 	return "body", nil
 }
 
-func mockGetDeviceInfo(req mgrcommon.ResourceInfoRequest) (string, error) {
+func mockGetDeviceInfo(ctx context.Context, req mgrcommon.ResourceInfoRequest) (string, error) {
 	if req.URL == "/redfish/v1/Managers/deviceAbsent:1" || req.URL == "/redfish/v1/Managers/uuid1:1/Ethernet" {
 		return "", fmt.Errorf("error")
 	}
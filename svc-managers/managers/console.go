@@ -0,0 +1,205 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package managers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrcommon"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrmodel"
+)
+
+// Console types which can be brokered with the devices
+const (
+	SerialConsole    = "SerialConsole"
+	GraphicalConsole = "GraphicalConsole"
+)
+
+// ConsoleSessionRequest is the request body of the creation of a console session
+type ConsoleSessionRequest struct {
+	ConsoleType string `json:"ConsoleType"`
+}
+
+// consoleSessionsURI returns the URI of the console sessions of a manager
+func consoleSessionsURI(managerID string) string {
+	return "/redfish/v1/Managers/" + managerID + "/Oem/ODIM/ConsoleSessions"
+}
+
+// CreateConsoleSession creates a console session with the plugin managing the device of the manager.
+// The returned URI is a WebSocket of the API service relaying the console, it is authorized by the
+// token of the console session and must be connected once within the validity of the token.
+func (e *ExternalInterface) CreateConsoleSession(ctx context.Context, req *managersproto.ManagerActionRequest, userName string) response.RPC {
+	var request ConsoleSessionRequest
+	if resp := validateActionRequest(ctx, req.RequestBody, managerAction{request: &request, required: "ConsoleType"}, nil); resp != nil {
+		return *resp
+	}
+	if request.ConsoleType != SerialConsole && request.ConsoleType != GraphicalConsole {
+		errorMessage := "ConsoleType must be " + SerialConsole + " or " + GraphicalConsole
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueNotInList, errorMessage, []interface{}{request.ConsoleType, "ConsoleType"}, nil)
+	}
+
+	managerData, gerr := e.DB.GetManagerByURL("/redfish/v1/Managers/" + req.ManagerID)
+	if gerr != nil {
+		errorMessage := "unable to get the manager: " + gerr.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"Managers", req.ManagerID}, nil)
+	}
+	// the console is refused only when the manager reports it as disabled,
+	// as many managers don't report their consoles
+	var manager map[string]interface{}
+	json.Unmarshal([]byte(managerData), &manager)
+	if console, ok := manager[request.ConsoleType].(map[string]interface{}); ok && console["ServiceEnabled"] == false {
+		errorMessage := "the " + request.ConsoleType + " of the manager " + req.ManagerID + " is disabled"
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.PropertyValueNotInList, errorMessage, []interface{}{request.ConsoleType, "ConsoleType"}, nil)
+	}
+
+	requestData := strings.SplitN(req.ManagerID, ":", 2)
+	if len(requestData) <= 1 {
+		errorMessage := "error: the console is not supported on the manager " + req.ManagerID
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"Managers", req.ManagerID}, nil)
+	}
+	uuid, managerID := requestData[0], requestData[1]
+	ctx = logs.With(ctx, logs.SystemIDField, uuid)
+	target, gerr := e.DB.GetTarget(uuid)
+	if gerr != nil {
+		errorMessage := "unable to get the target of the manager: " + gerr.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"Managers", req.ManagerID}, nil)
+	}
+	decryptedPasswordByte, err := e.Device.DecryptDevicePassword(target.Password)
+	if err != nil {
+		errorMessage := "error while trying to decrypt device password: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	ctx = logs.With(ctx, logs.PluginIDField, target.PluginID)
+	plugin, gerr := e.DB.GetPluginData(target.PluginID)
+	if gerr != nil {
+		errorMessage := "unable to get plugin details: " + gerr.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+
+	var contactRequest mgrcommon.PluginContactRequest
+	contactRequest.ContactClient = e.Device.ContactClient
+	contactRequest.Plugin = plugin
+	contactRequest.HTTPMethodType = http.MethodPost
+	contactRequest.DeviceInfo = map[string]interface{}{
+		"ManagerAddress": target.ManagerAddress,
+		"UserName":       target.UserName,
+		"Password":       decryptedPasswordByte,
+		"PostBody":       req.RequestBody,
+	}
+	contactRequest.OID = consoleSessionsURI(managerID)
+	body, _, status, err := mgrcommon.ContactPlugin(ctx, contactRequest, "error while creating the console session of "+req.ManagerID+": ")
+	if err != nil {
+		resp := response.RPC{
+			StatusCode:    status.StatusCode,
+			StatusMessage: status.StatusMessage,
+			Header:        map[string]string{"Content-type": "application/json; charset=utf-8"},
+		}
+		json.Unmarshal(body, &resp.Body)
+		return resp
+	}
+	var pluginSession struct {
+		Token     string
+		ExpiresAt time.Time
+	}
+	if err := json.Unmarshal(body, &pluginSession); err != nil || pluginSession.Token == "" {
+		errorMessage := "unable to read the console session created by the plugin: " + string(body)
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+
+	token, err := newConsoleToken()
+	if err != nil {
+		errorMessage := "unable to create the console session token: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	consoleURI := "/redfish/v1/Console/" + pluginSession.Token
+	for key, value := range config.Data.URLTranslation.SouthBoundURL {
+		consoleURI = strings.Replace(consoleURI, key, value, -1)
+	}
+	session := mgrmodel.ConsoleSession{
+		ManagerID:    req.ManagerID,
+		ConsoleType:  request.ConsoleType,
+		UserName:     userName,
+		SessionToken: req.SessionToken,
+		ConsoleURL:   "wss://" + plugin.IP + ":" + plugin.Port + consoleURI,
+		ExpiresAt:    pluginSession.ExpiresAt,
+	}
+	if gerr := e.DB.SaveConsoleSession(token, session); gerr != nil {
+		errorMessage := "unable to save the console session: " + gerr.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	logs.Log(ctx).Info("audit: user " + userName + " created a " + request.ConsoleType + " session on the manager " + req.ManagerID)
+
+	sessionURI := consoleSessionsURI(req.ManagerID) + "/" + token
+	return response.RPC{
+		StatusCode:    http.StatusCreated,
+		StatusMessage: response.Created,
+		Header: map[string]string{
+			"Content-type":  "application/json; charset=utf-8",
+			"Location":      sessionURI,
+			"OData-Version": "4.0",
+		},
+		Body: map[string]interface{}{
+			"Id":          token,
+			"ConsoleType": request.ConsoleType,
+			"ConsoleURI":  sessionURI,
+			"ExpiresAt":   session.ExpiresAt.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+// ConnectConsoleSession takes the console session for its connection, the console session
+// is returned only when it belongs to the manager and has not expired
+func (e *ExternalInterface) ConnectConsoleSession(ctx context.Context, req *managersproto.ConsoleSessionRequest) (*mgrmodel.ConsoleSession, response.RPC) {
+	session, gerr := e.DB.TakeConsoleSession(req.Token)
+	if gerr != nil || session.ManagerID != req.ManagerID || time.Now().After(session.ExpiresAt) {
+		errorMessage := "the console session " + req.Token + " of the manager " + req.ManagerID + " is not valid or has expired"
+		logs.Log(ctx).Error(errorMessage)
+		return nil, common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"ConsoleSessions", req.Token}, nil)
+	}
+	return session, response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+	}
+}
+
+// newConsoleToken returns a random token for a console session
+func newConsoleToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
@@ -0,0 +1,111 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package managers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
+	"github.com/ODIM-Project/ODIM/svc-managers/mgrmodel"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockConsoleContactClient(url, method, token string, odataID string, body interface{}, loginCredential map[string]string) (*http.Response, error) {
+	if url == "https://localhost:9093/ODIM/v1/Managers/1/Oem/ODIM/ConsoleSessions" {
+		expiresAt := time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"Token":"pluginToken","ExpiresAt":"` + expiresAt + `"}`)),
+		}, nil
+	}
+	return nil, fmt.Errorf("InvalidRequest")
+}
+
+type mockConsoleSessions map[string]mgrmodel.ConsoleSession
+
+func (m mockConsoleSessions) save(token string, session mgrmodel.ConsoleSession) *errors.Error {
+	m[token] = session
+	return nil
+}
+
+func (m mockConsoleSessions) take(token string) (*mgrmodel.ConsoleSession, *errors.Error) {
+	session, ok := m[token]
+	if !ok {
+		return nil, errors.PackError(errors.DBKeyNotFound, "not found")
+	}
+	delete(m, token)
+	return &session, nil
+}
+
+func TestConsoleSession(t *testing.T) {
+	config.SetUpMockConfig(t)
+	sessions := mockConsoleSessions{}
+	e := mockActionExternalInterface(&mockInventory{saved: map[string]string{}})
+	e.Device.ContactClient = mockConsoleContactClient
+	e.DB.SaveConsoleSession = sessions.save
+	e.DB.TakeConsoleSession = sessions.take
+
+	resp := e.CreateConsoleSession(context.Background(), &managersproto.ManagerActionRequest{
+		SessionToken: "token",
+		ManagerID:    "uuid:1",
+		URL:          "/redfish/v1/Managers/uuid:1/Oem/ODIM/ConsoleSessions",
+		RequestBody:  []byte(`{"ConsoleType":"SerialConsole"}`),
+	}, "admin")
+	assert.Equal(t, http.StatusCreated, int(resp.StatusCode), "Status code should be StatusCreated.")
+	body := resp.Body.(map[string]interface{})
+	token := body["Id"].(string)
+	assert.Equal(t, "/redfish/v1/Managers/uuid:1/Oem/ODIM/ConsoleSessions/"+token, resp.Header["Location"], "Location should be the console session")
+	assert.Equal(t, "wss://localhost:9093/ODIM/v1/Console/pluginToken", sessions[token].ConsoleURL, "console should be relayed by the plugin")
+	assert.Equal(t, "admin", sessions[token].UserName, "user of the console session should be saved")
+
+	session, resp := e.ConnectConsoleSession(context.Background(), &managersproto.ConsoleSessionRequest{ManagerID: "uuid:2", Token: token})
+	assert.Nil(t, session, "console session of another manager should not be connected")
+	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status code should be StatusNotFound.")
+
+	sessions["expired"] = mgrmodel.ConsoleSession{ManagerID: "uuid:1", ExpiresAt: time.Now().Add(-time.Minute)}
+	session, resp = e.ConnectConsoleSession(context.Background(), &managersproto.ConsoleSessionRequest{ManagerID: "uuid:1", Token: "expired"})
+	assert.Nil(t, session, "expired console session should not be connected")
+
+	sessions[token] = mgrmodel.ConsoleSession{ManagerID: "uuid:1", ConsoleType: SerialConsole, ExpiresAt: time.Now().Add(time.Minute)}
+	session, resp = e.ConnectConsoleSession(context.Background(), &managersproto.ConsoleSessionRequest{ManagerID: "uuid:1", Token: token})
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	assert.Equal(t, SerialConsole, session.ConsoleType, "console session should be returned")
+
+	resp = e.CreateConsoleSession(context.Background(), &managersproto.ManagerActionRequest{
+		ManagerID:   "uuid:1",
+		RequestBody: []byte(`{"ConsoleType":"CommandShell"}`),
+	}, "admin")
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be StatusBadRequest.")
+
+	resp = e.CreateConsoleSession(context.Background(), &managersproto.ManagerActionRequest{
+		ManagerID:   "uuid:1",
+		RequestBody: []byte(`{}`),
+	}, "admin")
+	assert.Equal(t, http.StatusBadRequest, int(resp.StatusCode), "Status code should be StatusBadRequest.")
+
+	resp = e.CreateConsoleSession(context.Background(), &managersproto.ManagerActionRequest{
+		ManagerID:   "invalidID",
+		RequestBody: []byte(`{"ConsoleType":"GraphicalConsole"}`),
+	}, "admin")
+	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status code should be StatusNotFound.")
+}
@@ -16,9 +16,9 @@
 package managers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 	"net/http"
 	"strings"
 
@@ -26,6 +26,7 @@ import (
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-managers/mgrcommon"
@@ -34,7 +35,7 @@ import (
 )
 
 // GetManagersCollection will get the all the managers(odimra, Plugins, Servers)
-func (e *ExternalInterface) GetManagersCollection(req *managersproto.ManagerRequest) (response.RPC, error) {
+func (e *ExternalInterface) GetManagersCollection(ctx context.Context, req *managersproto.ManagerRequest) (response.RPC, error) {
 	var resp response.RPC
 	resp.Header = map[string]string{
 		"Allow":             `"GET"`,
@@ -56,7 +57,7 @@ func (e *ExternalInterface) GetManagersCollection(req *managersproto.ManagerRequ
 	// Add servers as manager in manager collection
 	managersCollectionKeysArray, err := e.DB.GetAllKeysFromTable("Managers")
 	if err != nil || len(managersCollectionKeysArray) == 0 {
-		log.Error("odimra Doesnt have Servers")
+		logs.Log(ctx).Error("odimra Doesnt have Servers")
 	}
 
 	for _, key := range managersCollectionKeysArray {
@@ -66,11 +67,11 @@ func (e *ExternalInterface) GetManagersCollection(req *managersproto.ManagerRequ
 	managers.MembersCount = len(members)
 	resp.Body = managers
 	resp.StatusCode = http.StatusOK
-	return resp, nil
+	return common.ApplyQueryOptionsWithIndex(resp, req.URL, e.DB.GetManagersByURL, common.FilterIndexLookup("Managers")), nil
 }
 
 // GetManagers will fetch individual manager details with the given ID
-func (e *ExternalInterface) GetManagers(req *managersproto.ManagerRequest) response.RPC {
+func (e *ExternalInterface) GetManagers(ctx context.Context, req *managersproto.ManagerRequest) response.RPC {
 	var resp response.RPC
 	resp.Header = map[string]string{
 		"Allow":             `"GET"`,
@@ -84,7 +85,7 @@ func (e *ExternalInterface) GetManagers(req *managersproto.ManagerRequest) respo
 	if req.ManagerID == config.Data.RootServiceUUID {
 		manager, err := e.getManagerDetails(req.ManagerID)
 		if err != nil {
-			log.Error("error getting manager details : " + err.Error())
+			logs.Log(ctx).Error("error getting manager details : " + err.Error())
 			errArgs := []interface{}{"Managers", req.ManagerID}
 			errorMessage := err.Error()
 			resp = common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage,
@@ -96,13 +97,14 @@ func (e *ExternalInterface) GetManagers(req *managersproto.ManagerRequest) respo
 
 		requestData := strings.Split(req.ManagerID, ":")
 		if len(requestData) <= 1 {
-			resp = e.getPluginManagerResoure(requestData[0], req.URL)
+			resp = e.getPluginManagerResoure(ctx, requestData[0], req.URL)
 			return resp
 		}
 		uuid := requestData[0]
+		ctx = logs.With(ctx, logs.SystemIDField, uuid)
 		data, err := e.DB.GetManagerByURL(req.URL)
 		if err != nil {
-			log.Error("error getting manager details : " + err.Error())
+			logs.Log(ctx).Error("error getting manager details : " + err.Error())
 			var errArgs = []interface{}{}
 			var statusCode int
 			var StatusMessage string
@@ -124,7 +126,7 @@ func (e *ExternalInterface) GetManagers(req *managersproto.ManagerRequest) respo
 		jerr := json.Unmarshal([]byte(data), &managerData)
 		if jerr != nil {
 			errorMessage := "error unmarshalling manager details: " + jerr.Error()
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			resp = common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage,
 				nil, nil)
 			return resp
@@ -136,9 +138,9 @@ func (e *ExternalInterface) GetManagers(req *managersproto.ManagerRequest) respo
 		}
 
 		if managerType != common.ManagerTypeService && managerType != "" {
-			deviceData, err := e.getResourceInfoFromDevice(req.URL, uuid, requestData[1])
+			deviceData, err := e.getResourceInfoFromDevice(ctx, req.URL, uuid, requestData[1])
 			if err != nil {
-				log.Error("Device " + req.URL + " is unreachable: " + err.Error())
+				logs.Log(ctx).Error("Device " + req.URL + " is unreachable: " + err.Error())
 				// Updating the state
 				managerData["Status"] = map[string]string{
 					"State": "Absent",
@@ -147,7 +149,7 @@ func (e *ExternalInterface) GetManagers(req *managersproto.ManagerRequest) respo
 				jerr := json.Unmarshal([]byte(deviceData), &managerData)
 				if jerr != nil {
 					errorMessage := "error unmarshaling manager details: " + jerr.Error()
-					log.Error(errorMessage)
+					logs.Log(ctx).Error(errorMessage)
 					resp = common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage,
 						nil, nil)
 					return resp
@@ -156,7 +158,7 @@ func (e *ExternalInterface) GetManagers(req *managersproto.ManagerRequest) respo
 			err = e.DB.UpdateManagersData(req.URL, managerData)
 			if err != nil {
 				errorMessage := "error while saving manager details: " + err.Error()
-				log.Error(errorMessage)
+				logs.Log(ctx).Error(errorMessage)
 				resp = common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage,
 					nil, nil)
 				return resp
@@ -164,7 +166,7 @@ func (e *ExternalInterface) GetManagers(req *managersproto.ManagerRequest) respo
 			dataBytes, err := json.Marshal(managerData)
 			if err != nil {
 				errorMessage := "error while marshalling manager details: " + err.Error()
-				log.Error(errorMessage)
+				logs.Log(ctx).Error(errorMessage)
 				resp = common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage,
 					nil, nil)
 				return resp
@@ -212,7 +214,7 @@ func (e *ExternalInterface) getManagerDetails(id string) (mgrmodel.Manager, erro
 // Url will be parsed from that search key will created
 // There will be two return values for the fuction. One is the RPC response, which contains the
 // status code, status message, headers and body and the second value is error.
-func (e *ExternalInterface) GetManagersResource(req *managersproto.ManagerRequest) response.RPC {
+func (e *ExternalInterface) GetManagersResource(ctx context.Context, req *managersproto.ManagerRequest) response.RPC {
 	var resp response.RPC
 	resp.Header = map[string]string{
 		"Allow":             `"GET"`,
@@ -223,12 +225,18 @@ func (e *ExternalInterface) GetManagersResource(req *managersproto.ManagerReques
 		"OData-Version":     "4.0",
 	}
 
+	if req.ManagerID == config.Data.RootServiceUUID {
+		if certificate := findODIMRACertificate(req.URL); certificate != nil {
+			return e.getODIMRACertificate(ctx, certificate, req.URL)
+		}
+	}
 	requestData := strings.Split(req.ManagerID, ":")
 	if len(requestData) <= 1 {
-		resp = e.getPluginManagerResoure(requestData[0], req.URL)
+		resp = e.getPluginManagerResoure(ctx, requestData[0], req.URL)
 		return resp
 	}
 	uuid := requestData[0]
+	ctx = logs.With(ctx, logs.SystemIDField, uuid)
 	urlData := strings.Split(req.URL, "/")
 	var tableName string
 	if req.ResourceID == "" {
@@ -242,15 +250,15 @@ func (e *ExternalInterface) GetManagersResource(req *managersproto.ManagerReques
 	if err != nil {
 		if errors.DBKeyNotFound == err.ErrNo() {
 			var err error
-			if data, err = e.getResourceInfoFromDevice(req.URL, uuid, requestData[1]); err != nil {
+			if data, err = e.getResourceInfoFromDevice(ctx, req.URL, uuid, requestData[1]); err != nil {
 				errorMessage := "unable to get resource details from device: " + err.Error()
-				log.Error(errorMessage)
+				logs.Log(ctx).Error(errorMessage)
 				errArgs := []interface{}{tableName, req.ManagerID}
 				return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, errArgs, nil)
 			}
 		} else {
 			errorMessage := "unable to get managers details: " + err.Error()
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, []interface{}{}, nil)
 		}
 	}
@@ -264,11 +272,11 @@ func (e *ExternalInterface) GetManagersResource(req *managersproto.ManagerReques
 	return resp
 }
 
-func (e *ExternalInterface) getPluginManagerResoure(managerID, reqURI string) response.RPC {
+func (e *ExternalInterface) getPluginManagerResoure(ctx context.Context, managerID, reqURI string) response.RPC {
 	var resp response.RPC
 	data, dberr := e.DB.GetManagerByURL("/redfish/v1/Managers/" + managerID)
 	if dberr != nil {
-		log.Error("unable to get manager details : " + dberr.Error())
+		logs.Log(ctx).Error("unable to get manager details : " + dberr.Error())
 		var errArgs = []interface{}{"Managers", managerID}
 		errorMessage := dberr.Error()
 		resp = common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage,
@@ -279,16 +287,17 @@ func (e *ExternalInterface) getPluginManagerResoure(managerID, reqURI string) re
 	jerr := json.Unmarshal([]byte(data), &managerData)
 	if jerr != nil {
 		errorMessage := "unable to unmarshal manager details: " + jerr.Error()
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		resp = common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage,
 			nil, nil)
 		return resp
 	}
 	var pluginID = managerData["Name"].(string)
+	ctx = logs.With(ctx, logs.PluginIDField, pluginID)
 	// Get the Plugin info
 	plugin, gerr := e.DB.GetPluginData(pluginID)
 	if gerr != nil {
-		log.Error("unable to get manager details : " + gerr.Error())
+		logs.Log(ctx).Error("unable to get manager details : " + gerr.Error())
 		var errArgs = []interface{}{"Plugin", pluginID}
 		errorMessage := gerr.Error()
 		resp = common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage,
@@ -299,46 +308,22 @@ func (e *ExternalInterface) getPluginManagerResoure(managerID, reqURI string) re
 
 	req.ContactClient = e.Device.ContactClient
 	req.Plugin = plugin
-
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		token := mgrcommon.GetPluginToken(req)
-		if token == "" {
-			var errorMessage = "unable to create session with plugin " + plugin.ID
-			return common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errorMessage,
-				[]interface{}{}, nil)
-		}
-		req.Token = token
-	} else {
-		req.BasicAuth = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	req.OID = reqURI
 	var errorMessage = "unable to get the details " + reqURI + ": "
 	var header = map[string]string{"Content-type": "application/json; charset=utf-8"}
-	body, _, getResponse, err := mgrcommon.ContactPlugin(req, errorMessage)
+	body, _, getResponse, err := mgrcommon.ContactPlugin(ctx, req, errorMessage)
 	if err != nil {
-		if getResponse.StatusCode == http.StatusUnauthorized && strings.EqualFold(req.Plugin.PreferredAuthType, "XAuthToken") {
-			if body, _, getResponse, err = mgrcommon.RetryManagersOperation(req, errorMessage); err != nil {
-				resp.StatusCode = getResponse.StatusCode
-				json.Unmarshal(body, &resp.Body)
-				resp.Header = header
-				return resp
-			}
-		} else {
-			resp.StatusCode = getResponse.StatusCode
-			json.Unmarshal(body, &resp.Body)
-			resp.Header = header
-			return resp
-		}
+		resp.StatusCode = getResponse.StatusCode
+		resp.StatusMessage = getResponse.StatusMessage
+		json.Unmarshal(body, &resp.Body)
+		resp.Header = header
+		return resp
 	}
-	return fillResponse(body)
+	return fillResponse(ctx, body)
 
 }
 
-func fillResponse(body []byte) response.RPC {
+func fillResponse(ctx context.Context, body []byte) response.RPC {
 	var resp response.RPC
 	data := string(body)
 	//replacing the resposne with north bound translation URL
@@ -348,7 +333,7 @@ func fillResponse(body []byte) response.RPC {
 	var respData map[string]interface{}
 	err := json.Unmarshal([]byte(data), &respData)
 	if err != nil {
-		log.Error(err.Error())
+		logs.Log(ctx).Error(err.Error())
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(),
 			[]interface{}{}, nil)
 	}
@@ -367,7 +352,7 @@ func fillResponse(body []byte) response.RPC {
 
 }
 
-func (e *ExternalInterface) getResourceInfoFromDevice(reqURL, uuid, systemID string) (string, error) {
+func (e *ExternalInterface) getResourceInfoFromDevice(ctx context.Context, reqURL, uuid, systemID string) (string, error) {
 	var getDeviceInfoRequest = mgrcommon.ResourceInfoRequest{
 		URL:                   reqURL,
 		UUID:                  uuid,
@@ -375,6 +360,6 @@ func (e *ExternalInterface) getResourceInfoFromDevice(reqURL, uuid, systemID str
 		ContactClient:         e.Device.ContactClient,
 		DecryptDevicePassword: e.Device.DecryptDevicePassword,
 	}
-	return e.Device.GetDeviceInfo(getDeviceInfoRequest)
+	return e.Device.GetDeviceInfo(ctx, getDeviceInfoRequest)
 
 }
@@ -14,6 +14,7 @@
 package managers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -21,7 +22,6 @@ import (
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
-	"github.com/ODIM-Project/ODIM/svc-managers/mgrcommon"
 	"github.com/ODIM-Project/ODIM/svc-managers/mgrmodel"
 	"github.com/ODIM-Project/ODIM/svc-managers/mgrresponse"
 	"github.com/stretchr/testify/assert"
@@ -30,7 +30,7 @@ import (
 func TestGetManagersCollection(t *testing.T) {
 	req := &managersproto.ManagerRequest{}
 	e := mockGetExternalInterface()
-	response, err := e.GetManagersCollection(req)
+	response, err := e.GetManagersCollection(context.Background(), req)
 	assert.Nil(t, err, "There should be no error")
 
 	manager := response.Body.(mgrresponse.ManagersCollection)
@@ -38,6 +38,21 @@ func TestGetManagersCollection(t *testing.T) {
 	assert.Equal(t, manager.MembersCount, 1, fmt.Sprintf("Managers count is expected to be 1 but got %v", manager.MembersCount))
 }
 
+func TestGetManagersCollectionWithExpand(t *testing.T) {
+	req := &managersproto.ManagerRequest{
+		URL: "/redfish/v1/Managers?$expand=*&$select=Name",
+	}
+	e := mockGetExternalInterface()
+	response, err := e.GetManagersCollection(context.Background(), req)
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, http.StatusOK, int(response.StatusCode), "Status code should be StatusOK.")
+
+	managers := response.Body.(map[string]interface{})
+	members := managers["Members"].([]interface{})
+	assert.Equal(t, 1, len(members), "Members count is expected to be 1")
+	assert.Equal(t, map[string]interface{}{"Name": "somePlugin"}, members[0], "Member should be expanded with the selected properties")
+}
+
 func TestGetManagerRootUUIDNotFound(t *testing.T) {
 	config.SetUpMockConfig(t)
 	config.Data.RootServiceUUID = "nonExistingUUID"
@@ -45,7 +60,7 @@ func TestGetManagerRootUUIDNotFound(t *testing.T) {
 		ManagerID: config.Data.RootServiceUUID,
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagers(req)
+	response := e.GetManagers(context.Background(), req)
 
 	assert.Equal(t, http.StatusNotFound, int(response.StatusCode), "Status code should be StatusNotFound")
 }
@@ -56,7 +71,7 @@ func TestGetManager(t *testing.T) {
 		ManagerID: config.Data.RootServiceUUID,
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagers(req)
+	response := e.GetManagers(context.Background(), req)
 
 	var manager mgrmodel.Manager
 	data, _ := json.Marshal(response.Body)
@@ -76,7 +91,7 @@ func TestGetManagerWithDeviceAbsent(t *testing.T) {
 		URL:       "/redfish/v1/Managers/deviceAbsent:1",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagers(req)
+	response := e.GetManagers(context.Background(), req)
 
 	var manager mgrmodel.Manager
 	data, _ := json.Marshal(response.Body)
@@ -93,7 +108,7 @@ func TestGetManagerwithInvalidURL(t *testing.T) {
 		URL:       "/redfish/v1/Managers/invalidURL:1",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagers(req)
+	response := e.GetManagers(context.Background(), req)
 	assert.Equal(t, http.StatusNotFound, int(response.StatusCode), "Status code should be StatusOK.")
 
 }
@@ -104,7 +119,7 @@ func TestGetManagerwithValidURL(t *testing.T) {
 		URL:       "/redfish/v1/Managers/uuid:1",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagers(req)
+	response := e.GetManagers(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(response.StatusCode), "Status code should be StatusOK.")
 
 }
@@ -114,7 +129,7 @@ func TestGetManagerInvalidID(t *testing.T) {
 		ManagerID: "invalidID",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagers(req)
+	response := e.GetManagers(context.Background(), req)
 
 	assert.Equal(t, http.StatusNotFound, int(response.StatusCode), "Status code should be StatusNotFound")
 }
@@ -126,7 +141,7 @@ func TestGetManagerResourcewithBadManagerID(t *testing.T) {
 		URL:       "/redfish/v1/Managers/uuid",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagersResource(req)
+	response := e.GetManagersResource(context.Background(), req)
 	assert.Equal(t, http.StatusNotFound, int(response.StatusCode), "Status code should be StatusBadRequest.")
 }
 
@@ -137,7 +152,7 @@ func TestGetManagerResourcewithValidURL(t *testing.T) {
 		URL:       "/redfish/v1/Managers/uuid:1/EthernetInterfaces",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagersResource(req)
+	response := e.GetManagersResource(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(response.StatusCode), "Status code should be StatusOK.")
 
 	req = &managersproto.ManagerRequest{
@@ -145,7 +160,7 @@ func TestGetManagerResourcewithValidURL(t *testing.T) {
 		ResourceID: "1",
 		URL:        "/redfish/v1/Managers/uuid:1/EthernetInterfaces/1",
 	}
-	response = e.GetManagersResource(req)
+	response = e.GetManagersResource(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(response.StatusCode), "Status code should be StatusOK.")
 
 }
@@ -157,20 +172,18 @@ func TestGetManagerResourcewithInvalidURL(t *testing.T) {
 		URL:       "/redfish/v1/Managers/uuid1:1/Ethernet",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagersResource(req)
+	response := e.GetManagersResource(context.Background(), req)
 	assert.Equal(t, http.StatusNotFound, int(response.StatusCode), "Status code should be StatusNotFound.")
 }
 
 func TestGetPluginManagerResourceSuccess(t *testing.T) {
-	mgrcommon.Token.Tokens = make(map[string]string)
-
 	config.SetUpMockConfig(t)
 	req := &managersproto.ManagerRequest{
 		ManagerID: "uuid",
 		URL:       "/redfish/v1/Managers/uuid/EthernetInterfaces",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagersResource(req)
+	response := e.GetManagersResource(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(response.StatusCode), "Status code should be StatusOK.")
 
 	req = &managersproto.ManagerRequest{
@@ -178,39 +191,32 @@ func TestGetPluginManagerResourceSuccess(t *testing.T) {
 		ResourceID: "1",
 		URL:        "/redfish/v1/Managers/uuid1/EthernetInterfaces",
 	}
-	response = e.GetManagersResource(req)
+	response = e.GetManagersResource(context.Background(), req)
 	assert.Equal(t, http.StatusOK, int(response.StatusCode), "Status code should be StatusOK.")
 
 }
 
 func TestGetPluginManagerResourceInvalidPluginFail(t *testing.T) {
-	mgrcommon.Token.Tokens = make(map[string]string)
-
 	config.SetUpMockConfig(t)
 	req := &managersproto.ManagerRequest{
 		ManagerID: "noPlugin",
 		URL:       "/redfish/v1/Managers/noPlugin/EthernetInterfaces",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagersResource(req)
+	response := e.GetManagersResource(context.Background(), req)
 	assert.Equal(t, http.StatusNotFound, int(response.StatusCode), "Status code should be StatusNotFound.")
 }
 
 func TestGetPluginManagerResourceInvalidPluginSessions(t *testing.T) {
-	mgrcommon.Token.Tokens = make(map[string]string)
-
 	config.SetUpMockConfig(t)
 	req := &managersproto.ManagerRequest{
 		ManagerID: "noToken",
 		URL:       "/redfish/v1/Managers/uuid/EthernetInterfaces",
 	}
 	e := mockGetExternalInterface()
-	response := e.GetManagersResource(req)
+	response := e.GetManagersResource(context.Background(), req)
 	assert.Equal(t, http.StatusUnauthorized, int(response.StatusCode), "Status code should be StatusUnauthorized.")
-	mgrcommon.Token.Tokens = map[string]string{
-		"CFM": "23456",
-	}
-	response = e.GetManagersResource(req)
+	response = e.GetManagersResource(context.Background(), req)
 	assert.Equal(t, http.StatusUnauthorized, int(response.StatusCode), "Status code should be StatusUnauthorized.")
 
 }
@@ -15,28 +15,28 @@
 package mgrcommon
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	log "github.com/sirupsen/logrus"
-	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
-	"sync"
 
+	"github.com/ODIM-Project/ODIM/lib-rest-client/pluginclient"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
-	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
-	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	"github.com/ODIM-Project/ODIM/svc-managers/mgrmodel"
 )
 
+// EndpointCertificatesURI is the URI of the certificate chains presented by the devices to a plugin
+const EndpointCertificatesURI = "/ODIM/v1/EndpointCertificates"
+
 //PluginContactRequest  hold the request of contact plugin
 type PluginContactRequest struct {
-	Token          string
 	OID            string
 	DeviceInfo     interface{}
-	BasicAuth      map[string]string
 	ContactClient  func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
 	Plugin         mgrmodel.Plugin
 	HTTPMethodType string
@@ -57,36 +57,13 @@ type ResourceInfoRequest struct {
 	DecryptDevicePassword func([]byte) ([]byte, error)
 }
 
-// PluginToken interface to hold the token
-type PluginToken struct {
-	Tokens map[string]string
-	lock   sync.Mutex
-}
-
-// Token variable hold the all the XAuthToken  against the plguin ID
-var Token PluginToken
-
 // DBInterface hold interface for db functions
 type DBInterface struct {
 	AddManagertoDBInterface func(mgrmodel.RAManager) error
 }
 
-// StoreToken to store the token ioto the  map
-func (p *PluginToken) StoreToken(plguinID, token string) {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-	p.Tokens[plguinID] = token
-}
-
-// GetToken to get the token from map
-func (p *PluginToken) GetToken(pluginID string) string {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-	return p.Tokens[pluginID]
-}
-
 //GetResourceInfoFromDevice will contact to the and gets the Particual resource info from device
-func GetResourceInfoFromDevice(req ResourceInfoRequest) (string, error) {
+func GetResourceInfoFromDevice(ctx context.Context, req ResourceInfoRequest) (string, error) {
 	target, gerr := mgrmodel.GetTarget(req.UUID)
 	if gerr != nil {
 		return "", gerr
@@ -100,22 +77,6 @@ func GetResourceInfoFromDevice(req ResourceInfoRequest) (string, error) {
 
 	contactRequest.ContactClient = req.ContactClient
 	contactRequest.Plugin = plugin
-
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		token := GetPluginToken(contactRequest)
-		if token == "" {
-			var errorMessage = "error: Unable to create session with plugin " + plugin.ID
-			return "", fmt.Errorf(errorMessage)
-		}
-
-		contactRequest.Token = token
-	} else {
-		contactRequest.BasicAuth = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	decryptedPasswordByte, err := req.DecryptDevicePassword(target.Password)
 	if err != nil {
 		errorMessage := "error while trying to decrypt device password: " + err.Error()
@@ -129,69 +90,85 @@ func GetResourceInfoFromDevice(req ResourceInfoRequest) (string, error) {
 	//replace the uuid:system id with the system to the @odata.id from request url
 	contactRequest.OID = strings.Replace(req.URL, req.UUID+":"+req.SystemID, req.SystemID, -1)
 	contactRequest.HTTPMethodType = http.MethodGet
-	body, _, getResp, err := ContactPlugin(contactRequest, "error while getting the details "+contactRequest.OID+": ")
+	body, _, _, err := ContactPlugin(ctx, contactRequest, "error while getting the details "+contactRequest.OID+": ")
 	if err != nil {
-		if getResp.StatusCode == http.StatusUnauthorized && strings.EqualFold(contactRequest.Plugin.PreferredAuthType, "XAuthToken") {
-			if body, _, _, err = RetryManagersOperation(contactRequest, "error while getting the details "+contactRequest.OID+": "); err != nil {
-				return "", fmt.Errorf("error while trying to get data from plugin: %v", err)
-			}
-		} else {
-			return "", fmt.Errorf("error while trying to get data from plugin: %v", err)
-		}
+		return "", fmt.Errorf("error while trying to get data from plugin: %v", err)
 	}
-	var updatedData = strings.Replace(string(body), "/redfish/v1/Systems/", "/redfish/v1/Systems/"+req.UUID+":", -1)
-	updatedData = strings.Replace(updatedData, "/redfish/v1/systems/", "/redfish/v1/systems/"+req.UUID+":", -1)
+	return TranslateDeviceURIs(string(body), req.UUID), nil
+}
+
+// TranslateDeviceURIs adds the uuid of the device to the IDs of the systems, managers
+// and chassis in the data of the device, as they are identified in odimra
+func TranslateDeviceURIs(data, uuid string) string {
+	var updatedData = strings.Replace(data, "/redfish/v1/Systems/", "/redfish/v1/Systems/"+uuid+":", -1)
+	updatedData = strings.Replace(updatedData, "/redfish/v1/systems/", "/redfish/v1/systems/"+uuid+":", -1)
 	// to replace the id in managers
-	updatedData = strings.Replace(updatedData, "/redfish/v1/Managers/", "/redfish/v1/Managers/"+req.UUID+":", -1)
+	updatedData = strings.Replace(updatedData, "/redfish/v1/Managers/", "/redfish/v1/Managers/"+uuid+":", -1)
 	// to replace id in chassis
-	updatedData = strings.Replace(updatedData, "/redfish/v1/Chassis/", "/redfish/v1/Chassis/"+req.UUID+":", -1)
-
-	return updatedData, nil
+	updatedData = strings.Replace(updatedData, "/redfish/v1/Chassis/", "/redfish/v1/Chassis/"+uuid+":", -1)
+	return updatedData
 }
 
-// ContactPlugin is commons which handles the request and response of Contact Plugin usage
-func ContactPlugin(req PluginContactRequest, errorMessage string) ([]byte, string, ResponseStatus, error) {
+// ContactPlugin is commons which handles the request and response of Contact Plugin usage.
+// The request is authenticated with the session token or the basic authentication of the
+// plugin, and the error response of the plugin is returned as a Redfish error response.
+func ContactPlugin(ctx context.Context, req PluginContactRequest, errorMessage string) ([]byte, string, ResponseStatus, error) {
 	var resp ResponseStatus
-	var response *http.Response
-	var err error
-	response, err = callPlugin(req)
+	pluginResp, err := pluginclient.Do(pluginclient.Request{
+		Plugin:        getPluginDetails(req.Plugin),
+		Method:        req.HTTPMethodType,
+		URI:           req.OID,
+		Body:          req.DeviceInfo,
+		ContactClient: req.ContactClient,
+		StatusCheck: func() bool {
+			return getPluginStatus(ctx, req.Plugin)
+		},
+	})
 	if err != nil {
-		if getPluginStatus(req.Plugin) {
-			response, err = callPlugin(req)
-		}
-		if err != nil {
-			errorMessage = errorMessage + err.Error()
-			resp.StatusCode = http.StatusInternalServerError
-			resp.StatusMessage = errors.InternalError
-			log.Error(errorMessage)
-			return nil, "", resp, fmt.Errorf(errorMessage)
-		}
-	}
-	defer response.Body.Close()
-	body, err := ioutil.ReadAll(response.Body)
+		errorMessage = errorMessage + err.Error()
+		resp.StatusCode = err.StatusCode
+		resp.StatusMessage = err.StatusMessage
+		logs.Log(ctx).Error(errorMessage)
+		return err.ResponseBody(), "", resp, fmt.Errorf(errorMessage)
+	}
+	resp.StatusCode = int32(pluginResp.StatusCode)
+	return pluginResp.Body, pluginResp.Header.Get("X-Auth-Token"), resp, nil
+}
+
+// GetPluginEndpointCertificates returns the certificate chains presented by the devices
+// to the plugin. The request is not sent again when the plugin couldn't be reached, as the
+// certificates are polled periodically.
+func GetPluginEndpointCertificates(plugin mgrmodel.Plugin, contactClient func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)) ([]common.EndpointCertificate, error) {
+	pluginResp, err := pluginclient.Do(pluginclient.Request{
+		Plugin:        getPluginDetails(plugin),
+		Method:        http.MethodGet,
+		URI:           EndpointCertificatesURI,
+		ContactClient: contactClient,
+	})
 	if err != nil {
-		errorMessage := "error while trying to read response body: " + err.Error()
-		resp.StatusCode = http.StatusInternalServerError
-		resp.StatusMessage = errors.InternalError
-		log.Error(errorMessage)
-		return nil, "", resp, fmt.Errorf(errorMessage)
+		return nil, err
 	}
-
-	if !(response.StatusCode == http.StatusOK || response.StatusCode == http.StatusCreated) {
-		resp.StatusCode = int32(response.StatusCode)
-		log.Error(errorMessage)
-		return body, "", resp, fmt.Errorf(errorMessage)
+	var certificates []common.EndpointCertificate
+	if jerr := json.Unmarshal(pluginResp.Body, &certificates); jerr != nil {
+		return nil, fmt.Errorf("error while trying to unmarshal the certificates of the plugin %s: %v", plugin.ID, jerr)
 	}
-	data := string(body)
-	//replacing the resposne with north bound translation URL
-	for key, value := range config.Data.URLTranslation.NorthBoundURL {
-		data = strings.Replace(data, key, value, -1)
+	return certificates, nil
+}
+
+// getPluginDetails returns the details of the plugin the requests are sent to
+func getPluginDetails(plugin mgrmodel.Plugin) pluginclient.Plugin {
+	return pluginclient.Plugin{
+		ID:                plugin.ID,
+		IP:                plugin.IP,
+		Port:              plugin.Port,
+		Username:          plugin.Username,
+		Password:          plugin.Password,
+		PreferredAuthType: plugin.PreferredAuthType,
 	}
-	return []byte(data), response.Header.Get("X-Auth-Token"), resp, nil
 }
 
 // getPluginStatus checks the status of given plugin in configured interval
-func getPluginStatus(plugin mgrmodel.Plugin) bool {
+func getPluginStatus(ctx context.Context, plugin mgrmodel.Plugin) bool {
 	var pluginStatus = common.PluginStatus{
 		Method: http.MethodGet,
 		RequestBody: common.StatusRequest{
@@ -209,74 +186,13 @@ func getPluginStatus(plugin mgrmodel.Plugin) bool {
 	}
 	status, _, _, err := pluginStatus.CheckStatus()
 	if err != nil && !status {
-		log.Error("Error While getting the status for plugin " + plugin.ID + err.Error())
+		logs.Log(ctx).Error("Error While getting the status for plugin " + plugin.ID + err.Error())
 		return status
 	}
-	log.Error("Status of plugin" + plugin.ID + strconv.FormatBool(status))
+	logs.Log(ctx).Error("Status of plugin" + plugin.ID + strconv.FormatBool(status))
 	return status
 }
 
-func callPlugin(req PluginContactRequest) (*http.Response, error) {
-	var oid string
-	for key, value := range config.Data.URLTranslation.SouthBoundURL {
-		oid = strings.Replace(req.OID, key, value, -1)
-	}
-	var reqURL = "https://" + req.Plugin.IP + ":" + req.Plugin.Port + oid
-	if strings.EqualFold(req.Plugin.PreferredAuthType, "BasicAuth") {
-		return req.ContactClient(reqURL, req.HTTPMethodType, "", oid, req.DeviceInfo, req.BasicAuth)
-	}
-	return req.ContactClient(reqURL, req.HTTPMethodType, req.Token, oid, req.DeviceInfo, nil)
-}
-
-// GetPluginToken will verify the if any token present to the plugin else it will create token for the new plugin
-func GetPluginToken(req PluginContactRequest) string {
-	authToken := Token.GetToken(req.Plugin.ID)
-	if authToken == "" {
-		return createToken(req)
-	}
-	return authToken
-}
-
-func createToken(req PluginContactRequest) string {
-	var contactRequest PluginContactRequest
-
-	contactRequest.ContactClient = req.ContactClient
-	contactRequest.Plugin = req.Plugin
-	contactRequest.HTTPMethodType = http.MethodPost
-	contactRequest.DeviceInfo = map[string]interface{}{
-		"Username": req.Plugin.Username,
-		"Password": string(req.Plugin.Password),
-	}
-	contactRequest.OID = "/ODIM/v1/Sessions"
-	_, token, _, err := ContactPlugin(contactRequest, "error while logging in to plugin: ")
-	if err != nil {
-		log.Error(err.Error())
-	}
-	if token != "" {
-		Token.StoreToken(req.Plugin.ID, token)
-	}
-	return token
-}
-
-// RetryManagersOperation will be called whenever  the unauthorized status code during the plugin call
-// This function will create a new session token reexcutes the plugin call
-func RetryManagersOperation(req PluginContactRequest, errorMessage string) ([]byte, string, ResponseStatus, error) {
-	var resp response.RPC
-	var token = createToken(req)
-	if token == "" {
-		var tokenErrorMessage = "error: Unable to create session with plugin " + req.Plugin.ID
-		resp = common.GeneralError(http.StatusUnauthorized, response.NoValidSession, tokenErrorMessage,
-			[]interface{}{}, nil)
-		data, _ := json.Marshal(resp.Body)
-		return data, "", ResponseStatus{
-			StatusCode: resp.StatusCode,
-		}, fmt.Errorf(tokenErrorMessage)
-	}
-	req.Token = token
-	return ContactPlugin(req, errorMessage)
-
-}
-
 // TrackConfigFileChanges monitors the odim config changes using fsnotfiy
 func TrackConfigFileChanges(configFilePath string, dbInterface DBInterface) {
 	eventChan := make(chan interface{})
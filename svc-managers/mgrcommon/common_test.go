@@ -16,6 +16,7 @@ package mgrcommon
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
@@ -138,8 +139,6 @@ func mockPluginData(t *testing.T, pluginID, PreferredAuthType, port string) erro
 }
 
 func TestGetResourceInfoFromDevice(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.InMemory)
@@ -171,17 +170,15 @@ func TestGetResourceInfoFromDevice(t *testing.T) {
 		ContactClient:         mockContactClient,
 		DecryptDevicePassword: stubDevicePassword,
 	}
-	_, err = GetResourceInfoFromDevice(req)
+	_, err = GetResourceInfoFromDevice(context.Background(), req)
 	assert.Nil(t, err, "There should be no error getting data")
 	req.UUID = "uuid1"
 	req.URL = "/redfish/v1/Managers/uuid1:1/EthernetInterfaces"
-	_, err = GetResourceInfoFromDevice(req)
+	_, err = GetResourceInfoFromDevice(context.Background(), req)
 	assert.Nil(t, err, "There should be no error getting data")
 }
 
 func TestGetResourceInfoFromDeviceInvalidPlugin(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.InMemory)
@@ -217,13 +214,11 @@ func TestGetResourceInfoFromDeviceInvalidPlugin(t *testing.T) {
 		ContactClient:         mockContactClient,
 		DecryptDevicePassword: stubDevicePassword,
 	}
-	_, err = GetResourceInfoFromDevice(req)
+	_, err = GetResourceInfoFromDevice(context.Background(), req)
 	assert.NotNil(t, err, "There should be an error")
 }
 
 func TestGetResourceInfoFromDeviceWithInvalidPluginSession(t *testing.T) {
-	Token.Tokens = make(map[string]string)
-
 	config.SetUpMockConfig(t)
 	defer func() {
 		err := common.TruncateDB(common.InMemory)
@@ -251,12 +246,9 @@ func TestGetResourceInfoFromDeviceWithInvalidPluginSession(t *testing.T) {
 		ContactClient:         mockContactClient,
 		DecryptDevicePassword: stubDevicePassword,
 	}
-	_, err = GetResourceInfoFromDevice(req)
+	_, err = GetResourceInfoFromDevice(context.Background(), req)
 
 	assert.NotNil(t, err, "There should be an error")
-	Token.Tokens = map[string]string{
-		"GRF": "23456",
-	}
-	_, err = GetResourceInfoFromDevice(req)
+	_, err = GetResourceInfoFromDevice(context.Background(), req)
 	assert.NotNil(t, err, "There should be an error")
 }
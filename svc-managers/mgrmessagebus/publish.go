@@ -0,0 +1,54 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+// Package mgrmessagebus publishes the events of the managers service to the message bus
+package mgrmessagebus
+
+import (
+	"context"
+	"encoding/json"
+
+	dc "github.com/ODIM-Project/ODIM/lib-messagebus/datacommunicator"
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	uuid "github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// Publish publishes the event of a resource of the collection to the message bus,
+// it's forwarded by the events service to the subscribers of the collection
+func Publish(event common.Event, collectionType string) {
+	k := dc.GetProducer(dc.KAFKA, config.Data.MessageQueueConfigFilePath)
+	if event.EventID == "" {
+		event.EventID = uuid.NewV4().String()
+	}
+	var messageData = common.MessageData{
+		Name:      "Resource Event",
+		Context:   "/redfish/v1/$metadata#Event.Event",
+		OdataType: "#Event.v1_4_0.Event",
+		Events:    []common.Event{event},
+	}
+	data, _ := json.Marshal(messageData)
+	var mbevent = common.Events{
+		IP:      collectionType,
+		Request: data,
+	}
+	k.DistributeAsync(context.Background(), "REDFISH-EVENTS-TOPIC", mbevent, func(err error) {
+		if err != nil {
+			log.Error("Unable Publish events to kafka" + err.Error())
+			return
+		}
+		log.Info("Event Published")
+	})
+}
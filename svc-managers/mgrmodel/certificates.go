@@ -0,0 +1,74 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package mgrmodel
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+)
+
+const certificateExpiryAlertTable = "CertificateExpiryAlert"
+
+// CertificateExpiryAlert is the last alert raised for the expiry of the certificate of an endpoint
+type CertificateExpiryAlert struct {
+	// ValidNotAfter is the expiry of the certificate the alert was raised for
+	ValidNotAfter time.Time `json:"ValidNotAfter"`
+	// ThresholdInDays is the threshold the alert was raised at, 0 once the certificate expired
+	ThresholdInDays int `json:"ThresholdInDays"`
+}
+
+// GetCertificateExpiryAlert returns the last alert raised for the expiry of the certificate of the endpoint
+func GetCertificateExpiryAlert(endpoint string) (*CertificateExpiryAlert, *errors.Error) {
+	conn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return nil, err
+	}
+	data, err := conn.Read(certificateExpiryAlertTable, endpoint)
+	if err != nil {
+		return nil, errors.PackError(err.ErrNo(), "unable to get the certificate expiry alert: ", err.Error())
+	}
+	var alert CertificateExpiryAlert
+	if errs := json.Unmarshal([]byte(data), &alert); errs != nil {
+		return nil, errors.PackError(errors.JSONUnmarshalFailed, errs)
+	}
+	return &alert, nil
+}
+
+// SaveCertificateExpiryAlert saves the last alert raised for the expiry of the certificate of the endpoint
+func SaveCertificateExpiryAlert(endpoint string, alert CertificateExpiryAlert) *errors.Error {
+	conn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return err
+	}
+	if err := conn.AddResourceData(certificateExpiryAlertTable, endpoint, alert); err != nil {
+		return errors.PackError(err.ErrNo(), "unable to save the certificate expiry alert: ", err.Error())
+	}
+	return nil
+}
+
+// DeleteCertificateExpiryAlert deletes the alerts raised for the expiry of the certificate of the endpoint
+func DeleteCertificateExpiryAlert(endpoint string) *errors.Error {
+	conn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return err
+	}
+	if err := conn.Delete(certificateExpiryAlertTable, endpoint); err != nil && err.ErrNo() != errors.DBKeyNotFound {
+		return errors.PackError(err.ErrNo(), "unable to delete the certificate expiry alert: ", err.Error())
+	}
+	return nil
+}
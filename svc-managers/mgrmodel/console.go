@@ -0,0 +1,72 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package mgrmodel
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+)
+
+const consoleSessionTable = "ConsoleSession"
+
+// ConsoleSession is a console session brokered with the plugin of a device, waiting to be connected
+type ConsoleSession struct {
+	ManagerID   string `json:"ManagerID"`
+	ConsoleType string `json:"ConsoleType"`
+	UserName    string `json:"UserName"`
+	// SessionToken is the session of the user who created the console session
+	SessionToken string `json:"SessionToken"`
+	// ConsoleURL is the WebSocket of the plugin relaying the console of the device
+	ConsoleURL string    `json:"ConsoleURL"`
+	ExpiresAt  time.Time `json:"ExpiresAt"`
+}
+
+// SaveConsoleSession saves the console session against its token,
+// the console session is removed by the DB once it expires without being connected
+func SaveConsoleSession(token string, session ConsoleSession) *errors.Error {
+	conn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return err
+	}
+	expiry := time.Until(session.ExpiresAt)
+	if expiry <= 0 {
+		return errors.PackError(errors.UndefinedErrorType, "unable to save the console session: the console session has already expired")
+	}
+	if err := conn.CreateWithExpiry(consoleSessionTable, token, session, expiry); err != nil {
+		return errors.PackError(err.ErrNo(), "unable to save the console session: ", err.Error())
+	}
+	return nil
+}
+
+// TakeConsoleSession returns the console session saved against the token and removes it
+// in a single operation, so that a console session is connected only once
+func TakeConsoleSession(token string) (*ConsoleSession, *errors.Error) {
+	conn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return nil, err
+	}
+	data, err := conn.Take(consoleSessionTable, token)
+	if err != nil {
+		return nil, errors.PackError(err.ErrNo(), "unable to get the console session: ", err.Error())
+	}
+	var session ConsoleSession
+	if errs := json.Unmarshal([]byte(data), &session); errs != nil {
+		return nil, errors.PackError(errors.UndefinedErrorType, errs)
+	}
+	return &session, nil
+}
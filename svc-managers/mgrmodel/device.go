@@ -103,3 +103,47 @@ func GetTarget(deviceUUID string) (*DeviceTarget, *errors.Error) {
 	}
 	return &target, nil
 }
+
+//GetAllPlugins fetches the details of all the plugins
+func GetAllPlugins() ([]Plugin, *errors.Error) {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := conn.GetAllDetails("Plugin")
+	if err != nil {
+		return nil, errors.PackError(err.ErrNo(), "error while trying to fetch the plugins: ", err.Error())
+	}
+	var plugins []Plugin
+	for _, key := range keys {
+		plugin, err := GetPluginData(key)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins, nil
+}
+
+//GetAllTargets fetches the System(Target Device Credentials) table details of all the devices
+func GetAllTargets() ([]DeviceTarget, *errors.Error) {
+	conn, err := common.GetDBConnection(common.OnDisk)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := conn.GetAllDetails("System")
+	if err != nil {
+		return nil, errors.PackError(err.ErrNo(), "error while trying to fetch the devices: ", err.Error())
+	}
+	var targets []DeviceTarget
+	for _, key := range keys {
+		target, err := GetTarget(key)
+		if err != nil {
+			return nil, err
+		}
+		// the devices are keyed by their uuid
+		target.DeviceUUID = key
+		targets = append(targets, *target)
+	}
+	return targets, nil
+}
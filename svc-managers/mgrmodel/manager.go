@@ -149,6 +149,12 @@ func GetManagerByURL(url string) (string, *errors.Error) {
 	return manager, nil
 }
 
+// GetManagersByURL fetches the details of the managers by URL from database in a single request,
+// the managers which are not found are left out
+func GetManagersByURL(urls []string) (map[string]string, *errors.Error) {
+	return common.GetMembersFromDB("Managers", common.InMemory)(urls)
+}
+
 // UpdateManagersData will modify the current details to given changes
 func UpdateManagersData(key string, managerData map[string]interface{}) error {
 
@@ -163,6 +169,9 @@ func UpdateManagersData(key string, managerData map[string]interface{}) error {
 	if _, err = conn.Update("Managers", key, string(data)); err != nil {
 		return fmt.Errorf("unable to update manager details in DB: %v", err)
 	}
+	if err := common.UpdateFilterIndex("Managers", key, data); err != nil {
+		return fmt.Errorf("unable to index manager details in DB: %v", err)
+	}
 	return nil
 }
 
@@ -176,6 +185,25 @@ func GenericSave(body []byte, table string, key string) error {
 	if err := connPool.Create(table, key, string(body)); err != nil {
 		return fmt.Errorf("%v", err)
 	}
+	if err := common.UpdateFilterIndex(table, key, body); err != nil {
+		return fmt.Errorf("%v", err)
+	}
+	return nil
+}
+
+// SaveResource will save the resource data into the database, the data already
+// stored against the key is replaced
+func SaveResource(body []byte, table string, key string) error {
+	connPool, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return fmt.Errorf("unable to connect DB: %v", err.Error())
+	}
+	if err := connPool.AddResourceData(table, key, string(body)); err != nil {
+		return fmt.Errorf("%v", err.Error())
+	}
+	if err := common.UpdateFilterIndex(table, key, body); err != nil {
+		return fmt.Errorf("%v", err.Error())
+	}
 	return nil
 }
 
@@ -193,5 +221,8 @@ func AddManagertoDB(mgr RAManager) error {
 	if err := connPool.AddResourceData("Managers", key, string(data)); err != nil {
 		return fmt.Errorf("%v", err.Error())
 	}
+	if err := common.UpdateFilterIndex("Managers", key, data); err != nil {
+		return fmt.Errorf("%v", err.Error())
+	}
 	return nil
 }
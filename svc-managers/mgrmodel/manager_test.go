@@ -16,6 +16,7 @@ package mgrmodel
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/stretchr/testify/assert"
@@ -41,6 +42,29 @@ func TestGenericSave(t *testing.T) {
 	assert.Equal(t, data, string(body), "should be same")
 }
 
+func TestSaveResource(t *testing.T) {
+	common.SetUpMockConfig()
+	defer func() {
+		err := common.TruncateDB(common.InMemory)
+		if err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}()
+
+	table := "VirtualMedia"
+	key := "/redfish/v1/Managers/uuid:1/VirtualMedia/1"
+	err := GenericSave([]byte(`{"Inserted":false}`), table, key)
+	assert.Nil(t, err, "There should be no error")
+
+	body := []byte(`{"Inserted":true}`)
+	err = SaveResource(body, table, key)
+	assert.Nil(t, err, "There should be no error")
+
+	data, err := GetResource(table, key)
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, data, string(body), "should be same")
+}
+
 func TestManager_Update(t *testing.T) {
 	common.SetUpMockConfig()
 	defer func() {
@@ -184,3 +208,31 @@ func TestAddManagertoDB(t *testing.T) {
 	assert.Equal(t, manager.UUID, "3bd1f589-117a-4cf9-89f2-da44ee8e012b", "uuid should be 3bd1f589-117a-4cf9-89f2-da44ee8e012b")
 	assert.Equal(t, manager.State, "Enabled", "state should be Enabled")
 }
+
+func TestConsoleSession(t *testing.T) {
+	common.SetUpMockConfig()
+	defer func() {
+		err := common.TruncateDB(common.InMemory)
+		if err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}()
+
+	expired := ConsoleSession{ManagerID: "uuid:1", ExpiresAt: time.Now().Add(-time.Minute)}
+	err := SaveConsoleSession("expired", expired)
+	assert.NotNil(t, err, "expired console session should not be saved")
+
+	session := ConsoleSession{ManagerID: "uuid:1", ConsoleType: "SerialConsole", UserName: "admin", ExpiresAt: time.Now().Add(time.Minute)}
+	err = SaveConsoleSession("token", session)
+	assert.Nil(t, err, "There should be no error")
+
+	_, err = TakeConsoleSession("expired")
+	assert.NotNil(t, err, "expired console session should not be found")
+
+	data, err := TakeConsoleSession("token")
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, "admin", data.UserName, "should be same")
+
+	_, err = TakeConsoleSession("token")
+	assert.NotNil(t, err, "console session should only be taken once")
+}
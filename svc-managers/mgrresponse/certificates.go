@@ -0,0 +1,137 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package mgrresponse
+
+import (
+	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
+)
+
+// CertificateService is the response of the certificate service of odimra
+type CertificateService struct {
+	OdataContext         string                    `json:"@odata.context"`
+	OdataID              string                    `json:"@odata.id"`
+	OdataType            string                    `json:"@odata.type"`
+	ID                   string                    `json:"Id"`
+	Name                 string                    `json:"Name"`
+	Description          string                    `json:"Description"`
+	Actions              CertificateServiceActions `json:"Actions"`
+	CertificateLocations dmtf.Link                 `json:"CertificateLocations"`
+	Oem                  CertificateServiceOem     `json:"Oem"`
+}
+
+// CertificateServiceOem holds the Oem properties of the certificate service
+type CertificateServiceOem struct {
+	ODIM CertificateServiceOemODIM `json:"ODIM"`
+}
+
+// CertificateServiceOemODIM holds the ODIM properties of the certificate service
+type CertificateServiceOemODIM struct {
+	EndpointCertificates dmtf.Link `json:"EndpointCertificates"`
+}
+
+// CertificateServiceActions holds the actions of the certificate service
+type CertificateServiceActions struct {
+	GenerateCSR        ActionTarget `json:"#CertificateService.GenerateCSR"`
+	ReplaceCertificate ActionTarget `json:"#CertificateService.ReplaceCertificate"`
+}
+
+// ActionTarget is the URI an action is performed on
+type ActionTarget struct {
+	Target string `json:"target"`
+}
+
+// CertificateLocations lists the certificates of odimra and of all the devices
+type CertificateLocations struct {
+	OdataContext string                    `json:"@odata.context"`
+	OdataID      string                    `json:"@odata.id"`
+	OdataType    string                    `json:"@odata.type"`
+	ID           string                    `json:"Id"`
+	Name         string                    `json:"Name"`
+	Links        CertificateLocationsLinks `json:"Links"`
+}
+
+// CertificateLocationsLinks holds the links of the certificates
+type CertificateLocationsLinks struct {
+	Certificates      []dmtf.Link `json:"Certificates"`
+	CertificatesCount int         `json:"Certificates@odata.count"`
+}
+
+// CertificateCollection is a collection of certificates of odimra
+type CertificateCollection struct {
+	OdataContext string      `json:"@odata.context"`
+	OdataID      string      `json:"@odata.id"`
+	OdataType    string      `json:"@odata.type"`
+	Name         string      `json:"Name"`
+	Members      []dmtf.Link `json:"Members"`
+	MembersCount int         `json:"Members@odata.count"`
+}
+
+// Certificate is a certificate of odimra
+type Certificate struct {
+	OdataContext             string                `json:"@odata.context"`
+	OdataID                  string                `json:"@odata.id"`
+	OdataType                string                `json:"@odata.type"`
+	ID                       string                `json:"Id"`
+	Name                     string                `json:"Name"`
+	CertificateString        string                `json:"CertificateString"`
+	CertificateType          string                `json:"CertificateType"`
+	Issuer                   CertificateIdentifier `json:"Issuer"`
+	Subject                  CertificateIdentifier `json:"Subject"`
+	ValidNotBefore           string                `json:"ValidNotBefore"`
+	ValidNotAfter            string                `json:"ValidNotAfter"`
+	SerialNumber             string                `json:"SerialNumber"`
+	Fingerprint              string                `json:"Fingerprint"`
+	FingerprintHashAlgorithm string                `json:"FingerprintHashAlgorithm"`
+}
+
+// CertificateIdentifier is the identity of the subject or the issuer of a certificate
+type CertificateIdentifier struct {
+	CommonName         string `json:"CommonName,omitempty"`
+	Organization       string `json:"Organization,omitempty"`
+	OrganizationalUnit string `json:"OrganizationalUnit,omitempty"`
+	City               string `json:"City,omitempty"`
+	State              string `json:"State,omitempty"`
+	Country            string `json:"Country,omitempty"`
+}
+
+// EndpointCertificates lists the certificates presented by odimra, the plugins and the devices
+type EndpointCertificates struct {
+	OdataID        string                `json:"@odata.id"`
+	ID             string                `json:"Id"`
+	Name           string                `json:"Name"`
+	Description    string                `json:"Description"`
+	Endpoints      []EndpointCertificate `json:"Endpoints"`
+	EndpointsCount int                   `json:"Endpoints@odata.count"`
+}
+
+// EndpointCertificate is the certificate chain presented by an endpoint on the last connection to it
+type EndpointCertificate struct {
+	Endpoint     string `json:"Endpoint"`
+	EndpointType string `json:"EndpointType"`
+	// ValidNotAfter is the earliest expiry of the certificates of the chain
+	ValidNotAfter string             `json:"ValidNotAfter"`
+	LastSeen      string             `json:"LastSeen"`
+	Chain         []ChainCertificate `json:"Chain"`
+}
+
+// ChainCertificate is a certificate of the chain presented by an endpoint
+type ChainCertificate struct {
+	Subject        CertificateIdentifier `json:"Subject"`
+	Issuer         CertificateIdentifier `json:"Issuer"`
+	SerialNumber   string                `json:"SerialNumber"`
+	Fingerprint    string                `json:"Fingerprint"`
+	ValidNotBefore string                `json:"ValidNotBefore"`
+	ValidNotAfter  string                `json:"ValidNotAfter"`
+}
@@ -19,8 +19,10 @@ import (
 	"encoding/json"
 	log "github.com/sirupsen/logrus"
 	"net/http"
+	"strings"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	managersproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/managers"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-managers/managers"
@@ -40,14 +42,14 @@ func (m *Managers) GetManagersCollection(ctx context.Context, req *managersproto
 	sessionToken := req.SessionToken
 	authResp := m.IsAuthorizedRPC(sessionToken, []string{common.PrivilegeLogin}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authenticate session")
+		logs.Log(ctx).Error("error while trying to authenticate session")
 		resp.StatusCode = authResp.StatusCode
 		resp.StatusMessage = authResp.StatusMessage
 		resp.Body = generateResponse(authResp.Body)
 		resp.Header = authResp.Header
 		return nil
 	}
-	data, _ := m.EI.GetManagersCollection(req)
+	data, _ := m.EI.GetManagersCollection(ctx, req)
 	resp.Header = data.Header
 	resp.StatusCode = data.StatusCode
 	resp.StatusMessage = data.StatusMessage
@@ -65,14 +67,14 @@ func (m *Managers) GetManager(ctx context.Context, req *managersproto.ManagerReq
 	sessionToken := req.SessionToken
 	authResp := m.IsAuthorizedRPC(sessionToken, []string{common.PrivilegeLogin}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authenticate session")
+		logs.Log(ctx).Error("error while trying to authenticate session")
 		resp.StatusCode = authResp.StatusCode
 		resp.StatusMessage = authResp.StatusMessage
 		resp.Body = generateResponse(authResp.Body)
 		resp.Header = authResp.Header
 		return nil
 	}
-	data := m.EI.GetManagers(req)
+	data := m.EI.GetManagers(ctx, req)
 	resp.Header = data.Header
 	resp.StatusCode = data.StatusCode
 	resp.StatusMessage = data.StatusMessage
@@ -90,14 +92,14 @@ func (m *Managers) GetManagersResource(ctx context.Context, req *managersproto.M
 	sessionToken := req.SessionToken
 	authResp := m.IsAuthorizedRPC(sessionToken, []string{common.PrivilegeLogin}, []string{})
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while trying to authenticate session")
+		logs.Log(ctx).Error("error while trying to authenticate session")
 		resp.StatusCode = authResp.StatusCode
 		resp.StatusMessage = authResp.StatusMessage
 		resp.Body = generateResponse(authResp.Body)
 		resp.Header = authResp.Header
 		return nil
 	}
-	data := m.EI.GetManagersResource(req)
+	data := m.EI.GetManagersResource(ctx, req)
 	resp.Header = data.Header
 	resp.StatusCode = data.StatusCode
 	resp.StatusMessage = data.StatusMessage
@@ -105,6 +107,238 @@ func (m *Managers) GetManagersResource(ctx context.Context, req *managersproto.M
 	return nil
 }
 
+// ResetManager defines the operations which handles the RPC request response
+// for the Manager.Reset action of the managers micro service.
+// The action is performed on the device as a task, the task monitor URI is returned.
+func (m *Managers) ResetManager(ctx context.Context, req *managersproto.ManagerActionRequest, resp *managersproto.ManagerResponse) error {
+	m.startTask(ctx, req, resp, http.MethodPost, m.EI.ResetManager)
+	return nil
+}
+
+// ResetManagerToDefaults defines the operations which handles the RPC request response
+// for the Manager.ResetToDefaults action of the managers micro service.
+// The action is performed on the device as a task, the task monitor URI is returned.
+func (m *Managers) ResetManagerToDefaults(ctx context.Context, req *managersproto.ManagerActionRequest, resp *managersproto.ManagerResponse) error {
+	m.startTask(ctx, req, resp, http.MethodPost, m.EI.ResetManagerToDefaults)
+	return nil
+}
+
+// InsertVirtualMedia defines the operations which handles the RPC request response
+// for the VirtualMedia.InsertMedia action of the managers micro service.
+// The action is performed on the device as a task, the task monitor URI is returned.
+func (m *Managers) InsertVirtualMedia(ctx context.Context, req *managersproto.ManagerActionRequest, resp *managersproto.ManagerResponse) error {
+	m.startTask(ctx, req, resp, http.MethodPost, m.EI.InsertVirtualMedia)
+	return nil
+}
+
+// EjectVirtualMedia defines the operations which handles the RPC request response
+// for the VirtualMedia.EjectMedia action of the managers micro service.
+// The action is performed on the device as a task, the task monitor URI is returned.
+func (m *Managers) EjectVirtualMedia(ctx context.Context, req *managersproto.ManagerActionRequest, resp *managersproto.ManagerResponse) error {
+	m.startTask(ctx, req, resp, http.MethodPost, m.EI.EjectVirtualMedia)
+	return nil
+}
+
+// UpdateVirtualMedia defines the operations which handles the RPC request response
+// for the PATCH on a virtual media of the managers micro service.
+// The update is performed on the device as a task, the task monitor URI is returned.
+func (m *Managers) UpdateVirtualMedia(ctx context.Context, req *managersproto.ManagerActionRequest, resp *managersproto.ManagerResponse) error {
+	m.startTask(ctx, req, resp, http.MethodPatch, m.EI.UpdateVirtualMedia)
+	return nil
+}
+
+// CreateConsoleSession defines the operations which handles the RPC request response
+// for the creation of a console session on a manager of the managers micro service.
+// The console session is brokered with the plugin of the device, the URI to connect to is returned.
+func (m *Managers) CreateConsoleSession(ctx context.Context, req *managersproto.ManagerActionRequest, resp *managersproto.ManagerResponse) error {
+	authResp := m.IsAuthorizedRPC(req.SessionToken, []string{common.PrivilegeConfigureManager}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		logs.Log(ctx).Error("error while trying to authenticate session")
+		fillProtoResponse(resp, authResp)
+		return nil
+	}
+	sessionUserName, err := m.EI.Task.GetSessionUserName(req.SessionToken)
+	if err != nil {
+		errMsg := "error while trying to get the session username: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		fillProtoResponse(resp, common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errMsg, nil, nil))
+		return nil
+	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
+	fillProtoResponse(resp, m.EI.CreateConsoleSession(ctx, req, sessionUserName))
+	return nil
+}
+
+// ConnectConsoleSession defines the operations which handles the RPC request response
+// for the connection of a console session of the managers micro service.
+// The console session is authorized by its token and the session of the user who created it
+// must still be valid, the WebSocket of the plugin relaying the console is returned with the session,
+// so that svc-api closes the console once the session is gone.
+func (m *Managers) ConnectConsoleSession(ctx context.Context, req *managersproto.ConsoleSessionRequest, resp *managersproto.ConsoleSessionResponse) error {
+	session, data := m.EI.ConnectConsoleSession(ctx, req)
+	if session != nil {
+		ctx = logs.With(ctx, logs.SessionUserField, session.UserName)
+		authResp := m.IsAuthorizedRPC(session.SessionToken, []string{common.PrivilegeConfigureManager}, []string{})
+		if authResp.StatusCode != http.StatusOK {
+			logs.Log(ctx).Error("error while trying to authenticate the session of the console session")
+			data = authResp
+		} else {
+			resp.ConsoleURL = session.ConsoleURL
+			resp.UserName = session.UserName
+			resp.ConsoleType = session.ConsoleType
+			resp.SessionToken = session.SessionToken
+		}
+	}
+	resp.StatusCode = data.StatusCode
+	resp.StatusMessage = data.StatusMessage
+	resp.Body = generateResponse(data.Body)
+	return nil
+}
+
+// GetCertificateService defines the operations which handles the RPC request response
+// for getting the certificate service of the managers micro service.
+func (m *Managers) GetCertificateService(ctx context.Context, req *managersproto.ManagerRequest, resp *managersproto.ManagerResponse) error {
+	authResp := m.IsAuthorizedRPC(req.SessionToken, []string{common.PrivilegeLogin}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		logs.Log(ctx).Error("error while trying to authenticate session")
+		fillProtoResponse(resp, authResp)
+		return nil
+	}
+	fillProtoResponse(resp, m.EI.GetCertificateService(ctx, req))
+	return nil
+}
+
+// GetCertificateLocations defines the operations which handles the RPC request response
+// for getting the certificate locations of odimra and of all the devices.
+func (m *Managers) GetCertificateLocations(ctx context.Context, req *managersproto.ManagerRequest, resp *managersproto.ManagerResponse) error {
+	authResp := m.IsAuthorizedRPC(req.SessionToken, []string{common.PrivilegeLogin}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		logs.Log(ctx).Error("error while trying to authenticate session")
+		fillProtoResponse(resp, authResp)
+		return nil
+	}
+	fillProtoResponse(resp, m.EI.GetCertificateLocations(ctx, req))
+	return nil
+}
+
+// GetEndpointCertificates defines the operations which handles the RPC request response
+// for getting the certificates presented by odimra, the plugins and the devices.
+func (m *Managers) GetEndpointCertificates(ctx context.Context, req *managersproto.ManagerRequest, resp *managersproto.ManagerResponse) error {
+	authResp := m.IsAuthorizedRPC(req.SessionToken, []string{common.PrivilegeLogin}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		logs.Log(ctx).Error("error while trying to authenticate session")
+		fillProtoResponse(resp, authResp)
+		return nil
+	}
+	fillProtoResponse(resp, m.EI.GetEndpointCertificates(ctx, req))
+	return nil
+}
+
+// GenerateCSR defines the operations which handles the RPC request response
+// for the CertificateService.GenerateCSR action of the managers micro service.
+func (m *Managers) GenerateCSR(ctx context.Context, req *managersproto.ManagerActionRequest, resp *managersproto.ManagerResponse) error {
+	authResp := m.IsAuthorizedRPC(req.SessionToken, []string{common.PrivilegeConfigureManager}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		logs.Log(ctx).Error("error while trying to authenticate session")
+		fillProtoResponse(resp, authResp)
+		return nil
+	}
+	fillProtoResponse(resp, m.EI.GenerateCSR(ctx, req))
+	return nil
+}
+
+// ReplaceCertificate defines the operations which handles the RPC request response
+// for the CertificateService.ReplaceCertificate action of the managers micro service.
+// The user replacing the certificate is recorded in the audit logs.
+func (m *Managers) ReplaceCertificate(ctx context.Context, req *managersproto.ManagerActionRequest, resp *managersproto.ManagerResponse) error {
+	authResp := m.IsAuthorizedRPC(req.SessionToken, []string{common.PrivilegeConfigureManager}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		logs.Log(ctx).Error("error while trying to authenticate session")
+		fillProtoResponse(resp, authResp)
+		return nil
+	}
+	sessionUserName, err := m.EI.Task.GetSessionUserName(req.SessionToken)
+	if err != nil {
+		errMsg := "error while trying to get the session username: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		fillProtoResponse(resp, common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errMsg, nil, nil))
+		return nil
+	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
+	fillProtoResponse(resp, m.EI.ReplaceCertificate(ctx, req, sessionUserName))
+	return nil
+}
+
+// startTask authorizes the request, creates the task and starts the action in the background,
+// the response holds the task monitor URI the progress of the action can be tracked on
+func (m *Managers) startTask(ctx context.Context, req *managersproto.ManagerActionRequest, resp *managersproto.ManagerResponse, httpMethod string,
+	action func(context.Context, string, *managersproto.ManagerActionRequest) response.RPC) {
+	authResp := m.IsAuthorizedRPC(req.SessionToken, []string{common.PrivilegeConfigureManager}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		logs.Log(ctx).Error("error while trying to authenticate session")
+		fillProtoResponse(resp, authResp)
+		return
+	}
+	sessionUserName, err := m.EI.Task.GetSessionUserName(req.SessionToken)
+	if err != nil {
+		errMsg := "error while trying to get the session username: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		fillProtoResponse(resp, common.GeneralError(http.StatusUnauthorized, response.NoValidSession, errMsg, nil, nil))
+		return
+	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
+	taskURI, err := m.EI.Task.CreateTask(sessionUserName)
+	if err != nil {
+		errMsg := "error while trying to create task: " + err.Error()
+		logs.Log(ctx).Error(errMsg)
+		fillProtoResponse(resp, common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil))
+		return
+	}
+	strArray := strings.Split(strings.TrimSuffix(taskURI, "/"), "/")
+	taskID := strArray[len(strArray)-1]
+	err = m.EI.Task.UpdateTask(common.TaskData{
+		TaskID:          taskID,
+		TargetURI:       req.URL,
+		TaskRequest:     managers.TaskRequest(req.RequestBody),
+		TaskState:       common.Running,
+		TaskStatus:      common.OK,
+		PercentComplete: 0,
+		HTTPMethod:      httpMethod,
+	})
+	if err != nil {
+		logs.Log(ctx).Error("error while contacting task-service with UpdateTask RPC : " + err.Error())
+	}
+	go action(ctx, taskID, req)
+
+	// return 202 Accepted
+	rpcResp := response.RPC{
+		StatusCode:    http.StatusAccepted,
+		StatusMessage: response.TaskStarted,
+		Header: map[string]string{
+			"Content-type": "application/json; charset=utf-8",
+			"Location":     "/taskmon/" + taskID,
+		},
+	}
+	commonResponse := response.Response{
+		OdataType:    "#Task.v1_4_2.Task",
+		ID:           taskID,
+		Name:         "Task " + taskID,
+		OdataContext: "/redfish/v1/$metadata#Task.Task",
+		OdataID:      taskURI,
+	}
+	commonResponse.MessageArgs = []string{taskID}
+	commonResponse.CreateGenericResponse(rpcResp.StatusMessage)
+	rpcResp.Body = commonResponse
+	fillProtoResponse(resp, rpcResp)
+}
+
+func fillProtoResponse(resp *managersproto.ManagerResponse, data response.RPC) {
+	resp.StatusCode = data.StatusCode
+	resp.StatusMessage = data.StatusMessage
+	resp.Body = generateResponse(data.Body)
+	resp.Header = data.Header
+}
+
 func generateResponse(input interface{}) []byte {
 	bytes, err := json.Marshal(input)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
@@ -119,7 +120,7 @@ func mockGetResource(table, key string) (string, *errors.Error) {
 	return "body", nil
 }
 
-func mockGetDeviceInfo(req mgrcommon.ResourceInfoRequest) (string, error) {
+func mockGetDeviceInfo(ctx context.Context, req mgrcommon.ResourceInfoRequest) (string, error) {
 	if req.URL == "/redfish/v1/Managers/deviceAbsent:1" || req.URL == "/redfish/v1/Managers/uuid1:1/Ethernet" {
 		return "", fmt.Errorf("error")
 	}
@@ -141,13 +142,36 @@ func mockGetExternalInterface() *managers.ExternalInterface {
 		DB: managers.DB{
 			GetAllKeysFromTable: mockGetAllKeysFromTable,
 			GetManagerByURL:     mockGetManagerByURL,
+			GetManagersByURL:    common.EachMember(mockGetManagerByURL),
 			GetPluginData:       mockGetPluginData,
 			UpdateManagersData:  mockUpdateManagersData,
 			GetResource:         mockGetResource,
+			GetTarget:           mockGetTarget,
+		},
+		Task: managers.Task{
+			CreateTask:         mockCreateTask,
+			UpdateTask:         mockUpdateTask,
+			GetSessionUserName: mockGetSessionUserName,
 		},
 	}
 }
 
+func mockGetTarget(uuid string) (*mgrmodel.DeviceTarget, *errors.Error) {
+	return nil, errors.PackError(errors.DBKeyNotFound, "not found")
+}
+
+func mockCreateTask(sessionUserName string) (string, error) {
+	return "/redfish/v1/TaskService/Tasks/task12345", nil
+}
+
+func mockUpdateTask(taskData common.TaskData) error {
+	return nil
+}
+
+func mockGetSessionUserName(sessionToken string) (string, error) {
+	return "admin", nil
+}
+
 func mockGetAllKeysFromTable(table string) ([]string, error) {
 	return []string{"/redfish/v1/Managers/uuid:1"}, nil
 }
@@ -267,3 +291,96 @@ func TestGetManagerResourcewithValidtoken(t *testing.T) {
 	assert.Nil(t, err, "The two words should be the same.")
 	assert.Equal(t, int(resp.StatusCode), http.StatusOK, "Status code should be StatusOK.")
 }
+
+func TestResetManager(t *testing.T) {
+	common.SetUpMockConfig()
+	var ctx context.Context
+	mgr := new(Managers)
+	mgr.IsAuthorizedRPC = mockIsAuthorized
+	mgr.EI = mockGetExternalInterface()
+
+	req := &managersproto.ManagerActionRequest{
+		ManagerID:    "uuid:1",
+		URL:          "/redfish/v1/Managers/uuid:1/Actions/Manager.Reset",
+		SessionToken: "InvalidToken",
+	}
+	var resp = &managersproto.ManagerResponse{}
+	mgr.ResetManager(ctx, req, resp)
+	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status code should be StatusUnauthorized.")
+
+	req.SessionToken = "validToken"
+	resp = &managersproto.ManagerResponse{}
+	err := mgr.ResetManager(ctx, req, resp)
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, http.StatusAccepted, int(resp.StatusCode), "Status code should be StatusAccepted.")
+	assert.Equal(t, "/taskmon/task12345", resp.Header["Location"], "Location should be the task monitor URI")
+}
+
+func mockTakeConsoleSession(token string) (*mgrmodel.ConsoleSession, *errors.Error) {
+	sessionToken := "validToken"
+	if token == "expiredUserSession" {
+		sessionToken = "InvalidToken"
+	} else if token != "consoleToken" {
+		return nil, errors.PackError(errors.DBKeyNotFound, "not found")
+	}
+	return &mgrmodel.ConsoleSession{
+		ManagerID:    "uuid:1",
+		ConsoleType:  "SerialConsole",
+		UserName:     "admin",
+		SessionToken: sessionToken,
+		ConsoleURL:   "wss://localhost:9093/ODIM/v1/Console/pluginToken",
+		ExpiresAt:    time.Now().Add(time.Minute),
+	}, nil
+}
+
+func TestConnectConsoleSession(t *testing.T) {
+	common.SetUpMockConfig()
+	var ctx context.Context
+	mgr := new(Managers)
+	mgr.IsAuthorizedRPC = mockIsAuthorized
+	mgr.EI = mockGetExternalInterface()
+	mgr.EI.DB.TakeConsoleSession = mockTakeConsoleSession
+
+	req := &managersproto.ConsoleSessionRequest{ManagerID: "uuid:1", Token: "consoleToken"}
+	var resp = &managersproto.ConsoleSessionResponse{}
+	err := mgr.ConnectConsoleSession(ctx, req, resp)
+	assert.Nil(t, err, "There should be no error")
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	assert.Equal(t, "wss://localhost:9093/ODIM/v1/Console/pluginToken", resp.ConsoleURL, "console URL of the plugin should be returned")
+	assert.Equal(t, "admin", resp.UserName, "user of the console session should be returned")
+	assert.Equal(t, "validToken", resp.SessionToken, "session of the user of the console session should be returned")
+
+	req.Token = "expiredUserSession"
+	resp = &managersproto.ConsoleSessionResponse{}
+	mgr.ConnectConsoleSession(ctx, req, resp)
+	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status code should be StatusUnauthorized.")
+	assert.Equal(t, "", resp.ConsoleURL, "console URL should not be returned")
+
+	req.Token = "unknownToken"
+	resp = &managersproto.ConsoleSessionResponse{}
+	mgr.ConnectConsoleSession(ctx, req, resp)
+	assert.Equal(t, http.StatusNotFound, int(resp.StatusCode), "Status code should be StatusNotFound.")
+}
+
+func TestGetCertificateService(t *testing.T) {
+	common.SetUpMockConfig()
+	var ctx context.Context
+	mgr := new(Managers)
+	mgr.IsAuthorizedRPC = mockIsAuthorized
+	mgr.EI = mockGetExternalInterface()
+
+	var resp = &managersproto.ManagerResponse{}
+	mgr.GetCertificateService(ctx, &managersproto.ManagerRequest{SessionToken: "InvalidToken"}, resp)
+	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status code should be StatusUnauthorized.")
+
+	resp = &managersproto.ManagerResponse{}
+	mgr.GetCertificateService(ctx, &managersproto.ManagerRequest{SessionToken: "validToken"}, resp)
+	assert.Equal(t, http.StatusOK, int(resp.StatusCode), "Status code should be StatusOK.")
+	var service map[string]interface{}
+	json.Unmarshal(resp.Body, &service)
+	assert.Equal(t, "/redfish/v1/CertificateService", service["@odata.id"], "certificate service should be returned")
+
+	resp = &managersproto.ManagerResponse{}
+	mgr.ReplaceCertificate(ctx, &managersproto.ManagerActionRequest{SessionToken: "InvalidToken"}, resp)
+	assert.Equal(t, http.StatusUnauthorized, int(resp.StatusCode), "Status code should be StatusUnauthorized.")
+}
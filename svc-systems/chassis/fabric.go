@@ -17,13 +17,13 @@ package chassis
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
 	"sync"
 
 	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
+	"github.com/ODIM-Project/ODIM/lib-rest-client/pluginclient"
 	"github.com/ODIM-Project/ODIM/lib-rest-client/pmbhandle"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
@@ -55,24 +55,13 @@ func getFabricFactory(collection *sresponse.Collection) *fabricFactory {
 }
 
 type pluginContactRequest struct {
-	URL             string
-	HTTPMethodType  string
-	ContactClient   func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
-	PostBody        interface{}
-	LoginCredential map[string]string
-	Plugin          smodel.Plugin
-	Token           string
+	URL            string
+	HTTPMethodType string
+	ContactClient  func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
+	PostBody       interface{}
+	Plugin         smodel.Plugin
 }
 
-// PluginToken interface to hold the token
-type PluginToken struct {
-	Tokens map[string]string
-	lock   sync.RWMutex
-}
-
-// Token variable hold the all the XAuthToken  against the plguin ID
-var Token PluginToken
-
 func (c *sourceProviderImpl) findFabricChassis(collection *sresponse.Collection) {
 	f := c.getFabricFactory(collection)
 	managers, err := f.getFabricManagers()
@@ -114,20 +103,6 @@ func (f *fabricFactory) getFabricManagerChassis(plugin smodel.Plugin) {
 
 // createChassisRequest creates the parameters ready for the plugin communication
 func (f *fabricFactory) createChassisRequest(plugin smodel.Plugin, url, method string, body *json.RawMessage) (pReq *pluginContactRequest, errResp *response.RPC, err error) {
-	var token string
-	cred := make(map[string]string)
-
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		token = f.getPluginToken(plugin)
-		if token == "" {
-			*errResp = common.GeneralError(http.StatusUnauthorized, response.ResourceAtURIUnauthorized, "unable to create session for plugin "+plugin.ID, []interface{}{url}, nil)
-			return nil, errResp, fmt.Errorf("unable to create session for plugin " + plugin.ID)
-		}
-	} else {
-		cred["UserName"] = plugin.Username
-		cred["Password"] = string(plugin.Password)
-	}
-
 	// validating Patch request properties are in uppercamelcase or not
 	if strings.EqualFold(method, http.MethodPatch) {
 		errResp = validateReqParamsCase(body)
@@ -144,13 +119,11 @@ func (f *fabricFactory) createChassisRequest(plugin smodel.Plugin, url, method s
 	}
 
 	pReq = &pluginContactRequest{
-		Token:           token,
-		LoginCredential: cred,
-		ContactClient:   f.contactClient,
-		Plugin:          plugin,
-		HTTPMethodType:  method,
-		URL:             url,
-		PostBody:        body,
+		ContactClient:  f.contactClient,
+		Plugin:         plugin,
+		HTTPMethodType: method,
+		URL:            url,
+		PostBody:       body,
 	}
 	return pReq, nil, nil
 }
@@ -158,9 +131,6 @@ func (f *fabricFactory) createChassisRequest(plugin smodel.Plugin, url, method s
 // collectChassisCollection contacts the plugin and collect the chassis response
 func collectChassisCollection(f *fabricFactory, pluginRequest *pluginContactRequest) ([]dmtf.Link, error) {
 	body, _, statusCode, _, err := contactPlugin(pluginRequest)
-	if statusCode == http.StatusUnauthorized && strings.EqualFold(pluginRequest.Plugin.PreferredAuthType, "XAuthToken") {
-		body, _, statusCode, _, err = retryFabricsOperation(f, pluginRequest)
-	}
 	if err != nil {
 		return []dmtf.Link{}, fmt.Errorf("while trying contact plugin " + pluginRequest.Plugin.ID + ", got " + err.Error())
 	}
@@ -170,57 +140,47 @@ func collectChassisCollection(f *fabricFactory, pluginRequest *pluginContactRequ
 	return extractChassisCollection(body)
 }
 
+// contactPlugin sends the request to the plugin and returns the response body, the session token,
+// the status code and the status message. An error is returned when the plugin couldn't be reached,
+// the error response of the plugin being returned with its status code otherwise.
 func contactPlugin(req *pluginContactRequest) ([]byte, string, int, string, error) {
-	pluginResponse, err := callPlugin(req)
+	pluginResponse, err := pluginclient.Do(pluginclient.Request{
+		Plugin: pluginclient.Plugin{
+			ID:                req.Plugin.ID,
+			IP:                req.Plugin.IP,
+			Port:              req.Plugin.Port,
+			Username:          req.Plugin.Username,
+			Password:          req.Plugin.Password,
+			PreferredAuthType: req.Plugin.PreferredAuthType,
+		},
+		Method:        req.HTTPMethodType,
+		URI:           req.URL,
+		Body:          req.PostBody,
+		ContactClient: req.ContactClient,
+		StatusCheck: func() bool {
+			return getPluginStatus(req.Plugin)
+		},
+	})
 	if err != nil {
-		if getPluginStatus(req.Plugin) {
-			pluginResponse, err = callPlugin(req)
-		}
-		if err != nil {
-			return nil, "", http.StatusInternalServerError, response.InternalError, fmt.Errorf(err.Error())
+		if err.Body == nil {
+			return nil, "", int(err.StatusCode), err.StatusMessage, err
 		}
+		return err.Body, "", int(err.StatusCode), statusMessage(int(err.StatusCode)), nil
 	}
-	defer pluginResponse.Body.Close()
-	body, err := ioutil.ReadAll(pluginResponse.Body)
-	if err != nil {
-		return nil, "", http.StatusInternalServerError, response.InternalError, fmt.Errorf(err.Error())
-	}
-	var statusMessage string
-	switch pluginResponse.StatusCode {
+	return pluginResponse.Body, pluginResponse.Header.Get("X-Auth-Token"), pluginResponse.StatusCode, statusMessage(pluginResponse.StatusCode), nil
+}
+
+func statusMessage(statusCode int) string {
+	switch statusCode {
 	case http.StatusOK:
-		statusMessage = response.Success
+		return response.Success
 	case http.StatusUnauthorized:
-		statusMessage = response.ResourceAtURIUnauthorized
+		return response.ResourceAtURIUnauthorized
 	case http.StatusNotFound:
-		statusMessage = response.ResourceNotFound
+		return response.ResourceNotFound
 	default:
-		statusMessage = response.CouldNotEstablishConnection
+		return response.CouldNotEstablishConnection
 	}
-	return body, pluginResponse.Header.Get("X-Auth-Token"), pluginResponse.StatusCode, statusMessage, nil
-}
-
-// retryFabricsOperation will be called whenever  the unauthorized status code during the plugin call
-// This function will create a new session token reexcutes the plugin call
-func retryFabricsOperation(f *fabricFactory, req *pluginContactRequest) ([]byte, string, int, string, error) {
-	var resp response.RPC
-	var token = f.createToken(req.Plugin)
-	if token == "" {
-		resp = common.GeneralError(http.StatusUnauthorized, response.NoValidSession, "error: Unable to create session with plugin "+req.Plugin.ID,
-			[]interface{}{}, nil)
-		data, _ := json.Marshal(resp.Body)
-		return data, "", int(resp.StatusCode), response.NoValidSession, fmt.Errorf("error: Unable to create session with plugin")
-	}
-	req.Token = token
-	return contactPlugin(req)
-
-}
-
-func callPlugin(req *pluginContactRequest) (*http.Response, error) {
-	var reqURL = "https://" + req.Plugin.IP + ":" + req.Plugin.Port + req.URL
-	if strings.EqualFold(req.Plugin.PreferredAuthType, "BasicAuth") {
-		return req.ContactClient(reqURL, req.HTTPMethodType, "", "", req.PostBody, req.LoginCredential)
-	}
-	return req.ContactClient(reqURL, req.HTTPMethodType, req.Token, "", req.PostBody, nil)
 }
 
 // getPluginStatus checks the status of given plugin in configured interval
@@ -246,47 +206,6 @@ func getPluginStatus(plugin smodel.Plugin) bool {
 	return status
 }
 
-// getPluginToken will verify the if any token present to the plugin else it will create token for the new plugin
-func (f *fabricFactory) getPluginToken(plugin smodel.Plugin) string {
-	authToken := Token.getToken(plugin.ID)
-	if authToken == "" {
-		return f.createToken(plugin)
-	}
-	return authToken
-}
-
-func (f *fabricFactory) createToken(plugin smodel.Plugin) string {
-	var contactRequest pluginContactRequest
-	contactRequest.ContactClient = f.contactClient
-	contactRequest.Plugin = plugin
-	contactRequest.HTTPMethodType = http.MethodPost
-	contactRequest.PostBody = map[string]interface{}{
-		"Username": plugin.Username,
-		"Password": string(plugin.Password),
-	}
-	contactRequest.URL = "/ODIM/v1/Sessions"
-	_, token, _, _, err := contactPlugin(&contactRequest)
-	if err != nil {
-		log.Error(err.Error())
-	}
-	if token != "" {
-		Token.storeToken(plugin.ID, token)
-	}
-	return token
-}
-
-func (p *PluginToken) storeToken(plguinID, token string) {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-	p.Tokens[plguinID] = token
-}
-
-func (p *PluginToken) getToken(pluginID string) string {
-	p.lock.RLock()
-	defer p.lock.RUnlock()
-	return p.Tokens[pluginID]
-}
-
 // extractChassisCollection unmarshals the plugin response and returns the collection members
 func extractChassisCollection(body []byte) ([]dmtf.Link, error) {
 	var resp sresponse.Collection
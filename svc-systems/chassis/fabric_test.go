@@ -28,7 +28,6 @@ import (
 )
 
 func Test_sourceProviderImpl_findFabricChassis(t *testing.T) {
-	Token.Tokens = make(map[string]string)
 	config.SetUpMockConfig(t)
 	col := sresponse.NewChassisCollection()
 	type args struct {
@@ -100,7 +99,10 @@ func contactClientMock(url, method, token string, odataID string, body interface
 			},
 		},
 	}
-	if url == "https://:/ODIM/v1/Chassis" {
+	if url == "https://:/ODIM/v1/Sessions" {
+		resp.StatusCode = http.StatusCreated
+		resp.Body = ioutil.NopCloser(bytes.NewBufferString(""))
+	} else if url == "https://:/ODIM/v1/Chassis" {
 		if token != "" {
 			resp.Body = ioutil.NopCloser(bytes.NewBufferString(tokenBody))
 		} else {
@@ -70,9 +70,6 @@ func (f *fabricFactory) getResource(plugin smodel.Plugin, rID string, ch chan re
 // pluginContactRequest, and returns the RPC response
 func collectChassisResource(f *fabricFactory, pluginRequest *pluginContactRequest) (r response.RPC) {
 	body, _, statusCode, _, err := contactPlugin(pluginRequest)
-	if statusCode == http.StatusUnauthorized && strings.EqualFold(pluginRequest.Plugin.PreferredAuthType, "XAuthToken") {
-		body, _, statusCode, _, err = retryFabricsOperation(f, pluginRequest)
-	}
 	if err != nil {
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 	}
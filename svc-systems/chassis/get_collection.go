@@ -24,6 +24,7 @@ import (
 	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	chassisproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/chassis"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-systems/plugin"
 	"github.com/ODIM-Project/ODIM/svc-systems/sresponse"
@@ -33,22 +34,25 @@ const collectionURL = "/redfish/v1/Chassis"
 
 func NewGetCollectionHandler(
 	pcf plugin.ClientFactory,
-	imkp func(table string) ([]string, error)) *GetCollection {
+	imkp func(table string) ([]string, error),
+	imrp func(table string, keys []string) (map[string]string, *errors.Error)) *GetCollection {
 
 	return &GetCollection{
-		&sourceProviderImpl{
+		sourcesProvider: &sourceProviderImpl{
 			pluginClientFactory: pcf,
 			getAllKeys:          imkp,
 			getFabricFactory:    getFabricFactory,
 		},
+		inMemoryResourceProvider: imrp,
 	}
 }
 
 type GetCollection struct {
-	sourcesProvider sourceProvider
+	sourcesProvider          sourceProvider
+	inMemoryResourceProvider func(table string, keys []string) (map[string]string, *errors.Error)
 }
 
-func (h *GetCollection) Handle() (r response.RPC) {
+func (h *GetCollection) Handle(req *chassisproto.GetChassisRequest) (r response.RPC) {
 	sources, e := h.sourcesProvider.findSources()
 	if e != nil {
 		return *e
@@ -68,7 +72,16 @@ func (h *GetCollection) Handle() (r response.RPC) {
 	h.sourcesProvider.findFabricChassis(&allChassisCollection)
 
 	initializeRPCResponse(&r, allChassisCollection)
-	return
+	return common.ApplyQueryOptionsWithIndex(r, req.URL, h.getMembers, common.FilterIndexLookup("Chassis"))
+}
+
+// getMembers reads the managed chassis from the in-memory DB for expanding and filtering the collection,
+// chassis served by the plugins are neither expanded nor matched by $filter
+func (h *GetCollection) getMembers(odataIDs []string) (map[string]string, *errors.Error) {
+	if h.inMemoryResourceProvider == nil {
+		return map[string]string{}, nil
+	}
+	return h.inMemoryResourceProvider("Chassis", odataIDs)
 }
 
 type sourceProvider interface {
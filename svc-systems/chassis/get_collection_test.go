@@ -23,6 +23,7 @@ import (
 
 	dmtfmodel "github.com/ODIM-Project/ODIM/lib-dmtf/model"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	chassisproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/chassis"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-systems/plugin"
 	"github.com/ODIM-Project/ODIM/svc-systems/sresponse"
@@ -39,9 +40,9 @@ func Test_GetCollectionHandler_WhenMultipleSourcesAreAvailable(t *testing.T) {
 
 	cspMock := new(collectionSourceProviderMock)
 	cspMock.On("findSources").Return([]source{source1, source2}, nil)
-	sut := GetCollection{cspMock}
+	sut := GetCollection{sourcesProvider: cspMock}
 
-	r := sut.Handle()
+	r := sut.Handle(&chassisproto.GetChassisRequest{URL: collectionURL})
 	require.EqualValues(t, http.StatusOK, r.StatusCode)
 	require.IsType(t, sresponse.NewChassisCollection(), r.Body)
 	require.Equal(t, []dmtfmodel.Link{{Oid: "1"}, {Oid: "3"}, {Oid: "2"}, {Oid: "4"}, {Oid: "5"}}, r.Body.(sresponse.Collection).Members)
@@ -55,13 +56,43 @@ func Test_GetCollectionHandler_WhenMultipleSourcesAreAvailable(t *testing.T) {
 	}, r.Header)
 }
 
+func Test_GetCollectionHandler_WithQueryParameters(t *testing.T) {
+	source1 := new(sourceMock)
+	source1.On("read").Return([]dmtfmodel.Link{{Oid: "/redfish/v1/Chassis/1"}, {Oid: "/redfish/v1/Chassis/2"}}, nil)
+
+	cspMock := new(collectionSourceProviderMock)
+	cspMock.On("findSources").Return([]source{source1}, nil)
+	sut := GetCollection{
+		sourcesProvider: cspMock,
+		inMemoryResourceProvider: func(table string, keys []string) (map[string]string, *errors.Error) {
+			resources := make(map[string]string, len(keys))
+			for _, key := range keys {
+				resources[key] = `{"@odata.id":"` + key + `","Id":"` + key[len(key)-1:] + `","Name":"Chassis"}`
+			}
+			return resources, nil
+		},
+	}
+
+	r := sut.Handle(&chassisproto.GetChassisRequest{URL: collectionURL + "?$expand=*&$top=1"})
+	require.EqualValues(t, http.StatusOK, r.StatusCode)
+	body := r.Body.(map[string]interface{})
+	require.Equal(t, []interface{}{map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/1", "Id": "1", "Name": "Chassis"}}, body["Members"])
+	require.Equal(t, collectionURL+"?$expand=*&$top=1&$skip=1", body["Members@odata.nextLink"])
+
+	r = sut.Handle(&chassisproto.GetChassisRequest{URL: collectionURL + "?$filter=Id%20eq%20'2'%20and%20Name%20eq%20Chassis"})
+	require.EqualValues(t, http.StatusOK, r.StatusCode)
+	body = r.Body.(map[string]interface{})
+	require.Equal(t, []interface{}{map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/2"}}, body["Members"])
+	require.Equal(t, 1, body["Members@odata.count"])
+}
+
 func Test_GetCollectionHandler_WhenCollectionSourcesCannotBeDetermined(t *testing.T) {
 	cspMock := new(collectionSourceProviderMock)
 
 	cspMock.On("findSources").Return([]source{}, &internalError)
-	sut := GetCollection{cspMock}
+	sut := GetCollection{sourcesProvider: cspMock}
 
-	r := sut.Handle()
+	r := sut.Handle(&chassisproto.GetChassisRequest{URL: collectionURL})
 	require.NotEqual(t, http.StatusOK, r.StatusCode)
 	require.IsType(t, response.CommonError{}, r.Body)
 }
@@ -71,9 +102,9 @@ func Test_GetCollectionHandler_WhenFirstSourceReturnsError(t *testing.T) {
 	source1.On("read").Return([]dmtfmodel.Link{}, &internalError)
 	cspMock := new(collectionSourceProviderMock)
 	cspMock.On("findSources").Return([]source{source1}, nil)
-	sut := GetCollection{cspMock}
+	sut := GetCollection{sourcesProvider: cspMock}
 
-	r := sut.Handle()
+	r := sut.Handle(&chassisproto.GetChassisRequest{URL: collectionURL})
 	require.NotEqual(t, http.StatusOK, r.StatusCode)
 	require.IsType(t, response.CommonError{}, r.Body)
 }
@@ -86,9 +117,9 @@ func Test_GetCollectionHandler_WhenNonFirstSourceReturnsError(t *testing.T) {
 	source2.On("read").Return([]dmtfmodel.Link{}, &internalError)
 	cspMock := new(collectionSourceProviderMock)
 	cspMock.On("findSources").Return([]source{source1, source2}, nil)
-	sut := GetCollection{cspMock}
+	sut := GetCollection{sourcesProvider: cspMock}
 
-	r := sut.Handle()
+	r := sut.Handle(&chassisproto.GetChassisRequest{URL: collectionURL})
 	require.NotEqual(t, http.StatusOK, r.StatusCode)
 	require.IsType(t, response.CommonError{}, r.Body)
 }
@@ -26,7 +26,6 @@ import (
 )
 
 func Test_fabricFactory_updateFabricChassisResource(t *testing.T) {
-	Token.Tokens = make(map[string]string)
 	config.SetUpMockConfig(t)
 	f := getFabricFactoryMock(nil)
 	var r response.RPC
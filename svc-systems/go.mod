@@ -10,7 +10,8 @@ require (
 	github.com/ODIM-Project/ODIM/lib-utilities v0.0.0-20201201072448-9772421f1b55
 	github.com/gomodule/redigo v2.0.0+incompatible
 	github.com/sirupsen/logrus v1.4.2
-	github.com/stretchr/testify v1.5.1
+	github.com/stretchr/testify v1.7.1
+	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
 	github.com/yudai/pp v2.0.1+incompatible // indirect
 	gopkg.in/go-playground/validator.v9 v9.30.0
 )
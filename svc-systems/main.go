@@ -34,7 +34,8 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
 	// verifying the uid of the user
@@ -50,8 +51,6 @@ func main() {
 		log.Fatal("error while trying to check DB connection health: " + err.Error())
 	}
 
-	chassis.Token.Tokens = make(map[string]string)
-
 	schemaFile, err := ioutil.ReadFile(config.Data.SearchAndFilterSchemaPath)
 	if err != nil {
 		log.Fatal("Error while trying to read search/filter schema json: " + err.Error())
@@ -60,6 +59,9 @@ func main() {
 	if err != nil {
 		log.Fatal("Error while trying to fetch search/filter schema json: " + err.Error())
 	}
+	if err = common.SetFilterIndexes(schemaFile); err != nil {
+		log.Fatal(err.Error())
+	}
 
 	configFilePath := os.Getenv("CONFIG_FILE_PATH")
 	if configFilePath == "" {
@@ -89,7 +91,7 @@ func registerHandler() {
 	chassisRPC := rpc.NewChassisRPC(
 		services.IsAuthorized,
 		chassis.NewCreateHandler(pcf),
-		chassis.NewGetCollectionHandler(pcf, smodel.GetAllKeysFromTable),
+		chassis.NewGetCollectionHandler(pcf, smodel.GetAllKeysFromTable, smodel.GetResources),
 		chassis.NewDeleteHandler(pcf, smodel.Find),
 		chassis.NewGetHandler(pcf, smodel.Find),
 		chassis.NewUpdateHandler(pcf),
@@ -101,7 +101,7 @@ func (cha *ChassisRPC) GetChassisResource(ctx context.Context, req *chassisproto
 		return nil
 	}
 	var pc = chassis.PluginContact{
-		ContactClient:   pmbhandle.ContactPlugin,
+		ContactClient:   pmbhandle.ContactClient(ctx),
 		DecryptPassword: common.DecryptWithPrivateKey,
 		GetPluginStatus: scommon.GetPluginStatus,
 	}
@@ -116,7 +116,7 @@ func (cha *ChassisRPC) GetChassisResource(ctx context.Context, req *chassisproto
 // to send back to requested user.
 func (cha *ChassisRPC) GetChassisCollection(_ context.Context, req *chassisproto.GetChassisRequest, resp *chassisproto.GetChassisResponse) error {
 	r := auth(cha.IsAuthorizedRPC, req.SessionToken, []string{common.PrivilegeLogin}, func() response.RPC {
-		return cha.GetCollectionHandler.Handle()
+		return cha.GetCollectionHandler.Handle(req)
 	})
 	addDefaultHeaders(rewrite(r, resp))
 	return nil
@@ -162,7 +162,7 @@ func jsonMarshal(input interface{}) []byte {
 	}
 	bytes, err := json.Marshal(input)
 	if err != nil {
-		log.Println("error in unmarshalling response object from util-libs", err.Error())
+		log.Error("error in unmarshalling response object from util-libs: " + err.Error())
 	}
 	return bytes
 }
@@ -131,7 +131,7 @@ func TestChassis_GetAllChassis(t *testing.T) {
 				return nil, errors.PackError(errors.DBKeyNotFound, "error")
 			}, func(table string) ([]string, error) {
 				return []string{}, nil
-			}), nil, nil, nil)
+			}, smodel.GetResources), nil, nil, nil)
 
 	type args struct {
 		ctx  context.Context
@@ -49,7 +49,7 @@ func (s *Systems) GetSystemResource(ctx context.Context, req *systemsproto.GetSy
 		return nil
 	}
 	var pc = systems.PluginContact{
-		ContactClient:   pmbhandle.ContactPlugin,
+		ContactClient:   pmbhandle.ContactClient(ctx),
 		DevicePassword:  common.DecryptWithPrivateKey,
 		GetPluginStatus: scommon.GetPluginStatus,
 	}
@@ -90,7 +90,7 @@ func (s *Systems) GetSystems(ctx context.Context, req *systemsproto.GetSystemsRe
 		return nil
 	}
 	var pc = systems.PluginContact{
-		ContactClient:   pmbhandle.ContactPlugin,
+		ContactClient:   pmbhandle.ContactClient(ctx),
 		DevicePassword:  common.DecryptWithPrivateKey,
 		GetPluginStatus: scommon.GetPluginStatus,
 	}
@@ -114,7 +114,7 @@ func (s *Systems) ComputerSystemReset(ctx context.Context, req *systemsproto.Com
 		return nil
 	}
 	var pc = systems.PluginContact{
-		ContactClient:  pmbhandle.ContactPlugin,
+		ContactClient:  pmbhandle.ContactClient(ctx),
 		DevicePassword: common.DecryptWithPrivateKey,
 	}
 	data := pc.ComputerSystemReset(req)
@@ -137,7 +137,7 @@ func (s *Systems) SetDefaultBootOrder(ctx context.Context, req *systemsproto.Def
 		return nil
 	}
 	var pc = systems.PluginContact{
-		ContactClient:  pmbhandle.ContactPlugin,
+		ContactClient:  pmbhandle.ContactClient(ctx),
 		DevicePassword: common.DecryptWithPrivateKey,
 	}
 	data := pc.SetDefaultBootOrder(req.SystemID)
@@ -160,7 +160,7 @@ func (s *Systems) ChangeBiosSettings(ctx context.Context, req *systemsproto.Bios
 		return nil
 	}
 	var pc = systems.PluginContact{
-		ContactClient:  pmbhandle.ContactPlugin,
+		ContactClient:  pmbhandle.ContactClient(ctx),
 		DevicePassword: common.DecryptWithPrivateKey,
 	}
 	data := pc.ChangeBiosSettings(req)
@@ -183,8 +183,9 @@ func (s *Systems) ChangeBootOrderSettings(ctx context.Context, req *systemsproto
 		return nil
 	}
 	var pc = systems.PluginContact{
-		ContactClient:  pmbhandle.ContactPlugin,
-		DevicePassword: common.DecryptWithPrivateKey,
+		ContactClient:           pmbhandle.ContactClient(ctx),
+		DevicePassword:          common.DecryptWithPrivateKey,
+		UpdateDynamicAggregates: systems.UpdateDynamicAggregates,
 	}
 	data := pc.ChangeBootOrderSettings(req)
 	fillSystemProtoResponse(resp, data)
@@ -231,6 +232,40 @@ func (s *Systems) DeleteVolume(ctx context.Context, req *systemsproto.VolumeRequ
 	return nil
 }
 
+// PassThrough defines the operations which handles the RPC request response
+// for the PassThrough service of systems micro service.
+// The functionality forwards the request on a resource which is not modelled
+// to the plugin, and return backs the response of the plugin to RPC.
+// The session is authorized with the privileges required by the HTTP method
+// of the request.
+func (s *Systems) PassThrough(ctx context.Context, req *systemsproto.PassThroughRequest, resp *systemsproto.SystemsResponse) error {
+	sessionToken := req.SessionToken
+	authResp := s.IsAuthorizedRPC(sessionToken, []string{passThroughPrivilege(req.Method)}, []string{})
+	if authResp.StatusCode != http.StatusOK {
+		log.Error("error while trying to authenticate session")
+		fillSystemProtoResponse(resp, authResp)
+		return nil
+	}
+	var pc = systems.PluginContact{
+		ContactClient:   pmbhandle.ContactClient(ctx),
+		DevicePassword:  common.DecryptWithPrivateKey,
+		GetPluginStatus: scommon.GetPluginStatus,
+	}
+	data := pc.PassThrough(ctx, req)
+	fillSystemProtoResponse(resp, data)
+	return nil
+}
+
+// passThroughPrivilege returns the privilege required for the HTTP method,
+// reading a resource requires Login and modifying it requires ConfigureComponents
+func passThroughPrivilege(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return common.PrivilegeLogin
+	}
+	return common.PrivilegeConfigureComponents
+}
+
 func fillSystemProtoResponse(resp *systemsproto.SystemsResponse, data response.RPC) {
 	resp.StatusCode = data.StatusCode
 	resp.StatusMessage = data.StatusMessage
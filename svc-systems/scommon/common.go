@@ -24,9 +24,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ODIM-Project/ODIM/lib-rest-client/pluginclient"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
-	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/schemavalidation"
 	"github.com/ODIM-Project/ODIM/svc-systems/smodel"
 )
 
@@ -42,10 +43,8 @@ var SF Schema
 
 //PluginContactRequest  hold the request of contact plugin
 type PluginContactRequest struct {
-	Token           string
 	OID             string
 	DeviceInfo      interface{}
-	BasicAuth       map[string]string
 	ContactClient   func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
 	GetPluginStatus func(smodel.Plugin) bool
 	Plugin          smodel.Plugin
@@ -89,27 +88,6 @@ func GetResourceInfoFromDevice(req ResourceInfoRequest, saveRequired bool) (stri
 	contactRequest.ContactClient = req.ContactClient
 	contactRequest.Plugin = plugin
 	contactRequest.GetPluginStatus = req.GetPluginStatus
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		contactRequest.HTTPMethodType = http.MethodPost
-		contactRequest.DeviceInfo = map[string]interface{}{
-			"Username": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		contactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, _, err := ContactPlugin(contactRequest, "error while getting the details "+contactRequest.OID+": ")
-		if err != nil {
-
-			return "", err
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.BasicAuth = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	decryptedPasswordByte, err := req.DevicePassword(target.Password)
 	if err != nil {
 		// Frame the RPC response body and response Header below
@@ -129,6 +107,7 @@ func GetResourceInfoFromDevice(req ResourceInfoRequest, saveRequired bool) (stri
 	if err != nil {
 		return "", err
 	}
+	body = schemavalidation.Validate(plugin.ID, strings.TrimSuffix(req.URL, "/"), body)
 
 	var resourceData map[string]interface{}
 	err = json.Unmarshal(body, &resourceData)
@@ -199,47 +178,40 @@ func getResourceName(oDataID string, memberFlag bool) string {
 	return str[len(str)-2]
 }
 
-// ContactPlugin is commons which handles the request and response of Contact Plugin usage
+// ContactPlugin is commons which handles the request and response of Contact Plugin usage.
+// The request is authenticated with the session token or the basic authentication of the
+// plugin, and the error response of the plugin is returned as a Redfish error response.
 func ContactPlugin(req PluginContactRequest, errorMessage string) ([]byte, string, ResponseStatus, error) {
 	var resp ResponseStatus
-	var response *http.Response
-	var err error
-	response, err = callPlugin(req)
-	if err != nil {
-		if req.GetPluginStatus(req.Plugin) {
-			response, err = callPlugin(req)
-		}
-		if err != nil {
-			errorMessage = errorMessage + err.Error()
-			resp.StatusCode = http.StatusInternalServerError
-			resp.StatusMessage = errors.InternalError
-			log.Error(errorMessage)
-			return nil, "", resp, fmt.Errorf(errorMessage)
+	pluginRequest := pluginclient.Request{
+		Plugin: pluginclient.Plugin{
+			ID:                req.Plugin.ID,
+			IP:                req.Plugin.IP,
+			Port:              req.Plugin.Port,
+			Username:          req.Plugin.Username,
+			Password:          req.Plugin.Password,
+			PreferredAuthType: req.Plugin.PreferredAuthType,
+		},
+		Method:        req.HTTPMethodType,
+		URI:           req.OID,
+		Body:          req.DeviceInfo,
+		ContactClient: req.ContactClient,
+	}
+	if req.GetPluginStatus != nil {
+		pluginRequest.StatusCheck = func() bool {
+			return req.GetPluginStatus(req.Plugin)
 		}
 	}
-	defer response.Body.Close()
-	body, err := ioutil.ReadAll(response.Body)
+	pluginResponse, err := pluginclient.Do(pluginRequest)
 	if err != nil {
-		errorMessage := "error while trying to read response body: " + err.Error()
-		resp.StatusCode = http.StatusInternalServerError
-		resp.StatusMessage = errors.InternalError
+		errorMessage = errorMessage + err.Error()
+		resp.StatusCode = err.StatusCode
+		resp.StatusMessage = err.StatusMessage
 		log.Error(errorMessage)
-		return nil, "", resp, fmt.Errorf(errorMessage)
-	}
-	log.Info("Response" + string(body))
-	log.Info("response.StatusCode" + string(response.StatusCode))
-	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
-		resp.StatusCode = int32(response.StatusCode)
-		log.Println(errorMessage)
-		return body, "", resp, fmt.Errorf(errorMessage)
-	}
-
-	data := string(body)
-	//replacing the resposne with north bound translation URL
-	for key, value := range config.Data.URLTranslation.NorthBoundURL {
-		data = strings.Replace(data, key, value, -1)
+		return err.ResponseBody(), "", resp, fmt.Errorf(errorMessage)
 	}
-	return []byte(data), response.Header.Get("X-Auth-Token"), resp, nil
+	resp.StatusCode = int32(pluginResponse.StatusCode)
+	return pluginResponse.Body, pluginResponse.Header.Get("X-Auth-Token"), resp, nil
 }
 
 func checkRetrievalInfo(oid string) bool {
@@ -278,18 +250,6 @@ func GetPluginStatus(plugin smodel.Plugin) bool {
 	return status
 }
 
-func callPlugin(req PluginContactRequest) (*http.Response, error) {
-	var oid string
-	for key, value := range config.Data.URLTranslation.SouthBoundURL {
-		oid = strings.Replace(req.OID, key, value, -1)
-	}
-	var reqURL = "https://" + req.Plugin.IP + ":" + req.Plugin.Port + oid
-	if strings.EqualFold(req.Plugin.PreferredAuthType, "BasicAuth") {
-		return req.ContactClient(reqURL, req.HTTPMethodType, "", oid, req.DeviceInfo, req.BasicAuth)
-	}
-	return req.ContactClient(reqURL, req.HTTPMethodType, req.Token, oid, req.DeviceInfo, nil)
-}
-
 // TrackConfigFileChanges monitors the odim config changes using fsnotfiy
 func TrackConfigFileChanges(configFilePath string) {
 	eventChan := make(chan interface{})
@@ -306,5 +266,8 @@ func TrackConfigFileChanges(configFilePath string) {
 		if err != nil {
 			log.Error("error while trying to fetch search/filter schema json" + err.Error())
 		}
+		if err = common.SetFilterIndexes(schemaFile); err != nil {
+			log.Error(err.Error())
+		}
 	}
 }
@@ -19,13 +19,10 @@ package smodel
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 
-	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
 
-	"github.com/gomodule/redigo/redis"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -98,6 +95,12 @@ func GetResource(Table, key string) (string, *errors.Error) {
 	return resource, nil
 }
 
+// GetResources fetches the resources of the keys from database in a single request,
+// the resources which are not found are left out
+func GetResources(Table string, keys []string) (map[string]string, *errors.Error) {
+	return common.GetMembersFromDB(Table, common.InMemory)(keys)
+}
+
 func Find(table, key string, r interface{}) *errors.Error {
 	conn, err := common.GetDBConnection(common.InMemory)
 	if err != nil {
@@ -125,50 +128,16 @@ func FindAll(table, key string) ([][]byte, error) {
 		return nil, cpErr
 	}
 
-	affectedKeys, err := scan(cp, strings.Join([]string{table, key}, ":"))
+	values, err := cp.Scan(table, key)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(affectedKeys) == 0 {
-		return [][]byte{}, nil
-	}
-
-	conn := cp.ReadPool.Get()
-	defer conn.Close()
-
-	return redis.ByteSlices(conn.Do("MGET", affectedKeys...))
-}
-
-func scan(cp *persistencemgr.ConnPool, key string) ([]interface{}, error) {
-	conn := cp.ReadPool.Get()
-	defer conn.Close()
-
-	var (
-		cursor int64
-		items  []interface{}
-	)
-
-	results := make([]interface{}, 0)
-
-	for {
-		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", key))
-		if err != nil {
-			return nil, err
-		}
-
-		_, err = redis.Scan(values, &cursor, &items)
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, items...)
-
-		if cursor == 0 {
-			break
-		}
+	result := make([][]byte, 0, len(values))
+	for _, value := range values {
+		result = append(result, []byte(value))
 	}
-
-	return results, nil
+	return result, nil
 }
 
 //GetAllKeysFromTable fetches all keys in a given table
@@ -240,9 +209,51 @@ func GenericSave(body []byte, table string, key string) error {
 		}
 		log.Warn("Skipped saving of duplicate data with key " + key)
 	}
+	if err := common.UpdateFilterIndex(table, key, body); err != nil {
+		return fmt.Errorf("error while trying to index %v resource: %v", table, err.Error())
+	}
 	return nil
 }
 
+// UpdateResourceTags sets the current tags of the device on its computer systems and chassis,
+// the URIs of the updated computer systems are returned
+func UpdateResourceTags(deviceUUID string) ([]string, error) {
+	conn, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		return nil, fmt.Errorf("error while trying to connecting to DB: %v", err.Error())
+	}
+	var systemURIs []string
+	for _, table := range []string{"ComputerSystem", "Chassis"} {
+		keys, err := conn.GetAllMatchingDetails(table, deviceUUID+":")
+		if err != nil {
+			return nil, fmt.Errorf("error while trying to get the %v resources of %v: %v", table, deviceUUID, err.Error())
+		}
+		for _, key := range keys {
+			if common.ResourceTagsDeviceUUID(key) != deviceUUID {
+				continue
+			}
+			data, err := GetResource(table, key)
+			if err != nil {
+				return nil, err
+			}
+			body, errs := common.ApplyResourceTags(table, key, []byte(data))
+			if errs != nil {
+				return nil, errs
+			}
+			if _, err := conn.Update(table, key, string(body)); err != nil {
+				return nil, fmt.Errorf("error while trying to update %v: %v", key, err.Error())
+			}
+			if err := common.UpdateFilterIndex(table, key, body); err != nil {
+				return nil, fmt.Errorf("error while trying to index %v resource: %v", table, err.Error())
+			}
+			if table == "ComputerSystem" {
+				systemURIs = append(systemURIs, key)
+			}
+		}
+	}
+	return systemURIs, nil
+}
+
 // GetStorageList is used to storage list of capacity
 /*
 1.index name to search with
@@ -256,7 +267,7 @@ func GetStorageList(index, condition string, match float64, regexFlag bool) ([]s
 	}
 	list, err := conn.GetStorageList(index, 0, match, condition, regexFlag)
 	if err != nil {
-		fmt.Println("error while getting the data", err)
+		log.Error("error while getting the data: " + err.Error())
 		return []string{}, nil
 	}
 	return list, nil
@@ -274,7 +285,7 @@ func GetString(index, match string, regexFlag bool) ([]string, error) {
 	}
 	list, err := conn.GetString(index, 0, "*"+match+"*", regexFlag)
 	if err != nil {
-		fmt.Println("error while getting the data", err)
+		log.Error("error while getting the data: " + err.Error())
 		return []string{}, nil
 	}
 	return list, nil
@@ -293,7 +304,7 @@ func GetRange(index string, min, max int, regexFlag bool) ([]string, error) {
 	}
 	list, err := conn.GetRange(index, min, max, regexFlag)
 	if err != nil {
-		fmt.Println("error while getting the data", err)
+		log.Error("error while getting the data: " + err.Error())
 		return []string{}, nil
 	}
 	return list, nil
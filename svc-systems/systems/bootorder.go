@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	systemsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/systems"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
@@ -64,27 +65,6 @@ func (p *PluginContact) SetDefaultBootOrder(systemID string) response.RPC {
 	contactRequest.ContactClient = p.ContactClient
 	contactRequest.Plugin = plugin
 
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		contactRequest.HTTPMethodType = http.MethodPost
-		contactRequest.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		contactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := scommon.ContactPlugin(contactRequest, "error while creating session with the plugin: ")
-
-		if err != nil {
-			return common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, err.Error(), nil, nil)
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.BasicAuth = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	postRequest := make(map[string]interface{})
 	postBody, _ := json.Marshal(postRequest)
 	target.PostBody = postBody
@@ -130,6 +110,20 @@ func (p *PluginContact) ChangeBiosSettings(req *systemsproto.BiosSettingsRequest
 	if gerr != nil {
 		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, gerr.Error(), []interface{}{"System", uuid}, nil)
 	}
+	if req.IfMatch != "" {
+		settingsURI := "/redfish/v1/Systems/" + req.SystemID + "/Bios/Settings"
+		// the settings are read the same way as GetSystemResource, without saving them
+		data, errResp, err := p.getSystemResourceData("Bios", settingsURI, uuid, requestData[1], false)
+		if err != nil {
+			log.Error("error while trying to get the current ETag of " + settingsURI + ": " + err.Error())
+			return errResp
+		}
+		if !persistencemgr.MatchETag(req.IfMatch, persistencemgr.ETag(data)) {
+			errorMessage := "If-Match " + req.IfMatch + " does not match the current ETag of " + settingsURI
+			log.Error(errorMessage)
+			return common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errorMessage, nil, nil)
+		}
+	}
 
 	var biosSetting BiosSetting
 
@@ -171,27 +165,6 @@ func (p *PluginContact) ChangeBiosSettings(req *systemsproto.BiosSettingsRequest
 	contactRequest.ContactClient = p.ContactClient
 	contactRequest.Plugin = plugin
 
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		contactRequest.HTTPMethodType = http.MethodPost
-		contactRequest.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		contactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := scommon.ContactPlugin(contactRequest, "error while creating session with the plugin: ")
-
-		if err != nil {
-			return common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, err.Error(), nil, nil)
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.BasicAuth = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	target.PostBody = req.RequestBody
 
 	contactRequest.HTTPMethodType = http.MethodPatch
@@ -256,6 +229,35 @@ func (p *PluginContact) ChangeBootOrderSettings(req *systemsproto.BootOrderSetti
 	if gerr != nil {
 		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, gerr.Error(), []interface{}{"System", uuid}, nil)
 	}
+	if req.IfMatch != "" {
+		systemURI := "/redfish/v1/Systems/" + req.SystemID
+		data, errResp, err := p.getSystemData(systemURI, uuid, requestData[1])
+		if err != nil {
+			log.Error("error while trying to get the current ETag of " + systemURI + ": " + err.Error())
+			return errResp
+		}
+		if !persistencemgr.MatchETag(req.IfMatch, persistencemgr.ETag(data)) {
+			errorMessage := "If-Match " + req.IfMatch + " does not match the current ETag of " + systemURI
+			log.Error(errorMessage)
+			return common.GeneralError(http.StatusPreconditionFailed, response.PreconditionFailed, errorMessage, nil, nil)
+		}
+	}
+	// the tags of the system are kept by odimra, only the other properties are sent to the BMC
+	pluginBody, tags, err := splitResourceTags(req.RequestBody)
+	if err != nil {
+		errorMessage := "error while trying to parse the Oem section of the request: " + err.Error()
+		log.Error(errorMessage)
+		return common.GeneralError(http.StatusBadRequest, response.MalformedJSON, errorMessage, nil, nil)
+	}
+	if tags != nil {
+		if statusMessage, msgArgs, err := validateResourceTags(*tags); err != nil {
+			log.Error(err.Error())
+			return common.GeneralError(http.StatusBadRequest, statusMessage, err.Error(), msgArgs, nil)
+		}
+		if pluginBody == nil {
+			return p.updateSystemTags(req.SystemID, uuid, *tags)
+		}
+	}
 	decryptedPasswordByte, err := p.DevicePassword(target.Password)
 	if err != nil {
 		// Frame the RPC response body and response Header below
@@ -273,29 +275,7 @@ func (p *PluginContact) ChangeBootOrderSettings(req *systemsproto.BootOrderSetti
 	contactRequest.ContactClient = p.ContactClient
 	contactRequest.Plugin = plugin
 
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		contactRequest.HTTPMethodType = http.MethodPost
-		contactRequest.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		contactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := scommon.ContactPlugin(contactRequest, "error while creating session with the plugin: ")
-
-		if err != nil {
-			return common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, err.Error(), nil, nil)
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.BasicAuth = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
-
-	target.PostBody = req.RequestBody
+	target.PostBody = pluginBody
 
 	contactRequest.HTTPMethodType = http.MethodPatch
 	contactRequest.DeviceInfo = target
@@ -321,6 +301,13 @@ func (p *PluginContact) ChangeBootOrderSettings(req *systemsproto.BootOrderSetti
 	if err != nil {
 		return common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil)
 	}
+	if tags != nil {
+		if err := p.saveResourceTags(uuid, *tags); err != nil {
+			errorMessage := "error while trying to save the tags of the system: " + err.Error()
+			log.Error(errorMessage)
+			return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+		}
+	}
 	smodel.AddSystemResetInfo("/redfish/v1/Systems/"+req.SystemID, "On")
 	return resp
 }
@@ -612,3 +612,71 @@ func TestPluginContact_ChangeBootOrderSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginContact_ChangeBootOrderSettingsIfMatch(t *testing.T) {
+	config.SetUpMockConfig(t)
+	defer func() {
+		err := common.TruncateDB(common.OnDisk)
+		if err != nil {
+			t.Fatalf("error: %v", err)
+		}
+		err = common.TruncateDB(common.InMemory)
+		if err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}()
+
+	device := smodel.Target{
+		ManagerAddress: "10.24.0.12",
+		Password:       []byte("imKp3Q6Cx989b6JSPHnRhritEcXWtaB3zqVBkSwhCenJYfgAYBf9FlAocE"),
+		UserName:       "admin",
+		DeviceUUID:     "7a2c6100-67da-5fd6-ab82-6870d29c7279",
+		PluginID:       "GRF",
+	}
+	if err := mockPluginData(t); err != nil {
+		t.Fatalf("Error in creating mock PluginData :%v", err)
+	}
+	if err := mockDeviceData("7a2c6100-67da-5fd6-ab82-6870d29c7279", device); err != nil {
+		t.Fatalf("Error in creating mock DeviceData :%v", err)
+	}
+	if err := mockSystemData("/redfish/v1/Systems/7a2c6100-67da-5fd6-ab82-6870d29c7279:1"); err != nil {
+		t.Fatalf("Error in creating mock resource data :%v", err)
+	}
+	pluginContact := PluginContact{
+		ContactClient:  mockContactClient,
+		DevicePassword: stubDevicePassword,
+	}
+	request := []byte(`{"Boot": {"BootSourceOverrideTarget": "Pxe"}}`)
+	tests := []struct {
+		name     string
+		systemID string
+		ifMatch  string
+		want     int32
+	}{
+		{
+			name:     "stale If-Match",
+			systemID: "7a2c6100-67da-5fd6-ab82-6870d29c7279:1",
+			ifMatch:  `W/"stale"`,
+			want:     http.StatusPreconditionFailed,
+		},
+		{
+			name:     "If-Match on a system which can't be read",
+			systemID: "7a2c6100-67da-5fd6-ab82-6870d29c7279:2",
+			ifMatch:  `W/"stale"`,
+			want:     http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pluginContact.ChangeBootOrderSettings(&systemsproto.BootOrderSettingsRequest{
+				SystemID:     tt.systemID,
+				RequestBody:  request,
+				SessionToken: "token",
+				IfMatch:      tt.ifMatch,
+			})
+			if got.StatusCode != tt.want {
+				t.Errorf("PluginContact.ChangeBootOrderSettings() status = %v, want %v", got.StatusCode, tt.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,10 @@
 //Package systems ...
 package systems
 
+import (
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+)
+
 // BiosSetting structure for checking request body case
 type BiosSetting struct {
 	OdataContext      string      `json:"@odata.context"`
@@ -29,6 +33,12 @@ type BiosSetting struct {
 // BootOrderSettings structure for checking request body case
 type BootOrderSettings struct {
 	Boot Boot `json:"Boot"`
+	Oem  Oem  `json:"Oem"`
+}
+
+// Oem structure for checking request body case of the Oem section of a system
+type Oem struct {
+	ODIM common.ResourceTags `json:"ODIM"`
 }
 
 // Boot structure for checking request body case in BootOrderSettings
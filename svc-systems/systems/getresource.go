@@ -17,17 +17,18 @@ package systems
 
 import (
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	log "github.com/sirupsen/logrus"
 	"net/http"
 
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
 	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
@@ -50,45 +51,6 @@ func setRegexFlag(val string) bool {
 	return false
 }
 
-func errorResp(each string, resp response.RPC) (response.RPC, error) {
-	if each == "" {
-		errorMessage := " not a valid search/filter expression"
-		return common.GeneralError(http.StatusBadRequest, response.QueryCombinationInvalid, errorMessage, []interface{}{"ComputerSystem", ""}, nil), fmt.Errorf(errorMessage)
-	}
-	return resp, nil
-}
-
-func ifMatches(strPara string, operator string, resp response.RPC) (response.RPC, error) {
-	strSplit := strings.Split(strPara, operator)
-	for _, e := range strSplit {
-
-		resp, err := errorResp(e, resp)
-		if err != nil {
-			log.Error(err.Error())
-			return resp, err
-		}
-	}
-	return resp, nil
-}
-
-func validate(strPara string, resp response.RPC) (response.RPC, error) {
-	if strings.Contains(strPara, "and") {
-		resp, err := ifMatches(strPara, "and", resp)
-		if err != nil {
-			log.Error(err.Error())
-			return resp, err
-		}
-	}
-	if strings.Contains(strPara, "or") {
-		resp, err := ifMatches(strPara, "or", resp)
-		if err != nil {
-			log.Error(err.Error())
-			return resp, err
-		}
-	}
-	return resp, nil
-}
-
 // validateLastParameter checks whether last parameter in the expression
 // is an operator or not. It throughs an error if the last parameter is operator.
 // It also checks whether the expression is empty eg: /redfish/v1/Systems?$filter=%20
@@ -238,303 +200,6 @@ func GetMembers(allowed map[string]map[string]bool, expression []string, resp re
 	return members, resp, nil
 }
 
-//getAllSystemIDs will fetch all the document ID's present in the DB
-func getAllSystemIDs(resp response.RPC) ([]dmtf.Link, response.RPC, error) {
-	var mems []dmtf.Link
-	systemKeys, err := smodel.GetAllKeysFromTable("ComputerSystem")
-	if err != nil {
-		log.Error("error getting all keys of systemcollection table : " + err.Error())
-		errorMessage := err.Error()
-		if errorMessage == "error while trying to get resource details: no data with the with table name SystemCollection found" {
-			return nil, common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"ComputerSystem", ""}, nil), err
-		}
-		return nil, common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil), err
-	}
-	for _, key := range systemKeys {
-		mems = append(mems, dmtf.Link{Oid: key})
-	}
-
-	return mems, resp, nil
-
-}
-
-//LogicalOperation will take slice of slices of member collection and logical operation to perform
-//return 1 slice of member collection with logical operation performed on them
-func LogicalOperation(interm [][]dmtf.Link, lo string) []dmtf.Link {
-	var respMembers []dmtf.Link
-	if lo == "and" {
-		if len(interm[0]) < len(interm[1]) {
-			for i := 0; i < len(interm[0]); i++ {
-				for _, v := range interm[1] {
-					if interm[0][i] == v {
-						respMembers = append(respMembers, v)
-					}
-				}
-			}
-		} else {
-			for i := 0; i < len(interm[1]); i++ {
-				for _, v := range interm[0] {
-					if interm[1][i] == v {
-						respMembers = append(respMembers, v)
-					}
-				}
-			}
-		}
-
-	} else if lo == "or" {
-		encountered := map[dmtf.Link]bool{}
-		var tmp []dmtf.Link
-		tmp = append(interm[0], interm[1]...)
-		for v := range tmp {
-			encountered[tmp[v]] = true
-		}
-		for key := range encountered {
-			respMembers = append(respMembers, key)
-		}
-	}
-
-	return respMembers
-}
-
-//SearchAndFilter take the url as input and return the search result based on the filter expression
-func SearchAndFilter(paramStr []string, resp response.RPC) (response.RPC, error) {
-	allowed := make(map[string]map[string]bool)
-	allowed["queryKeys"] = make(map[string]bool)
-	allowed["logicalOperators"] = make(map[string]bool)
-
-	for _, value := range scommon.SF.QueryKeys {
-		value = "$" + value
-		allowed["queryKeys"][value] = true
-	}
-	query := strings.Split(paramStr[1], "=")
-	if len(query) < 2 {
-		errorMessage := " not a valid search/filter expression"
-		return common.GeneralError(http.StatusBadRequest, response.QueryCombinationInvalid, errorMessage, []interface{}{"ComputerSystem", ""}, nil), fmt.Errorf(errorMessage)
-	}
-	if !allowed["queryKeys"][query[0]] {
-		errorMessage := " not a valid search/filter expression"
-		return common.GeneralError(http.StatusBadRequest, response.QueryCombinationInvalid, errorMessage, []interface{}{"ComputerSystem", ""}, nil), fmt.Errorf(errorMessage)
-	}
-	percentSpl := strings.Split(query[1], "%")
-	var strPara string
-	if len(percentSpl) > 1 {
-		for i, j := range percentSpl {
-			if i == 0 {
-				strPara += j
-			} else {
-				bl, _ := hex.DecodeString(j[:2])
-				strung := string(bl)
-				strPara += strung
-				strPara += j[2:]
-			}
-		}
-	} else {
-		strPara = paramStr[1]
-	}
-	var respMembers []dmtf.Link
-	var err error
-
-	if checkParentheses(strPara) {
-		if strings.Count(strPara, "(") != strings.Count(strPara, ")") {
-			errorMessage := " not a valid search/filter expression"
-			return common.GeneralError(http.StatusBadRequest, response.QueryCombinationInvalid, errorMessage, []interface{}{"ComputerSystem", ""}, nil), fmt.Errorf(errorMessage)
-		}
-
-		var sa []dmtf.Link
-		re := regexp.MustCompile(`\((.*?)\)`)
-		submatchall := re.FindAllString(strPara, -1)
-		for _, element := range submatchall {
-			element = strings.Trim(element, "(")
-			element = strings.Trim(element, ")")
-			fmt.Println(element)
-			if strings.Contains(element, " and ") || strings.Contains(element, " or ") {
-				var x []string
-				if strings.Contains(element, " and ") {
-					x = strings.Split(element, " and ")
-				} else {
-					x = strings.Split(element, " or ")
-				}
-				for _, each := range x {
-					sa, resp, err = GetMembers(allowed, strings.Split(each, " "), resp)
-					if err != nil {
-						return resp, err
-					}
-					s, err := json.Marshal(sa)
-					if err != nil {
-						errorMessage := " error while marshalling database data"
-						return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil), fmt.Errorf(errorMessage)
-					}
-					strPara = strings.Replace(strPara, each, string(s), -1)
-				}
-			} else {
-				sa, resp, err = GetMembers(allowed, strings.Split(element, " "), resp)
-				if err != nil {
-					return resp, err
-				}
-				s, err := json.Marshal(sa)
-				if err != nil {
-					errorMessage := " error while marshalling database data"
-					return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil), fmt.Errorf(errorMessage)
-				}
-				strPara = strings.Replace(strPara, element, string(s), -1)
-			}
-		}
-		expressionVals := re.FindAllString(strPara, -1)
-		for _, eles := range expressionVals {
-			var ww []dmtf.Link
-			ele := strings.Trim(eles, "(")
-			ele = strings.Trim(ele, ")")
-			if strings.Contains(ele, " and ") {
-				var inter [][]dmtf.Link
-				for _, e := range strings.Split(ele, " and ") {
-					_ = json.Unmarshal([]byte(e), &ww)
-					inter = append(inter, ww)
-				}
-				interResp := LogicalOperation(inter, "and")
-				interSam, _ := json.Marshal(interResp)
-				strPara = strings.Replace(strPara, eles, string(interSam), 1)
-			} else if strings.Contains(ele, " or ") {
-				var inter [][]dmtf.Link
-				for _, e := range strings.Split(ele, " or ") {
-					_ = json.Unmarshal([]byte(e), &ww)
-					inter = append(inter, ww)
-				}
-				interResp := LogicalOperation(inter, "or")
-				interSam, _ := json.Marshal(interResp)
-				strPara = strings.Replace(strPara, eles, string(interSam), 1)
-			} else {
-				strPara = strings.Replace(strPara, eles, ele, 1)
-			}
-		}
-
-		if strings.Contains(strPara, "and ") || strings.Contains(strPara, " and") || strings.Contains(strPara, "or ") || strings.Contains(strPara, " or") {
-			var ww []dmtf.Link
-			var inter [][]dmtf.Link
-			resp, err = validate(strPara, resp)
-			if err != nil {
-				log.Error(err.Error())
-				return resp, err
-			}
-			if strings.Contains(strPara, " and ") {
-				for _, e := range strings.Split(strPara, " and ") {
-					resp, err = errorResp(e, resp)
-					if err != nil {
-						log.Error(err.Error())
-						return resp, err
-					}
-					_ = json.Unmarshal([]byte(e), &ww)
-					inter = append(inter, ww)
-				}
-				respMembers = LogicalOperation(inter, "and")
-			}
-			if strings.Contains(strPara, " or ") {
-				for _, e := range strings.Split(strPara, " or ") {
-					resp, err = errorResp(e, resp)
-					if err != nil {
-						log.Error(err.Error())
-						return resp, err
-					}
-					_ = json.Unmarshal([]byte(e), &ww)
-					inter = append(inter, ww)
-				}
-				respMembers = LogicalOperation(inter, "or")
-
-			}
-		}
-		if !strings.Contains(strPara, " and ") && !strings.Contains(strPara, " or ") && !strings.Contains(strPara, " not ") {
-			var mems []dmtf.Link
-			var mem dmtf.Link
-			para := strings.Trim(strPara, "[")
-			para = strings.Trim(para, "]")
-			for _, e := range strings.Split(para, ",") {
-				_ = json.Unmarshal([]byte(e), &mem)
-				mems = append(mems, mem)
-			}
-			respMembers = mems
-		}
-
-	} else if strings.Contains(strPara, "and ") || strings.Contains(strPara, " and") || strings.Contains(strPara, "or ") || strings.Contains(strPara, " or") {
-		var ww []dmtf.Link
-		var inter [][]dmtf.Link
-		resp, err = validate(strPara, resp)
-		if err != nil {
-			log.Error(err.Error())
-			return resp, err
-		}
-		if strings.Contains(strPara, " and ") {
-			for _, each := range strings.Split(strPara, " and ") {
-				resp, err = errorResp(each, resp)
-				if err != nil {
-					log.Error(err.Error())
-					return resp, err
-				}
-				ww, resp, err = GetMembers(allowed, strings.Split(each, " "), resp)
-				if err != nil {
-					return resp, err
-				}
-				inter = append(inter, ww)
-			}
-			respMembers = LogicalOperation(inter, "and")
-		}
-		if strings.Contains(strPara, " or ") {
-			for _, each := range strings.Split(strPara, " or ") {
-				resp, err = errorResp(each, resp)
-				if err != nil {
-					log.Error(err.Error())
-					return resp, err
-				}
-				ww, resp, err = GetMembers(allowed, strings.Split(each, " "), resp)
-				if err != nil {
-					return resp, err
-				}
-				inter = append(inter, ww)
-			}
-			respMembers = LogicalOperation(inter, "or")
-
-		}
-	} else if strings.Contains(strPara, " not ") || strings.Contains(strPara, "not ") {
-		var all, exp []dmtf.Link
-		all, resp, err = getAllSystemIDs(resp)
-		if err != nil {
-			return resp, err
-		}
-		expression := strings.Split(strPara, "not ")
-		exp, resp, err = GetMembers(allowed, strings.Split(expression[1], " "), resp)
-		if err != nil {
-			return resp, err
-		}
-		for _, eachA := range exp {
-			for j, eachB := range all {
-				if eachA == eachB {
-					all = append(all[:j], all[j+1:]...)
-				}
-			}
-		}
-		respMembers = all
-	} else {
-		respMembers, resp, err = GetMembers(allowed, strings.Split(strPara, " "), resp)
-		if err != nil {
-			return resp, err
-		}
-	}
-	systemCollection := sresponse.Collection{
-		OdataContext: "/redfish/v1/$metadata#ComputerSystemCollection.ComputerSystemCollection",
-		OdataID:      "/redfish/v1/Systems",
-		OdataType:    "#ComputerSystemCollection.ComputerSystemCollection",
-		Description:  "Computer Systems view",
-		Name:         "Computer Systems",
-	}
-	if len(respMembers) == 0 {
-		respMembers = []dmtf.Link{}
-	}
-	systemCollection.Members = respMembers
-	systemCollection.MembersCount = len(respMembers)
-	resp.Body = systemCollection
-	resp.StatusCode = http.StatusOK
-	resp.StatusMessage = response.Success
-	return resp, nil
-}
-
 // GetSystemResource is used to fetch resource data. The function is supposed to be used as part of RPC
 // For getting system resource information,  parameters need to be passed GetSystemsRequest .
 // GetSystemsRequest holds the  Uuid,Url and Resourceid ,
@@ -576,33 +241,13 @@ func (p *PluginContact) GetSystemResource(req *systemsproto.GetSystemsRequest) r
 		tableName = urlData[len(urlData)-2]
 	}
 
-	data, err := smodel.GetResource(tableName, req.URL)
-	if err != nil {
-		log.Error("error getting system details : " + err.Error())
-		errorMessage := err.Error()
-		if errors.DBKeyNotFound == err.ErrNo() {
-			var getDeviceInfoRequest = scommon.ResourceInfoRequest{
-				URL:             req.URL,
-				UUID:            uuid,
-				SystemID:        requestData[1],
-				ContactClient:   p.ContactClient,
-				DevicePassword:  p.DevicePassword,
-				GetPluginStatus: p.GetPluginStatus,
-			}
-			var err error
-			if data, err = scommon.GetResourceInfoFromDevice(getDeviceInfoRequest, saveRequired); err != nil {
-				return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, err.Error(), []interface{}{"ComputerSystem", req.URL}, nil)
-			}
-			if saveRequired && strings.Contains(req.URL, "/Storage") {
-				rediscoverStorageInventory(uuid, "/redfish/v1/Systems/"+requestData[1]+"/Storage")
-			}
-
-		} else {
-			return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
-		}
+	data, errResp, gerr := p.getSystemResourceData(tableName, req.URL, uuid, requestData[1], saveRequired)
+	if gerr != nil {
+		return errResp
 	}
 	var resource map[string]interface{}
 	json.Unmarshal([]byte(data), &resource)
+	resp.Header["ETag"] = persistencemgr.ETag(data)
 	resp.Body = resource
 	resp.StatusCode = http.StatusOK
 	resp.StatusMessage = response.Success
@@ -631,21 +276,6 @@ func rediscoverStorageInventory(systemID, systemURL string) {
 // GetSystemsCollection is to fetch all the Systems uri's and retruns with created collection
 // of systems data from odimra
 func GetSystemsCollection(req *systemsproto.GetSystemsRequest) response.RPC {
-	allowed := make(map[string]map[string]bool)
-	allowed["searchKeys"] = make(map[string]bool)
-	allowed["conditionKeys"] = make(map[string]bool)
-	allowed["queryKeys"] = make(map[string]bool)
-	for _, value := range scommon.SF.SearchKeys {
-		for k := range value {
-			allowed["searchKeys"][k] = true
-		}
-	}
-	for _, value := range scommon.SF.ConditionKeys {
-		allowed["conditionKeys"][value] = true
-	}
-	for _, value := range scommon.SF.QueryKeys {
-		allowed["queryKeys"][value] = true
-	}
 	var resp response.RPC
 	resp.Header = map[string]string{
 		"Allow":             `"GET"`,
@@ -655,14 +285,6 @@ func GetSystemsCollection(req *systemsproto.GetSystemsRequest) response.RPC {
 		"Transfer-Encoding": "chunked",
 		"OData-Version":     "4.0",
 	}
-	paramStr := strings.SplitN(req.URL, "?", 2)
-	if len(paramStr) > 1 {
-		resp, retError := SearchAndFilter(paramStr, resp)
-		if retError != nil {
-			return resp
-		}
-		return resp
-	}
 	systemKeys, err := smodel.GetAllKeysFromTable("ComputerSystem")
 	if err != nil {
 		log.Error("error getting all keys of systemcollection table : " + err.Error())
@@ -679,19 +301,63 @@ func GetSystemsCollection(req *systemsproto.GetSystemsRequest) response.RPC {
 		Description:  "Computer Systems view",
 		Name:         "Computer Systems",
 	}
+	sort.Strings(systemKeys)
 	members := []dmtf.Link{}
 	for _, key := range systemKeys {
 		members = append(members, dmtf.Link{Oid: key})
 	}
-	if len(members) == 0 {
-		members = []dmtf.Link{}
-	}
 	systemCollection.Members = members
 	systemCollection.MembersCount = len(members)
 	resp.Body = systemCollection
 	resp.StatusCode = http.StatusOK
 	resp.StatusMessage = response.Success
-	return resp
+	return common.ApplyQueryOptionsWithIndex(resp, req.URL, getSystemMembers, searchKeyLookup())
+}
+
+// searchKeyLookup returns the lookup used for $filter on the systems collection. Comparisons on the
+// search keys of the search/filter schema are answered by the indexes created during the discovery of
+// the systems, and the others by the indexes configured for ComputerSystem in filterIndexes.
+// The error response of GetMembers is returned in a FilterError when the lookup fails.
+func searchKeyLookup() common.FilterIndexFunc {
+	lookupIndex := common.FilterIndexLookup("ComputerSystem")
+	return func(property, operator, value string) ([]string, bool, error) {
+		if !isSearchKey(property) {
+			return lookupIndex(property, operator, value)
+		}
+		links, resp, err := GetMembers(make(map[string]map[string]bool), []string{property, operator, value}, response.RPC{})
+		if err != nil {
+			return nil, true, &common.FilterError{Response: resp, Err: err}
+		}
+		var members []string
+		for _, link := range links {
+			members = append(members, link.Oid)
+		}
+		return members, true, nil
+	}
+}
+
+func isSearchKey(property string) bool {
+	for _, value := range scommon.SF.SearchKeys {
+		if _, ok := value[property]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getSystemMembers reads the systems from the in-memory DB for expanding the systems collection,
+// Id of each system is prefixed with the uuid the same way as in GetSystems
+func getSystemMembers(odataIDs []string) (map[string]string, *errors.Error) {
+	systems, err := smodel.GetResources("ComputerSystem", odataIDs)
+	if err != nil {
+		return nil, err
+	}
+	for odataID, data := range systems {
+		systemID := odataID[strings.LastIndex(odataID, "/")+1:]
+		uuid := strings.Split(systemID, ":")[0]
+		systems[odataID] = strings.Replace(data, `"Id":"`, `"Id":"`+uuid+`:`, -1)
+	}
+	return systems, nil
 }
 
 // GetSystems is used to fetch resource data. The function is supposed to be used as part of RPC
@@ -717,43 +383,82 @@ func (p *PluginContact) GetSystems(req *systemsproto.GetSystemsRequest) response
 		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"ComputerSystem", req.RequestParam}, nil)
 	}
 	uuid := requestData[0]
-	var data string
-	var err *errors.Error
-	// check the whether SystemResetInfo available in db. If it is available, then get the data from device
-	_, err = smodel.GetSystemResetInfo(req.URL)
+	data, errResp, err := p.getSystemData(req.URL, uuid, requestData[1])
+	if err != nil {
+		return errResp
+	}
+	resp.Header["ETag"] = persistencemgr.ETag(data)
+	data = strings.Replace(data, `"Id":"`, `"Id":"`+uuid+`:`, -1)
+	var resource map[string]interface{}
+	json.Unmarshal([]byte(data), &resource)
+	resp.Body = resource
+	resp.StatusCode = http.StatusOK
+	resp.StatusMessage = response.Success
+
+	return resp
+}
+
+// getSystemResourceData reads the resource of a computer system from the DB, or from the device
+// when it isn't in the DB, in which case it's saved in the DB when saveRequired is set.
+// GetSystemResource and the If-Match checks of the updates both read the resource with it,
+// so that the ETags are computed from the same data. On failure, the error response is returned.
+func (p *PluginContact) getSystemResourceData(tableName, url, uuid, systemID string, saveRequired bool) (string, response.RPC, error) {
+	data, err := smodel.GetResource(tableName, url)
 	if err == nil {
+		return data, response.RPC{}, nil
+	}
+	log.Error("error getting system details : " + err.Error())
+	if errors.DBKeyNotFound != err.ErrNo() {
+		return "", common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil), err
+	}
+	var getDeviceInfoRequest = scommon.ResourceInfoRequest{
+		URL:             url,
+		UUID:            uuid,
+		SystemID:        systemID,
+		ContactClient:   p.ContactClient,
+		DevicePassword:  p.DevicePassword,
+		GetPluginStatus: p.GetPluginStatus,
+	}
+	data, derr := scommon.GetResourceInfoFromDevice(getDeviceInfoRequest, saveRequired)
+	if derr != nil {
+		return "", common.GeneralError(http.StatusNotFound, response.ResourceNotFound, derr.Error(), []interface{}{"ComputerSystem", url}, nil), derr
+	}
+	if saveRequired && strings.Contains(url, "/Storage") {
+		rediscoverStorageInventory(uuid, "/redfish/v1/Systems/"+systemID+"/Storage")
+	}
+	return data, response.RPC{}, nil
+}
+
+// getSystemData reads the computer system from the device when a reset of the system is pending,
+// that is when it's in SystemResetInfo, and from the DB otherwise. GetSystems and the If-Match
+// checks of the updates both read the system with it, so that the ETags are computed from the
+// same data. On failure, the error response is returned.
+func (p *PluginContact) getSystemData(url, uuid, systemID string) (string, response.RPC, error) {
+	if _, err := smodel.GetSystemResetInfo(url); err == nil {
 		var getDeviceInfoRequest = scommon.ResourceInfoRequest{
-			URL:             req.URL,
+			URL:             url,
 			UUID:            uuid,
-			SystemID:        requestData[1],
+			SystemID:        systemID,
 			ContactClient:   p.ContactClient,
 			DevicePassword:  p.DevicePassword,
 			GetPluginStatus: p.GetPluginStatus,
 			ResourceName:    "ComputerSystem",
 		}
-		var err error
-		if data, err = scommon.GetResourceInfoFromDevice(getDeviceInfoRequest, true); err != nil {
-			return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, err.Error(), []interface{}{"ComputerSystem", req.URL}, nil)
-		}
-	} else {
-		data, err = smodel.GetSystemByUUID(req.URL)
+		data, err := scommon.GetResourceInfoFromDevice(getDeviceInfoRequest, true)
 		if err != nil {
-			log.Error("error getting system details : " + err.Error())
-			errorMessage := err.Error()
-			if errors.DBKeyNotFound == err.ErrNo() {
-				return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"ComputerSystem", req.RequestParam}, nil)
-			}
-			return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+			return "", common.GeneralError(http.StatusNotFound, response.ResourceNotFound, err.Error(), []interface{}{"ComputerSystem", url}, nil), err
 		}
+		return data, response.RPC{}, nil
 	}
-	data = strings.Replace(data, `"Id":"`, `"Id":"`+uuid+`:`, -1)
-	var resource map[string]interface{}
-	json.Unmarshal([]byte(data), &resource)
-	resp.Body = resource
-	resp.StatusCode = http.StatusOK
-	resp.StatusMessage = response.Success
-
-	return resp
+	data, err := smodel.GetSystemByUUID(url)
+	if err != nil {
+		log.Error("error getting system details : " + err.Error())
+		if errors.DBKeyNotFound == err.ErrNo() {
+			return "", common.GeneralError(http.StatusNotFound, response.ResourceNotFound, err.Error(), []interface{}{"ComputerSystem", url}, nil), err
+		}
+		return "", common.GeneralError(http.StatusInternalServerError, response.InternalError, err.Error(), nil, nil), err
+	}
+	return data, response.RPC{}, nil
 }
 
 // getStringData supports the eq and ne only for  expression
@@ -842,15 +547,3 @@ func parseRegexData(data []string, regex string) ([]string, error) {
 	}
 	return list, nil
 }
-
-// this function checks query has open bracket["("] as prefix to ignore the brackets inside the string
-// for e.g if query is ProcessorSummary/Model eq Intel(R) Xeon(R) Gold 6152 CPU @ 2.10GHz
-// here Inter(R) has bracket inbetween the string, so ignore this string for the first if search criteria
-func checkParentheses(strPara string) bool {
-	for _, val := range strings.Split(strPara, " ") {
-		if strings.HasPrefix(val, "(") {
-			return true
-		}
-	}
-	return false
-}
@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"sort"
 	"testing"
 
 	dmtf "github.com/ODIM-Project/ODIM/lib-dmtf/model"
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	systemsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/systems"
@@ -81,6 +83,26 @@ func mockSystemResourceData(body []byte, table, key string) error {
 	return nil
 }
 
+// filteredBody returns the collection the way it is present in the response of a $filter request
+func filteredBody(collection sresponse.Collection) map[string]interface{} {
+	sort.Slice(collection.Members, func(i, j int) bool {
+		return collection.Members[i].Oid < collection.Members[j].Oid
+	})
+	members := []interface{}{}
+	for _, member := range collection.Members {
+		members = append(members, map[string]interface{}{"@odata.id": member.Oid})
+	}
+	return map[string]interface{}{
+		"@odata.context":      collection.OdataContext,
+		"@odata.id":           collection.OdataID,
+		"@odata.type":         collection.OdataType,
+		"Description":         collection.Description,
+		"Name":                collection.Name,
+		"Members":             members,
+		"Members@odata.count": len(members),
+	}
+}
+
 func getEncryptedKey(t *testing.T, key []byte) []byte {
 	cryptedKey, err := common.EncryptWithPublicKey(key)
 	if err != nil {
@@ -214,7 +236,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -229,7 +251,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -244,7 +266,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -259,7 +281,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -274,7 +296,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -289,7 +311,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -304,7 +326,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -319,7 +341,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -334,7 +356,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -349,7 +371,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -364,7 +386,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -379,7 +401,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -394,7 +416,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -409,7 +431,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -424,7 +446,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -439,7 +461,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -454,7 +476,7 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -469,9 +491,19 @@ func TestGetAllSystems(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
+		},
+		{
+			name: "invalid $top",
+			args: args{
+				req: &systemsproto.GetSystemsRequest{
+					URL: "/redfish/v1/Systems?$top=abc",
+				},
+			},
+			want:    common.GeneralError(http.StatusBadRequest, response.QueryParameterValueTypeError, "error: invalid value abc for $top", []interface{}{"abc", "$top"}, nil),
+			wantErr: true,
 		}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -553,6 +585,7 @@ func TestGetSystems(t *testing.T) {
 					"Content-type":      "application/json; charset=utf-8",
 					"Transfer-Encoding": "chunked",
 					"OData-Version":     "4.0",
+					"ETag":              persistencemgr.ETag(string(reqData)),
 				},
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
@@ -634,6 +667,10 @@ func TestPluginContact_GetSystemResource(t *testing.T) {
 		"Transfer-Encoding": "chunked",
 		"OData-Version":     "4.0",
 	}
+	successHeader := map[string]string{"ETag": persistencemgr.ETag(string(reqData))}
+	for key, value := range header {
+		successHeader[key] = value
+	}
 	pluginContact := PluginContact{
 		ContactClient:  mockContactClient,
 		DevicePassword: stubDevicePassword,
@@ -680,7 +717,7 @@ func TestPluginContact_GetSystemResource(t *testing.T) {
 				},
 			},
 			want: response.RPC{
-				Header:        header,
+				Header:        successHeader,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
 				Body:          map[string]interface{}{"@odata.id": "/redfish/v1/Systems/6d4a0a66-7efa-578e-83cf-44dc68d2874e:1/SecureBoot"},
@@ -861,7 +898,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp2,
+				Body:          filteredBody(resp2),
 			},
 			wantErr: false,
 		},
@@ -876,7 +913,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp2,
+				Body:          filteredBody(resp2),
 			},
 			wantErr: false,
 		},
@@ -891,7 +928,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -906,7 +943,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -921,7 +958,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp3,
+				Body:          filteredBody(resp3),
 			},
 			wantErr: false,
 		},
@@ -936,7 +973,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp4,
+				Body:          filteredBody(resp4),
 			},
 			wantErr: false,
 		},
@@ -951,7 +988,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          systemsCollection,
+				Body:          filteredBody(systemsCollection),
 			},
 			wantErr: false,
 		},
@@ -966,7 +1003,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp1,
+				Body:          filteredBody(resp1),
 			},
 			wantErr: false,
 		},
@@ -981,7 +1018,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp1,
+				Body:          filteredBody(resp1),
 			},
 			wantErr: false,
 		},
@@ -996,7 +1033,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp5,
+				Body:          filteredBody(resp5),
 			},
 			wantErr: false,
 		},
@@ -1011,7 +1048,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp3,
+				Body:          filteredBody(resp3),
 			},
 			wantErr: false,
 		},
@@ -1026,7 +1063,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp3,
+				Body:          filteredBody(resp3),
 			},
 			wantErr: false,
 		},
@@ -1041,7 +1078,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp3,
+				Body:          filteredBody(resp3),
 			},
 			wantErr: false,
 		},
@@ -1056,7 +1093,7 @@ func TestGetAllSystemsWithMultipleIndexData(t *testing.T) {
 				Header:        header,
 				StatusCode:    http.StatusOK,
 				StatusMessage: response.Success,
-				Body:          resp3,
+				Body:          filteredBody(resp3),
 			},
 			wantErr: false,
 		},
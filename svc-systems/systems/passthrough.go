@@ -0,0 +1,159 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package systems
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	systemsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/systems"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/lib-utilities/schemavalidation"
+	"github.com/ODIM-Project/ODIM/svc-systems/scommon"
+	"github.com/ODIM-Project/ODIM/svc-systems/smodel"
+)
+
+// passThroughURI matches the URIs of the resources under a computer system, chassis or manager,
+// the submatches are the collection, the device UUID, the resource ID and the remaining path
+var passThroughURI = regexp.MustCompile(`^/redfish/v1/(Systems|Chassis|Managers)/([^/:]+):([^/]+)(/.*)?$`)
+
+// PassThrough forwards the request on a resource of a computer system, chassis or manager
+// which is not modelled by ODIMRA to the plugin managing the device, and returns the
+// response of the plugin with its URIs translated to the northbound URIs. The entries are
+// logged with the correlation fields of ctx, along with the system and the plugin.
+func (p *PluginContact) PassThrough(ctx context.Context, req *systemsproto.PassThroughRequest) response.RPC {
+	uri := strings.TrimSuffix(req.URL, "/")
+	parts := passThroughURI.FindStringSubmatch(uri)
+	if parts == nil || !IsPassThroughAllowed(uri) {
+		errorMessage := "error: the resource " + req.URL + " is not available for pass-through"
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, errorMessage, []interface{}{"Resource", req.URL}, nil)
+	}
+	collection, uuid, resourceID := parts[1], parts[2], parts[3]
+	ctx = logs.With(ctx, logs.SystemIDField, uuid)
+
+	target, gerr := smodel.GetTarget(uuid)
+	if gerr != nil {
+		logs.Log(ctx).Error("error while trying to get the target: " + gerr.Error())
+		return common.GeneralError(http.StatusNotFound, response.ResourceNotFound, gerr.Error(), []interface{}{collection, "/redfish/v1/" + collection + "/" + uuid + ":" + resourceID}, nil)
+	}
+	decryptedPasswordByte, err := p.DevicePassword(target.Password)
+	if err != nil {
+		errorMessage := "error while trying to decrypt device password: " + err.Error()
+		logs.Log(ctx).Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	target.Password = decryptedPasswordByte
+	ctx = logs.With(ctx, logs.PluginIDField, target.PluginID)
+	plugin, gerr := smodel.GetPluginData(target.PluginID)
+	if gerr != nil {
+		errorMessage := "error while trying to get plugin details"
+		logs.Log(ctx).Error(errorMessage + ": " + gerr.Error())
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+
+	// remove the device UUID from the URIs of the request and translate the URIs
+	// of the request body to the southbound URIs, the plugin client translates the OID
+	postBody := strings.Replace(string(req.RequestBody), uuid+":", "", -1)
+	for key, value := range config.Data.URLTranslation.SouthBoundURL {
+		postBody = strings.Replace(postBody, key, value, -1)
+	}
+	if postBody != "" {
+		target.PostBody = []byte(postBody)
+	}
+
+	var contactRequest scommon.PluginContactRequest
+	contactRequest.ContactClient = p.ContactClient
+	contactRequest.GetPluginStatus = p.GetPluginStatus
+	contactRequest.Plugin = plugin
+	contactRequest.HTTPMethodType = req.Method
+	contactRequest.DeviceInfo = target
+	contactRequest.OID = strings.Replace(uri, uuid+":"+resourceID, resourceID, 1)
+	body, _, getResponse, err := scommon.ContactPlugin(contactRequest, "error while forwarding the request on "+req.URL+": ")
+	if err == nil && req.Method == http.MethodGet {
+		body = schemavalidation.Validate(plugin.ID, uri, body)
+	}
+
+	resp := response.RPC{
+		StatusCode:    getResponse.StatusCode,
+		StatusMessage: getResponse.StatusMessage,
+		Header: map[string]string{
+			"Content-type":  "application/json; charset=utf-8",
+			"OData-Version": "4.0",
+		},
+	}
+	if err == nil {
+		resp.StatusMessage = response.Success
+	}
+	if len(body) != 0 {
+		resp.Body = []byte(northboundURIs(string(body), uuid))
+	}
+	return resp
+}
+
+// IsPassThroughAllowed checks the URI against the allow-list and deny-list patterns
+// of PassThroughConf, the URI is allowed when it has no "." or ".." segments, it matches
+// none of the deny-list patterns and either the allow-list is empty or the URI matches
+// one of its patterns
+func IsPassThroughAllowed(uri string) bool {
+	conf := config.Data.PassThroughConf
+	return conf != nil && isCleanPath(uri) && conf.Allows(uri)
+}
+
+// isCleanPath reports if the URI has no empty, "." or ".." segments, raw or percent-encoded.
+// The URI is decoded until it no longer changes, as the plugin or the BMC may decode it again,
+// so that such segments can't lead the request out of the resources matched by the patterns.
+func isCleanPath(uri string) bool {
+	for {
+		if path.Clean(uri) != uri {
+			return false
+		}
+		decoded, err := url.PathUnescape(uri)
+		if err != nil {
+			return false
+		}
+		if decoded == uri {
+			return true
+		}
+		uri = decoded
+	}
+}
+
+// deviceURI matches the URIs of the computer systems, chassis and managers,
+// the submatches are the collection and the resource ID
+var deviceURI = regexp.MustCompile(`/redfish/v1/(Systems|systems|Chassis|Managers)/([^/"?#\s]+)`)
+
+// northboundURIs translates the URIs of the plugin response to the northbound URIs
+// and prefixes the IDs of the computer systems, chassis and managers with the device UUID.
+// The IDs already prefixed with a device UUID, of this device or another one, are kept.
+func northboundURIs(data, uuid string) string {
+	for key, value := range config.Data.URLTranslation.NorthBoundURL {
+		data = strings.Replace(data, key, value, -1)
+	}
+	return deviceURI.ReplaceAllStringFunc(data, func(uri string) string {
+		parts := deviceURI.FindStringSubmatch(uri)
+		if strings.Contains(parts[2], ":") {
+			return uri
+		}
+		return "/redfish/v1/" + parts[1] + "/" + uuid + ":" + parts[2]
+	})
+}
@@ -0,0 +1,123 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package systems
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	systemsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/systems"
+)
+
+func TestIsPassThroughAllowed(t *testing.T) {
+	config.SetUpMockConfig(t)
+	tests := []struct {
+		name string
+		conf *config.PassThroughConf
+		uri  string
+		want bool
+	}{
+		{
+			name: "pass-through disabled",
+			conf: &config.PassThroughConf{},
+			uri:  "/redfish/v1/Chassis/uuid:1/Controls",
+			want: false,
+		},
+		{
+			name: "empty allow-list",
+			conf: &config.PassThroughConf{Enabled: true},
+			uri:  "/redfish/v1/Chassis/uuid:1/Controls",
+			want: true,
+		},
+		{
+			name: "URI in deny-list",
+			conf: &config.PassThroughConf{Enabled: true, DenyList: []string{"/Actions/.*Reset$"}},
+			uri:  "/redfish/v1/Managers/uuid:1/Actions/Manager.Reset",
+			want: false,
+		},
+		{
+			name: "URI in allow-list",
+			conf: &config.PassThroughConf{Enabled: true, AllowList: []string{"/Controls(/.*)?$"}},
+			uri:  "/redfish/v1/Chassis/uuid:1/Controls/1",
+			want: true,
+		},
+		{
+			name: "URI not in allow-list",
+			conf: &config.PassThroughConf{Enabled: true, AllowList: []string{"/Controls(/.*)?$"}},
+			uri:  "/redfish/v1/Systems/uuid:1/Certificates",
+			want: false,
+		},
+		{
+			name: "dot-dot segments",
+			conf: &config.PassThroughConf{Enabled: true, AllowList: []string{"^/redfish/v1/Systems/[^/]+/"}, DenyList: []string{"/Accounts"}},
+			uri:  "/redfish/v1/Systems/uuid:1/../../AccountService/Account%73",
+			want: false,
+		},
+		{
+			name: "encoded dot-dot segments",
+			conf: &config.PassThroughConf{Enabled: true, AllowList: []string{"^/redfish/v1/Systems/[^/]+/"}},
+			uri:  "/redfish/v1/Systems/uuid:1/%2e%2E/%252e%252e/AccountService",
+			want: false,
+		},
+		{
+			name: "dot segment",
+			conf: &config.PassThroughConf{Enabled: true},
+			uri:  "/redfish/v1/Systems/uuid:1/./Bios",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conf.Compile()
+			config.Data.PassThroughConf = tt.conf
+			if got := IsPassThroughAllowed(tt.uri); got != tt.want {
+				t.Errorf("IsPassThroughAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPluginContact_PassThroughNotAllowed(t *testing.T) {
+	config.SetUpMockConfig(t)
+	config.Data.PassThroughConf = &config.PassThroughConf{Enabled: true, DenyList: []string{"/Certificates"}}
+	config.Data.PassThroughConf.Compile()
+	pc := PluginContact{
+		ContactClient:  mockContactClient,
+		DevicePassword: stubDevicePassword,
+	}
+	for _, url := range []string{
+		"/redfish/v1/Systems/uuid:1/Certificates",
+		"/redfish/v1/Systems/1/Controls",
+		"/redfish/v1/Fabrics/uuid:1/Controls",
+	} {
+		resp := pc.PassThrough(context.TODO(), &systemsproto.PassThroughRequest{URL: url, Method: http.MethodGet})
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("PassThrough(%v) status = %v, want %v", url, resp.StatusCode, http.StatusNotFound)
+		}
+	}
+}
+
+func TestNorthboundURIs(t *testing.T) {
+	config.SetUpMockConfig(t)
+	body := `{"@odata.id":"/ODIM/v1/Chassis/1/Controls/1","RelatedItem":[{"@odata.id":"/ODIM/v1/Systems/1"},` +
+		`{"@odata.id":"/redfish/v1/Systems/other:1"},{"@odata.id":"/redfish/v1/Managers"},{"@odata.id":"/redfish/v1/Managers/"}]}`
+	want := `{"@odata.id":"/redfish/v1/Chassis/uuid:1/Controls/1","RelatedItem":[{"@odata.id":"/redfish/v1/Systems/uuid:1"},` +
+		`{"@odata.id":"/redfish/v1/Systems/other:1"},{"@odata.id":"/redfish/v1/Managers"},{"@odata.id":"/redfish/v1/Managers/"}]}`
+	if got := northboundURIs(body, "uuid"); got != want {
+		t.Errorf("northboundURIs() = %v, want %v", got, want)
+	}
+}
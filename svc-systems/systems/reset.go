@@ -33,6 +33,8 @@ type PluginContact struct {
 	ContactClient   func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
 	DevicePassword  func([]byte) ([]byte, error)
 	GetPluginStatus func(smodel.Plugin) bool
+	// UpdateDynamicAggregates re-evaluates the dynamic aggregates for the systems whose tags are changed
+	UpdateDynamicAggregates func([]string)
 }
 
 // ComputerSystemReset performs a reset action on the requeseted computer system with the specified ResetType
@@ -91,27 +93,6 @@ func (p *PluginContact) ComputerSystemReset(req *systemsproto.ComputerSystemRese
 	contactRequest.ContactClient = p.ContactClient
 	contactRequest.Plugin = plugin
 
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		contactRequest.HTTPMethodType = http.MethodPost
-		contactRequest.DeviceInfo = map[string]interface{}{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		contactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, getResponse, err := scommon.ContactPlugin(contactRequest, "error while creating session with the plugin: ")
-
-		if err != nil {
-			return common.GeneralError(getResponse.StatusCode, getResponse.StatusMessage, err.Error(), nil, nil)
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.BasicAuth = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	postRequest := make(map[string]interface{})
 	postRequest["ResetType"] = resetCompSys.ResetType
 	postBody, _ := json.Marshal(postRequest)
@@ -0,0 +1,165 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package systems
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	aggregatorproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/aggregator"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/lib-utilities/services"
+	"github.com/ODIM-Project/ODIM/svc-systems/smodel"
+)
+
+// splitResourceTags separates the Oem/ODIM section of the request body, which holds the tags
+// of the device kept by odimra, from the properties to be patched on the BMC.
+// The body to be sent to the BMC is nil if the request holds only the tags,
+// and the tags are nil if the request doesn't hold any.
+func splitResourceTags(requestBody []byte) ([]byte, *common.ResourceTags, error) {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(requestBody, &body); err != nil {
+		return nil, nil, err
+	}
+	rawOem, ok := body["Oem"]
+	if !ok {
+		return requestBody, nil, nil
+	}
+	var oem map[string]json.RawMessage
+	if err := json.Unmarshal(rawOem, &oem); err != nil {
+		return nil, nil, err
+	}
+	rawTags, ok := oem["ODIM"]
+	if !ok {
+		return requestBody, nil, nil
+	}
+	var tags common.ResourceTags
+	if err := json.Unmarshal(rawTags, &tags); err != nil {
+		return nil, nil, err
+	}
+	delete(oem, "ODIM")
+	if len(oem) == 0 {
+		delete(body, "Oem")
+	} else {
+		rawOem, _ = json.Marshal(oem)
+		body["Oem"] = rawOem
+	}
+	if len(body) == 0 {
+		return nil, &tags, nil
+	}
+	pluginBody, err := json.Marshal(body)
+	return pluginBody, &tags, err
+}
+
+// validateResourceTags checks the tags and the names of the custom properties, the names are
+// used as is in the $filter of the systems and of the membership of the aggregates.
+func validateResourceTags(tags common.ResourceTags) (string, []interface{}, error) {
+	for _, tag := range tags.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return response.PropertyValueFormatError, []interface{}{tag, "Tags"}, fmt.Errorf("error: tags can't be empty")
+		}
+	}
+	for name := range tags.CustomProperties {
+		if strings.TrimSpace(name) == "" || strings.ContainsAny(name, "/ '") {
+			return response.PropertyValueFormatError, []interface{}{name, "CustomProperties"}, fmt.Errorf("error: invalid custom property name %q", name)
+		}
+	}
+	return "", nil, nil
+}
+
+// mergeResourceTags applies the patch to the current tags of the device. The list of tags is replaced
+// when it is given, the custom properties are merged and those given with an empty value are removed.
+func mergeResourceTags(current, patch common.ResourceTags) common.ResourceTags {
+	if patch.Tags != nil {
+		current.Tags = patch.Tags
+	}
+	if len(patch.CustomProperties) > 0 && current.CustomProperties == nil {
+		current.CustomProperties = make(map[string]string)
+	}
+	for name, value := range patch.CustomProperties {
+		if value == "" {
+			delete(current.CustomProperties, name)
+			continue
+		}
+		current.CustomProperties[name] = value
+	}
+	return current
+}
+
+// saveResourceTags patches the tags of the device and sets them on its systems and chassis
+func (p *PluginContact) saveResourceTags(deviceUUID string, patch common.ResourceTags) error {
+	current, err := common.GetResourceTags(deviceUUID)
+	if err != nil {
+		return err
+	}
+	if err := common.SaveResourceTags(deviceUUID, mergeResourceTags(current, patch)); err != nil {
+		return err
+	}
+	systemURIs, uerr := smodel.UpdateResourceTags(deviceUUID)
+	if uerr != nil {
+		return uerr
+	}
+	go p.UpdateDynamicAggregates(systemURIs)
+	return nil
+}
+
+// updateSystemTags handles a request holding only the tags of the system,
+// the updated system is returned without contacting the BMC
+func (p *PluginContact) updateSystemTags(systemID, deviceUUID string, tags common.ResourceTags) response.RPC {
+	if err := p.saveResourceTags(deviceUUID, tags); err != nil {
+		errorMessage := "error while trying to save the tags of the system: " + err.Error()
+		log.Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	data, gerr := smodel.GetSystemByUUID("/redfish/v1/Systems/" + systemID)
+	if gerr != nil {
+		errorMessage := "error while trying to get the system: " + gerr.Error()
+		log.Error(errorMessage)
+		return common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil)
+	}
+	var resp response.RPC
+	var system map[string]interface{}
+	json.Unmarshal([]byte(data), &system)
+	resp.Header = map[string]string{
+		"Cache-Control":     "no-cache",
+		"Connection":        "keep-alive",
+		"Content-type":      "application/json; charset=utf-8",
+		"Transfer-Encoding": "chunked",
+		"OData-Version":     "4.0",
+		"ETag":              persistencemgr.ETag(data),
+	}
+	resp.StatusCode = http.StatusOK
+	resp.StatusMessage = response.Success
+	resp.Body = system
+	return resp
+}
+
+// UpdateDynamicAggregates asks the aggregator to re-evaluate the membership of the dynamic aggregates
+// for the given computer systems
+func UpdateDynamicAggregates(systemURIs []string) {
+	aggregator := aggregatorproto.NewAggregatorService(services.Aggregator, services.Service.Client())
+	for _, systemURI := range systemURIs {
+		if _, err := aggregator.UpdateDynamicAggregates(context.TODO(), &aggregatorproto.AggregatorRequest{URL: systemURI}); err != nil {
+			log.Error("error while trying to update the dynamic aggregates for " + systemURI + ": " + err.Error())
+		}
+	}
+}
@@ -0,0 +1,213 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package systems
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	systemsproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/systems"
+	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/svc-systems/smodel"
+)
+
+func TestSplitResourceTags(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		pluginBody string
+		tags       *common.ResourceTags
+		wantErr    bool
+	}{
+		{
+			name:       "without tags",
+			body:       `{"Boot":{"BootSourceOverrideTarget":"Pxe"}}`,
+			pluginBody: `{"Boot":{"BootSourceOverrideTarget":"Pxe"}}`,
+		},
+		{
+			name:       "vendor oem only",
+			body:       `{"Oem":{"Hpe":{"Key":"Value"}}}`,
+			pluginBody: `{"Oem":{"Hpe":{"Key":"Value"}}}`,
+		},
+		{
+			name: "tags only",
+			body: `{"Oem":{"ODIM":{"Tags":["prod"]}}}`,
+			tags: &common.ResourceTags{Tags: []string{"prod"}},
+		},
+		{
+			name:       "tags along with other properties",
+			body:       `{"Boot":{"BootSourceOverrideTarget":"Pxe"},"Oem":{"Hpe":{"Key":"Value"},"ODIM":{"CustomProperties":{"Owner":"team-a"}}}}`,
+			pluginBody: `{"Boot":{"BootSourceOverrideTarget":"Pxe"},"Oem":{"Hpe":{"Key":"Value"}}}`,
+			tags:       &common.ResourceTags{CustomProperties: map[string]string{"Owner": "team-a"}},
+		},
+		{
+			name:    "invalid oem",
+			body:    `{"Oem":"ODIM"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pluginBody, tags, err := splitResourceTags([]byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitResourceTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(pluginBody) != tt.pluginBody {
+				t.Errorf("splitResourceTags() pluginBody = %s, want %s", pluginBody, tt.pluginBody)
+			}
+			if !reflect.DeepEqual(tags, tt.tags) {
+				t.Errorf("splitResourceTags() tags = %v, want %v", tags, tt.tags)
+			}
+		})
+	}
+}
+
+func TestMergeResourceTags(t *testing.T) {
+	current := common.ResourceTags{
+		Tags:             []string{"rack-1"},
+		CustomProperties: map[string]string{"Owner": "team-a", "Location": "lab"},
+	}
+	got := mergeResourceTags(current, common.ResourceTags{
+		CustomProperties: map[string]string{"Owner": "team-b", "Location": ""},
+	})
+	want := common.ResourceTags{
+		Tags:             []string{"rack-1"},
+		CustomProperties: map[string]string{"Owner": "team-b"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeResourceTags() = %v, want %v", got, want)
+	}
+	got = mergeResourceTags(common.ResourceTags{}, common.ResourceTags{Tags: []string{}, CustomProperties: map[string]string{"Owner": "team-a"}})
+	want = common.ResourceTags{Tags: []string{}, CustomProperties: map[string]string{"Owner": "team-a"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeResourceTags() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateResourceTags(t *testing.T) {
+	valid := common.ResourceTags{Tags: []string{"prod"}, CustomProperties: map[string]string{"Owner": "team-a"}}
+	if _, _, err := validateResourceTags(valid); err != nil {
+		t.Errorf("validateResourceTags() unexpected error = %v", err)
+	}
+	invalid := []common.ResourceTags{
+		{Tags: []string{" "}},
+		{CustomProperties: map[string]string{"": "team-a"}},
+		{CustomProperties: map[string]string{"Owner/Name": "team-a"}},
+	}
+	for _, tags := range invalid {
+		if statusMessage, _, err := validateResourceTags(tags); err == nil || statusMessage != response.PropertyValueFormatError {
+			t.Errorf("validateResourceTags(%v) expected a PropertyValueFormatError", tags)
+		}
+	}
+}
+
+func TestPluginContact_ChangeBootOrderSettingsTags(t *testing.T) {
+	config.SetUpMockConfig(t)
+	defer func() {
+		if err := common.TruncateDB(common.OnDisk); err != nil {
+			t.Fatalf("error: %v", err)
+		}
+		if err := common.TruncateDB(common.InMemory); err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}()
+	deviceUUID := "7a2c6100-67da-5fd6-ab82-6870d29c7279"
+	device := smodel.Target{
+		ManagerAddress: "10.24.0.12",
+		Password:       []byte("imKp3Q6Cx989b6JSPHnRhritEcXWtaB3zqVBkSwhCenJYfgAYBf9FlAocE"),
+		UserName:       "admin",
+		DeviceUUID:     deviceUUID,
+		PluginID:       "GRF",
+	}
+	if err := mockPluginData(t); err != nil {
+		t.Fatalf("Error in creating mock PluginData :%v", err)
+	}
+	if err := mockDeviceData(deviceUUID, device); err != nil {
+		t.Fatalf("Error in creating mock DeviceData :%v", err)
+	}
+	if err := mockSystemData("/redfish/v1/Systems/" + deviceUUID + ":1"); err != nil {
+		t.Fatalf("Error in creating mock SystemData :%v", err)
+	}
+	connPool, _ := common.GetDBConnection(common.InMemory)
+	if err := connPool.Create("Chassis", "/redfish/v1/Chassis/"+deviceUUID+":1", `{"Id":"1"}`); err != nil {
+		t.Fatalf("Error in creating mock ChassisData :%v", err)
+	}
+	aggregatesUpdated := make(chan bool, 2)
+	pluginContact := PluginContact{
+		ContactClient:  mockContactClient,
+		DevicePassword: stubDevicePassword,
+		UpdateDynamicAggregates: func(systemURIs []string) {
+			aggregatesUpdated <- reflect.DeepEqual(systemURIs, []string{"/redfish/v1/Systems/" + deviceUUID + ":1"})
+		},
+	}
+
+	resp := pluginContact.ChangeBootOrderSettings(&systemsproto.BootOrderSettingsRequest{
+		SystemID:    deviceUUID + ":1",
+		RequestBody: []byte(`{"Oem":{"ODIM":{"Tags":["prod"],"CustomProperties":{"Owner":"team-a"}}}}`),
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ChangeBootOrderSettings() StatusCode = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	wantOem := map[string]interface{}{
+		"ODIM": map[string]interface{}{
+			"Tags":             []interface{}{"prod"},
+			"CustomProperties": map[string]interface{}{"Owner": "team-a"},
+		},
+	}
+	if got := resp.Body.(map[string]interface{})["Oem"]; !reflect.DeepEqual(got, wantOem) {
+		t.Errorf("ChangeBootOrderSettings() Oem = %v, want %v", got, wantOem)
+	}
+	chassis, _ := smodel.GetResource("Chassis", "/redfish/v1/Chassis/"+deviceUUID+":1")
+	var chassisData map[string]interface{}
+	json.Unmarshal([]byte(chassis), &chassisData)
+	if !reflect.DeepEqual(chassisData["Oem"], wantOem) {
+		t.Errorf("Chassis Oem = %v, want %v", chassisData["Oem"], wantOem)
+	}
+	if !<-aggregatesUpdated {
+		t.Errorf("UpdateDynamicAggregates() not called for the system of the device")
+	}
+
+	resp = pluginContact.ChangeBootOrderSettings(&systemsproto.BootOrderSettingsRequest{
+		SystemID:    deviceUUID + ":1",
+		RequestBody: []byte(`{"Boot":{"BootSourceOverrideTarget":"Pxe"},"Oem":{"ODIM":{"Tags":[],"CustomProperties":{"Owner":""}}}}`),
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ChangeBootOrderSettings() StatusCode = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	if tags, _ := common.GetResourceTags(deviceUUID); !tags.IsEmpty() {
+		t.Errorf("GetResourceTags() = %v, want no tags", tags)
+	}
+	system, _ := smodel.GetSystemByUUID("/redfish/v1/Systems/" + deviceUUID + ":1")
+	var systemData map[string]interface{}
+	json.Unmarshal([]byte(system), &systemData)
+	if _, ok := systemData["Oem"]; ok {
+		t.Errorf("system Oem = %v, want the tags to be removed", systemData["Oem"])
+	}
+	if !<-aggregatesUpdated {
+		t.Errorf("UpdateDynamicAggregates() not called for the system of the device")
+	}
+
+	resp = pluginContact.ChangeBootOrderSettings(&systemsproto.BootOrderSettingsRequest{
+		SystemID:    deviceUUID + ":1",
+		RequestBody: []byte(`{"Oem":{"ODIM":{"Tags":[""]}}}`),
+	})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("ChangeBootOrderSettings() StatusCode = %v, want %v", resp.StatusCode, http.StatusBadRequest)
+	}
+}
@@ -28,7 +28,8 @@ import (
 	"github.com/ODIM-Project/ODIM/svc-task/tmodel"
 )
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
 	// verifying the uid of the user
@@ -43,10 +44,15 @@ func main() {
 	if err := dc.SetConfiguration(config.Data.MessageQueueConfigFilePath); err != nil {
 		log.Fatal("error while trying to set messagebus configuration: " + err.Error())
 	}
+	// the events not yet published are flushed when the service stops
+	defer dc.CloseProducers()
 
 	if err := common.CheckDBConnection(); err != nil {
 		log.Fatal("error while trying to check DB connection health: " + err.Error())
 	}
+	if err := common.RunMigrations(services.Tasks, common.InMemory, tmodel.Migrations); err != nil {
+		log.Fatal("error while trying to migrate the DB: " + err.Error())
+	}
 	configFilePath := os.Getenv("CONFIG_FILE_PATH")
 	if configFilePath == "" {
 		log.Fatal("error: no value get the environment variable CONFIG_FILE_PATH")
@@ -63,6 +69,7 @@ func main() {
 	task.AuthenticationRPC = auth.Authentication
 	task.GetSessionUserNameRPC = auth.GetSessionUserName
 	task.GetTaskStatusModel = tmodel.GetTaskStatus
+	task.GetTasksModel = tmodel.GetTasks
 	task.GetAllTaskKeysModel = tmodel.GetAllTaskKeys
 	task.TransactionModel = tmodel.Transaction
 	task.OverWriteCompletedTaskUtilHelper = task.OverWriteCompletedTaskUtil
@@ -27,8 +27,12 @@ import (
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
+	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
+	"github.com/ODIM-Project/ODIM/lib-utilities/metrics"
 	taskproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/task"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
+	"github.com/ODIM-Project/ODIM/lib-utilities/tracing"
 	"github.com/ODIM-Project/ODIM/svc-task/tmodel"
 	"github.com/ODIM-Project/ODIM/svc-task/tresponse"
 	"github.com/golang/protobuf/ptypes"
@@ -46,17 +50,18 @@ type TasksRPC struct {
 	AuthenticationRPC                func(sessionToken string, privileges []string) response.RPC
 	GetSessionUserNameRPC            func(sessionToken string) (string, error)
 	GetTaskStatusModel               func(taskID string, db common.DbType) (*tmodel.Task, error)
+	GetTasksModel                    func(taskIDs []string, db common.DbType) (map[string]*tmodel.Task, error)
 	GetAllTaskKeysModel              func() ([]string, error)
 	TransactionModel                 func(key string, cb func(string) error) error
-	OverWriteCompletedTaskUtilHelper func(userName string) error
-	CreateTaskUtilHelper             func(userName string) (string, error)
+	OverWriteCompletedTaskUtilHelper func(ctx context.Context, userName string) error
+	CreateTaskUtilHelper             func(ctx context.Context, userName string) (string, error)
 	GetCompletedTasksIndexModel      func(userName string) ([]string, error)
 	DeleteTaskFromDBModel            func(t *tmodel.Task) error
 	DeleteTaskIndex                  func(taskID string) error
 	UpdateTaskStatusModel            func(t *tmodel.Task, db common.DbType) error
 	PersistTaskModel                 func(t *tmodel.Task, db common.DbType) error
 	ValidateTaskUserNameModel        func(userName string) error
-	PublishToMessageBus              func(taskURI string, taskEvenMessageID string, eventType string)
+	PublishToMessageBus              func(ctx context.Context, taskURI string, taskEvenMessageID string, eventType string)
 }
 
 //CreateTask is a rpc handler which intern call actual CreatTask to create new task
@@ -64,24 +69,25 @@ func (ts *TasksRPC) CreateTask(ctx context.Context, req *taskproto.CreateTaskReq
 	// Check for completed task if there are any, get the oldest Completed
 	//Task and Delete from the db along with it subtask as well.
 	// Search for the Completed tasks
+	ctx = logs.With(ctx, logs.SessionUserField, req.UserName)
 	go func() {
-		err := ts.OverWriteCompletedTaskUtilHelper(req.UserName)
+		err := ts.OverWriteCompletedTaskUtilHelper(ctx, req.UserName)
 		if err != nil {
-			log.Error("error: failed to over write the completed task: " + err.Error())
+			logs.Log(ctx).Error("error: failed to over write the completed task: " + err.Error())
 		}
 	}()
-	taskURI, err := ts.CreateTaskUtilHelper(req.UserName)
+	taskURI, err := ts.CreateTaskUtilHelper(ctx, req.UserName)
 	rsp.TaskURI = taskURI
 	return err
 }
 
 //OverWriteCompletedTaskUtil is helper method to find and delete eligible completed task
-func (ts *TasksRPC) OverWriteCompletedTaskUtil(userName string) error {
+func (ts *TasksRPC) OverWriteCompletedTaskUtil(ctx context.Context, userName string) error {
 	var taskID string
 
 	taskList, err := ts.GetCompletedTasksIndexModel(userName)
 	if err != nil {
-		log.Error("error while getting the completed task: " + err.Error())
+		logs.Log(ctx).Error("error while getting the completed task: " + err.Error())
 		return err
 	}
 	inputTimeStringformat := "2006-01-02 15:04:05 +0000 UTC"
@@ -94,9 +100,9 @@ func (ts *TasksRPC) OverWriteCompletedTaskUtil(userName string) error {
 		timeToLeaveNano := timeToLeaveString.Nanoseconds()
 		taskID = (strings.Split(value, "::"))[2]
 		if elapsedTimeNano > timeToLeaveNano {
-			err = ts.deleteCompletedTask(taskID)
+			err = ts.deleteCompletedTask(ctx, taskID)
 			if err != nil {
-				log.Error("error while deleting the completed task: " + err.Error())
+				logs.Log(ctx).Error("error while deleting the completed task: " + err.Error())
 
 			}
 		}
@@ -104,31 +110,31 @@ func (ts *TasksRPC) OverWriteCompletedTaskUtil(userName string) error {
 	return nil
 }
 
-func (ts *TasksRPC) deleteCompletedTask(taskID string) error {
+func (ts *TasksRPC) deleteCompletedTask(ctx context.Context, taskID string) error {
 	task, err := ts.GetTaskStatusModel(taskID, common.InMemory)
 	if err != nil {
-		log.Error("error getting taskID - " + taskID + " status : " + err.Error())
+		logs.Log(ctx).Error("error getting taskID - " + taskID + " status : " + err.Error())
 		return nil
 	}
 	for _, subTaskID := range task.ChildTaskIDs {
 		subTask, err := ts.GetTaskStatusModel(subTaskID, common.InMemory)
 		if err != nil {
-			log.Error("error getting task status : " + err.Error())
+			logs.Log(ctx).Error("error getting task status : " + err.Error())
 			continue
 		}
 		err = ts.DeleteTaskFromDBModel(subTask)
 		if err != nil {
-			log.Error("error while deleting subtask: " + err.Error())
+			logs.Log(ctx).Error("error while deleting subtask: " + err.Error())
 		}
 	}
 	err = ts.DeleteTaskFromDBModel(task)
 	if err != nil {
-		log.Error("error while deleting the main task: " + err.Error())
+		logs.Log(ctx).Error("error while deleting the main task: " + err.Error())
 		return err
 	}
 	err = ts.DeleteTaskIndex(taskID)
 	if err != nil {
-		log.Error("error while deleting the main task: " + err.Error())
+		logs.Log(ctx).Error("error while deleting the main task: " + err.Error())
 		return err
 	}
 	return nil
@@ -136,8 +142,8 @@ func (ts *TasksRPC) deleteCompletedTask(taskID string) error {
 
 //CreateChildTask is a rpc handler which intern call actual CreateChildTask to create sub task under parent task.
 func (ts *TasksRPC) CreateChildTask(ctx context.Context, req *taskproto.CreateTaskRequest, rsp *taskproto.CreateTaskResponse) error {
-
-	taskURI, err := ts.CreateChildTaskUtil(req.UserName, req.ParentTaskID)
+	ctx = logs.With(ctx, logs.SessionUserField, req.UserName)
+	taskURI, err := ts.CreateChildTaskUtil(ctx, req.UserName, req.ParentTaskID)
 	rsp.TaskURI = taskURI
 	return err
 }
@@ -146,33 +152,33 @@ func (ts *TasksRPC) CreateChildTask(ctx context.Context, req *taskproto.CreateTa
 func (ts *TasksRPC) UpdateTask(ctx context.Context, req *taskproto.UpdateTaskRequest, rsp *taskproto.UpdateTaskResponse) error {
 	endTime, err := ptypes.Timestamp(req.EndTime)
 	if err != nil {
-		log.Error("error: while trying to convert Protobuff timestamp to time.Time: " + err.Error())
+		logs.Log(ctx).Error("error: while trying to convert Protobuff timestamp to time.Time: " + err.Error())
 		return err
 	}
-	return ts.updateTaskUtil(req.TaskID, req.TaskState, req.TaskStatus, req.PercentComplete, req.PayLoad, endTime)
+	return ts.updateTaskUtil(ctx, req.TaskID, req.TaskState, req.TaskStatus, req.PercentComplete, req.PayLoad, endTime)
 }
 
 //DeleteTask is an API end point to delete the given task.
 func (ts *TasksRPC) DeleteTask(ctx context.Context, req *taskproto.GetTaskRequest, rsp *taskproto.TaskResponse) error {
 	constructCommonResponseHeader(rsp)
-	task, err := ts.validateAndAutherize(req, rsp)
+	ctx, task, err := ts.validateAndAutherize(ctx, req, rsp)
 	if err != nil {
 		return nil
 	}
 	privileges := []string{common.PrivilegeConfigureManager}
 	authResp := ts.AuthenticationRPC(req.SessionToken, privileges)
 	if authResp.StatusCode != http.StatusOK {
-		log.Error("error while authentication")
+		logs.Log(ctx).Error("error while authentication")
 		fillProtoResponse(rsp, authResp)
 		return nil
 
 	}
 	rsp.Header["Allow"] = "DELETE"
 	if task.PercentComplete == 100 {
-		delErr := ts.deleteCompletedTask(req.TaskID)
+		delErr := ts.deleteCompletedTask(ctx, req.TaskID)
 		if delErr != nil {
 			errorMessage := "Error while deleting the completed task: " + delErr.Error()
-			log.Error(errorMessage)
+			logs.Log(ctx).Error(errorMessage)
 			fillProtoResponse(rsp, common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil))
 			return nil
 		}
@@ -184,9 +190,11 @@ func (ts *TasksRPC) DeleteTask(ctx context.Context, req *taskproto.GetTaskReques
 
 	// Cancel the task using Transaction
 	for iter := 0; iter < 5; iter++ {
-		err = ts.TransactionModel(req.TaskID, ts.taskCancelCallBack)
+		err = ts.TransactionModel(req.TaskID, func(taskID string) error {
+			return ts.taskCancelCallBack(ctx, taskID)
+		})
 		if err != nil {
-			log.Error("error while requesting for task cancellation retrying: " + err.Error())
+			logs.Log(ctx).Error("error while requesting for task cancellation retrying: " + err.Error())
 			continue
 		}
 		break
@@ -194,7 +202,7 @@ func (ts *TasksRPC) DeleteTask(ctx context.Context, req *taskproto.GetTaskReques
 	if err != nil {
 		errorMessage := "error max retries exceeded for TaskCancel Transaction: " + err.Error()
 		fillProtoResponse(rsp, common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil))
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return nil
 	}
 
@@ -246,6 +254,11 @@ func (ts *TasksRPC) DeleteTask(ctx context.Context, req *taskproto.GetTaskReques
 		},
 		PercentComplete: task.PercentComplete,
 	}
+	if task.TraceID != "" {
+		taskResponse.Oem = &tresponse.TaskOem{
+			ODIM: tresponse.TaskOdimOem{TraceID: task.TraceID},
+		}
+	}
 	if task.ParentID == "" {
 		taskResponse.SubTasks = "/redfish/v1/Tasks/" + task.ID + "/SubTasks"
 	}
@@ -267,28 +280,31 @@ func constructCommonResponseHeader(rsp *taskproto.TaskResponse) {
 
 }
 
-func (ts *TasksRPC) validateAndAutherize(req *taskproto.GetTaskRequest, rsp *taskproto.TaskResponse) (*tmodel.Task, error) {
+// validateAndAutherize authorizes the session to get the task of the request, the returned
+// context carries the session user for the correlation of the entries logged for the request
+func (ts *TasksRPC) validateAndAutherize(ctx context.Context, req *taskproto.GetTaskRequest, rsp *taskproto.TaskResponse) (context.Context, *tmodel.Task, error) {
 	privileges := []string{common.PrivilegeLogin}
 	authResp := ts.AuthenticationRPC(req.SessionToken, privileges)
 	if authResp.StatusCode != http.StatusOK {
 		fillProtoResponse(rsp, authResp)
-		log.Error(authErrorMessage)
-		return nil, fmt.Errorf(authErrorMessage)
+		logs.Log(ctx).Error(authErrorMessage)
+		return ctx, nil, fmt.Errorf(authErrorMessage)
 	}
 	sessionUserName, err := ts.GetSessionUserNameRPC(req.SessionToken)
 	if err != nil {
 		// handle the error case with appropriate response body
 		fillProtoResponse(rsp, common.GeneralError(http.StatusUnauthorized, response.NoValidSession, authErrorMessage, nil, nil))
-		log.Error(authErrorMessage)
-		return nil, fmt.Errorf(authErrorMessage)
+		logs.Log(ctx).Error(authErrorMessage)
+		return ctx, nil, fmt.Errorf(authErrorMessage)
 
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 	// get task status from database using task id
 	task, err := ts.GetTaskStatusModel(req.TaskID, common.InMemory)
 	if err != nil {
-		log.Error("error getting task status : " + err.Error())
+		logs.Log(ctx).Error("error getting task status : " + err.Error())
 		fillProtoResponse(rsp, common.GeneralError(http.StatusNotFound, response.ResourceNotFound, err.Error(), []interface{}{"Task", req.TaskID}, nil))
-		return nil, err
+		return ctx, nil, err
 	}
 	//Compare the task username with requesting session user name.
 	//If username doesnot match with task username, then check if the user
@@ -298,17 +314,17 @@ func (ts *TasksRPC) validateAndAutherize(req *taskproto.GetTaskRequest, rsp *tas
 		authResp := ts.AuthenticationRPC(req.SessionToken, privileges)
 		if authResp.StatusCode != http.StatusOK {
 			fillProtoResponse(rsp, authResp)
-			log.Error(authErrorMessage)
-			return nil, fmt.Errorf(authErrorMessage)
+			logs.Log(ctx).Error(authErrorMessage)
+			return ctx, nil, fmt.Errorf(authErrorMessage)
 		}
 	}
-	return task, nil
+	return ctx, task, nil
 }
 
-func (ts *TasksRPC) taskCancelCallBack(taskID string) error {
+func (ts *TasksRPC) taskCancelCallBack(ctx context.Context, taskID string) error {
 	task, err := ts.GetTaskStatusModel(taskID, common.InMemory)
 	if err != nil {
-		log.Error("error getting task status : " + err.Error())
+		logs.Log(ctx).Error("error getting task status : " + err.Error())
 		return nil
 	}
 	if task.TaskState == common.Completed || task.TaskState == common.Exception || task.TaskState == common.Pending {
@@ -316,7 +332,7 @@ func (ts *TasksRPC) taskCancelCallBack(taskID string) error {
 		for _, subTaskID := range task.ChildTaskIDs {
 			subTask, err := ts.GetTaskStatusModel(subTaskID, common.InMemory)
 			if err != nil {
-				log.Error("error getting task status : " + err.Error())
+				logs.Log(ctx).Error("error getting task status : " + err.Error())
 				continue
 			}
 			ts.DeleteTaskFromDBModel(subTask)
@@ -327,7 +343,7 @@ func (ts *TasksRPC) taskCancelCallBack(taskID string) error {
 	for _, subTaskID := range task.ChildTaskIDs {
 		subTask, err := ts.GetTaskStatusModel(subTaskID, common.InMemory)
 		if err != nil {
-			log.Error("error getting task status : " + err.Error())
+			logs.Log(ctx).Error("error getting task status : " + err.Error())
 			continue
 		}
 		// Just changing the TaskState to Cancelling state,
@@ -339,10 +355,11 @@ func (ts *TasksRPC) taskCancelCallBack(taskID string) error {
 			subTask.TaskState = common.Cancelling
 			err := ts.UpdateTaskStatusModel(subTask, common.InMemory)
 			if err != nil {
-				log.Error("error while updating the task: " + err.Error())
+				logs.Log(ctx).Error("error while updating the task: " + err.Error())
 				return err
 			}
-			go ts.asyncTaskDelete(subTaskID)
+			metrics.ObserveTaskState(subTask.TaskState)
+			go ts.asyncTaskDelete(ctx, subTaskID)
 		}
 	}
 	// Delete the parent task
@@ -350,16 +367,17 @@ func (ts *TasksRPC) taskCancelCallBack(taskID string) error {
 		task.TaskState = common.Cancelling
 		err := ts.UpdateTaskStatusModel(task, common.InMemory)
 		if err != nil {
-			log.Error("error while updating the task: " + err.Error())
+			logs.Log(ctx).Error("error while updating the task: " + err.Error())
 			return err
 		}
-		go ts.asyncTaskDelete(taskID)
+		metrics.ObserveTaskState(task.TaskState)
+		go ts.asyncTaskDelete(ctx, taskID)
 	}
 
 	return nil
 }
 
-func (ts *TasksRPC) asyncTaskDelete(taskID string) {
+func (ts *TasksRPC) asyncTaskDelete(ctx context.Context, taskID string) {
 	//Polling for the taskstate.
 	//If the taskstate becomes Cancelled, then this means the thread associated with this task exited succefully,
 	//so go ahead delete the task from the db
@@ -368,13 +386,13 @@ func (ts *TasksRPC) asyncTaskDelete(taskID string) {
 	for {
 		task, err := ts.GetTaskStatusModel(taskID, common.InMemory)
 		if err != nil {
-			log.Error("error getting task status : " + err.Error())
+			logs.Log(ctx).Error("error getting task status : " + err.Error())
 			return
 		}
 		if task.TaskState == common.Cancelled {
 			err = ts.DeleteTaskFromDBModel(task)
 			if err != nil {
-				log.Error("error unable to delete the task from db: " + err.Error())
+				logs.Log(ctx).Error("error unable to delete the task from db: " + err.Error())
 				return
 			}
 			break
@@ -387,7 +405,7 @@ func (ts *TasksRPC) asyncTaskDelete(taskID string) {
 //GetSubTasks is an API end point to get all available tasks
 func (ts *TasksRPC) GetSubTasks(ctx context.Context, req *taskproto.GetTaskRequest, rsp *taskproto.TaskResponse) error {
 	constructCommonResponseHeader(rsp)
-	task, err := ts.validateAndAutherize(req, rsp)
+	ctx, task, err := ts.validateAndAutherize(ctx, req, rsp)
 	if err != nil {
 		return nil
 	}
@@ -430,20 +448,21 @@ func (ts *TasksRPC) GetSubTask(ctx context.Context, req *taskproto.GetTaskReques
 	privileges := []string{common.PrivilegeLogin}
 	authResp := ts.AuthenticationRPC(req.SessionToken, privileges)
 	if authResp.StatusCode != http.StatusOK {
-		log.Error(authErrorMessage)
+		logs.Log(ctx).Error(authErrorMessage)
 		fillProtoResponse(rsp, authResp)
 		return nil
 	}
 	sessionUserName, err := ts.GetSessionUserNameRPC(req.SessionToken)
 	if err != nil {
 		fillProtoResponse(rsp, common.GeneralError(http.StatusUnauthorized, response.NoValidSession, authErrorMessage, nil, nil))
-		log.Error(authErrorMessage)
+		logs.Log(ctx).Error(authErrorMessage)
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 	// get task status from database using task id
 	task, err := ts.GetTaskStatusModel(req.SubTaskID, common.InMemory)
 	if err != nil {
-		log.Error("error getting sub task status : " + err.Error())
+		logs.Log(ctx).Error("error getting sub task status : " + err.Error())
 		fillProtoResponse(rsp, common.GeneralError(http.StatusNotFound, response.ResourceNotFound, err.Error(), []interface{}{"Task", req.SubTaskID}, nil))
 		return nil
 	}
@@ -452,7 +471,7 @@ func (ts *TasksRPC) GetSubTask(ctx context.Context, req *taskproto.GetTaskReques
 		privileges := []string{common.PrivilegeConfigureUsers}
 		authResp := ts.AuthenticationRPC(req.SessionToken, privileges)
 		if authResp.StatusCode != http.StatusOK {
-			log.Error(authErrorMessage)
+			logs.Log(ctx).Error(authErrorMessage)
 			fillProtoResponse(rsp, authResp)
 			return nil
 		}
@@ -511,6 +530,11 @@ func (ts *TasksRPC) GetSubTask(ctx context.Context, req *taskproto.GetTaskReques
 		},
 		PercentComplete: task.PercentComplete,
 	}
+	if task.TraceID != "" {
+		taskResponse.Oem = &tresponse.TaskOem{
+			ODIM: tresponse.TaskOdimOem{TraceID: task.TraceID},
+		}
+	}
 
 	// Check the state of the task
 	if task.TaskState == "Completed" || task.TaskState == "Cancelled" || task.TaskState == "Killed" || task.TaskState == "Exception" {
@@ -543,7 +567,7 @@ func (ts *TasksRPC) TaskCollection(ctx context.Context, req *taskproto.GetTaskRe
 	authResp := ts.AuthenticationRPC(req.SessionToken, privileges)
 	if authResp.StatusCode != http.StatusOK {
 		fillProtoResponse(rsp, authResp)
-		log.Error(authErrorMessage)
+		logs.Log(ctx).Error(authErrorMessage)
 		return nil
 	}
 	// Get all task in in-memory db
@@ -551,17 +575,18 @@ func (ts *TasksRPC) TaskCollection(ctx context.Context, req *taskproto.GetTaskRe
 	if err != nil {
 		errorMessage := "error: while trying to get all task keys from db: " + err.Error()
 		fillProtoResponse(rsp, common.GeneralError(http.StatusInternalServerError, response.InternalError, errorMessage, nil, nil))
-		log.Error(errorMessage)
+		logs.Log(ctx).Error(errorMessage)
 		return nil
 	}
 	statusConfigureUsers := ts.AuthenticationRPC(req.SessionToken, []string{common.PrivilegeConfigureUsers})
 	sessionUserName, err := ts.GetSessionUserNameRPC(req.SessionToken)
 	if err != nil {
 		fillProtoResponse(rsp, common.GeneralError(http.StatusUnauthorized, response.NoValidSession, authErrorMessage, nil, nil))
-		log.Error(authErrorMessage)
+		logs.Log(ctx).Error(authErrorMessage)
 		return nil
 
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 	var listMembers = []tresponse.ListMember{}
 	for _, taskID := range tasks {
 		// Check who owns the task before returning, if this can only be done by admin,
@@ -570,7 +595,7 @@ func (ts *TasksRPC) TaskCollection(ctx context.Context, req *taskproto.GetTaskRe
 		if authResp.StatusCode == http.StatusOK && statusConfigureUsers.StatusCode != http.StatusOK {
 			task, err := ts.GetTaskStatusModel(taskID, common.InMemory)
 			if err != nil {
-				log.Error("error getting task status : " + err.Error())
+				logs.Log(ctx).Error("error getting task status : " + err.Error())
 				fillProtoResponse(rsp, common.GeneralError(http.StatusNotFound, response.ResourceNotFound, authErrorMessage, nil, nil))
 				return nil
 			}
@@ -587,17 +612,21 @@ func (ts *TasksRPC) TaskCollection(ctx context.Context, req *taskproto.GetTaskRe
 		}
 	}
 
-	// return response with status OK
-	rsp.StatusCode = http.StatusOK
-	rsp.StatusMessage = response.Success
-
 	//Frame the Response to send it back as response body
 	taskResp := tresponse.TaskCollectionResponse{
 		Response:     commonResponse,
 		MembersCount: len(listMembers),
 		Members:      listMembers,
 	}
-	rsp.Body = generateResponse(taskResp)
+	// return response with status OK, after applying the query parameters if any
+	resp := common.ApplyQueryOptions(response.RPC{
+		StatusCode:    http.StatusOK,
+		StatusMessage: response.Success,
+		Body:          taskResp,
+	}, req.URL, ts.getTaskMembers)
+	rsp.StatusCode = resp.StatusCode
+	rsp.StatusMessage = resp.StatusMessage
+	rsp.Body = generateResponse(resp.Body)
 	return nil
 }
 
@@ -612,12 +641,31 @@ func (ts *TasksRPC) TaskCollection(ctx context.Context, req *taskproto.GetTaskRe
 // StatusForbidden.
 func (ts *TasksRPC) GetTasks(ctx context.Context, req *taskproto.GetTaskRequest, rsp *taskproto.TaskResponse) error {
 	constructCommonResponseHeader(rsp)
-	task, err := ts.validateAndAutherize(req, rsp)
+	ctx, task, err := ts.validateAndAutherize(ctx, req, rsp)
 	if err != nil {
 		return nil
 	}
 	rsp.Header["Link"] = "</redfish/v1/SchemaStore/en/TaskCollection.json/>; rel=describedby"
 	//Build the respose Body
+	taskResponse := buildTaskResponse(task)
+	// Check the state of the task
+	if task.TaskState == "Completed" || task.TaskState == "Cancelled" || task.TaskState == "Killed" || task.TaskState == "Exception" {
+		// return with the 200 OK, along with response header and response body
+		rsp.StatusCode = http.StatusOK
+	} else {
+		// return 202
+		// build response header
+		// return with empty response body
+		rsp.Header["location"] = task.TaskMonitor
+		rsp.StatusCode = http.StatusAccepted
+	}
+	rsp.StatusMessage = "Success"
+	rsp.Body = generateResponse(taskResponse) // cannot convert task response directly to []byte that's why it needs to be marshalled and send as response in byte format
+	return nil
+}
+
+// buildTaskResponse builds the Task resource from the task stored in DB
+func buildTaskResponse(task *tmodel.Task) tresponse.Task {
 	messageList := []tresponse.Messages{}
 	for _, element := range task.Messages {
 		message := tresponse.Messages{
@@ -637,9 +685,7 @@ func (ts *TasksRPC) GetTasks(ctx context.Context, req *taskproto.GetTaskRequest,
 		OdataContext: "/redfish/v1/$metadata#Task.Task",
 		OdataID:      "/redfish/v1/TaskService/Tasks/" + task.ID,
 	}
-	rsp.StatusCode = http.StatusAccepted
-	rsp.StatusMessage = response.Success
-	commonResponse.CreateGenericResponse(rsp.StatusMessage)
+	commonResponse.CreateGenericResponse(response.Success)
 	commonResponse.Message = ""
 	commonResponse.MessageID = ""
 	commonResponse.Severity = ""
@@ -665,23 +711,34 @@ func (ts *TasksRPC) GetTasks(ctx context.Context, req *taskproto.GetTaskRequest,
 		},
 		PercentComplete: task.PercentComplete,
 	}
+	if task.TraceID != "" {
+		taskResponse.Oem = &tresponse.TaskOem{
+			ODIM: tresponse.TaskOdimOem{TraceID: task.TraceID},
+		}
+	}
 	if task.ParentID == "" && len(task.ChildTaskIDs) != 0 {
 		taskResponse.SubTasks = "/redfish/v1/TaskService/Tasks/" + task.ID + "/SubTasks"
 	}
-	// Check the state of the task
-	if task.TaskState == "Completed" || task.TaskState == "Cancelled" || task.TaskState == "Killed" || task.TaskState == "Exception" {
-		// return with the 200 OK, along with response header and response body
-		rsp.StatusCode = http.StatusOK
-	} else {
-		// return 202
-		// build response header
-		// return with empty response body
-		rsp.Header["location"] = task.TaskMonitor
-		rsp.StatusCode = http.StatusAccepted
+	return taskResponse
+}
+
+// getTaskMembers reads the tasks from the in-memory DB for expanding the task collection
+func (ts *TasksRPC) getTaskMembers(odataIDs []string) (map[string]string, *errors.Error) {
+	taskIDs := make([]string, len(odataIDs))
+	for i, odataID := range odataIDs {
+		taskIDs[i] = odataID[strings.LastIndex(odataID, "/")+1:]
 	}
-	rsp.StatusMessage = "Success"
-	rsp.Body = generateResponse(taskResponse) // cannot convert task response directly to []byte that's why it needs to be marshalled and send as response in byte format
-	return nil
+	tasks, err := ts.GetTasksModel(taskIDs, common.InMemory)
+	if err != nil {
+		return nil, errors.PackError(errors.UndefinedErrorType, err)
+	}
+	members := make(map[string]string, len(tasks))
+	for i, taskID := range taskIDs {
+		if task, ok := tasks[taskID]; ok {
+			members[odataIDs[i]] = string(generateResponse(buildTaskResponse(task)))
+		}
+	}
+	return members, nil
 }
 
 // GetTaskService is an API handler to get Task service details
@@ -699,7 +756,7 @@ func (ts *TasksRPC) GetTaskService(ctx context.Context, req *taskproto.GetTaskRe
 	authResp := ts.AuthenticationRPC(req.SessionToken, privileges)
 	if authResp.StatusCode != http.StatusOK {
 		fillProtoResponse(rsp, authResp)
-		log.Error(authErrorMessage)
+		logs.Log(ctx).Error(authErrorMessage)
 		return nil
 	}
 
@@ -768,16 +825,16 @@ func fillProtoResponse(resp *taskproto.TaskResponse, data response.RPC) {
 //Returns:
 //	New Task URI of Type string
 //	err of type error
-func (ts *TasksRPC) CreateTaskUtil(userName string) (string, error) {
+func (ts *TasksRPC) CreateTaskUtil(ctx context.Context, userName string) (string, error) {
 
 	if userName == "" {
-		log.Error("error invalid input argument for userName")
+		logs.Log(ctx).Error("error invalid input argument for userName")
 		return "", fmt.Errorf("error invalid username")
 	}
 	// Validate given username exist in the db
 	err := ts.ValidateTaskUserNameModel(userName)
 	if err != nil {
-		log.Error("error invalid user : " + err.Error())
+		logs.Log(ctx).Error("error invalid user : " + err.Error())
 		return "", fmt.Errorf("error invalid user: " + err.Error())
 	}
 	// Frame the model
@@ -792,6 +849,7 @@ func (ts *TasksRPC) CreateTaskUtil(userName string) (string, error) {
 		PercentComplete: 0,
 		StartTime:       currentTime,
 		EndTime:         time.Time{},
+		TraceID:         tracing.TraceID(ctx),
 	}
 	task.Name = "Task " + task.ID
 	task.TaskMonitor = "/taskmon/" + task.ID
@@ -800,9 +858,10 @@ func (ts *TasksRPC) CreateTaskUtil(userName string) (string, error) {
 	// Persist in the in-memory DB
 	err = ts.PersistTaskModel(&task, common.InMemory)
 	if err != nil {
-		log.Error("error while trying to insert the task details: " + err.Error())
+		logs.Log(ctx).Error("error while trying to insert the task details: " + err.Error())
 		return "", err
 	}
+	metrics.ObserveTaskState(task.TaskState)
 	// return the Task URI
 	return "/redfish/v1/TaskService/Tasks/" + task.ID, err
 }
@@ -814,25 +873,25 @@ func (ts *TasksRPC) CreateTaskUtil(userName string) (string, error) {
 //	err of type error
 //	nil - On Success
 //	Non nil - On Failure
-func (ts *TasksRPC) CreateChildTaskUtil(userName string, parentTaskID string) (string, error) {
+func (ts *TasksRPC) CreateChildTaskUtil(ctx context.Context, userName string, parentTaskID string) (string, error) {
 
 	var parentTask *tmodel.Task
 	var childTask *tmodel.Task
 	var taskURI string
 	if parentTaskID == "" {
-		log.Error("error empty/invalid input Parent Task ID")
+		logs.Log(ctx).Error("error empty/invalid input Parent Task ID")
 		return "", fmt.Errorf("error parent task ID is empty")
 	}
 	// Retrieve the task details from db
 	parentTask, err := ts.GetTaskStatusModel(parentTaskID, common.InMemory)
 	if err != nil {
-		log.Error("error while retrieving the task details from DB: " + err.Error())
+		logs.Log(ctx).Error("error while retrieving the task details from DB: " + err.Error())
 		return "", fmt.Errorf("error while retrieing the task detais from DB: " + err.Error())
 	}
 	// Create the child/sub task with parent task's UserName
-	taskURI, err = ts.CreateTaskUtilHelper(parentTask.UserName)
+	taskURI, err = ts.CreateTaskUtilHelper(ctx, parentTask.UserName)
 	if err != nil {
-		log.Error("error while creating the child/sub task: " + err.Error())
+		logs.Log(ctx).Error("error while creating the child/sub task: " + err.Error())
 		return "", fmt.Errorf("error while creating child/sub task: " + err.Error())
 	}
 	var childTaskID string
@@ -845,7 +904,7 @@ func (ts *TasksRPC) CreateChildTaskUtil(userName string, parentTaskID string) (s
 	// Get the Child task to update with Parent task ID
 	childTask, err = ts.GetTaskStatusModel(childTaskID, common.InMemory)
 	if err != nil {
-		log.Error("error while retrieving the child/sub task from DB: " + err.Error())
+		logs.Log(ctx).Error("error while retrieving the child/sub task from DB: " + err.Error())
 		return "", fmt.Errorf("error while retrieving the child/sub task from DB: " + err.Error())
 	}
 	childTask.ParentID = parentTaskID
@@ -853,7 +912,7 @@ func (ts *TasksRPC) CreateChildTaskUtil(userName string, parentTaskID string) (s
 	// Store the updated task in to In Memory DB
 	err = ts.UpdateTaskStatusModel(childTask, common.InMemory)
 	if err != nil {
-		log.Error("error while updating the child/sub task details in to DB: " + err.Error())
+		logs.Log(ctx).Error("error while updating the child/sub task details in to DB: " + err.Error())
 		return "", fmt.Errorf("error while updating the child/sub task details: " + err.Error())
 	}
 	// Add the child/sub task id in to ChildTaskIDs(array) of the parent task
@@ -861,7 +920,7 @@ func (ts *TasksRPC) CreateChildTaskUtil(userName string, parentTaskID string) (s
 	// Update the parent task in to In Memory DB
 	err = ts.UpdateTaskStatusModel(parentTask, common.InMemory)
 	if err != nil {
-		log.Error("error while updating the task details in to DB: " + err.Error())
+		logs.Log(ctx).Error("error while updating the task details in to DB: " + err.Error())
 		return "", fmt.Errorf("error while trying to update the task details in InMemory DB: " + err.Error())
 	}
 	return "/redfish/v1/TaskService/Tasks/" + childTaskID, err
@@ -878,7 +937,7 @@ func (ts *TasksRPC) CreateChildTaskUtil(userName string, parentTaskID string) (s
 //	err of type error
 //	nil - On Success
 //	Non nil - On Failure
-func (ts *TasksRPC) updateTaskUtil(taskID string, taskState string, taskStatus string, percentComplete int32, payLoad *taskproto.Payload, endTime time.Time) error {
+func (ts *TasksRPC) updateTaskUtil(ctx context.Context, taskID string, taskState string, taskStatus string, percentComplete int32, payLoad *taskproto.Payload, endTime time.Time) error {
 
 	var task *tmodel.Task
 	var taskEvenMessageID string
@@ -894,6 +953,7 @@ func (ts *TasksRPC) updateTaskUtil(taskID string, taskState string, taskStatus s
 	if task.TaskState == common.Cancelling && taskState != common.Cancelled {
 		return fmt.Errorf(common.Cancelling)
 	}
+	previousState := task.TaskState
 	// Set the task state
 	switch taskState {
 
@@ -905,7 +965,7 @@ func (ts *TasksRPC) updateTaskUtil(taskID string, taskState string, taskStatus s
 		if taskStatus == "Critical" || taskStatus == "Warning" || taskStatus == "OK" {
 			task.TaskStatus = taskStatus
 		} else {
-			log.Error("error invalid task status provided as input argument")
+			logs.Log(ctx).Error("error invalid task status provided as input argument")
 			return fmt.Errorf("error invalid taskStatus provided as input argument")
 		}
 		if endTime == (time.Time{}) {
@@ -933,7 +993,7 @@ func (ts *TasksRPC) updateTaskUtil(taskID string, taskState string, taskStatus s
 		if taskStatus == "Critical" || taskStatus == "Warning" {
 			task.TaskStatus = taskStatus
 		} else {
-			log.Error("error invalid tast status provided as input argument")
+			logs.Log(ctx).Error("error invalid tast status provided as input argument")
 			return fmt.Errorf("error invalid taskStatus provided as input argument")
 		}
 		if endTime == (time.Time{}) {
@@ -955,7 +1015,7 @@ func (ts *TasksRPC) updateTaskUtil(taskID string, taskState string, taskStatus s
 		if taskStatus == "Critical" || taskStatus == "Warning" {
 			task.TaskStatus = taskStatus
 		} else {
-			log.Error("error invalid tast status provided as input argument")
+			logs.Log(ctx).Error("error invalid tast status provided as input argument")
 			return fmt.Errorf("error invalid taskStatus provided as input argument")
 		}
 		if endTime == (time.Time{}) {
@@ -976,7 +1036,7 @@ func (ts *TasksRPC) updateTaskUtil(taskID string, taskState string, taskStatus s
 		if taskStatus == "Critical" || taskStatus == "Warning" {
 			task.TaskStatus = taskStatus
 		} else {
-			log.Error("error invalid tast status provided as input argument")
+			logs.Log(ctx).Error("error invalid tast status provided as input argument")
 			return fmt.Errorf("error invalid taskStatus provided as input argument")
 		}
 		if endTime == (time.Time{}) {
@@ -1069,18 +1129,21 @@ func (ts *TasksRPC) updateTaskUtil(taskID string, taskState string, taskStatus s
 		taskEvenMessageID = "TaskEvent.1.0.1.Task" + taskState
 		// TODO
 	default:
-		log.Error("error invalid task state")
+		logs.Log(ctx).Error("error invalid task state")
 		return fmt.Errorf("error invalid input argument for taskState")
 	}
 	// Update the task data in the InMemory DB
 	err = ts.UpdateTaskStatusModel(task, common.InMemory)
 	if err != nil {
-		log.Error("error while updating the task in to In-memory DB: " + err.Error())
+		logs.Log(ctx).Error("error while updating the task in to In-memory DB: " + err.Error())
 		return fmt.Errorf("error while updating the task in to In-memory DB: " + err.Error())
 	}
+	if task.TaskState != previousState {
+		metrics.ObserveTaskState(task.TaskState)
+	}
 	// Notify the user about task state change by sending statuschange event
 	//	notifyTaskStateChange(task.URI, taskEvenMessageID)
 	eventType := "StatusChange"
-	ts.PublishToMessageBus(task.URI, taskEvenMessageID, eventType)
+	ts.PublishToMessageBus(ctx, task.URI, taskEvenMessageID, eventType)
 	return err
 }
@@ -70,13 +70,13 @@ func mockGetTaskStatus(taskID string, db common.DbType) (*tmodel.Task, error) {
 	return &task, nil
 }
 
-func mockOverWriteCompletedTaskUtil(userName string) error {
+func mockOverWriteCompletedTaskUtil(ctx context.Context, userName string) error {
 	if userName != "validUser" {
 		return fmt.Errorf("user does not exist")
 	}
 	return nil
 }
-func mockCreateTaskUtil(userName string) (string, error) {
+func mockCreateTaskUtil(ctx context.Context, userName string) (string, error) {
 
 	if userName == "" {
 		return "", fmt.Errorf("error invalid input argument for userName")
@@ -132,7 +132,7 @@ func mockUpdateTaskStatusModel(task *tmodel.Task, db common.DbType) error {
 	}
 	return nil
 }
-func mockPublishToMessageBus(taskURI string, taskEvenMessageID string, eventType string) {
+func mockPublishToMessageBus(ctx context.Context, taskURI string, taskEvenMessageID string, eventType string) {
 
 }
 func mockValidateTaskUserNameModel(userName string) error {
@@ -300,6 +300,16 @@ func mockGetAllTaskKeysModel() ([]string, error) {
 	keys := []string{"task:key1", "task:key2"}
 	return keys, nil
 }
+func mockGetTasksModel(taskIDs []string, db common.DbType) (map[string]*tmodel.Task, error) {
+	tasks := make(map[string]*tmodel.Task, len(taskIDs))
+	for _, taskID := range taskIDs {
+		if task, err := mockGetTaskStatusModel(taskID, db); err == nil {
+			tasks[taskID] = task
+		}
+	}
+	return tasks, nil
+}
+
 func TestTasksRPC_TaskCollection(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -347,6 +357,61 @@ func TestTasksRPC_TaskCollection(t *testing.T) {
 				StatusCode: http.StatusUnauthorized,
 			},
 		},
+		{
+			name: "Positive test case, with $top and $skip.",
+			ts: &TasksRPC{
+				AuthenticationRPC:     mockIsAuthorized,
+				GetSessionUserNameRPC: mockGetSessionUserName,
+				GetAllTaskKeysModel:   mockGetAllTaskKeysModel,
+			},
+			args: args{
+				req: &taskproto.GetTaskRequest{
+					SessionToken: "validToken",
+					URL:          "/redfish/v1/TaskService/Tasks?$top=1&$skip=1",
+				},
+				rsp: &taskproto.TaskResponse{},
+			},
+			want: taskproto.TaskResponse{
+				StatusCode: http.StatusOK,
+			},
+		},
+		{
+			name: "Positive test case, with $expand.",
+			ts: &TasksRPC{
+				AuthenticationRPC:     mockIsAuthorized,
+				GetSessionUserNameRPC: mockGetSessionUserName,
+				GetAllTaskKeysModel:   mockGetAllTaskKeysModel,
+				GetTasksModel:         mockGetTasksModel,
+			},
+			args: args{
+				req: &taskproto.GetTaskRequest{
+					SessionToken: "validToken",
+					URL:          "/redfish/v1/TaskService/Tasks?$expand=.",
+				},
+				rsp: &taskproto.TaskResponse{},
+			},
+			want: taskproto.TaskResponse{
+				StatusCode: http.StatusOK,
+			},
+		},
+		{
+			name: "Negative test case, invalid $top.",
+			ts: &TasksRPC{
+				AuthenticationRPC:     mockIsAuthorized,
+				GetSessionUserNameRPC: mockGetSessionUserName,
+				GetAllTaskKeysModel:   mockGetAllTaskKeysModel,
+			},
+			args: args{
+				req: &taskproto.GetTaskRequest{
+					SessionToken: "validToken",
+					URL:          "/redfish/v1/TaskService/Tasks?$top=all",
+				},
+				rsp: &taskproto.TaskResponse{},
+			},
+			want: taskproto.TaskResponse{
+				StatusCode: http.StatusBadRequest,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -941,7 +1006,7 @@ func TestTasksRPC_OverWriteCompletedTaskUtil(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.ts.OverWriteCompletedTaskUtil(tt.args.userName); !(err != nil && tt.wantError != nil) && !(err == nil && tt.wantError == nil) {
+			if err := tt.ts.OverWriteCompletedTaskUtil(context.Background(), tt.args.userName); !(err != nil && tt.wantError != nil) && !(err == nil && tt.wantError == nil) {
 				t.Errorf("TasksRPC.OverWriteCompletedTaskUtil() error = %v, wantErr %v", err, tt.wantError)
 			}
 		})
@@ -1710,7 +1775,7 @@ func TestTasksRPC_CreateTaskUtil(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := tt.ts.CreateTaskUtil(tt.args.userName)
+			_, err := tt.ts.CreateTaskUtil(context.TODO(), tt.args.userName)
 			if !reflect.DeepEqual(err, tt.wantErr) {
 				t.Errorf("TasksRPC.CreateTaskUtil() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1781,7 +1846,7 @@ func TestTasksRPC_taskCancelCallBack(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.ts.taskCancelCallBack(tt.args.taskID)
+			err := tt.ts.taskCancelCallBack(context.Background(), tt.args.taskID)
 			if !reflect.DeepEqual(err, tt.wantErr) {
 				t.Errorf("TasksRPC.taskCancelCallBack() error = %v, wantErr %v", err, tt.wantErr)
 			}
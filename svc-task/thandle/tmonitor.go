@@ -18,11 +18,11 @@ package thandle
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"time"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	taskproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/task"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-task/tresponse"
@@ -48,20 +48,21 @@ func (ts *TasksRPC) GetTaskMonitor(ctx context.Context, req *taskproto.GetTaskRe
 	privileges := []string{common.PrivilegeLogin}
 	authResp := ts.AuthenticationRPC(req.SessionToken, privileges)
 	if authResp.StatusCode != http.StatusOK {
-		log.Printf(authErrorMessage)
+		logs.Log(ctx).Error(authErrorMessage)
 		fillProtoResponse(rsp, authResp)
 		return nil
 	}
-	_, err := ts.GetSessionUserNameRPC(req.SessionToken)
+	sessionUserName, err := ts.GetSessionUserNameRPC(req.SessionToken)
 	if err != nil {
-		log.Printf(authErrorMessage)
+		logs.Log(ctx).Error(authErrorMessage)
 		fillProtoResponse(rsp, common.GeneralError(http.StatusUnauthorized, response.NoValidSession, authErrorMessage, nil, nil))
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 	// get task status from database using task id
 	task, err := ts.GetTaskStatusModel(req.TaskID, common.InMemory)
 	if err != nil {
-		log.Printf("error getting task status : %v", err)
+		logs.Log(ctx).Errorf("error getting task status : %v", err)
 		fillProtoResponse(rsp, common.GeneralError(http.StatusNotFound, response.ResourceNotFound, err.Error(), []interface{}{"Task", req.TaskID}, nil))
 		return nil
 	}
@@ -79,7 +80,7 @@ func (ts *TasksRPC) GetTaskMonitor(ctx context.Context, req *taskproto.GetTaskRe
 		/*
 			err := task.Delete()
 			if err != nil {
-				log.Printf("error while deleting the task from db: %v", err)
+				logs.Log(ctx).Errorf("error while deleting the task from db: %v", err)
 			}
 		*/
 		return nil
@@ -15,6 +15,7 @@
 package tmessagebus
 
 import (
+	"context"
 	"encoding/json"
 	log "github.com/sirupsen/logrus"
 
@@ -25,15 +26,11 @@ import (
 )
 
 //Publish will takes the taskURI, messageID, Event type and publishes the data to message bus
-func Publish(taskURI string, messageID string, eventType string) {
+//along with the trace context carried by ctx
+func Publish(ctx context.Context, taskURI string, messageID string, eventType string) {
 
-	k, err := dc.Communicator(dc.KAFKA, config.Data.MessageQueueConfigFilePath)
-	if err != nil {
-		log.Error("Unable to connect to kafka" + err.Error())
-		return
-	}
+	k := dc.GetProducer(dc.KAFKA, config.Data.MessageQueueConfigFilePath)
 	var eventID = uuid.NewV4().String()
-	defer k.Close()
 	var event = common.Event{
 		EventID:   eventID,
 		MessageID: messageID,
@@ -55,9 +52,11 @@ func Publish(taskURI string, messageID string, eventType string) {
 		Request: data,
 	}
 
-	if err := k.Distribute("REDFISH-EVENTS-TOPIC", mbevent); err != nil {
-		log.Error("unable to publish the event to message bus: " + err.Error())
-		return
-	}
-	log.Error("info: TaskURI:" + taskURI + ", EventID:" + eventID + ", MessageID:" + messageID)
+	k.DistributeAsync(ctx, "REDFISH-EVENTS-TOPIC", mbevent, func(err error) {
+		if err != nil {
+			log.Error("unable to publish the event to message bus: " + err.Error())
+			return
+		}
+		log.Info("TaskURI:" + taskURI + ", EventID:" + eventID + ", MessageID:" + messageID)
+	})
 }
@@ -0,0 +1,29 @@
+//(C) Copyright [2020] Hewlett Packard Enterprise Development LP
+//
+//Licensed under the Apache License, Version 2.0 (the "License"); you may
+//not use this file except in compliance with the License. You may obtain
+//a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//License for the specific language governing permissions and limitations
+// under the License.
+
+package tmodel
+
+import (
+	"github.com/ODIM-Project/ODIM/lib-persistence-manager/persistencemgr"
+)
+
+// Migrations are the changes of the schema of the records stored by the task service,
+// they are run on the in-memory DB when the service starts
+var Migrations = []persistencemgr.Migration{
+	{
+		Table:       "task",
+		Version:     1,
+		Description: "initial schema of the tasks",
+	},
+}
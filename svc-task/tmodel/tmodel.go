@@ -63,6 +63,8 @@ type Task struct {
 	Messages        []*Message // Its there in the spec, how are we going to use it
 	StartTime       time.Time
 	EndTime         time.Time
+	// TraceID is the ID of the trace of the request which created the task
+	TraceID string
 }
 
 // Payload contain information detailing the HTTP and JSON payload
@@ -233,6 +235,31 @@ func GetTaskStatus(taskID string, db common.DbType) (*Task, error) {
 	return task, nil
 }
 
+// GetTasks reads the tasks of the taskIDs from the DB in a single request.
+// The tasks which are not found are left out of the result.
+func GetTasks(taskIDs []string, db common.DbType) (map[string]*Task, error) {
+	connPool, err := common.GetDBConnection(common.InMemory)
+	if err != nil {
+		log.Error("GetTasks : error while trying to get DB Connection : " + err.Error())
+		return nil, fmt.Errorf("error while trying to connnect to DB: %v", err.Error())
+	}
+	tasksData, err := connPool.ReadMultiple("task", taskIDs)
+	if err != nil {
+		log.Error("GetTasks : Unable to read taskdata from DB: " + err.Error())
+		return nil, fmt.Errorf("error while trying to read from DB: %v", err.Error())
+	}
+	tasks := make(map[string]*Task, len(tasksData))
+	for taskID, taskData := range tasksData {
+		task := new(Task)
+		if errs := json.Unmarshal([]byte(taskData), task); errs != nil {
+			log.Error("GetTasks : error while trying to unmarshal task data of " + taskID + ": " + errs.Error())
+			continue
+		}
+		tasks[taskID] = task
+	}
+	return tasks, nil
+}
+
 // GetAllTaskKeys will collect all task keys available in the DB
 //Takes:
 //	None
@@ -34,6 +34,7 @@ type SubTask struct {
 	PercentComplete int32        `json:"PercentComplete,omitempty"`
 	Payload         Payload      `json:"Payload,omitempty"`
 	Messages        []Messages   `json:"Messages"`
+	Oem             *TaskOem     `json:"Oem,omitempty"`
 }
 
 //Task struct is used to display to the user
@@ -49,6 +50,7 @@ type Task struct {
 	HidePayload     bool       `json:"-"`
 	Payload         Payload    `json:"Payload,omitempty"`
 	Messages        []Messages `json:"Messages"`
+	Oem             *TaskOem   `json:"Oem,omitempty"`
 }
 
 //Messages struct is used to display to the user
@@ -66,6 +68,17 @@ type Messages struct {
 type Oem struct {
 }
 
+// TaskOem is the Oem property of the task with the ODIMRA specific properties
+type TaskOem struct {
+	ODIM TaskOdimOem `json:"ODIM"`
+}
+
+// TaskOdimOem holds the ODIMRA specific properties of the task
+type TaskOdimOem struct {
+	// TraceID is the ID of the trace of the request which created the task
+	TraceID string `json:"TraceId"`
+}
+
 // Payload struct is used to give response to the user
 type Payload struct {
 	HTTPHeaders   []string `json:"HttpHeaders"`
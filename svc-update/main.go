@@ -24,10 +24,10 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-var log = logrus.New()
+// log is the standard logger, set up with the format and level of the service
+var log = logrus.StandardLogger()
 
 func main() {
-	//log.SetFormatter(&log.TextFormatter{})
 	// verifying the uid of the user
 	if uid := os.Geteuid(); uid == 0 {
 		log.Error("System Service should not be run as the root user")
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
+	"github.com/ODIM-Project/ODIM/lib-utilities/logs"
 	updateproto "github.com/ODIM-Project/ODIM/lib-utilities/proto/update"
 	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 )
@@ -97,11 +98,12 @@ func (a *Updater) SimepleUpdate(ctx context.Context, req *updateproto.UpdateRequ
 		log.Warn(errMsg)
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 	taskURI, err := a.connector.External.CreateTask(sessionUserName)
 	if err != nil {
 		errMsg := "error while trying to create task: " + err.Error()
 		generateRPCResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
-		log.Warn(errMsg)
+		logs.Log(ctx).Warn(errMsg)
 		return nil
 	}
 	strArray := strings.Split(taskURI, "/")
@@ -120,7 +122,7 @@ func (a *Updater) SimepleUpdate(ctx context.Context, req *updateproto.UpdateRequ
 		HTTPMethod:      http.MethodPost,
 	})
 	if err != nil {
-		log.Warn("error while contacting task-service with UpdateTask RPC : " + err.Error())
+		logs.Log(ctx).Warn("error while contacting task-service with UpdateTask RPC : " + err.Error())
 	}
 	go a.connector.SimpleUpdate(taskID, sessionUserName, req)
 	// return 202 Accepted
@@ -154,11 +156,12 @@ func (a *Updater) StartUpdate(ctx context.Context, req *updateproto.UpdateReques
 		log.Warn(errMsg)
 		return nil
 	}
+	ctx = logs.With(ctx, logs.SessionUserField, sessionUserName)
 	taskURI, err := a.connector.External.CreateTask(sessionUserName)
 	if err != nil {
 		errMsg := "error while trying to create task: " + err.Error()
 		generateRPCResponse(common.GeneralError(http.StatusInternalServerError, response.InternalError, errMsg, nil, nil), resp)
-		log.Warn(errMsg)
+		logs.Log(ctx).Warn(errMsg)
 		return nil
 	}
 	strArray := strings.Split(taskURI, "/")
@@ -178,7 +181,7 @@ func (a *Updater) StartUpdate(ctx context.Context, req *updateproto.UpdateReques
 	})
 	if err != nil {
 		// print error as we are unable to communicate with svc-task and then return
-		log.Warn("error while contacting task-service with UpdateTask RPC : " + err.Error())
+		logs.Log(ctx).Warn("error while contacting task-service with UpdateTask RPC : " + err.Error())
 	}
 	go a.connector.StartUpdate(taskID, sessionUserName, req)
 	// return 202 Accepted
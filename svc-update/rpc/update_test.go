@@ -39,6 +39,12 @@ func mockContactClient(url, method, token string, odataID string, body interface
 	return nil, fmt.Errorf("InvalidRequest")
 }
 
+func mockGetResources(table string, keys []string, dbType common.DbType) (map[string]string, *errors.Error) {
+	return common.EachMember(func(key string) (string, *errors.Error) {
+		return mockGetResource(table, key, dbType)
+	})(keys)
+}
+
 func mockGetResource(table, key string, dbType common.DbType) (string, *errors.Error) {
 	if (key == "/redfish/v1/UpdateService/FirmwareInentory/3bd1f589-117a-4cf9-89f2-da44ee8e012b:1") || (key == "/redfish/v1/UpdateService/SoftwareInentory/3bd1f589-117a-4cf9-89f2-da44ee8e012b:1") {
 		return "", errors.PackError(errors.DBKeyNotFound, "not found")
@@ -59,6 +65,7 @@ func mockGetExternalInterface() *update.ExternalInterface {
 		DB: update.DB{
 			GetAllKeysFromTable: mockGetAllKeysFromTable,
 			GetResource:         mockGetResource,
+			GetResources:        mockGetResources,
 		},
 	}
 }
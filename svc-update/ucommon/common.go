@@ -19,24 +19,21 @@ import (
 	"encoding/json"
 	"fmt"
 	log "github.com/sirupsen/logrus"
-	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/ODIM-Project/ODIM/lib-rest-client/pluginclient"
 	"github.com/ODIM-Project/ODIM/lib-utilities/common"
 	"github.com/ODIM-Project/ODIM/lib-utilities/config"
 	"github.com/ODIM-Project/ODIM/lib-utilities/errors"
-	"github.com/ODIM-Project/ODIM/lib-utilities/response"
 	"github.com/ODIM-Project/ODIM/svc-update/umodel"
 )
 
 //PluginContactRequest  hold the request of contact plugin
 type PluginContactRequest struct {
-	Token          string
 	OID            string
 	DeviceInfo     interface{}
-	BasicAuth      map[string]string
 	ContactClient  func(string, string, string, string, interface{}, map[string]string) (*http.Response, error)
 	PostBody       interface{}
 	Plugin         umodel.Plugin
@@ -82,28 +79,6 @@ func (i *CommonInterface) GetResourceInfoFromDevice(req ResourceInfoRequest) (st
 
 	contactRequest.ContactClient = req.ContactClient
 	contactRequest.Plugin = plugin
-
-	if strings.EqualFold(plugin.PreferredAuthType, "XAuthToken") {
-		var err error
-		contactRequest.HTTPMethodType = http.MethodPost
-		contactRequest.DeviceInfo = map[string]interface{}{
-			"Username": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-		contactRequest.OID = "/ODIM/v1/Sessions"
-		_, token, _, err := i.ContactPlugin(contactRequest, "error while getting the details "+contactRequest.OID+": ")
-		if err != nil {
-
-			return "", err
-		}
-		contactRequest.Token = token
-	} else {
-		contactRequest.BasicAuth = map[string]string{
-			"UserName": plugin.Username,
-			"Password": string(plugin.Password),
-		}
-
-	}
 	decryptedPasswordByte, err := req.DevicePassword(target.Password)
 	if err != nil {
 		// Frame the RPC response body and response Header below
@@ -189,55 +164,37 @@ func getResourceName(oDataID string, memberFlag bool) string {
 	return str[len(str)-2]
 }
 
-// ContactPlugin is commons which handles the request and response of Contact Plugin usage
+// ContactPlugin is commons which handles the request and response of Contact Plugin usage.
+// The request is authenticated with the session token or the basic authentication of the
+// plugin, and the error response of the plugin is returned as a Redfish error response.
 func ContactPlugin(req PluginContactRequest, errorMessage string) ([]byte, string, ResponseStatus, error) {
 	var resp ResponseStatus
-	var err error
-	pluginResponse, err := callPlugin(req)
-	if err != nil {
-		if getPluginStatus(req.Plugin) {
-			pluginResponse, err = callPlugin(req)
-		}
-		if err != nil {
-			errorMessage = errorMessage + err.Error()
-			resp.StatusCode = http.StatusServiceUnavailable
-			resp.StatusMessage = response.CouldNotEstablishConnection
-			resp.MsgArgs = []interface{}{"https://" + req.Plugin.IP + ":" + req.Plugin.Port + req.OID}
-			return nil, "", resp, fmt.Errorf(errorMessage)
-		}
-	}
-	defer pluginResponse.Body.Close()
-	body, err := ioutil.ReadAll(pluginResponse.Body)
+	pluginResponse, err := pluginclient.Do(pluginclient.Request{
+		Plugin: pluginclient.Plugin{
+			ID:                req.Plugin.ID,
+			IP:                req.Plugin.IP,
+			Port:              req.Plugin.Port,
+			Username:          req.Plugin.Username,
+			Password:          req.Plugin.Password,
+			PreferredAuthType: req.Plugin.PreferredAuthType,
+		},
+		Method:        req.HTTPMethodType,
+		URI:           req.OID,
+		Body:          req.DeviceInfo,
+		ContactClient: req.ContactClient,
+		StatusCheck: func() bool {
+			return getPluginStatus(req.Plugin)
+		},
+	})
 	if err != nil {
-		errorMessage := "error while trying to read response body: " + err.Error()
-		resp.StatusCode = http.StatusInternalServerError
-		resp.StatusMessage = errors.InternalError
-		log.Warn(errorMessage)
-		return nil, "", resp, fmt.Errorf(errorMessage)
-	}
-
-	if pluginResponse.StatusCode != http.StatusCreated && pluginResponse.StatusCode != http.StatusOK {
-		if pluginResponse.StatusCode == http.StatusUnauthorized {
-			errorMessage += "error: invalid resource username/password"
-			resp.StatusCode = int32(pluginResponse.StatusCode)
-			resp.StatusMessage = response.ResourceAtURIUnauthorized
-			resp.MsgArgs = []interface{}{"https://" + req.Plugin.IP + ":" + req.Plugin.Port + req.OID}
-			log.Warn(errorMessage)
-			return nil, "", resp, fmt.Errorf(errorMessage)
-		}
-		errorMessage += string(body)
-		resp.StatusCode = int32(pluginResponse.StatusCode)
-		resp.StatusMessage = response.InternalError
+		errorMessage = errorMessage + err.Error()
+		resp.StatusCode = err.StatusCode
+		resp.StatusMessage = err.StatusMessage
+		resp.MsgArgs = err.MsgArgs
 		log.Warn(errorMessage)
-		return body, "", resp, fmt.Errorf(errorMessage)
+		return err.ResponseBody(), "", resp, fmt.Errorf(errorMessage)
 	}
-
-	data := string(body)
-	//replacing the resposne with north bound translation URL
-	for key, value := range config.Data.URLTranslation.NorthBoundURL {
-		data = strings.Replace(data, key, value, -1)
-	}
-	return []byte(data), pluginResponse.Header.Get("X-Auth-Token"), resp, nil
+	return pluginResponse.Body, pluginResponse.Header.Get("X-Auth-Token"), resp, nil
 }
 
 func checkRetrievalInfo(oid string) bool {
@@ -275,15 +232,3 @@ func getPluginStatus(plugin umodel.Plugin) bool {
 	log.Info("Status of plugin " + plugin.ID + " " + strconv.FormatBool(status))
 	return status
 }
-
-func callPlugin(req PluginContactRequest) (*http.Response, error) {
-	var oid string
-	for key, value := range config.Data.URLTranslation.SouthBoundURL {
-		oid = strings.Replace(req.OID, key, value, -1)
-	}
-	var reqURL = "https://" + req.Plugin.IP + ":" + req.Plugin.Port + oid
-	if strings.EqualFold(req.Plugin.PreferredAuthType, "BasicAuth") {
-		return req.ContactClient(reqURL, req.HTTPMethodType, "", oid, req.DeviceInfo, req.BasicAuth)
-	}
-	return req.ContactClient(reqURL, req.HTTPMethodType, req.Token, oid, req.DeviceInfo, nil)
-}
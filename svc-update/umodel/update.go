@@ -75,6 +75,12 @@ func GetResource(Table, key string, dbtype common.DbType) (string, *errors.Error
 	return resource, nil
 }
 
+// GetResources reads the resources of the keys from the table in a single request to the DB.
+// The keys which are not found are left out of the result.
+func GetResources(Table string, keys []string, dbtype common.DbType) (map[string]string, *errors.Error) {
+	return common.GetMembersFromDB(Table, dbtype)(keys)
+}
+
 //GenericSave will save any resource data into the database
 func GenericSave(body []byte, table string, key string) error {
 	connPool, err := common.GetDBConnection(common.OnDisk)
@@ -80,6 +80,7 @@ type responseStatus struct {
 type DB struct {
 	GetAllKeysFromTable func(string, common.DbType) ([]string, error)
 	GetResource         func(string, string, common.DbType) (string, *errors.Error)
+	GetResources        func(string, []string, common.DbType) (map[string]string, *errors.Error)
 }
 
 // UpdateRequestBody struct defines the request body for update action
@@ -117,6 +118,7 @@ func GetExternalInterface() *ExternalInterface {
 		DB: DB{
 			GetAllKeysFromTable: umodel.GetAllKeysFromTable,
 			GetResource:         umodel.GetResource,
+			GetResources:        umodel.GetResources,
 		},
 	}
 }
@@ -138,7 +138,9 @@ func (e *ExternalInterface) GetAllFirmwareInventory(req *updateproto.UpdateReque
 	firmwareCollection.MembersCount = len(members)
 	resp.Body = firmwareCollection
 	resp.StatusCode = http.StatusOK
-	return resp
+	return common.ApplyQueryOptions(resp, req.URL, func(odataIDs []string) (map[string]string, *errors.Error) {
+		return e.DB.GetResources("FirmwareInventory", odataIDs, common.InMemory)
+	})
 }
 
 // GetFirmwareInventory is used to fetch resource data. The function is supposed to be used as part of RPC
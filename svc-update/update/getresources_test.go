@@ -41,6 +41,12 @@ func mockContactClient(url, method, token string, odataID string, body interface
 	return nil, fmt.Errorf("InvalidRequest")
 }
 
+func mockGetResources(table string, keys []string, dbType common.DbType) (map[string]string, *errors.Error) {
+	return common.EachMember(func(key string) (string, *errors.Error) {
+		return mockGetResource(table, key, dbType)
+	})(keys)
+}
+
 func mockGetResource(table, key string, dbType common.DbType) (string, *errors.Error) {
 	if (key == "/redfish/v1/UpdateService/FirmwareInentory/3bd1f589-117a-4cf9-89f2-da44ee8e012b:1") || (key == "/redfish/v1/UpdateService/SoftwareInentory/3bd1f589-117a-4cf9-89f2-da44ee8e012b:1") {
 		return "", errors.PackError(errors.DBKeyNotFound, "not found")
@@ -102,6 +108,7 @@ func mockGetExternalInterface() *ExternalInterface {
 		DB: DB{
 			GetAllKeysFromTable: mockGetAllKeysFromTable,
 			GetResource:         mockGetResource,
+			GetResources:        mockGetResources,
 		},
 	}
 }
@@ -225,6 +232,21 @@ func TestFirmwareInventoryCollection(t *testing.T) {
 	assert.Equal(t, update.MembersCount, 1, "Member count does not match")
 }
 
+func TestFirmwareInventoryCollectionWithQuery(t *testing.T) {
+	req := &updateproto.UpdateRequest{
+		URL: "/redfish/v1/UpdateService/FirmwareInventory?$skip=1&$select=Name",
+	}
+	e := mockGetExternalInterface()
+	response := e.GetAllFirmwareInventory(req)
+
+	update := response.Body.(map[string]interface{})
+	assert.Equal(t, http.StatusOK, int(response.StatusCode), "Status code should be StatusOK.")
+	assert.Equal(t, 0, len(update["Members"].([]interface{})), "Members should be skipped")
+	assert.Equal(t, float64(1), update["Members@odata.count"], "Member count does not match")
+	assert.Nil(t, update["Description"], "Description should not be selected")
+	assert.Equal(t, "FirmwareInventory", update["Name"], "Name should be selected")
+}
+
 func TestSoftwareInventoryCollection(t *testing.T) {
 	req := &updateproto.UpdateRequest{}
 	e := mockGetExternalInterface()